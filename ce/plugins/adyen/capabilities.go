@@ -4,6 +4,10 @@ import "github.com/formancehq/payments/pkg/domain/models"
 
 var capabilities = []models.Capability{
 	models.CAPABILITY_FETCH_ACCOUNTS,
+
+	models.CAPABILITY_CREATE_TRANSFER,
+	models.CAPABILITY_CREATE_PAYOUT,
+
 	models.CAPABILITY_CREATE_WEBHOOKS,
 	models.CAPABILITY_TRANSLATE_WEBHOOKS,
 }
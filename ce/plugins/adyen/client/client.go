@@ -8,6 +8,8 @@ import (
 	"github.com/adyen/adyen-go-api-library/v7/src/adyen"
 	"github.com/adyen/adyen-go-api-library/v7/src/common"
 	"github.com/adyen/adyen-go-api-library/v7/src/management"
+	"github.com/adyen/adyen-go-api-library/v7/src/transfers"
+	"github.com/adyen/adyen-go-api-library/v7/src/transferwebhook"
 	"github.com/adyen/adyen-go-api-library/v7/src/webhook"
 	"github.com/formancehq/payments/pkg/domain/httpwrapper"
 	"github.com/formancehq/payments/pkg/domain/metrics"
@@ -23,6 +25,10 @@ type Client interface {
 	VerifyWebhookHMAC(item webhook.NotificationItem, hmacKey string) bool
 	DeleteWebhook(ctx context.Context, connectorID string) error
 	TranslateWebhook(req string) (*webhook.Webhook, error)
+
+	CreateTransfer(ctx context.Context, idempotencyKey string, req transfers.TransferInfo) (*transfers.Transfer, error)
+	VerifyTransferWebhookHMAC(payload []byte, signature string, hmacKey string) bool
+	TranslateTransferWebhook(req string) (*transferwebhook.TransferNotificationRequest, error)
 }
 
 type client struct {
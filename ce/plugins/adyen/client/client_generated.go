@@ -14,6 +14,8 @@ import (
 	reflect "reflect"
 
 	management "github.com/adyen/adyen-go-api-library/v7/src/management"
+	transfers "github.com/adyen/adyen-go-api-library/v7/src/transfers"
+	transferwebhook "github.com/adyen/adyen-go-api-library/v7/src/transferwebhook"
 	webhook "github.com/adyen/adyen-go-api-library/v7/src/webhook"
 	models "github.com/formancehq/payments/pkg/domain/models"
 	gomock "go.uber.org/mock/gomock"
@@ -43,6 +45,21 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// CreateTransfer mocks base method.
+func (m *MockClient) CreateTransfer(ctx context.Context, idempotencyKey string, req transfers.TransferInfo) (*transfers.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransfer", ctx, idempotencyKey, req)
+	ret0, _ := ret[0].(*transfers.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransfer indicates an expected call of CreateTransfer.
+func (mr *MockClientMockRecorder) CreateTransfer(ctx, idempotencyKey, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransfer", reflect.TypeOf((*MockClient)(nil).CreateTransfer), ctx, idempotencyKey, req)
+}
+
 // CreateWebhook mocks base method.
 func (m *MockClient) CreateWebhook(ctx context.Context, url, connectorID string) (CreateWebhookResponse, error) {
 	m.ctrl.T.Helper()
@@ -87,6 +104,21 @@ func (mr *MockClientMockRecorder) GetMerchantAccounts(ctx, pageNumber, pageSize
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMerchantAccounts", reflect.TypeOf((*MockClient)(nil).GetMerchantAccounts), ctx, pageNumber, pageSize)
 }
 
+// TranslateTransferWebhook mocks base method.
+func (m *MockClient) TranslateTransferWebhook(req string) (*transferwebhook.TransferNotificationRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TranslateTransferWebhook", req)
+	ret0, _ := ret[0].(*transferwebhook.TransferNotificationRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TranslateTransferWebhook indicates an expected call of TranslateTransferWebhook.
+func (mr *MockClientMockRecorder) TranslateTransferWebhook(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TranslateTransferWebhook", reflect.TypeOf((*MockClient)(nil).TranslateTransferWebhook), req)
+}
+
 // TranslateWebhook mocks base method.
 func (m *MockClient) TranslateWebhook(req string) (*webhook.Webhook, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +134,20 @@ func (mr *MockClientMockRecorder) TranslateWebhook(req any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TranslateWebhook", reflect.TypeOf((*MockClient)(nil).TranslateWebhook), req)
 }
 
+// VerifyTransferWebhookHMAC mocks base method.
+func (m *MockClient) VerifyTransferWebhookHMAC(payload []byte, signature, hmacKey string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyTransferWebhookHMAC", payload, signature, hmacKey)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// VerifyTransferWebhookHMAC indicates an expected call of VerifyTransferWebhookHMAC.
+func (mr *MockClientMockRecorder) VerifyTransferWebhookHMAC(payload, signature, hmacKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyTransferWebhookHMAC", reflect.TypeOf((*MockClient)(nil).VerifyTransferWebhookHMAC), payload, signature, hmacKey)
+}
+
 // VerifyWebhookBasicAuth mocks base method.
 func (m *MockClient) VerifyWebhookBasicAuth(basicAuth *models.BasicAuth) bool {
 	m.ctrl.T.Helper()
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+
+	"github.com/adyen/adyen-go-api-library/v7/src/common"
+	"github.com/adyen/adyen-go-api-library/v7/src/transfers"
+	"github.com/adyen/adyen-go-api-library/v7/src/transferwebhook"
+	"github.com/formancehq/payments/pkg/domain/metrics"
+)
+
+// CreateTransfer moves funds out of a balance account, either to another
+// balance account (internal) or to a transfer instrument (bank). The
+// idempotency key is forwarded as the Idempotency-Key header so a retried
+// activity does not move funds twice.
+func (c *client) CreateTransfer(ctx context.Context, idempotencyKey string, req transfers.TransferInfo) (*transfers.Transfer, error) {
+	ctx = metrics.OperationContext(ctx, "create_transfer")
+	if idempotencyKey != "" {
+		ctx = common.WithIdempotencyKey(ctx, idempotencyKey)
+	}
+
+	transfer, raw, err := c.client.Transfers().TransfersApi.TransferFunds(
+		ctx,
+		c.client.Transfers().TransfersApi.TransferFundsInput().TransferInfo(req),
+	)
+	if err != nil {
+		statusCode := 0
+		if raw != nil {
+			statusCode = raw.StatusCode
+		}
+		return nil, c.wrapSDKError(err, statusCode)
+	}
+
+	return &transfer, nil
+}
+
+// VerifyTransferWebhookHMAC checks the HmacSignature header of a balance
+// platform webhook. Unlike standard notifications, the signature covers the
+// raw payload, signed with the hex-encoded key from the Customer Area.
+func (c *client) VerifyTransferWebhookHMAC(payload []byte, signature string, hmacKey string) bool {
+	if signature == "" || hmacKey == "" {
+		return false
+	}
+
+	key, err := hex.DecodeString(hmacKey)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write(payload)
+	expected := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+func (c *client) TranslateTransferWebhook(req string) (*transferwebhook.TransferNotificationRequest, error) {
+	return transferwebhook.HandleTransferNotificationRequest(req)
+}
@@ -9,13 +9,18 @@ import (
 )
 
 type Config struct {
-	APIKey             string `json:"apiKey" validate:"required"`
+	APIKey             string `json:"apiKey" validate:"required" sensitive:"true"`
 	CompanyID          string `json:"companyID" validate:"required"`
 	LiveEndpointPrefix string `json:"liveEndpointPrefix" validate:"omitempty,url_encoded"`
 
 	// https://datatracker.ietf.org/doc/html/rfc7617
 	WebhookUsername string `json:"webhookUsername" validate:"omitempty,excludes=:"`
-	WebhookPassword string `json:"webhookPassword" validate:""`
+	WebhookPassword string `json:"webhookPassword" validate:"" sensitive:"true"`
+
+	// TransferWebhookHMACKey is the HMAC key of the balance platform
+	// transfer webhook set up in the Customer Area. Transfer and payout
+	// status updates are only accepted when it is set.
+	TransferWebhookHMACKey string `json:"transferWebhookHMACKey" validate:"omitempty,hexadecimal" sensitive:"true"`
 }
 
 const PAGE_SIZE = 100
@@ -59,6 +59,22 @@ func TestUnmarshalAndValidateConfig(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name:    "Valid TransferWebhookHMACKey",
+			payload: []byte(`{"apiKey":"123","companyID":"456","transferWebhookHMACKey":"44782DEF547AAA06C910C43932B1EB0C"}`),
+			expected: Config{
+				APIKey:                 "123",
+				CompanyID:              "456",
+				TransferWebhookHMACKey: "44782DEF547AAA06C910C43932B1EB0C",
+			},
+			expectError: false,
+		},
+		{
+			name:        "Invalid TransferWebhookHMACKey",
+			payload:     []byte(`{"apiKey":"123","companyID":"456","transferWebhookHMACKey":"not-hex"}`),
+			expected:    Config{},
+			expectError: true,
+		},
 		{
 			name:        "Invalid WebhookUsername",
 			payload:     []byte(`{"apiKey":"123","companyID":"456","webhookUsername":"user:invalid"}`),
@@ -0,0 +1,66 @@
+package adyen
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adyen/adyen-go-api-library/v7/src/transfers"
+	"github.com/formancehq/go-libs/v5/pkg/types/currency"
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	errorsutils "github.com/formancehq/payments/pkg/domain/errors"
+	"github.com/formancehq/payments/pkg/domain/models"
+)
+
+const defaultPayoutPriority = "regular"
+
+func (p *Plugin) createPayout(ctx context.Context, pi models.PSPPaymentInitiation) (models.PSPPayment, error) {
+	balanceAccountID, err := p.validateTransferPayoutRequest(pi)
+	if err != nil {
+		return models.PSPPayment{}, err
+	}
+
+	transferInstrumentID := lookupMetadata(pi, transferInstrumentIDMetadataKey, pi.DestinationAccount, transferInstrumentIDMetadataKey)
+	if transferInstrumentID == "" {
+		return models.PSPPayment{}, errorsutils.NewWrappedError(
+			fmt.Errorf("transfer instrument is required in payout request"),
+			models.ErrInvalidRequest,
+		)
+	}
+
+	curr, _, err := currency.GetCurrencyAndPrecisionFromAsset(supportedCurrenciesWithDecimal, pi.Asset)
+	if err != nil {
+		return models.PSPPayment{}, errorsutils.NewWrappedError(
+			fmt.Errorf("failed to get currency and precision from asset: %w", err),
+			models.ErrInvalidRequest,
+		)
+	}
+
+	priority := models.ExtractNamespacedMetadata(pi.Metadata, priorityMetadataKey)
+	if priority == "" {
+		priority = defaultPayoutPriority
+	}
+
+	req := transfers.TransferInfo{
+		Amount: transfers.Amount{
+			Currency: curr,
+			Value:    pi.Amount.Int64(),
+		},
+		BalanceAccountId: pointer.For(balanceAccountID),
+		Category:         transferCategoryBank,
+		Counterparty: transfers.CounterpartyInfoV3{
+			TransferInstrumentId: pointer.For(transferInstrumentID),
+		},
+		Priority:  pointer.For(priority),
+		Reference: pointer.For(pi.Reference),
+	}
+	if pi.Description != "" {
+		req.Description = pointer.For(pi.Description)
+	}
+
+	resp, err := p.client.CreateTransfer(ctx, pi.Reference, req)
+	if err != nil {
+		return models.PSPPayment{}, err
+	}
+
+	return fromTransferToPayment(resp, models.PAYMENT_TYPE_PAYOUT, balanceAccountID, transferInstrumentID)
+}
@@ -0,0 +1,95 @@
+package adyen
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/adyen/adyen-go-api-library/v7/src/transfers"
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/payments/ce/plugins/adyen/client"
+	"github.com/formancehq/payments/pkg/domain/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gomock "go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Adyen Plugin Payouts Creation", func() {
+	var (
+		ctrl *gomock.Controller
+		m    *client.MockClient
+		plg  models.Plugin
+		pi   models.PSPPaymentInitiation
+		now  time.Time
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		m = client.NewMockClient(ctrl)
+		plg = &Plugin{client: m}
+		now = time.Now().UTC()
+
+		pi = models.PSPPaymentInitiation{
+			Reference: "pi_1",
+			CreatedAt: now,
+			DestinationAccount: &models.PSPAccount{
+				Reference: "bank1",
+				Metadata: map[string]string{
+					transferInstrumentIDMetadataKey: "TI_1",
+				},
+			},
+			Amount: big.NewInt(1500),
+			Asset:  "EUR/2",
+			Metadata: map[string]string{
+				sourceBalanceAccountIDMetadataKey: "BA_1",
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should fail - missing destination account", func(ctx SpecContext) {
+		pi.DestinationAccount = nil
+		_, err := plg.CreatePayout(ctx, models.CreatePayoutRequest{PaymentInitiation: pi})
+		Expect(err).To(MatchError(ContainSubstring("destination account is required")))
+	})
+
+	It("should fail - missing transfer instrument", func(ctx SpecContext) {
+		pi.DestinationAccount.Metadata = nil
+		_, err := plg.CreatePayout(ctx, models.CreatePayoutRequest{PaymentInitiation: pi})
+		Expect(err).To(MatchError(ContainSubstring("transfer instrument is required")))
+	})
+
+	It("should fail - amount overflowing minor units", func(ctx SpecContext) {
+		pi.Amount, _ = new(big.Int).SetString("100000000000000000000", 10)
+		_, err := plg.CreatePayout(ctx, models.CreatePayoutRequest{PaymentInitiation: pi})
+		Expect(err).To(MatchError(models.ErrInvalidRequest))
+	})
+
+	It("should create a bank transfer to the transfer instrument", func(ctx SpecContext) {
+		pi.Metadata[priorityMetadataKey] = "instant"
+		m.EXPECT().CreateTransfer(gomock.Any(), "pi_1", transfers.TransferInfo{
+			Amount:           transfers.Amount{Currency: "EUR", Value: 1500},
+			BalanceAccountId: pointer.For("BA_1"),
+			Category:         "bank",
+			Counterparty:     transfers.CounterpartyInfoV3{TransferInstrumentId: pointer.For("TI_1")},
+			Priority:         pointer.For("instant"),
+			Reference:        pointer.For("pi_1"),
+		}).Return(&transfers.Transfer{
+			Id:       pointer.For("tr_2"),
+			Amount:   transfers.Amount{Currency: "EUR", Value: 1500},
+			Category: "bank",
+			Status:   "authorised",
+		}, nil)
+
+		resp, err := plg.CreatePayout(ctx, models.CreatePayoutRequest{PaymentInitiation: pi})
+		Expect(err).To(BeNil())
+		Expect(resp.Payment).ToNot(BeNil())
+		Expect(resp.Payment.Reference).To(Equal("tr_2"))
+		Expect(resp.Payment.Type).To(Equal(models.PAYMENT_TYPE_PAYOUT))
+		Expect(resp.Payment.Status).To(Equal(models.PAYMENT_STATUS_PENDING))
+		Expect(resp.Payment.SourceAccountReference).To(Equal(pointer.For("BA_1")))
+		Expect(resp.Payment.DestinationAccountReference).To(Equal(pointer.For("TI_1")))
+	})
+})
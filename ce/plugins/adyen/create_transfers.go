@@ -0,0 +1,123 @@
+package adyen
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/adyen/adyen-go-api-library/v7/src/transfers"
+	"github.com/formancehq/go-libs/v5/pkg/types/currency"
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	errorsutils "github.com/formancehq/payments/pkg/domain/errors"
+	"github.com/formancehq/payments/pkg/domain/models"
+)
+
+const (
+	transferCategoryInternal = "internal"
+	transferCategoryBank     = "bank"
+)
+
+func (p *Plugin) createTransfer(ctx context.Context, pi models.PSPPaymentInitiation) (models.PSPPayment, error) {
+	balanceAccountID, err := p.validateTransferPayoutRequest(pi)
+	if err != nil {
+		return models.PSPPayment{}, err
+	}
+
+	counterpartyID := lookupMetadata(pi, destinationBalanceAccountIDMetadataKey, pi.DestinationAccount, balanceAccountIDMetadataKey)
+	if counterpartyID == "" {
+		return models.PSPPayment{}, errorsutils.NewWrappedError(
+			fmt.Errorf("destination balance account is required in transfer request"),
+			models.ErrInvalidRequest,
+		)
+	}
+
+	curr, _, err := currency.GetCurrencyAndPrecisionFromAsset(supportedCurrenciesWithDecimal, pi.Asset)
+	if err != nil {
+		return models.PSPPayment{}, errorsutils.NewWrappedError(
+			fmt.Errorf("failed to get currency and precision from asset: %w", err),
+			models.ErrInvalidRequest,
+		)
+	}
+
+	req := transfers.TransferInfo{
+		Amount: transfers.Amount{
+			Currency: curr,
+			Value:    pi.Amount.Int64(),
+		},
+		BalanceAccountId: pointer.For(balanceAccountID),
+		Category:         transferCategoryInternal,
+		Counterparty: transfers.CounterpartyInfoV3{
+			BalanceAccountId: pointer.For(counterpartyID),
+		},
+		Reference: pointer.For(pi.Reference),
+	}
+	if pi.Description != "" {
+		req.Description = pointer.For(pi.Description)
+	}
+
+	resp, err := p.client.CreateTransfer(ctx, pi.Reference, req)
+	if err != nil {
+		return models.PSPPayment{}, err
+	}
+
+	return fromTransferToPayment(resp, models.PAYMENT_TYPE_TRANSFER, balanceAccountID, counterpartyID)
+}
+
+func fromTransferToPayment(
+	from *transfers.Transfer,
+	paymentType models.PaymentType,
+	source string,
+	destination string,
+) (models.PSPPayment, error) {
+	raw, err := json.Marshal(from)
+	if err != nil {
+		return models.PSPPayment{}, err
+	}
+
+	if from.Id == nil {
+		return models.PSPPayment{}, fmt.Errorf("missing transfer id in adyen response")
+	}
+
+	createdAt := time.Now().UTC()
+	if from.CreationDate != nil {
+		createdAt = from.CreationDate.UTC()
+	}
+
+	return models.PSPPayment{
+		Reference:                   *from.Id,
+		CreatedAt:                   createdAt,
+		Type:                        paymentType,
+		Amount:                      big.NewInt(from.Amount.Value),
+		Asset:                       currency.FormatAsset(supportedCurrenciesWithDecimal, from.Amount.Currency),
+		Scheme:                      models.PAYMENT_SCHEME_OTHER,
+		Status:                      transferStatusToPaymentStatus(from.Category, from.Status),
+		SourceAccountReference:      pointer.For(source),
+		DestinationAccountReference: pointer.For(destination),
+		Raw:                         raw,
+	}, nil
+}
+
+// transferStatusToPaymentStatus maps an Adyen transfer status. Internal
+// transfers are final once booked, bank transfers only once the
+// counterparty has been credited.
+func transferStatusToPaymentStatus(category string, status string) models.PaymentStatus {
+	switch status {
+	case "received", "authorised", "pendingApproval", "approvalPending", "captured":
+		return models.PAYMENT_STATUS_PENDING
+	case "booked":
+		if category == transferCategoryInternal {
+			return models.PAYMENT_STATUS_SUCCEEDED
+		}
+		return models.PAYMENT_STATUS_PENDING
+	case "credited":
+		return models.PAYMENT_STATUS_SUCCEEDED
+	case "refused", "error", "failed", "declined", "returned":
+		return models.PAYMENT_STATUS_FAILED
+	case "cancelled":
+		return models.PAYMENT_STATUS_CANCELLED
+	default:
+		return models.PAYMENT_STATUS_OTHER
+	}
+}
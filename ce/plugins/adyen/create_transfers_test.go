@@ -0,0 +1,110 @@
+package adyen
+
+import (
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/adyen/adyen-go-api-library/v7/src/transfers"
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/payments/ce/plugins/adyen/client"
+	"github.com/formancehq/payments/pkg/domain/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gomock "go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Adyen Plugin Transfers Creation", func() {
+	var (
+		ctrl *gomock.Controller
+		m    *client.MockClient
+		plg  models.Plugin
+		pi   models.PSPPaymentInitiation
+		now  time.Time
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		m = client.NewMockClient(ctrl)
+		plg = &Plugin{client: m}
+		now = time.Now().UTC()
+
+		pi = models.PSPPaymentInitiation{
+			Reference:   "pi_1",
+			CreatedAt:   now,
+			Description: "test1",
+			SourceAccount: &models.PSPAccount{
+				Reference: "merchant1",
+				Metadata: map[string]string{
+					balanceAccountIDMetadataKey: "BA_1",
+				},
+			},
+			DestinationAccount: &models.PSPAccount{
+				Reference: "merchant2",
+				Metadata: map[string]string{
+					balanceAccountIDMetadataKey: "BA_2",
+				},
+			},
+			Amount: big.NewInt(1500),
+			Asset:  "EUR/2",
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should fail - missing source balance account", func(ctx SpecContext) {
+		pi.SourceAccount = nil
+		_, err := plg.CreateTransfer(ctx, models.CreateTransferRequest{PaymentInitiation: pi})
+		Expect(err).To(MatchError(ContainSubstring("source balance account is required")))
+	})
+
+	It("should fail - missing destination balance account", func(ctx SpecContext) {
+		pi.DestinationAccount.Metadata = nil
+		_, err := plg.CreateTransfer(ctx, models.CreateTransferRequest{PaymentInitiation: pi})
+		Expect(err).To(MatchError(ContainSubstring("destination balance account is required")))
+	})
+
+	It("should fail - unsupported asset", func(ctx SpecContext) {
+		pi.Asset = "HHH/2"
+		_, err := plg.CreateTransfer(ctx, models.CreateTransferRequest{PaymentInitiation: pi})
+		Expect(err).To(MatchError(ContainSubstring("failed to get currency and precision from asset")))
+	})
+
+	It("should fail - client error", func(ctx SpecContext) {
+		m.EXPECT().CreateTransfer(gomock.Any(), "pi_1", gomock.Any()).Return(nil, errors.New("test error"))
+
+		_, err := plg.CreateTransfer(ctx, models.CreateTransferRequest{PaymentInitiation: pi})
+		Expect(err).To(MatchError("test error"))
+	})
+
+	It("should create an internal transfer between balance accounts", func(ctx SpecContext) {
+		pi.Metadata = map[string]string{destinationBalanceAccountIDMetadataKey: "BA_3"}
+		m.EXPECT().CreateTransfer(gomock.Any(), "pi_1", transfers.TransferInfo{
+			Amount:           transfers.Amount{Currency: "EUR", Value: 1500},
+			BalanceAccountId: pointer.For("BA_1"),
+			Category:         "internal",
+			Counterparty:     transfers.CounterpartyInfoV3{BalanceAccountId: pointer.For("BA_3")},
+			Description:      pointer.For("test1"),
+			Reference:        pointer.For("pi_1"),
+		}).Return(&transfers.Transfer{
+			Id:           pointer.For("tr_1"),
+			Amount:       transfers.Amount{Currency: "EUR", Value: 1500},
+			Category:     "internal",
+			CreationDate: &now,
+			Status:       "received",
+		}, nil)
+
+		resp, err := plg.CreateTransfer(ctx, models.CreateTransferRequest{PaymentInitiation: pi})
+		Expect(err).To(BeNil())
+		Expect(resp.Payment).ToNot(BeNil())
+		Expect(resp.Payment.Reference).To(Equal("tr_1"))
+		Expect(resp.Payment.Type).To(Equal(models.PAYMENT_TYPE_TRANSFER))
+		Expect(resp.Payment.Status).To(Equal(models.PAYMENT_STATUS_PENDING))
+		Expect(resp.Payment.Amount).To(Equal(big.NewInt(1500)))
+		Expect(resp.Payment.Asset).To(Equal("EUR/2"))
+		Expect(resp.Payment.SourceAccountReference).To(Equal(pointer.For("BA_1")))
+		Expect(resp.Payment.DestinationAccountReference).To(Equal(pointer.For("BA_3")))
+	})
+})
@@ -138,10 +138,10 @@ var _ = Describe("Adyen Plugin", func() {
 	})
 
 	Context("create transfer", func() {
-		It("should fail because not implemented", func(ctx SpecContext) {
+		It("should fail if client is not set", func(ctx SpecContext) {
 			req := models.CreateTransferRequest{}
 			_, err := plg.CreateTransfer(ctx, req)
-			Expect(err).To(MatchError(plugins.ErrNotImplemented.Error()))
+			Expect(err).To(MatchError(plugins.ErrNotYetInstalled))
 		})
 	})
 
@@ -165,7 +165,7 @@ var _ = Describe("Adyen Plugin", func() {
 		It("should fail if client is not set", func(ctx SpecContext) {
 			req := models.CreatePayoutRequest{}
 			_, err := plg.CreatePayout(ctx, req)
-			Expect(err).To(MatchError(plugins.ErrNotImplemented.Error()))
+			Expect(err).To(MatchError(plugins.ErrNotYetInstalled))
 		})
 	})
 
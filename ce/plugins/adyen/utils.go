@@ -1,5 +1,12 @@
 package adyen
 
+import (
+	"fmt"
+
+	errorsutils "github.com/formancehq/payments/pkg/domain/errors"
+	"github.com/formancehq/payments/pkg/domain/models"
+)
+
 func shouldFetchMore[T any, V any](ret []T, pagedT []V, pageSize int) (bool, bool, []T) {
 	switch {
 	case len(pagedT) < pageSize:
@@ -10,3 +17,55 @@ func shouldFetchMore[T any, V any](ret []T, pagedT []V, pageSize int) (bool, boo
 		return true, true, ret
 	}
 }
+
+const (
+	// Adyen balance platform identifiers are not the merchant accounts
+	// fetched by fetch_accounts, so transfers and payouts reference them
+	// through metadata, either on the payment initiation or on the
+	// source/destination accounts.
+	balanceAccountIDMetadataKey            = "com.adyen.spec/balanceAccountId"
+	sourceBalanceAccountIDMetadataKey      = "com.adyen.spec/sourceBalanceAccountId"
+	destinationBalanceAccountIDMetadataKey = "com.adyen.spec/destinationBalanceAccountId"
+	transferInstrumentIDMetadataKey        = "com.adyen.spec/transferInstrumentId"
+	priorityMetadataKey                    = "com.adyen.spec/priority"
+)
+
+// lookupMetadata reads piKey from the payment initiation metadata, falling
+// back to accountKey on the given account.
+func lookupMetadata(pi models.PSPPaymentInitiation, piKey string, account *models.PSPAccount, accountKey string) string {
+	if value := models.ExtractNamespacedMetadata(pi.Metadata, piKey); value != "" {
+		return value
+	}
+	if account != nil {
+		return models.ExtractNamespacedMetadata(account.Metadata, accountKey)
+	}
+	return ""
+}
+
+// validateTransferPayoutRequest checks the fields common to transfers and
+// payouts and returns the source balance account ID.
+func (p *Plugin) validateTransferPayoutRequest(pi models.PSPPaymentInitiation) (string, error) {
+	if pi.Amount == nil || !pi.Amount.IsInt64() || pi.Amount.Sign() <= 0 {
+		return "", errorsutils.NewWrappedError(
+			fmt.Errorf("amount must be a positive integer in minor units"),
+			models.ErrInvalidRequest,
+		)
+	}
+
+	if pi.DestinationAccount == nil {
+		return "", errorsutils.NewWrappedError(
+			fmt.Errorf("destination account is required in transfer/payout request"),
+			models.ErrInvalidRequest,
+		)
+	}
+
+	balanceAccountID := lookupMetadata(pi, sourceBalanceAccountIDMetadataKey, pi.SourceAccount, balanceAccountIDMetadataKey)
+	if balanceAccountID == "" {
+		return "", errorsutils.NewWrappedError(
+			fmt.Errorf("source balance account is required in transfer/payout request"),
+			models.ErrInvalidRequest,
+		)
+	}
+
+	return balanceAccountID, nil
+}
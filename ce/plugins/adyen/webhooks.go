@@ -9,7 +9,9 @@ import (
 	"math/big"
 	"net/url"
 	"strings"
+	"time"
 
+	"github.com/adyen/adyen-go-api-library/v7/src/transferwebhook"
 	"github.com/adyen/adyen-go-api-library/v7/src/webhook"
 	"github.com/formancehq/go-libs/v5/pkg/types/currency"
 	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
@@ -18,6 +20,17 @@ import (
 
 const (
 	webhookHMACMetadataKey = "hmac_key"
+
+	standardWebhookName = "standard"
+	transferWebhookName = "transfers"
+
+	// Balance platform webhooks carry their signature in a header rather
+	// than in the notification items.
+	transferWebhookHMACHeader = "Hmacsignature"
+
+	// Additional data keys sent with dispute notifications.
+	disputePspReferenceKey = "disputePspReference"
+	defensePeriodEndsAtKey = "defensePeriodEndsAt"
 )
 
 type supportedWebhook struct {
@@ -27,10 +40,14 @@ type supportedWebhook struct {
 
 func (p *Plugin) initWebhookConfig() {
 	p.supportedWebhooks = map[string]supportedWebhook{
-		"standard": {
+		standardWebhookName: {
 			urlPath: "/standard",
 			fn:      p.translateStandardWebhook,
 		},
+		transferWebhookName: {
+			urlPath: "/transfers",
+			fn:      p.translateTransferWebhook,
+		},
 	}
 }
 
@@ -40,7 +57,7 @@ func (p *Plugin) createWebhooks(ctx context.Context, req models.CreateWebhooksRe
 		return configs, fmt.Errorf("STACK_PUBLIC_URL is not set")
 	}
 
-	name := "standard"
+	name := standardWebhookName
 	standardConfig := p.supportedWebhooks[name]
 
 	url, err := url.JoinPath(req.WebhookBaseUrl, standardConfig.urlPath)
@@ -61,6 +78,16 @@ func (p *Plugin) createWebhooks(ctx context.Context, req models.CreateWebhooksRe
 		},
 	})
 
+	// Transfer webhooks cannot be created through the Management API: they
+	// are set up on the balance platform in the Customer Area, pointing to
+	// this URL, and signed with the configured HMAC key.
+	if p.config.TransferWebhookHMACKey != "" {
+		configs = append(configs, models.PSPWebhookConfig{
+			Name:    transferWebhookName,
+			URLPath: p.supportedWebhooks[transferWebhookName].urlPath,
+		})
+	}
+
 	return configs, err
 }
 
@@ -69,6 +96,15 @@ func (p *Plugin) verifyWebhook(_ context.Context, req models.VerifyWebhookReques
 		return models.VerifyWebhookResponse{}, fmt.Errorf("invalid basic auth: %w", models.ErrWebhookVerification)
 	}
 
+	if req.Config != nil && req.Config.Name == transferWebhookName {
+		signature := webhookHeader(req.Webhook.Headers, transferWebhookHMACHeader)
+		if !p.client.VerifyTransferWebhookHMAC(req.Webhook.Body, signature, p.config.TransferWebhookHMACKey) {
+			return models.VerifyWebhookResponse{}, fmt.Errorf("invalid HMAC: %w", models.ErrWebhookVerification)
+		}
+
+		return webhookIdempotencyKey(req.Webhook.Body), nil
+	}
+
 	webhooks, err := p.client.TranslateWebhook(string(req.Webhook.Body))
 	if err != nil {
 		return models.VerifyWebhookResponse{}, err
@@ -80,11 +116,24 @@ func (p *Plugin) verifyWebhook(_ context.Context, req models.VerifyWebhookReques
 		}
 	}
 
-	sha := sha256.Sum256(req.Webhook.Body)
+	return webhookIdempotencyKey(req.Webhook.Body), nil
+}
+
+func webhookIdempotencyKey(body []byte) models.VerifyWebhookResponse {
+	sha := sha256.Sum256(body)
 	ik := base64.StdEncoding.EncodeToString(sha[:])
 	return models.VerifyWebhookResponse{
 		WebhookIdempotencyKey: pointer.For(ik),
-	}, nil
+	}
+}
+
+func webhookHeader(headers map[string][]string, name string) string {
+	for key, values := range headers {
+		if strings.EqualFold(key, name) && len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
 }
 
 func (p *Plugin) translateStandardWebhook(ctx context.Context, req models.TranslateWebhookRequest) (models.TranslateWebhookResponse, error) {
@@ -96,6 +145,7 @@ func (p *Plugin) translateStandardWebhook(ctx context.Context, req models.Transl
 	responses := make([]models.WebhookResponse, 0, len(*webhooks.NotificationItems))
 	for _, item := range *webhooks.NotificationItems {
 		var payment *models.PSPPayment
+		var dispute *models.PSPDispute
 		var err error
 		switch item.NotificationRequestItem.EventCode {
 		case webhook.EventCodeAuthorisation:
@@ -122,6 +172,14 @@ func (p *Plugin) translateStandardWebhook(ctx context.Context, req models.Transl
 			payment, err = p.handlePayoutDecline(item.NotificationRequestItem)
 		case webhook.EventCodePayoutExpire:
 			payment, err = p.handlePayoutExpire(item.NotificationRequestItem)
+		case webhook.EventCodeRequestForInformation,
+			webhook.EventCodeNotificationOfChargeback,
+			webhook.EventCodeChargeback,
+			webhook.EventCodeChargebackReversed,
+			webhook.EventCodeSecondChargeback,
+			webhook.EventCodePrearbitrationWon,
+			webhook.EventCodePrearbitrationLost:
+			dispute, err = p.handleDispute(item.NotificationRequestItem)
 		}
 		if err != nil {
 			return models.TranslateWebhookResponse{}, err
@@ -132,6 +190,12 @@ func (p *Plugin) translateStandardWebhook(ctx context.Context, req models.Transl
 				Payment: payment,
 			})
 		}
+
+		if dispute != nil {
+			responses = append(responses, models.WebhookResponse{
+				Dispute: dispute,
+			})
+		}
 	}
 
 	return models.TranslateWebhookResponse{
@@ -464,6 +528,186 @@ func (p *Plugin) handlePayoutExpire(
 	return &payment, nil
 }
 
+// translateTransferWebhook turns balance platform transfer notifications
+// into payment updates for the transfers and payouts created by the
+// connector. Only the outgoing leg is kept: an internal transfer notifies
+// both balance accounts under the same transfer ID.
+func (p *Plugin) handleDispute(
+	item webhook.NotificationRequestItem,
+) (*models.PSPDispute, error) {
+	raw, err := json.Marshal(item)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal item: %w", err)
+	}
+
+	// dispute notifications carry the disputed payment's pspReference, the
+	// dispute itself is identified by disputePspReference when present
+	reference := item.PspReference
+	if disputeReference := additionalDataString(item, disputePspReferenceKey); disputeReference != "" {
+		reference = disputeReference
+	}
+
+	paymentReference := item.PspReference
+	if item.OriginalReference != "" {
+		paymentReference = item.OriginalReference
+	}
+
+	dispute := models.PSPDispute{
+		Reference:        reference,
+		CreatedAt:        *item.EventDate,
+		PaymentReference: &paymentReference,
+		Amount:           big.NewInt(item.Amount.Value),
+		Asset:            currency.FormatAsset(supportedCurrenciesWithDecimal, item.Amount.Currency),
+		Status:           disputeStatusFromEventCode(item.EventCode),
+		Reason:           item.Reason,
+		Raw:              raw,
+	}
+
+	if defensePeriodEndsAt := additionalDataString(item, defensePeriodEndsAtKey); defensePeriodEndsAt != "" {
+		if dueDate, err := time.Parse(time.RFC3339, defensePeriodEndsAt); err == nil {
+			dispute.EvidenceDueDate = &dueDate
+		}
+	}
+
+	return &dispute, nil
+}
+
+func additionalDataString(item webhook.NotificationRequestItem, key string) string {
+	if item.AdditionalData == nil {
+		return ""
+	}
+	v, ok := (*item.AdditionalData)[key].(string)
+	if !ok {
+		return ""
+	}
+	return v
+}
+
+func disputeStatusFromEventCode(eventCode string) models.DisputeStatus {
+	switch eventCode {
+	case webhook.EventCodeRequestForInformation:
+		return models.DISPUTE_STATUS_INQUIRY
+	case webhook.EventCodeNotificationOfChargeback,
+		webhook.EventCodeChargeback:
+		return models.DISPUTE_STATUS_NEEDS_RESPONSE
+	case webhook.EventCodeChargebackReversed,
+		webhook.EventCodePrearbitrationWon:
+		return models.DISPUTE_STATUS_WON
+	case webhook.EventCodeSecondChargeback,
+		webhook.EventCodePrearbitrationLost:
+		return models.DISPUTE_STATUS_LOST
+	default:
+		return models.DISPUTE_STATUS_UNKNOWN
+	}
+}
+
+func (p *Plugin) translateTransferWebhook(ctx context.Context, req models.TranslateWebhookRequest) (models.TranslateWebhookResponse, error) {
+	notification, err := p.client.TranslateTransferWebhook(string(req.Webhook.Body))
+	if err != nil {
+		return models.TranslateWebhookResponse{}, err
+	}
+
+	data := notification.Data
+	if data.Id == nil {
+		return models.TranslateWebhookResponse{}, nil
+	}
+
+	if data.Direction != nil && *data.Direction != "outgoing" {
+		return models.TranslateWebhookResponse{}, nil
+	}
+
+	var paymentType models.PaymentType
+	var destination *string
+	switch data.Category {
+	case transferCategoryInternal:
+		paymentType = models.PAYMENT_TYPE_TRANSFER
+		if data.Counterparty != nil {
+			destination = data.Counterparty.BalanceAccountId
+		}
+	case transferCategoryBank:
+		paymentType = models.PAYMENT_TYPE_PAYOUT
+		if data.Counterparty != nil {
+			destination = data.Counterparty.TransferInstrumentId
+		}
+	default:
+		return models.TranslateWebhookResponse{}, nil
+	}
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return models.TranslateWebhookResponse{}, fmt.Errorf("failed to marshal item: %w", err)
+	}
+
+	createdAt := time.Now().UTC()
+	if data.CreationDate != nil {
+		createdAt = data.CreationDate.UTC()
+	}
+
+	var source *string
+	if data.BalanceAccount != nil {
+		source = data.BalanceAccount.Id
+	}
+
+	payment := models.PSPPayment{
+		Reference:                   *data.Id,
+		CreatedAt:                   createdAt,
+		Type:                        paymentType,
+		Amount:                      new(big.Int).Abs(big.NewInt(data.Amount.Value)),
+		Asset:                       currency.FormatAsset(supportedCurrenciesWithDecimal, data.Amount.Currency),
+		Scheme:                      models.PAYMENT_SCHEME_OTHER,
+		Status:                      transferStatusToPaymentStatus(data.Category, data.Status),
+		SourceAccountReference:      source,
+		DestinationAccountReference: destination,
+		Fees:                        transferEventsToPaymentFees(data.Events),
+		Raw:                         raw,
+	}
+
+	return models.TranslateWebhookResponse{
+		Responses: []models.WebhookResponse{
+			{Payment: &payment},
+		},
+	}, nil
+}
+
+// transferEventsToPaymentFees maps the markups applied on the events of a
+// transfer to payment fees. The exchange and authHoldReserve adjustments are
+// not fees and are ignored.
+func transferEventsToPaymentFees(events []transferwebhook.TransferEvent) []models.PaymentFee {
+	var fees []models.PaymentFee
+	for _, event := range events {
+		for _, adjustment := range event.AmountAdjustments {
+			if adjustment.Amount == nil || adjustment.AmountAdjustmentType == nil || adjustment.Amount.Value == 0 {
+				continue
+			}
+
+			var feeType models.PaymentFeeType
+			switch *adjustment.AmountAdjustmentType {
+			case "forexMarkup":
+				feeType = models.PAYMENT_FEE_TYPE_EXCHANGE
+			case "atmMarkup":
+				feeType = models.PAYMENT_FEE_TYPE_NETWORK
+			default:
+				continue
+			}
+
+			if _, ok := supportedCurrenciesWithDecimal[adjustment.Amount.Currency]; !ok {
+				continue
+			}
+
+			fee := models.PaymentFee{
+				Type:   feeType,
+				Amount: new(big.Int).Abs(big.NewInt(adjustment.Amount.Value)),
+				Asset:  currency.FormatAsset(supportedCurrenciesWithDecimal, adjustment.Amount.Currency),
+			}
+			if event.Id != nil {
+				fee.Reference = *event.Id
+			}
+			fees = append(fees, fee)
+		}
+	}
+	return fees
+}
+
 func parseScheme(scheme string) models.PaymentScheme {
 	switch {
 	case strings.HasPrefix(scheme, "visa"):
@@ -8,6 +8,7 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/adyen/adyen-go-api-library/v7/src/transferwebhook"
 	"github.com/adyen/adyen-go-api-library/v7/src/webhook"
 	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
 	"github.com/formancehq/payments/ce/plugins/adyen/client"
@@ -475,6 +476,104 @@ var _ = Describe("Adyen Plugin Accounts", func() {
 	})
 })
 
+var _ = Describe("Adyen Plugin Dispute Webhooks", func() {
+	var (
+		ctrl *gomock.Controller
+		plg  models.Plugin
+		m    *client.MockClient
+		now  time.Time
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		m = client.NewMockClient(ctrl)
+		p := &Plugin{client: m}
+		p.initWebhookConfig()
+		plg = p
+		now = time.Now().UTC().Truncate(time.Second)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	translateDispute := func(ctx SpecContext, eventCode string, additionalData *map[string]interface{}) models.PSPDispute {
+		w := webhook.Webhook{
+			Live: "false",
+			NotificationItems: &[]webhook.NotificationItem{
+				{
+					NotificationRequestItem: webhook.NotificationRequestItem{
+						AdditionalData: additionalData,
+						PspReference:   "payment-psp-ref",
+						Amount: webhook.Amount{
+							Currency: "EUR",
+							Value:    100,
+						},
+						EventCode:           eventCode,
+						EventDate:           &now,
+						MerchantAccountCode: "test",
+						Reason:              "Fraudulent Processing of Transactions",
+						Success:             "true",
+					},
+				},
+			},
+		}
+
+		b, _ := json.Marshal(&w)
+		req := models.TranslateWebhookRequest{
+			Name: "standard",
+			Webhook: models.PSPWebhook{
+				Body: b,
+			},
+		}
+		m.EXPECT().TranslateWebhook(string(req.Webhook.Body)).Return(&w, nil)
+
+		resp, err := plg.TranslateWebhook(ctx, req)
+		Expect(err).To(BeNil())
+		Expect(resp.Responses).To(HaveLen(1))
+		Expect(resp.Responses[0].Payment).To(BeNil())
+		Expect(resp.Responses[0].Dispute).NotTo(BeNil())
+		return *resp.Responses[0].Dispute
+	}
+
+	It("should handle a chargeback", func(ctx SpecContext) {
+		dispute := translateDispute(ctx, webhook.EventCodeChargeback, &map[string]interface{}{
+			"disputePspReference": "dispute-psp-ref",
+			"defensePeriodEndsAt": "2026-01-31T00:00:00+01:00",
+		})
+
+		Expect(dispute.Reference).To(Equal("dispute-psp-ref"))
+		Expect(dispute.PaymentReference).To(Equal(pointer.For("payment-psp-ref")))
+		Expect(dispute.CreatedAt).To(Equal(now))
+		Expect(dispute.Amount).To(Equal(big.NewInt(100)))
+		Expect(dispute.Asset).To(Equal("EUR/2"))
+		Expect(dispute.Status).To(Equal(models.DISPUTE_STATUS_NEEDS_RESPONSE))
+		Expect(dispute.Reason).To(Equal("Fraudulent Processing of Transactions"))
+		Expect(dispute.EvidenceDueDate).NotTo(BeNil())
+		Expect(dispute.EvidenceDueDate.UTC()).To(Equal(time.Date(2026, 1, 30, 23, 0, 0, 0, time.UTC)))
+	})
+
+	It("should fall back to the payment reference without dispute data", func(ctx SpecContext) {
+		dispute := translateDispute(ctx, webhook.EventCodeRequestForInformation, nil)
+
+		Expect(dispute.Reference).To(Equal("payment-psp-ref"))
+		Expect(dispute.Status).To(Equal(models.DISPUTE_STATUS_INQUIRY))
+		Expect(dispute.EvidenceDueDate).To(BeNil())
+	})
+
+	DescribeTable("should map event codes to dispute statuses",
+		func(ctx SpecContext, eventCode string, expected models.DisputeStatus) {
+			dispute := translateDispute(ctx, eventCode, nil)
+			Expect(dispute.Status).To(Equal(expected))
+		},
+		Entry("notification of chargeback", webhook.EventCodeNotificationOfChargeback, models.DISPUTE_STATUS_NEEDS_RESPONSE),
+		Entry("chargeback reversed", webhook.EventCodeChargebackReversed, models.DISPUTE_STATUS_WON),
+		Entry("prearbitration won", webhook.EventCodePrearbitrationWon, models.DISPUTE_STATUS_WON),
+		Entry("second chargeback", webhook.EventCodeSecondChargeback, models.DISPUTE_STATUS_LOST),
+		Entry("prearbitration lost", webhook.EventCodePrearbitrationLost, models.DISPUTE_STATUS_LOST),
+	)
+})
+
 func doTranslateCall(
 	ctx context.Context,
 	plg models.Plugin,
@@ -556,3 +655,185 @@ func comparePayments(a, b models.PSPPayment) {
 		Expect(b.Metadata[k]).To(Equal(v))
 	}
 }
+
+var _ = Describe("Adyen Plugin Transfer Webhooks", func() {
+	var (
+		plg  models.Plugin
+		ctrl *gomock.Controller
+		m    *client.MockClient
+		now  time.Time
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		m = client.NewMockClient(ctrl)
+		p := &Plugin{client: m, config: Config{TransferWebhookHMACKey: "abcd"}}
+		p.initWebhookConfig()
+		plg = p
+		now = time.Now().UTC()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should return the transfer webhook config when a key is configured", func(ctx SpecContext) {
+		req := models.CreateWebhooksRequest{
+			ConnectorID:    "test",
+			WebhookBaseUrl: "http://localhost:8080/test",
+		}
+
+		m.EXPECT().CreateWebhook(gomock.Any(), "http://localhost:8080/test/standard", req.ConnectorID).Return(client.CreateWebhookResponse{HMACKey: "test"}, nil)
+
+		resp, err := plg.CreateWebhooks(ctx, req)
+		Expect(err).To(BeNil())
+		Expect(resp.Configs).To(HaveLen(2))
+		Expect(resp.Configs[1].Name).To(Equal(transferWebhookName))
+		Expect(resp.Configs[1].URLPath).To(Equal("/transfers"))
+	})
+
+	It("should verify the transfer webhook signature header", func(ctx SpecContext) {
+		body := []byte(`{"data":{"id":"tr_1"}}`)
+		req := models.VerifyWebhookRequest{
+			Config: &models.WebhookConfig{Name: transferWebhookName},
+			Webhook: models.PSPWebhook{
+				Headers: map[string][]string{"Hmacsignature": {"signature"}},
+				Body:    body,
+			},
+		}
+
+		m.EXPECT().VerifyWebhookBasicAuth(req.Webhook.BasicAuth).Return(true)
+		m.EXPECT().VerifyTransferWebhookHMAC(body, "signature", "abcd").Return(false)
+
+		_, err := plg.VerifyWebhook(ctx, req)
+		Expect(err).To(MatchError("invalid HMAC: webhook verification error"))
+
+		m.EXPECT().VerifyWebhookBasicAuth(req.Webhook.BasicAuth).Return(true)
+		m.EXPECT().VerifyTransferWebhookHMAC(body, "signature", "abcd").Return(true)
+
+		resp, err := plg.VerifyWebhook(ctx, req)
+		Expect(err).To(BeNil())
+		Expect(resp.WebhookIdempotencyKey).ToNot(BeNil())
+	})
+
+	It("should translate a booked internal transfer", func(ctx SpecContext) {
+		notification := transferwebhook.TransferNotificationRequest{
+			Data: transferwebhook.TransferData{
+				Id:             pointer.For("tr_1"),
+				Amount:         transferwebhook.Amount{Currency: "EUR", Value: 1500},
+				BalanceAccount: &transferwebhook.ResourceReference{Id: pointer.For("BA_1")},
+				Category:       "internal",
+				Counterparty:   &transferwebhook.CounterpartyV3{BalanceAccountId: pointer.For("BA_2")},
+				CreationDate:   &now,
+				Direction:      pointer.For("outgoing"),
+				Status:         "booked",
+			},
+		}
+		req := models.TranslateWebhookRequest{
+			Name:    transferWebhookName,
+			Webhook: models.PSPWebhook{Body: []byte(`{}`)},
+		}
+
+		m.EXPECT().TranslateTransferWebhook(string(req.Webhook.Body)).Return(&notification, nil)
+
+		resp, err := plg.TranslateWebhook(ctx, req)
+		Expect(err).To(BeNil())
+		Expect(resp.Responses).To(HaveLen(1))
+		payment := resp.Responses[0].Payment
+		Expect(payment.Reference).To(Equal("tr_1"))
+		Expect(payment.Type).To(Equal(models.PAYMENT_TYPE_TRANSFER))
+		Expect(payment.Status).To(Equal(models.PAYMENT_STATUS_SUCCEEDED))
+		Expect(payment.Amount).To(Equal(big.NewInt(1500)))
+		Expect(payment.Asset).To(Equal("EUR/2"))
+		Expect(payment.SourceAccountReference).To(Equal(pointer.For("BA_1")))
+		Expect(payment.DestinationAccountReference).To(Equal(pointer.For("BA_2")))
+	})
+
+	It("should keep a booked bank payout pending until credited", func(ctx SpecContext) {
+		notification := transferwebhook.TransferNotificationRequest{
+			Data: transferwebhook.TransferData{
+				Id:           pointer.For("tr_2"),
+				Amount:       transferwebhook.Amount{Currency: "EUR", Value: 1500},
+				Category:     "bank",
+				Counterparty: &transferwebhook.CounterpartyV3{TransferInstrumentId: pointer.For("TI_1")},
+				Status:       "booked",
+			},
+		}
+		req := models.TranslateWebhookRequest{
+			Name:    transferWebhookName,
+			Webhook: models.PSPWebhook{Body: []byte(`{}`)},
+		}
+
+		m.EXPECT().TranslateTransferWebhook(string(req.Webhook.Body)).Return(&notification, nil)
+
+		resp, err := plg.TranslateWebhook(ctx, req)
+		Expect(err).To(BeNil())
+		Expect(resp.Responses).To(HaveLen(1))
+		Expect(resp.Responses[0].Payment.Type).To(Equal(models.PAYMENT_TYPE_PAYOUT))
+		Expect(resp.Responses[0].Payment.Status).To(Equal(models.PAYMENT_STATUS_PENDING))
+		Expect(resp.Responses[0].Payment.DestinationAccountReference).To(Equal(pointer.For("TI_1")))
+	})
+
+	It("should report the markups of a transfer as fees", func(ctx SpecContext) {
+		notification := transferwebhook.TransferNotificationRequest{
+			Data: transferwebhook.TransferData{
+				Id:           pointer.For("tr_3"),
+				Amount:       transferwebhook.Amount{Currency: "EUR", Value: 1500},
+				Category:     "bank",
+				Counterparty: &transferwebhook.CounterpartyV3{TransferInstrumentId: pointer.For("TI_1")},
+				Status:       "booked",
+				Events: []transferwebhook.TransferEvent{
+					{
+						Id: pointer.For("ev_1"),
+						AmountAdjustments: []transferwebhook.AmountAdjustment{
+							{AmountAdjustmentType: pointer.For("exchange"), Amount: &transferwebhook.Amount{Currency: "USD", Value: 1620}},
+							{AmountAdjustmentType: pointer.For("forexMarkup"), Amount: &transferwebhook.Amount{Currency: "EUR", Value: -12}},
+						},
+					},
+					{
+						Id: pointer.For("ev_2"),
+						AmountAdjustments: []transferwebhook.AmountAdjustment{
+							{AmountAdjustmentType: pointer.For("atmMarkup"), Amount: &transferwebhook.Amount{Currency: "EUR", Value: 3}},
+						},
+					},
+				},
+			},
+		}
+		req := models.TranslateWebhookRequest{
+			Name:    transferWebhookName,
+			Webhook: models.PSPWebhook{Body: []byte(`{}`)},
+		}
+
+		m.EXPECT().TranslateTransferWebhook(string(req.Webhook.Body)).Return(&notification, nil)
+
+		resp, err := plg.TranslateWebhook(ctx, req)
+		Expect(err).To(BeNil())
+		Expect(resp.Responses).To(HaveLen(1))
+		Expect(resp.Responses[0].Payment.Amount).To(Equal(big.NewInt(1500)))
+		Expect(resp.Responses[0].Payment.Fees).To(Equal([]models.PaymentFee{
+			{Reference: "ev_1", Type: models.PAYMENT_FEE_TYPE_EXCHANGE, Amount: big.NewInt(12), Asset: "EUR/2"},
+			{Reference: "ev_2", Type: models.PAYMENT_FEE_TYPE_NETWORK, Amount: big.NewInt(3), Asset: "EUR/2"},
+		}))
+	})
+
+	It("should ignore the incoming leg of a transfer", func(ctx SpecContext) {
+		notification := transferwebhook.TransferNotificationRequest{
+			Data: transferwebhook.TransferData{
+				Id:        pointer.For("tr_1"),
+				Category:  "internal",
+				Direction: pointer.For("incoming"),
+				Status:    "booked",
+			},
+		}
+		req := models.TranslateWebhookRequest{
+			Name:    transferWebhookName,
+			Webhook: models.PSPWebhook{Body: []byte(`{}`)},
+		}
+
+		m.EXPECT().TranslateTransferWebhook(string(req.Webhook.Body)).Return(&notification, nil)
+
+		resp, err := plg.TranslateWebhook(ctx, req)
+		Expect(err).To(BeNil())
+		Expect(resp.Responses).To(BeEmpty())
+	})
+})
@@ -10,8 +10,8 @@ import (
 
 type Config struct {
 	BaseURL   string `json:"baseUrl" validate:"required"`
-	AccessKey string `json:"accessKey" validate:"required"`
-	Secret    string `json:"secret" validate:"required"`
+	AccessKey string `json:"accessKey" validate:"required" sensitive:"true"`
+	Secret    string `json:"secret" validate:"required" sensitive:"true"`
 }
 
 const PAGE_SIZE = 100 // max size is 500 according to docs
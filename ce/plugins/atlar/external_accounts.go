@@ -103,13 +103,48 @@ func externalAccountFromAtlarData(
 		return models.PSPAccount{}, fmt.Errorf("failed to parse opening date: %w", err)
 	}
 
-	return models.PSPAccount{
+	account := models.PSPAccount{
 		Reference: externalAccount.ID,
 		CreatedAt: createdAt,
 		Name:      &counterparty.Name,
 		Metadata:  extractExternalAccountAndCounterpartyMetadata(externalAccount, counterparty),
 		Raw:       raw,
-	}, nil
+	}
+	models.FillBankAccountDetailsToPSPAccountMetadata(&account, externalAccountBankDetails(externalAccount, counterparty))
+
+	return account, nil
+}
+
+// externalAccountBankDetails returns the bank details of an Atlar external
+// account, taken from its identifiers.
+func externalAccountBankDetails(externalAccount *atlar_models.ExternalAccount, counterparty *atlar_models.Counterparty) models.BankAccountDetails {
+	details := models.BankAccountDetails{
+		Name: counterparty.Name,
+	}
+	if externalAccount.Bank != nil {
+		details.SwiftBicCode = externalAccount.Bank.Bic
+	}
+
+	for _, identifier := range externalAccount.Identifiers {
+		if identifier == nil || identifier.Type == nil || identifier.Number == nil {
+			continue
+		}
+
+		switch *identifier.Type {
+		case atlar_models.AccountIdentifierTypeIBAN:
+			details.IBAN = *identifier.Number
+		case atlar_models.AccountIdentifierTypeACCOUNTNUMBER:
+			details.AccountNumber = *identifier.Number
+		default:
+			continue
+		}
+
+		if identifier.Market != nil {
+			details.Country = *identifier.Market
+		}
+	}
+
+	return details
 }
 
 func extractExternalAccountAndCounterpartyMetadata(externalAccount *atlar_models.ExternalAccount, counterparty *atlar_models.Counterparty) metadata.Metadata {
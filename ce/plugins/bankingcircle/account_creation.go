@@ -0,0 +1,57 @@
+package bankingcircle
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/formancehq/go-libs/v5/pkg/types/currency"
+	"github.com/formancehq/payments/ce/plugins/bankingcircle/client"
+	"github.com/formancehq/payments/pkg/domain/models"
+	errorsutils "github.com/formancehq/payments/pkg/domain/errors"
+)
+
+const (
+	// Banking Circle company owning the new account, defaults to the company
+	// of the API client.
+	accountCompanyIDMetadataKey = "com.bankingcircle.spec/companyId"
+)
+
+func (p *Plugin) createAccount(ctx context.Context, req models.CreateAccountRequest) (models.CreateAccountResponse, error) {
+	if req.DefaultAsset == nil {
+		return models.CreateAccountResponse{}, errorsutils.NewWrappedError(
+			fmt.Errorf("default asset is required to create an account"),
+			models.ErrInvalidRequest,
+		)
+	}
+
+	curr, _, err := currency.GetCurrencyAndPrecisionFromAsset(supportedCurrenciesWithDecimal, *req.DefaultAsset)
+	if err != nil {
+		return models.CreateAccountResponse{}, errorsutils.NewWrappedError(
+			fmt.Errorf("failed to get currency and precision from asset: %w", err),
+			models.ErrInvalidRequest,
+		)
+	}
+
+	account, err := p.client.CreateAccount(ctx, &client.CreateAccountRequest{
+		IdempotencyKey:     req.Reference,
+		AccountDescription: req.Name,
+		Currency:           curr,
+		OwnedByCompanyID:   req.Metadata[accountCompanyIDMetadataKey],
+	})
+	if err != nil {
+		return models.CreateAccountResponse{}, err
+	}
+
+	pspAccount, err := toPSPAccount(*account)
+	if err != nil {
+		return models.CreateAccountResponse{}, err
+	}
+
+	if req.PaymentServiceUser != nil {
+		pspAccount.PsuID = &req.PaymentServiceUser.ID
+	}
+
+	return models.CreateAccountResponse{
+		Account: pspAccount,
+	}, nil
+}
@@ -0,0 +1,115 @@
+package bankingcircle
+
+import (
+	"errors"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/payments/ce/plugins/bankingcircle/client"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("BankingCircle Plugin Account Creation", func() {
+	var (
+		ctrl *gomock.Controller
+		m    *client.MockClient
+		plg  *Plugin
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		m = client.NewMockClient(ctrl)
+		plg = &Plugin{client: m}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("create account", func() {
+		var (
+			sampleRequest models.CreateAccountRequest
+			sampleAccount client.Account
+			now           time.Time
+		)
+
+		BeforeEach(func() {
+			now = time.Now().UTC()
+			sampleRequest = models.CreateAccountRequest{
+				Reference:    "virtual-1",
+				Name:         "John Doe",
+				DefaultAsset: pointer.For("EUR/2"),
+			}
+			sampleAccount = client.Account{
+				AccountID:          "A123",
+				AccountDescription: "John Doe",
+				Status:             "Active",
+				Currency:           "EUR",
+				OpeningDate:        now.Format("2006-01-02T15:04:05.999999999+00:00"),
+			}
+		})
+
+		It("should return an error - missing default asset", func(ctx SpecContext) {
+			req := sampleRequest
+			req.DefaultAsset = nil
+
+			resp, err := plg.CreateAccount(ctx, req)
+			Expect(err).ToNot(BeNil())
+			Expect(err).To(MatchError(models.ErrInvalidRequest))
+			Expect(resp).To(Equal(models.CreateAccountResponse{}))
+		})
+
+		It("should return an error - unsupported asset", func(ctx SpecContext) {
+			req := sampleRequest
+			req.DefaultAsset = pointer.For("HUF/2")
+
+			resp, err := plg.CreateAccount(ctx, req)
+			Expect(err).ToNot(BeNil())
+			Expect(err).To(MatchError(models.ErrInvalidRequest))
+			Expect(resp).To(Equal(models.CreateAccountResponse{}))
+		})
+
+		It("should return an error - create account error", func(ctx SpecContext) {
+			m.EXPECT().CreateAccount(gomock.Any(), gomock.Any()).Return(
+				nil,
+				errors.New("test error"),
+			)
+
+			resp, err := plg.CreateAccount(ctx, sampleRequest)
+			Expect(err).ToNot(BeNil())
+			Expect(err).To(MatchError("test error"))
+			Expect(resp).To(Equal(models.CreateAccountResponse{}))
+		})
+
+		It("should create the account", func(ctx SpecContext) {
+			req := sampleRequest
+			req.Metadata = map[string]string{
+				accountCompanyIDMetadataKey: "C456",
+			}
+			req.PaymentServiceUser = &models.PSPPaymentServiceUser{
+				ID:   uuid.New(),
+				Name: "John Doe",
+			}
+
+			m.EXPECT().CreateAccount(gomock.Any(), &client.CreateAccountRequest{
+				IdempotencyKey:     "virtual-1",
+				AccountDescription: "John Doe",
+				Currency:           "EUR",
+				OwnedByCompanyID:   "C456",
+			}).Return(&sampleAccount, nil)
+
+			resp, err := plg.CreateAccount(ctx, req)
+			Expect(err).To(BeNil())
+			Expect(resp.Account.Reference).To(Equal("A123"))
+			Expect(resp.Account.Name).To(Equal(pointer.For("John Doe")))
+			Expect(resp.Account.DefaultAsset).To(Equal(pointer.For("EUR/2")))
+			Expect(resp.Account.CreatedAt).To(BeTemporally("==", now))
+			Expect(resp.Account.PsuID).To(Equal(&req.PaymentServiceUser.ID))
+			Expect(resp.Account.Raw).ToNot(BeNil())
+		})
+	})
+})
@@ -78,28 +78,37 @@ func fillAccounts(
 	accounts []models.PSPAccount,
 ) ([]models.PSPAccount, error) {
 	for _, account := range pagedAccounts {
-		openingDate, err := time.Parse("2006-01-02T15:04:05.999999999+00:00", account.OpeningDate)
+		pspAccount, err := toPSPAccount(account)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse opening date: %w", err)
+			return nil, err
 		}
 
-		raw, err := json.Marshal(account)
-		if err != nil {
-			return nil, fmt.Errorf("failed to marshal account: %w", err)
-		}
-
-		accounts = append(accounts, models.PSPAccount{
-			Reference:    account.AccountID,
-			CreatedAt:    openingDate,
-			Name:         &account.AccountDescription,
-			DefaultAsset: pointer.For(currency.FormatAsset(supportedCurrenciesWithDecimal, account.Currency)),
-			Raw:          raw,
-		})
+		accounts = append(accounts, pspAccount)
 	}
 
 	return accounts, nil
 }
 
+func toPSPAccount(account client.Account) (models.PSPAccount, error) {
+	openingDate, err := time.Parse("2006-01-02T15:04:05.999999999+00:00", account.OpeningDate)
+	if err != nil {
+		return models.PSPAccount{}, fmt.Errorf("failed to parse opening date: %w", err)
+	}
+
+	raw, err := json.Marshal(account)
+	if err != nil {
+		return models.PSPAccount{}, fmt.Errorf("failed to marshal account: %w", err)
+	}
+
+	return models.PSPAccount{
+		Reference:    account.AccountID,
+		CreatedAt:    openingDate,
+		Name:         &account.AccountDescription,
+		DefaultAsset: pointer.For(currency.FormatAsset(supportedCurrenciesWithDecimal, account.Currency)),
+		Raw:          raw,
+	}, nil
+}
+
 func filterAccounts(pagedAccounts []client.Account, lastAccountID string) []client.Account {
 	if lastAccountID == "" {
 		return pagedAccounts
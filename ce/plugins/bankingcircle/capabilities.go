@@ -8,6 +8,7 @@ var capabilities = []models.Capability{
 	models.CAPABILITY_FETCH_BALANCES,
 
 	models.CAPABILITY_CREATE_BANK_ACCOUNT,
+	models.CAPABILITY_CREATE_ACCOUNT,
 	models.CAPABILITY_CREATE_TRANSFER,
 	models.CAPABILITY_CREATE_PAYOUT,
 }
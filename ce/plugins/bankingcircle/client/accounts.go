@@ -1,6 +1,7 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -39,6 +40,13 @@ type Account struct {
 	Balances           []Balance           `json:"balances"`
 }
 
+type CreateAccountRequest struct {
+	IdempotencyKey     string `json:"idempotencyKey"`
+	AccountDescription string `json:"accountDescription"`
+	Currency           string `json:"currency"`
+	OwnedByCompanyID   string `json:"ownedByCompanyId,omitempty"`
+}
+
 func (c *client) GetAccounts(ctx context.Context, page int, pageSize int, fromOpeningDate time.Time) ([]Account, error) {
 	if err := c.ensureAccessTokenIsValid(ctx); err != nil {
 		return nil, err
@@ -102,3 +110,33 @@ func (c *client) GetAccount(ctx context.Context, accountID string) (*Account, er
 	}
 	return &account, nil
 }
+
+func (c *client) CreateAccount(ctx context.Context, createRequest *CreateAccountRequest) (*Account, error) {
+	if err := c.ensureAccessTokenIsValid(ctx); err != nil {
+		return nil, err
+	}
+
+	ctx = context.WithValue(ctx, metrics.MetricOperationContextKey, "create_account")
+
+	body, err := json.Marshal(createRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal account request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint+"/api/v1/accounts", bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create account request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.accessToken)
+
+	var res Account
+	statusCode, err := c.httpClient.Do(ctx, req, &res, nil)
+	if err != nil {
+		return nil, errorsutils.NewWrappedError(
+			fmt.Errorf("failed to create account: status code %d", statusCode),
+			err,
+		)
+	}
+	return &res, nil
+}
@@ -17,6 +17,7 @@ import (
 type Client interface {
 	GetAccounts(ctx context.Context, page int, pageSize int, fromOpeningDate time.Time) ([]Account, error)
 	GetAccount(ctx context.Context, accountID string) (*Account, error)
+	CreateAccount(ctx context.Context, createRequest *CreateAccountRequest) (*Account, error)
 	GetPayments(ctx context.Context, page int, pageSize int) ([]Payment, error)
 	GetPayment(ctx context.Context, paymentID string) (*Payment, error)
 	GetPaymentStatus(ctx context.Context, paymentID string) (*StatusResponse, error)
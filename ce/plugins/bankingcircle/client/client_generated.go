@@ -41,6 +41,21 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// CreateAccount mocks base method.
+func (m *MockClient) CreateAccount(ctx context.Context, createRequest *CreateAccountRequest) (*Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccount", ctx, createRequest)
+	ret0, _ := ret[0].(*Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccount indicates an expected call of CreateAccount.
+func (mr *MockClientMockRecorder) CreateAccount(ctx, createRequest any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockClient)(nil).CreateAccount), ctx, createRequest)
+}
+
 // GetAccount mocks base method.
 func (m *MockClient) GetAccount(ctx context.Context, accountID string) (*Account, error) {
 	m.ctrl.T.Helper()
@@ -10,11 +10,11 @@ import (
 
 type Config struct {
 	Username              string `json:"username" yaml:"username" validate:"required"`
-	Password              string `json:"password" yaml:"password" validate:"required"`
+	Password              string `json:"password" yaml:"password" validate:"required" sensitive:"true"`
 	Endpoint              string `json:"endpoint" yaml:"endpoint" validate:"required"`
 	AuthorizationEndpoint string `json:"authorizationEndpoint" yaml:"authorizationEndpoint" validate:"required"`
-	UserCertificate       string `json:"userCertificate" yaml:"userCertificate" validate:"required"`
-	UserCertificateKey    string `json:"userCertificateKey" yaml:"userCertificateKey" validate:"required"`
+	UserCertificate       string `json:"userCertificate" yaml:"userCertificate" validate:"required" sensitive:"true"`
+	UserCertificateKey    string `json:"userCertificateKey" yaml:"userCertificateKey" validate:"required" sensitive:"true"`
 }
 
 const PAGE_SIZE = 100 // max page size is 5000 according to docs (!)
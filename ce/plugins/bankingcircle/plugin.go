@@ -100,6 +100,13 @@ func (p *Plugin) CreateBankAccount(ctx context.Context, req models.CreateBankAcc
 	return p.createBankAccount(req)
 }
 
+func (p *Plugin) CreateAccount(ctx context.Context, req models.CreateAccountRequest) (models.CreateAccountResponse, error) {
+	if p.client == nil {
+		return models.CreateAccountResponse{}, pkgplugins.ErrNotYetInstalled
+	}
+	return p.createAccount(ctx, req)
+}
+
 func (p *Plugin) CreateTransfer(ctx context.Context, req models.CreateTransferRequest) (models.CreateTransferResponse, error) {
 	if p.client == nil {
 		return models.CreateTransferResponse{}, pkgplugins.ErrNotYetInstalled
@@ -127,3 +134,4 @@ func (p *Plugin) CreatePayout(ctx context.Context, req models.CreatePayoutReques
 }
 
 var _ models.Plugin = &Plugin{}
+var _ models.PluginWithAccountCreation = &Plugin{}
@@ -137,6 +137,16 @@ var _ = Describe("BankingCircle Plugin", func() {
 		// Other tests will be in bank_account_creation_test.go
 	})
 
+	Context("create account", func() {
+		It("should fail when called before install", func(ctx SpecContext) {
+			req := models.CreateAccountRequest{}
+			_, err := plg.CreateAccount(ctx, req)
+			Expect(err).To(MatchError(plugins.ErrNotYetInstalled))
+		})
+
+		// Other tests will be in account_creation_test.go
+	})
+
 	Context("create transfer", func() {
 		It("should fail when called before install", func(ctx SpecContext) {
 			req := models.CreateTransferRequest{}
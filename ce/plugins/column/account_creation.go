@@ -0,0 +1,52 @@
+package column
+
+import (
+	"context"
+
+	"github.com/formancehq/go-libs/v5/pkg/types/currency"
+	"github.com/formancehq/payments/ce/plugins/column/client"
+	"github.com/formancehq/payments/pkg/domain/models"
+)
+
+func (p *Plugin) createAccount(ctx context.Context, req models.CreateAccountRequest) (models.CreateAccountResponse, error) {
+	// The reference is sent as Column's Idempotency-Key header so that a
+	// retried creation returns the same bank account.
+	if err := validateReference(req.Reference); err != nil {
+		return models.CreateAccountResponse{}, err
+	}
+
+	entityID := models.ExtractNamespacedMetadata(req.Metadata, client.ColumnEntityIDMetadataKey)
+	if entityID == "" {
+		return models.CreateAccountResponse{}, models.NewConnectorValidationError(client.ColumnEntityIDMetadataKey, ErrMissingEntityID)
+	}
+
+	if req.DefaultAsset != nil && *req.DefaultAsset != currency.FormatAsset(supportedCurrenciesWithDecimal, "USD") {
+		return models.CreateAccountResponse{}, models.NewConnectorValidationError("defaultAsset", ErrUnsupportedCurrency)
+	}
+
+	account, err := p.client.CreateAccount(
+		ctx,
+		&client.CreateAccountRequest{
+			EntityID:        entityID,
+			Description:     req.Name,
+			IsOverdraftable: models.ExtractNamespacedMetadata(req.Metadata, client.ColumnIsOverdraftableMetadataKey) == "true",
+		},
+		req.Reference,
+	)
+	if err != nil {
+		return models.CreateAccountResponse{}, err
+	}
+
+	pspAccount, err := toPSPAccount(account)
+	if err != nil {
+		return models.CreateAccountResponse{}, err
+	}
+
+	if req.PaymentServiceUser != nil {
+		pspAccount.PsuID = &req.PaymentServiceUser.ID
+	}
+
+	return models.CreateAccountResponse{
+		Account: pspAccount,
+	}, nil
+}
@@ -0,0 +1,141 @@
+package column
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/payments/ce/plugins/column/client"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Column Plugin Account Creation", func() {
+	var (
+		ctrl           *gomock.Controller
+		mockHTTPClient *client.MockHTTPClient
+		plg            *Plugin
+		sampleRequest  models.CreateAccountRequest
+		now            time.Time
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockHTTPClient = client.NewMockHTTPClient(ctrl)
+		c := client.New("test", "aseplye", "https://test.com")
+		c.SetHttpClient(mockHTTPClient)
+		plg = &Plugin{client: c}
+		now = time.Now().UTC()
+
+		sampleRequest = models.CreateAccountRequest{
+			Reference: "virtual-1",
+			Name:      "John Doe",
+			Metadata: map[string]string{
+				client.ColumnEntityIDMetadataKey: "enti_123",
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("create account", func() {
+		It("should return an error - missing reference", func(ctx SpecContext) {
+			req := sampleRequest
+			req.Reference = ""
+
+			resp, err := plg.CreateAccount(ctx, req)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring(ErrMissingReference.Error()))
+			Expect(resp).To(Equal(models.CreateAccountResponse{}))
+		})
+
+		It("should return an error - missing entity id", func(ctx SpecContext) {
+			req := sampleRequest
+			req.Metadata = nil
+
+			resp, err := plg.CreateAccount(ctx, req)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring(ErrMissingEntityID.Error()))
+			Expect(resp).To(Equal(models.CreateAccountResponse{}))
+		})
+
+		It("should return an error - unsupported asset", func(ctx SpecContext) {
+			req := sampleRequest
+			req.DefaultAsset = pointer.For("EUR/2")
+
+			resp, err := plg.CreateAccount(ctx, req)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring(ErrUnsupportedCurrency.Error()))
+			Expect(resp).To(Equal(models.CreateAccountResponse{}))
+		})
+
+		It("should return an error - create account error", func(ctx SpecContext) {
+			mockHTTPClient.EXPECT().Do(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(
+				http.StatusInternalServerError,
+				errors.New("test error"),
+			)
+
+			resp, err := plg.CreateAccount(ctx, sampleRequest)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("failed to create account: test error"))
+			Expect(resp).To(Equal(models.CreateAccountResponse{}))
+		})
+
+		It("should create the account", func(ctx SpecContext) {
+			req := sampleRequest
+			req.DefaultAsset = pointer.For("USD/2")
+			req.PaymentServiceUser = &models.PSPPaymentServiceUser{
+				ID:   uuid.New(),
+				Name: "John Doe",
+			}
+
+			expectedAccount := client.Account{
+				ID:                     "bacc_123",
+				Type:                   "CHECKING",
+				CurrencyCode:           "USD",
+				DefaultAccountNumber:   "123456789",
+				DefaultAccountNumberID: "acno_123",
+				Description:            "John Doe",
+				RoutingNumber:          "121145307",
+				Owners:                 []string{"enti_123"},
+				CreatedAt:              now.Format(time.RFC3339),
+			}
+
+			mockHTTPClient.EXPECT().Do(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ any, r *http.Request, _ any, _ any) (int, error) {
+					Expect(r.Method).To(Equal(http.MethodPost))
+					Expect(r.URL.Path).To(Equal("/bank-accounts"))
+					Expect(r.Header.Get("Idempotency-Key")).To(Equal("virtual-1"))
+
+					var body client.CreateAccountRequest
+					Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+					Expect(body).To(Equal(client.CreateAccountRequest{
+						EntityID:    "enti_123",
+						Description: "John Doe",
+					}))
+					return http.StatusOK, nil
+				},
+			).SetArg(2, expectedAccount)
+
+			raw, _ := json.Marshal(&expectedAccount)
+			createdAt, _ := time.Parse(time.RFC3339, expectedAccount.CreatedAt)
+
+			resp, err := plg.CreateAccount(ctx, req)
+			Expect(err).To(BeNil())
+			Expect(resp.Account.Reference).To(Equal("bacc_123"))
+			Expect(resp.Account.CreatedAt).To(Equal(createdAt))
+			Expect(resp.Account.Name).To(Equal(pointer.For("John Doe")))
+			Expect(resp.Account.DefaultAsset).To(Equal(pointer.For("USD/2")))
+			Expect(resp.Account.PsuID).To(Equal(&req.PaymentServiceUser.ID))
+			Expect(resp.Account.Metadata).To(HaveKeyWithValue(client.ColumnDefaultAccountNumberMetadataKey, "123456789"))
+			Expect(resp.Account.Raw).To(Equal(json.RawMessage(raw)))
+		})
+	})
+})
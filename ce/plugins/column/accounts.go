@@ -67,33 +67,42 @@ func (p *Plugin) fillAccounts(
 			break
 		}
 
-		createdTime, err := time.Parse(time.RFC3339, account.CreatedAt)
+		pspAccount, err := toPSPAccount(account)
 		if err != nil {
 			return nil, err
 		}
 
-		raw, err := json.Marshal(account)
-		if err != nil {
-			return nil, err
-		}
-
-		accounts = append(accounts, models.PSPAccount{
-			Reference:    account.ID,
-			CreatedAt:    createdTime,
-			Name:         &account.Description,
-			DefaultAsset: pointer.For(currency.FormatAsset(supportedCurrenciesWithDecimal, account.CurrencyCode)),
-			Raw:          raw,
-			Metadata: map[string]string{
-				client.ColumnTypeMetadataKey:                      account.Type,
-				client.ColumnBicMetadataKey:                       account.Bic,
-				client.ColumnDefaultAccountNumberIDMetadataKey:    account.DefaultAccountNumberID,
-				client.ColumnDefaultAccountNumberMetadataKey:      account.DefaultAccountNumber,
-				client.ColumnIsOverdraftableMetadataKey:           strconv.FormatBool(account.IsOverdraftable),
-				client.ColumnOverdraftReserveAccountIDMetadataKey: account.OverdraftReserveAccountID,
-				client.ColumnRoutingNumberMetadataKey:             account.RoutingNumber,
-				client.ColumnOwnersMetadataKey:                    strings.Join(account.Owners, ","),
-			},
-		})
+		accounts = append(accounts, pspAccount)
 	}
 	return accounts, nil
 }
+
+func toPSPAccount(account *client.Account) (models.PSPAccount, error) {
+	createdTime, err := time.Parse(time.RFC3339, account.CreatedAt)
+	if err != nil {
+		return models.PSPAccount{}, err
+	}
+
+	raw, err := json.Marshal(account)
+	if err != nil {
+		return models.PSPAccount{}, err
+	}
+
+	return models.PSPAccount{
+		Reference:    account.ID,
+		CreatedAt:    createdTime,
+		Name:         &account.Description,
+		DefaultAsset: pointer.For(currency.FormatAsset(supportedCurrenciesWithDecimal, account.CurrencyCode)),
+		Raw:          raw,
+		Metadata: map[string]string{
+			client.ColumnTypeMetadataKey:                      account.Type,
+			client.ColumnBicMetadataKey:                       account.Bic,
+			client.ColumnDefaultAccountNumberIDMetadataKey:    account.DefaultAccountNumberID,
+			client.ColumnDefaultAccountNumberMetadataKey:      account.DefaultAccountNumber,
+			client.ColumnIsOverdraftableMetadataKey:           strconv.FormatBool(account.IsOverdraftable),
+			client.ColumnOverdraftReserveAccountIDMetadataKey: account.OverdraftReserveAccountID,
+			client.ColumnRoutingNumberMetadataKey:             account.RoutingNumber,
+			client.ColumnOwnersMetadataKey:                    strings.Join(account.Owners, ","),
+		},
+	}, nil
+}
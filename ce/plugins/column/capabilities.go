@@ -9,6 +9,7 @@ var capabilities = []models.Capability{
 	models.CAPABILITY_FETCH_PAYMENTS,
 
 	models.CAPABILITY_CREATE_BANK_ACCOUNT,
+	models.CAPABILITY_CREATE_ACCOUNT,
 	models.CAPABILITY_CREATE_TRANSFER,
 	models.CAPABILITY_CREATE_PAYOUT,
 
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -33,6 +34,12 @@ type Account struct {
 	CreatedAt                 string   `json:"created_at"`
 }
 
+type CreateAccountRequest struct {
+	EntityID        string `json:"entity_id"`
+	Description     string `json:"description,omitempty"`
+	IsOverdraftable bool   `json:"is_overdraftable,omitempty"`
+}
+
 type AccountResponseWrapper[t any] struct {
 	BankAccounts t    `json:"bank_accounts"`
 	HasMore      bool `json:"has_more"`
@@ -62,3 +69,27 @@ func (c *client) GetAccounts(ctx context.Context, cursor string, pageSize int) (
 
 	return res.BankAccounts, res.HasMore, nil
 }
+
+func (c *client) CreateAccount(ctx context.Context, ar *CreateAccountRequest, idempotencyKey string) (*Account, error) {
+	ctx = context.WithValue(ctx, metrics.MetricOperationContextKey, "create_account")
+
+	body, err := json.Marshal(ar)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, "bank-accounts", bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create account request: %w", err)
+	}
+	setIdempotencyKey(req, idempotencyKey)
+
+	var res Account
+	var errRes columnError
+	_, err = c.httpClient.Do(ctx, req, &res, &errRes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create account: %w %w", err, errRes.Error())
+	}
+
+	return &res, nil
+}
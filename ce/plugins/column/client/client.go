@@ -15,6 +15,7 @@ import (
 
 type Client interface {
 	GetAccounts(ctx context.Context, cursor string, pageSize int) ([]*Account, bool, error)
+	CreateAccount(ctx context.Context, ar *CreateAccountRequest, idempotencyKey string) (*Account, error)
 	GetAccountBalances(ctx context.Context, accountID string) (*Balance, error)
 	GetCounterparties(ctx context.Context, cursor string, pageSize int) ([]*Counterparties, bool, error)
 	GetTransactions(ctx context.Context, timeline Timeline, pageSize int) ([]*Transaction, Timeline, bool, error)
@@ -44,6 +44,7 @@ const (
 	ColumnRoutingNumberMetadataKey                     = columnMetadataSpecNamespace + "routing_number"
 	ColumnTypeMetadataKey                              = columnMetadataSpecNamespace + "type"
 	ColumnOwnersMetadataKey                            = columnMetadataSpecNamespace + "owners"
+	ColumnEntityIDMetadataKey                          = columnMetadataSpecNamespace + "entity_id"
 	ColumnAccountNumberMetadataKey                     = columnMetadataSpecNamespace + "account_number"
 	ColumnAccountTypeMetadataKey                       = columnMetadataSpecNamespace + "account_type"
 	ColumnAddressCityMetadataKey                       = columnMetadataSpecNamespace + "address_city"
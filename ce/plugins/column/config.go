@@ -9,7 +9,7 @@ import (
 )
 
 type Config struct {
-	APIKey   string `json:"apiKey" validate:"required"`
+	APIKey   string `json:"apiKey" validate:"required" sensitive:"true"`
 	Endpoint string `json:"endpoint" validate:"required,url"`
 }
 
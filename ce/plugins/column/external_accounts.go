@@ -73,7 +73,7 @@ func (p *Plugin) fillExternalAccounts(
 			return nil, err
 		}
 
-		accounts = append(accounts, models.PSPAccount{
+		pspAccount := models.PSPAccount{
 			Reference: account.ID,
 			CreatedAt: createdTime,
 			Name:      &account.Name,
@@ -102,7 +102,23 @@ func (p *Plugin) fillExternalAccounts(
 				client.ColumnRoutingNumberMetadataKey:        account.RoutingNumber,
 				client.ColumnWireDrawdownAllowedMetadataKey:  strconv.FormatBool(account.WireDrawdownAllowed),
 			},
-		})
+		}
+
+		details := models.BankAccountDetails{
+			Name:          account.Name,
+			AccountNumber: account.AccountNumber,
+			Country:       account.LocalBankCountryCode,
+		}
+		// Column counterparties are reached either through an ABA routing
+		// number (domestic) or through a BIC (international wires).
+		if account.RoutingNumberType == "bic" {
+			details.SwiftBicCode = account.RoutingNumber
+		} else {
+			details.RoutingCode = account.RoutingNumber
+		}
+		models.FillBankAccountDetailsToPSPAccountMetadata(&pspAccount, details)
+
+		accounts = append(accounts, pspAccount)
 	}
 	return accounts, nil
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/formancehq/go-libs/v5/pkg/observe/log"
 	"github.com/formancehq/payments/ce/plugins/column/client"
+	"github.com/formancehq/payments/pkg/domain/bankvalidation"
 	pkgplugins "github.com/formancehq/payments/pkg/domain/plugins"
 	"github.com/formancehq/payments/pkg/domain/models"
 )
@@ -22,6 +23,9 @@ var Registration = pkgplugins.Registration{
 	Capabilities: capabilities,
 	RawConf:      Config{},
 	PageSize:     PAGE_SIZE,
+	RoutingCodeMetadataKeys: []bankvalidation.RoutingCodeMetadataKey{
+		{Key: client.ColumnRoutingNumberMetadataKey},
+	},
 }
 
 /*
@@ -53,6 +57,9 @@ var (
 
 	ErrMissingCountry = errors.New("required field country must be provided")
 
+	ErrMissingEntityID     = errors.New("required metadata com.column.spec/entity_id must be provided")
+	ErrUnsupportedCurrency = errors.New("column accounts can only be opened in USD")
+
 	// Metadata Address validation error messages (required when addressLine1 is provided)
 	ErrMissingMetadataAddressCity = fmt.Errorf("required metadata field %s must be provided", client.ColumnAddressCityMetadataKey)
 	ErrMissingMetadataCountry     = fmt.Errorf("required metadata field %s must be provided", client.ColumnAddressCountryCodeMetadataKey)
@@ -169,6 +176,13 @@ func (p *Plugin) CreateBankAccount(ctx context.Context, req models.CreateBankAcc
 	return p.createBankAccount(ctx, req.BankAccount)
 }
 
+func (p *Plugin) CreateAccount(ctx context.Context, req models.CreateAccountRequest) (models.CreateAccountResponse, error) {
+	if p.client == nil {
+		return models.CreateAccountResponse{}, pkgplugins.ErrNotYetInstalled
+	}
+	return p.createAccount(ctx, req)
+}
+
 func (p *Plugin) CreateTransfer(ctx context.Context, req models.CreateTransferRequest) (models.CreateTransferResponse, error) {
 	if p.client == nil {
 		return models.CreateTransferResponse{}, pkgplugins.ErrNotYetInstalled
@@ -224,3 +238,4 @@ func (p *Plugin) TranslateWebhook(ctx context.Context, req models.TranslateWebho
 }
 
 var _ models.Plugin = &Plugin{}
+var _ models.PluginWithAccountCreation = &Plugin{}
@@ -143,6 +143,14 @@ var _ = Describe("Column Plugin", func() {
 		})
 	})
 
+	Context("create account", func() {
+		It("should fail because not installed", func(ctx SpecContext) {
+			req := models.CreateAccountRequest{}
+			_, err := plg.(models.PluginWithAccountCreation).CreateAccount(ctx, req)
+			Expect(err).To(MatchError(plugins.ErrNotYetInstalled))
+		})
+	})
+
 	Context("create transfer", func() {
 		It("should fail when called before install", func(ctx SpecContext) {
 			req := models.CreateTransferRequest{}
@@ -261,6 +269,7 @@ var _ = Describe("Column Plugin", func() {
 			models.CAPABILITY_FETCH_EXTERNAL_ACCOUNTS,
 			models.CAPABILITY_FETCH_PAYMENTS,
 			models.CAPABILITY_CREATE_BANK_ACCOUNT,
+			models.CAPABILITY_CREATE_ACCOUNT,
 			models.CAPABILITY_CREATE_TRANSFER,
 			models.CAPABILITY_CREATE_PAYOUT,
 			models.CAPABILITY_CREATE_WEBHOOKS,
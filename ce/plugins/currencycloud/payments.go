@@ -3,6 +3,7 @@ package currencycloud
 import (
 	"context"
 	"encoding/json"
+	"math/big"
 	"time"
 
 	"github.com/formancehq/go-libs/v5/pkg/types/currency"
@@ -120,6 +121,31 @@ func transactionToPayment(transaction client.Transaction) (*models.PSPPayment, e
 
 	paymentType := matchTransactionType(transaction.RelatedEntityType, transaction.Type)
 
+	if transaction.Action == "payment_fee" && transaction.RelatedEntityID != "" {
+		// The fee is a separate transaction debited from the account: report
+		// it as a fee of the payment it was charged on, without touching the
+		// payment amount.
+		return &models.PSPPayment{
+			ParentReference: transaction.RelatedEntityID,
+			Reference:       transaction.ID,
+			CreatedAt:       transaction.CreatedAt,
+			Type:            paymentType,
+			Amount:          big.NewInt(0),
+			Asset:           currency.FormatAsset(supportedCurrenciesWithDecimal, transaction.Currency),
+			Scheme:          models.PAYMENT_SCHEME_OTHER,
+			Status:          matchTransactionStatus(transaction.Status),
+			Fees: []models.PaymentFee{
+				{
+					Reference: transaction.ID,
+					Type:      models.PAYMENT_FEE_TYPE_PROCESSING,
+					Amount:    amount,
+					Asset:     currency.FormatAsset(supportedCurrenciesWithDecimal, transaction.Currency),
+				},
+			},
+			Raw: raw,
+		}, nil
+	}
+
 	reference := transaction.RelatedEntityID
 	if reference == "" {
 		reference = transaction.ID
@@ -356,6 +356,44 @@ func TestTransactionToPayment(t *testing.T) {
 
 		comparePSPPayments(t, expected, *p)
 	})
+
+	t.Run("payment fee", func(t *testing.T) {
+		t.Parallel()
+
+		transaction := client.Transaction{
+			ID:                "fee",
+			AccountID:         "test",
+			Currency:          "EUR",
+			Type:              "debit",
+			Status:            "completed",
+			Action:            "payment_fee",
+			RelatedEntityType: "payment",
+			RelatedEntityID:   "payment",
+			CreatedAt:         now,
+			UpdatedAt:         now,
+			Amount:            "1.5",
+		}
+
+		p, err := transactionToPayment(transaction)
+		require.NoError(t, err)
+		require.NotNil(t, p)
+
+		expected := models.PSPPayment{
+			ParentReference: "payment",
+			Reference:       transaction.ID,
+			CreatedAt:       now,
+			Type:            models.PAYMENT_TYPE_PAYOUT,
+			Amount:          big.NewInt(0),
+			Asset:           "EUR/2",
+			Scheme:          models.PAYMENT_SCHEME_OTHER,
+			Status:          models.PAYMENT_STATUS_SUCCEEDED,
+			Fees: []models.PaymentFee{
+				{Reference: "fee", Type: models.PAYMENT_FEE_TYPE_PROCESSING, Amount: big.NewInt(150), Asset: "EUR/2"},
+			},
+		}
+
+		comparePSPPayments(t, expected, *p)
+	})
 }
 
 func comparePSPPayments(t *testing.T, a, b models.PSPPayment) {
@@ -367,6 +405,7 @@ func comparePSPPayments(t *testing.T, a, b models.PSPPayment) {
 	require.Equal(t, a.Asset, b.Asset)
 	require.Equal(t, a.Scheme, b.Scheme)
 	require.Equal(t, a.Status, b.Status)
+	require.Equal(t, a.Fees, b.Fees)
 
 	switch {
 	case a.SourceAccountReference != nil && b.SourceAccountReference != nil:
@@ -24,6 +24,12 @@ func (p *Plugin) fetchNextPayments(ctx context.Context, req models.FetchNextPaym
 		}
 	}
 
+	if req.Window != nil && oldState.LastUpdatedAtFrom.Before(req.Window.From) {
+		// payments created within the window cannot have been updated before
+		// it starts, no need to list older ones
+		oldState.LastUpdatedAtFrom = req.Window.From.Add(-time.Nanosecond)
+	}
+
 	newState := paymentsState{
 		LastUpdatedAtFrom: oldState.LastUpdatedAtFrom,
 	}
@@ -38,7 +44,7 @@ func (p *Plugin) fetchNextPayments(ctx context.Context, req models.FetchNextPaym
 			return models.FetchNextPaymentsResponse{}, err
 		}
 
-		payments, updatedAts, err = fillPayments(pagedPayments, payments, updatedAts, oldState)
+		payments, updatedAts, err = fillPayments(pagedPayments, payments, updatedAts, oldState, req.Window)
 		if err != nil {
 			return models.FetchNextPaymentsResponse{}, err
 		}
@@ -75,6 +81,7 @@ func fillPayments(
 	payments []models.PSPPayment,
 	updatedAts []time.Time,
 	oldState paymentsState,
+	window *models.FetchWindow,
 ) ([]models.PSPPayment, []time.Time, error) {
 	for _, payment := range pagedPayments {
 		switch payment.UpdatedAt.Compare(oldState.LastUpdatedAtFrom) {
@@ -84,6 +91,10 @@ func fillPayments(
 		default:
 		}
 
+		if window != nil && !window.Contains(payment.CreatedAt) {
+			continue
+		}
+
 		raw, err := json.Marshal(payment)
 		if err != nil {
 			return nil, nil, err
@@ -177,6 +177,29 @@ var _ = Describe("Generic Plugin Payments", func() {
 			// We fetched everything, state should be resetted
 			Expect(state.LastUpdatedAtFrom.UTC()).To(Equal(samplePayments[49].UpdatedAt.UTC()))
 		})
+
+		It("should fetch next payments - backfill window", func(ctx SpecContext) {
+			window := &models.FetchWindow{
+				From: samplePayments[10].CreatedAt,
+				To:   samplePayments[20].CreatedAt,
+			}
+			req := models.FetchNextPaymentsRequest{
+				PageSize: 60,
+				Window:   window,
+			}
+
+			m.EXPECT().ListTransactions(gomock.Any(), int64(1), int64(60), window.From.Add(-time.Nanosecond)).Return(
+				samplePayments[10:],
+				nil,
+			)
+
+			resp, err := plg.FetchNextPayments(ctx, req)
+			Expect(err).To(BeNil())
+			Expect(resp.Payments).To(HaveLen(10))
+			Expect(resp.Payments[0].Reference).To(Equal(samplePayments[10].Id))
+			Expect(resp.Payments[9].Reference).To(Equal(samplePayments[19].Id))
+			Expect(resp.HasMore).To(BeFalse())
+		})
 	})
 })
 
@@ -263,7 +286,7 @@ func TestFillPayments_WithRelatedTransactionID(t *testing.T) {
 
 	oldState := paymentsState{LastUpdatedAtFrom: now.Add(-time.Hour)}
 
-	payments, updatedAts, err := fillPayments(pagedPayments, nil, nil, oldState)
+	payments, updatedAts, err := fillPayments(pagedPayments, nil, nil, oldState, nil)
 	require.NoError(t, err)
 	require.Len(t, payments, 1)
 	require.Len(t, updatedAts, 1)
@@ -292,7 +315,7 @@ func TestFillPayments_WithoutSourceOrDestination(t *testing.T) {
 
 	oldState := paymentsState{LastUpdatedAtFrom: now.Add(-time.Hour)}
 
-	payments, _, err := fillPayments(pagedPayments, nil, nil, oldState)
+	payments, _, err := fillPayments(pagedPayments, nil, nil, oldState, nil)
 	require.NoError(t, err)
 	require.Len(t, payments, 1)
 	require.Nil(t, payments[0].SourceAccountReference)
@@ -317,7 +340,7 @@ func TestFillPayments_InvalidAmount(t *testing.T) {
 
 	oldState := paymentsState{LastUpdatedAtFrom: now.Add(-time.Hour)}
 
-	payments, _, err := fillPayments(pagedPayments, nil, nil, oldState)
+	payments, _, err := fillPayments(pagedPayments, nil, nil, oldState, nil)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "failed to parse amount")
 	require.Nil(t, payments)
@@ -350,7 +373,7 @@ func TestFillPayments_SkipsOldPayments(t *testing.T) {
 
 	oldState := paymentsState{LastUpdatedAtFrom: now.Add(-time.Hour)}
 
-	payments, updatedAts, err := fillPayments(pagedPayments, nil, nil, oldState)
+	payments, updatedAts, err := fillPayments(pagedPayments, nil, nil, oldState, nil)
 	require.NoError(t, err)
 	require.Len(t, payments, 1)
 	require.Len(t, updatedAts, 1)
@@ -393,7 +416,7 @@ func TestFillPayments_AllPaymentTypes(t *testing.T) {
 
 	oldState := paymentsState{}
 
-	payments, _, err := fillPayments(pagedPayments, nil, nil, oldState)
+	payments, _, err := fillPayments(pagedPayments, nil, nil, oldState, nil)
 	require.NoError(t, err)
 	require.Len(t, payments, 3)
 
@@ -428,7 +451,7 @@ func TestFillPayments_WithMetadata(t *testing.T) {
 
 	oldState := paymentsState{}
 
-	payments, _, err := fillPayments(pagedPayments, nil, nil, oldState)
+	payments, _, err := fillPayments(pagedPayments, nil, nil, oldState, nil)
 	require.NoError(t, err)
 	require.Len(t, payments, 1)
 	require.Equal(t, "123", payments[0].Metadata["order_id"])
@@ -457,7 +480,7 @@ func TestFillPayments_WithSourceAndDestination(t *testing.T) {
 
 	oldState := paymentsState{}
 
-	payments, _, err := fillPayments(pagedPayments, nil, nil, oldState)
+	payments, _, err := fillPayments(pagedPayments, nil, nil, oldState, nil)
 	require.NoError(t, err)
 	require.Len(t, payments, 1)
 	require.NotNil(t, payments[0].SourceAccountReference)
@@ -534,3 +557,37 @@ func TestPaymentsState_Marshaling(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, state.LastUpdatedAtFrom.Equal(decoded.LastUpdatedAtFrom))
 }
+
+func TestFillPayments_SkipsPaymentsOutsideWindow(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	pagedPayments := []genericclient.Transaction{
+		{
+			Id:        "tx_before",
+			CreatedAt: now.Add(-2 * time.Hour),
+			UpdatedAt: now,
+			Currency:  "EUR/2",
+			Type:      genericclient.PAYIN,
+			Status:    genericclient.SUCCEEDED,
+			Amount:    "1000",
+		},
+		{
+			Id:        "tx_within",
+			CreatedAt: now.Add(-30 * time.Minute),
+			UpdatedAt: now,
+			Currency:  "EUR/2",
+			Type:      genericclient.PAYIN,
+			Status:    genericclient.SUCCEEDED,
+			Amount:    "2000",
+		},
+	}
+
+	window := &models.FetchWindow{From: now.Add(-time.Hour), To: now}
+
+	payments, updatedAts, err := fillPayments(pagedPayments, nil, nil, paymentsState{}, window)
+	require.NoError(t, err)
+	require.Len(t, payments, 1)
+	require.Len(t, updatedAts, 1)
+	require.Equal(t, "tx_within", payments[0].Reference)
+}
@@ -0,0 +1,46 @@
+package increase
+
+import (
+	"context"
+
+	"github.com/formancehq/go-libs/v5/pkg/types/currency"
+	"github.com/formancehq/payments/ce/plugins/increase/client"
+	"github.com/formancehq/payments/pkg/domain/models"
+)
+
+func (p *Plugin) createAccount(ctx context.Context, req models.CreateAccountRequest) (models.CreateAccountResponse, error) {
+	if req.Name == "" {
+		return models.CreateAccountResponse{}, models.NewConnectorValidationError("name", models.ErrMissingConnectorField)
+	}
+
+	// Increase accounts are always held in USD
+	if req.DefaultAsset != nil && *req.DefaultAsset != currency.FormatAsset(supportedCurrenciesWithDecimal, "USD") {
+		return models.CreateAccountResponse{}, models.NewConnectorValidationError("defaultAsset", models.ErrInvalidRequest)
+	}
+
+	account, err := p.client.CreateAccount(
+		ctx,
+		&client.CreateAccountRequest{
+			Name:      req.Name,
+			EntityID:  models.ExtractNamespacedMetadata(req.Metadata, client.IncreaseEntityIDMetadataKey),
+			ProgramID: models.ExtractNamespacedMetadata(req.Metadata, client.IncreaseProgramIDMetadataKey),
+		},
+		p.generateIdempotencyKey(req.Reference),
+	)
+	if err != nil {
+		return models.CreateAccountResponse{}, err
+	}
+
+	pspAccount, err := toPSPAccount(account)
+	if err != nil {
+		return models.CreateAccountResponse{}, err
+	}
+
+	if req.PaymentServiceUser != nil {
+		pspAccount.PsuID = &req.PaymentServiceUser.ID
+	}
+
+	return models.CreateAccountResponse{
+		Account: pspAccount,
+	}, nil
+}
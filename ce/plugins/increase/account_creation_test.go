@@ -0,0 +1,145 @@
+package increase
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/payments/ce/plugins/increase/client"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Increase Plugin Account Creation", func() {
+	var (
+		plg *Plugin
+	)
+
+	BeforeEach(func() {
+		plg = &Plugin{}
+	})
+
+	Context("create account", func() {
+		var (
+			mockHTTPClient *client.MockHTTPClient
+			sampleRequest  models.CreateAccountRequest
+			now            time.Time
+		)
+
+		BeforeEach(func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockHTTPClient = client.NewMockHTTPClient(ctrl)
+			plg.client = client.New("test", "aseplye", "https://test.com", "we5432345")
+			plg.client.SetHttpClient(mockHTTPClient)
+			now = time.Now().UTC()
+
+			sampleRequest = models.CreateAccountRequest{
+				Reference: "virtual-1",
+				Name:      "John Doe",
+				Metadata: map[string]string{
+					client.IncreaseEntityIDMetadataKey: "entity_123",
+				},
+			}
+		})
+
+		It("should return an error - missing name", func(ctx SpecContext) {
+			req := sampleRequest
+			req.Name = ""
+
+			resp, err := plg.CreateAccount(ctx, req)
+			Expect(err).ToNot(BeNil())
+			Expect(err).To(MatchError("validation error occurred for field name: missing required field in request"))
+			Expect(resp).To(Equal(models.CreateAccountResponse{}))
+		})
+
+		It("should return an error - unsupported asset", func(ctx SpecContext) {
+			req := sampleRequest
+			req.DefaultAsset = pointer.For("EUR/2")
+
+			resp, err := plg.CreateAccount(ctx, req)
+			Expect(err).ToNot(BeNil())
+			Expect(err).To(MatchError(models.ErrInvalidRequest))
+			Expect(resp).To(Equal(models.CreateAccountResponse{}))
+		})
+
+		It("should return an error - create account error", func(ctx SpecContext) {
+			mockHTTPClient.EXPECT().Do(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+			).Return(
+				500,
+				errors.New("test error"),
+			)
+
+			resp, err := plg.CreateAccount(ctx, sampleRequest)
+			Expect(err).ToNot(BeNil())
+			Expect(err).To(MatchError("failed to create account: test error : : status code: 0"))
+			Expect(resp).To(Equal(models.CreateAccountResponse{}))
+		})
+
+		It("should create the account", func(ctx SpecContext) {
+			req := sampleRequest
+			req.DefaultAsset = pointer.For("USD/2")
+			req.PaymentServiceUser = &models.PSPPaymentServiceUser{
+				ID:   uuid.New(),
+				Name: "John Doe",
+			}
+
+			expectedAccount := client.Account{
+				ID:        "account_123",
+				Name:      "John Doe",
+				EntityID:  "entity_123",
+				Bank:      "first_internet_bank",
+				Status:    "open",
+				Type:      "account",
+				Currency:  "USD",
+				CreatedAt: now.Format(time.RFC3339),
+			}
+
+			mockHTTPClient.EXPECT().Do(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ any, r *http.Request, _ any, _ any) (int, error) {
+				Expect(r.Header.Get("Idempotency-Key")).To(Equal(plg.generateIdempotencyKey("virtual-1")))
+
+				var body client.CreateAccountRequest
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+				Expect(body).To(Equal(client.CreateAccountRequest{
+					Name:     "John Doe",
+					EntityID: "entity_123",
+				}))
+				return 200, nil
+			}).SetArg(2, expectedAccount)
+
+			raw, _ := json.Marshal(&expectedAccount)
+			createdAt, _ := time.Parse(time.RFC3339, expectedAccount.CreatedAt)
+
+			resp, err := plg.CreateAccount(ctx, req)
+			Expect(err).To(BeNil())
+			Expect(resp).To(Equal(models.CreateAccountResponse{
+				Account: models.PSPAccount{
+					Reference:    "account_123",
+					CreatedAt:    createdAt,
+					Name:         pointer.For("John Doe"),
+					DefaultAsset: pointer.For("USD/2"),
+					PsuID:        &req.PaymentServiceUser.ID,
+					Raw:          raw,
+					Metadata: map[string]string{
+						client.IncreaseTypeMetadataKey:   "account",
+						client.IncreaseBankMetadataKey:   "first_internet_bank",
+						client.IncreaseStatusMetadataKey: "open",
+					},
+				},
+			}))
+		})
+	})
+})
@@ -74,29 +74,38 @@ func (p *Plugin) fillAccounts(
 			break
 		}
 
-		createdTime, err := time.Parse(time.RFC3339, account.CreatedAt)
+		pspAccount, err := toPSPAccount(account)
 		if err != nil {
 			return nil, err
 		}
 
-		raw, err := json.Marshal(account)
-		if err != nil {
-			return nil, err
-		}
-
-		accounts = append(accounts, models.PSPAccount{
-			Reference:    account.ID,
-			CreatedAt:    createdTime,
-			Name:         &account.Name,
-			DefaultAsset: pointer.For(currency.FormatAsset(supportedCurrenciesWithDecimal, account.Currency)),
-			Raw:          raw,
-			Metadata: map[string]string{
-				client.IncreaseTypeMetadataKey:   account.Type,
-				client.IncreaseBankMetadataKey:   account.Bank,
-				client.IncreaseStatusMetadataKey: account.Status,
-			},
-		})
+		accounts = append(accounts, pspAccount)
 	}
 
 	return accounts, nil
 }
+
+func toPSPAccount(account *client.Account) (models.PSPAccount, error) {
+	createdTime, err := time.Parse(time.RFC3339, account.CreatedAt)
+	if err != nil {
+		return models.PSPAccount{}, err
+	}
+
+	raw, err := json.Marshal(account)
+	if err != nil {
+		return models.PSPAccount{}, err
+	}
+
+	return models.PSPAccount{
+		Reference:    account.ID,
+		CreatedAt:    createdTime,
+		Name:         &account.Name,
+		DefaultAsset: pointer.For(currency.FormatAsset(supportedCurrenciesWithDecimal, account.Currency)),
+		Raw:          raw,
+		Metadata: map[string]string{
+			client.IncreaseTypeMetadataKey:   account.Type,
+			client.IncreaseBankMetadataKey:   account.Bank,
+			client.IncreaseStatusMetadataKey: account.Status,
+		},
+	}, nil
+}
@@ -11,6 +11,7 @@ var capabilities = []models.Capability{
 	models.CAPABILITY_CREATE_TRANSFER,
 	models.CAPABILITY_CREATE_PAYOUT,
 	models.CAPABILITY_CREATE_BANK_ACCOUNT,
+	models.CAPABILITY_CREATE_ACCOUNT,
 
 	models.CAPABILITY_TRANSLATE_WEBHOOKS,
 	models.CAPABILITY_CREATE_WEBHOOKS,
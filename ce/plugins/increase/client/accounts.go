@@ -1,7 +1,9 @@
 package client
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -21,6 +23,12 @@ type Account struct {
 	CreatedAt string `json:"created_at"`
 }
 
+type CreateAccountRequest struct {
+	Name      string `json:"name"`
+	EntityID  string `json:"entity_id,omitempty"`
+	ProgramID string `json:"program_id,omitempty"`
+}
+
 func (c *client) GetAccounts(ctx context.Context, pageSize int, cursor string, createdAtAfter time.Time) ([]*Account, string, error) {
 	ctx = context.WithValue(ctx, metrics.MetricOperationContextKey, "list_accounts")
 
@@ -47,3 +55,27 @@ func (c *client) GetAccounts(ctx context.Context, pageSize int, cursor string, c
 	}
 	return res.Data, res.NextCursor, nil
 }
+
+func (c *client) CreateAccount(ctx context.Context, ar *CreateAccountRequest, idempotencyKey string) (*Account, error) {
+	ctx = context.WithValue(ctx, metrics.MetricOperationContextKey, "create_account")
+
+	body, err := json.Marshal(ar)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, "accounts", bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create account request: %w", err)
+	}
+	req.Header.Add("Idempotency-Key", idempotencyKey)
+
+	var res Account
+	var errRes increaseError
+	_, err = c.httpClient.Do(ctx, req, &res, &errRes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create account: %w %w", err, errRes.Error())
+	}
+
+	return &res, nil
+}
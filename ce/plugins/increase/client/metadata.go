@@ -25,4 +25,6 @@ const (
 	IncreaseTypeMetadataKey                     = increaseMetadataSpecNamespace + "type"
 	IncreaseBankMetadataKey                     = increaseMetadataSpecNamespace + "bank"
 	IncreaseStatusMetadataKey                   = increaseMetadataSpecNamespace + "status"
+	IncreaseEntityIDMetadataKey                 = increaseMetadataSpecNamespace + "entityID"
+	IncreaseProgramIDMetadataKey                = increaseMetadataSpecNamespace + "programID"
 )
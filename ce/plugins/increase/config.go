@@ -9,9 +9,9 @@ import (
 )
 
 type Config struct {
-	APIKey              string `json:"apiKey" validate:"required"`
+	APIKey              string `json:"apiKey" validate:"required" sensitive:"true"`
 	Endpoint            string `json:"endpoint" validate:"required"`
-	WebhookSharedSecret string `json:"webhookSharedSecret" validate:"required"`
+	WebhookSharedSecret string `json:"webhookSharedSecret" validate:"required" sensitive:"true"`
 }
 
 const PAGE_SIZE = 100 // max size is 100
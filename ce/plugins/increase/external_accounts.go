@@ -99,6 +99,11 @@ func (p *Plugin) mapExternalAccount(
 			client.IncreaseRoutingNumberMetadataKey: account.RoutingNumber,
 		},
 	}
+	models.FillBankAccountDetailsToPSPAccountMetadata(&pspAccount, models.BankAccountDetails{
+		Name:          account.Description,
+		AccountNumber: account.AccountNumber,
+		RoutingCode:   account.RoutingNumber,
+	})
 
 	return &pspAccount, nil
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/formancehq/go-libs/v5/pkg/observe/log"
 	"github.com/formancehq/payments/ce/plugins/increase/client"
+	"github.com/formancehq/payments/pkg/domain/bankvalidation"
 	"github.com/formancehq/payments/pkg/domain/models"
 	pkgplugins "github.com/formancehq/payments/pkg/domain/plugins"
 )
@@ -21,6 +22,9 @@ var Registration = pkgplugins.Registration{
 	Capabilities: capabilities,
 	RawConf:      Config{},
 	PageSize:     PAGE_SIZE,
+	RoutingCodeMetadataKeys: []bankvalidation.RoutingCodeMetadataKey{
+		{Key: client.IncreaseRoutingNumberMetadataKey, Country: "US"},
+	},
 }
 
 type Plugin struct {
@@ -116,6 +120,13 @@ func (p *Plugin) CreateBankAccount(ctx context.Context, req models.CreateBankAcc
 	return p.createBankAccount(ctx, req.BankAccount)
 }
 
+func (p *Plugin) CreateAccount(ctx context.Context, req models.CreateAccountRequest) (models.CreateAccountResponse, error) {
+	if p.client == nil {
+		return models.CreateAccountResponse{}, pkgplugins.ErrNotYetInstalled
+	}
+	return p.createAccount(ctx, req)
+}
+
 func (p *Plugin) CreateTransfer(ctx context.Context, req models.CreateTransferRequest) (models.CreateTransferResponse, error) {
 	if p.client == nil {
 		return models.CreateTransferResponse{}, pkgplugins.ErrNotYetInstalled
@@ -210,3 +221,4 @@ func (p *Plugin) VerifyWebhook(ctx context.Context, req models.VerifyWebhookRequ
 }
 
 var _ models.Plugin = &Plugin{}
+var _ models.PluginWithAccountCreation = &Plugin{}
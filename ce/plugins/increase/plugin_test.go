@@ -126,6 +126,14 @@ var _ = Describe("Increase Plugin", func() {
 		})
 	})
 
+	Context("create account", func() {
+		It("should fail when called before install", func(ctx SpecContext) {
+			req := models.CreateAccountRequest{}
+			_, err := plg.CreateAccount(ctx, req)
+			Expect(err).To(MatchError(plugins.ErrNotYetInstalled))
+		})
+	})
+
 	Context("create transfer", func() {
 		It("should fail when called before install", func(ctx SpecContext) {
 			req := models.CreateTransferRequest{}
@@ -266,6 +274,7 @@ var _ = Describe("Increase Plugin", func() {
 			models.CAPABILITY_CREATE_TRANSFER,
 			models.CAPABILITY_CREATE_PAYOUT,
 			models.CAPABILITY_CREATE_BANK_ACCOUNT,
+			models.CAPABILITY_CREATE_ACCOUNT,
 			models.CAPABILITY_TRANSLATE_WEBHOOKS,
 			models.CAPABILITY_CREATE_WEBHOOKS,
 		}
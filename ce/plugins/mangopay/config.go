@@ -10,7 +10,7 @@ import (
 
 type Config struct {
 	ClientID string `json:"clientID" validate:"required"`
-	APIKey   string `json:"apiKey" validate:"required"`
+	APIKey   string `json:"apiKey" validate:"required" sensitive:"true"`
 	Endpoint string `json:"endpoint" validate:"required"`
 }
 
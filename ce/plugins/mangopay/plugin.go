@@ -8,6 +8,7 @@ import (
 
 	"github.com/formancehq/go-libs/v5/pkg/observe/log"
 	"github.com/formancehq/payments/ce/plugins/mangopay/client"
+	"github.com/formancehq/payments/pkg/domain/bankvalidation"
 	"github.com/formancehq/payments/pkg/domain/models"
 	errorsutils "github.com/formancehq/payments/pkg/domain/errors"
 	pkgplugins "github.com/formancehq/payments/pkg/domain/plugins"
@@ -23,6 +24,10 @@ var Registration = pkgplugins.Registration{
 	Capabilities: capabilities,
 	RawConf:      Config{},
 	PageSize:     PAGE_SIZE,
+	RoutingCodeMetadataKeys: []bankvalidation.RoutingCodeMetadataKey{
+		{Key: client.MangopayABAMetadataKey, Country: "US"},
+		{Key: client.MangopaySortCodeMetadataKey, Country: "GB"},
+	},
 }
 
 type Plugin struct {
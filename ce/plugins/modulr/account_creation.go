@@ -0,0 +1,68 @@
+package modulr
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/formancehq/go-libs/v5/pkg/types/currency"
+	"github.com/formancehq/payments/ce/plugins/modulr/client"
+	errorsutils "github.com/formancehq/payments/pkg/domain/errors"
+	"github.com/formancehq/payments/pkg/domain/models"
+)
+
+const (
+	// Modulr customer owning the new account, required.
+	accountCustomerIDMetadataKey = "com.modulr.spec/customerId"
+	// Modulr product of the new account, defaults to the product configured
+	// on the customer.
+	accountProductCodeMetadataKey = "com.modulr.spec/productCode"
+)
+
+func (p *Plugin) createAccount(ctx context.Context, req models.CreateAccountRequest) (models.CreateAccountResponse, error) {
+	customerID := req.Metadata[accountCustomerIDMetadataKey]
+	if customerID == "" {
+		return models.CreateAccountResponse{}, errorsutils.NewWrappedError(
+			fmt.Errorf("customer id is required in metadata %s to create an account", accountCustomerIDMetadataKey),
+			models.ErrInvalidRequest,
+		)
+	}
+
+	if req.DefaultAsset == nil {
+		return models.CreateAccountResponse{}, errorsutils.NewWrappedError(
+			fmt.Errorf("default asset is required to create an account"),
+			models.ErrInvalidRequest,
+		)
+	}
+
+	curr, _, err := currency.GetCurrencyAndPrecisionFromAsset(supportedCurrenciesWithDecimal, *req.DefaultAsset)
+	if err != nil {
+		return models.CreateAccountResponse{}, errorsutils.NewWrappedError(
+			fmt.Errorf("failed to get currency and precision from asset: %v", err),
+			models.ErrInvalidRequest,
+		)
+	}
+
+	account, err := p.client.CreateAccount(ctx, customerID, &client.CreateAccountRequest{
+		IdempotencyKey:    req.Reference,
+		Currency:          curr,
+		ExternalReference: req.Reference,
+		Name:              req.Name,
+		ProductCode:       req.Metadata[accountProductCodeMetadataKey],
+	})
+	if err != nil {
+		return models.CreateAccountResponse{}, err
+	}
+
+	pspAccount, err := toPSPAccount(*account)
+	if err != nil {
+		return models.CreateAccountResponse{}, err
+	}
+	pspAccount.Metadata = req.Metadata
+	if req.PaymentServiceUser != nil {
+		pspAccount.PsuID = &req.PaymentServiceUser.ID
+	}
+
+	return models.CreateAccountResponse{
+		Account: pspAccount,
+	}, nil
+}
@@ -0,0 +1,133 @@
+package modulr
+
+import (
+	"errors"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/payments/ce/plugins/modulr/client"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Modulr Plugin Account Creation", func() {
+	var (
+		ctrl *gomock.Controller
+		m    *client.MockClient
+		plg  *Plugin
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		m = client.NewMockClient(ctrl)
+		plg = &Plugin{client: m}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("create account", func() {
+		var (
+			sampleRequest models.CreateAccountRequest
+			sampleAccount client.Account
+			now           time.Time
+		)
+
+		BeforeEach(func() {
+			now = time.Now().UTC()
+			sampleRequest = models.CreateAccountRequest{
+				Reference:    "virtual-1",
+				Name:         "John Doe",
+				DefaultAsset: pointer.For("GBP/2"),
+				Metadata: map[string]string{
+					accountCustomerIDMetadataKey: "C123",
+				},
+			}
+			sampleAccount = client.Account{
+				ID:          "A123",
+				Name:        "John Doe",
+				Status:      "ACTIVE",
+				Balance:     "0",
+				Currency:    "GBP",
+				CustomerID:  "C123",
+				CreatedDate: now.Format("2006-01-02T15:04:05.999-0700"),
+			}
+		})
+
+		It("should return an error - missing customer id", func(ctx SpecContext) {
+			req := sampleRequest
+			req.Metadata = map[string]string{}
+
+			resp, err := plg.CreateAccount(ctx, req)
+			Expect(err).ToNot(BeNil())
+			Expect(err).To(MatchError(models.ErrInvalidRequest))
+			Expect(resp).To(Equal(models.CreateAccountResponse{}))
+		})
+
+		It("should return an error - missing default asset", func(ctx SpecContext) {
+			req := sampleRequest
+			req.DefaultAsset = nil
+
+			resp, err := plg.CreateAccount(ctx, req)
+			Expect(err).ToNot(BeNil())
+			Expect(err).To(MatchError(models.ErrInvalidRequest))
+			Expect(resp).To(Equal(models.CreateAccountResponse{}))
+		})
+
+		It("should return an error - unsupported asset", func(ctx SpecContext) {
+			req := sampleRequest
+			req.DefaultAsset = pointer.For("HUF/2")
+
+			resp, err := plg.CreateAccount(ctx, req)
+			Expect(err).ToNot(BeNil())
+			Expect(err).To(MatchError(models.ErrInvalidRequest))
+			Expect(resp).To(Equal(models.CreateAccountResponse{}))
+		})
+
+		It("should return an error - create account error", func(ctx SpecContext) {
+			m.EXPECT().CreateAccount(gomock.Any(), "C123", gomock.Any()).Return(
+				nil,
+				errors.New("test error"),
+			)
+
+			resp, err := plg.CreateAccount(ctx, sampleRequest)
+			Expect(err).ToNot(BeNil())
+			Expect(err).To(MatchError("test error"))
+			Expect(resp).To(Equal(models.CreateAccountResponse{}))
+		})
+
+		It("should create the account", func(ctx SpecContext) {
+			req := sampleRequest
+			req.Metadata = map[string]string{
+				accountCustomerIDMetadataKey:  "C123",
+				accountProductCodeMetadataKey: "P456",
+			}
+			req.PaymentServiceUser = &models.PSPPaymentServiceUser{
+				ID:   uuid.New(),
+				Name: "John Doe",
+			}
+
+			m.EXPECT().CreateAccount(gomock.Any(), "C123", &client.CreateAccountRequest{
+				IdempotencyKey:    "virtual-1",
+				Currency:          "GBP",
+				ExternalReference: "virtual-1",
+				Name:              "John Doe",
+				ProductCode:       "P456",
+			}).Return(&sampleAccount, nil)
+
+			resp, err := plg.CreateAccount(ctx, req)
+			Expect(err).To(BeNil())
+			Expect(resp.Account.Reference).To(Equal("A123"))
+			Expect(resp.Account.Name).To(Equal(pointer.For("John Doe")))
+			Expect(resp.Account.DefaultAsset).To(Equal(pointer.For("GBP/2")))
+			Expect(resp.Account.CreatedAt).To(BeTemporally("==", now.Truncate(time.Millisecond)))
+			Expect(resp.Account.PsuID).To(Equal(&req.PaymentServiceUser.ID))
+			Expect(resp.Account.Metadata).To(Equal(req.Metadata))
+			Expect(resp.Account.Raw).ToNot(BeNil())
+		})
+	})
+})
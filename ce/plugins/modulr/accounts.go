@@ -79,31 +79,40 @@ func fillAccounts(
 			break
 		}
 
-		createdTime, err := time.Parse("2006-01-02T15:04:05.999-0700", account.CreatedDate)
+		pspAccount, err := toPSPAccount(account)
 		if err != nil {
 			return nil, err
 		}
 
-		switch createdTime.Compare(oldState.LastCreatedAt) {
+		switch pspAccount.CreatedAt.Compare(oldState.LastCreatedAt) {
 		case -1, 0:
 			// Account already ingested, skip
 			continue
 		default:
 		}
 
-		raw, err := json.Marshal(account)
-		if err != nil {
-			return nil, err
-		}
-
-		accounts = append(accounts, models.PSPAccount{
-			Reference:    account.ID,
-			CreatedAt:    createdTime,
-			Name:         &account.Name,
-			DefaultAsset: pointer.For(currency.FormatAsset(supportedCurrenciesWithDecimal, account.Currency)),
-			Raw:          raw,
-		})
+		accounts = append(accounts, pspAccount)
 	}
 
 	return accounts, nil
 }
+
+func toPSPAccount(account client.Account) (models.PSPAccount, error) {
+	createdTime, err := time.Parse("2006-01-02T15:04:05.999-0700", account.CreatedDate)
+	if err != nil {
+		return models.PSPAccount{}, err
+	}
+
+	raw, err := json.Marshal(account)
+	if err != nil {
+		return models.PSPAccount{}, err
+	}
+
+	return models.PSPAccount{
+		Reference:    account.ID,
+		CreatedAt:    createdTime,
+		Name:         &account.Name,
+		DefaultAsset: pointer.For(currency.FormatAsset(supportedCurrenciesWithDecimal, account.Currency)),
+		Raw:          raw,
+	}, nil
+}
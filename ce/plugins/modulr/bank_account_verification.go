@@ -0,0 +1,78 @@
+package modulr
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/payments/ce/plugins/modulr/client"
+	errorsutils "github.com/formancehq/payments/pkg/domain/errors"
+	"github.com/formancehq/payments/pkg/domain/models"
+)
+
+// Set to BUSINESS on bank accounts owned by a company, defaults to PERSONAL.
+const bankAccountTypeMetadataKey = "com.modulr.spec/accountType"
+
+func (p *Plugin) verifyBankAccount(ctx context.Context, ba models.BankAccount) (models.VerifyBankAccountResponse, error) {
+	if ba.AccountNumber == nil || *ba.AccountNumber == "" {
+		return models.VerifyBankAccountResponse{}, errorsutils.NewWrappedError(
+			fmt.Errorf("account number is required to verify a bank account"),
+			models.ErrInvalidRequest,
+		)
+	}
+
+	sortCode := ba.Metadata[models.BankAccountRoutingCodeMetadataKey]
+	if sortCode == "" {
+		return models.VerifyBankAccountResponse{}, errorsutils.NewWrappedError(
+			fmt.Errorf("sort code is required in metadata %s to verify a bank account", models.BankAccountRoutingCodeMetadataKey),
+			models.ErrInvalidRequest,
+		)
+	}
+
+	accountType := client.AccountNameCheckAccountTypePersonal
+	switch ba.Metadata[bankAccountTypeMetadataKey] {
+	case "", string(client.AccountNameCheckAccountTypePersonal):
+	case string(client.AccountNameCheckAccountTypeBusiness):
+		accountType = client.AccountNameCheckAccountTypeBusiness
+	default:
+		return models.VerifyBankAccountResponse{}, errorsutils.NewWrappedError(
+			fmt.Errorf("invalid account type in metadata %s: %s", bankAccountTypeMetadataKey, ba.Metadata[bankAccountTypeMetadataKey]),
+			models.ErrInvalidRequest,
+		)
+	}
+
+	resp, err := p.client.CheckAccountName(ctx, &client.AccountNameCheckRequest{
+		SortCode:      strings.ReplaceAll(sortCode, "-", ""),
+		AccountNumber: *ba.AccountNumber,
+		Name:          ba.Name,
+		AccountType:   accountType,
+	})
+	if err != nil {
+		return models.VerifyBankAccountResponse{}, err
+	}
+
+	res := models.VerifyBankAccountResponse{
+		Result: matchAccountNameCheckResult(resp.Result.Code),
+	}
+	if resp.Result.Name != "" {
+		res.MatchedName = pointer.For(resp.Result.Name)
+	}
+
+	return res, nil
+}
+
+func matchAccountNameCheckResult(code string) models.BankAccountVerificationResult {
+	switch code {
+	case client.AccountNameCheckResultMatch:
+		return models.BANK_ACCOUNT_VERIFICATION_RESULT_MATCH
+	case client.AccountNameCheckResultCloseMatch,
+		// The name matches but the account is personal instead of business
+		// (or the other way around), the scheme treats it as a close match.
+		client.AccountNameCheckResultAccountTypeMismatch:
+		return models.BANK_ACCOUNT_VERIFICATION_RESULT_CLOSE_MATCH
+	default:
+		// NO_MATCH, but also unknown accounts or accounts that were switched
+		return models.BANK_ACCOUNT_VERIFICATION_RESULT_NO_MATCH
+	}
+}
@@ -0,0 +1,147 @@
+package modulr
+
+import (
+	"errors"
+
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/payments/ce/plugins/modulr/client"
+	"github.com/formancehq/payments/pkg/domain/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Modulr Plugin Bank Account Verification", func() {
+	var (
+		ctrl *gomock.Controller
+		m    *client.MockClient
+		plg  *Plugin
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		m = client.NewMockClient(ctrl)
+		plg = &Plugin{client: m}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("verify bank account", func() {
+		var (
+			sampleBankAccount models.BankAccount
+		)
+
+		BeforeEach(func() {
+			sampleBankAccount = models.BankAccount{
+				Name:          "John Doe",
+				AccountNumber: pointer.For("12345678"),
+				Country:       pointer.For("GB"),
+				Metadata: map[string]string{
+					models.BankAccountRoutingCodeMetadataKey: "20-00-00",
+				},
+			}
+		})
+
+		It("should return an error - missing account number", func(ctx SpecContext) {
+			ba := sampleBankAccount
+			ba.AccountNumber = nil
+
+			resp, err := plg.VerifyBankAccount(ctx, models.VerifyBankAccountRequest{BankAccount: ba})
+			Expect(err).ToNot(BeNil())
+			Expect(err).To(MatchError(models.ErrInvalidRequest))
+			Expect(resp).To(Equal(models.VerifyBankAccountResponse{}))
+		})
+
+		It("should return an error - missing sort code", func(ctx SpecContext) {
+			ba := sampleBankAccount
+			ba.Metadata = map[string]string{}
+
+			resp, err := plg.VerifyBankAccount(ctx, models.VerifyBankAccountRequest{BankAccount: ba})
+			Expect(err).ToNot(BeNil())
+			Expect(err).To(MatchError(models.ErrInvalidRequest))
+			Expect(resp).To(Equal(models.VerifyBankAccountResponse{}))
+		})
+
+		It("should return an error - invalid account type", func(ctx SpecContext) {
+			ba := sampleBankAccount
+			ba.Metadata = map[string]string{
+				models.BankAccountRoutingCodeMetadataKey: "200000",
+				bankAccountTypeMetadataKey:               "OTHER",
+			}
+
+			resp, err := plg.VerifyBankAccount(ctx, models.VerifyBankAccountRequest{BankAccount: ba})
+			Expect(err).ToNot(BeNil())
+			Expect(err).To(MatchError(models.ErrInvalidRequest))
+			Expect(resp).To(Equal(models.VerifyBankAccountResponse{}))
+		})
+
+		It("should return an error - check account name error", func(ctx SpecContext) {
+			m.EXPECT().CheckAccountName(gomock.Any(), gomock.Any()).Return(nil, errors.New("test error"))
+
+			resp, err := plg.VerifyBankAccount(ctx, models.VerifyBankAccountRequest{BankAccount: sampleBankAccount})
+			Expect(err).ToNot(BeNil())
+			Expect(err).To(MatchError("test error"))
+			Expect(resp).To(Equal(models.VerifyBankAccountResponse{}))
+		})
+
+		It("should be ok - match", func(ctx SpecContext) {
+			m.EXPECT().CheckAccountName(gomock.Any(), &client.AccountNameCheckRequest{
+				SortCode:      "200000",
+				AccountNumber: "12345678",
+				Name:          "John Doe",
+				AccountType:   client.AccountNameCheckAccountTypePersonal,
+			}).Return(&client.AccountNameCheckResponse{
+				ID:     "check1",
+				Result: client.AccountNameCheckResult{Code: client.AccountNameCheckResultMatch},
+			}, nil)
+
+			resp, err := plg.VerifyBankAccount(ctx, models.VerifyBankAccountRequest{BankAccount: sampleBankAccount})
+			Expect(err).To(BeNil())
+			Expect(resp).To(Equal(models.VerifyBankAccountResponse{
+				Result: models.BANK_ACCOUNT_VERIFICATION_RESULT_MATCH,
+			}))
+		})
+
+		It("should be ok - close match on a business account", func(ctx SpecContext) {
+			ba := sampleBankAccount
+			ba.Metadata = map[string]string{
+				models.BankAccountRoutingCodeMetadataKey: "200000",
+				bankAccountTypeMetadataKey:               "BUSINESS",
+			}
+
+			m.EXPECT().CheckAccountName(gomock.Any(), &client.AccountNameCheckRequest{
+				SortCode:      "200000",
+				AccountNumber: "12345678",
+				Name:          "John Doe",
+				AccountType:   client.AccountNameCheckAccountTypeBusiness,
+			}).Return(&client.AccountNameCheckResponse{
+				ID:     "check1",
+				Result: client.AccountNameCheckResult{Code: client.AccountNameCheckResultCloseMatch, Name: "Jon Doe"},
+			}, nil)
+
+			resp, err := plg.VerifyBankAccount(ctx, models.VerifyBankAccountRequest{BankAccount: ba})
+			Expect(err).To(BeNil())
+			Expect(resp).To(Equal(models.VerifyBankAccountResponse{
+				Result:      models.BANK_ACCOUNT_VERIFICATION_RESULT_CLOSE_MATCH,
+				MatchedName: pointer.For("Jon Doe"),
+			}))
+		})
+
+		DescribeTable("should translate the result codes",
+			func(ctx SpecContext, code string, expected models.BankAccountVerificationResult) {
+				m.EXPECT().CheckAccountName(gomock.Any(), gomock.Any()).Return(&client.AccountNameCheckResponse{
+					Result: client.AccountNameCheckResult{Code: code},
+				}, nil)
+
+				resp, err := plg.VerifyBankAccount(ctx, models.VerifyBankAccountRequest{BankAccount: sampleBankAccount})
+				Expect(err).To(BeNil())
+				Expect(resp.Result).To(Equal(expected))
+			},
+			Entry("no match", client.AccountNameCheckResultNoMatch, models.BANK_ACCOUNT_VERIFICATION_RESULT_NO_MATCH),
+			Entry("account type mismatch", client.AccountNameCheckResultAccountTypeMismatch, models.BANK_ACCOUNT_VERIFICATION_RESULT_CLOSE_MATCH),
+			Entry("unknown account", "ACCOUNT_DOES_NOT_EXIST", models.BANK_ACCOUNT_VERIFICATION_RESULT_NO_MATCH),
+		)
+	})
+})
@@ -10,4 +10,7 @@ var capabilities = []models.Capability{
 
 	models.CAPABILITY_CREATE_TRANSFER,
 	models.CAPABILITY_CREATE_PAYOUT,
+
+	models.CAPABILITY_VERIFY_BANK_ACCOUNT,
+	models.CAPABILITY_CREATE_ACCOUNT,
 }
@@ -0,0 +1,71 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	errorsutils "github.com/formancehq/payments/pkg/domain/errors"
+	"github.com/formancehq/payments/pkg/domain/metrics"
+)
+
+type AccountNameCheckAccountType string
+
+const (
+	AccountNameCheckAccountTypePersonal AccountNameCheckAccountType = "PERSONAL"
+	AccountNameCheckAccountTypeBusiness AccountNameCheckAccountType = "BUSINESS"
+)
+
+// Result codes of the Confirmation of Payee scheme returned by Modulr.
+const (
+	AccountNameCheckResultMatch               = "MATCH"
+	AccountNameCheckResultCloseMatch          = "CLOSE_MATCH"
+	AccountNameCheckResultNoMatch             = "NO_MATCH"
+	AccountNameCheckResultAccountTypeMismatch = "ACCOUNT_TYPE_MISMATCH"
+)
+
+type AccountNameCheckRequest struct {
+	SortCode      string                      `json:"sortCode"`
+	AccountNumber string                      `json:"accountNumber"`
+	Name          string                      `json:"name"`
+	AccountType   AccountNameCheckAccountType `json:"accountType"`
+}
+
+type AccountNameCheckResult struct {
+	Code string `json:"code"`
+	// Name of the account holder, returned on close matches
+	Name string `json:"name"`
+}
+
+type AccountNameCheckResponse struct {
+	ID     string                 `json:"id"`
+	Result AccountNameCheckResult `json:"result"`
+}
+
+func (c *client) CheckAccountName(ctx context.Context, checkRequest *AccountNameCheckRequest) (*AccountNameCheckResponse, error) {
+	ctx = context.WithValue(ctx, metrics.MetricOperationContextKey, "check_account_name")
+
+	body, err := json.Marshal(checkRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.buildEndpoint("account-name-check"), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create account name check request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	var res AccountNameCheckResponse
+	var errRes modulrErrors
+	_, err = c.httpClient.Do(ctx, req, &res, &errRes)
+	if err != nil {
+		return nil, errorsutils.NewWrappedError(
+			fmt.Errorf("failed to check account name: %v", errRes.Error()),
+			err,
+		)
+	}
+	return &res, nil
+}
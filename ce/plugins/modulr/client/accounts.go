@@ -1,7 +1,9 @@
 package client
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -77,3 +79,39 @@ func (c *client) GetAccount(ctx context.Context, accountID string) (*Account, er
 	}
 	return &res, nil
 }
+
+//nolint:tagliatelle // allow for clients
+type CreateAccountRequest struct {
+	IdempotencyKey    string `json:"-"`
+	Currency          string `json:"currency"`
+	ExternalReference string `json:"externalReference,omitempty"`
+	Name              string `json:"name,omitempty"`
+	ProductCode       string `json:"productCode,omitempty"`
+}
+
+func (c *client) CreateAccount(ctx context.Context, customerID string, createRequest *CreateAccountRequest) (*Account, error) {
+	ctx = context.WithValue(ctx, metrics.MetricOperationContextKey, "create_account")
+
+	body, err := json.Marshal(createRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.buildEndpoint("customers/%s/accounts", customerID), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create account request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("x-mod-nonce", createRequest.IdempotencyKey)
+
+	var res Account
+	var errRes modulrErrors
+	_, err = c.httpClient.Do(ctx, req, &res, &errRes)
+	if err != nil {
+		return nil, errorsutils.NewWrappedError(
+			fmt.Errorf("failed to create account: %v", errRes.Error()),
+			err,
+		)
+	}
+	return &res, nil
+}
@@ -17,6 +17,7 @@ import (
 type Client interface {
 	GetAccounts(ctx context.Context, page, pageSize int, fromCreatedAt time.Time) ([]Account, error)
 	GetAccount(ctx context.Context, accountID string) (*Account, error)
+	CreateAccount(ctx context.Context, customerID string, createRequest *CreateAccountRequest) (*Account, error)
 	GetBeneficiaries(ctx context.Context, page, pageSize int, modifiedSince time.Time) ([]Beneficiary, error)
 	GetPayments(ctx context.Context, paymentType PaymentType, page, pageSize int, modifiedSince time.Time) ([]Payment, error)
 	InitiatePayout(ctx context.Context, payoutRequest *PayoutRequest) (*PayoutResponse, error)
@@ -24,6 +25,7 @@ type Client interface {
 	GetTransactions(ctx context.Context, accountID string, page, pageSize int, fromPostedDate, toPostedDate time.Time) ([]Transaction, int, error)
 	InitiateTransfer(ctx context.Context, transferRequest *TransferRequest) (*TransferResponse, error)
 	GetTransfer(ctx context.Context, transferID string) (TransferResponse, error)
+	CheckAccountName(ctx context.Context, checkRequest *AccountNameCheckRequest) (*AccountNameCheckResponse, error)
 }
 
 type apiTransport struct {
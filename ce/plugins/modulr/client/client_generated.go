@@ -41,6 +41,36 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// CheckAccountName mocks base method.
+func (m *MockClient) CheckAccountName(ctx context.Context, checkRequest *AccountNameCheckRequest) (*AccountNameCheckResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAccountName", ctx, checkRequest)
+	ret0, _ := ret[0].(*AccountNameCheckResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckAccountName indicates an expected call of CheckAccountName.
+func (mr *MockClientMockRecorder) CheckAccountName(ctx, checkRequest any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAccountName", reflect.TypeOf((*MockClient)(nil).CheckAccountName), ctx, checkRequest)
+}
+
+// CreateAccount mocks base method.
+func (m *MockClient) CreateAccount(ctx context.Context, customerID string, createRequest *CreateAccountRequest) (*Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccount", ctx, customerID, createRequest)
+	ret0, _ := ret[0].(*Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccount indicates an expected call of CreateAccount.
+func (mr *MockClientMockRecorder) CreateAccount(ctx, customerID, createRequest any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockClient)(nil).CreateAccount), ctx, customerID, createRequest)
+}
+
 // GetAccount mocks base method.
 func (m *MockClient) GetAccount(ctx context.Context, accountID string) (*Account, error) {
 	m.ctrl.T.Helper()
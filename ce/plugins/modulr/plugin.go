@@ -126,4 +126,20 @@ func (p *Plugin) CreatePayout(ctx context.Context, req models.CreatePayoutReques
 	}, nil
 }
 
+func (p *Plugin) VerifyBankAccount(ctx context.Context, req models.VerifyBankAccountRequest) (models.VerifyBankAccountResponse, error) {
+	if p.client == nil {
+		return models.VerifyBankAccountResponse{}, pkgplugins.ErrNotYetInstalled
+	}
+	return p.verifyBankAccount(ctx, req.BankAccount)
+}
+
+func (p *Plugin) CreateAccount(ctx context.Context, req models.CreateAccountRequest) (models.CreateAccountResponse, error) {
+	if p.client == nil {
+		return models.CreateAccountResponse{}, pkgplugins.ErrNotYetInstalled
+	}
+	return p.createAccount(ctx, req)
+}
+
 var _ models.Plugin = &Plugin{}
+var _ models.PluginWithBankAccountVerification = &Plugin{}
+var _ models.PluginWithAccountCreation = &Plugin{}
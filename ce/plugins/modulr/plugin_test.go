@@ -160,6 +160,26 @@ var _ = Describe("Modulr Plugin", func() {
 		// Other tests will be in payouts_test.go
 	})
 
+	Context("verify bank account", func() {
+		It("should fail when called before install", func(ctx SpecContext) {
+			req := models.VerifyBankAccountRequest{}
+			_, err := plg.VerifyBankAccount(ctx, req)
+			Expect(err).To(MatchError(plugins.ErrNotYetInstalled))
+		})
+
+		// Other tests will be in bank_account_verification_test.go
+	})
+
+	Context("create account", func() {
+		It("should fail when called before install", func(ctx SpecContext) {
+			req := models.CreateAccountRequest{}
+			_, err := plg.CreateAccount(ctx, req)
+			Expect(err).To(MatchError(plugins.ErrNotYetInstalled))
+		})
+
+		// Other tests will be in account_creation_test.go
+	})
+
 	Context("reverse payout", func() {
 		It("should fail because not implemented", func(ctx SpecContext) {
 			req := models.ReversePayoutRequest{}
@@ -14,6 +14,11 @@ import (
 )
 
 func (p *Plugin) fetchNextAccounts(ctx context.Context, req models.FetchNextAccountsRequest) (models.FetchNextAccountsResponse, error) {
+	if req.FromPayload == nil && p.config.IsTransferEnabled {
+		// Periodic fetch, only scheduled when Plaid Transfer is enabled
+		return p.fetchTransferLedgerAccount(ctx)
+	}
+
 	var from models.OpenBankingForwardedUserFromPayload
 	if err := json.Unmarshal(req.FromPayload, &from); err != nil {
 		return models.FetchNextAccountsResponse{}, err
@@ -56,7 +61,7 @@ func translatePlaidAccountToPSPAccount(account plaid.AccountBase, psuID uuid.UUI
 		PsuID:                   &psuID,
 		OpenBankingConnectionID: &connectionID,
 		Metadata: map[string]string{
-			"accountType": string(account.Type),
+			accountTypeMetadataKey: string(account.Type),
 		},
 		Raw: raw,
 	}
@@ -19,6 +19,10 @@ func (p *Plugin) fetchNextBalances(ctx context.Context, req models.FetchNextBala
 		return models.FetchNextBalancesResponse{}, err
 	}
 
+	if pspAccount.Metadata[accountTypeMetadataKey] == transferLedgerAccountType {
+		return toTransferLedgerBalances(pspAccount)
+	}
+
 	pspBalance, err := toPSPBalance(pspAccount)
 	if err != nil {
 		if errors.Is(err, plugins.ErrCurrencyNotSupported) {
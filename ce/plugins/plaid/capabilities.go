@@ -7,6 +7,10 @@ var capabilities = []models.Capability{
 	models.CAPABILITY_FETCH_BALANCES,
 	models.CAPABILITY_FETCH_EXTERNAL_ACCOUNTS,
 	models.CAPABILITY_FETCH_PAYMENTS,
+
+	models.CAPABILITY_CREATE_TRANSFER,
+	models.CAPABILITY_CREATE_PAYOUT,
+
 	models.CAPABILITY_CREATE_WEBHOOKS,
 	models.CAPABILITY_TRANSLATE_WEBHOOKS,
 }
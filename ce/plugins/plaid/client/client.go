@@ -25,6 +25,10 @@ type Client interface {
 	FormanceOpenBankingRedirect(ctx context.Context, req FormanceOpenBankingRedirectRequest) error
 	ListAccounts(ctx context.Context, accessToken string) (plaid.AccountsGetResponse, error)
 	ListTransactions(ctx context.Context, accessToken string, cursor string, pageSize int) (plaid.TransactionsSyncResponse, error)
+	CreateTransferAuthorization(ctx context.Context, req CreateTransferAuthorizationRequest) (plaid.TransferAuthorization, error)
+	CreateTransfer(ctx context.Context, req CreateTransferRequest) (plaid.Transfer, error)
+	SyncTransferEvents(ctx context.Context, afterID int32, pageSize int) (plaid.TransferEventSyncResponse, error)
+	GetTransferLedger(ctx context.Context) (plaid.TransferLedgerGetResponse, error)
 	TranslateItemAddResultWebhook(body []byte) (plaid.ItemAddResultWebhook, error)
 	TranslateSessionFinishedWebhook(body []byte) (plaid.LinkSessionFinishedWebhook, error)
 	TranslateUserPendingDisconnectWebhook(body []byte) (plaid.PendingDisconnectWebhook, error)
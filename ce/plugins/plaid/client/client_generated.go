@@ -71,6 +71,36 @@ func (mr *MockClientMockRecorder) CreateLinkToken(ctx, req any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLinkToken", reflect.TypeOf((*MockClient)(nil).CreateLinkToken), ctx, req)
 }
 
+// CreateTransfer mocks base method.
+func (m *MockClient) CreateTransfer(ctx context.Context, req CreateTransferRequest) (plaid.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransfer", ctx, req)
+	ret0, _ := ret[0].(plaid.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransfer indicates an expected call of CreateTransfer.
+func (mr *MockClientMockRecorder) CreateTransfer(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransfer", reflect.TypeOf((*MockClient)(nil).CreateTransfer), ctx, req)
+}
+
+// CreateTransferAuthorization mocks base method.
+func (m *MockClient) CreateTransferAuthorization(ctx context.Context, req CreateTransferAuthorizationRequest) (plaid.TransferAuthorization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransferAuthorization", ctx, req)
+	ret0, _ := ret[0].(plaid.TransferAuthorization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransferAuthorization indicates an expected call of CreateTransferAuthorization.
+func (mr *MockClientMockRecorder) CreateTransferAuthorization(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransferAuthorization", reflect.TypeOf((*MockClient)(nil).CreateTransferAuthorization), ctx, req)
+}
+
 // CreateUser mocks base method.
 func (m *MockClient) CreateUser(ctx context.Context, userID string) (string, error) {
 	m.ctrl.T.Helper()
@@ -143,6 +173,21 @@ func (mr *MockClientMockRecorder) FormanceOpenBankingRedirect(ctx, req any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FormanceOpenBankingRedirect", reflect.TypeOf((*MockClient)(nil).FormanceOpenBankingRedirect), ctx, req)
 }
 
+// GetTransferLedger mocks base method.
+func (m *MockClient) GetTransferLedger(ctx context.Context) (plaid.TransferLedgerGetResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferLedger", ctx)
+	ret0, _ := ret[0].(plaid.TransferLedgerGetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransferLedger indicates an expected call of GetTransferLedger.
+func (mr *MockClientMockRecorder) GetTransferLedger(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferLedger", reflect.TypeOf((*MockClient)(nil).GetTransferLedger), ctx)
+}
+
 // GetWebhookVerificationKey mocks base method.
 func (m *MockClient) GetWebhookVerificationKey(ctx context.Context, kid string) (*plaid.JWKPublicKey, error) {
 	m.ctrl.T.Helper()
@@ -188,6 +233,21 @@ func (mr *MockClientMockRecorder) ListTransactions(ctx, accessToken, cursor, pag
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransactions", reflect.TypeOf((*MockClient)(nil).ListTransactions), ctx, accessToken, cursor, pageSize)
 }
 
+// SyncTransferEvents mocks base method.
+func (m *MockClient) SyncTransferEvents(ctx context.Context, afterID int32, pageSize int) (plaid.TransferEventSyncResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncTransferEvents", ctx, afterID, pageSize)
+	ret0, _ := ret[0].(plaid.TransferEventSyncResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncTransferEvents indicates an expected call of SyncTransferEvents.
+func (mr *MockClientMockRecorder) SyncTransferEvents(ctx, afterID, pageSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncTransferEvents", reflect.TypeOf((*MockClient)(nil).SyncTransferEvents), ctx, afterID, pageSize)
+}
+
 // TranslateItemAddResultWebhook mocks base method.
 func (m *MockClient) TranslateItemAddResultWebhook(body []byte) (plaid.ItemAddResultWebhook, error) {
 	m.ctrl.T.Helper()
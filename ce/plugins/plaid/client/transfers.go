@@ -0,0 +1,115 @@
+package client
+
+import (
+	"context"
+
+	"github.com/formancehq/payments/pkg/domain/metrics"
+	"github.com/plaid/plaid-go/v34/plaid"
+)
+
+type CreateTransferAuthorizationRequest struct {
+	AccessToken    string
+	AccountID      string
+	Type           plaid.TransferType
+	Amount         string
+	Currency       string
+	LegalName      string
+	EmailAddress   *string
+	PhoneNumber    *string
+	IdempotencyKey string
+}
+
+func (c *client) CreateTransferAuthorization(ctx context.Context, req CreateTransferAuthorizationRequest) (plaid.TransferAuthorization, error) {
+	ctx = context.WithValue(ctx, metrics.MetricOperationContextKey, "create_transfer_authorization")
+
+	user := plaid.NewTransferAuthorizationUserInRequest(req.LegalName)
+	if req.EmailAddress != nil {
+		user.SetEmailAddress(*req.EmailAddress)
+	}
+	if req.PhoneNumber != nil {
+		user.SetPhoneNumber(*req.PhoneNumber)
+	}
+
+	request := plaid.NewTransferAuthorizationCreateRequest(
+		req.AccessToken,
+		req.AccountID,
+		req.Type,
+		plaid.TRANSFERNETWORK_ACH,
+		req.Amount,
+		*user,
+	)
+	request.SetAchClass(plaid.ACHCLASS_PPD)
+	request.SetIsoCurrencyCode(req.Currency)
+	if req.IdempotencyKey != "" {
+		request.SetIdempotencyKey(req.IdempotencyKey)
+	}
+
+	resp, _, err := c.client.PlaidApi.TransferAuthorizationCreate(ctx).TransferAuthorizationCreateRequest(*request).Execute()
+	if err != nil {
+		return plaid.TransferAuthorization{}, wrapSDKError(err)
+	}
+
+	return resp.Authorization, nil
+}
+
+type CreateTransferRequest struct {
+	AccessToken     string
+	AccountID       string
+	AuthorizationID string
+	Amount          string
+	Currency        string
+	Description     string
+	Metadata        map[string]string
+}
+
+func (c *client) CreateTransfer(ctx context.Context, req CreateTransferRequest) (plaid.Transfer, error) {
+	ctx = context.WithValue(ctx, metrics.MetricOperationContextKey, "create_transfer")
+
+	request := plaid.NewTransferCreateRequest(
+		req.AccessToken,
+		req.AccountID,
+		req.AuthorizationID,
+		req.Description,
+	)
+	request.SetAmount(req.Amount)
+	request.SetIsoCurrencyCode(req.Currency)
+	if len(req.Metadata) > 0 {
+		request.SetMetadata(req.Metadata)
+	}
+
+	resp, _, err := c.client.PlaidApi.TransferCreate(ctx).TransferCreateRequest(*request).Execute()
+	if err != nil {
+		return plaid.Transfer{}, wrapSDKError(err)
+	}
+
+	return resp.Transfer, nil
+}
+
+func (c *client) SyncTransferEvents(ctx context.Context, afterID int32, pageSize int) (plaid.TransferEventSyncResponse, error) {
+	ctx = context.WithValue(ctx, metrics.MetricOperationContextKey, "sync_transfer_events")
+
+	request := plaid.NewTransferEventSyncRequest(afterID)
+	if pageSize > 0 {
+		request.SetCount(int32(pageSize))
+	}
+
+	resp, _, err := c.client.PlaidApi.TransferEventSync(ctx).TransferEventSyncRequest(*request).Execute()
+	if err != nil {
+		return plaid.TransferEventSyncResponse{}, wrapSDKError(err)
+	}
+
+	return resp, nil
+}
+
+func (c *client) GetTransferLedger(ctx context.Context) (plaid.TransferLedgerGetResponse, error) {
+	ctx = context.WithValue(ctx, metrics.MetricOperationContextKey, "get_transfer_ledger")
+
+	request := plaid.NewTransferLedgerGetRequest()
+
+	resp, _, err := c.client.PlaidApi.TransferLedgerGet(ctx).TransferLedgerGetRequest(*request).Execute()
+	if err != nil {
+		return plaid.TransferLedgerGetResponse{}, wrapSDKError(err)
+	}
+
+	return resp, nil
+}
@@ -62,8 +62,12 @@ var (
 
 type Config struct {
 	ClientID     string `json:"clientID" validate:"required"`
-	ClientSecret string `json:"clientSecret" validate:"required"`
+	ClientSecret string `json:"clientSecret" validate:"required" sensitive:"true"`
 	IsSandbox    bool   `json:"isSandbox" validate:""`
+	// IsTransferEnabled must only be set when the Plaid Transfer product is
+	// enabled on the client account, otherwise ledger and transfer events
+	// fetching will fail.
+	IsTransferEnabled bool `json:"isTransferEnabled" validate:""`
 }
 
 func unmarshalAndValidateConfig(payload json.RawMessage) (Config, error) {
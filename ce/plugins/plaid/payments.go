@@ -19,6 +19,11 @@ type paymentsState struct {
 }
 
 func (p *Plugin) fetchNextPayments(ctx context.Context, req models.FetchNextPaymentsRequest) (models.FetchNextPaymentsResponse, error) {
+	if req.FromPayload == nil && p.config.IsTransferEnabled {
+		// Periodic fetch, only scheduled when Plaid Transfer is enabled
+		return p.fetchNextTransferEvents(ctx, req)
+	}
+
 	var oldState paymentsState
 	if req.State != nil {
 		if err := json.Unmarshal(req.State, &oldState); err != nil {
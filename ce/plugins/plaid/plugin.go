@@ -13,7 +13,7 @@ import (
 const ProviderName = "plaid"
 
 var Registration = pkgplugins.Registration{
-	PluginType: models.PluginTypeOpenBanking,
+	PluginType: models.PluginTypeBoth,
 	CreateFunc: func(connectorID models.ConnectorID, name string, logger logging.Logger, rm json.RawMessage) (models.Plugin, error) {
 		return New(name, logger, connectorID, rm)
 	},
@@ -69,7 +69,7 @@ func (p *Plugin) Config() models.PluginInternalConfig {
 
 func (p *Plugin) Install(ctx context.Context, req models.InstallRequest) (models.InstallResponse, error) {
 	return models.InstallResponse{
-		Workflow: workflow(),
+		Workflow: workflow(p.config),
 	}, nil
 }
 
@@ -100,6 +100,36 @@ func (p *Plugin) FetchNextPayments(ctx context.Context, req models.FetchNextPaym
 	return p.fetchNextPayments(ctx, req)
 }
 
+func (p *Plugin) CreateTransfer(ctx context.Context, req models.CreateTransferRequest) (models.CreateTransferResponse, error) {
+	if p.client == nil {
+		return models.CreateTransferResponse{}, pkgplugins.ErrNotYetInstalled
+	}
+
+	payment, err := p.createTransfer(ctx, req)
+	if err != nil {
+		return models.CreateTransferResponse{}, err
+	}
+
+	return models.CreateTransferResponse{
+		Payment: payment,
+	}, nil
+}
+
+func (p *Plugin) CreatePayout(ctx context.Context, req models.CreatePayoutRequest) (models.CreatePayoutResponse, error) {
+	if p.client == nil {
+		return models.CreatePayoutResponse{}, pkgplugins.ErrNotYetInstalled
+	}
+
+	payment, err := p.createPayout(ctx, req)
+	if err != nil {
+		return models.CreatePayoutResponse{}, err
+	}
+
+	return models.CreatePayoutResponse{
+		Payment: payment,
+	}, nil
+}
+
 func (p *Plugin) CreateUser(ctx context.Context, req models.CreateUserRequest) (models.CreateUserResponse, error) {
 	if p.client == nil {
 		return models.CreateUserResponse{}, pkgplugins.ErrNotYetInstalled
@@ -49,6 +49,22 @@ var _ = Describe("Plaid *Plugin", func() {
 			Expect(err).To(BeNil())
 			Expect(res.Workflow).ToNot(BeEmpty())
 		})
+
+		It("returns transfer fetching tasks when transfer is enabled", func(ctx SpecContext) {
+			connectorID := models.ConnectorID{Reference: uuid.New(), Provider: "plaid"}
+			config := json.RawMessage(`{"clientID":"1234","clientSecret":"abc123","isSandbox":true,"isTransferEnabled":true}`)
+			p, err := plaid.New("plaid", logger, connectorID, config)
+			Expect(err).To(BeNil())
+			res, err := p.Install(context.Background(), models.InstallRequest{})
+			Expect(err).To(BeNil())
+			Expect(res.Workflow).To(HaveLen(3))
+			Expect(res.Workflow[1].TaskType).To(Equal(models.TASK_FETCH_ACCOUNTS))
+			Expect(res.Workflow[1].Periodically).To(BeTrue())
+			Expect(res.Workflow[1].NextTasks).To(HaveLen(1))
+			Expect(res.Workflow[1].NextTasks[0].TaskType).To(Equal(models.TASK_FETCH_BALANCES))
+			Expect(res.Workflow[2].TaskType).To(Equal(models.TASK_FETCH_PAYMENTS))
+			Expect(res.Workflow[2].Periodically).To(BeTrue())
+		})
 	})
 
 	Context("uninstall", func() {
@@ -76,6 +92,18 @@ var _ = Describe("Plaid *Plugin", func() {
 			Expect(err).To(MatchError(plugins.ErrNotYetInstalled))
 		})
 
+		It("fails when create transfer is called before install", func(ctx SpecContext) {
+			req := models.CreateTransferRequest{}
+			_, err := plg.CreateTransfer(context.Background(), req)
+			Expect(err).To(MatchError(plugins.ErrNotYetInstalled))
+		})
+
+		It("fails when create payout is called before install", func(ctx SpecContext) {
+			req := models.CreatePayoutRequest{}
+			_, err := plg.CreatePayout(context.Background(), req)
+			Expect(err).To(MatchError(plugins.ErrNotYetInstalled))
+		})
+
 		It("fails when create user is called before install", func(ctx SpecContext) {
 			req := models.CreateUserRequest{}
 			_, err := plg.CreateUser(context.Background(), req)
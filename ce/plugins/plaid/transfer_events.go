@@ -0,0 +1,128 @@
+package plaid
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/plaid/plaid-go/v34/plaid"
+)
+
+type transferEventsState struct {
+	LastEventID int32 `json:"lastEventID"`
+}
+
+// fetchNextTransferEvents syncs the Plaid Transfer events. Every event is
+// translated into a payment adjustment of the related transfer, which will in
+// turn update the related payment initiation.
+func (p *Plugin) fetchNextTransferEvents(ctx context.Context, req models.FetchNextPaymentsRequest) (models.FetchNextPaymentsResponse, error) {
+	var oldState transferEventsState
+	if req.State != nil {
+		if err := json.Unmarshal(req.State, &oldState); err != nil {
+			return models.FetchNextPaymentsResponse{}, err
+		}
+	}
+
+	newState := transferEventsState{
+		LastEventID: oldState.LastEventID,
+	}
+
+	resp, err := p.client.SyncTransferEvents(ctx, oldState.LastEventID, req.PageSize)
+	if err != nil {
+		return models.FetchNextPaymentsResponse{}, err
+	}
+
+	payments := make([]models.PSPPayment, 0, len(resp.TransferEvents))
+	for _, event := range resp.TransferEvents {
+		if event.EventId > newState.LastEventID {
+			newState.LastEventID = event.EventId
+		}
+
+		payment, ok, err := translateTransferEventToPSPPayment(event)
+		if err != nil {
+			return models.FetchNextPaymentsResponse{}, err
+		}
+
+		if !ok {
+			continue
+		}
+
+		payments = append(payments, payment)
+	}
+
+	payload, err := json.Marshal(newState)
+	if err != nil {
+		return models.FetchNextPaymentsResponse{}, err
+	}
+
+	return models.FetchNextPaymentsResponse{
+		Payments: payments,
+		NewState: payload,
+		HasMore:  resp.HasMore,
+	}, nil
+}
+
+func translateTransferEventToPSPPayment(event plaid.TransferEvent) (models.PSPPayment, bool, error) {
+	// Sweep events have no transfer ID, and refund events are related to a
+	// refund of the transfer, not the transfer itself.
+	if event.TransferId == "" || event.GetRefundId() != "" {
+		return models.PSPPayment{}, false, nil
+	}
+
+	status, ok := transferEventTypeToPaymentStatus(event.EventType)
+	if !ok {
+		return models.PSPPayment{}, false, nil
+	}
+
+	amount, asset, err := translateTransferAmount(event.GetTransferAmount(), transferCurrency)
+	if err != nil {
+		return models.PSPPayment{}, false, err
+	}
+
+	raw, err := json.Marshal(event)
+	if err != nil {
+		return models.PSPPayment{}, false, err
+	}
+
+	var ledgerID *string
+	if event.LedgerId.IsSet() {
+		ledgerID = event.LedgerId.Get()
+	}
+
+	payment := models.PSPPayment{
+		Reference: event.TransferId,
+		CreatedAt: event.Timestamp.UTC(),
+		Amount:    amount,
+		Asset:     asset,
+		Status:    status,
+		Raw:       raw,
+	}
+	fillTransferPaymentTypeAndAccounts(&payment, string(event.GetTransferType()), event.AccountId, ledgerID)
+
+	if failure, ok := event.GetFailureReasonOk(); ok && failure != nil && failure.Description != nil {
+		payment.Metadata = map[string]string{
+			"failureReason": *failure.Description,
+		}
+	}
+
+	return payment, true, nil
+}
+
+func transferEventTypeToPaymentStatus(eventType plaid.TransferEventType) (models.PaymentStatus, bool) {
+	switch eventType {
+	case plaid.TRANSFEREVENTTYPE_PENDING:
+		return models.PAYMENT_STATUS_PENDING, true
+	case plaid.TRANSFEREVENTTYPE_POSTED,
+		plaid.TRANSFEREVENTTYPE_SETTLED,
+		plaid.TRANSFEREVENTTYPE_FUNDS_AVAILABLE:
+		return models.PAYMENT_STATUS_SUCCEEDED, true
+	case plaid.TRANSFEREVENTTYPE_CANCELLED:
+		return models.PAYMENT_STATUS_CANCELLED, true
+	case plaid.TRANSFEREVENTTYPE_FAILED,
+		plaid.TRANSFEREVENTTYPE_RETURNED:
+		return models.PAYMENT_STATUS_FAILED, true
+	default:
+		// Sweep and refund events do not change the status of the transfer
+		return models.PAYMENT_STATUS_UNKNOWN, false
+	}
+}
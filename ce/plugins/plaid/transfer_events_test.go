@@ -0,0 +1,108 @@
+package plaid
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/payments/ce/plugins/plaid/client"
+	"github.com/formancehq/payments/pkg/domain/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/plaid/plaid-go/v34/plaid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Plaid *Plugin Transfer Events", func() {
+	Context("fetch next payments without payload", func() {
+		var (
+			ctrl *gomock.Controller
+			m    *client.MockClient
+			plg  models.Plugin
+			now  time.Time
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			m = client.NewMockClient(ctrl)
+			plg = &Plugin{client: m, config: Config{IsTransferEnabled: true}}
+			now = time.Now().UTC().Truncate(time.Second)
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		newEvent := func(id int32, transferID string, eventType plaid.TransferEventType, transferType plaid.OmittableTransferType) plaid.TransferEvent {
+			event := plaid.NewTransferEventWithDefaults()
+			event.SetEventId(id)
+			event.SetTimestamp(now.Add(time.Duration(id) * time.Minute))
+			event.SetEventType(eventType)
+			event.SetTransferId(transferID)
+			if transferID != "" {
+				event.SetAccountId("account_1")
+				event.SetLedgerId("ledger_1")
+				event.SetTransferType(transferType)
+				event.SetTransferAmount("10.50")
+			}
+			return *event
+		}
+
+		It("should return an error - sync transfer events error", func(ctx SpecContext) {
+			m.EXPECT().SyncTransferEvents(gomock.Any(), int32(0), 10).Return(
+				plaid.TransferEventSyncResponse{},
+				errors.New("test error"),
+			)
+
+			resp, err := plg.FetchNextPayments(ctx, models.FetchNextPaymentsRequest{PageSize: 10})
+			Expect(err).To(MatchError("test error"))
+			Expect(resp).To(Equal(models.FetchNextPaymentsResponse{}))
+		})
+
+		It("should translate transfer events into payments", func(ctx SpecContext) {
+			failed := newEvent(4, "transfer_2", plaid.TRANSFEREVENTTYPE_RETURNED, plaid.OMITTABLETRANSFERTYPE_CREDIT)
+			failed.SetFailureReason(plaid.TransferFailure{Description: pointer.For("account closed")})
+
+			m.EXPECT().SyncTransferEvents(gomock.Any(), int32(2), 10).Return(
+				plaid.TransferEventSyncResponse{
+					TransferEvents: []plaid.TransferEvent{
+						newEvent(3, "transfer_1", plaid.TRANSFEREVENTTYPE_SETTLED, plaid.OMITTABLETRANSFERTYPE_DEBIT),
+						failed,
+						newEvent(5, "", plaid.TRANSFEREVENTTYPE_SWEEP_SETTLED, ""),
+						newEvent(6, "transfer_1", plaid.TRANSFEREVENTTYPE_SWEPT, plaid.OMITTABLETRANSFERTYPE_DEBIT),
+					},
+					HasMore: true,
+				},
+				nil,
+			)
+
+			resp, err := plg.FetchNextPayments(ctx, models.FetchNextPaymentsRequest{
+				State:    json.RawMessage(`{"lastEventID":2}`),
+				PageSize: 10,
+			})
+			Expect(err).To(BeNil())
+			Expect(resp.HasMore).To(BeTrue())
+			Expect(resp.Payments).To(HaveLen(2))
+
+			Expect(resp.Payments[0].Reference).To(Equal("transfer_1"))
+			Expect(resp.Payments[0].Type).To(Equal(models.PAYMENT_TYPE_PAYIN))
+			Expect(resp.Payments[0].Status).To(Equal(models.PAYMENT_STATUS_SUCCEEDED))
+			Expect(resp.Payments[0].Amount).To(Equal(big.NewInt(1050)))
+			Expect(resp.Payments[0].Asset).To(Equal("USD/2"))
+			Expect(resp.Payments[0].CreatedAt).To(Equal(now.Add(3 * time.Minute)))
+			Expect(resp.Payments[0].SourceAccountReference).To(Equal(pointer.For("account_1")))
+			Expect(resp.Payments[0].DestinationAccountReference).To(Equal(pointer.For("ledger_1")))
+
+			Expect(resp.Payments[1].Reference).To(Equal("transfer_2"))
+			Expect(resp.Payments[1].Type).To(Equal(models.PAYMENT_TYPE_PAYOUT))
+			Expect(resp.Payments[1].Status).To(Equal(models.PAYMENT_STATUS_FAILED))
+			Expect(resp.Payments[1].Metadata).To(HaveKeyWithValue("failureReason", "account closed"))
+
+			var state transferEventsState
+			Expect(json.Unmarshal(resp.NewState, &state)).To(BeNil())
+			Expect(state.LastEventID).To(Equal(int32(6)))
+		})
+	})
+})
@@ -0,0 +1,72 @@
+package plaid
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/types/currency"
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/plaid/plaid-go/v34/plaid"
+)
+
+const (
+	accountTypeMetadataKey    = "accountType"
+	transferLedgerAccountType = "transfer_ledger"
+)
+
+// fetchTransferLedgerAccount returns the Plaid Ledger used to fund the
+// transfers as an internal account. Its balance is fetched afterwards from
+// the raw ledger.
+func (p *Plugin) fetchTransferLedgerAccount(ctx context.Context) (models.FetchNextAccountsResponse, error) {
+	ledger, err := p.client.GetTransferLedger(ctx)
+	if err != nil {
+		return models.FetchNextAccountsResponse{}, err
+	}
+
+	raw, err := json.Marshal(ledger)
+	if err != nil {
+		return models.FetchNextAccountsResponse{}, err
+	}
+
+	name := ledger.Name
+	return models.FetchNextAccountsResponse{
+		Accounts: []models.PSPAccount{
+			{
+				Reference:    ledger.LedgerId,
+				CreatedAt:    time.Now().UTC(),
+				Name:         &name,
+				DefaultAsset: pointer.For(currency.FormatAsset(supportedCurrenciesWithDecimal, transferCurrency)),
+				Metadata: map[string]string{
+					accountTypeMetadataKey: transferLedgerAccountType,
+				},
+				Raw: raw,
+			},
+		},
+		HasMore: false,
+	}, nil
+}
+
+func toTransferLedgerBalances(pspAccount models.PSPAccount) (models.FetchNextBalancesResponse, error) {
+	var ledger plaid.TransferLedgerGetResponse
+	if err := json.Unmarshal(pspAccount.Raw, &ledger); err != nil {
+		return models.FetchNextBalancesResponse{}, err
+	}
+
+	amount, asset, err := translateTransferAmount(ledger.Balance.Available, transferCurrency)
+	if err != nil {
+		return models.FetchNextBalancesResponse{}, err
+	}
+
+	return models.FetchNextBalancesResponse{
+		Balances: []models.PSPBalance{
+			{
+				AccountReference: pspAccount.Reference,
+				CreatedAt:        time.Now().UTC(),
+				Amount:           amount,
+				Asset:            asset,
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,92 @@
+package plaid
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/payments/ce/plugins/plaid/client"
+	"github.com/formancehq/payments/pkg/domain/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/plaid/plaid-go/v34/plaid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Plaid *Plugin Transfer Ledger", func() {
+	var (
+		ctrl *gomock.Controller
+		m    *client.MockClient
+		plg  models.Plugin
+
+		ledger plaid.TransferLedgerGetResponse
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		m = client.NewMockClient(ctrl)
+		plg = &Plugin{client: m, config: Config{IsTransferEnabled: true}}
+
+		ledger = plaid.TransferLedgerGetResponse{
+			LedgerId: "ledger_1",
+			Name:     "Default ledger",
+			Balance: plaid.TransferLedgerBalance{
+				Available: "1200.25",
+				Pending:   "10.00",
+			},
+			IsDefault: true,
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("fetch next accounts without payload", func() {
+		It("should return an error - get transfer ledger error", func(ctx SpecContext) {
+			m.EXPECT().GetTransferLedger(gomock.Any()).Return(plaid.TransferLedgerGetResponse{}, errors.New("test error"))
+
+			resp, err := plg.FetchNextAccounts(ctx, models.FetchNextAccountsRequest{PageSize: 10})
+			Expect(err).To(MatchError("test error"))
+			Expect(resp).To(Equal(models.FetchNextAccountsResponse{}))
+		})
+
+		It("should return the ledger as an account", func(ctx SpecContext) {
+			m.EXPECT().GetTransferLedger(gomock.Any()).Return(ledger, nil)
+
+			resp, err := plg.FetchNextAccounts(ctx, models.FetchNextAccountsRequest{PageSize: 10})
+			Expect(err).To(BeNil())
+			Expect(resp.HasMore).To(BeFalse())
+			Expect(resp.Accounts).To(HaveLen(1))
+			Expect(resp.Accounts[0].Reference).To(Equal("ledger_1"))
+			Expect(resp.Accounts[0].Name).To(Equal(pointer.For("Default ledger")))
+			Expect(resp.Accounts[0].DefaultAsset).To(Equal(pointer.For("USD/2")))
+			Expect(resp.Accounts[0].PsuID).To(BeNil())
+			Expect(resp.Accounts[0].Metadata).To(HaveKeyWithValue(accountTypeMetadataKey, transferLedgerAccountType))
+		})
+	})
+
+	Context("fetch next balances of the ledger", func() {
+		It("should return the available balance", func(ctx SpecContext) {
+			raw, err := json.Marshal(ledger)
+			Expect(err).To(BeNil())
+
+			fromPayload, err := json.Marshal(models.PSPAccount{
+				Reference: "ledger_1",
+				Metadata: map[string]string{
+					accountTypeMetadataKey: transferLedgerAccountType,
+				},
+				Raw: raw,
+			})
+			Expect(err).To(BeNil())
+
+			resp, err := plg.FetchNextBalances(ctx, models.FetchNextBalancesRequest{FromPayload: fromPayload})
+			Expect(err).To(BeNil())
+			Expect(resp.Balances).To(HaveLen(1))
+			Expect(resp.Balances[0].AccountReference).To(Equal("ledger_1"))
+			Expect(resp.Balances[0].Amount).To(Equal(big.NewInt(120025)))
+			Expect(resp.Balances[0].Asset).To(Equal("USD/2"))
+		})
+	})
+})
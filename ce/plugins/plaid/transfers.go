@@ -0,0 +1,280 @@
+package plaid
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/formancehq/go-libs/v5/pkg/types/currency"
+	"github.com/formancehq/payments/ce/plugins/plaid/client"
+	errorsutils "github.com/formancehq/payments/pkg/domain/errors"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/plaid/plaid-go/v34/plaid"
+)
+
+const (
+	// Plaid Transfer only supports USD for ACH transfers
+	transferCurrency = "USD"
+
+	transferDescriptionMaxLength    = 15
+	transferIdempotencyKeyMaxLength = 50
+)
+
+// createTransfer debits the user account linked through Plaid. The funds are
+// credited to the Plaid Ledger balance of the client.
+func (p *Plugin) createTransfer(ctx context.Context, req models.CreateTransferRequest) (*models.PSPPayment, error) {
+	if err := validateTransferRequest(
+		req.PaymentInitiation,
+		req.PaymentInitiation.SourceAccount,
+		"sourceAccount",
+		req.PaymentServiceUser,
+		req.OpenBankingConnection,
+	); err != nil {
+		return nil, err
+	}
+
+	return p.initiateTransfer(
+		ctx,
+		plaid.TRANSFERTYPE_DEBIT,
+		req.PaymentInitiation,
+		req.PaymentInitiation.SourceAccount,
+		req.PaymentServiceUser,
+		req.OpenBankingConnection,
+	)
+}
+
+// createPayout credits the user account linked through Plaid. The funds are
+// debited from the Plaid Ledger balance of the client.
+func (p *Plugin) createPayout(ctx context.Context, req models.CreatePayoutRequest) (*models.PSPPayment, error) {
+	if err := validateTransferRequest(
+		req.PaymentInitiation,
+		req.PaymentInitiation.DestinationAccount,
+		"destinationAccount",
+		req.PaymentServiceUser,
+		req.OpenBankingConnection,
+	); err != nil {
+		return nil, err
+	}
+
+	return p.initiateTransfer(
+		ctx,
+		plaid.TRANSFERTYPE_CREDIT,
+		req.PaymentInitiation,
+		req.PaymentInitiation.DestinationAccount,
+		req.PaymentServiceUser,
+		req.OpenBankingConnection,
+	)
+}
+
+func validateTransferRequest(
+	pi models.PSPPaymentInitiation,
+	userAccount *models.PSPAccount,
+	userAccountField string,
+	psu *models.PSPPaymentServiceUser,
+	connection *models.OpenBankingConnection,
+) error {
+	if pi.Amount == nil {
+		return models.NewConnectorValidationError("amount", models.ErrMissingConnectorField)
+	}
+
+	if userAccount == nil {
+		return models.NewConnectorValidationError(userAccountField, models.ErrMissingConnectorField)
+	}
+
+	// The user account must come from a Plaid link, otherwise we won't have
+	// the access token needed to move money from/to it.
+	if userAccount.PsuID == nil || userAccount.OpenBankingConnectionID == nil {
+		return models.NewConnectorValidationError(userAccountField, models.ErrInvalidRequest)
+	}
+
+	if psu == nil {
+		return models.NewConnectorValidationError("paymentServiceUser", models.ErrMissingConnectorField)
+	}
+
+	if connection == nil || connection.AccessToken == nil {
+		return models.NewConnectorValidationError("openBankingConnection", models.ErrMissingConnectorField)
+	}
+
+	if connection.ConnectionID != *userAccount.OpenBankingConnectionID {
+		return models.NewConnectorValidationError("openBankingConnection", models.ErrInvalidRequest)
+	}
+
+	return nil
+}
+
+func (p *Plugin) initiateTransfer(
+	ctx context.Context,
+	transferType plaid.TransferType,
+	pi models.PSPPaymentInitiation,
+	userAccount *models.PSPAccount,
+	psu *models.PSPPaymentServiceUser,
+	connection *models.OpenBankingConnection,
+) (*models.PSPPayment, error) {
+	curr, precision, err := currency.GetCurrencyAndPrecisionFromAsset(supportedCurrenciesWithDecimal, pi.Asset)
+	if err != nil {
+		return nil, errorsutils.NewWrappedError(
+			fmt.Errorf("failed to get currency and precision from asset: %w", err),
+			models.ErrInvalidRequest,
+		)
+	}
+
+	if curr != transferCurrency {
+		return nil, fmt.Errorf("unsupported currency %s for plaid transfer: %w", curr, models.ErrInvalidRequest)
+	}
+
+	amount, err := currency.GetStringAmountFromBigIntWithPrecision(pi.Amount, precision)
+	if err != nil {
+		return nil, errorsutils.NewWrappedError(
+			fmt.Errorf("failed to get string amount from big int amount %v: %v", pi.Amount, err),
+			models.ErrInvalidRequest,
+		)
+	}
+
+	authorizationRequest := client.CreateTransferAuthorizationRequest{
+		AccessToken:    connection.AccessToken.Token,
+		AccountID:      userAccount.Reference,
+		Type:           transferType,
+		Amount:         amount,
+		Currency:       curr,
+		LegalName:      psu.Name,
+		IdempotencyKey: generateIdempotencyKey(pi.Reference),
+	}
+	if psu.ContactDetails != nil {
+		authorizationRequest.EmailAddress = psu.ContactDetails.Email
+		authorizationRequest.PhoneNumber = psu.ContactDetails.PhoneNumber
+	}
+
+	authorization, err := p.client.CreateTransferAuthorization(ctx, authorizationRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	if authorization.Decision != plaid.TRANSFERAUTHORIZATIONDECISION_APPROVED {
+		reason := ""
+		if rationale, ok := authorization.GetDecisionRationaleOk(); ok && rationale != nil {
+			reason = fmt.Sprintf(": %s: %s", rationale.Code, rationale.Description)
+		}
+		return nil, fmt.Errorf("transfer authorization %s%s: %w", authorization.Decision, reason, models.ErrInvalidRequest)
+	}
+
+	transfer, err := p.client.CreateTransfer(ctx, client.CreateTransferRequest{
+		AccessToken:     connection.AccessToken.Token,
+		AccountID:       userAccount.Reference,
+		AuthorizationID: authorization.Id,
+		Amount:          amount,
+		Currency:        curr,
+		Description:     transferDescription(pi),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return translateTransferToPSPPayment(transfer)
+}
+
+func translateTransferToPSPPayment(transfer plaid.Transfer) (*models.PSPPayment, error) {
+	raw, err := json.Marshal(transfer)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, asset, err := translateTransferAmount(transfer.Amount, transfer.IsoCurrencyCode)
+	if err != nil {
+		return nil, err
+	}
+
+	var ledgerID *string
+	if transfer.LedgerId.IsSet() {
+		ledgerID = transfer.LedgerId.Get()
+	}
+
+	payment := &models.PSPPayment{
+		Reference: transfer.Id,
+		CreatedAt: transfer.Created.UTC(),
+		Amount:    amount,
+		Asset:     asset,
+		Status:    transferStatusToPaymentStatus(transfer.Status),
+		Raw:       raw,
+	}
+	fillTransferPaymentTypeAndAccounts(payment, string(transfer.Type), transfer.AccountId, ledgerID)
+
+	return payment, nil
+}
+
+// fillTransferPaymentTypeAndAccounts sets the payment type, scheme and
+// accounts depending on the direction of the transfer. A debit pulls money
+// from the user account to the ledger, while a credit pushes money from the
+// ledger to the user account.
+func fillTransferPaymentTypeAndAccounts(payment *models.PSPPayment, transferType string, accountID *string, ledgerID *string) {
+	switch transferType {
+	case string(plaid.TRANSFERTYPE_DEBIT):
+		payment.Type = models.PAYMENT_TYPE_PAYIN
+		payment.Scheme = models.PAYMENT_SCHEME_ACH_DEBIT
+		payment.SourceAccountReference = accountID
+		payment.DestinationAccountReference = ledgerID
+	default:
+		payment.Type = models.PAYMENT_TYPE_PAYOUT
+		payment.Scheme = models.PAYMENT_SCHEME_ACH
+		payment.SourceAccountReference = ledgerID
+		payment.DestinationAccountReference = accountID
+	}
+}
+
+func transferStatusToPaymentStatus(status plaid.TransferStatus) models.PaymentStatus {
+	switch status {
+	case plaid.TRANSFERSTATUS_PENDING:
+		return models.PAYMENT_STATUS_PENDING
+	case plaid.TRANSFERSTATUS_POSTED,
+		plaid.TRANSFERSTATUS_SETTLED,
+		plaid.TRANSFERSTATUS_FUNDS_AVAILABLE:
+		return models.PAYMENT_STATUS_SUCCEEDED
+	case plaid.TRANSFERSTATUS_CANCELLED:
+		return models.PAYMENT_STATUS_CANCELLED
+	case plaid.TRANSFERSTATUS_FAILED,
+		plaid.TRANSFERSTATUS_RETURNED:
+		return models.PAYMENT_STATUS_FAILED
+	default:
+		return models.PAYMENT_STATUS_UNKNOWN
+	}
+}
+
+func translateTransferAmount(amount string, curr string) (*big.Int, string, error) {
+	if curr == "" {
+		curr = transferCurrency
+	}
+
+	precision, err := currency.GetPrecision(supportedCurrenciesWithDecimal, curr)
+	if err != nil {
+		return nil, "", err
+	}
+
+	amountInt, err := currency.GetAmountWithPrecisionFromString(amount, precision)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return amountInt, currency.FormatAssetWithPrecision(curr, precision), nil
+}
+
+func transferDescription(pi models.PSPPaymentInitiation) string {
+	description := pi.Description
+	if description == "" {
+		description = pi.Reference
+	}
+
+	if len(description) > transferDescriptionMaxLength {
+		description = description[:transferDescriptionMaxLength]
+	}
+
+	return description
+}
+
+// Plaid idempotency keys are limited to 50 characters, and payment initiation
+// references can be longer, so we hash them.
+func generateIdempotencyKey(reference string) string {
+	hash := sha256.Sum256([]byte(reference))
+	return hex.EncodeToString(hash[:])[:transferIdempotencyKeyMaxLength]
+}
@@ -0,0 +1,253 @@
+package plaid
+
+import (
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/payments/ce/plugins/plaid/client"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/plaid/plaid-go/v34/plaid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Plaid *Plugin Transfers", func() {
+	var (
+		ctrl *gomock.Controller
+		m    *client.MockClient
+		plg  models.Plugin
+
+		now        time.Time
+		psu        *models.PSPPaymentServiceUser
+		connection *models.OpenBankingConnection
+		userAcc    *models.PSPAccount
+		ledgerAcc  *models.PSPAccount
+		pi         models.PSPPaymentInitiation
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		m = client.NewMockClient(ctrl)
+		plg = &Plugin{client: m}
+
+		now = time.Now().UTC().Truncate(time.Second)
+		psu = &models.PSPPaymentServiceUser{
+			ID:   uuid.New(),
+			Name: "John Doe",
+			ContactDetails: &models.ContactDetails{
+				Email: pointer.For("john.doe@example.com"),
+			},
+		}
+		connection = &models.OpenBankingConnection{
+			ConnectionID: "item_1",
+			AccessToken:  &models.Token{Token: "access-token"},
+		}
+		userAcc = &models.PSPAccount{
+			Reference:               "account_1",
+			PsuID:                   &psu.ID,
+			OpenBankingConnectionID: pointer.For("item_1"),
+		}
+		ledgerAcc = &models.PSPAccount{
+			Reference: "ledger_1",
+		}
+		pi = models.PSPPaymentInitiation{
+			Reference:   "pi_reference",
+			CreatedAt:   now,
+			Description: "monthly subscription",
+			Amount:      big.NewInt(1050),
+			Asset:       "USD/2",
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	sampleTransfer := func(transferType plaid.TransferType) plaid.Transfer {
+		transfer := plaid.NewTransferWithDefaults()
+		transfer.SetId("transfer_1")
+		transfer.SetAccountId("account_1")
+		transfer.SetLedgerId("ledger_1")
+		transfer.SetType(transferType)
+		transfer.SetAmount("10.50")
+		transfer.SetIsoCurrencyCode("USD")
+		transfer.SetStatus(plaid.TRANSFERSTATUS_PENDING)
+		transfer.SetCreated(now)
+		return *transfer
+	}
+
+	approvedAuthorization := func() plaid.TransferAuthorization {
+		authorization := plaid.NewTransferAuthorizationWithDefaults()
+		authorization.SetId("authorization_1")
+		authorization.SetDecision(plaid.TRANSFERAUTHORIZATIONDECISION_APPROVED)
+		return *authorization
+	}
+
+	Context("create transfer", func() {
+		BeforeEach(func() {
+			pi.SourceAccount = userAcc
+			pi.DestinationAccount = ledgerAcc
+		})
+
+		It("should return an error - missing source account", func(ctx SpecContext) {
+			pi.SourceAccount = nil
+			_, err := plg.CreateTransfer(ctx, models.CreateTransferRequest{
+				PaymentInitiation:     pi,
+				PaymentServiceUser:    psu,
+				OpenBankingConnection: connection,
+			})
+			Expect(err).To(MatchError("validation error occurred for field sourceAccount: missing required field in request"))
+		})
+
+		It("should return an error - source account not linked through plaid", func(ctx SpecContext) {
+			pi.SourceAccount = ledgerAcc
+			_, err := plg.CreateTransfer(ctx, models.CreateTransferRequest{
+				PaymentInitiation:     pi,
+				PaymentServiceUser:    psu,
+				OpenBankingConnection: connection,
+			})
+			Expect(err).To(MatchError(models.ErrInvalidRequest))
+		})
+
+		It("should return an error - missing open banking connection", func(ctx SpecContext) {
+			_, err := plg.CreateTransfer(ctx, models.CreateTransferRequest{
+				PaymentInitiation:  pi,
+				PaymentServiceUser: psu,
+			})
+			Expect(err).To(MatchError("validation error occurred for field openBankingConnection: missing required field in request"))
+		})
+
+		It("should return an error - unsupported currency", func(ctx SpecContext) {
+			pi.Asset = "EUR/2"
+			_, err := plg.CreateTransfer(ctx, models.CreateTransferRequest{
+				PaymentInitiation:     pi,
+				PaymentServiceUser:    psu,
+				OpenBankingConnection: connection,
+			})
+			Expect(err).To(MatchError(models.ErrInvalidRequest))
+		})
+
+		It("should return an error - authorization declined", func(ctx SpecContext) {
+			authorization := approvedAuthorization()
+			authorization.SetDecision(plaid.TRANSFERAUTHORIZATIONDECISION_DECLINED)
+			authorization.SetDecisionRationale(plaid.TransferAuthorizationDecisionRationale{
+				Code:        plaid.TRANSFERAUTHORIZATIONDECISIONRATIONALECODE_NSF,
+				Description: "insufficient funds",
+			})
+			m.EXPECT().CreateTransferAuthorization(gomock.Any(), gomock.Any()).Return(authorization, nil)
+
+			_, err := plg.CreateTransfer(ctx, models.CreateTransferRequest{
+				PaymentInitiation:     pi,
+				PaymentServiceUser:    psu,
+				OpenBankingConnection: connection,
+			})
+			Expect(err).To(MatchError(models.ErrInvalidRequest))
+			Expect(err.Error()).To(ContainSubstring("insufficient funds"))
+		})
+
+		It("should return an error - create transfer error", func(ctx SpecContext) {
+			m.EXPECT().CreateTransferAuthorization(gomock.Any(), gomock.Any()).Return(approvedAuthorization(), nil)
+			m.EXPECT().CreateTransfer(gomock.Any(), gomock.Any()).Return(plaid.Transfer{}, errors.New("test error"))
+
+			_, err := plg.CreateTransfer(ctx, models.CreateTransferRequest{
+				PaymentInitiation:     pi,
+				PaymentServiceUser:    psu,
+				OpenBankingConnection: connection,
+			})
+			Expect(err).To(MatchError("test error"))
+		})
+
+		It("should debit the user account", func(ctx SpecContext) {
+			m.EXPECT().CreateTransferAuthorization(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ any, req client.CreateTransferAuthorizationRequest) (plaid.TransferAuthorization, error) {
+					Expect(req.AccessToken).To(Equal("access-token"))
+					Expect(req.AccountID).To(Equal("account_1"))
+					Expect(req.Type).To(Equal(plaid.TRANSFERTYPE_DEBIT))
+					Expect(req.Amount).To(Equal("10.50"))
+					Expect(req.Currency).To(Equal("USD"))
+					Expect(req.LegalName).To(Equal("John Doe"))
+					Expect(req.EmailAddress).To(Equal(pointer.For("john.doe@example.com")))
+					Expect(req.IdempotencyKey).To(HaveLen(transferIdempotencyKeyMaxLength))
+					return approvedAuthorization(), nil
+				},
+			)
+			m.EXPECT().CreateTransfer(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ any, req client.CreateTransferRequest) (plaid.Transfer, error) {
+					Expect(req.AuthorizationID).To(Equal("authorization_1"))
+					Expect(req.Description).To(Equal("monthly subscri"))
+					return sampleTransfer(plaid.TRANSFERTYPE_DEBIT), nil
+				},
+			)
+
+			resp, err := plg.CreateTransfer(ctx, models.CreateTransferRequest{
+				PaymentInitiation:     pi,
+				PaymentServiceUser:    psu,
+				OpenBankingConnection: connection,
+			})
+			Expect(err).To(BeNil())
+			Expect(resp.PollingTransferID).To(BeNil())
+			Expect(resp.Payment).ToNot(BeNil())
+			Expect(resp.Payment.Reference).To(Equal("transfer_1"))
+			Expect(resp.Payment.Type).To(Equal(models.PAYMENT_TYPE_PAYIN))
+			Expect(resp.Payment.Scheme).To(Equal(models.PAYMENT_SCHEME_ACH_DEBIT))
+			Expect(resp.Payment.Status).To(Equal(models.PAYMENT_STATUS_PENDING))
+			Expect(resp.Payment.Amount).To(Equal(big.NewInt(1050)))
+			Expect(resp.Payment.Asset).To(Equal("USD/2"))
+			Expect(resp.Payment.SourceAccountReference).To(Equal(pointer.For("account_1")))
+			Expect(resp.Payment.DestinationAccountReference).To(Equal(pointer.For("ledger_1")))
+		})
+	})
+
+	Context("create payout", func() {
+		BeforeEach(func() {
+			pi.SourceAccount = ledgerAcc
+			pi.DestinationAccount = userAcc
+		})
+
+		It("should return an error - missing payment service user", func(ctx SpecContext) {
+			_, err := plg.CreatePayout(ctx, models.CreatePayoutRequest{
+				PaymentInitiation:     pi,
+				OpenBankingConnection: connection,
+			})
+			Expect(err).To(MatchError("validation error occurred for field paymentServiceUser: missing required field in request"))
+		})
+
+		It("should return an error - connection not related to the account", func(ctx SpecContext) {
+			connection.ConnectionID = "item_2"
+			_, err := plg.CreatePayout(ctx, models.CreatePayoutRequest{
+				PaymentInitiation:     pi,
+				PaymentServiceUser:    psu,
+				OpenBankingConnection: connection,
+			})
+			Expect(err).To(MatchError(models.ErrInvalidRequest))
+		})
+
+		It("should credit the user account", func(ctx SpecContext) {
+			m.EXPECT().CreateTransferAuthorization(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ any, req client.CreateTransferAuthorizationRequest) (plaid.TransferAuthorization, error) {
+					Expect(req.Type).To(Equal(plaid.TRANSFERTYPE_CREDIT))
+					return approvedAuthorization(), nil
+				},
+			)
+			m.EXPECT().CreateTransfer(gomock.Any(), gomock.Any()).Return(sampleTransfer(plaid.TRANSFERTYPE_CREDIT), nil)
+
+			resp, err := plg.CreatePayout(ctx, models.CreatePayoutRequest{
+				PaymentInitiation:     pi,
+				PaymentServiceUser:    psu,
+				OpenBankingConnection: connection,
+			})
+			Expect(err).To(BeNil())
+			Expect(resp.PollingPayoutID).To(BeNil())
+			Expect(resp.Payment).ToNot(BeNil())
+			Expect(resp.Payment.Reference).To(Equal("transfer_1"))
+			Expect(resp.Payment.Type).To(Equal(models.PAYMENT_TYPE_PAYOUT))
+			Expect(resp.Payment.Scheme).To(Equal(models.PAYMENT_SCHEME_ACH))
+			Expect(resp.Payment.SourceAccountReference).To(Equal(pointer.For("ledger_1")))
+			Expect(resp.Payment.DestinationAccountReference).To(Equal(pointer.For("account_1")))
+		})
+	})
+})
@@ -144,6 +144,13 @@ func (p *Plugin) handleAllWebhook(ctx context.Context, req models.TranslateWebho
 	case plaid.WEBHOOKTYPE_TRANSACTIONS:
 		return p.handleTransactionsWebhook(req, baseWebhook)
 
+	// Same as LINK, not defined inside the plaid sdk
+	case "TRANSFER":
+		// TRANSFER_EVENTS_UPDATE webhooks only notify that new transfer
+		// events are available. They are already synced periodically when
+		// Plaid Transfer is enabled, so nothing to do here.
+		return []models.WebhookResponse{}, nil
+
 	default:
 		return []models.WebhookResponse{}, fmt.Errorf("unsupported webhook type: %s", baseWebhook.WebhookType)
 	}
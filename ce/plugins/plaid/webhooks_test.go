@@ -156,6 +156,24 @@ var _ = Describe("Plaid *Plugin Webhooks", func() {
 			Expect(resp).ToNot(Equal(models.TranslateWebhookResponse{}))
 		})
 
+		It("should ignore transfer webhooks", func(ctx SpecContext) {
+			req := models.TranslateWebhookRequest{
+				Name: "all",
+				Webhook: models.PSPWebhook{
+					Body: []byte(`{"webhook_type": "TRANSFER", "webhook_code": "TRANSFER_EVENTS_UPDATE"}`),
+				},
+			}
+
+			m.EXPECT().BaseWebhookTranslation(req.Webhook.Body).Return(client.BaseWebhooks{
+				WebhookType: "TRANSFER",
+				WebhookCode: "TRANSFER_EVENTS_UPDATE",
+			}, nil)
+
+			resp, err := plg.TranslateWebhook(ctx, req)
+			Expect(err).To(BeNil())
+			Expect(resp.Responses).To(BeEmpty())
+		})
+
 		It("should return an error - base webhook translation error", func(ctx SpecContext) {
 			req := models.TranslateWebhookRequest{
 				Name: "all",
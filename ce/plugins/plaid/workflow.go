@@ -2,11 +2,11 @@ package plaid
 
 import "github.com/formancehq/payments/pkg/domain/models"
 
-func workflow() models.ConnectorTasksTree {
-	// Do not launch fetch data workflows here, since we're depending on the
-	// users to finish the link flow instead of the installation of this
-	// connector.
-	return []models.ConnectorTaskTree{
+func workflow(config Config) models.ConnectorTasksTree {
+	// Do not launch fetch data workflows for the users here, since we're
+	// depending on the users to finish the link flow instead of the
+	// installation of this connector.
+	tree := []models.ConnectorTaskTree{
 		{
 			TaskType:     models.TASK_CREATE_WEBHOOKS,
 			Name:         "create_webhooks",
@@ -14,4 +14,32 @@ func workflow() models.ConnectorTasksTree {
 			NextTasks:    []models.ConnectorTaskTree{},
 		},
 	}
+
+	if !config.IsTransferEnabled {
+		return tree
+	}
+
+	// Plaid Transfer data (ledger balance and transfer events) is not related
+	// to a specific user, so we need to fetch it periodically.
+	return append(tree,
+		models.ConnectorTaskTree{
+			TaskType:     models.TASK_FETCH_ACCOUNTS,
+			Name:         "fetch_transfer_ledger",
+			Periodically: true,
+			NextTasks: []models.ConnectorTaskTree{
+				{
+					TaskType:     models.TASK_FETCH_BALANCES,
+					Name:         "fetch_transfer_ledger_balance",
+					Periodically: true,
+					NextTasks:    []models.ConnectorTaskTree{},
+				},
+			},
+		},
+		models.ConnectorTaskTree{
+			TaskType:     models.TASK_FETCH_PAYMENTS,
+			Name:         "fetch_transfer_events",
+			Periodically: true,
+			NextTasks:    []models.ConnectorTaskTree{},
+		},
+	)
 }
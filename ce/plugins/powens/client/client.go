@@ -12,6 +12,8 @@ import (
 type Client interface {
 	CreateUser(context.Context) (CreateUserResponse, error)
 	CreateTemporaryCode(context.Context, CreateTemporaryLinkRequest) (CreateTemporaryLinkResponse, error)
+	CreatePayment(context.Context, CreatePaymentRequest) (CreatePaymentResponse, error)
+	CreatePaymentScopedToken(context.Context, CreatePaymentScopedTokenRequest) (CreatePaymentScopedTokenResponse, error)
 
 	DeleteUserConnection(ctx context.Context, req DeleteUserConnectionRequest) error
 	DeleteUser(ctx context.Context, req DeleteUserRequest) error
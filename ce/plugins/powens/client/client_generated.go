@@ -40,6 +40,36 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// CreatePayment mocks base method.
+func (m *MockClient) CreatePayment(arg0 context.Context, arg1 CreatePaymentRequest) (CreatePaymentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePayment", arg0, arg1)
+	ret0, _ := ret[0].(CreatePaymentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePayment indicates an expected call of CreatePayment.
+func (mr *MockClientMockRecorder) CreatePayment(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePayment", reflect.TypeOf((*MockClient)(nil).CreatePayment), arg0, arg1)
+}
+
+// CreatePaymentScopedToken mocks base method.
+func (m *MockClient) CreatePaymentScopedToken(arg0 context.Context, arg1 CreatePaymentScopedTokenRequest) (CreatePaymentScopedTokenResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePaymentScopedToken", arg0, arg1)
+	ret0, _ := ret[0].(CreatePaymentScopedTokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePaymentScopedToken indicates an expected call of CreatePaymentScopedToken.
+func (mr *MockClientMockRecorder) CreatePaymentScopedToken(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentScopedToken", reflect.TypeOf((*MockClient)(nil).CreatePaymentScopedToken), arg0, arg1)
+}
+
 // CreateTemporaryCode mocks base method.
 func (m *MockClient) CreateTemporaryCode(arg0 context.Context, arg1 CreateTemporaryLinkRequest) (CreateTemporaryLinkResponse, error) {
 	m.ctrl.T.Helper()
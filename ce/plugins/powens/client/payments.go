@@ -0,0 +1,106 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	errorsutils "github.com/formancehq/payments/pkg/domain/errors"
+	"github.com/formancehq/payments/pkg/domain/metrics"
+)
+
+type PaymentBeneficiary struct {
+	SchemeName     string `json:"scheme_name"`
+	Identification string `json:"identification"`
+	Label          string `json:"label"`
+}
+
+type PaymentInstruction struct {
+	Amount      json.Number        `json:"amount"`
+	Currency    string             `json:"currency"`
+	Label       string             `json:"label"`
+	Reference   string             `json:"reference,omitempty"`
+	Beneficiary PaymentBeneficiary `json:"beneficiary"`
+}
+
+type CreatePaymentRequest struct {
+	AccessToken string `json:"-"`
+
+	ClientRedirectURI string               `json:"client_redirect_uri"`
+	ClientState       string               `json:"client_state"`
+	Instructions      []PaymentInstruction `json:"instructions"`
+}
+
+type CreatePaymentResponse struct {
+	ID    int    `json:"id"`
+	State string `json:"state"`
+}
+
+func (c *client) CreatePayment(ctx context.Context, request CreatePaymentRequest) (CreatePaymentResponse, error) {
+	ctx = context.WithValue(ctx, metrics.MetricOperationContextKey, "create_payment")
+
+	body, err := json.Marshal(&request)
+	if err != nil {
+		return CreatePaymentResponse{}, err
+	}
+
+	endpoint := fmt.Sprintf("%s/2.0/payments", c.endpoint)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewBuffer(body))
+	if err != nil {
+		return CreatePaymentResponse{}, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", request.AccessToken))
+
+	var resp CreatePaymentResponse
+	var errResp powensError
+	if _, err := c.httpClient.Do(ctx, req, &resp, &errResp); err != nil {
+		return CreatePaymentResponse{}, errorsutils.NewWrappedError(
+			fmt.Errorf("failed to create payment: %v", errResp.Error()),
+			err,
+		)
+	}
+
+	return resp, nil
+}
+
+type CreatePaymentScopedTokenRequest struct {
+	AccessToken string
+	PaymentID   int
+}
+
+type CreatePaymentScopedTokenResponse struct {
+	ScopedToken string `json:"scoped_token"`
+}
+
+func (c *client) CreatePaymentScopedToken(ctx context.Context, request CreatePaymentScopedTokenRequest) (CreatePaymentScopedTokenResponse, error) {
+	ctx = context.WithValue(ctx, metrics.MetricOperationContextKey, "create_payment_scoped_token")
+
+	body, err := json.Marshal(map[string]string{
+		"scope": "payment:validate",
+	})
+	if err != nil {
+		return CreatePaymentScopedTokenResponse{}, err
+	}
+
+	endpoint := fmt.Sprintf("%s/2.0/payments/%d/scopedtoken", c.endpoint, request.PaymentID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewBuffer(body))
+	if err != nil {
+		return CreatePaymentScopedTokenResponse{}, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", request.AccessToken))
+
+	var resp CreatePaymentScopedTokenResponse
+	var errResp powensError
+	if _, err := c.httpClient.Do(ctx, req, &resp, &errResp); err != nil {
+		return CreatePaymentScopedTokenResponse{}, errorsutils.NewWrappedError(
+			fmt.Errorf("failed to create payment scoped token: %v", errResp.Error()),
+			err,
+		)
+	}
+
+	return resp, nil
+}
@@ -27,6 +27,9 @@ const (
 	WebhookEventTypeAccountsFetched WebhookEventType = "ACCOUNTS_FETCHED"
 	// Accounts Synced returns the list of transactions that were fetched from a specific accounts
 	WebhookEventTypeAccountSynced WebhookEventType = "ACCOUNT_SYNCED"
+	// Payment State Updated indicates that the state of a payment initiated
+	// through the pay webview has changed.
+	WebhookEventTypePaymentStateUpdated WebhookEventType = "PAYMENT_STATE_UPDATED"
 )
 
 type CreateWebhookAuthRequest struct {
@@ -151,6 +154,14 @@ type UserDeletedWebhook struct {
 	UserID int `json:"id"`
 }
 
+type PaymentStateUpdatedWebhook struct {
+	ID               int                  `json:"id"`
+	State            string               `json:"state"`
+	ErrorCode        string               `json:"error_code"`
+	ErrorDescription string               `json:"error_description"`
+	Instructions     []PaymentInstruction `json:"instructions"`
+}
+
 type ConnectionSyncedUser struct {
 	ID int `json:"id"`
 }
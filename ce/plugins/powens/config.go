@@ -19,8 +19,8 @@ const PAGE_SIZE = 100 // max page size is 1000
 
 type Config struct {
 	ClientID              string `json:"clientID" validate:"required"`
-	ClientSecret          string `json:"clientSecret" validate:"required"`
-	ConfigurationToken    string `json:"configurationToken" validate:"required"`
+	ClientSecret          string `json:"clientSecret" validate:"required" sensitive:"true"`
+	ConfigurationToken    string `json:"configurationToken" validate:"required" sensitive:"true"`
 	Domain                string `json:"domain" validate:"required"`
 	MaxConnectionsPerLink uint32 `json:"maxConnectionsPerLink" validate:"required,min=1"`
 	Endpoint              string `json:"endpoint" validate:"required"`
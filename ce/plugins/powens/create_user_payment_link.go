@@ -0,0 +1,132 @@
+package powens
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/formancehq/go-libs/v5/pkg/types/currency"
+	"github.com/formancehq/payments/ce/plugins/powens/client"
+	"github.com/formancehq/payments/pkg/domain/models"
+)
+
+func validateCreateUserPaymentLinkRequest(req models.CreateUserPaymentLinkRequest) error {
+	if req.PaymentServiceUser == nil {
+		return fmt.Errorf("payment service user is required: %w", models.ErrInvalidRequest)
+	}
+
+	if req.OpenBankingForwardedUser == nil {
+		return fmt.Errorf("open banking connections are required: %w", models.ErrInvalidRequest)
+	}
+
+	if req.OpenBankingForwardedUser.AccessToken == nil {
+		return fmt.Errorf("auth token is required: %w", models.ErrInvalidRequest)
+	}
+
+	if req.CallBackState == "" {
+		return fmt.Errorf("callBackState is required: %w", models.ErrInvalidRequest)
+	}
+
+	if req.FormanceRedirectURL == nil || *req.FormanceRedirectURL == "" {
+		return fmt.Errorf("formanceRedirectURL is required: %w", models.ErrInvalidRequest)
+	}
+
+	if req.PaymentInitiation.Amount == nil {
+		return fmt.Errorf("payment initiation amount is required: %w", models.ErrInvalidRequest)
+	}
+
+	if req.PaymentInitiation.DestinationAccount == nil ||
+		req.PaymentInitiation.DestinationAccount.Metadata[models.AccountIBANMetadataKey] == "" {
+		return fmt.Errorf("destination account iban is required: %w", models.ErrInvalidRequest)
+	}
+
+	return nil
+}
+
+func (p *Plugin) createUserPaymentLink(ctx context.Context, req models.CreateUserPaymentLinkRequest) (models.CreateUserPaymentLinkResponse, error) {
+	if err := validateCreateUserPaymentLinkRequest(req); err != nil {
+		return models.CreateUserPaymentLinkResponse{}, err
+	}
+
+	curr, precision, err := currency.GetCurrencyAndPrecisionFromAsset(currency.ISO4217Currencies, req.PaymentInitiation.Asset)
+	if err != nil {
+		return models.CreateUserPaymentLinkResponse{}, fmt.Errorf("failed to get currency and precision from asset: %v: %w", err, models.ErrInvalidRequest)
+	}
+
+	amount, err := currency.GetStringAmountFromBigIntWithPrecision(req.PaymentInitiation.Amount, precision)
+	if err != nil {
+		return models.CreateUserPaymentLinkResponse{}, fmt.Errorf("failed to get string amount from big int: %v: %w", err, models.ErrInvalidRequest)
+	}
+
+	destination := req.PaymentInitiation.DestinationAccount
+	beneficiaryName := destination.Metadata[models.AccountBankAccountNameMetadataKey]
+	if beneficiaryName == "" && destination.Name != nil {
+		beneficiaryName = *destination.Name
+	}
+
+	label := req.PaymentInitiation.Description
+	if label == "" {
+		label = req.PaymentInitiation.Reference
+	}
+
+	accessToken := req.OpenBankingForwardedUser.AccessToken.Token
+	payment, err := p.client.CreatePayment(ctx, client.CreatePaymentRequest{
+		AccessToken:       accessToken,
+		ClientRedirectURI: *req.FormanceRedirectURL,
+		ClientState:       req.CallBackState,
+		Instructions: []client.PaymentInstruction{
+			{
+				Amount:    json.Number(amount),
+				Currency:  curr,
+				Label:     label,
+				Reference: req.PaymentInitiation.Reference,
+				Beneficiary: client.PaymentBeneficiary{
+					SchemeName:     "iban",
+					Identification: destination.Metadata[models.AccountIBANMetadataKey],
+					Label:          beneficiaryName,
+				},
+			},
+		},
+	})
+	if err != nil {
+		return models.CreateUserPaymentLinkResponse{}, err
+	}
+
+	scopedToken, err := p.client.CreatePaymentScopedToken(ctx, client.CreatePaymentScopedTokenRequest{
+		AccessToken: accessToken,
+		PaymentID:   payment.ID,
+	})
+	if err != nil {
+		return models.CreateUserPaymentLinkResponse{}, err
+	}
+
+	payURL, err := url.JoinPath(powensWebviewBaseURL, "pay")
+	if err != nil {
+		return models.CreateUserPaymentLinkResponse{}, err
+	}
+
+	u, err := url.Parse(payURL)
+	if err != nil {
+		return models.CreateUserPaymentLinkResponse{}, err
+	}
+
+	paymentID := strconv.Itoa(payment.ID)
+
+	query := u.Query()
+	query.Add("domain", p.config.Domain)
+	query.Add("client_id", p.clientID)
+	query.Add("payment_id", paymentID)
+	query.Add("code", scopedToken.ScopedToken)
+	query.Add("state", req.CallBackState)
+	u.RawQuery = query.Encode()
+	// We need to add the redirect URI to the query string directly because
+	// the encoded redirect URI is not UI friendly
+	u.RawQuery += "&redirect_uri=" + *req.FormanceRedirectURL
+
+	return models.CreateUserPaymentLinkResponse{
+		Link:                    u.String(),
+		PaymentRequestReference: paymentID,
+	}, nil
+}
@@ -0,0 +1,141 @@
+package powens
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+
+	"github.com/formancehq/payments/ce/plugins/powens/client"
+	"github.com/formancehq/payments/pkg/domain/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gomock "go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Powens *Plugin Create User Payment Link", func() {
+	Context("create user payment link", func() {
+		var (
+			ctrl *gomock.Controller
+			plg  models.Plugin
+			m    *client.MockClient
+
+			req models.CreateUserPaymentLinkRequest
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			m = client.NewMockClient(ctrl)
+			plg = &Plugin{
+				client:   m,
+				clientID: "client-123",
+				config: Config{
+					Domain:                "test.com",
+					MaxConnectionsPerLink: 5,
+				},
+			}
+
+			redirectURL := "https://formance.com/redirect"
+			req = models.CreateUserPaymentLinkRequest{
+				PaymentServiceUser: &models.PSPPaymentServiceUser{},
+				OpenBankingForwardedUser: &models.OpenBankingForwardedUser{
+					AccessToken: &models.Token{
+						Token: "auth-token-123",
+					},
+				},
+				PaymentInitiation: models.PSPPaymentInitiation{
+					Reference:   "ref-123",
+					Description: "test payment",
+					Amount:      big.NewInt(1050),
+					Asset:       "EUR/2",
+					DestinationAccount: &models.PSPAccount{
+						Reference: "account-123",
+						Metadata: map[string]string{
+							models.AccountIBANMetadataKey:            "FR7630006000011234567890189",
+							models.AccountBankAccountNameMetadataKey: "Merchant",
+						},
+					},
+				},
+				CallBackState:       "state-123",
+				FormanceRedirectURL: &redirectURL,
+			}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should return an error - missing payment service user", func(ctx SpecContext) {
+			req.PaymentServiceUser = nil
+
+			resp, err := plg.CreateUserPaymentLink(ctx, req)
+			Expect(err).To(MatchError(ContainSubstring("payment service user is required")))
+			Expect(resp).To(Equal(models.CreateUserPaymentLinkResponse{}))
+		})
+
+		It("should return an error - missing auth token", func(ctx SpecContext) {
+			req.OpenBankingForwardedUser = &models.OpenBankingForwardedUser{}
+
+			resp, err := plg.CreateUserPaymentLink(ctx, req)
+			Expect(err).To(MatchError(ContainSubstring("auth token is required")))
+			Expect(resp).To(Equal(models.CreateUserPaymentLinkResponse{}))
+		})
+
+		It("should return an error - missing destination iban", func(ctx SpecContext) {
+			req.PaymentInitiation.DestinationAccount = nil
+
+			resp, err := plg.CreateUserPaymentLink(ctx, req)
+			Expect(err).To(MatchError(ContainSubstring("destination account iban is required")))
+			Expect(resp).To(Equal(models.CreateUserPaymentLinkResponse{}))
+		})
+
+		It("should return an error - create payment error", func(ctx SpecContext) {
+			m.EXPECT().CreatePayment(gomock.Any(), gomock.Any()).Return(client.CreatePaymentResponse{}, errors.New("test error"))
+
+			resp, err := plg.CreateUserPaymentLink(ctx, req)
+			Expect(err).To(MatchError("test error"))
+			Expect(resp).To(Equal(models.CreateUserPaymentLinkResponse{}))
+		})
+
+		It("should return an error - create scoped token error", func(ctx SpecContext) {
+			m.EXPECT().CreatePayment(gomock.Any(), gomock.Any()).Return(client.CreatePaymentResponse{ID: 42}, nil)
+			m.EXPECT().CreatePaymentScopedToken(gomock.Any(), client.CreatePaymentScopedTokenRequest{
+				AccessToken: "auth-token-123",
+				PaymentID:   42,
+			}).Return(client.CreatePaymentScopedTokenResponse{}, errors.New("test error"))
+
+			resp, err := plg.CreateUserPaymentLink(ctx, req)
+			Expect(err).To(MatchError("test error"))
+			Expect(resp).To(Equal(models.CreateUserPaymentLinkResponse{}))
+		})
+
+		It("should be ok", func(ctx SpecContext) {
+			m.EXPECT().CreatePayment(gomock.Any(), client.CreatePaymentRequest{
+				AccessToken:       "auth-token-123",
+				ClientRedirectURI: "https://formance.com/redirect",
+				ClientState:       "state-123",
+				Instructions: []client.PaymentInstruction{
+					{
+						Amount:    json.Number("10.50"),
+						Currency:  "EUR",
+						Label:     "test payment",
+						Reference: "ref-123",
+						Beneficiary: client.PaymentBeneficiary{
+							SchemeName:     "iban",
+							Identification: "FR7630006000011234567890189",
+							Label:          "Merchant",
+						},
+					},
+				},
+			}).Return(client.CreatePaymentResponse{ID: 42}, nil)
+			m.EXPECT().CreatePaymentScopedToken(gomock.Any(), client.CreatePaymentScopedTokenRequest{
+				AccessToken: "auth-token-123",
+				PaymentID:   42,
+			}).Return(client.CreatePaymentScopedTokenResponse{ScopedToken: "scoped-123"}, nil)
+
+			resp, err := plg.CreateUserPaymentLink(ctx, req)
+			Expect(err).To(BeNil())
+			Expect(resp.PaymentRequestReference).To(Equal("42"))
+			Expect(resp.Link).To(Equal("https://webview.powens.com/pay?client_id=client-123&code=scoped-123&domain=test.com&payment_id=42&state=state-123&redirect_uri=https://formance.com/redirect"))
+		})
+	})
+})
@@ -7,6 +7,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"net/url"
 	"strconv"
 	"time"
@@ -44,6 +45,10 @@ func (p *Plugin) initWebhookConfig() {
 			urlPath:        "/connection-deleted",
 			handleFunction: p.handleConnectionDeleted,
 		},
+		client.WebhookEventTypePaymentStateUpdated: {
+			urlPath:        "/payment-state-updated",
+			handleFunction: p.handlePaymentStateUpdated,
+		},
 	}
 }
 
@@ -465,3 +470,116 @@ func translateTransactionToPSPPayment(transaction client.Transaction, accountRef
 
 	return p, nil
 }
+
+func (p *Plugin) handlePaymentStateUpdated(ctx context.Context, req models.TranslateWebhookRequest) ([]models.WebhookResponse, error) {
+	var webhook client.PaymentStateUpdatedWebhook
+	if err := json.Unmarshal(req.Webhook.Body, &webhook); err != nil {
+		return nil, err
+	}
+
+	var status models.PaymentInitiationAdjustmentStatus
+	switch webhook.State {
+	case "validating", "pending", "accepted", "partially_accepted":
+		status = models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PROCESSING
+	case "done":
+		status = models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PROCESSED
+	case "rejected":
+		status = models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_REJECTED
+	case "expired", "cancelled", "partially_rejected":
+		status = models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_FAILED
+	default:
+		// Payment has just been created or is in an unknown state, nothing to
+		// do on our side.
+		return nil, nil
+	}
+
+	var errMsg *string
+	if status == models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_FAILED ||
+		status == models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_REJECTED {
+		switch {
+		case webhook.ErrorDescription != "":
+			errMsg = pointer.For(webhook.ErrorDescription)
+		case webhook.ErrorCode != "":
+			errMsg = pointer.For(webhook.ErrorCode)
+		default:
+			errMsg = pointer.For(webhook.State)
+		}
+	}
+
+	at := time.Now().UTC()
+	payment, err := translatePaymentStateUpdatedToPSPPayment(webhook, status, at)
+	if err != nil {
+		return nil, err
+	}
+
+	return []models.WebhookResponse{
+		{
+			UserPaymentUpdated: &models.PSPUserPaymentUpdated{
+				PaymentRequestReference: strconv.Itoa(webhook.ID),
+				Status:                  status,
+				At:                      at,
+				Payment:                 payment,
+				Error:                   errMsg,
+			},
+		},
+	}, nil
+}
+
+// translatePaymentStateUpdatedToPSPPayment builds the payout of a payment from
+// its instructions. The payments created by CreateUserPaymentLink only have
+// one instruction, the amounts are summed up otherwise.
+func translatePaymentStateUpdatedToPSPPayment(
+	webhook client.PaymentStateUpdatedWebhook,
+	status models.PaymentInitiationAdjustmentStatus,
+	at time.Time,
+) (*models.PSPPayment, error) {
+	if len(webhook.Instructions) == 0 {
+		return nil, nil
+	}
+
+	curr := webhook.Instructions[0].Currency
+	precision, ok := currency.ISO4217Currencies[curr]
+	if !ok {
+		return nil, fmt.Errorf("invalid currency code: %s: %w", curr, models.ErrInvalidRequest)
+	}
+
+	amount := big.NewInt(0)
+	for _, instruction := range webhook.Instructions {
+		if instruction.Currency != curr {
+			return nil, fmt.Errorf("payment instructions with different currencies: %w", models.ErrInvalidRequest)
+		}
+
+		instructionAmount, err := currency.GetAmountWithPrecisionFromString(instruction.Amount.String(), precision)
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount: %s: %w", instruction.Amount, models.ErrInvalidRequest)
+		}
+		amount.Add(amount, instructionAmount)
+	}
+
+	raw, err := json.Marshal(webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	var paymentStatus models.PaymentStatus
+	switch status {
+	case models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PROCESSED:
+		paymentStatus = models.PAYMENT_STATUS_SUCCEEDED
+	case models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_FAILED,
+		models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_REJECTED:
+		paymentStatus = models.PAYMENT_STATUS_FAILED
+	default:
+		paymentStatus = models.PAYMENT_STATUS_PENDING
+	}
+
+	return &models.PSPPayment{
+		Reference: strconv.Itoa(webhook.ID),
+		CreatedAt: at,
+		Type:      models.PAYMENT_TYPE_PAYOUT,
+		Amount:    amount,
+		Asset:     currency.FormatAssetWithPrecision(curr, precision),
+		Scheme:    models.PAYMENT_SCHEME_OTHER,
+		Status:    paymentStatus,
+		Raw:       raw,
+	}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"errors"
+	"math/big"
 
 	"github.com/formancehq/payments/ce/plugins/powens/client"
 	"github.com/formancehq/payments/pkg/domain/models"
@@ -46,7 +47,7 @@ var _ = Describe("Powens *Plugin Webhooks", func() {
 
 			resp, err := plg.CreateWebhooks(ctx, req)
 			Expect(err).To(BeNil())
-			Expect(resp.Configs).To(HaveLen(3))
+			Expect(resp.Configs).To(HaveLen(4))
 		})
 
 		It("should return an error - client create webhook auth error", func(ctx SpecContext) {
@@ -164,6 +165,65 @@ var _ = Describe("Powens *Plugin Webhooks", func() {
 			}
 			Expect(found).To(BeTrue())
 		})
+
+		It("should translate a done payment state into a processed adjustment", func(ctx SpecContext) {
+			req := models.TranslateWebhookRequest{
+				Name:    string(client.WebhookEventTypePaymentStateUpdated),
+				Webhook: models.PSPWebhook{Body: []byte(`{"id":42,"state":"done","instructions":[{"amount":"10.50","currency":"EUR","label":"ref"}]}`)},
+			}
+
+			resp, err := plg.TranslateWebhook(ctx, req)
+			Expect(err).To(BeNil())
+			Expect(resp.Responses).To(HaveLen(1))
+			Expect(resp.Responses[0].UserPaymentUpdated).ToNot(BeNil())
+			Expect(resp.Responses[0].UserPaymentUpdated.PaymentRequestReference).To(Equal("42"))
+			Expect(resp.Responses[0].UserPaymentUpdated.Status).To(Equal(models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PROCESSED))
+			Expect(resp.Responses[0].UserPaymentUpdated.Error).To(BeNil())
+
+			payment := resp.Responses[0].UserPaymentUpdated.Payment
+			Expect(payment).ToNot(BeNil())
+			Expect(payment.Reference).To(Equal("42"))
+			Expect(payment.Type).To(Equal(models.PAYMENT_TYPE_PAYOUT))
+			Expect(payment.Amount).To(Equal(big.NewInt(1050)))
+			Expect(payment.Asset).To(Equal("EUR/2"))
+			Expect(payment.Status).To(Equal(models.PAYMENT_STATUS_SUCCEEDED))
+		})
+
+		It("should not translate the payment when the webhook has no instructions", func(ctx SpecContext) {
+			req := models.TranslateWebhookRequest{
+				Name:    string(client.WebhookEventTypePaymentStateUpdated),
+				Webhook: models.PSPWebhook{Body: []byte(`{"id":42,"state":"done"}`)},
+			}
+
+			resp, err := plg.TranslateWebhook(ctx, req)
+			Expect(err).To(BeNil())
+			Expect(resp.Responses).To(HaveLen(1))
+			Expect(resp.Responses[0].UserPaymentUpdated.Payment).To(BeNil())
+		})
+
+		It("should translate a rejected payment state with its error", func(ctx SpecContext) {
+			req := models.TranslateWebhookRequest{
+				Name:    string(client.WebhookEventTypePaymentStateUpdated),
+				Webhook: models.PSPWebhook{Body: []byte(`{"id":42,"state":"rejected","error_code":"insufficientFunds","error_description":"not enough money"}`)},
+			}
+
+			resp, err := plg.TranslateWebhook(ctx, req)
+			Expect(err).To(BeNil())
+			Expect(resp.Responses).To(HaveLen(1))
+			Expect(resp.Responses[0].UserPaymentUpdated.Status).To(Equal(models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_REJECTED))
+			Expect(*resp.Responses[0].UserPaymentUpdated.Error).To(Equal("not enough money"))
+		})
+
+		It("should ignore a created payment state", func(ctx SpecContext) {
+			req := models.TranslateWebhookRequest{
+				Name:    string(client.WebhookEventTypePaymentStateUpdated),
+				Webhook: models.PSPWebhook{Body: []byte(`{"id":42,"state":"created"}`)},
+			}
+
+			resp, err := plg.TranslateWebhook(ctx, req)
+			Expect(err).To(BeNil())
+			Expect(resp.Responses).To(BeEmpty())
+		})
 	})
 
 	Context("trim webhook", func() {
@@ -10,9 +10,9 @@ import (
 
 type Config struct {
 	ClientID     string `json:"clientID" validate:"required"`
-	APIKey       string `json:"apiKey" validate:"required"`
+	APIKey       string `json:"apiKey" validate:"required" sensitive:"true"`
 	Endpoint     string `json:"endpoint" validate:"required,url"`
-	StagingToken string `json:"stagingToken" validate:"omitempty"`
+	StagingToken string `json:"stagingToken" validate:"omitempty" sensitive:"true"`
 }
 
 const PAGE_SIZE = 100 // max page size is 100
@@ -135,7 +135,7 @@ func (p *Plugin) beneficiaryToPSPAccounts(
 			p.logger.Info("mapping beneficiary to external account error: ", err)
 			continue
 		}
-		accounts = append(accounts, models.PSPAccount{
+		account := models.PSPAccount{
 			Reference:    accountReference,
 			CreatedAt:    createdAt,
 			Name:         &beneficiary.Name,
@@ -151,8 +151,21 @@ func (p *Plugin) beneficiaryToPSPAccounts(
 				"updated_at":                         beneficiary.UpdatedAt,
 			},
 			Raw: raw,
+		}
+
+		routingCode := beneficiary.BankAccount.RoutingNumber
+		if routingCode == "" {
+			routingCode = beneficiary.BankAccount.SwiftSortCode
+		}
+		models.FillBankAccountDetailsToPSPAccountMetadata(&account, models.BankAccountDetails{
+			Name:          beneficiary.Name,
+			IBAN:          beneficiary.BankAccount.Iban,
+			AccountNumber: beneficiary.BankAccount.AccountNumber,
+			SwiftBicCode:  beneficiary.BankAccount.Bic,
+			RoutingCode:   routingCode,
 		})
 
+		accounts = append(accounts, account)
 	}
 	return accounts, nil
 }
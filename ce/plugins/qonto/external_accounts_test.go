@@ -494,16 +494,29 @@ func assertBeneficiaryMapping(beneficiary client.Beneficiary, resultingPSPAccoun
 	Expect(*resultingPSPAccount.Name).To(Equal(beneficiary.Name))
 	Expect(resultingPSPAccount.CreatedAt.Format(client.QontoTimeformat)).To(Equal(beneficiary.CreatedAt))
 	Expect(*resultingPSPAccount.DefaultAsset).To(Equal(expectedCurrency))
-	Expect(resultingPSPAccount.Metadata).To(Equal(map[string]string{
-		"beneficiary_id":                     beneficiary.Id,
-		"bank_account_number":                beneficiary.BankAccount.AccountNumber,
-		"bank_account_iban":                  beneficiary.BankAccount.Iban,
-		"bank_account_bic":                   beneficiary.BankAccount.Bic,
-		"bank_account_swift_sort_code":       beneficiary.BankAccount.SwiftSortCode,
-		"bank_account_routing_number":        beneficiary.BankAccount.RoutingNumber,
-		"bank_account_intermediary_bank_bic": beneficiary.BankAccount.IntermediaryBankBic,
-		"updated_at":                         beneficiary.UpdatedAt,
-	}))
+	expectedMetadata := map[string]string{
+		"beneficiary_id":                         beneficiary.Id,
+		"bank_account_number":                    beneficiary.BankAccount.AccountNumber,
+		"bank_account_iban":                      beneficiary.BankAccount.Iban,
+		"bank_account_bic":                       beneficiary.BankAccount.Bic,
+		"bank_account_swift_sort_code":           beneficiary.BankAccount.SwiftSortCode,
+		"bank_account_routing_number":            beneficiary.BankAccount.RoutingNumber,
+		"bank_account_intermediary_bank_bic":     beneficiary.BankAccount.IntermediaryBankBic,
+		"updated_at":                             beneficiary.UpdatedAt,
+		models.AccountBankAccountNameMetadataKey: beneficiary.Name,
+	}
+	switch counter % 3 {
+	case 0:
+		expectedMetadata[models.AccountIBANMetadataKey] = beneficiary.BankAccount.Iban
+		expectedMetadata[models.AccountSwiftBicCodeMetadataKey] = beneficiary.BankAccount.Bic
+	case 1:
+		expectedMetadata[models.AccountAccountNumberMetadataKey] = beneficiary.BankAccount.AccountNumber
+		expectedMetadata[models.BankAccountRoutingCodeMetadataKey] = beneficiary.BankAccount.SwiftSortCode
+	case 2:
+		expectedMetadata[models.AccountAccountNumberMetadataKey] = beneficiary.BankAccount.AccountNumber
+		expectedMetadata[models.BankAccountRoutingCodeMetadataKey] = beneficiary.BankAccount.RoutingNumber
+	}
+	Expect(resultingPSPAccount.Metadata).To(Equal(expectedMetadata))
 	Expect(resultingPSPAccount.Raw).To(Equal(expectedRaw))
 }
 
@@ -7,6 +7,7 @@ var capabilities = []models.Capability{
 	models.CAPABILITY_FETCH_BALANCES,
 	models.CAPABILITY_FETCH_EXTERNAL_ACCOUNTS,
 	models.CAPABILITY_FETCH_PAYMENTS,
+	models.CAPABILITY_FETCH_DISPUTES,
 
 	models.CAPABILITY_CREATE_TRANSFER,
 	models.CAPABILITY_CREATE_PAYOUT,
@@ -17,6 +17,7 @@ import (
 	"github.com/stripe/stripe-go/v80/balance"
 	"github.com/stripe/stripe-go/v80/balancetransaction"
 	"github.com/stripe/stripe-go/v80/bankaccount"
+	"github.com/stripe/stripe-go/v80/dispute"
 	"github.com/stripe/stripe-go/v80/payout"
 	"github.com/stripe/stripe-go/v80/transfer"
 	"github.com/stripe/stripe-go/v80/transferreversal"
@@ -34,6 +35,7 @@ type Client interface {
 	GetAccountBalances(ctx context.Context, accountID string) (*stripe.Balance, error)
 	GetExternalAccounts(ctx context.Context, accountID string, timeline Timeline, pageSize int64) ([]*stripe.BankAccount, Timeline, bool, error)
 	GetPayments(ctx context.Context, accountID string, timeline Timeline, pageSize int64) ([]*stripe.BalanceTransaction, Timeline, bool, error)
+	GetDisputes(ctx context.Context, accountID string, timeline Timeline, pageSize int64) ([]*stripe.Dispute, Timeline, bool, error)
 	CreatePayout(ctx context.Context, createPayoutRequest *CreatePayoutRequest) (*stripe.Payout, error)
 	CreateTransfer(ctx context.Context, createTransferRequest *CreateTransferRequest) (*stripe.Transfer, error)
 	ReverseTransfer(ctx context.Context, reverseTransferRequest ReverseTransferRequest) (*stripe.TransferReversal, error)
@@ -53,6 +55,7 @@ type client struct {
 	payoutClient             payout.Client
 	bankAccountClient        bankaccount.Client
 	balanceTransactionClient balancetransaction.Client
+	disputeClient            dispute.Client
 	webhookEndpointClient    webhookendpoint.Client
 }
 
@@ -83,6 +86,7 @@ func New(
 		payoutClient:             payout.Client{B: backend, Key: apiKey},
 		bankAccountClient:        bankaccount.Client{B: backend, Key: apiKey},
 		balanceTransactionClient: balancetransaction.Client{B: backend, Key: apiKey},
+		disputeClient:            dispute.Client{B: backend, Key: apiKey},
 		webhookEndpointClient:    webhookendpoint.Client{B: backend, Key: apiKey},
 	}, nil
 }
@@ -133,6 +133,23 @@ func (mr *MockClientMockRecorder) GetAccounts(ctx, timeline, pageSize any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccounts", reflect.TypeOf((*MockClient)(nil).GetAccounts), ctx, timeline, pageSize)
 }
 
+// GetDisputes mocks base method.
+func (m *MockClient) GetDisputes(ctx context.Context, accountID string, timeline Timeline, pageSize int64) ([]*stripe.Dispute, Timeline, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDisputes", ctx, accountID, timeline, pageSize)
+	ret0, _ := ret[0].([]*stripe.Dispute)
+	ret1, _ := ret[1].(Timeline)
+	ret2, _ := ret[2].(bool)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetDisputes indicates an expected call of GetDisputes.
+func (mr *MockClientMockRecorder) GetDisputes(ctx, accountID, timeline, pageSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDisputes", reflect.TypeOf((*MockClient)(nil).GetDisputes), ctx, accountID, timeline, pageSize)
+}
+
 // GetExternalAccounts mocks base method.
 func (m *MockClient) GetExternalAccounts(ctx context.Context, accountID string, timeline Timeline, pageSize int64) ([]*stripe.BankAccount, Timeline, bool, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,79 @@
+package client
+
+import (
+	"context"
+
+	"github.com/formancehq/payments/pkg/domain/metrics"
+	"github.com/stripe/stripe-go/v80"
+)
+
+// the charge is expanded so that the disputed charge's balance transaction,
+// which is the reference of the pay-in on our side, is available
+const expandCharge = "data.charge"
+
+func (c *client) GetDisputes(
+	ctx context.Context,
+	accountID string,
+	timeline Timeline,
+	pageSize int64,
+) (results []*stripe.Dispute, _ Timeline, hasMore bool, err error) {
+	results = make([]*stripe.Dispute, 0, int(pageSize))
+
+	if !timeline.IsCaughtUp() {
+		var backlog []interface{}
+		backlog, timeline, hasMore, err = fetchBacklog(timeline, pageSize, func(params stripe.ListParams) (stripe.ListContainer, error) {
+			if accountID != "" {
+				params.StripeAccount = &accountID
+			}
+			params.Context = metrics.OperationContext(ctx, "list_disputes_scan")
+			disputeParams := &stripe.DisputeListParams{ListParams: params}
+			disputeParams.AddExpand(expandCharge)
+			itr := c.disputeClient.List(disputeParams)
+			return itr.DisputeList(), wrapSDKErr(itr.Err())
+		})
+		if err != nil {
+			return results, timeline, false, err
+		}
+		for _, d := range backlog {
+			results = append(results, d.(*stripe.Dispute))
+		}
+
+		return results, timeline, hasMore, err
+	}
+
+	filters := stripe.ListParams{
+		Context:      metrics.OperationContext(ctx, "list_disputes"),
+		Limit:        limit(pageSize, len(results)),
+		EndingBefore: &timeline.LatestID,
+		Single:       true, // turn off autopagination
+	}
+	if accountID != "" {
+		filters.StripeAccount = &accountID
+	}
+
+	params := &stripe.DisputeListParams{ListParams: filters}
+	params.AddExpand(expandCharge)
+
+	itr := c.disputeClient.List(params)
+	if err := itr.Err(); err != nil {
+		return nil, timeline, false, wrapSDKErr(err)
+	}
+	data := reverseDisputes(itr.DisputeList().Data)
+	results = append(results, data...)
+	if len(results) == 0 {
+		return results, timeline, itr.DisputeList().HasMore, nil
+	}
+
+	timeline.LatestID = results[len(results)-1].ID
+	return results, timeline, itr.DisputeList().HasMore, nil
+}
+
+// Stripe now returns data in reverse chronological order no matter which params we provide so we need to reverse the slice
+func reverseDisputes(in []*stripe.Dispute) []*stripe.Dispute {
+	out := make([]*stripe.Dispute, len(in))
+	copy(out, in)
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return out
+}
@@ -0,0 +1,98 @@
+package client_test
+
+import (
+	"errors"
+
+	"github.com/formancehq/go-libs/v5/pkg/observe/log"
+	"github.com/formancehq/payments/ce/plugins/stripe/client"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stripe/stripe-go/v80"
+	gomock "go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Stripe Client Disputes", func() {
+	var (
+		logger = logging.NewDefaultLogger(GinkgoWriter, true, false, false)
+		cl     client.Client
+		ctrl   *gomock.Controller
+		b      *client.MockBackend
+		token  string
+		err    error
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		b = client.NewMockBackend(ctrl)
+		token = "dummy"
+		b.EXPECT().Call("GET", "/v1/account", token, nil, &stripe.Account{}).DoAndReturn(
+			func(_, _, _ string, _ any, account *stripe.Account) error {
+				account.ID = "rootID"
+				return nil
+			})
+		cl, err = client.New("test", logger, b, token)
+		Expect(err).To(BeNil())
+	})
+
+	Context("Get Disputes", func() {
+		var (
+			accountID = "someAccount"
+			pageSize  = 8
+		)
+
+		It("fails when underlying calls fail", func(ctx SpecContext) {
+			expectedErr := errors.New("some err")
+
+			b.EXPECT().CallRaw("GET", "/v1/disputes", token, gomock.Any(), gomock.Any(), gomock.Any()).Return(expectedErr)
+			_, _, _, err := cl.GetDisputes(
+				ctx,
+				accountID,
+				client.Timeline{},
+				int64(pageSize),
+			)
+			Expect(err).NotTo(BeNil())
+			Expect(err).To(MatchError(expectedErr))
+		})
+
+		It("returns backlog results and sets latest ID to newest entry", func(ctx SpecContext) {
+			b.EXPECT().CallRaw("GET", "/v1/disputes", token, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(
+				method, path, token string, p, p2 any, l *stripe.DisputeList,
+			) error {
+				l.Data = []*stripe.Dispute{{ID: "dp_3"}, {ID: "dp_2"}, {ID: "dp_1"}}
+				l.ListMeta = stripe.ListMeta{HasMore: false}
+				return nil
+			})
+			disputes, timeline, hasMore, err := cl.GetDisputes(
+				ctx,
+				accountID,
+				client.Timeline{},
+				int64(pageSize),
+			)
+			Expect(err).To(BeNil())
+			Expect(hasMore).To(BeFalse())
+			Expect(disputes).To(HaveLen(3))
+			Expect(timeline.LatestID).To(Equal("dp_3"))
+		})
+
+		It("returns new disputes in chronological order once caught up", func(ctx SpecContext) {
+			b.EXPECT().CallRaw("GET", "/v1/disputes", token, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(
+				method, path, token string, p, p2 any, l *stripe.DisputeList,
+			) error {
+				l.Data = []*stripe.Dispute{{ID: "dp_5"}, {ID: "dp_4"}}
+				l.ListMeta = stripe.ListMeta{HasMore: false}
+				return nil
+			})
+			disputes, timeline, _, err := cl.GetDisputes(
+				ctx,
+				accountID,
+				client.Timeline{LatestID: "dp_3"},
+				int64(pageSize),
+			)
+			Expect(err).To(BeNil())
+			Expect(disputes).To(HaveLen(2))
+			Expect(disputes[0].ID).To(Equal("dp_4"))
+			Expect(disputes[1].ID).To(Equal("dp_5"))
+			Expect(timeline.LatestID).To(Equal("dp_5"))
+		})
+	})
+})
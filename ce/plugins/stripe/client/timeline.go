@@ -107,6 +107,17 @@ func fetchBacklog(
 		for _, acc := range v.Data {
 			results = append(results, acc)
 		}
+
+	case *stripe.DisputeList:
+		if len(v.Data) == 0 {
+			return results, timeline, hasMore, nil
+		}
+		dispute := v.Data[len(v.Data)-1]
+		oldestID = dispute.ID
+		newestID = v.Data[0].ID
+		for _, d := range v.Data {
+			results = append(results, d)
+		}
 	default:
 		return results, timeline, hasMore, fmt.Errorf("failed to fetch backlog for type %T", list)
 	}
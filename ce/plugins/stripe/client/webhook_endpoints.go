@@ -16,15 +16,22 @@ type endpointConfig struct {
 	isConnect  bool
 }
 
+var eventTypes = []*string{
+	stripe.String(string(stripe.EventTypeBalanceAvailable)),
+	stripe.String(string(stripe.EventTypeChargeDisputeCreated)),
+	stripe.String(string(stripe.EventTypeChargeDisputeUpdated)),
+	stripe.String(string(stripe.EventTypeChargeDisputeClosed)),
+	stripe.String(string(stripe.EventTypeChargeDisputeFundsWithdrawn)),
+	stripe.String(string(stripe.EventTypeChargeDisputeFundsReinstated)),
+}
+
 var endpoints = []endpointConfig{
 	{
-		eventTypes: []*string{
-			stripe.String(string(stripe.EventTypeBalanceAvailable)),
-		},
-		isConnect: false,
+		eventTypes: eventTypes,
+		isConnect:  false,
 	},
 	{
-		eventTypes: []*string{stripe.String(string(stripe.EventTypeBalanceAvailable))},
+		eventTypes: eventTypes,
 		isConnect:  true,
 	},
 }
@@ -9,7 +9,7 @@ import (
 )
 
 type Config struct {
-	APIKey string `json:"apiKey" validate:"required"`
+	APIKey string `json:"apiKey" validate:"required" sensitive:"true"`
 }
 
 const PAGE_SIZE = 100 // max page size is 100
@@ -0,0 +1,132 @@
+package stripe
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/types/currency"
+	"github.com/formancehq/payments/ce/plugins/stripe/client"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/formancehq/payments/pkg/domain/plugins"
+	"github.com/pkg/errors"
+	stripesdk "github.com/stripe/stripe-go/v80"
+)
+
+type disputesState struct {
+	Timeline client.Timeline `json:"timeline"`
+}
+
+func (p *Plugin) fetchNextDisputes(ctx context.Context, req models.FetchNextDisputesRequest) (models.FetchNextDisputesResponse, error) {
+	var oldState disputesState
+	if req.State != nil {
+		if err := json.Unmarshal(req.State, &oldState); err != nil {
+			return models.FetchNextDisputesResponse{}, err
+		}
+	}
+
+	var from models.PSPAccount
+	if req.FromPayload == nil {
+		return models.FetchNextDisputesResponse{}, errors.New("missing from payload when fetching disputes")
+	}
+	if err := json.Unmarshal(req.FromPayload, &from); err != nil {
+		return models.FetchNextDisputesResponse{}, err
+	}
+
+	newState := oldState
+	rawDisputes, timeline, hasMore, err := p.client.GetDisputes(
+		ctx,
+		resolveAccount(from.Reference),
+		oldState.Timeline,
+		int64(req.PageSize),
+	)
+	if err != nil {
+		return models.FetchNextDisputesResponse{}, err
+	}
+	newState.Timeline = timeline
+
+	disputes := make([]models.PSPDispute, 0, len(rawDisputes))
+	for _, rawDispute := range rawDisputes {
+		dispute, err := toPSPDispute(rawDispute)
+		if err != nil {
+			if errors.Is(err, plugins.ErrCurrencyNotSupported) {
+				p.logger.WithField("reference", rawDispute.ID).Info("skipping dispute with unsupported currency")
+				continue
+			}
+			return models.FetchNextDisputesResponse{}, fmt.Errorf("failed to translate dispute: %w", err)
+		}
+		disputes = append(disputes, dispute)
+	}
+
+	payload, err := json.Marshal(newState)
+	if err != nil {
+		return models.FetchNextDisputesResponse{}, err
+	}
+	return models.FetchNextDisputesResponse{
+		Disputes: disputes,
+		NewState: payload,
+		HasMore:  hasMore,
+	}, nil
+}
+
+func toPSPDispute(dispute *stripesdk.Dispute) (models.PSPDispute, error) {
+	disputeCurrency := strings.ToUpper(string(dispute.Currency))
+	if _, ok := supportedCurrenciesWithDecimal[disputeCurrency]; !ok {
+		return models.PSPDispute{}, fmt.Errorf("%w %q", ErrUnsupportedCurrency, disputeCurrency)
+	}
+
+	raw, err := json.Marshal(dispute)
+	if err != nil {
+		return models.PSPDispute{}, fmt.Errorf("failed to marshal raw data: %w", err)
+	}
+
+	metadata := make(map[string]string)
+	appendMetadata(metadata, dispute.Metadata)
+
+	res := models.PSPDispute{
+		Reference: dispute.ID,
+		CreatedAt: time.Unix(dispute.Created, 0),
+		Amount:    big.NewInt(dispute.Amount),
+		Asset:     currency.FormatAsset(supportedCurrenciesWithDecimal, disputeCurrency),
+		Status:    toDisputeStatus(dispute.Status),
+		Reason:    string(dispute.Reason),
+		Metadata:  metadata,
+		Raw:       raw,
+	}
+
+	// charge payments are referenced by their balance transaction, which is
+	// only known when the charge was expanded
+	if dispute.Charge != nil && dispute.Charge.BalanceTransaction != nil && dispute.Charge.BalanceTransaction.ID != "" {
+		res.PaymentReference = &dispute.Charge.BalanceTransaction.ID
+	}
+
+	if dispute.EvidenceDetails != nil && dispute.EvidenceDetails.DueBy != 0 {
+		dueBy := time.Unix(dispute.EvidenceDetails.DueBy, 0)
+		res.EvidenceDueDate = &dueBy
+	}
+
+	return res, nil
+}
+
+func toDisputeStatus(status stripesdk.DisputeStatus) models.DisputeStatus {
+	switch status {
+	case stripesdk.DisputeStatusWarningNeedsResponse,
+		stripesdk.DisputeStatusWarningUnderReview:
+		return models.DISPUTE_STATUS_INQUIRY
+	case stripesdk.DisputeStatusWarningClosed:
+		return models.DISPUTE_STATUS_CLOSED
+	case stripesdk.DisputeStatusNeedsResponse:
+		return models.DISPUTE_STATUS_NEEDS_RESPONSE
+	case stripesdk.DisputeStatusUnderReview:
+		return models.DISPUTE_STATUS_UNDER_REVIEW
+	case stripesdk.DisputeStatusWon:
+		return models.DISPUTE_STATUS_WON
+	case stripesdk.DisputeStatusLost:
+		return models.DISPUTE_STATUS_LOST
+	default:
+		return models.DISPUTE_STATUS_UNKNOWN
+	}
+}
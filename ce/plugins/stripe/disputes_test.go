@@ -0,0 +1,152 @@
+package stripe
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/formancehq/go-libs/v5/pkg/observe/log"
+	"github.com/formancehq/payments/ce/plugins/stripe/client"
+	"github.com/formancehq/payments/pkg/domain/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	stripesdk "github.com/stripe/stripe-go/v80"
+	gomock "go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Stripe Plugin Disputes", func() {
+	var (
+		ctrl *gomock.Controller
+		m    *client.MockClient
+		plg  models.Plugin
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		m = client.NewMockClient(ctrl)
+		plg = &Plugin{client: m, logger: logging.Testing()}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("fetch next disputes", func() {
+		var (
+			pageSize       int
+			accRef         string
+			sampleDisputes []*stripesdk.Dispute
+		)
+
+		BeforeEach(func() {
+			pageSize = 10
+			accRef = "baseAcc"
+			sampleDisputes = []*stripesdk.Dispute{
+				{
+					ID:       "dp_1",
+					Amount:   1000,
+					Currency: stripesdk.CurrencyUSD,
+					Created:  1700000000,
+					Status:   stripesdk.DisputeStatusNeedsResponse,
+					Reason:   stripesdk.DisputeReasonFraudulent,
+					Charge: &stripesdk.Charge{
+						ID:                 "ch_1",
+						BalanceTransaction: &stripesdk.BalanceTransaction{ID: "txn_1"},
+					},
+					EvidenceDetails: &stripesdk.DisputeEvidenceDetails{DueBy: 1700864000},
+					Metadata:        map[string]string{"order": "42"},
+				},
+				{
+					ID:       "dp_2",
+					Amount:   500,
+					Currency: stripesdk.CurrencyEUR,
+					Created:  1700000100,
+					Status:   stripesdk.DisputeStatusWarningNeedsResponse,
+				},
+				{
+					ID:       "dp_3",
+					Amount:   500,
+					Currency: "xxx",
+					Created:  1700000200,
+					Status:   stripesdk.DisputeStatusWon,
+				},
+			}
+		})
+
+		It("fails when from payload is missing", func(ctx SpecContext) {
+			req := models.FetchNextDisputesRequest{
+				State:    json.RawMessage(`{}`),
+				PageSize: pageSize,
+			}
+			_, err := plg.FetchNextDisputes(ctx, req)
+			Expect(err).To(MatchError("missing from payload when fetching disputes"))
+		})
+
+		It("returns client errors", func(ctx SpecContext) {
+			req := models.FetchNextDisputesRequest{
+				FromPayload: json.RawMessage(fmt.Sprintf(`{"reference": "%s"}`, accRef)),
+				State:       json.RawMessage(`{}`),
+				PageSize:    pageSize,
+			}
+			m.EXPECT().GetDisputes(gomock.Any(), accRef, gomock.Any(), int64(pageSize)).Return(
+				nil, client.Timeline{}, false, fmt.Errorf("some error"),
+			)
+			_, err := plg.FetchNextDisputes(ctx, req)
+			Expect(err).To(MatchError("some error"))
+		})
+
+		It("fetches next disputes and skips unsupported currencies", func(ctx SpecContext) {
+			req := models.FetchNextDisputesRequest{
+				FromPayload: json.RawMessage(fmt.Sprintf(`{"reference": "%s"}`, accRef)),
+				State:       json.RawMessage(`{}`),
+				PageSize:    pageSize,
+			}
+			m.EXPECT().GetDisputes(gomock.Any(), accRef, gomock.Any(), int64(pageSize)).Return(
+				sampleDisputes,
+				client.Timeline{LatestID: "dp_3"},
+				true,
+				nil,
+			)
+			res, err := plg.FetchNextDisputes(ctx, req)
+			Expect(err).To(BeNil())
+			Expect(res.HasMore).To(BeTrue())
+			Expect(res.Disputes).To(HaveLen(2))
+
+			Expect(res.Disputes[0].Reference).To(Equal("dp_1"))
+			Expect(res.Disputes[0].Amount).To(Equal(big.NewInt(1000)))
+			Expect(res.Disputes[0].Asset).To(Equal("USD/2"))
+			Expect(res.Disputes[0].Status).To(Equal(models.DISPUTE_STATUS_NEEDS_RESPONSE))
+			Expect(res.Disputes[0].Reason).To(Equal("fraudulent"))
+			Expect(res.Disputes[0].PaymentReference).NotTo(BeNil())
+			Expect(*res.Disputes[0].PaymentReference).To(Equal("txn_1"))
+			Expect(res.Disputes[0].EvidenceDueDate.Unix()).To(Equal(int64(1700864000)))
+			Expect(res.Disputes[0].Metadata).To(HaveKeyWithValue("order", "42"))
+
+			Expect(res.Disputes[1].Reference).To(Equal("dp_2"))
+			Expect(res.Disputes[1].Status).To(Equal(models.DISPUTE_STATUS_INQUIRY))
+			Expect(res.Disputes[1].PaymentReference).To(BeNil())
+			Expect(res.Disputes[1].EvidenceDueDate).To(BeNil())
+
+			var state disputesState
+			err = json.Unmarshal(res.NewState, &state)
+			Expect(err).To(BeNil())
+			Expect(state.Timeline.LatestID).To(Equal("dp_3"))
+		})
+	})
+
+	Context("dispute status", func() {
+		DescribeTable("maps stripe statuses",
+			func(status stripesdk.DisputeStatus, expected models.DisputeStatus) {
+				Expect(toDisputeStatus(status)).To(Equal(expected))
+			},
+			Entry("warning needs response", stripesdk.DisputeStatusWarningNeedsResponse, models.DISPUTE_STATUS_INQUIRY),
+			Entry("warning under review", stripesdk.DisputeStatusWarningUnderReview, models.DISPUTE_STATUS_INQUIRY),
+			Entry("warning closed", stripesdk.DisputeStatusWarningClosed, models.DISPUTE_STATUS_CLOSED),
+			Entry("needs response", stripesdk.DisputeStatusNeedsResponse, models.DISPUTE_STATUS_NEEDS_RESPONSE),
+			Entry("under review", stripesdk.DisputeStatusUnderReview, models.DISPUTE_STATUS_UNDER_REVIEW),
+			Entry("won", stripesdk.DisputeStatusWon, models.DISPUTE_STATUS_WON),
+			Entry("lost", stripesdk.DisputeStatusLost, models.DISPUTE_STATUS_LOST),
+			Entry("unknown", stripesdk.DisputeStatus("other"), models.DISPUTE_STATUS_UNKNOWN),
+		)
+	})
+})
@@ -524,9 +524,78 @@ func (p *Plugin) translatePayment(accountRef *string, balanceTransaction *stripe
 		return nil, nil
 	}
 
+	if payment != nil {
+		payment.Fees = p.feeDetailsToPaymentFees(balanceTransaction)
+	}
+
 	return payment, err
 }
 
+// feeDetailsToPaymentFees aggregates the fee details of a balance transaction
+// by fee type and currency. Refunded fees come as negative details, they are
+// netted against the charged ones and the fees which do not add up to a
+// positive amount are dropped, payment fees are always positive.
+func (p *Plugin) feeDetailsToPaymentFees(balanceTransaction *stripesdk.BalanceTransaction) []models.PaymentFee {
+	var fees []models.PaymentFee
+	for _, detail := range balanceTransaction.FeeDetails {
+		if detail == nil || detail.Amount == 0 {
+			continue
+		}
+
+		feeCurrency := strings.ToUpper(string(detail.Currency))
+		if _, ok := supportedCurrenciesWithDecimal[feeCurrency]; !ok {
+			p.logger.WithField("reference", balanceTransaction.ID).Infof("skipping fee with unsupported currency %q", feeCurrency)
+			continue
+		}
+
+		fee := models.PaymentFee{
+			Type:   feeDetailTypeToPaymentFeeType(detail.Type),
+			Amount: big.NewInt(detail.Amount),
+			Asset:  currency.FormatAsset(supportedCurrenciesWithDecimal, feeCurrency),
+		}
+
+		merged := false
+		for i := range fees {
+			if fees[i].Type == fee.Type && fees[i].Asset == fee.Asset {
+				fees[i].Amount.Add(fees[i].Amount, fee.Amount)
+				merged = true
+				break
+			}
+		}
+		if !merged {
+			fees = append(fees, fee)
+		}
+	}
+
+	res := fees[:0]
+	for _, fee := range fees {
+		if fee.Amount.Sign() <= 0 {
+			p.logger.WithField("reference", balanceTransaction.ID).Infof("skipping refunded %s fee in %s", fee.Type, fee.Asset)
+			continue
+		}
+		res = append(res, fee)
+	}
+	if len(res) == 0 {
+		return nil
+	}
+	return res
+}
+
+func feeDetailTypeToPaymentFeeType(feeType string) models.PaymentFeeType {
+	switch feeType {
+	case "stripe_fee":
+		return models.PAYMENT_FEE_TYPE_PROCESSING
+	case "application_fee":
+		return models.PAYMENT_FEE_TYPE_PLATFORM
+	case "payment_method_passthrough_fee":
+		return models.PAYMENT_FEE_TYPE_NETWORK
+	case "tax":
+		return models.PAYMENT_FEE_TYPE_TAX
+	default:
+		return models.PAYMENT_FEE_TYPE_OTHER
+	}
+}
+
 func convertDisputeStatus(status stripesdk.DisputeStatus) models.PaymentStatus {
 	switch status {
 	case stripesdk.DisputeStatusNeedsResponse, stripesdk.DisputeStatusUnderReview:
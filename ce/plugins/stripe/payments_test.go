@@ -3,6 +3,7 @@ package stripe
 import (
 	"encoding/json"
 	"fmt"
+	"math/big"
 
 	"github.com/formancehq/go-libs/v5/pkg/observe/log"
 	"github.com/formancehq/payments/ce/plugins/stripe/client"
@@ -44,6 +45,12 @@ var _ = Describe("Stripe Plugin Payments", func() {
 				{
 					ID:   "charge",
 					Type: stripesdk.BalanceTransactionTypeCharge,
+					FeeDetails: []*stripesdk.BalanceTransactionFeeDetail{
+						{Amount: 30, Currency: stripesdk.CurrencyEUR, Type: "stripe_fee"},
+						{Amount: 10, Currency: stripesdk.CurrencyEUR, Type: "application_fee"},
+						{Amount: 5, Currency: stripesdk.CurrencyEUR, Type: "stripe_fee"},
+						{Amount: 7, Currency: stripesdk.CurrencyEEK, Type: "tax"},
+					},
 					Source: &stripesdk.BalanceTransactionSource{
 						Charge: &stripesdk.Charge{
 							Currency:             stripesdk.CurrencyBIF,
@@ -263,6 +270,11 @@ var _ = Describe("Stripe Plugin Payments", func() {
 			Expect(res.Payments[0].Reference).To(Equal(samplePayments[0].ID))
 			Expect(res.Payments[0].Type).To(Equal(models.PAYMENT_TYPE_PAYIN))
 			Expect(res.Payments[0].Status).To(Equal(models.PAYMENT_STATUS_SUCCEEDED))
+			Expect(res.Payments[0].Fees).To(Equal([]models.PaymentFee{
+				{Type: models.PAYMENT_FEE_TYPE_PROCESSING, Amount: big.NewInt(35), Asset: "EUR/2"},
+				{Type: models.PAYMENT_FEE_TYPE_PLATFORM, Amount: big.NewInt(10), Asset: "EUR/2"},
+			}))
+			Expect(res.Payments[1].Fees).To(BeEmpty())
 			Expect(res.Payments[1].Reference).To(Equal(samplePayments[1].ID))
 			Expect(res.Payments[1].ParentReference).To(Equal(samplePayments[1].Source.Refund.Charge.BalanceTransaction.ID))
 			Expect(res.Payments[1].Type).To(Equal(models.PAYMENT_TYPE_PAYIN))
@@ -321,4 +333,34 @@ var _ = Describe("Stripe Plugin Payments", func() {
 			Expect(state.Timeline.LatestID).To(Equal(samplePayments[len(samplePayments)-1].ID))
 		})
 	})
+
+	Context("fee details", func() {
+		It("nets refunded fees and drops the ones which are not positive", func() {
+			fees := plg.feeDetailsToPaymentFees(&stripesdk.BalanceTransaction{
+				ID: "refund",
+				FeeDetails: []*stripesdk.BalanceTransactionFeeDetail{
+					{Amount: -30, Currency: stripesdk.CurrencyEUR, Type: "stripe_fee"},
+					{Amount: 10, Currency: stripesdk.CurrencyEUR, Type: "application_fee"},
+					{Amount: -4, Currency: stripesdk.CurrencyEUR, Type: "application_fee"},
+					{Amount: -2, Currency: stripesdk.CurrencyEUR, Type: "tax"},
+				},
+			})
+			Expect(fees).To(Equal([]models.PaymentFee{
+				{Type: models.PAYMENT_FEE_TYPE_PLATFORM, Amount: big.NewInt(6), Asset: "EUR/2"},
+			}))
+			for _, fee := range fees {
+				Expect(fee.Validate()).To(Succeed())
+			}
+		})
+
+		It("returns no fees when they are all refunded", func() {
+			fees := plg.feeDetailsToPaymentFees(&stripesdk.BalanceTransaction{
+				ID: "refund",
+				FeeDetails: []*stripesdk.BalanceTransactionFeeDetail{
+					{Amount: -30, Currency: stripesdk.CurrencyEUR, Type: "stripe_fee"},
+				},
+			})
+			Expect(fees).To(BeNil())
+		})
+	})
 })
@@ -22,6 +22,13 @@ var Registration = pkgplugins.Registration{
 	Capabilities: capabilities,
 	RawConf:      Config{},
 	PageSize:     PAGE_SIZE,
+	// Stripe allows 100 requests per second in live mode and 25 in test mode.
+	// A call may list several pages while scanning for the oldest object, so
+	// the budget keeps a margin below the test mode limit.
+	RateBudget: &models.RateBudget{
+		CallsPerSecond: 5,
+		Burst:          5,
+	},
 }
 
 type Plugin struct {
@@ -117,6 +124,13 @@ func (p *Plugin) FetchNextPayments(ctx context.Context, req models.FetchNextPaym
 	return p.fetchNextPayments(ctx, req)
 }
 
+func (p *Plugin) FetchNextDisputes(ctx context.Context, req models.FetchNextDisputesRequest) (models.FetchNextDisputesResponse, error) {
+	if p.client == nil {
+		return models.FetchNextDisputesResponse{}, pkgplugins.ErrNotYetInstalled
+	}
+	return p.fetchNextDisputes(ctx, req)
+}
+
 func (p *Plugin) CreateTransfer(ctx context.Context, req models.CreateTransferRequest) (models.CreateTransferResponse, error) {
 	if p.client == nil {
 		return models.CreateTransferResponse{}, pkgplugins.ErrNotYetInstalled
@@ -107,6 +107,16 @@ var _ = Describe("Stripe Plugin", func() {
 		// Other tests will be in payments_test.go
 	})
 
+	Context("fetch next disputes", func() {
+		It("should fail when called before install", func(ctx SpecContext) {
+			req := models.FetchNextDisputesRequest{State: json.RawMessage(`{}`)}
+			_, err := plg.FetchNextDisputes(ctx, req)
+			Expect(err).To(MatchError(plugins.ErrNotYetInstalled))
+		})
+
+		// Other tests will be in disputes_test.go
+	})
+
 	Context("fetch next others", func() {
 		It("should fail because not implemented", func(ctx SpecContext) {
 			req := models.FetchNextOthersRequest{State: json.RawMessage(`{}`)}
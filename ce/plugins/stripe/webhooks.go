@@ -19,7 +19,12 @@ var (
 	webhookRelatedAccountIDKey = "webhook_related_account_id"
 
 	supportedWebhooks = map[stripe.EventType]TranslateWebhookFunc{
-		stripe.EventTypeBalanceAvailable: translateBalanceWebhook,
+		stripe.EventTypeBalanceAvailable:             translateBalanceWebhook,
+		stripe.EventTypeChargeDisputeCreated:         translateDisputeWebhook,
+		stripe.EventTypeChargeDisputeUpdated:         translateDisputeWebhook,
+		stripe.EventTypeChargeDisputeClosed:          translateDisputeWebhook,
+		stripe.EventTypeChargeDisputeFundsWithdrawn:  translateDisputeWebhook,
+		stripe.EventTypeChargeDisputeFundsReinstated: translateDisputeWebhook,
 	}
 )
 
@@ -131,3 +136,27 @@ func translateBalanceWebhook(
 	}
 	return responses, nil
 }
+
+func translateDisputeWebhook(
+	ctx context.Context,
+	accountRef string,
+	evt *stripe.Event,
+) ([]models.WebhookResponse, error) {
+	var dispute stripe.Dispute
+	err := json.Unmarshal(evt.Data.Raw, &dispute)
+	if err != nil {
+		return []models.WebhookResponse{}, fmt.Errorf("failed to parse %q webhook JSON: %w", evt.Type, err)
+	}
+
+	// the charge is not expanded in webhook payloads, so the payment reference
+	// is left empty and the one stored when polling is kept
+	pspDispute, err := toPSPDispute(&dispute)
+	if err != nil {
+		return []models.WebhookResponse{}, err
+	}
+	return []models.WebhookResponse{
+		{
+			Dispute: &pspDispute,
+		},
+	}, nil
+}
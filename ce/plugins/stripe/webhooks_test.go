@@ -233,5 +233,50 @@ var _ = Describe("Stripe Plugin Webhooks", func() {
 			Expect(res.Responses[0].Balance.Asset).To(Equal("AUD/2"))
 			Expect(res.Responses[0].Balance.Amount).To(Equal(big.NewInt(balance.Available[0].Amount)))
 		})
+
+		It("translates a charge.dispute.updated webhook", func(ctx SpecContext) {
+			innerPayload := []byte(`{
+				"id": "dp_1",
+				"object": "dispute",
+				"amount": 1000,
+				"currency": "usd",
+				"charge": "ch_1",
+				"created": 1700000000,
+				"status": "under_review",
+				"reason": "fraudulent",
+				"evidence_details": {"due_by": 1700864000}
+			}`)
+
+			e := &stripe.Event{
+				Created:    time.Now().Unix(),
+				APIVersion: stripe.APIVersion,
+				Type:       stripe.EventTypeChargeDisputeUpdated,
+				Data:       &stripe.EventData{Raw: json.RawMessage(innerPayload)},
+			}
+			payload, err := json.Marshal(e)
+			Expect(err).To(BeNil())
+
+			req := models.TranslateWebhookRequest{
+				Name:    "some_name",
+				Webhook: pspWebhook(secret, payload),
+				Config: &models.WebhookConfig{
+					Metadata: map[string]string{
+						"secret":                   secret,
+						webhookRelatedAccountIDKey: rootAccount,
+					},
+				},
+			}
+			res, err := plg.TranslateWebhook(ctx, req)
+			Expect(err).To(BeNil())
+			Expect(res.Responses).To(HaveLen(1))
+			Expect(res.Responses[0].Dispute).NotTo(BeNil())
+			Expect(res.Responses[0].Dispute.Reference).To(Equal("dp_1"))
+			Expect(res.Responses[0].Dispute.Status).To(Equal(models.DISPUTE_STATUS_UNDER_REVIEW))
+			Expect(res.Responses[0].Dispute.Asset).To(Equal("USD/2"))
+			Expect(res.Responses[0].Dispute.Amount).To(Equal(big.NewInt(1000)))
+			Expect(res.Responses[0].Dispute.Reason).To(Equal("fraudulent"))
+			Expect(res.Responses[0].Dispute.PaymentReference).To(BeNil())
+			Expect(res.Responses[0].Dispute.EvidenceDueDate.Unix()).To(Equal(int64(1700864000)))
+		})
 	})
 })
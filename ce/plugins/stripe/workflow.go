@@ -21,6 +21,12 @@ func workflow() models.ConnectorTasksTree {
 					Periodically: true,
 					NextTasks:    []models.ConnectorTaskTree{},
 				},
+				{
+					TaskType:     models.TASK_FETCH_DISPUTES,
+					Name:         "fetch_disputes",
+					Periodically: true,
+					NextTasks:    []models.ConnectorTaskTree{},
+				},
 				{
 					TaskType:     models.TASK_FETCH_EXTERNAL_ACCOUNTS,
 					Name:         "fetch_recipients",
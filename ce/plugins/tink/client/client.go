@@ -20,6 +20,9 @@ type Client interface {
 	GetAccountTransactionsDeletedWebhook(ctx context.Context, payload []byte) (AccountTransactionsDeletedWebhook, error)
 	GetRefreshFinishedWebhook(ctx context.Context, payload []byte) (RefreshFinishedWebhook, error)
 	GetAccountCreatedWebhook(ctx context.Context, payload []byte) (AccountCreatedWebhook, error)
+	GetPaymentUpdatedWebhook(ctx context.Context, payload []byte) (PaymentUpdatedWebhook, error)
+	CreatePaymentRequest(ctx context.Context, request CreatePaymentRequestRequest) (CreatePaymentRequestResponse, error)
+	GetPaymentRequest(ctx context.Context, paymentRequestID string) (PaymentRequest, error)
 	DeleteUserConnection(ctx context.Context, req DeleteUserConnectionRequest) error
 	DeleteUser(ctx context.Context, req DeleteUserRequest) error
 	ListTransactions(ctx context.Context, req ListTransactionRequest) (ListTransactionResponse, error)
@@ -28,8 +31,9 @@ type Client interface {
 }
 
 type client struct {
-	httpClient httpwrapper.Client
-	userClient httpwrapper.Client
+	httpClient        httpwrapper.Client
+	paymentHTTPClient httpwrapper.Client
+	userClient        httpwrapper.Client
 
 	connectorName string
 	clientID      string
@@ -40,6 +44,22 @@ type client struct {
 func New(connectorName, clientID, clientSecret, endpoint string) Client {
 	endpoint = strings.TrimSuffix(endpoint, "/")
 
+	c := &client{
+		httpClient:        newClientCredentialsHTTPClient(connectorName, clientID, clientSecret, endpoint, allScopes),
+		paymentHTTPClient: newClientCredentialsHTTPClient(connectorName, clientID, clientSecret, endpoint, paymentScopes),
+
+		connectorName: connectorName,
+		clientID:      clientID,
+		clientSecret:  clientSecret,
+		endpoint:      endpoint,
+	}
+
+	c.userClient = c.createUserHTTPClient()
+
+	return c
+}
+
+func newClientCredentialsHTTPClient(connectorName, clientID, clientSecret, endpoint string, scopes []Scopes) httpwrapper.Client {
 	config := &httpwrapper.Config{
 		Transport: metrics.NewTransport(connectorName, metrics.TransportOpts{}),
 		OAuthConfig: &clientcredentials.Config{
@@ -47,8 +67,8 @@ func New(connectorName, clientID, clientSecret, endpoint string) Client {
 			ClientSecret: clientSecret,
 			TokenURL:     fmt.Sprintf("%s/api/v1/oauth/token", endpoint),
 			Scopes: func() []string {
-				out := make([]string, len(allScopes))
-				for i, s := range allScopes {
+				out := make([]string, len(scopes))
+				for i, s := range scopes {
 					out[i] = string(s)
 				}
 				return out
@@ -56,18 +76,7 @@ func New(connectorName, clientID, clientSecret, endpoint string) Client {
 		},
 	}
 
-	c := &client{
-		httpClient: httpwrapper.NewClient(config),
-
-		connectorName: connectorName,
-		clientID:      clientID,
-		clientSecret:  clientSecret,
-		endpoint:      endpoint,
-	}
-
-	c.userClient = c.createUserHTTPClient()
-
-	return c
+	return httpwrapper.NewClient(config)
 }
 
 func (c *client) createUserHTTPClient() httpwrapper.Client {
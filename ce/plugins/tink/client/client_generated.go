@@ -40,6 +40,21 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// CreatePaymentRequest mocks base method.
+func (m *MockClient) CreatePaymentRequest(ctx context.Context, request CreatePaymentRequestRequest) (CreatePaymentRequestResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePaymentRequest", ctx, request)
+	ret0, _ := ret[0].(CreatePaymentRequestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePaymentRequest indicates an expected call of CreatePaymentRequest.
+func (mr *MockClientMockRecorder) CreatePaymentRequest(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentRequest", reflect.TypeOf((*MockClient)(nil).CreatePaymentRequest), ctx, request)
+}
+
 // CreateTemporaryAuthorizationCode mocks base method.
 func (m *MockClient) CreateTemporaryAuthorizationCode(ctx context.Context, request CreateTemporaryCodeRequest) (CreateTemporaryCodeResponse, error) {
 	m.ctrl.T.Helper()
@@ -187,6 +202,36 @@ func (mr *MockClientMockRecorder) GetAccountTransactionsModifiedWebhook(ctx, pay
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountTransactionsModifiedWebhook", reflect.TypeOf((*MockClient)(nil).GetAccountTransactionsModifiedWebhook), ctx, payload)
 }
 
+// GetPaymentRequest mocks base method.
+func (m *MockClient) GetPaymentRequest(ctx context.Context, paymentRequestID string) (PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentRequest", ctx, paymentRequestID)
+	ret0, _ := ret[0].(PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentRequest indicates an expected call of GetPaymentRequest.
+func (mr *MockClientMockRecorder) GetPaymentRequest(ctx, paymentRequestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentRequest", reflect.TypeOf((*MockClient)(nil).GetPaymentRequest), ctx, paymentRequestID)
+}
+
+// GetPaymentUpdatedWebhook mocks base method.
+func (m *MockClient) GetPaymentUpdatedWebhook(ctx context.Context, payload []byte) (PaymentUpdatedWebhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentUpdatedWebhook", ctx, payload)
+	ret0, _ := ret[0].(PaymentUpdatedWebhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentUpdatedWebhook indicates an expected call of GetPaymentUpdatedWebhook.
+func (mr *MockClientMockRecorder) GetPaymentUpdatedWebhook(ctx, payload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentUpdatedWebhook", reflect.TypeOf((*MockClient)(nil).GetPaymentUpdatedWebhook), ctx, payload)
+}
+
 // GetRefreshFinishedWebhook mocks base method.
 func (m *MockClient) GetRefreshFinishedWebhook(ctx context.Context, payload []byte) (RefreshFinishedWebhook, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,88 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/formancehq/payments/pkg/domain/metrics"
+)
+
+type PaymentRequestDestination struct {
+	AccountNumber string `json:"accountNumber"`
+	Type          string `json:"type"`
+}
+
+type PaymentRequestRemittanceInformation struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type CreatePaymentRequestRequest struct {
+	Destinations          []PaymentRequestDestination          `json:"destinations"`
+	Amount                json.Number                          `json:"amount"`
+	Currency              string                               `json:"currency"`
+	Market                string                               `json:"market"`
+	RecipientName         string                               `json:"recipientName"`
+	SourceMessage         string                               `json:"sourceMessage,omitempty"`
+	RemittanceInformation *PaymentRequestRemittanceInformation `json:"remittanceInformation,omitempty"`
+}
+
+type CreatePaymentRequestResponse struct {
+	ID string `json:"id"`
+}
+
+func (c *client) CreatePaymentRequest(ctx context.Context, request CreatePaymentRequestRequest) (CreatePaymentRequestResponse, error) {
+	ctx = context.WithValue(ctx, metrics.MetricOperationContextKey, "create_payment_request")
+
+	body, err := json.Marshal(&request)
+	if err != nil {
+		return CreatePaymentRequestResponse{}, err
+	}
+
+	endpoint := fmt.Sprintf("%s/api/v1/payments/requests", c.endpoint)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewBuffer(body))
+	if err != nil {
+		return CreatePaymentRequestResponse{}, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	var resp CreatePaymentRequestResponse
+	_, err = c.paymentHTTPClient.Do(ctx, req, &resp, nil)
+	if err != nil {
+		return CreatePaymentRequestResponse{}, fmt.Errorf("failed to create payment request: %w", err)
+	}
+
+	return resp, nil
+}
+
+type PaymentRequest struct {
+	ID            string                      `json:"id"`
+	Amount        json.Number                 `json:"amount"`
+	Currency      string                      `json:"currency"`
+	Market        string                      `json:"market"`
+	RecipientName string                      `json:"recipientName"`
+	Destinations  []PaymentRequestDestination `json:"destinations"`
+	Created       int64                       `json:"created"`
+	Updated       int64                       `json:"updated"`
+}
+
+func (c *client) GetPaymentRequest(ctx context.Context, paymentRequestID string) (PaymentRequest, error) {
+	ctx = context.WithValue(ctx, metrics.MetricOperationContextKey, "get_payment_request")
+
+	endpoint := fmt.Sprintf("%s/api/v1/payments/requests/%s", c.endpoint, paymentRequestID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return PaymentRequest{}, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var resp PaymentRequest
+	_, err = c.paymentHTTPClient.Do(ctx, req, &resp, nil)
+	if err != nil {
+		return PaymentRequest{}, fmt.Errorf("failed to get payment request: %w", err)
+	}
+
+	return resp, nil
+}
@@ -22,6 +22,9 @@ const (
 
 	SCOPES_TRANSACTIONS_READ Scopes = "transactions:read"
 
+	SCOPES_PAYMENT_READ  Scopes = "payment:read"
+	SCOPES_PAYMENT_WRITE Scopes = "payment:write"
+
 	SCOPES_WEBHOOKS Scopes = "webhook-endpoints"
 )
 
@@ -40,3 +43,11 @@ var allScopes = []Scopes{
 	SCOPES_TRANSACTIONS_READ,
 	SCOPES_WEBHOOKS,
 }
+
+// paymentScopes are requested on their own token, only by the payment
+// requests calls: Tink apps provisioned for account information only are not
+// granted them and would fail to get any token otherwise.
+var paymentScopes = []Scopes{
+	SCOPES_PAYMENT_READ,
+	SCOPES_PAYMENT_WRITE,
+}
@@ -20,6 +20,7 @@ const (
 	AccountCreated                    WebhookEventType = "account:created"
 	AccountUpdated                    WebhookEventType = "account:updated"
 	RefreshFinished                   WebhookEventType = "refresh:finished"
+	PaymentUpdated                    WebhookEventType = "payment:updated"
 )
 
 type CreateWebhookRequest struct {
@@ -258,3 +259,30 @@ func (c *client) GetRefreshFinishedWebhook(ctx context.Context, payload []byte)
 
 	return base.Content, nil
 }
+
+type PaymentUpdatedWebhook struct {
+	UserID           string `json:"userId"`
+	ExternalUserID   string `json:"externalUserId"`
+	PaymentRequestID string `json:"paymentRequestId"`
+	PaymentID        string `json:"paymentId"`
+	Status           string `json:"status"`
+	StatusMessage    string `json:"statusMessage"`
+	Updated          int64  `json:"updated"`
+}
+
+func (c *client) GetPaymentUpdatedWebhook(ctx context.Context, payload []byte) (PaymentUpdatedWebhook, error) {
+	type baseWebhook struct {
+		Context WebhookContext        `json:"context"`
+		Content PaymentUpdatedWebhook `json:"content"`
+	}
+
+	var base baseWebhook
+	if err := json.Unmarshal(payload, &base); err != nil {
+		return PaymentUpdatedWebhook{}, err
+	}
+
+	base.Content.UserID = base.Context.UserID
+	base.Content.ExternalUserID = base.Context.ExternalUserID
+
+	return base.Content, nil
+}
@@ -11,7 +11,8 @@ import (
 const (
 	UserIDMetadataKey = "user_id"
 
-	tinkLinkBaseURL = "https://link.tink.com/1.0/transactions"
+	tinkLinkBaseURL        = "https://link.tink.com/1.0/transactions"
+	tinkPaymentLinkBaseURL = "https://link.tink.com/1.0/pay"
 )
 
 const PAGE_SIZE = 100 // max page size is 100
@@ -60,7 +61,7 @@ var (
 
 type Config struct {
 	ClientID     string `json:"clientID" validate:"required"`
-	ClientSecret string `json:"clientSecret" validate:"required"`
+	ClientSecret string `json:"clientSecret" validate:"required" sensitive:"true"`
 	Endpoint     string `json:"endpoint" validate:"required"`
 }
 
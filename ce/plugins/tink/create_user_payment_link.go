@@ -0,0 +1,123 @@
+package tink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/formancehq/go-libs/v5/pkg/types/currency"
+	"github.com/formancehq/payments/ce/plugins/tink/client"
+	"github.com/formancehq/payments/pkg/domain/models"
+)
+
+func validateCreateUserPaymentLinkRequest(req models.CreateUserPaymentLinkRequest) error {
+	if req.PaymentServiceUser == nil {
+		return fmt.Errorf("missing payment service user: %w", models.ErrInvalidRequest)
+	}
+
+	if req.FormanceRedirectURL == nil || *req.FormanceRedirectURL == "" {
+		return fmt.Errorf("missing formanceRedirectURL: %w", models.ErrInvalidRequest)
+	}
+
+	if req.CallBackState == "" {
+		return fmt.Errorf("missing callBackState: %w", models.ErrInvalidRequest)
+	}
+
+	if req.PaymentServiceUser.Address == nil || req.PaymentServiceUser.Address.Country == nil {
+		return fmt.Errorf("missing payment service user country: %w", models.ErrInvalidRequest)
+	}
+
+	if _, ok := supportedMarkets[*req.PaymentServiceUser.Address.Country]; !ok {
+		return fmt.Errorf("unsupported payment service user country: %s: %w", *req.PaymentServiceUser.Address.Country, models.ErrInvalidRequest)
+	}
+
+	if req.PaymentServiceUser.ContactDetails == nil ||
+		req.PaymentServiceUser.ContactDetails.Locale == nil ||
+		*req.PaymentServiceUser.ContactDetails.Locale == "" {
+		return fmt.Errorf("missing payment service user locale: %w", models.ErrInvalidRequest)
+	}
+
+	if _, ok := supportedLocales[*req.PaymentServiceUser.ContactDetails.Locale]; !ok {
+		return fmt.Errorf("unsupported payment service user locale: %s: %w", *req.PaymentServiceUser.ContactDetails.Locale, models.ErrInvalidRequest)
+	}
+
+	if req.PaymentInitiation.Amount == nil {
+		return fmt.Errorf("missing payment initiation amount: %w", models.ErrInvalidRequest)
+	}
+
+	if req.PaymentInitiation.DestinationAccount == nil ||
+		req.PaymentInitiation.DestinationAccount.Metadata[models.AccountIBANMetadataKey] == "" {
+		return fmt.Errorf("missing destination account iban: %w", models.ErrInvalidRequest)
+	}
+
+	return nil
+}
+
+func (p *Plugin) createUserPaymentLink(ctx context.Context, req models.CreateUserPaymentLinkRequest) (models.CreateUserPaymentLinkResponse, error) {
+	if err := validateCreateUserPaymentLinkRequest(req); err != nil {
+		return models.CreateUserPaymentLinkResponse{}, err
+	}
+
+	curr, precision, err := currency.GetCurrencyAndPrecisionFromAsset(currency.ISO4217Currencies, req.PaymentInitiation.Asset)
+	if err != nil {
+		return models.CreateUserPaymentLinkResponse{}, fmt.Errorf("failed to get currency and precision from asset: %v: %w", err, models.ErrInvalidRequest)
+	}
+
+	amount, err := currency.GetStringAmountFromBigIntWithPrecision(req.PaymentInitiation.Amount, precision)
+	if err != nil {
+		return models.CreateUserPaymentLinkResponse{}, fmt.Errorf("failed to get string amount from big int: %v: %w", err, models.ErrInvalidRequest)
+	}
+
+	destination := req.PaymentInitiation.DestinationAccount
+	recipientName := destination.Metadata[models.AccountBankAccountNameMetadataKey]
+	if recipientName == "" && destination.Name != nil {
+		recipientName = *destination.Name
+	}
+
+	paymentRequest := client.CreatePaymentRequestRequest{
+		Destinations: []client.PaymentRequestDestination{
+			{
+				AccountNumber: destination.Metadata[models.AccountIBANMetadataKey],
+				Type:          "iban",
+			},
+		},
+		Amount:        json.Number(amount),
+		Currency:      curr,
+		Market:        *req.PaymentServiceUser.Address.Country,
+		RecipientName: recipientName,
+		SourceMessage: req.PaymentInitiation.Description,
+		RemittanceInformation: &client.PaymentRequestRemittanceInformation{
+			Type:  "UNSTRUCTURED",
+			Value: req.PaymentInitiation.Reference,
+		},
+	}
+
+	resp, err := p.client.CreatePaymentRequest(ctx, paymentRequest)
+	if err != nil {
+		return models.CreateUserPaymentLinkResponse{}, err
+	}
+
+	u, err := url.Parse(tinkPaymentLinkBaseURL)
+	if err != nil {
+		return models.CreateUserPaymentLinkResponse{}, err
+	}
+
+	// We have to build the query manually because we don't want to escape the
+	// redirect url
+	query := url.Values{}
+	query.Add("client_id", p.clientID)
+	query.Add("payment_request_id", resp.ID)
+	query.Add("state", req.CallBackState)
+	query.Add("market", *req.PaymentServiceUser.Address.Country)
+	query.Add("locale", *req.PaymentServiceUser.ContactDetails.Locale)
+	u.RawQuery = query.Encode()
+	// We need to add the redirect URI to the query string directly because
+	// the encoded redirect URI is not UI friendly
+	u.RawQuery += "&redirect_uri=" + *req.FormanceRedirectURL
+
+	return models.CreateUserPaymentLinkResponse{
+		Link:                    u.String(),
+		PaymentRequestReference: resp.ID,
+	}, nil
+}
@@ -0,0 +1,141 @@
+package tink
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+
+	"github.com/formancehq/payments/ce/plugins/tink/client"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gomock "go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Tink *Plugin Create User Payment Link", func() {
+	Context("create user payment link", func() {
+		var (
+			ctrl *gomock.Controller
+			plg  models.Plugin
+			m    *client.MockClient
+
+			req models.CreateUserPaymentLinkRequest
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			m = client.NewMockClient(ctrl)
+			plg = &Plugin{
+				client:   m,
+				clientID: "test_client_id",
+			}
+
+			country := "FR"
+			locale := "fr_FR"
+			redirectURL := "https://example.com/callback"
+			req = models.CreateUserPaymentLinkRequest{
+				PaymentServiceUser: &models.PSPPaymentServiceUser{
+					ID:   uuid.New(),
+					Name: "Test User",
+					Address: &models.Address{
+						Country: &country,
+					},
+					ContactDetails: &models.ContactDetails{
+						Locale: &locale,
+					},
+				},
+				PaymentInitiation: models.PSPPaymentInitiation{
+					Reference:   "ref_123",
+					Description: "test payment",
+					Amount:      big.NewInt(1050),
+					Asset:       "EUR/2",
+					DestinationAccount: &models.PSPAccount{
+						Reference: "account_123",
+						Metadata: map[string]string{
+							models.AccountIBANMetadataKey:            "FR7630006000011234567890189",
+							models.AccountBankAccountNameMetadataKey: "Merchant",
+						},
+					},
+				},
+				FormanceRedirectURL: &redirectURL,
+				CallBackState:       "test_state",
+			}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should create user payment link successfully", func(ctx SpecContext) {
+			m.EXPECT().CreatePaymentRequest(gomock.Any(), client.CreatePaymentRequestRequest{
+				Destinations: []client.PaymentRequestDestination{
+					{
+						AccountNumber: "FR7630006000011234567890189",
+						Type:          "iban",
+					},
+				},
+				Amount:        json.Number("10.50"),
+				Currency:      "EUR",
+				Market:        "FR",
+				RecipientName: "Merchant",
+				SourceMessage: "test payment",
+				RemittanceInformation: &client.PaymentRequestRemittanceInformation{
+					Type:  "UNSTRUCTURED",
+					Value: "ref_123",
+				},
+			}).Return(client.CreatePaymentRequestResponse{ID: "pr_123"}, nil)
+
+			resp, err := plg.CreateUserPaymentLink(ctx, req)
+			Expect(err).To(BeNil())
+			Expect(resp.PaymentRequestReference).To(Equal("pr_123"))
+			Expect(resp.Link).To(ContainSubstring("https://link.tink.com/1.0/pay"))
+			Expect(resp.Link).To(ContainSubstring("client_id=test_client_id"))
+			Expect(resp.Link).To(ContainSubstring("payment_request_id=pr_123"))
+			Expect(resp.Link).To(ContainSubstring("state=test_state"))
+			Expect(resp.Link).To(ContainSubstring("market=FR"))
+			Expect(resp.Link).To(ContainSubstring("locale=fr_FR"))
+			Expect(resp.Link).To(ContainSubstring("redirect_uri=https://example.com/callback"))
+		})
+
+		It("should return error when payment service user is nil", func(ctx SpecContext) {
+			req.PaymentServiceUser = nil
+
+			resp, err := plg.CreateUserPaymentLink(ctx, req)
+			Expect(err).To(MatchError(ContainSubstring("missing payment service user")))
+			Expect(resp).To(Equal(models.CreateUserPaymentLinkResponse{}))
+		})
+
+		It("should return error when callback state is empty", func(ctx SpecContext) {
+			req.CallBackState = ""
+
+			resp, err := plg.CreateUserPaymentLink(ctx, req)
+			Expect(err).To(MatchError(ContainSubstring("missing callBackState")))
+			Expect(resp).To(Equal(models.CreateUserPaymentLinkResponse{}))
+		})
+
+		It("should return error when destination account has no iban", func(ctx SpecContext) {
+			req.PaymentInitiation.DestinationAccount.Metadata = map[string]string{}
+
+			resp, err := plg.CreateUserPaymentLink(ctx, req)
+			Expect(err).To(MatchError(ContainSubstring("missing destination account iban")))
+			Expect(resp).To(Equal(models.CreateUserPaymentLinkResponse{}))
+		})
+
+		It("should return error when asset is not supported", func(ctx SpecContext) {
+			req.PaymentInitiation.Asset = "HHH/2"
+
+			resp, err := plg.CreateUserPaymentLink(ctx, req)
+			Expect(err).To(MatchError(models.ErrInvalidRequest))
+			Expect(resp).To(Equal(models.CreateUserPaymentLinkResponse{}))
+		})
+
+		It("should return error when client fails", func(ctx SpecContext) {
+			m.EXPECT().CreatePaymentRequest(gomock.Any(), gomock.Any()).Return(client.CreatePaymentRequestResponse{}, errors.New("test error"))
+
+			resp, err := plg.CreateUserPaymentLink(ctx, req)
+			Expect(err).To(MatchError("test error"))
+			Expect(resp).To(Equal(models.CreateUserPaymentLinkResponse{}))
+		})
+	})
+})
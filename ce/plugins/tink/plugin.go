@@ -125,6 +125,14 @@ func (p *Plugin) CreateUserLink(ctx context.Context, req models.CreateUserLinkRe
 	return p.createUserLink(ctx, req)
 }
 
+func (p *Plugin) CreateUserPaymentLink(ctx context.Context, req models.CreateUserPaymentLinkRequest) (models.CreateUserPaymentLinkResponse, error) {
+	if p.client == nil {
+		return models.CreateUserPaymentLinkResponse{}, pkgplugins.ErrNotYetInstalled
+	}
+
+	return p.createUserPaymentLink(ctx, req)
+}
+
 func (p *Plugin) UpdateUserLink(ctx context.Context, req models.UpdateUserLinkRequest) (models.UpdateUserLinkResponse, error) {
 	if p.client == nil {
 		return models.UpdateUserLinkResponse{}, pkgplugins.ErrNotYetInstalled
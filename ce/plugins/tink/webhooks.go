@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/formancehq/go-libs/v5/pkg/types/currency"
 	"github.com/formancehq/payments/ce/plugins/tink/client"
 	"github.com/formancehq/payments/pkg/domain/models"
 	"github.com/google/uuid"
@@ -23,6 +24,8 @@ const (
 
 	webhookIDMetadataKey     = "webhook_id"
 	webhookSecretMetadataKey = "secret"
+
+	paymentIDMetadataKey = "payment_id"
 )
 
 type supportedWebhook struct {
@@ -56,6 +59,10 @@ func (p *Plugin) initWebhookConfig() {
 			urlPath: "/refresh-finished",
 			fn:      p.handleRefreshFinished,
 		},
+		client.PaymentUpdated: {
+			urlPath: "/payment-updated",
+			fn:      p.handlePaymentUpdated,
+		},
 	}
 }
 
@@ -353,3 +360,135 @@ func (p *Plugin) handleRefreshFinished(ctx context.Context, req models.Translate
 
 	return nil, nil
 }
+
+func (p *Plugin) handlePaymentUpdated(ctx context.Context, req models.TranslateWebhookRequest) ([]models.WebhookResponse, error) {
+	// The payment:updated event is triggered every time the status of a
+	// payment initiated through a payment request changes.
+	// https://docs.tink.com/resources/payments/webhooks-for-payments
+
+	paymentUpdatedWebhook, err := p.client.GetPaymentUpdatedWebhook(ctx, req.Webhook.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if paymentUpdatedWebhook.PaymentRequestID == "" {
+		return nil, fmt.Errorf("missing payment request id: %w", models.ErrInvalidRequest)
+	}
+
+	status, ok := paymentStatusToAdjustmentStatus(paymentUpdatedWebhook.Status)
+	if !ok {
+		// Intermediate statuses are not relevant for the payment initiation
+		return nil, nil
+	}
+
+	var errMsg *string
+	if status == models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_FAILED ||
+		status == models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_REJECTED {
+		msg := paymentUpdatedWebhook.StatusMessage
+		if msg == "" {
+			msg = paymentUpdatedWebhook.Status
+		}
+		errMsg = &msg
+	}
+
+	at := time.Now().UTC()
+	if paymentUpdatedWebhook.Updated > 0 {
+		at = time.Unix(0, paymentUpdatedWebhook.Updated*int64(time.Millisecond)).UTC()
+	}
+
+	paymentRequest, err := p.client.GetPaymentRequest(ctx, paymentUpdatedWebhook.PaymentRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	payment, err := toPSPPaymentFromPaymentRequest(paymentRequest, paymentUpdatedWebhook, status, at)
+	if err != nil {
+		return nil, err
+	}
+
+	return []models.WebhookResponse{
+		{
+			UserPaymentUpdated: &models.PSPUserPaymentUpdated{
+				PaymentRequestReference: paymentUpdatedWebhook.PaymentRequestID,
+				Status:                  status,
+				At:                      at,
+				Payment:                 &payment,
+				Error:                   errMsg,
+			},
+		},
+	}, nil
+}
+
+// toPSPPaymentFromPaymentRequest builds the payout of a payment request. Its
+// reference is the payment request ID, as the payment ID is only given by
+// Tink once the payment is signed by the user.
+func toPSPPaymentFromPaymentRequest(
+	paymentRequest client.PaymentRequest,
+	webhook client.PaymentUpdatedWebhook,
+	status models.PaymentInitiationAdjustmentStatus,
+	at time.Time,
+) (models.PSPPayment, error) {
+	precision, ok := currency.ISO4217Currencies[paymentRequest.Currency]
+	if !ok {
+		return models.PSPPayment{}, fmt.Errorf("invalid currency code: %s: %w", paymentRequest.Currency, models.ErrInvalidRequest)
+	}
+
+	amount, err := currency.GetAmountWithPrecisionFromString(paymentRequest.Amount.String(), precision)
+	if err != nil {
+		return models.PSPPayment{}, fmt.Errorf("invalid amount: %s: %w", paymentRequest.Amount, models.ErrInvalidRequest)
+	}
+
+	raw, err := json.Marshal(webhook)
+	if err != nil {
+		return models.PSPPayment{}, err
+	}
+
+	createdAt := at
+	if paymentRequest.Created > 0 {
+		createdAt = time.Unix(0, paymentRequest.Created*int64(time.Millisecond)).UTC()
+	}
+
+	metadata := make(map[string]string)
+	if webhook.PaymentID != "" {
+		metadata[paymentIDMetadataKey] = webhook.PaymentID
+	}
+
+	return models.PSPPayment{
+		Reference: paymentRequest.ID,
+		CreatedAt: createdAt,
+		Type:      models.PAYMENT_TYPE_PAYOUT,
+		Amount:    amount,
+		Asset:     currency.FormatAssetWithPrecision(paymentRequest.Currency, precision),
+		Scheme:    models.PAYMENT_SCHEME_OTHER,
+		Status:    adjustmentStatusToPaymentStatus(status),
+		Metadata:  metadata,
+		Raw:       raw,
+	}, nil
+}
+
+func adjustmentStatusToPaymentStatus(status models.PaymentInitiationAdjustmentStatus) models.PaymentStatus {
+	switch status {
+	case models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PROCESSED:
+		return models.PAYMENT_STATUS_SUCCEEDED
+	case models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_FAILED,
+		models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_REJECTED:
+		return models.PAYMENT_STATUS_FAILED
+	default:
+		return models.PAYMENT_STATUS_PENDING
+	}
+}
+
+func paymentStatusToAdjustmentStatus(status string) (models.PaymentInitiationAdjustmentStatus, bool) {
+	switch status {
+	case "SENT", "SIGNED", "SETTLEMENT_IN_PROGRESS":
+		return models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PROCESSING, true
+	case "EXECUTED", "SETTLEMENT_COMPLETED":
+		return models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PROCESSED, true
+	case "REJECTED":
+		return models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_REJECTED, true
+	case "FAILED", "CANCELLED":
+		return models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_FAILED, true
+	default:
+		return models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_UNKNOWN, false
+	}
+}
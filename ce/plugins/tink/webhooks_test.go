@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/formancehq/payments/ce/plugins/tink/client"
@@ -92,9 +93,17 @@ var _ = Describe("Tink *Plugin Webhooks", func() {
 				nil,
 			)
 
+			m.EXPECT().CreateWebhook(gomock.Any(), gomock.Any(), connectorID, gomock.Any()).Return(
+				client.CreateWebhookResponse{
+					ID:     "webhook_7",
+					Secret: "secret_7",
+				},
+				nil,
+			)
+
 			resp, err := plg.CreateWebhooks(ctx, req)
 			Expect(err).To(BeNil())
-			Expect(resp.Configs).To(HaveLen(6))
+			Expect(resp.Configs).To(HaveLen(7))
 		})
 
 		It("should return error when connector ID is empty", func(ctx SpecContext) {
@@ -278,4 +287,143 @@ var _ = Describe("Tink *Plugin Webhooks", func() {
 			Expect(resp).To(Equal(models.TranslateWebhookResponse{}))
 		})
 	})
+
+	Context("payment updated webhook", func() {
+		var (
+			ctrl *gomock.Controller
+			plg  *Plugin
+			m    *client.MockClient
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			m = client.NewMockClient(ctrl)
+			plg = &Plugin{client: m}
+			plg.initWebhookConfig()
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should translate a settled payment into a processed adjustment", func(ctx SpecContext) {
+			req := models.TranslateWebhookRequest{
+				Name:    string(client.PaymentUpdated),
+				Webhook: models.PSPWebhook{Body: []byte(`{}`)},
+			}
+
+			m.EXPECT().GetPaymentUpdatedWebhook(gomock.Any(), req.Webhook.Body).Return(client.PaymentUpdatedWebhook{
+				PaymentRequestID: "pr_123",
+				Status:           "SETTLEMENT_COMPLETED",
+				Updated:          1700000000000,
+			}, nil)
+			m.EXPECT().GetPaymentRequest(gomock.Any(), "pr_123").Return(client.PaymentRequest{
+				ID:       "pr_123",
+				Amount:   "10.5",
+				Currency: "EUR",
+				Created:  1690000000000,
+			}, nil)
+
+			resp, err := plg.TranslateWebhook(ctx, req)
+			Expect(err).To(BeNil())
+			Expect(resp.Responses).To(HaveLen(1))
+			Expect(resp.Responses[0].UserPaymentUpdated).ToNot(BeNil())
+			Expect(resp.Responses[0].UserPaymentUpdated.PaymentRequestReference).To(Equal("pr_123"))
+			Expect(resp.Responses[0].UserPaymentUpdated.Status).To(Equal(models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PROCESSED))
+			Expect(resp.Responses[0].UserPaymentUpdated.At).To(Equal(time.UnixMilli(1700000000000).UTC()))
+			Expect(resp.Responses[0].UserPaymentUpdated.Error).To(BeNil())
+
+			payment := resp.Responses[0].UserPaymentUpdated.Payment
+			Expect(payment).ToNot(BeNil())
+			Expect(payment.Reference).To(Equal("pr_123"))
+			Expect(payment.Type).To(Equal(models.PAYMENT_TYPE_PAYOUT))
+			Expect(payment.Amount).To(Equal(big.NewInt(1050)))
+			Expect(payment.Asset).To(Equal("EUR/2"))
+			Expect(payment.Status).To(Equal(models.PAYMENT_STATUS_SUCCEEDED))
+			Expect(payment.CreatedAt).To(Equal(time.UnixMilli(1690000000000).UTC()))
+		})
+
+		It("should translate a failed payment with its status message", func(ctx SpecContext) {
+			req := models.TranslateWebhookRequest{
+				Name:    string(client.PaymentUpdated),
+				Webhook: models.PSPWebhook{Body: []byte(`{}`)},
+			}
+
+			m.EXPECT().GetPaymentUpdatedWebhook(gomock.Any(), req.Webhook.Body).Return(client.PaymentUpdatedWebhook{
+				PaymentRequestID: "pr_123",
+				Status:           "FAILED",
+				StatusMessage:    "insufficient funds",
+			}, nil)
+			m.EXPECT().GetPaymentRequest(gomock.Any(), "pr_123").Return(client.PaymentRequest{
+				ID:       "pr_123",
+				Amount:   "10",
+				Currency: "EUR",
+			}, nil)
+
+			resp, err := plg.TranslateWebhook(ctx, req)
+			Expect(err).To(BeNil())
+			Expect(resp.Responses).To(HaveLen(1))
+			Expect(resp.Responses[0].UserPaymentUpdated.Status).To(Equal(models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_FAILED))
+			Expect(*resp.Responses[0].UserPaymentUpdated.Error).To(Equal("insufficient funds"))
+			Expect(resp.Responses[0].UserPaymentUpdated.Payment.Status).To(Equal(models.PAYMENT_STATUS_FAILED))
+		})
+
+		It("should return an error when the payment request cannot be fetched", func(ctx SpecContext) {
+			req := models.TranslateWebhookRequest{
+				Name:    string(client.PaymentUpdated),
+				Webhook: models.PSPWebhook{Body: []byte(`{}`)},
+			}
+
+			m.EXPECT().GetPaymentUpdatedWebhook(gomock.Any(), req.Webhook.Body).Return(client.PaymentUpdatedWebhook{
+				PaymentRequestID: "pr_123",
+				Status:           "EXECUTED",
+			}, nil)
+			m.EXPECT().GetPaymentRequest(gomock.Any(), "pr_123").Return(client.PaymentRequest{}, errors.New("test error"))
+
+			_, err := plg.TranslateWebhook(ctx, req)
+			Expect(err).To(MatchError("test error"))
+		})
+
+		It("should ignore intermediate statuses", func(ctx SpecContext) {
+			req := models.TranslateWebhookRequest{
+				Name:    string(client.PaymentUpdated),
+				Webhook: models.PSPWebhook{Body: []byte(`{}`)},
+			}
+
+			m.EXPECT().GetPaymentUpdatedWebhook(gomock.Any(), req.Webhook.Body).Return(client.PaymentUpdatedWebhook{
+				PaymentRequestID: "pr_123",
+				Status:           "AWAITING_CREDENTIALS",
+			}, nil)
+
+			resp, err := plg.TranslateWebhook(ctx, req)
+			Expect(err).To(BeNil())
+			Expect(resp.Responses).To(BeEmpty())
+		})
+
+		It("should return an error when the payment request id is missing", func(ctx SpecContext) {
+			req := models.TranslateWebhookRequest{
+				Name:    string(client.PaymentUpdated),
+				Webhook: models.PSPWebhook{Body: []byte(`{}`)},
+			}
+
+			m.EXPECT().GetPaymentUpdatedWebhook(gomock.Any(), req.Webhook.Body).Return(client.PaymentUpdatedWebhook{
+				Status: "FAILED",
+			}, nil)
+
+			_, err := plg.TranslateWebhook(ctx, req)
+			Expect(err).To(MatchError(ContainSubstring("missing payment request id")))
+		})
+
+		It("should return an error when the client fails", func(ctx SpecContext) {
+			req := models.TranslateWebhookRequest{
+				Name:    string(client.PaymentUpdated),
+				Webhook: models.PSPWebhook{Body: []byte(`{}`)},
+			}
+
+			m.EXPECT().GetPaymentUpdatedWebhook(gomock.Any(), req.Webhook.Body).Return(client.PaymentUpdatedWebhook{}, errors.New("test error"))
+
+			_, err := plg.TranslateWebhook(ctx, req)
+			Expect(err).To(MatchError("test error"))
+		})
+	})
 })
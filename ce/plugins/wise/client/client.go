@@ -34,6 +34,7 @@ type Client interface {
 	CreatePayout(ctx context.Context, quote Quote, targetAccount uint64, transactionID string) (*Payout, error)
 	GetProfiles(ctx context.Context) ([]Profile, error)
 	CreateQuote(ctx context.Context, profileID, currency string, amount json.Number) (Quote, error)
+	GetQuote(ctx context.Context, profileID uint64, quoteID string) (Quote, error)
 	GetRecipientAccounts(ctx context.Context, profileID uint64, pageSize int, seekPositionForNext uint64) (*RecipientAccountsResponse, error)
 	GetRecipientAccount(ctx context.Context, accountID uint64) (*RecipientAccount, error)
 	GetTransfers(ctx context.Context, profileID uint64, offset int, limit int) ([]Transfer, error)
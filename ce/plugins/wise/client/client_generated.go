@@ -175,6 +175,21 @@ func (mr *MockClientMockRecorder) GetProfiles(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfiles", reflect.TypeOf((*MockClient)(nil).GetProfiles), ctx)
 }
 
+// GetQuote mocks base method.
+func (m *MockClient) GetQuote(ctx context.Context, profileID uint64, quoteID string) (Quote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuote", ctx, profileID, quoteID)
+	ret0, _ := ret[0].(Quote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuote indicates an expected call of GetQuote.
+func (mr *MockClientMockRecorder) GetQuote(ctx, profileID, quoteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuote", reflect.TypeOf((*MockClient)(nil).GetQuote), ctx, profileID, quoteID)
+}
+
 // GetRecipientAccount mocks base method.
 func (m *MockClient) GetRecipientAccount(ctx context.Context, accountID uint64) (*RecipientAccount, error) {
 	m.ctrl.T.Helper()
@@ -39,7 +39,7 @@ type Payout struct {
 }
 
 func (t *Payout) UnmarshalJSON(data []byte) error {
-	type Alias Transfer
+	type Alias Payout
 
 	aux := &struct {
 		Created string `json:"created"`
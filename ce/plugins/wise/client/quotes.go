@@ -13,7 +13,30 @@ import (
 )
 
 type Quote struct {
-	ID uuid.UUID `json:"id"`
+	ID             uuid.UUID            `json:"id"`
+	PayOut         string               `json:"payOut"`
+	PaymentOptions []QuotePaymentOption `json:"paymentOptions"`
+}
+
+type QuotePaymentOption struct {
+	Disabled bool   `json:"disabled"`
+	PayIn    string `json:"payIn"`
+	PayOut   string `json:"payOut"`
+	Fee      struct {
+		Total json.Number `json:"total"`
+	} `json:"fee"`
+}
+
+// Fee returns the total fee, in the source currency, of the quote when the
+// transfer is funded from a balance, as are the transfers of the connector.
+func (q Quote) Fee() (json.Number, bool) {
+	for _, option := range q.PaymentOptions {
+		if option.Disabled || option.PayIn != "BALANCE" || option.PayOut != q.PayOut {
+			continue
+		}
+		return option.Fee.Total, option.Fee.Total != ""
+	}
+	return "", false
 }
 
 func (c *client) CreateQuote(ctx context.Context, profileID, currency string, amount json.Number) (Quote, error) {
@@ -51,3 +74,28 @@ func (c *client) CreateQuote(ctx context.Context, profileID, currency string, am
 	}
 	return quote, nil
 }
+
+func (c *client) GetQuote(ctx context.Context, profileID uint64, quoteID string) (Quote, error) {
+	ctx = context.WithValue(ctx, metrics.MetricOperationContextKey, "get_quote")
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		c.endpoint(fmt.Sprintf("v3/profiles/%d/quotes/%s", profileID, quoteID)),
+		http.NoBody,
+	)
+	if err != nil {
+		return Quote{}, err
+	}
+
+	var quote Quote
+	var errRes wiseErrors
+	statusCode, err := c.httpClient.Do(ctx, req, &quote, &errRes)
+	if err != nil {
+		return Quote{}, errorsutils.NewWrappedError(
+			fmt.Errorf("failed to get quote: %v", errRes.Error(statusCode)),
+			err,
+		)
+	}
+	return quote, nil
+}
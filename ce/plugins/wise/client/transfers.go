@@ -29,11 +29,14 @@ type Transfer struct {
 	Details               struct {
 		Reference string `json:"reference"`
 	} `json:"details"`
-	Rate float64 `json:"rate"`
-	User uint64  `json:"user"`
+	Rate      float64 `json:"rate"`
+	User      uint64  `json:"user"`
+	QuoteUUID string  `json:"quoteUuid"`
 
 	SourceBalanceID      uint64 `json:"-"`
 	DestinationBalanceID uint64 `json:"-"`
+	// Fee charged by Wise in the source currency, taken from the quote
+	Fee json.Number `json:"-"`
 
 	CreatedAt time.Time `json:"-"`
 }
@@ -88,6 +91,14 @@ func (c *client) GetTransfers(ctx context.Context, profileID uint64, offset int,
 	}
 
 	for i, transfer := range transfers {
+		if transfer.QuoteUUID != "" {
+			quote, err := c.GetQuote(ctx, profileID, transfer.QuoteUUID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get transfer quote: %w", err)
+			}
+			transfers[i].Fee, _ = quote.Fee()
+		}
+
 		var sourceProfileID, targetProfileID uint64
 		if transfer.SourceAccount != 0 {
 			recipientAccount, err := c.GetRecipientAccount(ctx, transfer.SourceAccount)
@@ -46,3 +46,44 @@ func TestCreateTransferSetsContentType(t *testing.T) {
 	require.Equal(t, uint64(2147689353), transfer.ID)
 	require.False(t, transfer.CreatedAt.IsZero())
 }
+
+func TestGetTransfersTakesTheFeeFromTheQuote(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/transfers":
+			_, _ = w.Write([]byte(`[{
+				"id": 2147689353,
+				"quoteUuid": "482ef789-9f3d-41f0-9b7f-bc37c4d748be",
+				"status": "outgoing_payment_sent",
+				"created": "2026-07-02 12:38:02",
+				"sourceCurrency": "USD",
+				"sourceValue": 100,
+				"targetCurrency": "EUR",
+				"targetValue": 91.5,
+				"rate": 0.92
+			}]`))
+		case "/v3/profiles/42/quotes/482ef789-9f3d-41f0-9b7f-bc37c4d748be":
+			_, _ = w.Write([]byte(`{
+				"id": "482ef789-9f3d-41f0-9b7f-bc37c4d748be",
+				"payOut": "BANK_TRANSFER",
+				"paymentOptions": [
+					{"disabled": false, "payIn": "BANK_TRANSFER", "payOut": "BANK_TRANSFER", "fee": {"total": 0.75}},
+					{"disabled": false, "payIn": "BALANCE", "payOut": "BANK_TRANSFER", "fee": {"total": 0.54}}
+				]
+			}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := newWithEndpoint("wise", "test-key", server.URL)
+
+	transfers, err := c.GetTransfers(context.Background(), 42, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, transfers, 1)
+	require.Equal(t, "0.54", transfers[0].Fee.String())
+}
@@ -7,14 +7,14 @@ import (
 	"encoding/pem"
 	"fmt"
 
-	"github.com/formancehq/payments/pkg/domain/models"
 	errorsutils "github.com/formancehq/payments/pkg/domain/errors"
+	"github.com/formancehq/payments/pkg/domain/models"
 	"github.com/go-playground/validator/v10"
 	"github.com/pkg/errors"
 )
 
 type Config struct {
-	APIKey           string `json:"apiKey" validate:"required"`
+	APIKey           string `json:"apiKey" validate:"required" sensitive:"true"`
 	WebhookPublicKey string `json:"webhookPublicKey" validate:"required"`
 
 	webhookPublicKey *rsa.PublicKey `json:"-"`
@@ -156,6 +156,7 @@ func fromTransferToPayment(from client.Transfer) (models.PSPPayment, error) {
 		Asset:     currency.FormatAsset(supportedCurrenciesWithDecimal, from.TargetCurrency),
 		Scheme:    models.PAYMENT_SCHEME_OTHER,
 		Status:    matchTransferStatus(from.Status),
+		Fees:      fromTransferToPaymentFees(from),
 		Raw:       raw,
 	}
 
@@ -170,6 +171,28 @@ func fromTransferToPayment(from client.Transfer) (models.PSPPayment, error) {
 	return p, nil
 }
 
+// fromTransferToPaymentFees reports the fee Wise charged on a transfer, as
+// returned by the quote the transfer was created from.
+func fromTransferToPaymentFees(from client.Transfer) []models.PaymentFee {
+	precision, ok := supportedCurrenciesWithDecimal[from.SourceCurrency]
+	if !ok || from.Fee == "" {
+		return nil
+	}
+
+	amount, err := currency.GetAmountWithPrecisionFromString(from.Fee.String(), precision)
+	if err != nil || amount.Sign() <= 0 {
+		return nil
+	}
+
+	return []models.PaymentFee{
+		{
+			Type:   models.PAYMENT_FEE_TYPE_PROCESSING,
+			Amount: amount,
+			Asset:  currency.FormatAsset(supportedCurrenciesWithDecimal, from.SourceCurrency),
+		},
+	}
+}
+
 func matchTransferStatus(status string) models.PaymentStatus {
 	switch status {
 	case "incoming_payment_waiting", "incoming_payment_initiated", "processing", "funds_converted", "bounced_back":
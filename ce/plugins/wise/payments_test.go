@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/formancehq/go-libs/v5/pkg/observe/log"
@@ -326,4 +327,53 @@ var _ = Describe("Wise Plugin Payments", func() {
 			Expect(state.LastTransferID).To(Equal(uint64(204)))
 		})
 	})
+
+	Context("transfer fees", func() {
+		It("should report the quote fee in the source currency", func() {
+			payment, err := fromTransferToPayment(client.Transfer{
+				ID:             1,
+				Status:         "outgoing_payment_sent",
+				SourceCurrency: "USD",
+				SourceValue:    "100",
+				TargetCurrency: "EUR",
+				TargetValue:    "91.5",
+				Rate:           0.92,
+				Fee:            "0.54",
+			})
+			Expect(err).To(BeNil())
+			Expect(payment.Amount).To(Equal(big.NewInt(9150)))
+			Expect(payment.Fees).To(Equal([]models.PaymentFee{
+				{Type: models.PAYMENT_FEE_TYPE_PROCESSING, Amount: big.NewInt(54), Asset: "USD/2"},
+			}))
+		})
+
+		It("should not report a fee without a quote fee", func() {
+			payment, err := fromTransferToPayment(client.Transfer{
+				ID:             2,
+				Status:         "outgoing_payment_sent",
+				SourceCurrency: "EUR",
+				SourceValue:    "100.5",
+				TargetCurrency: "EUR",
+				TargetValue:    "100",
+				Rate:           1,
+			})
+			Expect(err).To(BeNil())
+			Expect(payment.Fees).To(BeNil())
+		})
+
+		It("should not report a zero fee", func() {
+			payment, err := fromTransferToPayment(client.Transfer{
+				ID:             3,
+				Status:         "outgoing_payment_sent",
+				SourceCurrency: "EUR",
+				SourceValue:    "100",
+				TargetCurrency: "EUR",
+				TargetValue:    "100",
+				Rate:           1,
+				Fee:            "0",
+			})
+			Expect(err).To(BeNil())
+			Expect(payment.Fees).To(BeNil())
+		})
+	})
 })
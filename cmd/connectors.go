@@ -0,0 +1,299 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/formancehq/go-libs/v5/pkg/observe/log"
+	"github.com/formancehq/go-libs/v5/pkg/service"
+	"github.com/formancehq/payments/internal/api/services"
+	"github.com/formancehq/payments/internal/connectors/engine"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/spf13/cobra"
+	"go.uber.org/fx"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	connectorsOutputFlag                    = "output"
+	connectorsFormatFlag                    = "format"
+	connectorsSecretsFlag                   = "secrets"
+	connectorsTargetConfigEncryptionKeyFlag = "target-config-encryption-key"
+	connectorsFileFlag                      = "file"
+	connectorsPlanFlag                      = "plan"
+
+	connectorsFormatJSON = "json"
+	connectorsFormatYAML = "yaml"
+)
+
+func newConnectors() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "connectors",
+		Short: "Export and import connectors to promote them between environments",
+	}
+
+	export := &cobra.Command{
+		Use:          "export",
+		Short:        "Export the connectors configs, webhooks configs and pools",
+		SilenceUsage: true,
+		RunE:         runConnectorsExport(),
+	}
+	commonFlags(export)
+	export.Flags().String(stackPublicURLFlag, "", "Stack public url")
+	export.Flags().StringP(connectorsOutputFlag, "o", "", "Output file, defaults to stdout")
+	export.Flags().String(connectorsFormatFlag, "", "Output format, json or yaml, defaults to the output file extension or json")
+	export.Flags().String(connectorsSecretsFlag, "redacted", "How secrets are exported: plain, redacted or encrypted")
+	export.Flags().String(connectorsTargetConfigEncryptionKeyFlag, "", "Config encryption key of the target environment, required to export encrypted secrets")
+	cmd.AddCommand(export)
+
+	imp := &cobra.Command{
+		Use:          "import",
+		Short:        "Import connectors and pools from an export, creating or updating them by name",
+		SilenceUsage: true,
+		RunE:         runConnectorsImport(),
+	}
+	commonFlags(imp)
+	imp.Flags().String(stackPublicURLFlag, "", "Stack public url")
+	imp.Flags().StringP(connectorsFileFlag, "f", "", "Export file to import, either json or yaml")
+	imp.Flags().Bool(connectorsPlanFlag, false, "Only show what the import would change")
+	_ = imp.MarkFlagRequired(connectorsFileFlag)
+	cmd.AddCommand(imp)
+
+	return cmd
+}
+
+func runConnectorsExport() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		setLogger()
+
+		output, _ := cmd.Flags().GetString(connectorsOutputFlag)
+		format, _ := cmd.Flags().GetString(connectorsFormatFlag)
+		secretsFlag, _ := cmd.Flags().GetString(connectorsSecretsFlag)
+		targetKey, _ := cmd.Flags().GetString(connectorsTargetConfigEncryptionKeyFlag)
+
+		secrets, err := models.ConnectorsExportSecretsFromString(secretsFlag)
+		if err != nil {
+			return err
+		}
+
+		format, err = connectorsDocumentFormat(format, output)
+		if err != nil {
+			return err
+		}
+
+		options := models.ConnectorsExportOptions{
+			Secrets:       secrets,
+			EncryptionKey: targetKey,
+		}
+		if err := options.Validate(); err != nil {
+			return err
+		}
+
+		var export models.ConnectorsExport
+		err = withConnectorsService(cmd, func(ctx context.Context, s *services.Service) error {
+			export, err = s.ConnectorsExport(ctx, options)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		data, err := encodeConnectorsExport(export, format)
+		if err != nil {
+			return err
+		}
+
+		if output == "" {
+			_, err = cmd.OutOrStdout().Write(data)
+			return err
+		}
+		return os.WriteFile(output, data, 0600)
+	}
+}
+
+func runConnectorsImport() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		setLogger()
+
+		file, _ := cmd.Flags().GetString(connectorsFileFlag)
+		planOnly, _ := cmd.Flags().GetBool(connectorsPlanFlag)
+
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+
+		document, err := decodeConnectorsExport(data)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", file, err)
+		}
+
+		var plan models.ConnectorsImportPlan
+		err = withConnectorsService(cmd, func(ctx context.Context, s *services.Service) error {
+			plan, err = s.ConnectorsImport(ctx, document, planOnly)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		return printConnectorsImportPlan(cmd.OutOrStdout(), plan)
+	}
+}
+
+// withConnectorsService starts the storage and the engine, without the API
+// server, to run fn against the services of the environment.
+func withConnectorsService(cmd *cobra.Command, fn func(ctx context.Context, s *services.Service) error) error {
+	stack, _ := cmd.Flags().GetString(StackFlag)
+	stackPublicURL, _ := cmd.Flags().GetString(stackPublicURLFlag)
+	pollingPeriodDefault, _ := cmd.Flags().GetDuration(ConnectorPollingPeriodDefault)
+	pollingPeriodMinimum, _ := cmd.Flags().GetDuration(ConnectorPollingPeriodMinimum)
+	debug := service.IsDebug(cmd)
+
+	// stdout is kept for the command output
+	logger := logging.NewDefaultLogger(cmd.ErrOrStderr(), debug, true, false)
+
+	commonOpts, err := commonOptions(cmd)
+	if err != nil {
+		return fmt.Errorf("failed to configure common options: %w", err)
+	}
+
+	var s *services.Service
+	options := []fx.Option{
+		fx.Supply(fx.Annotate(logger, fx.As(new(logging.Logger)))),
+		commonOpts,
+		engine.Module(stack, stackPublicURL, debug, pollingPeriodDefault, pollingPeriodMinimum),
+		fx.Provide(func(storage storage.Storage, engine engine.Engine) *services.Service {
+			return services.New(storage, engine, debug)
+		}),
+		fx.Populate(&s),
+	}
+
+	app := fx.New(options...)
+	if err := app.Start(cmd.Context()); err != nil {
+		return err
+	}
+	defer func() {
+		if err := app.Stop(context.Background()); err != nil {
+			logger.Errorf("failed to stop app: %s", err)
+		}
+	}()
+
+	return fn(cmd.Context(), s)
+}
+
+func connectorsDocumentFormat(format, output string) (string, error) {
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(output)) {
+		case ".yaml", ".yml":
+			format = connectorsFormatYAML
+		default:
+			format = connectorsFormatJSON
+		}
+	}
+
+	switch format = strings.ToLower(format); format {
+	case connectorsFormatJSON, connectorsFormatYAML:
+		return format, nil
+	default:
+		return "", fmt.Errorf("unknown format %q, expected json or yaml", format)
+	}
+}
+
+func encodeConnectorsExport(export models.ConnectorsExport, format string) ([]byte, error) {
+	data, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	if format == connectorsFormatJSON {
+		return append(data, '\n'), nil
+	}
+
+	// Going through a node keeps the fields in the order of the JSON
+	// document, JSON being a subset of YAML.
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return nil, err
+	}
+	resetYAMLStyle(&node)
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&node); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// resetYAMLStyle drops the JSON flow style and quotes. Strings which would be
+// read back as another type are still quoted by the encoder.
+func resetYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetYAMLStyle(child)
+	}
+}
+
+func decodeConnectorsExport(data []byte) (models.ConnectorsExport, error) {
+	// JSON being a subset of YAML, both formats are decoded the same way.
+	var raw any
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return models.ConnectorsExport{}, err
+	}
+
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return models.ConnectorsExport{}, err
+	}
+
+	var document models.ConnectorsExport
+	if err := json.Unmarshal(data, &document); err != nil {
+		return models.ConnectorsExport{}, err
+	}
+	return document, nil
+}
+
+func printConnectorsImportPlan(out io.Writer, plan models.ConnectorsImportPlan) error {
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+
+	fmt.Fprintln(w, "CONNECTOR\tPROVIDER\tACTION\tDETAILS")
+	for _, c := range plan.Connectors {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", c.Name, c.Provider, c.Action, importChangeDetails(c.Changes, c.Reason))
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "POOL\tACTION\tDETAILS")
+	for _, p := range plan.Pools {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", p.Name, p.Action, importChangeDetails(p.Changes, p.Reason))
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if !plan.Applied {
+		_, err := fmt.Fprintln(out, "\nNothing was applied, run the import without --plan to apply these changes.")
+		return err
+	}
+	return nil
+}
+
+func importChangeDetails(changes []string, reason string) string {
+	if reason != "" {
+		return reason
+	}
+	return strings.Join(changes, ", ")
+}
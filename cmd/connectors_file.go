@@ -0,0 +1,202 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/formancehq/go-libs/v5/pkg/observe/log"
+	"github.com/formancehq/payments/internal/api/services"
+	"github.com/formancehq/payments/internal/connectors/engine"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"go.uber.org/fx"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	ConnectorsFileFlag      = "connectors-file"
+	ConnectorsFilePruneFlag = "connectors-file-prune"
+
+	connectorsFileFromEnv  = "fromEnv"
+	connectorsFileFromFile = "fromFile"
+)
+
+// connectorsFile declares the connectors which must be installed, for
+// example:
+//
+//	connectors:
+//	  - name: stripe-eu
+//	    provider: stripe
+//	    config:
+//	      apiKey:
+//	        fromEnv: STRIPE_API_KEY
+//	      pollingPeriod: 30m
+//
+// Any config value can be read from an environment variable (fromEnv) or
+// from a file (fromFile) instead of being written in the file.
+type connectorsFile struct {
+	Connectors []connectorsFileConnector `yaml:"connectors"`
+}
+
+type connectorsFileConnector struct {
+	Name     string         `yaml:"name"`
+	Provider string         `yaml:"provider"`
+	Config   map[string]any `yaml:"config"`
+}
+
+// loadConnectorsFile reads the connectors file and resolves its secrets into
+// a document which can be reconciled.
+func loadConnectorsFile(path string) (models.ConnectorsExport, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return models.ConnectorsExport{}, err
+	}
+
+	var file connectorsFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return models.ConnectorsExport{}, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	document := models.ConnectorsExport{
+		Version:    models.ConnectorsExportVersion,
+		Secrets:    models.CONNECTORS_EXPORT_SECRETS_PLAIN,
+		Connectors: make([]models.ConnectorExport, 0, len(file.Connectors)),
+	}
+	for _, c := range file.Connectors {
+		config := make(map[string]any, len(c.Config)+1)
+		for key, value := range c.Config {
+			resolved, err := resolveConnectorsFileValue(value)
+			if err != nil {
+				return models.ConnectorsExport{}, fmt.Errorf("failed to resolve %s of connector %q: %w", key, c.Name, err)
+			}
+			config[key] = resolved
+		}
+
+		// The name is part of the plugin config, there is no need to repeat it
+		if _, ok := config["name"]; !ok {
+			config["name"] = c.Name
+		}
+
+		rawConfig, err := json.Marshal(config)
+		if err != nil {
+			return models.ConnectorsExport{}, fmt.Errorf("failed to marshal config of connector %q: %w", c.Name, err)
+		}
+
+		document.Connectors = append(document.Connectors, models.ConnectorExport{
+			Name:     c.Name,
+			Provider: c.Provider,
+			Config:   rawConfig,
+		})
+	}
+
+	if err := document.Validate(); err != nil {
+		return models.ConnectorsExport{}, fmt.Errorf("invalid connectors file %s: %w", path, err)
+	}
+
+	return document, nil
+}
+
+func resolveConnectorsFileValue(value any) (any, error) {
+	ref, ok := value.(map[string]any)
+	if !ok || len(ref) != 1 {
+		return value, nil
+	}
+
+	if name, ok := ref[connectorsFileFromEnv].(string); ok {
+		v, ok := os.LookupEnv(name)
+		if !ok {
+			return nil, fmt.Errorf("environment variable %s is not set", name)
+		}
+		return v, nil
+	}
+
+	if path, ok := ref[connectorsFileFromFile].(string); ok {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		// Secret files usually end with a newline which is not part of the secret
+		return strings.TrimRight(string(data), "\r\n"), nil
+	}
+
+	return value, nil
+}
+
+// connectorsFileModule reconciles the installed connectors with the document
+// once the engine is started. The server does not start if the
+// reconciliation fails.
+func connectorsFileModule(document models.ConnectorsExport, prune bool, debug bool) fx.Option {
+	return fx.Invoke(func(
+		lc fx.Lifecycle,
+		logger logging.Logger,
+		storage storage.Storage,
+		engine engine.Engine,
+	) {
+		lc.Append(fx.Hook{
+			OnStart: func(ctx context.Context) error {
+				return reconcileConnectorsFile(ctx, logger, storage, services.New(storage, engine, debug), document, prune)
+			},
+		})
+	})
+}
+
+// Name of the lock serializing the reconciliations of the instances sharing
+// the same database.
+const connectorsFileLock = "connectors-file"
+
+// reconcileConnectorsFile reconciles the connectors under a database lock:
+// every replica of the server starts with the same file, and reconciling
+// concurrently would race on the connectors names, or prune the connectors
+// another replica is installing. Replicas reconcile one after the other, the
+// reconciliation being idempotent the followers find nothing to change.
+func reconcileConnectorsFile(
+	ctx context.Context,
+	logger logging.Logger,
+	storage storage.Storage,
+	service *services.Service,
+	document models.ConnectorsExport,
+	prune bool,
+) error {
+	return storage.WithLock(ctx, connectorsFileLock, func(ctx context.Context) error {
+		plan, err := service.ConnectorsReconcile(ctx, document, prune)
+		if err != nil {
+			return fmt.Errorf("failed to reconcile connectors file: %w", err)
+		}
+
+		logConnectorsReconciliation(logger, plan)
+		return nil
+	})
+}
+
+func logConnectorsReconciliation(logger logging.Logger, plan models.ConnectorsImportPlan) {
+	counts := make(map[models.ConnectorsImportAction]int)
+	for _, c := range plan.Connectors {
+		counts[c.Action]++
+
+		l := logger.WithFields(map[string]any{
+			"connector": c.Name,
+			"provider":  c.Provider,
+			"action":    c.Action,
+		})
+		switch c.Action {
+		case models.CONNECTORS_IMPORT_ACTION_SKIP:
+			l.Errorf("connectors file: connector %q skipped: %s", c.Name, c.Reason)
+		case models.CONNECTORS_IMPORT_ACTION_UPDATE:
+			l.Infof("connectors file: connector %q updated: %s", c.Name, strings.Join(c.Changes, ", "))
+		default:
+			l.Infof("connectors file: connector %q %s", c.Name, strings.ToLower(string(c.Action)))
+		}
+	}
+
+	logger.Infof(
+		"connectors file reconciled: %d created, %d updated, %d unchanged, %d uninstalled, %d skipped",
+		counts[models.CONNECTORS_IMPORT_ACTION_CREATE],
+		counts[models.CONNECTORS_IMPORT_ACTION_UPDATE],
+		counts[models.CONNECTORS_IMPORT_ACTION_UNCHANGED],
+		counts[models.CONNECTORS_IMPORT_ACTION_UNINSTALL],
+		counts[models.CONNECTORS_IMPORT_ACTION_SKIP],
+	)
+}
@@ -0,0 +1,169 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/formancehq/go-libs/v5/pkg/observe/log"
+	"github.com/formancehq/go-libs/v5/pkg/storage/bun/paginate"
+	"github.com/formancehq/payments/internal/api/services"
+	"github.com/formancehq/payments/internal/connectors/engine"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gomock "go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Connectors file", func() {
+	var dir string
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+	})
+
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		Expect(os.WriteFile(path, []byte(content), 0600)).To(Succeed())
+		return path
+	}
+
+	It("resolves the secrets from the environment and from files", func() {
+		GinkgoT().Setenv("CONNECTORS_FILE_TEST_API_KEY", "sk_live")
+		secret := write("secret", "whsec\n")
+		path := write("connectors.yaml", `
+connectors:
+  - name: stripe-eu
+    provider: stripe
+    config:
+      apiKey:
+        fromEnv: CONNECTORS_FILE_TEST_API_KEY
+      webhookSecret:
+        fromFile: `+secret+`
+      pollingPeriod: 30m
+      pageSize: 25
+`)
+
+		document, err := loadConnectorsFile(path)
+		Expect(err).To(BeNil())
+		Expect(document.Version).To(Equal(models.ConnectorsExportVersion))
+		Expect(document.Secrets).To(Equal(models.CONNECTORS_EXPORT_SECRETS_PLAIN))
+		Expect(document.Connectors).To(HaveLen(1))
+		Expect(document.Connectors[0].Name).To(Equal("stripe-eu"))
+		Expect(document.Connectors[0].Provider).To(Equal("stripe"))
+		Expect(document.Connectors[0].Config).To(MatchJSON(`{
+			"name": "stripe-eu",
+			"apiKey": "sk_live",
+			"webhookSecret": "whsec",
+			"pollingPeriod": "30m",
+			"pageSize": 25
+		}`))
+	})
+
+	It("keeps objects which are not secret references", func() {
+		path := write("connectors.yaml", `
+connectors:
+  - name: generic
+    provider: generic
+    config:
+      headers:
+        fromEnv: A
+        other: B
+`)
+
+		document, err := loadConnectorsFile(path)
+		Expect(err).To(BeNil())
+		Expect(document.Connectors[0].Config).To(MatchJSON(`{"name":"generic","headers":{"fromEnv":"A","other":"B"}}`))
+	})
+
+	It("fails when an environment variable is not set", func() {
+		path := write("connectors.yaml", `
+connectors:
+  - name: stripe-eu
+    provider: stripe
+    config:
+      apiKey:
+        fromEnv: CONNECTORS_FILE_TEST_UNSET
+`)
+
+		_, err := loadConnectorsFile(path)
+		Expect(err).To(MatchError(ContainSubstring("CONNECTORS_FILE_TEST_UNSET is not set")))
+	})
+
+	It("fails when a secret file does not exist", func() {
+		path := write("connectors.yaml", `
+connectors:
+  - name: stripe-eu
+    provider: stripe
+    config:
+      apiKey:
+        fromFile: `+filepath.Join(dir, "missing")+`
+`)
+
+		_, err := loadConnectorsFile(path)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fails when the file is invalid", func() {
+		path := write("connectors.yaml", `
+connectors:
+  - name: stripe-eu
+    provider: stripe
+  - name: stripe-eu
+    provider: stripe
+`)
+
+		_, err := loadConnectorsFile(path)
+		Expect(err).To(MatchError(models.ErrValidation))
+	})
+})
+
+var _ = Describe("Connectors file reconciliation", func() {
+	var (
+		ctrl     *gomock.Controller
+		store    *storage.MockStorage
+		service  *services.Service
+		logger   logging.Logger
+		document models.ConnectorsExport
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		store = storage.NewMockStorage(ctrl)
+		service = services.New(store, engine.NewMockEngine(ctrl), false)
+		logger = logging.NewDefaultLogger(GinkgoWriter, false, false, false)
+		document = models.ConnectorsExport{
+			Version: models.ConnectorsExportVersion,
+			Secrets: models.CONNECTORS_EXPORT_SECRETS_PLAIN,
+		}
+	})
+
+	It("reconciles under the connectors file lock", func() {
+		locked := false
+		store.EXPECT().WithLock(gomock.Any(), connectorsFileLock, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, _ string, fn func(ctx context.Context) error) error {
+				locked = true
+				defer func() { locked = false }()
+				return fn(ctx)
+			},
+		)
+		store.EXPECT().ConnectorsList(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(context.Context, storage.ListConnectorsQuery) (*paginate.Cursor[models.Connector], error) {
+				Expect(locked).To(BeTrue())
+				return &paginate.Cursor[models.Connector]{}, nil
+			},
+		)
+		store.EXPECT().PoolsList(gomock.Any(), gomock.Any()).Return(&paginate.Cursor[models.Pool]{}, nil)
+
+		Expect(reconcileConnectorsFile(context.Background(), logger, store, service, document, false)).To(Succeed())
+	})
+
+	It("does not reconcile when the lock cannot be acquired", func() {
+		store.EXPECT().WithLock(gomock.Any(), connectorsFileLock, gomock.Any()).Return(fmt.Errorf("connection refused"))
+
+		err := reconcileConnectorsFile(context.Background(), logger, store, service, document, false)
+		Expect(err).To(MatchError(ContainSubstring("connection refused")))
+	})
+})
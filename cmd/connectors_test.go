@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Connectors export/import", func() {
+	var export models.ConnectorsExport
+
+	BeforeEach(func() {
+		export = models.ConnectorsExport{
+			Version:    models.ConnectorsExportVersion,
+			ExportedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			Secrets:    models.CONNECTORS_EXPORT_SECRETS_REDACTED,
+			Connectors: []models.ConnectorExport{
+				{
+					Name:     "stripe-eu",
+					Provider: "stripe",
+					Config:   json.RawMessage(`{"apiKey":"<redacted>","name":"stripe-eu","pageSize":"25","pollingPeriod":"30m"}`),
+					WebhooksConfigs: []models.WebhookConfigExport{
+						{Name: "payments", URLPath: "/payments", Metadata: map[string]string{"secret": "<redacted>"}},
+					},
+				},
+			},
+			Pools: []models.PoolExport{
+				{
+					Name: "treasury",
+					Type: models.POOL_TYPE_STATIC,
+					Accounts: []models.PoolAccountExport{
+						{Connector: "stripe-eu", Reference: "acc_123"},
+					},
+				},
+				{
+					Name:  "eur",
+					Type:  models.POOL_TYPE_DYNAMIC,
+					Query: map[string]any{"$match": map[string]any{"default_asset": "EUR/2"}},
+				},
+			},
+		}
+	})
+
+	Context("document format", func() {
+		It("defaults to json", func() {
+			Expect(connectorsDocumentFormat("", "")).To(Equal(connectorsFormatJSON))
+			Expect(connectorsDocumentFormat("", "export.json")).To(Equal(connectorsFormatJSON))
+		})
+
+		It("uses the output file extension", func() {
+			Expect(connectorsDocumentFormat("", "export.yaml")).To(Equal(connectorsFormatYAML))
+			Expect(connectorsDocumentFormat("", "export.YML")).To(Equal(connectorsFormatYAML))
+		})
+
+		It("prefers the given format", func() {
+			Expect(connectorsDocumentFormat("YAML", "export.json")).To(Equal(connectorsFormatYAML))
+		})
+
+		It("rejects unknown formats", func() {
+			_, err := connectorsDocumentFormat("toml", "")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	DescribeTable("round trips the export",
+		func(format string) {
+			data, err := encodeConnectorsExport(export, format)
+			Expect(err).To(BeNil())
+
+			decoded, err := decodeConnectorsExport(data)
+			Expect(err).To(BeNil())
+			Expect(decoded.Validate()).To(Succeed())
+			Expect(decoded.Version).To(Equal(export.Version))
+			Expect(decoded.ExportedAt).To(Equal(export.ExportedAt))
+			Expect(decoded.Secrets).To(Equal(export.Secrets))
+			Expect(decoded.Pools).To(Equal(export.Pools))
+			Expect(decoded.Connectors).To(HaveLen(1))
+			Expect(decoded.Connectors[0].WebhooksConfigs).To(Equal(export.Connectors[0].WebhooksConfigs))
+			Expect(decoded.Connectors[0].Config).To(MatchJSON(export.Connectors[0].Config))
+		},
+		Entry("json", connectorsFormatJSON),
+		Entry("yaml", connectorsFormatYAML),
+	)
+
+	It("keeps the yaml values as strings", func() {
+		data, err := encodeConnectorsExport(export, connectorsFormatYAML)
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(ContainSubstring(`pageSize: "25"`))
+		Expect(string(data)).To(ContainSubstring("version: 1\n"))
+	})
+
+	It("prints the import plan", func() {
+		connectorID := models.ConnectorID{Provider: "stripe"}
+		plan := models.ConnectorsImportPlan{
+			Connectors: []models.ConnectorImportChange{
+				{Name: "stripe-eu", Provider: "stripe", Action: models.CONNECTORS_IMPORT_ACTION_UPDATE, ConnectorID: &connectorID, Changes: []string{"apiKey", "pollingPeriod"}},
+				{Name: "adyen", Provider: "adyen", Action: models.CONNECTORS_IMPORT_ACTION_SKIP, Reason: "missing redacted secrets: apiKey"},
+			},
+			Pools: []models.PoolImportChange{
+				{Name: "eur", Action: models.CONNECTORS_IMPORT_ACTION_CREATE},
+			},
+		}
+
+		var out bytes.Buffer
+		Expect(printConnectorsImportPlan(&out, plan)).To(Succeed())
+		Expect(out.String()).To(ContainSubstring("apiKey, pollingPeriod"))
+		Expect(out.String()).To(ContainSubstring("missing redacted secrets: apiKey"))
+		Expect(out.String()).To(ContainSubstring("Nothing was applied"))
+
+		out.Reset()
+		plan.Applied = true
+		Expect(printConnectorsImportPlan(&out, plan)).To(Succeed())
+		Expect(out.String()).NotTo(ContainSubstring("Nothing was applied"))
+	})
+})
@@ -32,6 +32,9 @@ const (
 	OutboxPollingIntervalFlag                    = "outbox-schedule-polling-interval"
 	OutboxCleanupIntervalFlag                    = "outbox-schedule-cleanup-interval"
 	SkipOutboxScheduleCreationFlag               = "skip-outbox-schedule-creation"
+	ScreeningURLFlag                             = "screening-url"
+	ScreeningTimeoutFlag                         = "screening-timeout"
+	PayoutBankAccountVerificationFlag            = "payout-bank-account-verification"
 )
 
 func NewRootCommand() *cobra.Command {
@@ -67,6 +70,9 @@ func NewRootCommand() *cobra.Command {
 	recreateSchedules := newRecreateSchedules()
 	root.AddCommand(recreateSchedules)
 
+	connectors := newConnectors()
+	root.AddCommand(connectors)
+
 	return root
 }
 
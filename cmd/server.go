@@ -24,6 +24,8 @@ func newServer() *cobra.Command {
 	commonFlags(cmd)
 
 	cmd.Flags().String(stackPublicURLFlag, "", "Stack public url")
+	cmd.Flags().String(ConnectorsFileFlag, "", "YAML file declaring the connectors to install or update on startup")
+	cmd.Flags().Bool(ConnectorsFilePruneFlag, false, "Uninstall the connectors missing from the connectors file")
 	return cmd
 }
 
@@ -54,11 +56,27 @@ func serverOptions(cmd *cobra.Command) (fx.Option, error) {
 	stackPublicURL, _ := cmd.Flags().GetString(stackPublicURLFlag)
 	pollingPeriodDefault, _ := cmd.Flags().GetDuration(ConnectorPollingPeriodDefault)
 	pollingPeriodMinimum, _ := cmd.Flags().GetDuration(ConnectorPollingPeriodMinimum)
-	return fx.Options(
+	connectorsFilePath, _ := cmd.Flags().GetString(ConnectorsFileFlag)
+	connectorsFilePrune, _ := cmd.Flags().GetBool(ConnectorsFilePruneFlag)
+
+	options := []fx.Option{
 		authnfx.JWTModuleFromFlags(cmd),
 		api.NewModule(listen, service.IsDebug(cmd)),
 		v2.NewModule(),
 		v3.NewModule(),
 		engine.Module(stack, stackPublicURL, service.IsDebug(cmd), pollingPeriodDefault, pollingPeriodMinimum),
-	), nil
+	}
+
+	if connectorsFilePath != "" {
+		document, err := loadConnectorsFile(connectorsFilePath)
+		if err != nil {
+			return nil, err
+		}
+		// Registered after the engine so that it runs once the engine is started
+		options = append(options, connectorsFileModule(document, connectorsFilePrune, service.IsDebug(cmd)))
+	} else if connectorsFilePrune {
+		return nil, fmt.Errorf("--%s requires --%s", ConnectorsFilePruneFlag, ConnectorsFileFlag)
+	}
+
+	return fx.Options(options...), nil
 }
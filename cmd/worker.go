@@ -7,6 +7,7 @@ import (
 	"github.com/formancehq/go-libs/v5/pkg/service"
 	"github.com/formancehq/go-libs/v5/pkg/workflow/temporal"
 	"github.com/formancehq/payments/internal/worker"
+	"github.com/formancehq/payments/pkg/domain/models"
 	"github.com/spf13/cobra"
 	"go.uber.org/fx"
 )
@@ -36,6 +37,9 @@ func newWorker() *cobra.Command {
 	cmd.Flags().Bool(SkipOutboxScheduleCreationFlag, false, "Skip creating the outbox event publisher schedule (e.g. for tests)")
 	cmd.Flags().Duration(ConnectorHealthCheckInterval, 12*time.Hour, "Interval for connector health checks")
 	cmd.Flags().Int(ConnectorHealthCheckErrorThreshold, 10, "Number of consecutive errors required to pause a connector schedule")
+	cmd.Flags().String(ScreeningURLFlag, "", "Url of the screening service called before payment initiations are sent to the connector (disabled if empty)")
+	cmd.Flags().Duration(ScreeningTimeoutFlag, 10*time.Second, "Timeout of the requests sent to the screening service")
+	cmd.Flags().String(PayoutBankAccountVerificationFlag, "", "Minimum bank account verification result (MATCH or CLOSE_MATCH) required on the destination of payouts (disabled if empty)")
 	return cmd
 }
 
@@ -80,6 +84,18 @@ func workerOptions(cmd *cobra.Command) (fx.Option, error) {
 	outboxCleanupInterval, _ := cmd.Flags().GetDuration(OutboxCleanupIntervalFlag)
 	healthCheckInterval, _ := cmd.Flags().GetDuration(ConnectorHealthCheckInterval)
 	healthCheckErrorThreshold, _ := cmd.Flags().GetInt(ConnectorHealthCheckErrorThreshold)
+	screeningURL, _ := cmd.Flags().GetString(ScreeningURLFlag)
+	screeningTimeout, _ := cmd.Flags().GetDuration(ScreeningTimeoutFlag)
+
+	payoutBankAccountVerification := models.BANK_ACCOUNT_VERIFICATION_RESULT_UNKNOWN
+	if v, _ := cmd.Flags().GetString(PayoutBankAccountVerificationFlag); v != "" {
+		var err error
+		payoutBankAccountVerification, err = models.BankAccountVerificationResultFromString(v)
+		if err != nil || payoutBankAccountVerification == models.BANK_ACCOUNT_VERIFICATION_RESULT_NO_MATCH ||
+			payoutBankAccountVerification == models.BANK_ACCOUNT_VERIFICATION_RESULT_UNKNOWN {
+			return nil, fmt.Errorf("invalid value %q for --%s: expected MATCH or CLOSE_MATCH", v, PayoutBankAccountVerificationFlag)
+		}
+	}
 	return fx.Options(
 		worker.NewHealthCheckModule(listen, service.IsDebug(cmd)),
 		worker.NewModule(
@@ -99,6 +115,9 @@ func workerOptions(cmd *cobra.Command) (fx.Option, error) {
 			outboxCleanupInterval,
 			healthCheckInterval,
 			healthCheckErrorThreshold,
+			screeningURL,
+			screeningTimeout,
+			payoutBankAccountVerification,
 		),
 	), nil
 }
@@ -10,7 +10,7 @@ import (
 
 type Config struct {
 	ClientID     string `json:"clientID" validate:"required"`
-	ClientSecret string `json:"clientSecret" validate:"required"`
+	ClientSecret string `json:"clientSecret" validate:"required" sensitive:"true"`
 	Endpoint     string `json:"endpoint" validate:"required,uri"`
 	AuthEndpoint string `json:"authEndpoint" validate:"required,uri"` // TODO maybe we can do a redirect
 }
@@ -31,6 +31,11 @@ type Client interface {
 	GetMyMarkets(ctx context.Context) ([]MyMarket, error)
 	GetTradingFees(ctx context.Context) ([]TradingFee, error)
 	GetWithdrawalFees(ctx context.Context) ([]WithdrawalFee, error)
+
+	// Withdrawal endpoints backing CreatePayout — see MAPPINGS §4.6.
+	CreateCryptoWithdrawal(ctx context.Context, req CryptoWithdrawalRequest) (Withdrawal, error)
+	CreateFiatWithdrawal(ctx context.Context, req FiatWithdrawalRequest) (Withdrawal, error)
+	GetWithdrawalRequest(ctx context.Context, id int64) (WithdrawalRequest, error)
 }
 
 const DefaultEndpoint = "https://www.bitstamp.net"
@@ -259,3 +264,86 @@ func (c *client) GetWithdrawalFees(ctx context.Context) ([]WithdrawalFee, error)
 	}
 	return out, nil
 }
+
+// CreateCryptoWithdrawal withdraws a crypto currency to a whitelisted
+// address. The currency is lower-cased into the path as Bitstamp
+// expects (e.g. /api/v2/btc_withdrawal/).
+func (c *client) CreateCryptoWithdrawal(ctx context.Context, req CryptoWithdrawalRequest) (Withdrawal, error) {
+	currency := strings.ToLower(strings.TrimSpace(req.Currency))
+	form := url.Values{}
+	form.Set("amount", req.Amount)
+	form.Set("address", req.Address)
+	if req.Network != "" {
+		form.Set("network", req.Network)
+	}
+	if req.MemoID != "" {
+		form.Set("memo_id", req.MemoID)
+	}
+	if req.DestinationTag != "" {
+		form.Set("destination_tag", req.DestinationTag)
+	}
+
+	var out Withdrawal
+	if err := c.signedPOST(ctx, "/api/v2/"+currency+"_withdrawal/", form, &out); err != nil {
+		return Withdrawal{}, fmt.Errorf("create %s withdrawal: %w", currency, err)
+	}
+	if out.RequestID() == 0 {
+		return Withdrawal{}, fmt.Errorf("create %s withdrawal: missing withdrawal id in response", currency)
+	}
+	return out, nil
+}
+
+// CreateFiatWithdrawal opens a SEPA or international bank withdrawal
+// to an account already whitelisted on the Bitstamp account.
+func (c *client) CreateFiatWithdrawal(ctx context.Context, req FiatWithdrawalRequest) (Withdrawal, error) {
+	form := url.Values{}
+	form.Set("type", req.Type)
+	form.Set("amount", req.Amount)
+	form.Set("account_currency", strings.ToUpper(req.AccountCurrency))
+	form.Set("name", req.Name)
+	form.Set("iban", req.IBAN)
+	form.Set("bic", req.BIC)
+	form.Set("address", req.Address)
+	form.Set("postal_code", req.PostalCode)
+	form.Set("city", req.City)
+	form.Set("country", req.Country)
+	if req.Comment != "" {
+		form.Set("comment", req.Comment)
+	}
+
+	var out Withdrawal
+	if err := c.signedPOST(ctx, "/api/v2/withdrawal/open/", form, &out); err != nil {
+		return Withdrawal{}, fmt.Errorf("create fiat withdrawal: %w", err)
+	}
+	if out.RequestID() == 0 {
+		return Withdrawal{}, fmt.Errorf("create fiat withdrawal: missing withdrawal id in response")
+	}
+	return out, nil
+}
+
+// withdrawalRequestsMaxTimedelta is the widest lookback Bitstamp
+// accepts on /withdrawal-requests/ (the default is one day).
+const withdrawalRequestsMaxTimedelta = "50000000"
+
+// GetWithdrawalRequest looks a single withdrawal request up by id. A
+// NotFoundError is returned when Bitstamp does not list it (yet).
+func (c *client) GetWithdrawalRequest(ctx context.Context, id int64) (WithdrawalRequest, error) {
+	const path = "/api/v2/withdrawal-requests/"
+	form := url.Values{}
+	form.Set("id", strconv.FormatInt(id, 10))
+	form.Set("timedelta", withdrawalRequestsMaxTimedelta)
+	// Bitstamp rejects the call unless both limit and offset are set.
+	form.Set("limit", "1")
+	form.Set("offset", "0")
+
+	var out []WithdrawalRequest
+	if err := c.signedPOST(ctx, path, form, &out); err != nil {
+		return WithdrawalRequest{}, fmt.Errorf("get withdrawal request %d: %w", id, err)
+	}
+	for _, wr := range out {
+		if wr.ID == id {
+			return wr, nil
+		}
+	}
+	return WithdrawalRequest{}, &NotFoundError{Endpoint: path, Message: fmt.Sprintf("withdrawal request %d", id)}
+}
@@ -40,6 +40,36 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// CreateCryptoWithdrawal mocks base method.
+func (m *MockClient) CreateCryptoWithdrawal(ctx context.Context, req CryptoWithdrawalRequest) (Withdrawal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCryptoWithdrawal", ctx, req)
+	ret0, _ := ret[0].(Withdrawal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCryptoWithdrawal indicates an expected call of CreateCryptoWithdrawal.
+func (mr *MockClientMockRecorder) CreateCryptoWithdrawal(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCryptoWithdrawal", reflect.TypeOf((*MockClient)(nil).CreateCryptoWithdrawal), ctx, req)
+}
+
+// CreateFiatWithdrawal mocks base method.
+func (m *MockClient) CreateFiatWithdrawal(ctx context.Context, req FiatWithdrawalRequest) (Withdrawal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFiatWithdrawal", ctx, req)
+	ret0, _ := ret[0].(Withdrawal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFiatWithdrawal indicates an expected call of CreateFiatWithdrawal.
+func (mr *MockClientMockRecorder) CreateFiatWithdrawal(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFiatWithdrawal", reflect.TypeOf((*MockClient)(nil).CreateFiatWithdrawal), ctx, req)
+}
+
 // GetAccountBalances mocks base method.
 func (m *MockClient) GetAccountBalances(ctx context.Context) ([]AccountBalance, error) {
 	m.ctrl.T.Helper()
@@ -159,3 +189,18 @@ func (mr *MockClientMockRecorder) GetWithdrawalFees(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithdrawalFees", reflect.TypeOf((*MockClient)(nil).GetWithdrawalFees), ctx)
 }
+
+// GetWithdrawalRequest mocks base method.
+func (m *MockClient) GetWithdrawalRequest(ctx context.Context, id int64) (WithdrawalRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithdrawalRequest", ctx, id)
+	ret0, _ := ret[0].(WithdrawalRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWithdrawalRequest indicates an expected call of GetWithdrawalRequest.
+func (mr *MockClientMockRecorder) GetWithdrawalRequest(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithdrawalRequest", reflect.TypeOf((*MockClient)(nil).GetWithdrawalRequest), ctx, id)
+}
@@ -444,6 +444,47 @@ func TestGetUserTransactionsOmitsZeroSinceID(t *testing.T) {
 	}
 }
 
+func TestCreateCryptoWithdrawalPostsToCurrencyPath(t *testing.T) {
+	t.Parallel()
+	srv, lastReq := stubServer(t, `{"id":42}`)
+	got, err := New("bitstamp", testAPIKey, testAPISecret, srv.URL).
+		CreateCryptoWithdrawal(t.Context(), CryptoWithdrawalRequest{
+			Currency:       "XRP",
+			Amount:         "25.5",
+			Address:        "rAddress",
+			Network:        "xrpl",
+			DestinationTag: "12345",
+		})
+	if err != nil {
+		t.Fatalf("CreateCryptoWithdrawal: %v", err)
+	}
+	if got.RequestID() != 42 {
+		t.Errorf("expected withdrawal id 42, got %+v", got)
+	}
+	if lastReq().URL.Path != "/api/v2/xrp_withdrawal/" {
+		t.Errorf("unexpected path %q", lastReq().URL.Path)
+	}
+	body, _ := io.ReadAll(lastReq().Body)
+	for _, field := range []string{"amount=25.5", "address=rAddress", "network=xrpl", "destination_tag=12345"} {
+		if !strings.Contains(string(body), field) {
+			t.Errorf("body missing %s: %q", field, body)
+		}
+	}
+	if strings.Contains(string(body), "memo_id=") {
+		t.Errorf("empty memo_id must be omitted, got body %q", body)
+	}
+}
+
+func TestCreateFiatWithdrawalRequiresWithdrawalID(t *testing.T) {
+	t.Parallel()
+	srv, _ := stubServer(t, `{"status":"error","reason":"Insufficient balance"}`)
+	_, err := New("bitstamp", testAPIKey, testAPISecret, srv.URL).
+		CreateFiatWithdrawal(t.Context(), FiatWithdrawalRequest{Type: "sepa", Amount: "100.00", AccountCurrency: "eur"})
+	if err == nil {
+		t.Fatal("expected error when the response carries no withdrawal id")
+	}
+}
+
 func TestSignedPOSTWrapsGenericServerError(t *testing.T) {
 	t.Parallel()
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
@@ -461,3 +502,35 @@ func TestSignedPOSTWrapsGenericServerError(t *testing.T) {
 	}
 }
 
+
+func TestGetWithdrawalRequestFiltersByID(t *testing.T) {
+	t.Parallel()
+	srv, lastReq := stubServer(t, `[{"id":42,"datetime":"2025-09-25 14:42:59","type":4,"currency":"BTC","network":"bitcoin","amount":"1.50000000","status":2,"address":"bc1qaddress","txid":"abcdef"}]`)
+	got, err := New("bitstamp", testAPIKey, testAPISecret, srv.URL).
+		GetWithdrawalRequest(t.Context(), 42)
+	if err != nil {
+		t.Fatalf("GetWithdrawalRequest: %v", err)
+	}
+	if got.ID != 42 || got.Status != 2 || got.TxID != "abcdef" {
+		t.Errorf("unexpected withdrawal request: %+v", got)
+	}
+	if lastReq().URL.Path != "/api/v2/withdrawal-requests/" {
+		t.Errorf("unexpected path %q", lastReq().URL.Path)
+	}
+	body, _ := io.ReadAll(lastReq().Body)
+	for _, field := range []string{"id=42", "limit=1", "offset=0"} {
+		if !strings.Contains(string(body), field) {
+			t.Errorf("body missing %s: %q", field, body)
+		}
+	}
+}
+
+func TestGetWithdrawalRequestNotListed(t *testing.T) {
+	t.Parallel()
+	srv, _ := stubServer(t, `[]`)
+	_, err := New("bitstamp", testAPIKey, testAPISecret, srv.URL).
+		GetWithdrawalRequest(t.Context(), 42)
+	if !IsNotFoundError(err) {
+		t.Fatalf("expected a NotFoundError, got %v", err)
+	}
+}
@@ -156,6 +156,69 @@ type WithdrawalFee struct {
 	Network  string `json:"network,omitempty"`
 }
 
+// CryptoWithdrawalRequest is the form body of POST
+// /api/v2/{currency}_withdrawal/. Network is required by Bitstamp on
+// currencies that span several blockchains; MemoID and DestinationTag
+// are only honoured on the networks that use them (XRP, XLM, HBAR, …).
+type CryptoWithdrawalRequest struct {
+	Currency       string
+	Amount         string
+	Address        string
+	Network        string
+	MemoID         string
+	DestinationTag string
+}
+
+// FiatWithdrawalRequest is the form body of POST /api/v2/withdrawal/open/.
+// Type is "sepa" or "international"; the bank account must already be
+// whitelisted on the Bitstamp account.
+type FiatWithdrawalRequest struct {
+	Type            string
+	Amount          string
+	AccountCurrency string
+	Name            string
+	IBAN            string
+	BIC             string
+	Address         string
+	PostalCode      string
+	City            string
+	Country         string
+	Comment         string
+}
+
+// Withdrawal is the response of both withdrawal endpoints. Crypto
+// withdrawals answer with `id`, fiat ones with `withdrawal_id`; both
+// are withdrawal-request ids as listed by /withdrawal-requests/.
+type Withdrawal struct {
+	ID           int64 `json:"id,omitempty"`
+	WithdrawalID int64 `json:"withdrawal_id,omitempty"`
+}
+
+// RequestID returns the withdrawal-request id regardless of which
+// endpoint produced the response.
+func (w Withdrawal) RequestID() int64 {
+	if w.ID != 0 {
+		return w.ID
+	}
+	return w.WithdrawalID
+}
+
+// WithdrawalRequest is one row of POST /api/v2/withdrawal-requests/.
+// Type and Status are integer enums (MAPPINGS §4.3.5 / §4.3.6);
+// Datetime has no microseconds, unlike the other endpoints.
+type WithdrawalRequest struct {
+	ID            int64  `json:"id"`
+	Datetime      string `json:"datetime"`
+	Type          int    `json:"type"`
+	Currency      string `json:"currency"`
+	Network       string `json:"network,omitempty"`
+	Amount        string `json:"amount"`
+	Status        int    `json:"status"`
+	Address       string `json:"address,omitempty"`
+	TransactionID string `json:"transaction_id,omitempty"`
+	TxID          string `json:"txid,omitempty"`
+}
+
 // AccountOrderDataEvent is one item from GET /api/v2/account_order_data/.
 // event is "order_created" or "order_deleted"; further lifecycle events
 // follow the same shape and are handled generically.
@@ -12,8 +12,8 @@ import (
 // rationale on the deliberately minimal surface (no accountScope,
 // derivatives, or per-source toggles — the PSP is the source of truth).
 type Config struct {
-	APIKey    string `json:"apiKey" validate:"required"`
-	APISecret string `json:"apiSecret" validate:"required"`
+	APIKey    string `json:"apiKey" validate:"required" sensitive:"true"`
+	APISecret string `json:"apiSecret" validate:"required" sensitive:"true"`
 	Endpoint  string `json:"endpoint" validate:"omitempty,url"`
 }
 
@@ -39,10 +39,24 @@ const (
 	MetadataKeyOrderEventID        = MetadataPrefix + "order_event_id"
 	MetadataKeyOrderDatetimeSecs   = MetadataPrefix + "order_status_datetime_s"
 	MetadataKeyOrderDatetimeMicros = MetadataPrefix + "order_status_datetime_ms"
+
+	// Withdrawal keys. destination_address / network / memo_id /
+	// destination_tag / withdrawal_type are read from the
+	// PaymentInitiation metadata on CreatePayout and echoed back on the
+	// created PSPPayment.
+	MetadataKeyNetwork            = MetadataPrefix + "network"
+	MetadataKeyDestinationAddress = MetadataPrefix + "destination_address"
+	MetadataKeyMemoID             = MetadataPrefix + "memo_id"
+	MetadataKeyDestinationTag     = MetadataPrefix + "destination_tag"
+	MetadataKeyWithdrawalType     = MetadataPrefix + "withdrawal_type"
+	MetadataKeyWithdrawalID       = MetadataPrefix + "withdrawal_id"
+	MetadataKeyTxID               = MetadataPrefix + "txid"
+	MetadataKeyBankTransactionID  = MetadataPrefix + "bank_transaction_id"
 )
 
 const (
-	PaymentSourceUserTransactions = "user_transactions"
+	PaymentSourceUserTransactions   = "user_transactions"
+	PaymentSourceWithdrawalRequests = "withdrawal_requests"
 )
 
 const (
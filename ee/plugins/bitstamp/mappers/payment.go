@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/formancehq/go-libs/v5/pkg/types/currency"
 	"github.com/formancehq/payments/ee/plugins/bitstamp/client"
 	"github.com/formancehq/payments/pkg/domain/models"
 )
@@ -66,6 +67,11 @@ func UserTransactionToPSPPayment(currencies map[string]int, tx client.UserTransa
 
 	paymentType, metadata := classifyPaymentType(tx)
 
+	fees, err := paymentFees(currencies, asset, tx)
+	if err != nil {
+		return PaymentMapResult{}, fmt.Errorf("resolve fee for tx %d: %w", tx.ID, err)
+	}
+
 	return PaymentMapResult{
 		Payment: &models.PSPPayment{
 			Reference: strconv.FormatInt(tx.ID, 10),
@@ -74,10 +80,9 @@ func UserTransactionToPSPPayment(currencies map[string]int, tx client.UserTransa
 			Amount:    amount,
 			Asset:     asset,
 			Scheme:    models.PAYMENT_SCHEME_OTHER,
-			// user_transactions returns settled-only history; pending
-			// withdrawals live on /withdrawal-requests/ which a sibling
-			// orchestrator polls (MAPPINGS.md §3.3.c).
+			// user_transactions returns settled-only history.
 			Status:   models.PAYMENT_STATUS_SUCCEEDED,
+			Fees:     fees,
 			Metadata: metadata,
 			Raw:      raw,
 		},
@@ -85,6 +90,29 @@ func UserTransactionToPSPPayment(currencies map[string]int, tx client.UserTransa
 	}, nil
 }
 
+// paymentFees maps the fee of a deposit / withdrawal row. Unlike trades,
+// the fee of a single-asset row is charged in the payment asset.
+func paymentFees(currencies map[string]int, asset string, tx client.UserTransaction) ([]models.PaymentFee, error) {
+	if tx.Fee == "" || IsZeroAmount(AbsAmount(tx.Fee)) {
+		return nil, nil
+	}
+	_, precision, err := currency.GetCurrencyAndPrecisionFromAsset(currencies, asset)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := ParseDecimalAmount(AbsAmount(tx.Fee), precision)
+	if err != nil {
+		return nil, err
+	}
+	return []models.PaymentFee{
+		{
+			Type:   models.PAYMENT_FEE_TYPE_PROCESSING,
+			Amount: amount,
+			Asset:  asset,
+		},
+	}, nil
+}
+
 // classifyPaymentType maps a user_transactions row to a
 // (PaymentType, metadata). Two-legged transfer types (14 / 33 / 35)
 // split by amount sign — see MAPPINGS §4.3 sub-section on
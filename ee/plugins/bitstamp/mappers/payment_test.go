@@ -55,6 +55,9 @@ func TestUserTransactionToPSPPaymentDeposit(t *testing.T) {
 	if res.UnknownType {
 		t.Errorf("type 0 should be known")
 	}
+	if res.Payment.Fees != nil {
+		t.Errorf("fees=%v, want none for a zero fee", res.Payment.Fees)
+	}
 }
 
 func TestUserTransactionToPSPPaymentWithdrawalNegative(t *testing.T) {
@@ -84,6 +87,13 @@ func TestUserTransactionToPSPPaymentWithdrawalNegative(t *testing.T) {
 	if res.Payment.Metadata[MetadataKeyFee] != "0.01" {
 		t.Errorf("missing fee metadata: %v", res.Payment.Metadata)
 	}
+	if len(res.Payment.Fees) != 1 {
+		t.Fatalf("fees=%v, want one fee", res.Payment.Fees)
+	}
+	fee := res.Payment.Fees[0]
+	if fee.Type != models.PAYMENT_FEE_TYPE_PROCESSING || fee.Asset != "EUR/2" || fee.Amount.Cmp(big.NewInt(1)) != 0 {
+		t.Errorf("fee=%+v, want PROCESSING 1 EUR/2", fee)
+	}
 }
 
 func TestUserTransactionToPSPPaymentSkipsTradesAndConversions(t *testing.T) {
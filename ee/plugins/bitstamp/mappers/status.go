@@ -133,6 +133,45 @@ func AccountOrderEventToStatus(event, amountStr, amountTraded string) models.Ord
 	}
 }
 
+// Bitstamp withdrawal-requests.status values. See MAPPINGS §4.3.6.
+const (
+	WithdrawalRequestStatusOpen       = 0
+	WithdrawalRequestStatusInProgress = 1
+	WithdrawalRequestStatusFinished   = 2
+	WithdrawalRequestStatusCanceled   = 3
+	WithdrawalRequestStatusFailed     = 4
+)
+
+// WithdrawalRequestStatusToPaymentStatus maps withdrawal-requests.status;
+// unknown codes fall back to PAYMENT_STATUS_UNKNOWN.
+func WithdrawalRequestStatusToPaymentStatus(status int) models.PaymentStatus {
+	switch status {
+	case WithdrawalRequestStatusOpen, WithdrawalRequestStatusInProgress:
+		return models.PAYMENT_STATUS_PENDING
+	case WithdrawalRequestStatusFinished:
+		return models.PAYMENT_STATUS_SUCCEEDED
+	case WithdrawalRequestStatusCanceled:
+		return models.PAYMENT_STATUS_CANCELLED
+	case WithdrawalRequestStatusFailed:
+		return models.PAYMENT_STATUS_FAILED
+	default:
+		return models.PAYMENT_STATUS_UNKNOWN
+	}
+}
+
+// WithdrawalRequestTypeToScheme maps withdrawal-requests.type per
+// MAPPINGS §4.3.5: 0 is SEPA, 1-4 are other rails.
+func WithdrawalRequestTypeToScheme(t int) models.PaymentScheme {
+	switch t {
+	case 0:
+		return models.PAYMENT_SCHEME_SEPA_CREDIT
+	case 1, 2, 3, 4:
+		return models.PAYMENT_SCHEME_OTHER
+	default:
+		return models.PAYMENT_SCHEME_UNKNOWN
+	}
+}
+
 // Order subtype (0 - limit; 1 - instant; 2 - market; 3 - daily; 4 - IOC; 5 - MOC; 6 - FOK; 7 - CASH SELL; 8 - GTD; 20 - stop loss; 21 - take profit; 22 - stop loss limit; 23 - take profit limit; 24 - trailing stop loss; 25 - trailing take profit; 26 - stop loss limit; 27 - trailing take profit limit).
 const (
 	OrderSubtypeLimit                   = 0
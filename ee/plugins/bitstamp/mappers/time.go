@@ -10,6 +10,8 @@ import (
 // the no-microsecond variant — see withdrawalRequestDatetimeLayout.
 const BitstampDatetimeLayout = "2006-01-02 15:04:05.000000"
 
+const withdrawalRequestDatetimeLayout = "2006-01-02 15:04:05"
+
 func ParseBitstampTime(s string) (time.Time, error) {
 	t, err := time.Parse(BitstampDatetimeLayout, s)
 	if err != nil {
@@ -18,6 +20,16 @@ func ParseBitstampTime(s string) (time.Time, error) {
 	return t.UTC(), nil
 }
 
+// ParseWithdrawalRequestTime parses the withdrawal-requests datetime,
+// which carries no microseconds.
+func ParseWithdrawalRequestTime(s string) (time.Time, error) {
+	t, err := time.Parse(withdrawalRequestDatetimeLayout, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse bitstamp withdrawal request datetime %q: %w", s, err)
+	}
+	return t.UTC(), nil
+}
+
 // BitstampGenesis is the stable lower-bound sentinel used as
 // PSPAccount.CreatedAt — Bitstamp does not expose per-currency
 // creation dates. Readers should treat it as "unknown, definitely
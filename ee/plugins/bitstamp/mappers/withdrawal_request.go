@@ -0,0 +1,68 @@
+package mappers
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/formancehq/payments/ee/plugins/bitstamp/client"
+	"github.com/formancehq/payments/pkg/domain/models"
+)
+
+// WithdrawalRequestReferencePrefix prefixes the References of
+// withdrawal-requests rows (MAPPINGS §4.3.3), so that they never
+// collide with the numeric user_transactions ids.
+const WithdrawalRequestReferencePrefix = "wr:"
+
+// WithdrawalRequestToPSPPayment maps a withdrawal-requests row to a
+// PAYOUT carrying the request lifecycle status.
+func WithdrawalRequestToPSPPayment(currencies map[string]int, wr client.WithdrawalRequest) (models.PSPPayment, error) {
+	symbol := NormalizeCurrency(wr.Currency)
+	precision, err := PrecisionFor(currencies, symbol)
+	if err != nil {
+		return models.PSPPayment{}, fmt.Errorf("withdrawal request %d: %w", wr.ID, err)
+	}
+
+	amount, err := ParseDecimalAmount(AbsAmount(wr.Amount), precision)
+	if err != nil {
+		return models.PSPPayment{}, fmt.Errorf("withdrawal request %d: %w", wr.ID, err)
+	}
+
+	createdAt, err := ParseWithdrawalRequestTime(wr.Datetime)
+	if err != nil {
+		return models.PSPPayment{}, fmt.Errorf("withdrawal request %d: %w", wr.ID, err)
+	}
+
+	raw, err := json.Marshal(wr)
+	if err != nil {
+		return models.PSPPayment{}, fmt.Errorf("marshal raw for withdrawal request %d: %w", wr.ID, err)
+	}
+
+	requestID := strconv.FormatInt(wr.ID, 10)
+	return models.PSPPayment{
+		Reference:              WithdrawalRequestReferencePrefix + requestID,
+		CreatedAt:              createdAt,
+		Type:                   models.PAYMENT_TYPE_PAYOUT,
+		Amount:                 amount,
+		Asset:                  FormatAsset(currencies, symbol),
+		Scheme:                 WithdrawalRequestTypeToScheme(wr.Type),
+		Status:                 WithdrawalRequestStatusToPaymentStatus(wr.Status),
+		SourceAccountReference: &symbol,
+		Metadata:               WithdrawalRequestMetadata(wr),
+		Raw:                    raw,
+	}, nil
+}
+
+// WithdrawalRequestMetadata for withdrawal-requests rows, see MAPPINGS §6.1.
+func WithdrawalRequestMetadata(wr client.WithdrawalRequest) map[string]string {
+	m := map[string]string{
+		MetadataKeySource:       PaymentSourceWithdrawalRequests,
+		MetadataKeyType:         strconv.Itoa(wr.Type),
+		MetadataKeyWithdrawalID: strconv.FormatInt(wr.ID, 10),
+	}
+	setIfNonEmpty(m, MetadataKeyNetwork, wr.Network)
+	setIfNonEmpty(m, MetadataKeyDestinationAddress, wr.Address)
+	setIfNonEmpty(m, MetadataKeyTxID, wr.TxID)
+	setIfNonEmpty(m, MetadataKeyBankTransactionID, wr.TransactionID)
+	return m
+}
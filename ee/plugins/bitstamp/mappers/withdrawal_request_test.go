@@ -0,0 +1,63 @@
+package mappers
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/formancehq/payments/ee/plugins/bitstamp/client"
+	"github.com/formancehq/payments/pkg/domain/models"
+)
+
+func TestWithdrawalRequestStatusToPaymentStatus(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		status int
+		want   models.PaymentStatus
+	}{
+		{WithdrawalRequestStatusOpen, models.PAYMENT_STATUS_PENDING},
+		{WithdrawalRequestStatusInProgress, models.PAYMENT_STATUS_PENDING},
+		{WithdrawalRequestStatusFinished, models.PAYMENT_STATUS_SUCCEEDED},
+		{WithdrawalRequestStatusCanceled, models.PAYMENT_STATUS_CANCELLED},
+		{WithdrawalRequestStatusFailed, models.PAYMENT_STATUS_FAILED},
+		{99, models.PAYMENT_STATUS_UNKNOWN},
+	}
+	for _, tc := range cases {
+		if got := WithdrawalRequestStatusToPaymentStatus(tc.status); got != tc.want {
+			t.Errorf("status %d: got %v want %v", tc.status, got, tc.want)
+		}
+	}
+}
+
+func TestWithdrawalRequestToPSPPayment(t *testing.T) {
+	t.Parallel()
+	currencies := map[string]int{"EUR": 2}
+	got, err := WithdrawalRequestToPSPPayment(currencies, client.WithdrawalRequest{
+		ID:            7,
+		Datetime:      "2025-09-25 14:42:59",
+		Type:          0,
+		Currency:      "eur",
+		Amount:        "100.00",
+		Status:        WithdrawalRequestStatusFinished,
+		TransactionID: "bank-tx",
+	})
+	if err != nil {
+		t.Fatalf("WithdrawalRequestToPSPPayment: %v", err)
+	}
+	if got.Reference != "wr:7" || got.Asset != "EUR/2" || got.Amount.Cmp(big.NewInt(10000)) != 0 {
+		t.Errorf("unexpected payment: %+v", got)
+	}
+	if got.Type != models.PAYMENT_TYPE_PAYOUT || got.Status != models.PAYMENT_STATUS_SUCCEEDED || got.Scheme != models.PAYMENT_SCHEME_SEPA_CREDIT {
+		t.Errorf("unexpected type/status/scheme: %v %v %v", got.Type, got.Status, got.Scheme)
+	}
+	if !got.CreatedAt.Equal(time.Date(2025, 9, 25, 14, 42, 59, 0, time.UTC)) {
+		t.Errorf("unexpected createdAt %v", got.CreatedAt)
+	}
+	if got.Metadata[MetadataKeyBankTransactionID] != "bank-tx" || got.Metadata[MetadataKeySource] != PaymentSourceWithdrawalRequests {
+		t.Errorf("unexpected metadata %v", got.Metadata)
+	}
+
+	if _, err := WithdrawalRequestToPSPPayment(currencies, client.WithdrawalRequest{ID: 8, Currency: "DOGE", Amount: "1"}); err == nil {
+		t.Error("expected an error for an unsupported currency")
+	}
+}
@@ -0,0 +1,292 @@
+package bitstamp
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/formancehq/go-libs/v5/pkg/types/currency"
+	"github.com/formancehq/payments/ee/plugins/bitstamp/client"
+	"github.com/formancehq/payments/ee/plugins/bitstamp/mappers"
+	errorsutils "github.com/formancehq/payments/pkg/domain/errors"
+	"github.com/formancehq/payments/pkg/domain/models"
+)
+
+const (
+	currencyTypeFiat = "fiat"
+
+	withdrawalTypeSEPA          = "sepa"
+	withdrawalTypeInternational = "international"
+
+	// networkStatusEnabled is the /currencies/ networks[].withdrawal
+	// value for a network that accepts withdrawals.
+	networkStatusEnabled = "Enabled"
+)
+
+// createPayout withdraws funds from the Bitstamp account. Fiat currencies
+// go through /withdrawal/open/ to the destination bank account; crypto
+// currencies go through /{currency}_withdrawal/ to the address carried
+// in the PaymentInitiation metadata. Both destinations must already be
+// whitelisted on the Bitstamp account. Bitstamp settles withdrawals
+// asynchronously: the withdrawal-request id is returned for polling and
+// pollPayoutStatus reports the payment once the request is final.
+func (p *Plugin) createPayout(ctx context.Context, pi models.PSPPaymentInitiation) (models.CreatePayoutResponse, error) {
+	if err := validatePayoutRequest(pi); err != nil {
+		return models.CreatePayoutResponse{}, err
+	}
+
+	currencies, err := p.getCurrencies(ctx)
+	if err != nil {
+		return models.CreatePayoutResponse{}, err
+	}
+
+	symbol, precision, err := currency.GetCurrencyAndPrecisionFromAsset(currencies, pi.Asset)
+	if err != nil {
+		return models.CreatePayoutResponse{}, errorsutils.NewWrappedError(
+			fmt.Errorf("failed to get currency and precision from asset: %w", err),
+			models.ErrInvalidRequest,
+		)
+	}
+
+	if pi.SourceAccount != nil && mappers.NormalizeCurrency(pi.SourceAccount.Reference) != symbol {
+		return models.CreatePayoutResponse{}, errorsutils.NewWrappedError(
+			fmt.Errorf("source account %s cannot be debited in %s", pi.SourceAccount.Reference, symbol),
+			models.ErrInvalidRequest,
+		)
+	}
+
+	amount, err := currency.GetStringAmountFromBigIntWithPrecision(pi.Amount, precision)
+	if err != nil {
+		return models.CreatePayoutResponse{}, err
+	}
+
+	index, err := p.currenciesIndex(ctx)
+	if err != nil {
+		return models.CreatePayoutResponse{}, err
+	}
+
+	var withdrawal client.Withdrawal
+	if strings.EqualFold(index[symbol].Type, currencyTypeFiat) {
+		withdrawal, err = p.createFiatWithdrawal(ctx, pi, symbol, amount)
+	} else {
+		withdrawal, err = p.createCryptoWithdrawal(ctx, pi, index[symbol], symbol, amount)
+	}
+	if err != nil {
+		return models.CreatePayoutResponse{}, err
+	}
+
+	requestID := strconv.FormatInt(withdrawal.RequestID(), 10)
+	return models.CreatePayoutResponse{PollingPayoutID: &requestID}, nil
+}
+
+// pollPayoutStatus looks the withdrawal request up on /withdrawal-requests/
+// and returns the payment once it reached a final status. The withdrawal
+// fee is read from /fees/withdrawal/ at that time, the request rows do
+// not carry it.
+func (p *Plugin) pollPayoutStatus(ctx context.Context, payoutID string) (models.PollPayoutStatusResponse, error) {
+	requestID, err := strconv.ParseInt(payoutID, 10, 64)
+	if err != nil {
+		return models.PollPayoutStatusResponse{}, errorsutils.NewWrappedError(
+			fmt.Errorf("invalid withdrawal request id %q: %w", payoutID, err),
+			models.ErrInvalidRequest,
+		)
+	}
+
+	wr, err := p.client.GetWithdrawalRequest(ctx, requestID)
+	if err != nil {
+		if client.IsNotFoundError(err) {
+			// Not listed yet, wait for the next polling
+			return models.PollPayoutStatusResponse{}, nil
+		}
+		return models.PollPayoutStatusResponse{}, err
+	}
+
+	switch mappers.WithdrawalRequestStatusToPaymentStatus(wr.Status) {
+	case models.PAYMENT_STATUS_PENDING:
+		return models.PollPayoutStatusResponse{}, nil
+	case models.PAYMENT_STATUS_UNKNOWN:
+		p.logger.WithField("withdrawalRequestID", wr.ID).Infof("unknown withdrawal request status %d, waiting for a final one", wr.Status)
+		return models.PollPayoutStatusResponse{}, nil
+	}
+
+	currencies, err := p.getCurrencies(ctx)
+	if err != nil {
+		return models.PollPayoutStatusResponse{}, err
+	}
+
+	payment, err := mappers.WithdrawalRequestToPSPPayment(currencies, wr)
+	if err != nil {
+		return models.PollPayoutStatusResponse{}, err
+	}
+
+	fee, err := p.withdrawalFee(ctx, mappers.NormalizeCurrency(wr.Currency), wr.Network)
+	if err != nil {
+		return models.PollPayoutStatusResponse{}, err
+	}
+	if !mappers.IsZeroAmount(fee) {
+		payment.Metadata[mappers.MetadataKeyFee] = fee
+	}
+
+	return models.PollPayoutStatusResponse{Payment: &payment}, nil
+}
+
+func (p *Plugin) createCryptoWithdrawal(
+	ctx context.Context,
+	pi models.PSPPaymentInitiation,
+	cur client.Currency,
+	symbol string,
+	amount string,
+) (client.Withdrawal, error) {
+	address := payoutMetadata(pi, mappers.MetadataKeyDestinationAddress)
+	if address == "" {
+		return client.Withdrawal{}, models.NewConnectorValidationError(mappers.MetadataKeyDestinationAddress, models.ErrMissingConnectorField)
+	}
+
+	network, err := selectWithdrawalNetwork(cur, symbol, payoutMetadata(pi, mappers.MetadataKeyNetwork))
+	if err != nil {
+		return client.Withdrawal{}, err
+	}
+
+	return p.client.CreateCryptoWithdrawal(ctx, client.CryptoWithdrawalRequest{
+		Currency:       symbol,
+		Amount:         amount,
+		Address:        address,
+		Network:        network,
+		MemoID:         payoutMetadata(pi, mappers.MetadataKeyMemoID),
+		DestinationTag: payoutMetadata(pi, mappers.MetadataKeyDestinationTag),
+	})
+}
+
+func (p *Plugin) createFiatWithdrawal(
+	ctx context.Context,
+	pi models.PSPPaymentInitiation,
+	symbol string,
+	amount string,
+) (client.Withdrawal, error) {
+	if pi.DestinationAccount == nil {
+		return client.Withdrawal{}, models.NewConnectorValidationError("destinationAccount", models.ErrMissingConnectorField)
+	}
+
+	withdrawalType := strings.ToLower(payoutMetadata(pi, mappers.MetadataKeyWithdrawalType))
+	switch withdrawalType {
+	case "":
+		withdrawalType = withdrawalTypeSEPA
+	case withdrawalTypeSEPA, withdrawalTypeInternational:
+	default:
+		return client.Withdrawal{}, errorsutils.NewWrappedError(
+			fmt.Errorf("unsupported withdrawal type %q", withdrawalType),
+			models.ErrInvalidRequest,
+		)
+	}
+
+	bankAccount := pi.DestinationAccount.Metadata
+	for _, key := range []string{
+		models.AccountBankAccountNameMetadataKey,
+		models.AccountIBANMetadataKey,
+		models.AccountSwiftBicCodeMetadataKey,
+	} {
+		if models.ExtractNamespacedMetadata(bankAccount, key) == "" {
+			return client.Withdrawal{}, models.NewConnectorValidationError(key, models.ErrMissingConnectorField)
+		}
+	}
+
+	return p.client.CreateFiatWithdrawal(ctx, client.FiatWithdrawalRequest{
+		Type:            withdrawalType,
+		Amount:          amount,
+		AccountCurrency: symbol,
+		Name:            models.ExtractNamespacedMetadata(bankAccount, models.AccountBankAccountNameMetadataKey),
+		IBAN:            models.ExtractNamespacedMetadata(bankAccount, models.AccountIBANMetadataKey),
+		BIC:             models.ExtractNamespacedMetadata(bankAccount, models.AccountSwiftBicCodeMetadataKey),
+		Address:         models.ExtractNamespacedMetadata(bankAccount, models.BankAccountOwnerAddressLine1MetadataKey),
+		PostalCode:      models.ExtractNamespacedMetadata(bankAccount, models.BankAccountOwnerPostalCodeMetadataKey),
+		City:            models.ExtractNamespacedMetadata(bankAccount, models.BankAccountOwnerCityMetadataKey),
+		Country:         models.ExtractNamespacedMetadata(bankAccount, models.AccountBankAccountCountryMetadataKey),
+		Comment:         pi.Description,
+	})
+}
+
+// selectWithdrawalNetwork resolves the network a crypto withdrawal is
+// sent on. An explicit network must be one of the currency's networks
+// with withdrawals enabled; without one, the only enabled network is
+// picked, and multi-network currencies are rejected as ambiguous.
+// Currencies without a networks list are left to Bitstamp's default.
+func selectWithdrawalNetwork(cur client.Currency, symbol, requested string) (string, error) {
+	requested = strings.ToLower(strings.TrimSpace(requested))
+	if len(cur.Networks) == 0 {
+		return requested, nil
+	}
+
+	enabled := make([]string, 0, len(cur.Networks))
+	for _, network := range cur.Networks {
+		if network.Withdrawal != networkStatusEnabled {
+			continue
+		}
+		if requested != "" && strings.EqualFold(network.Network, requested) {
+			return network.Network, nil
+		}
+		enabled = append(enabled, network.Network)
+	}
+
+	switch {
+	case requested != "":
+		return "", errorsutils.NewWrappedError(
+			fmt.Errorf("network %s is not enabled for %s withdrawals", requested, symbol),
+			models.ErrInvalidRequest,
+		)
+	case len(enabled) == 1:
+		return enabled[0], nil
+	case len(enabled) == 0:
+		return "", errorsutils.NewWrappedError(
+			fmt.Errorf("withdrawals are disabled for %s", symbol),
+			models.ErrInvalidRequest,
+		)
+	default:
+		return "", errorsutils.NewWrappedError(
+			fmt.Errorf("%s is withdrawable on several networks (%s), %s is required", symbol, strings.Join(enabled, ", "), mappers.MetadataKeyNetwork),
+			models.ErrInvalidRequest,
+		)
+	}
+}
+
+// withdrawalFee returns the fee Bitstamp charges for withdrawing
+// symbol on network, or "" when the fee schedule has no matching row.
+// Fiat rows carry no network.
+func (p *Plugin) withdrawalFee(ctx context.Context, symbol, network string) (string, error) {
+	fees, err := p.client.GetWithdrawalFees(ctx)
+	if err != nil {
+		return "", err
+	}
+	for _, fee := range fees {
+		if mappers.NormalizeCurrency(fee.Currency) != symbol {
+			continue
+		}
+		if network == "" || strings.EqualFold(fee.Network, network) {
+			return fee.Fee, nil
+		}
+	}
+	return "", nil
+}
+
+// payoutMetadata reads a withdrawal key from the PaymentInitiation
+// metadata, falling back to the destination account metadata so a
+// whitelisted address can be stored once on the account.
+func payoutMetadata(pi models.PSPPaymentInitiation, key string) string {
+	if value := strings.TrimSpace(models.ExtractNamespacedMetadata(pi.Metadata, key)); value != "" {
+		return value
+	}
+	if pi.DestinationAccount != nil {
+		return strings.TrimSpace(models.ExtractNamespacedMetadata(pi.DestinationAccount.Metadata, key))
+	}
+	return ""
+}
+
+func validatePayoutRequest(pi models.PSPPaymentInitiation) error {
+	if pi.Amount == nil || pi.Amount.Sign() <= 0 {
+		return models.NewConnectorValidationError("amount", models.ErrInvalidRequest)
+	}
+	if pi.Asset == "" {
+		return models.NewConnectorValidationError("asset", models.ErrMissingConnectorField)
+	}
+	return nil
+}
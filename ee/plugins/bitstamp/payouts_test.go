@@ -0,0 +1,292 @@
+package bitstamp
+
+import (
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/observe/log"
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/payments/ee/plugins/bitstamp/client"
+	"github.com/formancehq/payments/ee/plugins/bitstamp/mappers"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/formancehq/payments/pkg/domain/plugins"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Bitstamp Plugin Payouts", func() {
+	var (
+		ctrl *gomock.Controller
+		m    *client.MockClient
+		plg  *Plugin
+		pi   models.PSPPaymentInitiation
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		m = client.NewMockClient(ctrl)
+		plg = &Plugin{
+			Plugin: plugins.NewBasePlugin(),
+			client: m,
+			logger: logging.NewDefaultLogger(GinkgoWriter, true, false, false),
+			currencies: map[string]int{
+				"EUR":  2,
+				"BTC":  8,
+				"USDC": 6,
+			},
+			currenciesFull: []client.Currency{
+				{Currency: "EUR", Decimals: 2, Type: "fiat"},
+				{Currency: "BTC", Decimals: 8, Type: "crypto", Networks: []client.CurrencyNetwork{
+					{Network: "bitcoin", Withdrawal: "Enabled"},
+					{Network: "xrpl", Withdrawal: "Disabled"},
+				}},
+				{Currency: "USDC", Decimals: 6, Type: "crypto", Networks: []client.CurrencyNetwork{
+					{Network: "ethereum", Withdrawal: "Enabled"},
+					{Network: "solana", Withdrawal: "Enabled"},
+				}},
+			},
+			currLastSync: time.Now(),
+		}
+
+		pi = models.PSPPaymentInitiation{
+			Reference:     "pi_1",
+			CreatedAt:     time.Now().UTC(),
+			Description:   "payout",
+			SourceAccount: &models.PSPAccount{Reference: "BTC"},
+			Amount:        big.NewInt(150000000),
+			Asset:         "BTC/8",
+			Metadata: map[string]string{
+				mappers.MetadataKeyDestinationAddress: "bc1qaddress",
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("create payout", func() {
+		It("should return an error - missing amount", func(ctx SpecContext) {
+			pi.Amount = nil
+			_, err := plg.CreatePayout(ctx, models.CreatePayoutRequest{PaymentInitiation: pi})
+			Expect(err).To(MatchError(models.ErrInvalidRequest))
+		})
+
+		It("should return an error - unsupported asset", func(ctx SpecContext) {
+			pi.Asset = "DOGE/8"
+			_, err := plg.CreatePayout(ctx, models.CreatePayoutRequest{PaymentInitiation: pi})
+			Expect(err).To(MatchError(models.ErrInvalidRequest))
+		})
+
+		It("should return an error - source account holds another currency", func(ctx SpecContext) {
+			pi.SourceAccount = &models.PSPAccount{Reference: "EUR"}
+			_, err := plg.CreatePayout(ctx, models.CreatePayoutRequest{PaymentInitiation: pi})
+			Expect(err).To(MatchError(models.ErrInvalidRequest))
+		})
+
+		It("should return an error - missing crypto destination address", func(ctx SpecContext) {
+			pi.Metadata = nil
+			_, err := plg.CreatePayout(ctx, models.CreatePayoutRequest{PaymentInitiation: pi})
+			Expect(err).To(MatchError("validation error occurred for field com.bitstamp.spec/destination_address: missing required field in request"))
+		})
+
+		It("should return an error - network with withdrawals disabled", func(ctx SpecContext) {
+			pi.Metadata[mappers.MetadataKeyNetwork] = "xrpl"
+			_, err := plg.CreatePayout(ctx, models.CreatePayoutRequest{PaymentInitiation: pi})
+			Expect(err).To(MatchError(models.ErrInvalidRequest))
+		})
+
+		It("should return an error - ambiguous network", func(ctx SpecContext) {
+			pi.SourceAccount = nil
+			pi.Asset = "USDC/6"
+			pi.Amount = big.NewInt(1000000)
+			_, err := plg.CreatePayout(ctx, models.CreatePayoutRequest{PaymentInitiation: pi})
+			Expect(err).To(MatchError(models.ErrInvalidRequest))
+			Expect(err.Error()).To(ContainSubstring("several networks"))
+		})
+
+		It("should return an error - withdrawal error", func(ctx SpecContext) {
+			m.EXPECT().CreateCryptoWithdrawal(gomock.Any(), gomock.Any()).Return(client.Withdrawal{}, errors.New("test error"))
+
+			_, err := plg.CreatePayout(ctx, models.CreatePayoutRequest{PaymentInitiation: pi})
+			Expect(err).To(MatchError("test error"))
+		})
+
+		It("should withdraw crypto on the only enabled network", func(ctx SpecContext) {
+			pi.Metadata[mappers.MetadataKeyDestinationTag] = "12345"
+			m.EXPECT().CreateCryptoWithdrawal(gomock.Any(), client.CryptoWithdrawalRequest{
+				Currency:       "BTC",
+				Amount:         "1.50000000",
+				Address:        "bc1qaddress",
+				Network:        "bitcoin",
+				DestinationTag: "12345",
+			}).Return(client.Withdrawal{ID: 42}, nil)
+
+			resp, err := plg.CreatePayout(ctx, models.CreatePayoutRequest{PaymentInitiation: pi})
+			Expect(err).To(BeNil())
+			Expect(resp.Payment).To(BeNil())
+			Expect(resp.PollingPayoutID).To(Equal(pointer.For("42")))
+		})
+
+		It("should withdraw crypto on the requested network", func(ctx SpecContext) {
+			pi.SourceAccount = nil
+			pi.Asset = "USDC/6"
+			pi.Amount = big.NewInt(1000000)
+			pi.Metadata[mappers.MetadataKeyNetwork] = "Solana"
+			m.EXPECT().CreateCryptoWithdrawal(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ any, req client.CryptoWithdrawalRequest) (client.Withdrawal, error) {
+					Expect(req.Network).To(Equal("solana"))
+					Expect(req.Amount).To(Equal("1.000000"))
+					return client.Withdrawal{ID: 43}, nil
+				},
+			)
+
+			resp, err := plg.CreatePayout(ctx, models.CreatePayoutRequest{PaymentInitiation: pi})
+			Expect(err).To(BeNil())
+			Expect(resp.PollingPayoutID).To(Equal(pointer.For("43")))
+		})
+
+		It("should return an error - fiat withdrawal without bank account details", func(ctx SpecContext) {
+			pi.SourceAccount = nil
+			pi.Asset = "EUR/2"
+			pi.Amount = big.NewInt(10000)
+			pi.DestinationAccount = &models.PSPAccount{Reference: "bank_account_1"}
+			_, err := plg.CreatePayout(ctx, models.CreatePayoutRequest{PaymentInitiation: pi})
+			Expect(err).To(MatchError(models.ErrMissingConnectorField))
+		})
+
+		It("should open a SEPA withdrawal to the destination bank account", func(ctx SpecContext) {
+			pi.SourceAccount = nil
+			pi.Asset = "EUR/2"
+			pi.Amount = big.NewInt(10000)
+			pi.DestinationAccount = &models.PSPAccount{
+				Reference: "bank_account_1",
+				Metadata: map[string]string{
+					models.AccountBankAccountNameMetadataKey:       "John Doe",
+					models.AccountIBANMetadataKey:                  "FR7630006000011234567890189",
+					models.AccountSwiftBicCodeMetadataKey:          "AGRIFRPP",
+					models.AccountBankAccountCountryMetadataKey:    "FR",
+					models.BankAccountOwnerCityMetadataKey:         "Paris",
+					models.BankAccountOwnerPostalCodeMetadataKey:   "75001",
+					models.BankAccountOwnerAddressLine1MetadataKey: "1 rue de Rivoli",
+				},
+			}
+			m.EXPECT().CreateFiatWithdrawal(gomock.Any(), client.FiatWithdrawalRequest{
+				Type:            "sepa",
+				Amount:          "100.00",
+				AccountCurrency: "EUR",
+				Name:            "John Doe",
+				IBAN:            "FR7630006000011234567890189",
+				BIC:             "AGRIFRPP",
+				Address:         "1 rue de Rivoli",
+				PostalCode:      "75001",
+				City:            "Paris",
+				Country:         "FR",
+				Comment:         "payout",
+			}).Return(client.Withdrawal{WithdrawalID: 7}, nil)
+
+			resp, err := plg.CreatePayout(ctx, models.CreatePayoutRequest{PaymentInitiation: pi})
+			Expect(err).To(BeNil())
+			Expect(resp.PollingPayoutID).To(Equal(pointer.For("7")))
+		})
+	})
+
+	Context("poll payout status", func() {
+		var withdrawalRequest client.WithdrawalRequest
+
+		BeforeEach(func() {
+			withdrawalRequest = client.WithdrawalRequest{
+				ID:       42,
+				Datetime: "2025-09-25 14:42:59",
+				Type:     4,
+				Currency: "BTC",
+				Network:  "bitcoin",
+				Amount:   "1.50000000",
+				Status:   mappers.WithdrawalRequestStatusFinished,
+				Address:  "bc1qaddress",
+				TxID:     "abcdef",
+			}
+		})
+
+		It("should return an error - invalid payout id", func(ctx SpecContext) {
+			_, err := plg.PollPayoutStatus(ctx, models.PollPayoutStatusRequest{PayoutID: "wr:42"})
+			Expect(err).To(MatchError(models.ErrInvalidRequest))
+		})
+
+		It("should return an error - client error", func(ctx SpecContext) {
+			m.EXPECT().GetWithdrawalRequest(gomock.Any(), int64(42)).Return(client.WithdrawalRequest{}, errors.New("test error"))
+
+			_, err := plg.PollPayoutStatus(ctx, models.PollPayoutStatusRequest{PayoutID: "42"})
+			Expect(err).To(MatchError("test error"))
+		})
+
+		It("should wait while the withdrawal request is not listed", func(ctx SpecContext) {
+			m.EXPECT().GetWithdrawalRequest(gomock.Any(), int64(42)).Return(client.WithdrawalRequest{}, &client.NotFoundError{})
+
+			resp, err := plg.PollPayoutStatus(ctx, models.PollPayoutStatusRequest{PayoutID: "42"})
+			Expect(err).To(BeNil())
+			Expect(resp.Payment).To(BeNil())
+			Expect(resp.Error).To(BeNil())
+		})
+
+		It("should wait while the withdrawal request is in progress", func(ctx SpecContext) {
+			withdrawalRequest.Status = mappers.WithdrawalRequestStatusInProgress
+			m.EXPECT().GetWithdrawalRequest(gomock.Any(), int64(42)).Return(withdrawalRequest, nil)
+
+			resp, err := plg.PollPayoutStatus(ctx, models.PollPayoutStatusRequest{PayoutID: "42"})
+			Expect(err).To(BeNil())
+			Expect(resp.Payment).To(BeNil())
+		})
+
+		It("should return the payment with its fee once the withdrawal request is finished", func(ctx SpecContext) {
+			m.EXPECT().GetWithdrawalRequest(gomock.Any(), int64(42)).Return(withdrawalRequest, nil)
+			m.EXPECT().GetWithdrawalFees(gomock.Any()).Return([]client.WithdrawalFee{
+				{Currency: "btc", Network: "xrpl", Fee: "0"},
+				{Currency: "btc", Network: "bitcoin", Fee: "0.00008"},
+			}, nil)
+
+			resp, err := plg.PollPayoutStatus(ctx, models.PollPayoutStatusRequest{PayoutID: "42"})
+			Expect(err).To(BeNil())
+			Expect(resp.Payment).ToNot(BeNil())
+			Expect(resp.Payment.Reference).To(Equal("wr:42"))
+			Expect(resp.Payment.Type).To(Equal(models.PAYMENT_TYPE_PAYOUT))
+			Expect(resp.Payment.Status).To(Equal(models.PAYMENT_STATUS_SUCCEEDED))
+			Expect(resp.Payment.Scheme).To(Equal(models.PAYMENT_SCHEME_OTHER))
+			Expect(resp.Payment.Amount).To(Equal(big.NewInt(150000000)))
+			Expect(resp.Payment.Asset).To(Equal("BTC/8"))
+			Expect(resp.Payment.SourceAccountReference).To(Equal(pointer.For("BTC")))
+			Expect(resp.Payment.Metadata).To(HaveKeyWithValue(mappers.MetadataKeyFee, "0.00008"))
+			Expect(resp.Payment.Metadata).To(HaveKeyWithValue(mappers.MetadataKeyNetwork, "bitcoin"))
+			Expect(resp.Payment.Metadata).To(HaveKeyWithValue(mappers.MetadataKeyTxID, "abcdef"))
+			Expect(resp.Payment.Metadata).To(HaveKeyWithValue(mappers.MetadataKeyWithdrawalID, "42"))
+			Expect(resp.Payment.Metadata).To(HaveKeyWithValue(mappers.MetadataKeySource, mappers.PaymentSourceWithdrawalRequests))
+		})
+
+		It("should return a failed payment when the withdrawal request failed", func(ctx SpecContext) {
+			withdrawalRequest = client.WithdrawalRequest{
+				ID:            7,
+				Datetime:      "2025-09-25 14:42:59",
+				Type:          0,
+				Currency:      "EUR",
+				Amount:        "100.00",
+				Status:        mappers.WithdrawalRequestStatusFailed,
+				TransactionID: "bank-tx",
+			}
+			m.EXPECT().GetWithdrawalRequest(gomock.Any(), int64(7)).Return(withdrawalRequest, nil)
+			m.EXPECT().GetWithdrawalFees(gomock.Any()).Return([]client.WithdrawalFee{
+				{Currency: "eur", Fee: "3.00"},
+			}, nil)
+
+			resp, err := plg.PollPayoutStatus(ctx, models.PollPayoutStatusRequest{PayoutID: "7"})
+			Expect(err).To(BeNil())
+			Expect(resp.Payment).ToNot(BeNil())
+			Expect(resp.Payment.Status).To(Equal(models.PAYMENT_STATUS_FAILED))
+			Expect(resp.Payment.Scheme).To(Equal(models.PAYMENT_SCHEME_SEPA_CREDIT))
+			Expect(resp.Payment.Amount).To(Equal(big.NewInt(10000)))
+			Expect(resp.Payment.Metadata).To(HaveKeyWithValue(mappers.MetadataKeyFee, "3.00"))
+			Expect(resp.Payment.Metadata).To(HaveKeyWithValue(mappers.MetadataKeyBankTransactionID, "bank-tx"))
+		})
+	})
+})
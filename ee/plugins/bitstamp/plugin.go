@@ -201,4 +201,18 @@ func (p *Plugin) FetchNextConversions(ctx context.Context, req models.FetchNextC
 	return p.fetchNextConversions(ctx, req)
 }
 
+func (p *Plugin) CreatePayout(ctx context.Context, req models.CreatePayoutRequest) (models.CreatePayoutResponse, error) {
+	if p.client == nil {
+		return models.CreatePayoutResponse{}, pkgplugins.ErrNotYetInstalled
+	}
+	return p.createPayout(ctx, req.PaymentInitiation)
+}
+
+func (p *Plugin) PollPayoutStatus(ctx context.Context, req models.PollPayoutStatusRequest) (models.PollPayoutStatusResponse, error) {
+	if p.client == nil {
+		return models.PollPayoutStatusResponse{}, pkgplugins.ErrNotYetInstalled
+	}
+	return p.pollPayoutStatus(ctx, req.PayoutID)
+}
+
 var _ models.Plugin = &Plugin{}
@@ -154,13 +154,14 @@ var _ = Describe("Bitstamp Plugin", func() {
 	})
 
 	Context("capabilities", func() {
-		It("declares fetch accounts, balances, payments, orders, conversions", func() {
+		It("declares fetch accounts, balances, payments, orders, conversions and create payout", func() {
 			Expect(capabilities).To(ContainElements(
 				models.CAPABILITY_FETCH_ACCOUNTS,
 				models.CAPABILITY_FETCH_BALANCES,
 				models.CAPABILITY_FETCH_PAYMENTS,
 				models.CAPABILITY_FETCH_ORDERS,
 				models.CAPABILITY_FETCH_CONVERSIONS,
+				models.CAPABILITY_CREATE_PAYOUT,
 			))
 		})
 	})
@@ -248,10 +249,10 @@ var _ = Describe("Bitstamp Plugin", func() {
 	})
 
 	Context("create payout", func() {
-		It("should fail because not implemented", func(ctx SpecContext) {
+		It("should fail when called before install", func(ctx SpecContext) {
 			req := models.CreatePayoutRequest{}
 			_, err := plg.CreatePayout(ctx, req)
-			Expect(err).To(MatchError(plugins.ErrNotImplemented))
+			Expect(err).To(MatchError(plugins.ErrNotYetInstalled))
 		})
 	})
 
@@ -264,10 +265,10 @@ var _ = Describe("Bitstamp Plugin", func() {
 	})
 
 	Context("poll payout status", func() {
-		It("should fail because not implemented", func(ctx SpecContext) {
+		It("should fail when called before install", func(ctx SpecContext) {
 			req := models.PollPayoutStatusRequest{}
 			_, err := plg.PollPayoutStatus(ctx, req)
-			Expect(err).To(MatchError(plugins.ErrNotImplemented))
+			Expect(err).To(MatchError(plugins.ErrNotYetInstalled))
 		})
 	})
 
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/formancehq/go-libs/v5/pkg/types/currency"
+	"github.com/formancehq/payments/ee/plugins/coinbaseprime/client"
 )
 
 // ensureAssetsFresh reloads the currencies/networkSymbols maps from
@@ -51,6 +52,17 @@ func (p *Plugin) getAssets(ctx context.Context) (map[string]int, map[string]stri
 	return p.currencies, p.networkSymbols, nil
 }
 
+// getAssetNetworks returns the networks the given base symbol can be
+// withdrawn on, from the same snapshot as getAssets.
+func (p *Plugin) getAssetNetworks(ctx context.Context, symbol string) ([]client.NetworkInfo, error) {
+	if err := p.ensureAssetsFresh(ctx); err != nil {
+		return nil, err
+	}
+	p.assetsMu.RLock()
+	defer p.assetsMu.RUnlock()
+	return p.assetNetworks[symbol], nil
+}
+
 // resolveAssetAndPrecision obtains a fresh snapshot of the asset cache
 // (refreshing via ensureAssetsFresh if the TTL has expired) and resolves the
 // given Coinbase Prime symbol to a Formance asset string and its precision.
@@ -8,4 +8,6 @@ var capabilities = []models.Capability{
 	models.CAPABILITY_FETCH_PAYMENTS,
 	models.CAPABILITY_FETCH_ORDERS,
 	models.CAPABILITY_FETCH_CONVERSIONS,
+
+	models.CAPABILITY_CREATE_PAYOUT,
 }
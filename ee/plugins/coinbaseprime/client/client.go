@@ -1,10 +1,12 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -24,6 +26,7 @@ type Client interface {
 	GetBalanceForWallet(ctx context.Context, walletID string) (*WalletBalanceResponse, error)
 	GetTransactions(ctx context.Context, cursor string, pageSize int, types ...string) (*TransactionsResponse, error)
 	ListOrders(ctx context.Context, cursor string, pageSize int) (*OrdersResponse, error)
+	CreateWithdrawal(ctx context.Context, walletID string, request CreateWithdrawalRequest) (*CreateWithdrawalResponse, error)
 }
 
 const defaultBaseURL = "https://api.prime.coinbase.com"
@@ -234,6 +237,36 @@ func (c *client) ListOrders(ctx context.Context, cursor string, pageSize int) (*
 	return &response, nil
 }
 
+func (c *client) CreateWithdrawal(ctx context.Context, walletID string, request CreateWithdrawalRequest) (*CreateWithdrawalResponse, error) {
+	if walletID == "" {
+		return nil, fmt.Errorf("missing wallet ID for withdrawal")
+	}
+	endpoint := fmt.Sprintf("%s/v1/portfolios/%s/wallets/%s/withdrawals", c.baseURL, c.portfolioID, url.PathEscape(walletID))
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal withdrawal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	if err := c.signRequest(req, string(body)); err != nil {
+		return nil, err
+	}
+
+	var response CreateWithdrawalResponse
+	var errorResponse ErrorResponse
+	statusCode, err := c.httpClient.Do(ctx, req, &response, &errorResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create withdrawal (status %d, message: %s): %w", statusCode, errorResponse.Message, err)
+	}
+
+	return &response, nil
+}
+
 func (c *client) buildPortfolioEndpoint(resource, cursor string, pageSize int, extra url.Values) (string, error) {
 	endpoint, err := url.Parse(fmt.Sprintf("%s/v1/portfolios/%s/%s", c.baseURL, c.portfolioID, resource))
 	if err != nil {
@@ -418,6 +451,52 @@ type OrdersResponse struct {
 	Pagination Pagination `json:"pagination"`
 }
 
+// Withdrawal destination types accepted by Coinbase Prime.
+const (
+	DestinationTypeBlockchain    = "DESTINATION_BLOCKCHAIN"
+	DestinationTypePaymentMethod = "DESTINATION_PAYMENT_METHOD"
+)
+
+// CreateWithdrawalRequest is the body of
+// POST /v1/portfolios/{portfolio_id}/wallets/{wallet_id}/withdrawals.
+// Exactly one of PaymentMethod (fiat) and BlockchainAddress (crypto) is
+// set, matching DestinationType.
+type CreateWithdrawalRequest struct {
+	Amount            string             `json:"amount"`
+	DestinationType   string             `json:"destination_type"`
+	IdempotencyKey    string             `json:"idempotency_key"`
+	CurrencySymbol    string             `json:"currency_symbol"`
+	PaymentMethod     *PaymentMethod     `json:"payment_method,omitempty"`
+	BlockchainAddress *BlockchainAddress `json:"blockchain_address,omitempty"`
+}
+
+// PaymentMethod references a bank account registered on the portfolio.
+type PaymentMethod struct {
+	PaymentMethodID string `json:"payment_method_id"`
+}
+
+// BlockchainAddress is an on-chain withdrawal destination.
+// AccountIdentifier carries the destination tag / memo where the network
+// uses one.
+type BlockchainAddress struct {
+	Address           string       `json:"address"`
+	AccountIdentifier string       `json:"account_identifier,omitempty"`
+	Network           *NetworkInfo `json:"network,omitempty"`
+}
+
+// CreateWithdrawalResponse is the withdrawal activity created by Coinbase
+// Prime. Withdrawals go through the portfolio approval flow before a
+// transaction is broadcast.
+type CreateWithdrawalResponse struct {
+	ActivityID      string `json:"activity_id"`
+	ApprovalURL     string `json:"approval_url"`
+	Symbol          string `json:"symbol"`
+	Amount          string `json:"amount"`
+	Fee             string `json:"fee"`
+	DestinationType string `json:"destination_type"`
+	SourceType      string `json:"source_type"`
+}
+
 // ErrorResponse represents an API error.
 type ErrorResponse struct {
 	Message string `json:"message"`
@@ -40,6 +40,21 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// CreateWithdrawal mocks base method.
+func (m *MockClient) CreateWithdrawal(ctx context.Context, walletID string, request CreateWithdrawalRequest) (*CreateWithdrawalResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWithdrawal", ctx, walletID, request)
+	ret0, _ := ret[0].(*CreateWithdrawalResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWithdrawal indicates an expected call of CreateWithdrawal.
+func (mr *MockClientMockRecorder) CreateWithdrawal(ctx, walletID, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithdrawal", reflect.TypeOf((*MockClient)(nil).CreateWithdrawal), ctx, walletID, request)
+}
+
 // GetAssets mocks base method.
 func (m *MockClient) GetAssets(ctx context.Context, entityID string) (*AssetsResponse, error) {
 	m.ctrl.T.Helper()
@@ -5,6 +5,7 @@ import (
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/base64"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -173,3 +174,49 @@ func TestPortfolioEndpointsEncodeCursor(t *testing.T) {
 	}
 }
 
+
+func TestCreateWithdrawalPostsSignedBody(t *testing.T) {
+	t.Parallel()
+
+	var capturedPath, capturedMethod string
+	var captured CreateWithdrawalRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		capturedPath = r.URL.Path
+		capturedMethod = r.Method
+		if err := json.NewDecoder(r.Body).Decode(&captured); err != nil {
+			t.Errorf("unexpected body: %v", err)
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"activity_id":"act-1","symbol":"BTC","amount":"0.5","fee":"0.0001"}`))
+	}))
+	defer server.Close()
+
+	c := NewWithBaseURL("coinbaseprime", "api-key", "secret", "passphrase", "portfolio-123", server.URL)
+
+	resp, err := c.CreateWithdrawal(context.Background(), "wallet-1", CreateWithdrawalRequest{
+		Amount:          "0.5",
+		DestinationType: DestinationTypeBlockchain,
+		IdempotencyKey:  "key-1",
+		CurrencySymbol:  "BTC",
+		BlockchainAddress: &BlockchainAddress{
+			Address: "bc1qaddress",
+			Network: &NetworkInfo{ID: "bitcoin", Type: "mainnet"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if capturedMethod != http.MethodPost {
+		t.Fatalf("expected POST, got %s", capturedMethod)
+	}
+	if capturedPath != "/v1/portfolios/portfolio-123/wallets/wallet-1/withdrawals" {
+		t.Fatalf("unexpected path %q", capturedPath)
+	}
+	if captured.BlockchainAddress == nil || captured.BlockchainAddress.Network.ID != "bitcoin" || captured.PaymentMethod != nil {
+		t.Fatalf("unexpected request body: %+v", captured)
+	}
+	if resp.ActivityID != "act-1" || resp.Fee != "0.0001" {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+}
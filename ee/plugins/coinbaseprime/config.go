@@ -9,9 +9,9 @@ import (
 )
 
 type Config struct {
-	APIKey      string `json:"apiKey" validate:"required"`
-	APISecret   string `json:"apiSecret" validate:"required"`
-	Passphrase  string `json:"passphrase" validate:"required"`
+	APIKey      string `json:"apiKey" validate:"required" sensitive:"true"`
+	APISecret   string `json:"apiSecret" validate:"required" sensitive:"true"`
+	Passphrase  string `json:"passphrase" validate:"required" sensitive:"true"`
 	PortfolioID string `json:"portfolioId" validate:"required"`
 }
 
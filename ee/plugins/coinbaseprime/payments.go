@@ -91,6 +91,11 @@ func (p *Plugin) transactionToPayment(ctx context.Context, tx client.Transaction
 
 	metadata := buildTransactionMetadata(tx)
 
+	fees, err := p.transactionFees(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
 	payment := models.PSPPayment{
 		Reference: tx.ID,
 		CreatedAt: tx.CreatedAt,
@@ -99,6 +104,7 @@ func (p *Plugin) transactionToPayment(ctx context.Context, tx client.Transaction
 		Asset:     asset,
 		Scheme:    models.PAYMENT_SCHEME_OTHER,
 		Status:    status,
+		Fees:      fees,
 		Metadata:  metadata,
 		Raw:       raw,
 	}
@@ -110,6 +116,59 @@ func (p *Plugin) transactionToPayment(ctx context.Context, tx client.Transaction
 	return &payment, nil
 }
 
+// transactionFees maps the Prime fees and the network fees of a
+// transaction. Both are charged in the fee symbol, which defaults to the
+// transaction symbol.
+func (p *Plugin) transactionFees(ctx context.Context, tx client.Transaction) ([]models.PaymentFee, error) {
+	hasFees := tx.Fees != "" && tx.Fees != "0"
+	hasNetworkFees := tx.NetworkFees != "" && tx.NetworkFees != "0"
+	if !hasFees && !hasNetworkFees {
+		return nil, nil
+	}
+
+	feeSymbol := tx.FeeSymbol
+	if feeSymbol == "" {
+		feeSymbol = tx.Symbol
+	}
+	asset, precision, ok, err := p.resolveAssetAndPrecision(ctx, feeSymbol)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		p.logger.Infof("skipping fees of transaction %s: unsupported currency %q", tx.ID, feeSymbol)
+		return nil, nil
+	}
+
+	var fees []models.PaymentFee
+	appendFee := func(feeType models.PaymentFeeType, value string) error {
+		amount, err := currency.GetAmountWithPrecisionFromString(strings.TrimPrefix(value, "-"), precision)
+		if err != nil {
+			return fmt.Errorf("failed to parse fee amount: %w", err)
+		}
+		if amount.Sign() == 0 {
+			return nil
+		}
+		fees = append(fees, models.PaymentFee{
+			Type:   feeType,
+			Amount: amount,
+			Asset:  asset,
+		})
+		return nil
+	}
+
+	if hasFees {
+		if err := appendFee(models.PAYMENT_FEE_TYPE_PROCESSING, tx.Fees); err != nil {
+			return nil, err
+		}
+	}
+	if hasNetworkFees {
+		if err := appendFee(models.PAYMENT_FEE_TYPE_NETWORK, tx.NetworkFees); err != nil {
+			return nil, err
+		}
+	}
+	return fees, nil
+}
+
 func buildTransactionMetadata(tx client.Transaction) map[string]string {
 	metadata := make(map[string]string)
 	set := func(k, v string) {
@@ -732,6 +732,7 @@ var _ = Describe("Coinbase Plugin Payments", func() {
 			Expect(md).ToNot(HaveKey(MetadataPrefix + "fee_symbol"))
 			Expect(md).ToNot(HaveKey(MetadataPrefix + "fees"))
 			Expect(md).ToNot(HaveKey(MetadataPrefix + "network_fees"))
+			Expect(resp.Payments[0].Fees).To(BeNil())
 		})
 
 		It("should include fee_symbol when fees is greater than zero", func(ctx SpecContext) {
@@ -768,6 +769,9 @@ var _ = Describe("Coinbase Plugin Payments", func() {
 			md := resp.Payments[0].Metadata
 			Expect(md[MetadataPrefix+"fee_symbol"]).To(Equal("BTC"))
 			Expect(md[MetadataPrefix+"fees"]).To(Equal("0.001"))
+			Expect(resp.Payments[0].Fees).To(Equal([]models.PaymentFee{
+				{Type: models.PAYMENT_FEE_TYPE_PROCESSING, Amount: big.NewInt(100000), Asset: "BTC/8"},
+			}))
 		})
 
 		It("should include fee_symbol when network_fees is greater than zero", func(ctx SpecContext) {
@@ -804,6 +808,9 @@ var _ = Describe("Coinbase Plugin Payments", func() {
 			md := resp.Payments[0].Metadata
 			Expect(md[MetadataPrefix+"fee_symbol"]).To(Equal("ETH"))
 			Expect(md[MetadataPrefix+"network_fees"]).To(Equal("0.0005"))
+			Expect(resp.Payments[0].Fees).To(Equal([]models.PaymentFee{
+				{Type: models.PAYMENT_FEE_TYPE_NETWORK, Amount: big.NewInt(500000000000000), Asset: "ETH/18"},
+			}))
 		})
 
 		It("should include fee_symbol when either fees or network_fees is greater than zero", func(ctx SpecContext) {
@@ -841,6 +848,10 @@ var _ = Describe("Coinbase Plugin Payments", func() {
 			Expect(md[MetadataPrefix+"fee_symbol"]).To(Equal("USDC"))
 			Expect(md[MetadataPrefix+"fees"]).To(Equal("0.5"))
 			Expect(md[MetadataPrefix+"network_fees"]).To(Equal("0.1"))
+			Expect(resp.Payments[0].Fees).To(Equal([]models.PaymentFee{
+				{Type: models.PAYMENT_FEE_TYPE_PROCESSING, Amount: big.NewInt(500000), Asset: "USDC/6"},
+				{Type: models.PAYMENT_FEE_TYPE_NETWORK, Amount: big.NewInt(100000), Asset: "USDC/6"},
+			}))
 		})
 	})
 })
@@ -0,0 +1,200 @@
+package coinbaseprime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/types/currency"
+	"github.com/formancehq/payments/ee/plugins/coinbaseprime/client"
+	errorsutils "github.com/formancehq/payments/pkg/domain/errors"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+)
+
+// PaymentInitiation metadata keys read by CreatePayout. Crypto withdrawals
+// need a destination address and, for assets available on several
+// networks, the network ID; fiat withdrawals go to a payment method
+// registered on the portfolio.
+const (
+	MetadataKeyDestinationAddress = MetadataPrefix + "destination_address"
+	MetadataKeyAccountIdentifier  = MetadataPrefix + "account_identifier"
+	MetadataKeyNetwork            = MetadataPrefix + "network"
+	MetadataKeyPaymentMethodID    = MetadataPrefix + "payment_method_id"
+)
+
+// idempotencyNamespace seeds the withdrawal idempotency keys so a retried
+// CreatePayout for the same PaymentInitiation never withdraws twice.
+var idempotencyNamespace = uuid.MustParse("7b0f6c52-0d3e-4b8e-9b5f-2f1c8a6e4d10")
+
+// createPayout creates a withdrawal from the source wallet. Coinbase Prime
+// withdrawals go through the portfolio consensus/approval flow, so the
+// returned payment is PENDING and the settled transaction surfaces later
+// through fetch_payments.
+func (p *Plugin) createPayout(ctx context.Context, pi models.PSPPaymentInitiation) (models.CreatePayoutResponse, error) {
+	if err := validatePayoutRequest(pi); err != nil {
+		return models.CreatePayoutResponse{}, err
+	}
+
+	currencies, _, err := p.getAssets(ctx)
+	if err != nil {
+		return models.CreatePayoutResponse{}, err
+	}
+
+	symbol, precision, err := currency.GetCurrencyAndPrecisionFromAsset(currencies, pi.Asset)
+	if err != nil {
+		return models.CreatePayoutResponse{}, errorsutils.NewWrappedError(
+			fmt.Errorf("failed to get currency and precision from asset: %w", err),
+			models.ErrInvalidRequest,
+		)
+	}
+
+	amount, err := currency.GetStringAmountFromBigIntWithPrecision(pi.Amount, precision)
+	if err != nil {
+		return models.CreatePayoutResponse{}, err
+	}
+
+	request := client.CreateWithdrawalRequest{
+		Amount:         amount,
+		IdempotencyKey: uuid.NewSHA1(idempotencyNamespace, []byte(pi.Reference)).String(),
+		CurrencySymbol: symbol,
+	}
+
+	if paymentMethodID := payoutMetadata(pi, MetadataKeyPaymentMethodID); paymentMethodID != "" {
+		request.DestinationType = client.DestinationTypePaymentMethod
+		request.PaymentMethod = &client.PaymentMethod{PaymentMethodID: paymentMethodID}
+	} else {
+		address := payoutMetadata(pi, MetadataKeyDestinationAddress)
+		if address == "" {
+			return models.CreatePayoutResponse{}, models.NewConnectorValidationError(MetadataKeyDestinationAddress, models.ErrMissingConnectorField)
+		}
+
+		networks, err := p.getAssetNetworks(ctx, symbol)
+		if err != nil {
+			return models.CreatePayoutResponse{}, err
+		}
+		network, err := selectWithdrawalNetwork(networks, symbol, payoutMetadata(pi, MetadataKeyNetwork))
+		if err != nil {
+			return models.CreatePayoutResponse{}, err
+		}
+
+		request.DestinationType = client.DestinationTypeBlockchain
+		request.BlockchainAddress = &client.BlockchainAddress{
+			Address:           address,
+			AccountIdentifier: payoutMetadata(pi, MetadataKeyAccountIdentifier),
+			Network:           network,
+		}
+	}
+
+	withdrawal, err := p.client.CreateWithdrawal(ctx, pi.SourceAccount.Reference, request)
+	if err != nil {
+		return models.CreatePayoutResponse{}, err
+	}
+
+	raw, err := json.Marshal(withdrawal)
+	if err != nil {
+		return models.CreatePayoutResponse{}, err
+	}
+
+	metadata := make(map[string]string)
+	set := func(k, v string) {
+		if v != "" {
+			metadata[MetadataPrefix+k] = v
+		}
+	}
+	set("wallet_id", pi.SourceAccount.Reference)
+	set("activity_id", withdrawal.ActivityID)
+	set("approval_url", withdrawal.ApprovalURL)
+	set("destination_type", request.DestinationType)
+	if withdrawal.Fee != "" && withdrawal.Fee != "0" {
+		set("fees", withdrawal.Fee)
+		set("fee_symbol", symbol)
+	}
+	if request.BlockchainAddress != nil {
+		set("destination_address", request.BlockchainAddress.Address)
+		set("account_identifier", request.BlockchainAddress.AccountIdentifier)
+		if request.BlockchainAddress.Network != nil {
+			set("network", request.BlockchainAddress.Network.ID)
+		}
+	}
+	if request.PaymentMethod != nil {
+		set("payment_method_id", request.PaymentMethod.PaymentMethodID)
+	}
+
+	payment := models.PSPPayment{
+		Reference:              withdrawal.ActivityID,
+		CreatedAt:              time.Now().UTC(),
+		Type:                   models.PAYMENT_TYPE_PAYOUT,
+		Amount:                 pi.Amount,
+		Asset:                  pi.Asset,
+		Scheme:                 models.PAYMENT_SCHEME_OTHER,
+		Status:                 models.PAYMENT_STATUS_PENDING,
+		SourceAccountReference: &pi.SourceAccount.Reference,
+		Metadata:               metadata,
+		Raw:                    raw,
+	}
+	if pi.DestinationAccount != nil {
+		payment.DestinationAccountReference = &pi.DestinationAccount.Reference
+	}
+
+	return models.CreatePayoutResponse{Payment: &payment}, nil
+}
+
+// selectWithdrawalNetwork matches the requested network ID against the
+// networks the asset is listed on. Without a request, an asset listed on a
+// single network defaults to it and the API picks the default network for
+// assets with no network listing at all.
+func selectWithdrawalNetwork(networks []client.NetworkInfo, symbol, requested string) (*client.NetworkInfo, error) {
+	if requested == "" {
+		switch len(networks) {
+		case 0:
+			return nil, nil
+		case 1:
+			network := networks[0]
+			return &network, nil
+		default:
+			return nil, errorsutils.NewWrappedError(
+				fmt.Errorf("%s is withdrawable on several networks, %s is required", symbol, MetadataKeyNetwork),
+				models.ErrInvalidRequest,
+			)
+		}
+	}
+
+	for _, network := range networks {
+		if strings.EqualFold(network.ID, requested) {
+			return &network, nil
+		}
+	}
+	return nil, errorsutils.NewWrappedError(
+		fmt.Errorf("network %s is not available for %s", requested, symbol),
+		models.ErrInvalidRequest,
+	)
+}
+
+// payoutMetadata reads a withdrawal parameter from the PaymentInitiation
+// metadata, falling back to the destination account metadata so an address
+// or payment method can be stored once on an account.
+func payoutMetadata(pi models.PSPPaymentInitiation, key string) string {
+	if value := strings.TrimSpace(models.ExtractNamespacedMetadata(pi.Metadata, key)); value != "" {
+		return value
+	}
+	if pi.DestinationAccount != nil {
+		return strings.TrimSpace(models.ExtractNamespacedMetadata(pi.DestinationAccount.Metadata, key))
+	}
+	return ""
+}
+
+func validatePayoutRequest(pi models.PSPPaymentInitiation) error {
+	if pi.SourceAccount == nil {
+		return models.NewConnectorValidationError("sourceAccount", models.ErrMissingConnectorField)
+	}
+	if pi.Amount == nil || pi.Amount.Sign() <= 0 {
+		return models.NewConnectorValidationError("amount", models.ErrInvalidRequest)
+	}
+	if pi.Asset == "" {
+		return models.NewConnectorValidationError("asset", models.ErrMissingConnectorField)
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package coinbaseprime
+
+import (
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/observe/log"
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/payments/ee/plugins/coinbaseprime/client"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/formancehq/payments/pkg/domain/plugins"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Coinbase Plugin Payouts", func() {
+	var (
+		ctrl *gomock.Controller
+		m    *client.MockClient
+		plg  *Plugin
+		pi   models.PSPPaymentInitiation
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		m = client.NewMockClient(ctrl)
+		plg = &Plugin{
+			Plugin: plugins.NewBasePlugin(),
+			client: m,
+			logger: logging.NewDefaultLogger(GinkgoWriter, true, false, false),
+			currencies: map[string]int{
+				"USD":  2,
+				"BTC":  8,
+				"USDC": 6,
+			},
+			assetNetworks: map[string][]client.NetworkInfo{
+				"BTC":  {{ID: "bitcoin", Type: "mainnet"}},
+				"USDC": {{ID: "ethereum", Type: "mainnet"}, {ID: "base", Type: "mainnet"}},
+			},
+			assetsLastSync: time.Now(),
+		}
+
+		pi = models.PSPPaymentInitiation{
+			Reference:     "pi_1",
+			CreatedAt:     time.Now().UTC(),
+			SourceAccount: &models.PSPAccount{Reference: "wallet-btc"},
+			Amount:        big.NewInt(50_000_000),
+			Asset:         "BTC/8",
+			Metadata: map[string]string{
+				MetadataKeyDestinationAddress: "bc1qaddress",
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("create payout", func() {
+		It("should return an error - missing source account", func(ctx SpecContext) {
+			pi.SourceAccount = nil
+			_, err := plg.CreatePayout(ctx, models.CreatePayoutRequest{PaymentInitiation: pi})
+			Expect(err).To(MatchError("validation error occurred for field sourceAccount: missing required field in request"))
+		})
+
+		It("should return an error - unsupported asset", func(ctx SpecContext) {
+			pi.Asset = "DOGE/8"
+			_, err := plg.CreatePayout(ctx, models.CreatePayoutRequest{PaymentInitiation: pi})
+			Expect(err).To(MatchError(models.ErrInvalidRequest))
+		})
+
+		It("should return an error - missing destination", func(ctx SpecContext) {
+			pi.Metadata = nil
+			_, err := plg.CreatePayout(ctx, models.CreatePayoutRequest{PaymentInitiation: pi})
+			Expect(err).To(MatchError(models.ErrMissingConnectorField))
+		})
+
+		It("should return an error - ambiguous network", func(ctx SpecContext) {
+			pi.Asset = "USDC/6"
+			_, err := plg.CreatePayout(ctx, models.CreatePayoutRequest{PaymentInitiation: pi})
+			Expect(err).To(MatchError(models.ErrInvalidRequest))
+			Expect(err.Error()).To(ContainSubstring("several networks"))
+		})
+
+		It("should return an error - unknown network", func(ctx SpecContext) {
+			pi.Metadata[MetadataKeyNetwork] = "solana"
+			_, err := plg.CreatePayout(ctx, models.CreatePayoutRequest{PaymentInitiation: pi})
+			Expect(err).To(MatchError(models.ErrInvalidRequest))
+		})
+
+		It("should return an error - withdrawal error", func(ctx SpecContext) {
+			m.EXPECT().CreateWithdrawal(gomock.Any(), "wallet-btc", gomock.Any()).Return(nil, errors.New("test error"))
+
+			_, err := plg.CreatePayout(ctx, models.CreatePayoutRequest{PaymentInitiation: pi})
+			Expect(err).To(MatchError("test error"))
+		})
+
+		It("should withdraw crypto with a stable idempotency key and report the fee", func(ctx SpecContext) {
+			var keys []string
+			m.EXPECT().CreateWithdrawal(gomock.Any(), "wallet-btc", gomock.Any()).Times(2).DoAndReturn(
+				func(_ any, _ string, req client.CreateWithdrawalRequest) (*client.CreateWithdrawalResponse, error) {
+					Expect(req.Amount).To(Equal("0.50000000"))
+					Expect(req.CurrencySymbol).To(Equal("BTC"))
+					Expect(req.DestinationType).To(Equal(client.DestinationTypeBlockchain))
+					Expect(req.PaymentMethod).To(BeNil())
+					Expect(req.BlockchainAddress).To(Equal(&client.BlockchainAddress{
+						Address: "bc1qaddress",
+						Network: &client.NetworkInfo{ID: "bitcoin", Type: "mainnet"},
+					}))
+					keys = append(keys, req.IdempotencyKey)
+					return &client.CreateWithdrawalResponse{ActivityID: "act-1", Fee: "0.0001"}, nil
+				},
+			)
+
+			resp, err := plg.CreatePayout(ctx, models.CreatePayoutRequest{PaymentInitiation: pi})
+			Expect(err).To(BeNil())
+			_, err = plg.CreatePayout(ctx, models.CreatePayoutRequest{PaymentInitiation: pi})
+			Expect(err).To(BeNil())
+			Expect(keys[0]).ToNot(BeEmpty())
+			Expect(keys[0]).To(Equal(keys[1]))
+
+			Expect(resp.PollingPayoutID).To(BeNil())
+			Expect(resp.Payment).ToNot(BeNil())
+			Expect(resp.Payment.Reference).To(Equal("act-1"))
+			Expect(resp.Payment.Type).To(Equal(models.PAYMENT_TYPE_PAYOUT))
+			Expect(resp.Payment.Status).To(Equal(models.PAYMENT_STATUS_PENDING))
+			Expect(resp.Payment.Amount).To(Equal(big.NewInt(50_000_000)))
+			Expect(resp.Payment.SourceAccountReference).To(Equal(pointer.For("wallet-btc")))
+			Expect(resp.Payment.Metadata).To(HaveKeyWithValue(MetadataPrefix+"fees", "0.0001"))
+			Expect(resp.Payment.Metadata).To(HaveKeyWithValue(MetadataKeyNetwork, "bitcoin"))
+		})
+
+		It("should withdraw fiat to a payment method", func(ctx SpecContext) {
+			pi.SourceAccount = &models.PSPAccount{Reference: "wallet-usd"}
+			pi.Asset = "USD/2"
+			pi.Amount = big.NewInt(10000)
+			pi.Metadata = map[string]string{MetadataKeyPaymentMethodID: "pm-1"}
+			m.EXPECT().CreateWithdrawal(gomock.Any(), "wallet-usd", gomock.Any()).DoAndReturn(
+				func(_ any, _ string, req client.CreateWithdrawalRequest) (*client.CreateWithdrawalResponse, error) {
+					Expect(req.Amount).To(Equal("100.00"))
+					Expect(req.DestinationType).To(Equal(client.DestinationTypePaymentMethod))
+					Expect(req.PaymentMethod).To(Equal(&client.PaymentMethod{PaymentMethodID: "pm-1"}))
+					Expect(req.BlockchainAddress).To(BeNil())
+					return &client.CreateWithdrawalResponse{ActivityID: "act-2"}, nil
+				},
+			)
+
+			resp, err := plg.CreatePayout(ctx, models.CreatePayoutRequest{PaymentInitiation: pi})
+			Expect(err).To(BeNil())
+			Expect(resp.Payment.Reference).To(Equal("act-2"))
+			Expect(resp.Payment.Metadata).To(HaveKeyWithValue(MetadataKeyPaymentMethodID, "pm-1"))
+			Expect(resp.Payment.Metadata).ToNot(HaveKey(MetadataPrefix + "fees"))
+		})
+	})
+})
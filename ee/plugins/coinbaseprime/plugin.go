@@ -44,7 +44,7 @@ type Plugin struct {
 	config Config
 
 	// assetsMu protects concurrent reads/writes of the cached reference data
-	// below (currencies, networkSymbols, entityID, assetsLastSync). Reads
+	// below (currencies, networkSymbols, assetNetworks, entityID, assetsLastSync). Reads
 	// dominate, so a RWMutex is used.
 	assetsMu sync.RWMutex
 	// assetsRefreshMu serializes the refresh path so only one goroutine at a
@@ -53,9 +53,10 @@ type Plugin struct {
 	assetsRefreshMu sync.Mutex
 	assetsLastSync  time.Time
 
-	entityID       string            // portfolio entity ID, fetched once at Install
-	currencies     map[string]int    // symbol → decimal precision (loaded dynamically)
-	networkSymbols map[string]string // network-scoped symbol → base symbol (e.g. "BASEUSDC" → "USDC")
+	entityID       string                          // portfolio entity ID, fetched once at Install
+	currencies     map[string]int                  // symbol → decimal precision (loaded dynamically)
+	networkSymbols map[string]string               // network-scoped symbol → base symbol (e.g. "BASEUSDC" → "USDC")
+	assetNetworks  map[string][]client.NetworkInfo // base symbol → networks it can be withdrawn on
 }
 
 func New(name string, logger logging.Logger, rawConfig json.RawMessage) (*Plugin, error) {
@@ -121,6 +122,7 @@ func (p *Plugin) loadAssets(ctx context.Context) error {
 
 	currencies := make(map[string]int, len(assets.Assets)+len(fiatCurrenciesFallback))
 	networkSymbols := make(map[string]string)
+	assetNetworks := make(map[string][]client.NetworkInfo)
 
 	// Start with fiat fallback
 	for k, v := range fiatCurrenciesFallback {
@@ -148,12 +150,16 @@ func (p *Plugin) loadAssets(ctx context.Context) error {
 			if ns != "" && ns != symbol {
 				networkSymbols[ns] = symbol
 			}
+			if net.Network.ID != "" {
+				assetNetworks[symbol] = append(assetNetworks[symbol], net.Network)
+			}
 		}
 	}
 
 	p.assetsMu.Lock()
 	p.currencies = currencies
 	p.networkSymbols = networkSymbols
+	p.assetNetworks = assetNetworks
 	p.assetsLastSync = time.Now()
 	p.assetsMu.Unlock()
 	return nil
@@ -228,4 +234,11 @@ func (p *Plugin) FetchNextConversions(ctx context.Context, req models.FetchNextC
 	return p.fetchNextConversions(ctx, req)
 }
 
+func (p *Plugin) CreatePayout(ctx context.Context, req models.CreatePayoutRequest) (models.CreatePayoutResponse, error) {
+	if p.client == nil {
+		return models.CreatePayoutResponse{}, pkgplugins.ErrNotYetInstalled
+	}
+	return p.createPayout(ctx, req.PaymentInitiation)
+}
+
 var _ models.Plugin = &Plugin{}
@@ -214,10 +214,10 @@ var _ = Describe("Coinbase Plugin", func() {
 	})
 
 	Context("create payout", func() {
-		It("should fail because not implemented", func(ctx SpecContext) {
+		It("should fail when called before Install", func(ctx SpecContext) {
 			req := models.CreatePayoutRequest{}
 			_, err := plg.CreatePayout(ctx, req)
-			Expect(err).To(MatchError(plugins.ErrNotImplemented))
+			Expect(err).To(MatchError(plugins.ErrNotYetInstalled))
 		})
 	})
 
@@ -7,22 +7,22 @@ import (
 	"encoding/pem"
 	"fmt"
 
-	"github.com/formancehq/payments/pkg/domain/models"
 	errorsutils "github.com/formancehq/payments/pkg/domain/errors"
+	"github.com/formancehq/payments/pkg/domain/models"
 	"github.com/go-playground/validator/v10"
 	"github.com/pkg/errors"
 )
 
 type Config struct {
-	APIKey     string `json:"apiKey" validate:"required"`
-	PrivateKey string `json:"privateKey" validate:"required"`
+	APIKey     string `json:"apiKey" validate:"required" sensitive:"true"`
+	PrivateKey string `json:"privateKey" validate:"required" sensitive:"true"`
 	Endpoint   string `json:"endpoint"`
 
 	privateKey *rsa.PrivateKey `json:"-"`
 }
 
 const (
-	PAGE_SIZE      = 200
+	PAGE_SIZE       = 200
 	DefaultEndpoint = "https://api.fireblocks.io"
 )
 
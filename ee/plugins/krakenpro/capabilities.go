@@ -2,13 +2,15 @@ package krakenpro
 
 import "github.com/formancehq/payments/pkg/domain/models"
 
-// Read-only crypto-exchange capability set per EN-1014.
-// Transfers / payouts / bank accounts / webhooks are intentionally
-// omitted — this connector is read-only by design.
+// Crypto-exchange capability set per EN-1014, plus payouts through
+// Kraken withdrawals to whitelisted keys. Transfers / bank accounts /
+// webhooks are intentionally omitted.
 var capabilities = []models.Capability{
 	models.CAPABILITY_FETCH_ACCOUNTS,
 	models.CAPABILITY_FETCH_BALANCES,
 	models.CAPABILITY_FETCH_PAYMENTS,
 	models.CAPABILITY_FETCH_ORDERS,
 	models.CAPABILITY_FETCH_CONVERSIONS,
+
+	models.CAPABILITY_CREATE_PAYOUT,
 }
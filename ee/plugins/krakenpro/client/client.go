@@ -23,8 +23,8 @@ import (
 
 //go:generate mockgen -source client.go -destination client_generated.go -package client . Client
 
-// Client is the read-only Kraken Pro REST surface used by the connector.
-// Each method maps 1:1 to a Kraken endpoint. The implementation handles
+// Client is the Kraken Pro REST surface used by the connector. Each
+// method maps 1:1 to a Kraken endpoint. The implementation handles
 // HMAC-SHA512 signing for /private/* paths.
 type Client interface {
 	GetAssets(ctx context.Context) (map[string]AssetInfo, error)
@@ -32,6 +32,10 @@ type Client interface {
 	GetBalanceEx(ctx context.Context) (map[string]BalanceExEntry, error)
 	GetLedgers(ctx context.Context, params LedgersParams) (LedgersResponse, error)
 	GetClosedOrders(ctx context.Context, params ClosedOrdersParams) (ClosedOrdersResponse, error)
+
+	// Withdrawal surface backing CreatePayout (MAPPINGS §10).
+	GetWithdrawInfo(ctx context.Context, params WithdrawParams) (WithdrawInfo, error)
+	Withdraw(ctx context.Context, params WithdrawParams) (WithdrawResponse, error)
 }
 
 // LedgersParams filters /0/private/Ledgers. Pagination is a frozen
@@ -55,6 +59,19 @@ type ClosedOrdersParams struct {
 	WithoutCount bool
 }
 
+// WithdrawParams addresses /0/private/WithdrawInfo and /0/private/Withdraw.
+// Key is the name of a withdrawal destination whitelisted on the Kraken
+// account; Address, when set, makes Kraken verify it matches the key.
+// MaxFee (Withdraw only) aborts the withdrawal if the fee rose above the
+// quoted one.
+type WithdrawParams struct {
+	Asset   string
+	Key     string
+	Amount  string
+	Address string
+	MaxFee  string
+}
+
 // ClosetimeClose selects the close timestamp for ClosedOrders Start/End
 // filtering, so a newly-closed order with an ancient open time still
 // falls inside the current window.
@@ -294,3 +311,42 @@ func (c *client) GetClosedOrders(ctx context.Context, p ClosedOrdersParams) (Clo
 	}
 	return out, nil
 }
+
+// GetWithdrawInfo fetches /0/private/WithdrawInfo: the method (network),
+// limit and fee Kraken would apply to the withdrawal.
+func (c *client) GetWithdrawInfo(ctx context.Context, p WithdrawParams) (WithdrawInfo, error) {
+	params := map[string]any{
+		"asset":  p.Asset,
+		"key":    p.Key,
+		"amount": p.Amount,
+	}
+	var out WithdrawInfo
+	if err := c.do(ctx, http.MethodPost, "/0/private/WithdrawInfo", params, &out); err != nil {
+		return WithdrawInfo{}, fmt.Errorf("get withdraw info: %w", err)
+	}
+	return out, nil
+}
+
+// Withdraw calls /0/private/Withdraw and returns the reference id of the
+// withdrawal, which is also the refid of the ledger rows it produces.
+func (c *client) Withdraw(ctx context.Context, p WithdrawParams) (WithdrawResponse, error) {
+	params := map[string]any{
+		"asset":  p.Asset,
+		"key":    p.Key,
+		"amount": p.Amount,
+	}
+	if p.Address != "" {
+		params["address"] = p.Address
+	}
+	if p.MaxFee != "" {
+		params["max_fee"] = p.MaxFee
+	}
+	var out WithdrawResponse
+	if err := c.do(ctx, http.MethodPost, "/0/private/Withdraw", params, &out); err != nil {
+		return WithdrawResponse{}, fmt.Errorf("withdraw: %w", err)
+	}
+	if out.Refid == "" {
+		return WithdrawResponse{}, fmt.Errorf("withdraw: missing refid in response")
+	}
+	return out, nil
+}
@@ -114,3 +114,33 @@ func (mr *MockClientMockRecorder) GetLedgers(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLedgers", reflect.TypeOf((*MockClient)(nil).GetLedgers), ctx, params)
 }
+
+// GetWithdrawInfo mocks base method.
+func (m *MockClient) GetWithdrawInfo(ctx context.Context, params WithdrawParams) (WithdrawInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithdrawInfo", ctx, params)
+	ret0, _ := ret[0].(WithdrawInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWithdrawInfo indicates an expected call of GetWithdrawInfo.
+func (mr *MockClientMockRecorder) GetWithdrawInfo(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithdrawInfo", reflect.TypeOf((*MockClient)(nil).GetWithdrawInfo), ctx, params)
+}
+
+// Withdraw mocks base method.
+func (m *MockClient) Withdraw(ctx context.Context, params WithdrawParams) (WithdrawResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Withdraw", ctx, params)
+	ret0, _ := ret[0].(WithdrawResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Withdraw indicates an expected call of Withdraw.
+func (mr *MockClientMockRecorder) Withdraw(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Withdraw", reflect.TypeOf((*MockClient)(nil).Withdraw), ctx, params)
+}
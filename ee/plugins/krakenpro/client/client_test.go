@@ -286,6 +286,32 @@ func TestGetLedgersPassesEndAndType(t *testing.T) {
 	}
 }
 
+func TestWithdrawPassesParamsAndDecodesRefid(t *testing.T) {
+	t.Parallel()
+	_, c := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/0/private/Withdraw" {
+			t.Errorf("path=%s", r.URL.Path)
+		}
+		body, _ := io.ReadAll(r.Body)
+		var got map[string]any
+		_ = json.Unmarshal(body, &got)
+		if got["asset"] != "XXBT" || got["key"] != "cold-wallet" || got["amount"] != "0.25" || got["max_fee"] != "0.00015" {
+			t.Errorf("unexpected body %v", got)
+		}
+		if _, ok := got["address"]; ok {
+			t.Errorf("empty address must be omitted, got %v", got["address"])
+		}
+		_, _ = io.WriteString(w, `{"error":[],"result":{"refid":"FTQcuak-V6Za8qrWnhzTx67yYHz8Tg"}}`)
+	})
+	got, err := c.Withdraw(context.Background(), WithdrawParams{Asset: "XXBT", Key: "cold-wallet", Amount: "0.25", MaxFee: "0.00015"})
+	if err != nil {
+		t.Fatalf("Withdraw: %v", err)
+	}
+	if got.Refid != "FTQcuak-V6Za8qrWnhzTx67yYHz8Tg" {
+		t.Errorf("refid=%q", got.Refid)
+	}
+}
+
 func TestPublicCallSurfacesAPIError(t *testing.T) {
 	t.Parallel()
 	_, c := newTestServer(t, func(w http.ResponseWriter, _ *http.Request) {
@@ -117,3 +117,17 @@ type ClosedOrdersResponse struct {
 	Closed map[string]OrderEntry `json:"closed"`
 	Count  int                   `json:"count,omitempty"`
 }
+
+// WithdrawInfo is the /0/private/WithdrawInfo result. Method names the
+// funding method (network) pinned by the withdrawal key.
+type WithdrawInfo struct {
+	Method string `json:"method"`
+	Limit  string `json:"limit"`
+	Amount string `json:"amount"`
+	Fee    string `json:"fee"`
+}
+
+// WithdrawResponse is the /0/private/Withdraw result.
+type WithdrawResponse struct {
+	Refid string `json:"refid"`
+}
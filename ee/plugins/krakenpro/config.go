@@ -9,8 +9,8 @@ import (
 )
 
 type Config struct {
-	APIKey    string `json:"apiKey" validate:"required"`
-	APISecret string `json:"apiSecret" validate:"required"`
+	APIKey    string `json:"apiKey" validate:"required" sensitive:"true"`
+	APISecret string `json:"apiSecret" validate:"required" sensitive:"true"`
 	Endpoint  string `json:"endpoint" validate:"required,url"`
 }
 
@@ -62,6 +62,20 @@ func LedgerEntryToPSPPayment(currencies map[string]int, ledgerID string, e clien
 		return PaymentMapResult{}, fmt.Errorf("ledger %s marshal: %w", ledgerID, err)
 	}
 
+	// The ledger fee is charged in the entry asset.
+	var fees []models.PaymentFee
+	if !IsZeroAmount(e.Fee) {
+		fee, err := ParseDecimalAmount(AbsAmount(e.Fee), precision)
+		if err != nil {
+			return PaymentMapResult{}, fmt.Errorf("ledger %s fee: %w", ledgerID, err)
+		}
+		fees = append(fees, models.PaymentFee{
+			Type:   models.PAYMENT_FEE_TYPE_PROCESSING,
+			Amount: fee,
+			Asset:  FormatAsset(currencies, symbol),
+		})
+	}
+
 	payment := &models.PSPPayment{
 		Reference: ledgerID,
 		CreatedAt: FloatEpochToTime(e.Time),
@@ -70,6 +84,7 @@ func LedgerEntryToPSPPayment(currencies map[string]int, ledgerID string, e clien
 		Asset:     FormatAsset(currencies, symbol),
 		Scheme:    models.PAYMENT_SCHEME_OTHER,
 		Status:    models.PAYMENT_STATUS_SUCCEEDED,
+		Fees:      fees,
 		Metadata:  LedgerMetadata(e),
 		Raw:       raw,
 	}
@@ -49,12 +49,15 @@ func TestLedgerEntryToPSPPaymentDeposit(t *testing.T) {
 	if p.SourceAccountReference != nil {
 		t.Errorf("PAYIN should leave source ref nil, got %v", *p.SourceAccountReference)
 	}
+	if p.Fees != nil {
+		t.Errorf("fees=%v, want none without a fee", p.Fees)
+	}
 }
 
 func TestLedgerEntryToPSPPaymentWithdrawal(t *testing.T) {
 	t.Parallel()
 	entry := client.LedgerEntry{
-		Type: "withdrawal", Asset: "XXBT", Amount: "-0.5", Time: 1.0,
+		Type: "withdrawal", Asset: "XXBT", Amount: "-0.5", Fee: "0.00015", Time: 1.0,
 	}
 	res, err := LedgerEntryToPSPPayment(testCurrencies, "L1", entry)
 	if err != nil {
@@ -76,6 +79,13 @@ func TestLedgerEntryToPSPPaymentWithdrawal(t *testing.T) {
 	if res.Payment.DestinationAccountReference != nil {
 		t.Errorf("PAYOUT should leave dest ref nil, got %v", *res.Payment.DestinationAccountReference)
 	}
+	if len(res.Payment.Fees) != 1 {
+		t.Fatalf("fees=%v, want one fee", res.Payment.Fees)
+	}
+	fee := res.Payment.Fees[0]
+	if fee.Type != models.PAYMENT_FEE_TYPE_PROCESSING || fee.Asset != "BTC/8" || fee.Amount.Cmp(big.NewInt(15000)) != 0 {
+		t.Errorf("fee=%+v, want PROCESSING 15000 BTC/8", fee)
+	}
 }
 
 func TestLedgerEntryToPSPPaymentTransferIsTransfer(t *testing.T) {
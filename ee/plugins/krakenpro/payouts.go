@@ -0,0 +1,169 @@
+package krakenpro
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/types/currency"
+	"github.com/formancehq/payments/ee/plugins/krakenpro/client"
+	"github.com/formancehq/payments/ee/plugins/krakenpro/mappers"
+	errorsutils "github.com/formancehq/payments/pkg/domain/errors"
+	"github.com/formancehq/payments/pkg/domain/models"
+)
+
+// PaymentInitiation metadata keys read by CreatePayout. The withdrawal
+// key names a destination whitelisted on the Kraken account; the network
+// and any destination tag / memo are pinned by that key, so network is
+// only checked against it and tags are rejected (MAPPINGS §10).
+const (
+	metadataKeyWithdrawalKey      = mappers.MetadataPrefix + "withdrawal_key"
+	metadataKeyDestinationAddress = mappers.MetadataPrefix + "destination_address"
+	metadataKeyNetwork            = mappers.MetadataPrefix + "network"
+	metadataKeyDestinationTag     = mappers.MetadataPrefix + "destination_tag"
+	metadataKeyMemo               = mappers.MetadataPrefix + "memo"
+)
+
+// createPayout withdraws from a spot account to a whitelisted withdrawal
+// key. The fee is quoted through WithdrawInfo first and passed back as
+// max_fee, so Kraken refuses the withdrawal rather than charging more
+// than what is reported on the returned payment. The payment is PENDING;
+// the ledger rows sharing its refid surface through fetch_payments.
+func (p *Plugin) createPayout(ctx context.Context, pi models.PSPPaymentInitiation) (models.CreatePayoutResponse, error) {
+	if err := validatePayoutRequest(pi); err != nil {
+		return models.CreatePayoutResponse{}, err
+	}
+
+	currencies, _, err := p.ensureAssets(ctx)
+	if err != nil {
+		return models.CreatePayoutResponse{}, err
+	}
+
+	symbol, precision, err := currency.GetCurrencyAndPrecisionFromAsset(currencies, pi.Asset)
+	if err != nil {
+		return models.CreatePayoutResponse{}, errorsutils.NewWrappedError(
+			fmt.Errorf("failed to get currency and precision from asset: %w", err),
+			models.ErrInvalidRequest,
+		)
+	}
+
+	// The source account reference is the raw Kraken code (XXBT, ZUSD,
+	// …), which is what the withdrawal endpoints expect. Earn / staked
+	// variants cannot be withdrawn from directly.
+	code := pi.SourceAccount.Reference
+	if mappers.NormalizeAsset(code) != symbol || mappers.HasSuffixFamily(code) {
+		return models.CreatePayoutResponse{}, errorsutils.NewWrappedError(
+			fmt.Errorf("source account %s is not the %s spot account", code, symbol),
+			models.ErrInvalidRequest,
+		)
+	}
+
+	amount, err := currency.GetStringAmountFromBigIntWithPrecision(pi.Amount, precision)
+	if err != nil {
+		return models.CreatePayoutResponse{}, err
+	}
+
+	params := client.WithdrawParams{
+		Asset:   code,
+		Key:     payoutMetadata(pi, metadataKeyWithdrawalKey),
+		Amount:  amount,
+		Address: payoutMetadata(pi, metadataKeyDestinationAddress),
+	}
+
+	info, err := p.client.GetWithdrawInfo(ctx, params)
+	if err != nil {
+		return models.CreatePayoutResponse{}, err
+	}
+
+	if network := payoutMetadata(pi, metadataKeyNetwork); network != "" && !strings.EqualFold(network, info.Method) {
+		return models.CreatePayoutResponse{}, errorsutils.NewWrappedError(
+			fmt.Errorf("withdrawal key %s sends %s on %s, not %s", params.Key, symbol, info.Method, network),
+			models.ErrInvalidRequest,
+		)
+	}
+
+	params.MaxFee = info.Fee
+	withdrawal, err := p.client.Withdraw(ctx, params)
+	if err != nil {
+		return models.CreatePayoutResponse{}, err
+	}
+
+	raw, err := json.Marshal(struct {
+		client.WithdrawResponse
+		Info client.WithdrawInfo `json:"info"`
+	}{withdrawal, info})
+	if err != nil {
+		return models.CreatePayoutResponse{}, err
+	}
+
+	metadata := map[string]string{
+		mappers.MetadataPrefix + "refid":        withdrawal.Refid,
+		mappers.MetadataPrefix + "kraken_type":  "withdrawal",
+		mappers.MetadataPrefix + "kraken_asset": code,
+		metadataKeyWithdrawalKey:                params.Key,
+		metadataKeyNetwork:                      info.Method,
+	}
+	if params.Address != "" {
+		metadata[metadataKeyDestinationAddress] = params.Address
+	}
+	if !mappers.IsZeroAmount(info.Fee) {
+		metadata[mappers.MetadataPrefix+"fee"] = info.Fee
+	}
+
+	payment := models.PSPPayment{
+		Reference:              withdrawal.Refid,
+		CreatedAt:              time.Now().UTC(),
+		Type:                   models.PAYMENT_TYPE_PAYOUT,
+		Amount:                 pi.Amount,
+		Asset:                  pi.Asset,
+		Scheme:                 models.PAYMENT_SCHEME_OTHER,
+		Status:                 models.PAYMENT_STATUS_PENDING,
+		SourceAccountReference: &code,
+		Metadata:               metadata,
+		Raw:                    raw,
+	}
+	if pi.DestinationAccount != nil {
+		payment.DestinationAccountReference = &pi.DestinationAccount.Reference
+	}
+
+	return models.CreatePayoutResponse{Payment: &payment}, nil
+}
+
+// payoutMetadata reads a withdrawal key from the PaymentInitiation
+// metadata, falling back to the destination account metadata so a
+// whitelisted key can be stored once on an account.
+func payoutMetadata(pi models.PSPPaymentInitiation, key string) string {
+	if value := strings.TrimSpace(models.ExtractNamespacedMetadata(pi.Metadata, key)); value != "" {
+		return value
+	}
+	if pi.DestinationAccount != nil {
+		return strings.TrimSpace(models.ExtractNamespacedMetadata(pi.DestinationAccount.Metadata, key))
+	}
+	return ""
+}
+
+func validatePayoutRequest(pi models.PSPPaymentInitiation) error {
+	if pi.SourceAccount == nil {
+		return models.NewConnectorValidationError("sourceAccount", models.ErrMissingConnectorField)
+	}
+	if pi.Amount == nil || pi.Amount.Sign() <= 0 {
+		return models.NewConnectorValidationError("amount", models.ErrInvalidRequest)
+	}
+	if pi.Asset == "" {
+		return models.NewConnectorValidationError("asset", models.ErrMissingConnectorField)
+	}
+	if payoutMetadata(pi, metadataKeyWithdrawalKey) == "" {
+		return models.NewConnectorValidationError(metadataKeyWithdrawalKey, models.ErrMissingConnectorField)
+	}
+	for _, key := range []string{metadataKeyDestinationTag, metadataKeyMemo} {
+		if payoutMetadata(pi, key) != "" {
+			return errorsutils.NewWrappedError(
+				fmt.Errorf("%s is configured on the Kraken withdrawal key, not per payout", key),
+				models.ErrInvalidRequest,
+			)
+		}
+	}
+	return nil
+}
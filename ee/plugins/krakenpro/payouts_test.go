@@ -0,0 +1,126 @@
+package krakenpro
+
+import (
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/observe/log"
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/payments/ee/plugins/krakenpro/client"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/formancehq/payments/pkg/domain/plugins"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Kraken Pro create_payout", func() {
+	var (
+		ctrl *gomock.Controller
+		m    *client.MockClient
+		plg  *Plugin
+		pi   models.PSPPaymentInitiation
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		m = client.NewMockClient(ctrl)
+		plg = &Plugin{
+			Plugin: plugins.NewBasePlugin(),
+			client: m,
+			logger: logging.NewDefaultLogger(GinkgoWriter, true, false, false),
+			currencies: map[string]int{
+				"BTC": 8, "USD": 2,
+			},
+			assetsLoaded: time.Now(),
+		}
+
+		pi = models.PSPPaymentInitiation{
+			Reference:     "pi_1",
+			CreatedAt:     time.Now().UTC(),
+			SourceAccount: &models.PSPAccount{Reference: "XXBT"},
+			Amount:        big.NewInt(25_000_000),
+			Asset:         "BTC/8",
+			Metadata: map[string]string{
+				metadataKeyWithdrawalKey: "cold-wallet",
+			},
+		}
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	It("requires a source account", func(ctx SpecContext) {
+		pi.SourceAccount = nil
+		_, err := plg.CreatePayout(ctx, models.CreatePayoutRequest{PaymentInitiation: pi})
+		Expect(err).To(MatchError("validation error occurred for field sourceAccount: missing required field in request"))
+	})
+
+	It("requires a withdrawal key", func(ctx SpecContext) {
+		pi.Metadata = nil
+		_, err := plg.CreatePayout(ctx, models.CreatePayoutRequest{PaymentInitiation: pi})
+		Expect(err).To(MatchError(models.ErrMissingConnectorField))
+	})
+
+	It("rejects per-payout destination tags", func(ctx SpecContext) {
+		pi.Metadata[metadataKeyDestinationTag] = "12345"
+		_, err := plg.CreatePayout(ctx, models.CreatePayoutRequest{PaymentInitiation: pi})
+		Expect(err).To(MatchError(models.ErrInvalidRequest))
+	})
+
+	It("rejects earn variants as source account", func(ctx SpecContext) {
+		pi.SourceAccount = &models.PSPAccount{Reference: "XBT.M"}
+		_, err := plg.CreatePayout(ctx, models.CreatePayoutRequest{PaymentInitiation: pi})
+		Expect(err).To(MatchError(models.ErrInvalidRequest))
+	})
+
+	It("rejects a network the withdrawal key does not use", func(ctx SpecContext) {
+		pi.Metadata[metadataKeyNetwork] = "Lightning"
+		m.EXPECT().GetWithdrawInfo(gomock.Any(), gomock.Any()).Return(client.WithdrawInfo{Method: "Bitcoin", Fee: "0.00015"}, nil)
+
+		_, err := plg.CreatePayout(ctx, models.CreatePayoutRequest{PaymentInitiation: pi})
+		Expect(err).To(MatchError(models.ErrInvalidRequest))
+	})
+
+	It("maps a fatal auth error to a non-retryable error", func(ctx SpecContext) {
+		m.EXPECT().GetWithdrawInfo(gomock.Any(), gomock.Any()).Return(client.WithdrawInfo{}, &client.APIError{Code: "EGeneral:Permission denied"})
+
+		_, err := plg.CreatePayout(ctx, models.CreatePayoutRequest{PaymentInitiation: pi})
+		Expect(err).To(MatchError(models.ErrInvalidRequest))
+	})
+
+	It("propagates withdraw errors", func(ctx SpecContext) {
+		m.EXPECT().GetWithdrawInfo(gomock.Any(), gomock.Any()).Return(client.WithdrawInfo{Method: "Bitcoin", Fee: "0.00015"}, nil)
+		m.EXPECT().Withdraw(gomock.Any(), gomock.Any()).Return(client.WithdrawResponse{}, errors.New("test error"))
+
+		_, err := plg.CreatePayout(ctx, models.CreatePayoutRequest{PaymentInitiation: pi})
+		Expect(err).To(MatchError("test error"))
+	})
+
+	It("withdraws with the quoted fee as max fee and reports it", func(ctx SpecContext) {
+		pi.Metadata[metadataKeyNetwork] = "bitcoin"
+		expected := client.WithdrawParams{
+			Asset:  "XXBT",
+			Key:    "cold-wallet",
+			Amount: "0.25000000",
+		}
+		m.EXPECT().GetWithdrawInfo(gomock.Any(), expected).Return(client.WithdrawInfo{Method: "Bitcoin", Fee: "0.00015"}, nil)
+		expected.MaxFee = "0.00015"
+		m.EXPECT().Withdraw(gomock.Any(), expected).Return(client.WithdrawResponse{Refid: "FTQcuak-V6Za8qrWnhzTx67yYHz8Tg"}, nil)
+
+		resp, err := plg.CreatePayout(ctx, models.CreatePayoutRequest{PaymentInitiation: pi})
+		Expect(err).To(BeNil())
+		Expect(resp.PollingPayoutID).To(BeNil())
+		Expect(resp.Payment).NotTo(BeNil())
+		Expect(resp.Payment.Reference).To(Equal("FTQcuak-V6Za8qrWnhzTx67yYHz8Tg"))
+		Expect(resp.Payment.Type).To(Equal(models.PAYMENT_TYPE_PAYOUT))
+		Expect(resp.Payment.Status).To(Equal(models.PAYMENT_STATUS_PENDING))
+		Expect(resp.Payment.Amount).To(Equal(big.NewInt(25_000_000)))
+		Expect(resp.Payment.Asset).To(Equal("BTC/8"))
+		Expect(resp.Payment.SourceAccountReference).To(Equal(pointer.For("XXBT")))
+		Expect(resp.Payment.Metadata).To(HaveKeyWithValue("com.krakenpro.spec/fee", "0.00015"))
+		Expect(resp.Payment.Metadata).To(HaveKeyWithValue("com.krakenpro.spec/refid", "FTQcuak-V6Za8qrWnhzTx67yYHz8Tg"))
+		Expect(resp.Payment.Metadata).To(HaveKeyWithValue(metadataKeyNetwork, "Bitcoin"))
+		Expect(resp.Payment.Metadata).To(HaveKeyWithValue(metadataKeyWithdrawalKey, "cold-wallet"))
+	})
+})
@@ -150,6 +150,14 @@ func (p *Plugin) FetchNextConversions(ctx context.Context, req models.FetchNextC
 	return resp, mapFatalAuth(err)
 }
 
+func (p *Plugin) CreatePayout(ctx context.Context, req models.CreatePayoutRequest) (models.CreatePayoutResponse, error) {
+	if p.client == nil {
+		return models.CreatePayoutResponse{}, plugins.ErrNotYetInstalled
+	}
+	resp, err := p.createPayout(ctx, req.PaymentInitiation)
+	return resp, mapFatalAuth(err)
+}
+
 // logCycle writes the standard end-of-cycle log line every
 // orchestrator emits. Kept here so every fetch_* task uses the same
 // field names — downstream log queries / dashboards can rely on a
@@ -127,6 +127,10 @@ var _ = Describe("Kraken Pro Plugin", func() {
 			_, err := plg.FetchNextConversions(ctx, models.FetchNextConversionsRequest{State: json.RawMessage(`{}`)})
 			Expect(err).To(MatchError(plugins.ErrNotYetInstalled))
 		})
+		It("CreatePayout returns ErrNotYetInstalled", func(ctx SpecContext) {
+			_, err := plg.CreatePayout(ctx, models.CreatePayoutRequest{})
+			Expect(err).To(MatchError(plugins.ErrNotYetInstalled))
+		})
 	})
 
 	Context("Uninstall", func() {
@@ -138,13 +142,14 @@ var _ = Describe("Kraken Pro Plugin", func() {
 	})
 
 	Context("capabilities", func() {
-		It("declares all five fetch-only capabilities", func() {
+		It("declares the five fetch capabilities and create payout", func() {
 			Expect(capabilities).To(ConsistOf(
 				models.CAPABILITY_FETCH_ACCOUNTS,
 				models.CAPABILITY_FETCH_BALANCES,
 				models.CAPABILITY_FETCH_PAYMENTS,
 				models.CAPABILITY_FETCH_ORDERS,
 				models.CAPABILITY_FETCH_CONVERSIONS,
+				models.CAPABILITY_CREATE_PAYOUT,
 			))
 		})
 	})
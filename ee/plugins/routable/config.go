@@ -18,7 +18,7 @@ const PAGE_SIZE = 100
 // connector-level optional because callers can override it per-request
 // via the MetadataKeyActingTeamMember key on the PSPPaymentInitiation.
 type Config struct {
-	APIKey           string `json:"apiKey" validate:"required"`
+	APIKey           string `json:"apiKey" validate:"required" sensitive:"true"`
 	Endpoint         string `json:"endpoint" validate:"omitempty,url"`
 	ActingTeamMember string `json:"actingTeamMember"`
 }
@@ -16,6 +16,7 @@ import (
 type Backend interface {
 	// Accounts
 	AccountsCreate(ctx context.Context, account models.Account) (*models.Account, error)
+	AccountsCreateVirtual(ctx context.Context, connectorID models.ConnectorID, psuID *uuid.UUID, req models.CreateAccountRequest, waitResult bool) (models.Task, error)
 	AccountsList(ctx context.Context, query storage.ListAccountsQuery) (*paginate.Cursor[models.Account], error)
 	AccountsGet(ctx context.Context, id models.AccountID) (*models.Account, error)
 
@@ -30,24 +31,43 @@ type Backend interface {
 	BankAccountsList(ctx context.Context, query storage.ListBankAccountsQuery) (*paginate.Cursor[models.BankAccount], error)
 	BankAccountsUpdateMetadata(ctx context.Context, id uuid.UUID, metadata map[string]string) error
 	BankAccountsForwardToConnector(ctx context.Context, bankAccountID uuid.UUID, connectorID models.ConnectorID, waitResult bool) (models.Task, error)
+	BankAccountsVerify(ctx context.Context, bankAccountID uuid.UUID, connectorID models.ConnectorID, waitResult bool) (models.Task, error)
+
+	// Counterparties
+	CounterpartiesGet(ctx context.Context, id uuid.UUID) (*models.Counterparty, error)
+	CounterpartiesList(ctx context.Context, query storage.ListCounterpartiesQuery) (*paginate.Cursor[models.Counterparty], error)
 
 	// Connectors
 	ConnectorsConfigs() registry.Configs
 	ConnectorsConfig(ctx context.Context, connectorID models.ConnectorID) (json.RawMessage, error)
 	ConnectorsConfigUpdate(ctx context.Context, connectorID models.ConnectorID, rawConfig json.RawMessage) error
+	ConnectorsClone(ctx context.Context, connectorID models.ConnectorID, name string, overrides json.RawMessage) (models.ConnectorClone, error)
 	ConnectorsCapabilities() map[string][]models.Capability
 	ConnectorsCapabilitiesGet(ctx context.Context, connectorID models.ConnectorID) ([]models.Capability, error)
 	ConnectorsList(ctx context.Context, query storage.ListConnectorsQuery) (*paginate.Cursor[models.Connector], error)
 	ConnectorsInstall(ctx context.Context, provider string, config json.RawMessage) (models.ConnectorID, error)
 	ConnectorsUninstall(ctx context.Context, connectorID models.ConnectorID) (models.Task, error)
 	ConnectorsReset(ctx context.Context, connectorID models.ConnectorID) (models.Task, error)
+	ConnectorsSync(ctx context.Context, connectorID models.ConnectorID) (models.Task, error)
+	ConnectorsBackfill(ctx context.Context, connectorID models.ConnectorID, capability models.Capability, window models.FetchWindow) (models.Task, error)
+	ConnectorsRotateCredentials(ctx context.Context, connectorID models.ConnectorID, credentials json.RawMessage) (models.Task, error)
+	ConnectorsTest(ctx context.Context, provider string, config json.RawMessage) (models.ConnectorTestResult, error)
+	ConnectorsHealth(ctx context.Context, connectorID models.ConnectorID) (*models.ConnectorHealth, error)
+	ConnectorsExport(ctx context.Context, options models.ConnectorsExportOptions) (models.ConnectorsExport, error)
+	ConnectorsImport(ctx context.Context, document models.ConnectorsExport, dryRun bool) (models.ConnectorsImportPlan, error)
 
 	// Payments
 	PaymentsCreate(ctx context.Context, payment models.Payment) error
 	PaymentsUpdateMetadata(ctx context.Context, id models.PaymentID, metadata map[string]string) error
 	PaymentsList(ctx context.Context, query storage.ListPaymentsQuery) (*paginate.Cursor[models.Payment], error)
+	PaymentsAggregate(ctx context.Context, query storage.PaymentsAggregateQuery) ([]models.PaymentAggregate, error)
 	PaymentsGet(ctx context.Context, id models.PaymentID) (*models.Payment, error)
 
+	// Payment Correlation Rules
+	PaymentCorrelationRulesCreate(ctx context.Context, rule models.PaymentCorrelationRule) error
+	PaymentCorrelationRulesDelete(ctx context.Context, id uuid.UUID) error
+	PaymentCorrelationRulesList(ctx context.Context, query storage.ListPaymentCorrelationRulesQuery) (*paginate.Cursor[models.PaymentCorrelationRule], error)
+
 	// Payment Initiations
 	PaymentInitiationsCreate(ctx context.Context, paymentInitiation models.PaymentInitiation, sendToPSP bool, waitResult bool) (models.Task, error)
 	PaymentInitiationsList(ctx context.Context, query storage.ListPaymentInitiationsQuery) (*paginate.Cursor[models.PaymentInitiation], error)
@@ -56,6 +76,15 @@ type Backend interface {
 	PaymentInitiationsReject(ctx context.Context, id models.PaymentInitiationID) error
 	PaymentInitiationsRetry(ctx context.Context, id models.PaymentInitiationID, waitResult bool) (models.Task, error)
 	PaymentInitiationsDelete(ctx context.Context, id models.PaymentInitiationID) error
+	PaymentInitiationsScreeningResult(ctx context.Context, id models.PaymentInitiationID, result models.ScreeningResult) error
+
+	// Payment Initiation Limits
+	PaymentInitiationLimitsCreate(ctx context.Context, limit models.PaymentInitiationLimit) error
+	PaymentInitiationLimitsGet(ctx context.Context, id uuid.UUID) (*models.PaymentInitiationLimit, error)
+	PaymentInitiationLimitsList(ctx context.Context, query storage.ListPaymentInitiationLimitsQuery) (*paginate.Cursor[models.PaymentInitiationLimit], error)
+	PaymentInitiationLimitsUpdate(ctx context.Context, id uuid.UUID, update models.PaymentInitiationLimitUpdate) error
+	PaymentInitiationLimitsDelete(ctx context.Context, id uuid.UUID) error
+	PaymentInitiationLimitsUsage(ctx context.Context, id uuid.UUID) ([]models.PaymentInitiationLimitUsage, error)
 
 	// Payment Initiation Reversals
 	PaymentInitiationReversalsCreate(ctx context.Context, reversal models.PaymentInitiationReversal, waitResult bool) (models.Task, error)
@@ -84,6 +113,7 @@ type Backend interface {
 	PaymentServiceUsersLinkAttemptsGet(ctx context.Context, psuID uuid.UUID, connectorID models.ConnectorID, id uuid.UUID) (*models.OpenBankingConnectionAttempt, error)
 	PaymentServiceUsersCreateLink(ctx context.Context, ApplicationName string, psuID uuid.UUID, connectorID models.ConnectorID, idempotencyKey *uuid.UUID, ClientRedirectURL *string) (string, string, error)
 	PaymentServiceUsersUpdateLink(ctx context.Context, applicationName string, psuID uuid.UUID, connectorID models.ConnectorID, connectionID string, idempotencyKey *uuid.UUID, ClientRedirectURL *string) (string, string, error)
+	PaymentServiceUsersCreatePaymentLink(ctx context.Context, ApplicationName string, psuID uuid.UUID, pi models.PaymentInitiation, bankAccountID uuid.UUID, idempotencyKey *uuid.UUID, ClientRedirectURL *string) (string, string, error)
 	PaymentServiceUsersCompleteLinkFlow(ctx context.Context, connectorID models.ConnectorID, httpCallInformation models.HTTPCallInformation) (string, error)
 
 	// Pools
@@ -98,6 +128,9 @@ type Backend interface {
 	// Schedules
 	SchedulesList(ctx context.Context, query storage.ListSchedulesQuery) (*paginate.Cursor[models.Schedule], error)
 	SchedulesGet(ctx context.Context, id string, connectorID models.ConnectorID) (*models.Schedule, error)
+	SchedulesPause(ctx context.Context, id string, connectorID models.ConnectorID) error
+	SchedulesResume(ctx context.Context, id string, connectorID models.ConnectorID) error
+	SchedulesTrigger(ctx context.Context, id string, connectorID models.ConnectorID) error
 
 	// Tasks
 	TaskGet(ctx context.Context, id models.TaskID) (*models.Task, error)
@@ -115,4 +148,8 @@ type Backend interface {
 	// Conversions
 	ConversionsList(ctx context.Context, query storage.ListConversionsQuery) (*paginate.Cursor[models.Conversion], error)
 	ConversionsGet(ctx context.Context, id models.ConversionID) (*models.Conversion, error)
+
+	// Disputes
+	DisputesList(ctx context.Context, query storage.ListDisputesQuery) (*paginate.Cursor[models.Dispute], error)
+	DisputesGet(ctx context.Context, id models.DisputeID) (*models.Dispute, error)
 }
@@ -62,6 +62,21 @@ func (mr *MockBackendMockRecorder) AccountsCreate(ctx, account any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccountsCreate", reflect.TypeOf((*MockBackend)(nil).AccountsCreate), ctx, account)
 }
 
+// AccountsCreateVirtual mocks base method.
+func (m *MockBackend) AccountsCreateVirtual(ctx context.Context, connectorID models.ConnectorID, psuID *uuid.UUID, req models.CreateAccountRequest, waitResult bool) (models.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AccountsCreateVirtual", ctx, connectorID, psuID, req, waitResult)
+	ret0, _ := ret[0].(models.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AccountsCreateVirtual indicates an expected call of AccountsCreateVirtual.
+func (mr *MockBackendMockRecorder) AccountsCreateVirtual(ctx, connectorID, psuID, req, waitResult any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccountsCreateVirtual", reflect.TypeOf((*MockBackend)(nil).AccountsCreateVirtual), ctx, connectorID, psuID, req, waitResult)
+}
+
 // AccountsGet mocks base method.
 func (m *MockBackend) AccountsGet(ctx context.Context, id models.AccountID) (*models.Account, error) {
 	m.ctrl.T.Helper()
@@ -180,6 +195,36 @@ func (mr *MockBackendMockRecorder) BankAccountsUpdateMetadata(ctx, id, metadata
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BankAccountsUpdateMetadata", reflect.TypeOf((*MockBackend)(nil).BankAccountsUpdateMetadata), ctx, id, metadata)
 }
 
+// BankAccountsVerify mocks base method.
+func (m *MockBackend) BankAccountsVerify(ctx context.Context, bankAccountID uuid.UUID, connectorID models.ConnectorID, waitResult bool) (models.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BankAccountsVerify", ctx, bankAccountID, connectorID, waitResult)
+	ret0, _ := ret[0].(models.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BankAccountsVerify indicates an expected call of BankAccountsVerify.
+func (mr *MockBackendMockRecorder) BankAccountsVerify(ctx, bankAccountID, connectorID, waitResult any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BankAccountsVerify", reflect.TypeOf((*MockBackend)(nil).BankAccountsVerify), ctx, bankAccountID, connectorID, waitResult)
+}
+
+// ConnectorsBackfill mocks base method.
+func (m *MockBackend) ConnectorsBackfill(ctx context.Context, connectorID models.ConnectorID, capability models.Capability, window models.FetchWindow) (models.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConnectorsBackfill", ctx, connectorID, capability, window)
+	ret0, _ := ret[0].(models.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConnectorsBackfill indicates an expected call of ConnectorsBackfill.
+func (mr *MockBackendMockRecorder) ConnectorsBackfill(ctx, connectorID, capability, window any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectorsBackfill", reflect.TypeOf((*MockBackend)(nil).ConnectorsBackfill), ctx, connectorID, capability, window)
+}
+
 // ConnectorsCapabilities mocks base method.
 func (m *MockBackend) ConnectorsCapabilities() map[string][]models.Capability {
 	m.ctrl.T.Helper()
@@ -209,6 +254,21 @@ func (mr *MockBackendMockRecorder) ConnectorsCapabilitiesGet(ctx, connectorID an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectorsCapabilitiesGet", reflect.TypeOf((*MockBackend)(nil).ConnectorsCapabilitiesGet), ctx, connectorID)
 }
 
+// ConnectorsClone mocks base method.
+func (m *MockBackend) ConnectorsClone(ctx context.Context, connectorID models.ConnectorID, name string, overrides json.RawMessage) (models.ConnectorClone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConnectorsClone", ctx, connectorID, name, overrides)
+	ret0, _ := ret[0].(models.ConnectorClone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConnectorsClone indicates an expected call of ConnectorsClone.
+func (mr *MockBackendMockRecorder) ConnectorsClone(ctx, connectorID, name, overrides any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectorsClone", reflect.TypeOf((*MockBackend)(nil).ConnectorsClone), ctx, connectorID, name, overrides)
+}
+
 // ConnectorsConfig mocks base method.
 func (m *MockBackend) ConnectorsConfig(ctx context.Context, connectorID models.ConnectorID) (json.RawMessage, error) {
 	m.ctrl.T.Helper()
@@ -252,6 +312,21 @@ func (mr *MockBackendMockRecorder) ConnectorsConfigs() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectorsConfigs", reflect.TypeOf((*MockBackend)(nil).ConnectorsConfigs))
 }
 
+// ConnectorsExport mocks base method.
+func (m *MockBackend) ConnectorsExport(ctx context.Context, options models.ConnectorsExportOptions) (models.ConnectorsExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConnectorsExport", ctx, options)
+	ret0, _ := ret[0].(models.ConnectorsExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConnectorsExport indicates an expected call of ConnectorsExport.
+func (mr *MockBackendMockRecorder) ConnectorsExport(ctx, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectorsExport", reflect.TypeOf((*MockBackend)(nil).ConnectorsExport), ctx, options)
+}
+
 // ConnectorsHandleWebhooks mocks base method.
 func (m *MockBackend) ConnectorsHandleWebhooks(ctx context.Context, url, urlPath string, webhook models.Webhook) error {
 	m.ctrl.T.Helper()
@@ -266,6 +341,36 @@ func (mr *MockBackendMockRecorder) ConnectorsHandleWebhooks(ctx, url, urlPath, w
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectorsHandleWebhooks", reflect.TypeOf((*MockBackend)(nil).ConnectorsHandleWebhooks), ctx, url, urlPath, webhook)
 }
 
+// ConnectorsHealth mocks base method.
+func (m *MockBackend) ConnectorsHealth(ctx context.Context, connectorID models.ConnectorID) (*models.ConnectorHealth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConnectorsHealth", ctx, connectorID)
+	ret0, _ := ret[0].(*models.ConnectorHealth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConnectorsHealth indicates an expected call of ConnectorsHealth.
+func (mr *MockBackendMockRecorder) ConnectorsHealth(ctx, connectorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectorsHealth", reflect.TypeOf((*MockBackend)(nil).ConnectorsHealth), ctx, connectorID)
+}
+
+// ConnectorsImport mocks base method.
+func (m *MockBackend) ConnectorsImport(ctx context.Context, document models.ConnectorsExport, dryRun bool) (models.ConnectorsImportPlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConnectorsImport", ctx, document, dryRun)
+	ret0, _ := ret[0].(models.ConnectorsImportPlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConnectorsImport indicates an expected call of ConnectorsImport.
+func (mr *MockBackendMockRecorder) ConnectorsImport(ctx, document, dryRun any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectorsImport", reflect.TypeOf((*MockBackend)(nil).ConnectorsImport), ctx, document, dryRun)
+}
+
 // ConnectorsInstall mocks base method.
 func (m *MockBackend) ConnectorsInstall(ctx context.Context, provider string, config json.RawMessage) (models.ConnectorID, error) {
 	m.ctrl.T.Helper()
@@ -311,6 +416,51 @@ func (mr *MockBackendMockRecorder) ConnectorsReset(ctx, connectorID any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectorsReset", reflect.TypeOf((*MockBackend)(nil).ConnectorsReset), ctx, connectorID)
 }
 
+// ConnectorsRotateCredentials mocks base method.
+func (m *MockBackend) ConnectorsRotateCredentials(ctx context.Context, connectorID models.ConnectorID, credentials json.RawMessage) (models.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConnectorsRotateCredentials", ctx, connectorID, credentials)
+	ret0, _ := ret[0].(models.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConnectorsRotateCredentials indicates an expected call of ConnectorsRotateCredentials.
+func (mr *MockBackendMockRecorder) ConnectorsRotateCredentials(ctx, connectorID, credentials any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectorsRotateCredentials", reflect.TypeOf((*MockBackend)(nil).ConnectorsRotateCredentials), ctx, connectorID, credentials)
+}
+
+// ConnectorsSync mocks base method.
+func (m *MockBackend) ConnectorsSync(ctx context.Context, connectorID models.ConnectorID) (models.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConnectorsSync", ctx, connectorID)
+	ret0, _ := ret[0].(models.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConnectorsSync indicates an expected call of ConnectorsSync.
+func (mr *MockBackendMockRecorder) ConnectorsSync(ctx, connectorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectorsSync", reflect.TypeOf((*MockBackend)(nil).ConnectorsSync), ctx, connectorID)
+}
+
+// ConnectorsTest mocks base method.
+func (m *MockBackend) ConnectorsTest(ctx context.Context, provider string, config json.RawMessage) (models.ConnectorTestResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConnectorsTest", ctx, provider, config)
+	ret0, _ := ret[0].(models.ConnectorTestResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConnectorsTest indicates an expected call of ConnectorsTest.
+func (mr *MockBackendMockRecorder) ConnectorsTest(ctx, provider, config any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectorsTest", reflect.TypeOf((*MockBackend)(nil).ConnectorsTest), ctx, provider, config)
+}
+
 // ConnectorsUninstall mocks base method.
 func (m *MockBackend) ConnectorsUninstall(ctx context.Context, connectorID models.ConnectorID) (models.Task, error) {
 	m.ctrl.T.Helper()
@@ -356,6 +506,66 @@ func (mr *MockBackendMockRecorder) ConversionsList(ctx, query any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConversionsList", reflect.TypeOf((*MockBackend)(nil).ConversionsList), ctx, query)
 }
 
+// CounterpartiesGet mocks base method.
+func (m *MockBackend) CounterpartiesGet(ctx context.Context, id uuid.UUID) (*models.Counterparty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CounterpartiesGet", ctx, id)
+	ret0, _ := ret[0].(*models.Counterparty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CounterpartiesGet indicates an expected call of CounterpartiesGet.
+func (mr *MockBackendMockRecorder) CounterpartiesGet(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CounterpartiesGet", reflect.TypeOf((*MockBackend)(nil).CounterpartiesGet), ctx, id)
+}
+
+// CounterpartiesList mocks base method.
+func (m *MockBackend) CounterpartiesList(ctx context.Context, query storage.ListCounterpartiesQuery) (*paginate.Cursor[models.Counterparty], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CounterpartiesList", ctx, query)
+	ret0, _ := ret[0].(*paginate.Cursor[models.Counterparty])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CounterpartiesList indicates an expected call of CounterpartiesList.
+func (mr *MockBackendMockRecorder) CounterpartiesList(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CounterpartiesList", reflect.TypeOf((*MockBackend)(nil).CounterpartiesList), ctx, query)
+}
+
+// DisputesGet mocks base method.
+func (m *MockBackend) DisputesGet(ctx context.Context, id models.DisputeID) (*models.Dispute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisputesGet", ctx, id)
+	ret0, _ := ret[0].(*models.Dispute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisputesGet indicates an expected call of DisputesGet.
+func (mr *MockBackendMockRecorder) DisputesGet(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisputesGet", reflect.TypeOf((*MockBackend)(nil).DisputesGet), ctx, id)
+}
+
+// DisputesList mocks base method.
+func (m *MockBackend) DisputesList(ctx context.Context, query storage.ListDisputesQuery) (*paginate.Cursor[models.Dispute], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisputesList", ctx, query)
+	ret0, _ := ret[0].(*paginate.Cursor[models.Dispute])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisputesList indicates an expected call of DisputesList.
+func (mr *MockBackendMockRecorder) DisputesList(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisputesList", reflect.TypeOf((*MockBackend)(nil).DisputesList), ctx, query)
+}
+
 // OrdersGet mocks base method.
 func (m *MockBackend) OrdersGet(ctx context.Context, id models.OrderID) (*models.Order, error) {
 	m.ctrl.T.Helper()
@@ -386,6 +596,49 @@ func (mr *MockBackendMockRecorder) OrdersList(ctx, query any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrdersList", reflect.TypeOf((*MockBackend)(nil).OrdersList), ctx, query)
 }
 
+// PaymentCorrelationRulesCreate mocks base method.
+func (m *MockBackend) PaymentCorrelationRulesCreate(ctx context.Context, rule models.PaymentCorrelationRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PaymentCorrelationRulesCreate", ctx, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PaymentCorrelationRulesCreate indicates an expected call of PaymentCorrelationRulesCreate.
+func (mr *MockBackendMockRecorder) PaymentCorrelationRulesCreate(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentCorrelationRulesCreate", reflect.TypeOf((*MockBackend)(nil).PaymentCorrelationRulesCreate), ctx, rule)
+}
+
+// PaymentCorrelationRulesDelete mocks base method.
+func (m *MockBackend) PaymentCorrelationRulesDelete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PaymentCorrelationRulesDelete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PaymentCorrelationRulesDelete indicates an expected call of PaymentCorrelationRulesDelete.
+func (mr *MockBackendMockRecorder) PaymentCorrelationRulesDelete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentCorrelationRulesDelete", reflect.TypeOf((*MockBackend)(nil).PaymentCorrelationRulesDelete), ctx, id)
+}
+
+// PaymentCorrelationRulesList mocks base method.
+func (m *MockBackend) PaymentCorrelationRulesList(ctx context.Context, query storage.ListPaymentCorrelationRulesQuery) (*paginate.Cursor[models.PaymentCorrelationRule], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PaymentCorrelationRulesList", ctx, query)
+	ret0, _ := ret[0].(*paginate.Cursor[models.PaymentCorrelationRule])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PaymentCorrelationRulesList indicates an expected call of PaymentCorrelationRulesList.
+func (mr *MockBackendMockRecorder) PaymentCorrelationRulesList(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentCorrelationRulesList", reflect.TypeOf((*MockBackend)(nil).PaymentCorrelationRulesList), ctx, query)
+}
+
 // PaymentInitiationAdjustmentsGetLast mocks base method.
 func (m *MockBackend) PaymentInitiationAdjustmentsGetLast(ctx context.Context, id models.PaymentInitiationID) (*models.PaymentInitiationAdjustment, error) {
 	m.ctrl.T.Helper()
@@ -431,6 +684,93 @@ func (mr *MockBackendMockRecorder) PaymentInitiationAdjustmentsListAll(ctx, id a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentInitiationAdjustmentsListAll", reflect.TypeOf((*MockBackend)(nil).PaymentInitiationAdjustmentsListAll), ctx, id)
 }
 
+// PaymentInitiationLimitsCreate mocks base method.
+func (m *MockBackend) PaymentInitiationLimitsCreate(ctx context.Context, limit models.PaymentInitiationLimit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PaymentInitiationLimitsCreate", ctx, limit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PaymentInitiationLimitsCreate indicates an expected call of PaymentInitiationLimitsCreate.
+func (mr *MockBackendMockRecorder) PaymentInitiationLimitsCreate(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentInitiationLimitsCreate", reflect.TypeOf((*MockBackend)(nil).PaymentInitiationLimitsCreate), ctx, limit)
+}
+
+// PaymentInitiationLimitsDelete mocks base method.
+func (m *MockBackend) PaymentInitiationLimitsDelete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PaymentInitiationLimitsDelete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PaymentInitiationLimitsDelete indicates an expected call of PaymentInitiationLimitsDelete.
+func (mr *MockBackendMockRecorder) PaymentInitiationLimitsDelete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentInitiationLimitsDelete", reflect.TypeOf((*MockBackend)(nil).PaymentInitiationLimitsDelete), ctx, id)
+}
+
+// PaymentInitiationLimitsGet mocks base method.
+func (m *MockBackend) PaymentInitiationLimitsGet(ctx context.Context, id uuid.UUID) (*models.PaymentInitiationLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PaymentInitiationLimitsGet", ctx, id)
+	ret0, _ := ret[0].(*models.PaymentInitiationLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PaymentInitiationLimitsGet indicates an expected call of PaymentInitiationLimitsGet.
+func (mr *MockBackendMockRecorder) PaymentInitiationLimitsGet(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentInitiationLimitsGet", reflect.TypeOf((*MockBackend)(nil).PaymentInitiationLimitsGet), ctx, id)
+}
+
+// PaymentInitiationLimitsList mocks base method.
+func (m *MockBackend) PaymentInitiationLimitsList(ctx context.Context, query storage.ListPaymentInitiationLimitsQuery) (*paginate.Cursor[models.PaymentInitiationLimit], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PaymentInitiationLimitsList", ctx, query)
+	ret0, _ := ret[0].(*paginate.Cursor[models.PaymentInitiationLimit])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PaymentInitiationLimitsList indicates an expected call of PaymentInitiationLimitsList.
+func (mr *MockBackendMockRecorder) PaymentInitiationLimitsList(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentInitiationLimitsList", reflect.TypeOf((*MockBackend)(nil).PaymentInitiationLimitsList), ctx, query)
+}
+
+// PaymentInitiationLimitsUpdate mocks base method.
+func (m *MockBackend) PaymentInitiationLimitsUpdate(ctx context.Context, id uuid.UUID, update models.PaymentInitiationLimitUpdate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PaymentInitiationLimitsUpdate", ctx, id, update)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PaymentInitiationLimitsUpdate indicates an expected call of PaymentInitiationLimitsUpdate.
+func (mr *MockBackendMockRecorder) PaymentInitiationLimitsUpdate(ctx, id, update any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentInitiationLimitsUpdate", reflect.TypeOf((*MockBackend)(nil).PaymentInitiationLimitsUpdate), ctx, id, update)
+}
+
+// PaymentInitiationLimitsUsage mocks base method.
+func (m *MockBackend) PaymentInitiationLimitsUsage(ctx context.Context, id uuid.UUID) ([]models.PaymentInitiationLimitUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PaymentInitiationLimitsUsage", ctx, id)
+	ret0, _ := ret[0].([]models.PaymentInitiationLimitUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PaymentInitiationLimitsUsage indicates an expected call of PaymentInitiationLimitsUsage.
+func (mr *MockBackendMockRecorder) PaymentInitiationLimitsUsage(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentInitiationLimitsUsage", reflect.TypeOf((*MockBackend)(nil).PaymentInitiationLimitsUsage), ctx, id)
+}
+
 // PaymentInitiationRelatedPaymentsList mocks base method.
 func (m *MockBackend) PaymentInitiationRelatedPaymentsList(ctx context.Context, id models.PaymentInitiationID, query storage.ListPaymentInitiationRelatedPaymentsQuery) (*paginate.Cursor[models.Payment], error) {
 	m.ctrl.T.Helper()
@@ -579,6 +919,20 @@ func (mr *MockBackendMockRecorder) PaymentInitiationsRetry(ctx, id, waitResult a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentInitiationsRetry", reflect.TypeOf((*MockBackend)(nil).PaymentInitiationsRetry), ctx, id, waitResult)
 }
 
+// PaymentInitiationsScreeningResult mocks base method.
+func (m *MockBackend) PaymentInitiationsScreeningResult(ctx context.Context, id models.PaymentInitiationID, result models.ScreeningResult) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PaymentInitiationsScreeningResult", ctx, id, result)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PaymentInitiationsScreeningResult indicates an expected call of PaymentInitiationsScreeningResult.
+func (mr *MockBackendMockRecorder) PaymentInitiationsScreeningResult(ctx, id, result any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentInitiationsScreeningResult", reflect.TypeOf((*MockBackend)(nil).PaymentInitiationsScreeningResult), ctx, id, result)
+}
+
 // PaymentServiceUsersAddBankAccount mocks base method.
 func (m *MockBackend) PaymentServiceUsersAddBankAccount(ctx context.Context, psuID, bankAccountID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -683,6 +1037,22 @@ func (mr *MockBackendMockRecorder) PaymentServiceUsersCreateLink(ctx, Applicatio
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentServiceUsersCreateLink", reflect.TypeOf((*MockBackend)(nil).PaymentServiceUsersCreateLink), ctx, ApplicationName, psuID, connectorID, idempotencyKey, ClientRedirectURL)
 }
 
+// PaymentServiceUsersCreatePaymentLink mocks base method.
+func (m *MockBackend) PaymentServiceUsersCreatePaymentLink(ctx context.Context, ApplicationName string, psuID uuid.UUID, pi models.PaymentInitiation, bankAccountID uuid.UUID, idempotencyKey *uuid.UUID, ClientRedirectURL *string) (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PaymentServiceUsersCreatePaymentLink", ctx, ApplicationName, psuID, pi, bankAccountID, idempotencyKey, ClientRedirectURL)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// PaymentServiceUsersCreatePaymentLink indicates an expected call of PaymentServiceUsersCreatePaymentLink.
+func (mr *MockBackendMockRecorder) PaymentServiceUsersCreatePaymentLink(ctx, ApplicationName, psuID, pi, bankAccountID, idempotencyKey, ClientRedirectURL any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentServiceUsersCreatePaymentLink", reflect.TypeOf((*MockBackend)(nil).PaymentServiceUsersCreatePaymentLink), ctx, ApplicationName, psuID, pi, bankAccountID, idempotencyKey, ClientRedirectURL)
+}
+
 // PaymentServiceUsersDelete mocks base method.
 func (m *MockBackend) PaymentServiceUsersDelete(ctx context.Context, psuID uuid.UUID) (models.Task, error) {
 	m.ctrl.T.Helper()
@@ -803,6 +1173,21 @@ func (mr *MockBackendMockRecorder) PaymentServiceUsersUpdateLink(ctx, applicatio
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentServiceUsersUpdateLink", reflect.TypeOf((*MockBackend)(nil).PaymentServiceUsersUpdateLink), ctx, applicationName, psuID, connectorID, connectionID, idempotencyKey, ClientRedirectURL)
 }
 
+// PaymentsAggregate mocks base method.
+func (m *MockBackend) PaymentsAggregate(ctx context.Context, query storage.PaymentsAggregateQuery) ([]models.PaymentAggregate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PaymentsAggregate", ctx, query)
+	ret0, _ := ret[0].([]models.PaymentAggregate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PaymentsAggregate indicates an expected call of PaymentsAggregate.
+func (mr *MockBackendMockRecorder) PaymentsAggregate(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentsAggregate", reflect.TypeOf((*MockBackend)(nil).PaymentsAggregate), ctx, query)
+}
+
 // PaymentsCreate mocks base method.
 func (m *MockBackend) PaymentsCreate(ctx context.Context, payment models.Payment) error {
 	m.ctrl.T.Helper()
@@ -1021,6 +1406,48 @@ func (mr *MockBackendMockRecorder) SchedulesList(ctx, query any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchedulesList", reflect.TypeOf((*MockBackend)(nil).SchedulesList), ctx, query)
 }
 
+// SchedulesPause mocks base method.
+func (m *MockBackend) SchedulesPause(ctx context.Context, id string, connectorID models.ConnectorID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SchedulesPause", ctx, id, connectorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SchedulesPause indicates an expected call of SchedulesPause.
+func (mr *MockBackendMockRecorder) SchedulesPause(ctx, id, connectorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchedulesPause", reflect.TypeOf((*MockBackend)(nil).SchedulesPause), ctx, id, connectorID)
+}
+
+// SchedulesResume mocks base method.
+func (m *MockBackend) SchedulesResume(ctx context.Context, id string, connectorID models.ConnectorID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SchedulesResume", ctx, id, connectorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SchedulesResume indicates an expected call of SchedulesResume.
+func (mr *MockBackendMockRecorder) SchedulesResume(ctx, id, connectorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchedulesResume", reflect.TypeOf((*MockBackend)(nil).SchedulesResume), ctx, id, connectorID)
+}
+
+// SchedulesTrigger mocks base method.
+func (m *MockBackend) SchedulesTrigger(ctx context.Context, id string, connectorID models.ConnectorID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SchedulesTrigger", ctx, id, connectorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SchedulesTrigger indicates an expected call of SchedulesTrigger.
+func (mr *MockBackendMockRecorder) SchedulesTrigger(ctx, id, connectorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchedulesTrigger", reflect.TypeOf((*MockBackend)(nil).SchedulesTrigger), ctx, id, connectorID)
+}
+
 // TaskGet mocks base method.
 func (m *MockBackend) TaskGet(ctx context.Context, id models.TaskID) (*models.Task, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,25 @@
+package services
+
+import (
+	"context"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+)
+
+func (s *Service) AccountsCreateVirtual(ctx context.Context, connectorID models.ConnectorID, psuID *uuid.UUID, req models.CreateAccountRequest, waitResult bool) (models.Task, error) {
+	if psuID != nil {
+		psu, err := s.storage.PaymentServiceUsersGet(ctx, *psuID)
+		if err != nil {
+			return models.Task{}, newStorageError(err, "failed to get payment service user")
+		}
+
+		req.PaymentServiceUser = models.ToPSPPaymentServiceUser(psu)
+	}
+
+	task, err := s.engine.CreateAccount(ctx, connectorID, req, waitResult)
+	if err != nil {
+		return models.Task{}, handleEngineErrors(err)
+	}
+	return task, nil
+}
@@ -0,0 +1,112 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/formancehq/payments/internal/connectors/engine"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestAccountsCreateVirtual(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := storage.NewMockStorage(ctrl)
+	eng := engine.NewMockEngine(ctrl)
+
+	s := New(store, eng, false)
+
+	connectorID := models.ConnectorID{
+		Reference: uuid.New(),
+		Provider:  "test",
+	}
+	psu := &models.PaymentServiceUser{
+		ID:   uuid.New(),
+		Name: "test",
+	}
+
+	tests := []struct {
+		name          string
+		psuID         *uuid.UUID
+		engineErr     error
+		storageErr    error
+		expectedError error
+		// compare the whole error instead of looking for it in the chain
+		exactError bool
+	}{
+		{
+			name: "success",
+		},
+		{
+			name:  "success with payment service user",
+			psuID: &psu.ID,
+		},
+		{
+			name:          "validation error",
+			engineErr:     engine.ErrValidation,
+			expectedError: ErrValidation,
+		},
+		{
+			name:          "connector not found",
+			engineErr:     engine.ErrNotFound,
+			expectedError: ErrNotFound,
+		},
+		{
+			name:          "capability not supported",
+			engineErr:     &engine.ErrConnectorCapabilityNotSupported{Capability: "CREATE_ACCOUNT", Provider: "test"},
+			expectedError: &engine.ErrConnectorCapabilityNotSupported{Capability: "CREATE_ACCOUNT", Provider: "test"},
+			exactError:    true,
+		},
+		{
+			name:          "payment service user not found",
+			psuID:         &psu.ID,
+			storageErr:    storage.ErrNotFound,
+			expectedError: storage.ErrNotFound,
+		},
+		{
+			name:          "other storage error",
+			psuID:         &psu.ID,
+			storageErr:    fmt.Errorf("error"),
+			expectedError: newStorageError(fmt.Errorf("error"), "failed to get payment service user"),
+			exactError:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := models.CreateAccountRequest{
+				Reference: "virtual-1",
+				Name:      "test",
+			}
+
+			if test.psuID != nil {
+				store.EXPECT().PaymentServiceUsersGet(gomock.Any(), *test.psuID).Return(psu, test.storageErr)
+			}
+
+			if test.storageErr == nil {
+				expected := req
+				if test.psuID != nil {
+					expected.PaymentServiceUser = models.ToPSPPaymentServiceUser(psu)
+				}
+				eng.EXPECT().CreateAccount(gomock.Any(), connectorID, expected, false).Return(models.Task{}, test.engineErr)
+			}
+
+			_, err := s.AccountsCreateVirtual(context.Background(), connectorID, test.psuID, req, false)
+			switch {
+			case test.expectedError == nil:
+				require.NoError(t, err)
+			case test.exactError:
+				require.Equal(t, test.expectedError, err)
+			default:
+				require.ErrorIs(t, err, test.expectedError)
+			}
+		})
+	}
+}
@@ -3,9 +3,16 @@ package services
 import (
 	"context"
 
+	"github.com/formancehq/payments/internal/connectors/plugins/registry"
+	"github.com/formancehq/payments/pkg/domain/bankvalidation"
+	errorsutils "github.com/formancehq/payments/pkg/domain/errors"
 	"github.com/formancehq/payments/pkg/domain/models"
 )
 
 func (s *Service) BankAccountsCreate(ctx context.Context, bankAccount models.BankAccount) error {
+	if err := bankvalidation.ValidateBankAccount(bankAccount, registry.GetRoutingCodeMetadataKeys()...); err != nil {
+		return errorsutils.NewWrappedError(err, ErrValidation)
+	}
+
 	return newStorageError(s.storage.BankAccountsUpsert(ctx, bankAccount), "cannot create bank account")
 }
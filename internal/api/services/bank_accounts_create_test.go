@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
 	"github.com/formancehq/payments/internal/connectors/engine"
+	"github.com/formancehq/payments/pkg/domain/bankvalidation"
 	"github.com/formancehq/payments/pkg/domain/models"
 	"github.com/formancehq/payments/internal/storage"
 	"github.com/stretchr/testify/require"
@@ -55,4 +57,12 @@ func TestBankAccountsCreate(t *testing.T) {
 			}
 		})
 	}
+	t.Run("invalid bank details", func(t *testing.T) {
+		err := s.BankAccountsCreate(context.Background(), models.BankAccount{
+			IBAN:         pointer.For("DE89370400440532013001"),
+			SwiftBicCode: pointer.For("DEUTDEFF"),
+		})
+		require.ErrorIs(t, err, ErrValidation)
+		require.ErrorIs(t, err, bankvalidation.ErrInvalidIBANChecksum)
+	})
 }
@@ -0,0 +1,91 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/formancehq/payments/internal/connectors/engine"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestBankAccountsVerify(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := storage.NewMockStorage(ctrl)
+	eng := engine.NewMockEngine(ctrl)
+
+	s := New(store, eng, false)
+
+	connectorID := models.ConnectorID{
+		Reference: uuid.New(),
+		Provider:  "test",
+	}
+
+	tests := []struct {
+		name          string
+		engineErr     error
+		storageErr    error
+		expectedError error
+		// compare the whole error instead of looking for it in the chain
+		exactError bool
+	}{
+		{
+			name: "success",
+		},
+		{
+			name:          "validation error",
+			engineErr:     engine.ErrValidation,
+			expectedError: ErrValidation,
+		},
+		{
+			name:          "connector not found",
+			engineErr:     engine.ErrNotFound,
+			expectedError: ErrNotFound,
+		},
+		{
+			name:          "capability not supported",
+			engineErr:     &engine.ErrConnectorCapabilityNotSupported{Capability: "VERIFY_BANK_ACCOUNT", Provider: "test"},
+			expectedError: &engine.ErrConnectorCapabilityNotSupported{Capability: "VERIFY_BANK_ACCOUNT", Provider: "test"},
+			exactError:    true,
+		},
+		{
+			name:          "bank account not found",
+			storageErr:    storage.ErrNotFound,
+			expectedError: storage.ErrNotFound,
+		},
+		{
+			name:          "other storage error",
+			storageErr:    fmt.Errorf("error"),
+			expectedError: newStorageError(fmt.Errorf("error"), "failed to get bank account"),
+			exactError:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bankAccountID := uuid.New()
+			store.EXPECT().BankAccountsGet(gomock.Any(), bankAccountID, true).Return(&models.BankAccount{ID: bankAccountID}, test.storageErr)
+
+			if test.storageErr == nil {
+				eng.EXPECT().VerifyBankAccount(gomock.Any(), models.BankAccount{ID: bankAccountID}, connectorID, false).Return(models.Task{}, test.engineErr)
+			}
+
+			_, err := s.BankAccountsVerify(context.Background(), bankAccountID, connectorID, false)
+			switch {
+			case test.expectedError == nil:
+				require.NoError(t, err)
+			case test.exactError:
+				require.Equal(t, test.expectedError, err)
+			default:
+				require.ErrorIs(t, err, test.expectedError)
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package services
+
+import (
+	"context"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+)
+
+func (s *Service) ConnectorsBackfill(ctx context.Context, connectorID models.ConnectorID, capability models.Capability, window models.FetchWindow) (models.Task, error) {
+	task, err := s.engine.BackfillConnector(ctx, connectorID, capability, window)
+	if err != nil {
+		return models.Task{}, handleEngineErrors(err)
+	}
+	return task, nil
+}
@@ -0,0 +1,135 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+)
+
+// ConnectorsClone installs a new connector with the provider and the config
+// of an existing one, the given fields overriding the copied config. Dynamic
+// pools whose query targets the cloned connector are copied as well, targeting
+// the new connector. Static pools are not, as the accounts of the new
+// connector are yet to be fetched.
+func (s *Service) ConnectorsClone(ctx context.Context, connectorID models.ConnectorID, name string, overrides json.RawMessage) (models.ConnectorClone, error) {
+	connector, err := s.storage.ConnectorsGet(ctx, connectorID)
+	if err != nil {
+		return models.ConnectorClone{}, newStorageError(err, "cannot get connector")
+	}
+
+	config, err := cloneConnectorConfig(connector.Config, name, overrides)
+	if err != nil {
+		return models.ConnectorClone{}, handleEngineErrors(err)
+	}
+
+	pools, err := s.listPools(ctx)
+	if err != nil {
+		return models.ConnectorClone{}, err
+	}
+
+	cloneID, err := s.engine.InstallConnector(ctx, connector.Provider, config)
+	if err != nil {
+		return models.ConnectorClone{}, handleEngineErrors(err)
+	}
+
+	clone := models.ConnectorClone{
+		ConnectorID: cloneID,
+		PoolIDs:     make([]uuid.UUID, 0),
+	}
+	for _, pool := range pools {
+		if pool.Type != models.POOL_TYPE_DYNAMIC || !poolQueryTargets(pool.Query, connectorID.String()) {
+			continue
+		}
+
+		p := models.Pool{
+			ID:        uuid.New(),
+			Name:      fmt.Sprintf("%s-%s", pool.Name, name),
+			CreatedAt: time.Now().UTC(),
+			Type:      models.POOL_TYPE_DYNAMIC,
+			Query:     retargetPoolQuery(pool.Query, connectorID.String(), cloneID.String()).(map[string]any),
+		}
+		if err := s.engine.CreatePool(ctx, p); err != nil {
+			return models.ConnectorClone{}, fmt.Errorf("failed to clone pool %q: %w", pool.Name, handleEngineErrors(err))
+		}
+		clone.PoolIDs = append(clone.PoolIDs, p.ID)
+	}
+
+	return clone, nil
+}
+
+// cloneConnectorConfig overrides the fields of a connector config, secrets
+// included, and renames it.
+func cloneConnectorConfig(rawConfig json.RawMessage, name string, overrides json.RawMessage) (json.RawMessage, error) {
+	var config map[string]json.RawMessage
+	if err := json.Unmarshal(rawConfig, &config); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal connector config: %w", err)
+	}
+
+	if len(overrides) > 0 {
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(overrides, &fields); err != nil {
+			return nil, fmt.Errorf("invalid config overrides: %w", models.ErrValidation)
+		}
+		for field, value := range fields {
+			config[field] = value
+		}
+	}
+
+	rawName, err := json.Marshal(name)
+	if err != nil {
+		return nil, err
+	}
+	config["name"] = rawName
+
+	return json.Marshal(config)
+}
+
+// retargetPoolQuery replaces the given connector ID by another one in the
+// connector_id conditions of a pool query.
+func retargetPoolQuery(query any, from, to string) any {
+	switch q := query.(type) {
+	case map[string]any:
+		res := make(map[string]any, len(q))
+		for key, value := range q {
+			if id, ok := value.(string); ok && key == "connector_id" && id == from {
+				res[key] = to
+				continue
+			}
+			res[key] = retargetPoolQuery(value, from, to)
+		}
+		return res
+	case []any:
+		res := make([]any, len(q))
+		for i, value := range q {
+			res[i] = retargetPoolQuery(value, from, to)
+		}
+		return res
+	default:
+		return query
+	}
+}
+
+func poolQueryTargets(query any, connectorID string) bool {
+	switch q := query.(type) {
+	case map[string]any:
+		for key, value := range q {
+			if id, ok := value.(string); ok && key == "connector_id" && id == connectorID {
+				return true
+			}
+			if poolQueryTargets(value, connectorID) {
+				return true
+			}
+		}
+	case []any:
+		for _, value := range q {
+			if poolQueryTargets(value, connectorID) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,141 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/formancehq/go-libs/v5/pkg/storage/bun/paginate"
+	"github.com/formancehq/payments/internal/connectors/engine"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestConnectorsClone(t *testing.T) {
+	t.Parallel()
+
+	connectorID := models.ConnectorID{Reference: uuid.New(), Provider: "stripe"}
+	cloneID := models.ConnectorID{Reference: uuid.New(), Provider: "stripe"}
+	otherID := models.ConnectorID{Reference: uuid.New(), Provider: "wise"}
+
+	connector := &models.Connector{
+		ConnectorBase: models.ConnectorBase{ID: connectorID, Name: "stripe-sandbox", Provider: "stripe"},
+		Config:        json.RawMessage(`{"apiKey":"sk_test","environment":"sandbox","name":"stripe-sandbox","pollingPeriod":"30m"}`),
+		Environment:   "sandbox",
+	}
+	pools := &paginate.Cursor[models.Pool]{
+		Data: []models.Pool{
+			{
+				Name:  "eur",
+				Type:  models.POOL_TYPE_DYNAMIC,
+				Query: map[string]any{"$and": []any{map[string]any{"$match": map[string]any{"connector_id": connectorID.String()}}, map[string]any{"$match": map[string]any{"default_asset": "EUR/2"}}}},
+			},
+			{
+				Name:  "wise",
+				Type:  models.POOL_TYPE_DYNAMIC,
+				Query: map[string]any{"$match": map[string]any{"connector_id": otherID.String()}},
+			},
+			{
+				Name:         "treasury",
+				Type:         models.POOL_TYPE_STATIC,
+				PoolAccounts: []models.AccountID{{Reference: "acc", ConnectorID: connectorID}},
+			},
+		},
+	}
+
+	t.Run("clones config and pools", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		store := storage.NewMockStorage(ctrl)
+		eng := engine.NewMockEngine(ctrl)
+		s := New(store, eng, false)
+
+		store.EXPECT().ConnectorsGet(gomock.Any(), connectorID).Return(connector, nil)
+		store.EXPECT().PoolsList(gomock.Any(), gomock.Any()).Return(pools, nil)
+		eng.EXPECT().InstallConnector(gomock.Any(), "stripe", gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, config json.RawMessage) (models.ConnectorID, error) {
+				require.JSONEq(t, `{"apiKey":"sk_live","environment":"production","name":"stripe-production","pollingPeriod":"30m"}`, string(config))
+				return cloneID, nil
+			},
+		)
+		eng.EXPECT().CreatePool(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, pool models.Pool) error {
+				require.Equal(t, "eur-stripe-production", pool.Name)
+				require.Equal(t, models.POOL_TYPE_DYNAMIC, pool.Type)
+				require.Equal(t, map[string]any{"$and": []any{map[string]any{"$match": map[string]any{"connector_id": cloneID.String()}}, map[string]any{"$match": map[string]any{"default_asset": "EUR/2"}}}}, pool.Query)
+				return nil
+			},
+		)
+
+		clone, err := s.ConnectorsClone(context.Background(), connectorID, "stripe-production", json.RawMessage(`{"apiKey":"sk_live","environment":"production"}`))
+		require.NoError(t, err)
+		require.Equal(t, cloneID, clone.ConnectorID)
+		require.Len(t, clone.PoolIDs, 1)
+		// the source pool is left untouched
+		require.Equal(t, connectorID.String(), pools.Data[0].Query["$and"].([]any)[0].(map[string]any)["$match"].(map[string]any)["connector_id"])
+	})
+
+	t.Run("connector not found", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		store := storage.NewMockStorage(ctrl)
+		s := New(store, engine.NewMockEngine(ctrl), false)
+
+		store.EXPECT().ConnectorsGet(gomock.Any(), connectorID).Return(nil, storage.ErrNotFound)
+
+		_, err := s.ConnectorsClone(context.Background(), connectorID, "stripe-production", nil)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("invalid overrides", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		store := storage.NewMockStorage(ctrl)
+		s := New(store, engine.NewMockEngine(ctrl), false)
+
+		store.EXPECT().ConnectorsGet(gomock.Any(), connectorID).Return(connector, nil)
+
+		_, err := s.ConnectorsClone(context.Background(), connectorID, "stripe-production", json.RawMessage(`[]`))
+		require.ErrorIs(t, err, ErrValidation)
+	})
+
+	t.Run("install error", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		store := storage.NewMockStorage(ctrl)
+		eng := engine.NewMockEngine(ctrl)
+		s := New(store, eng, false)
+
+		store.EXPECT().ConnectorsGet(gomock.Any(), connectorID).Return(connector, nil)
+		store.EXPECT().PoolsList(gomock.Any(), gomock.Any()).Return(pools, nil)
+		eng.EXPECT().InstallConnector(gomock.Any(), "stripe", gomock.Any()).Return(models.ConnectorID{}, engine.ErrValidation)
+
+		_, err := s.ConnectorsClone(context.Background(), connectorID, "stripe-production", nil)
+		require.ErrorIs(t, err, ErrValidation)
+	})
+
+	t.Run("pool error", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		store := storage.NewMockStorage(ctrl)
+		eng := engine.NewMockEngine(ctrl)
+		s := New(store, eng, false)
+
+		store.EXPECT().ConnectorsGet(gomock.Any(), connectorID).Return(connector, nil)
+		store.EXPECT().PoolsList(gomock.Any(), gomock.Any()).Return(pools, nil)
+		eng.EXPECT().InstallConnector(gomock.Any(), "stripe", gomock.Any()).Return(cloneID, nil)
+		eng.EXPECT().CreatePool(gomock.Any(), gomock.Any()).Return(fmt.Errorf("error"))
+
+		_, err := s.ConnectorsClone(context.Background(), connectorID, "stripe-production", nil)
+		require.Error(t, err)
+	})
+}
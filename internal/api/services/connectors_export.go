@@ -0,0 +1,256 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/storage/bun/paginate"
+	"github.com/formancehq/payments/internal/connectors/plugins/registry"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+)
+
+func (s *Service) ConnectorsExport(ctx context.Context, options models.ConnectorsExportOptions) (models.ConnectorsExport, error) {
+	if err := options.Validate(); err != nil {
+		return models.ConnectorsExport{}, handleEngineErrors(err)
+	}
+
+	connectors, err := s.listInstalledConnectors(ctx)
+	if err != nil {
+		return models.ConnectorsExport{}, err
+	}
+
+	export := models.ConnectorsExport{
+		Version:    models.ConnectorsExportVersion,
+		ExportedAt: time.Now().UTC(),
+		Secrets:    options.Secrets,
+		Connectors: make([]models.ConnectorExport, 0, len(connectors)),
+		Pools:      []models.PoolExport{},
+	}
+
+	connectorNames := make(map[string]string, len(connectors))
+	for _, connector := range connectors {
+		connectorNames[connector.ID.String()] = connector.Name
+
+		config, err := s.exportConnectorConfig(ctx, connector.Provider, connector.Config, options)
+		if err != nil {
+			return models.ConnectorsExport{}, fmt.Errorf("failed to export config of connector %q: %w", connector.Name, err)
+		}
+
+		webhooksConfigs, err := s.storage.WebhooksConfigsGetFromConnectorID(ctx, connector.ID)
+		if err != nil {
+			return models.ConnectorsExport{}, newStorageError(err, "cannot get webhooks configs")
+		}
+
+		c := models.ConnectorExport{
+			Name:     connector.Name,
+			Provider: connector.Provider,
+			Config:   config,
+		}
+		for _, webhookConfig := range webhooksConfigs {
+			metadata, err := s.exportWebhookConfigMetadata(ctx, webhookConfig.Metadata, options)
+			if err != nil {
+				return models.ConnectorsExport{}, fmt.Errorf("failed to export webhooks config %q of connector %q: %w", webhookConfig.Name, connector.Name, err)
+			}
+
+			c.WebhooksConfigs = append(c.WebhooksConfigs, models.WebhookConfigExport{
+				Name:     webhookConfig.Name,
+				URLPath:  webhookConfig.URLPath,
+				Metadata: metadata,
+			})
+		}
+		export.Connectors = append(export.Connectors, c)
+	}
+
+	pools, err := s.listPools(ctx)
+	if err != nil {
+		return models.ConnectorsExport{}, err
+	}
+
+	for _, pool := range pools {
+		p := models.PoolExport{
+			Name: pool.Name,
+			Type: pool.Type,
+		}
+
+		switch pool.Type {
+		case models.POOL_TYPE_DYNAMIC:
+			p.Query = pool.Query
+		default:
+			p.Type = models.POOL_TYPE_STATIC
+			for _, accountID := range pool.PoolAccounts {
+				name, ok := connectorNames[accountID.ConnectorID.String()]
+				if !ok {
+					// The connector of the account is being uninstalled
+					continue
+				}
+				p.Accounts = append(p.Accounts, models.PoolAccountExport{
+					Connector: name,
+					Reference: accountID.Reference,
+				})
+			}
+		}
+		export.Pools = append(export.Pools, p)
+	}
+
+	return export, nil
+}
+
+// exportConnectorConfig redacts or encrypts the secrets of a connector config
+// depending on the export options.
+func (s *Service) exportConnectorConfig(ctx context.Context, provider string, rawConfig json.RawMessage, options models.ConnectorsExportOptions) (json.RawMessage, error) {
+	if options.Secrets == models.CONNECTORS_EXPORT_SECRETS_PLAIN {
+		return rawConfig, nil
+	}
+
+	sensitive, err := sensitiveConfigFields(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	var config map[string]json.RawMessage
+	if err := json.Unmarshal(rawConfig, &config); err != nil {
+		return nil, err
+	}
+
+	for field, value := range config {
+		if _, ok := sensitive[field]; !ok || isEmptyConfigValue(value) {
+			continue
+		}
+
+		switch options.Secrets {
+		case models.CONNECTORS_EXPORT_SECRETS_REDACTED:
+			config[field] = json.RawMessage(fmt.Sprintf("%q", models.ConnectorsExportRedactedValue))
+		case models.CONNECTORS_EXPORT_SECRETS_ENCRYPTED:
+			encrypted, err := s.storage.EncryptRawWithKey(ctx, value, options.EncryptionKey)
+			if err != nil {
+				return nil, newStorageError(err, "cannot encrypt secret")
+			}
+			config[field] = encrypted
+		}
+	}
+
+	return json.Marshal(config)
+}
+
+// exportWebhookConfigMetadata redacts or encrypts the metadata of a webhooks
+// config depending on the export options. Plugins keep the secrets shared
+// with the PSP there, so every value is handled as a secret.
+func (s *Service) exportWebhookConfigMetadata(ctx context.Context, metadata map[string]string, options models.ConnectorsExportOptions) (map[string]string, error) {
+	if options.Secrets == models.CONNECTORS_EXPORT_SECRETS_PLAIN || len(metadata) == 0 {
+		return metadata, nil
+	}
+
+	res := make(map[string]string, len(metadata))
+	for key, value := range metadata {
+		switch options.Secrets {
+		case models.CONNECTORS_EXPORT_SECRETS_REDACTED:
+			res[key] = models.ConnectorsExportRedactedValue
+		case models.CONNECTORS_EXPORT_SECRETS_ENCRYPTED:
+			raw, err := json.Marshal(value)
+			if err != nil {
+				return nil, err
+			}
+
+			encrypted, err := s.storage.EncryptRawWithKey(ctx, raw, options.EncryptionKey)
+			if err != nil {
+				return nil, newStorageError(err, "cannot encrypt secret")
+			}
+
+			if err := json.Unmarshal(encrypted, &value); err != nil {
+				return nil, err
+			}
+			res[key] = value
+		}
+	}
+
+	return res, nil
+}
+
+// sensitiveConfigFields returns the config fields the plugin of the provider
+// flags as sensitive.
+func sensitiveConfigFields(provider string) (map[string]struct{}, error) {
+	config, err := registry.GetConfig(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]struct{})
+	for field, parameter := range config {
+		if parameter.Sensitive {
+			res[field] = struct{}{}
+		}
+	}
+	return res, nil
+}
+
+func isEmptyConfigValue(value json.RawMessage) bool {
+	switch string(value) {
+	case "", "null", `""`:
+		return true
+	default:
+		return false
+	}
+}
+
+// listInstalledConnectors lists every connector which is not being
+// uninstalled.
+func (s *Service) listInstalledConnectors(ctx context.Context) ([]models.Connector, error) {
+	var res []models.Connector
+	query := storage.NewListConnectorsQuery(
+		paginate.NewPaginatedQueryOptions(storage.ConnectorQuery{}).
+			WithPageSize(100),
+	)
+
+	for {
+		cursor, err := s.storage.ConnectorsList(ctx, query)
+		if err != nil {
+			return nil, newStorageError(err, "cannot list connectors")
+		}
+
+		for _, connector := range cursor.Data {
+			if !connector.ScheduledForDeletion {
+				res = append(res, connector)
+			}
+		}
+
+		if !cursor.HasMore {
+			break
+		}
+
+		if err := paginate.UnmarshalCursor(cursor.Next, &query); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+func (s *Service) listPools(ctx context.Context) ([]models.Pool, error) {
+	var res []models.Pool
+	query := storage.NewListPoolsQuery(
+		paginate.NewPaginatedQueryOptions(storage.PoolQuery{}).
+			WithPageSize(100),
+	)
+
+	for {
+		cursor, err := s.storage.PoolsList(ctx, query)
+		if err != nil {
+			return nil, newStorageError(err, "cannot list pools")
+		}
+
+		res = append(res, cursor.Data...)
+
+		if !cursor.HasMore {
+			break
+		}
+
+		if err := paginate.UnmarshalCursor(cursor.Next, &query); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,177 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/formancehq/go-libs/v5/pkg/storage/bun/paginate"
+	"github.com/formancehq/payments/internal/connectors/engine"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestConnectorsExport(t *testing.T) {
+	t.Parallel()
+
+	connectorID := models.ConnectorID{Reference: uuid.New(), Provider: "stripe"}
+	deletedConnectorID := models.ConnectorID{Reference: uuid.New(), Provider: "wise"}
+
+	connectors := &paginate.Cursor[models.Connector]{
+		Data: []models.Connector{
+			{
+				ConnectorBase: models.ConnectorBase{ID: connectorID, Name: "stripe-eu", Provider: "stripe"},
+				Config:        json.RawMessage(`{"apiKey":"sk_test","name":"stripe-eu","webhookSecret":"","pollingPeriod":"30m"}`),
+			},
+			{
+				ConnectorBase:        models.ConnectorBase{ID: deletedConnectorID, Name: "wise", Provider: "wise"},
+				Config:               json.RawMessage(`{"apiKey":"key","name":"wise"}`),
+				ScheduledForDeletion: true,
+			},
+		},
+	}
+	pools := &paginate.Cursor[models.Pool]{
+		Data: []models.Pool{
+			{
+				Name: "treasury",
+				Type: models.POOL_TYPE_STATIC,
+				PoolAccounts: []models.AccountID{
+					{Reference: "acc_1", ConnectorID: connectorID},
+					{Reference: "acc_2", ConnectorID: deletedConnectorID},
+				},
+			},
+			{
+				Name:  "eur",
+				Type:  models.POOL_TYPE_DYNAMIC,
+				Query: map[string]any{"$match": map[string]any{"default_asset": "EUR/2"}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		options        models.ConnectorsExportOptions
+		listErr        error
+		expectedConfig string
+		expectedSecret string
+		expectedError  error
+		typedError     bool
+	}{
+		{
+			name:           "plain secrets",
+			options:        models.ConnectorsExportOptions{Secrets: models.CONNECTORS_EXPORT_SECRETS_PLAIN},
+			expectedConfig: `{"apiKey":"sk_test","name":"stripe-eu","webhookSecret":"","pollingPeriod":"30m"}`,
+			expectedSecret: "whsec",
+		},
+		{
+			name:           "redacted secrets",
+			options:        models.ConnectorsExportOptions{Secrets: models.CONNECTORS_EXPORT_SECRETS_REDACTED},
+			expectedConfig: `{"apiKey":"<redacted>","name":"stripe-eu","webhookSecret":"","pollingPeriod":"30m"}`,
+			expectedSecret: "<redacted>",
+		},
+		{
+			name:           "encrypted secrets",
+			options:        models.ConnectorsExportOptions{Secrets: models.CONNECTORS_EXPORT_SECRETS_ENCRYPTED, EncryptionKey: "target"},
+			expectedConfig: `{"apiKey":"encrypted","name":"stripe-eu","webhookSecret":"","pollingPeriod":"30m"}`,
+			expectedSecret: "encrypted_secret",
+		},
+		{
+			name:          "missing encryption key",
+			options:       models.ConnectorsExportOptions{Secrets: models.CONNECTORS_EXPORT_SECRETS_ENCRYPTED},
+			expectedError: ErrValidation,
+			typedError:    true,
+		},
+		{
+			name:          "storage error",
+			options:       models.ConnectorsExportOptions{Secrets: models.CONNECTORS_EXPORT_SECRETS_REDACTED},
+			listErr:       fmt.Errorf("error"),
+			expectedError: newStorageError(fmt.Errorf("error"), "cannot list connectors"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := storage.NewMockStorage(ctrl)
+			eng := engine.NewMockEngine(ctrl)
+			s := New(store, eng, false)
+
+			if test.expectedError == nil || test.listErr != nil {
+				store.EXPECT().ConnectorsList(gomock.Any(), gomock.Any()).Return(connectors, test.listErr)
+			}
+			if test.expectedError == nil {
+				store.EXPECT().WebhooksConfigsGetFromConnectorID(gomock.Any(), connectorID).Return([]models.WebhookConfig{
+					{Name: "payments", ConnectorID: connectorID, URLPath: "/payments", Metadata: map[string]string{"secret": "whsec"}},
+				}, nil)
+				if test.options.Secrets == models.CONNECTORS_EXPORT_SECRETS_ENCRYPTED {
+					store.EXPECT().EncryptRawWithKey(gomock.Any(), json.RawMessage(`"sk_test"`), "target").Return(json.RawMessage(`"encrypted"`), nil)
+					store.EXPECT().EncryptRawWithKey(gomock.Any(), json.RawMessage(`"whsec"`), "target").Return(json.RawMessage(`"encrypted_secret"`), nil)
+				}
+				store.EXPECT().PoolsList(gomock.Any(), gomock.Any()).Return(pools, nil)
+			}
+
+			export, err := s.ConnectorsExport(context.Background(), test.options)
+			if test.expectedError != nil {
+				if test.typedError {
+					require.ErrorIs(t, err, test.expectedError)
+				} else {
+					require.Equal(t, test.expectedError, err)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, models.ConnectorsExportVersion, export.Version)
+			require.Equal(t, test.options.Secrets, export.Secrets)
+			require.Len(t, export.Connectors, 1)
+			require.Equal(t, "stripe-eu", export.Connectors[0].Name)
+			require.JSONEq(t, test.expectedConfig, string(export.Connectors[0].Config))
+			require.Equal(t, []models.WebhookConfigExport{
+				{Name: "payments", URLPath: "/payments", Metadata: map[string]string{"secret": test.expectedSecret}},
+			}, export.Connectors[0].WebhooksConfigs)
+			require.Equal(t, []models.PoolExport{
+				{
+					Name:     "treasury",
+					Type:     models.POOL_TYPE_STATIC,
+					Accounts: []models.PoolAccountExport{{Connector: "stripe-eu", Reference: "acc_1"}},
+				},
+				{
+					Name:  "eur",
+					Type:  models.POOL_TYPE_DYNAMIC,
+					Query: map[string]any{"$match": map[string]any{"default_asset": "EUR/2"}},
+				},
+			}, export.Pools)
+		})
+	}
+}
+
+func TestConnectorsExportSensitiveFields(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	store := storage.NewMockStorage(ctrl)
+	eng := engine.NewMockEngine(ctrl)
+	s := New(store, eng, false)
+
+	// Only the fields flagged by the plugin are secrets: the wise webhook
+	// public key is exported as is.
+	store.EXPECT().ConnectorsList(gomock.Any(), gomock.Any()).Return(&paginate.Cursor[models.Connector]{
+		Data: []models.Connector{
+			{
+				ConnectorBase: models.ConnectorBase{ID: models.ConnectorID{Reference: uuid.New(), Provider: "wise"}, Name: "wise", Provider: "wise"},
+				Config:        json.RawMessage(`{"apiKey":"key","name":"wise","webhookPublicKey":"pem"}`),
+			},
+		},
+	}, nil)
+	store.EXPECT().WebhooksConfigsGetFromConnectorID(gomock.Any(), gomock.Any()).Return(nil, nil)
+	store.EXPECT().PoolsList(gomock.Any(), gomock.Any()).Return(&paginate.Cursor[models.Pool]{}, nil)
+
+	export, err := s.ConnectorsExport(context.Background(), models.ConnectorsExportOptions{Secrets: models.CONNECTORS_EXPORT_SECRETS_REDACTED})
+	require.NoError(t, err)
+	require.Len(t, export.Connectors, 1)
+	require.JSONEq(t, `{"apiKey":"<redacted>","name":"wise","webhookPublicKey":"pem"}`, string(export.Connectors[0].Config))
+}
@@ -0,0 +1,19 @@
+package services
+
+import (
+	"context"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+)
+
+// Number of failed workflow instances returned along with the connector health.
+const connectorHealthRecentErrorsLimit = 10
+
+func (s *Service) ConnectorsHealth(ctx context.Context, connectorID models.ConnectorID) (*models.ConnectorHealth, error) {
+	health, err := s.storage.ConnectorHealthGet(ctx, connectorID, connectorHealthRecentErrorsLimit)
+	if err != nil {
+		return nil, newStorageError(err, "cannot get connector health")
+	}
+
+	return health, nil
+}
@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/formancehq/payments/internal/connectors/engine"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestConnectorsHealth(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := storage.NewMockStorage(ctrl)
+	eng := engine.NewMockEngine(ctrl)
+
+	s := New(store, eng, false)
+
+	tests := []struct {
+		name          string
+		err           error
+		expectedError error
+	}{
+		{
+			name:          "success",
+			err:           nil,
+			expectedError: nil,
+		},
+		{
+			name:          "storage error not found",
+			err:           storage.ErrNotFound,
+			expectedError: newStorageError(storage.ErrNotFound, "cannot get connector health"),
+		},
+		{
+			name:          "other error",
+			err:           fmt.Errorf("error"),
+			expectedError: newStorageError(fmt.Errorf("error"), "cannot get connector health"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			store.EXPECT().ConnectorHealthGet(gomock.Any(), models.ConnectorID{}, connectorHealthRecentErrorsLimit).Return(&models.ConnectorHealth{}, test.err)
+			health, err := s.ConnectorsHealth(context.Background(), models.ConnectorID{})
+			if test.expectedError == nil {
+				require.NotNil(t, health)
+				require.NoError(t, err)
+			} else {
+				require.Equal(t, test.expectedError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,574 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+)
+
+type connectorImport struct {
+	change models.ConnectorImportChange
+	config json.RawMessage
+	// Webhooks configs of the document missing on the existing connector
+	webhooksConfigs []models.WebhookConfig
+}
+
+type poolImport struct {
+	change   models.PoolImportChange
+	pool     models.Pool
+	accounts []models.AccountID
+	query    map[string]any
+}
+
+// ConnectorsImport computes what importing the document changes on this
+// environment and applies it, unless dryRun is set. Objects are matched by
+// name: missing ones are created, existing ones are updated, and objects of
+// the environment missing from the document are left untouched. Applying the
+// same document twice is a no-op.
+func (s *Service) ConnectorsImport(ctx context.Context, document models.ConnectorsExport, dryRun bool) (models.ConnectorsImportPlan, error) {
+	if err := document.Validate(); err != nil {
+		return models.ConnectorsImportPlan{}, handleEngineErrors(err)
+	}
+
+	connectors, err := s.planConnectorsImport(ctx, document)
+	if err != nil {
+		return models.ConnectorsImportPlan{}, err
+	}
+
+	pools, err := s.planPoolsImport(ctx, document, connectors)
+	if err != nil {
+		return models.ConnectorsImportPlan{}, err
+	}
+
+	if !dryRun {
+		if err := s.applyConnectorsImport(ctx, connectors); err != nil {
+			return models.ConnectorsImportPlan{}, err
+		}
+
+		if err := s.applyPoolsImport(ctx, pools); err != nil {
+			return models.ConnectorsImportPlan{}, err
+		}
+	}
+
+	plan := models.ConnectorsImportPlan{
+		Applied:    !dryRun,
+		Connectors: make([]models.ConnectorImportChange, 0, len(connectors)),
+		Pools:      make([]models.PoolImportChange, 0, len(pools)),
+	}
+	for _, c := range connectors {
+		plan.Connectors = append(plan.Connectors, c.change)
+	}
+	for _, p := range pools {
+		plan.Pools = append(plan.Pools, p.change)
+	}
+
+	return plan, nil
+}
+
+func (s *Service) planConnectorsImport(ctx context.Context, document models.ConnectorsExport) ([]*connectorImport, error) {
+	installed, err := s.listInstalledConnectors(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]models.Connector, len(installed))
+	for _, connector := range installed {
+		byName[connector.Name] = connector
+	}
+
+	res := make([]*connectorImport, 0, len(document.Connectors))
+	for _, c := range document.Connectors {
+		imp := &connectorImport{
+			change: models.ConnectorImportChange{
+				Name:     c.Name,
+				Provider: c.Provider,
+			},
+		}
+		res = append(res, imp)
+
+		var existing *models.Connector
+		if connector, ok := byName[c.Name]; ok {
+			existing = &connector
+			imp.change.ConnectorID = &connector.ID
+		}
+
+		if existing != nil && !strings.EqualFold(existing.Provider, c.Provider) {
+			imp.change.Action = models.CONNECTORS_IMPORT_ACTION_SKIP
+			imp.change.Reason = fmt.Sprintf("connector already exists with provider %s", existing.Provider)
+			continue
+		}
+
+		sensitive, err := sensitiveConfigFields(c.Provider)
+		if err != nil {
+			imp.change.Action = models.CONNECTORS_IMPORT_ACTION_SKIP
+			imp.change.Reason = fmt.Sprintf("unknown provider %s", c.Provider)
+			continue
+		}
+
+		config, redacted, err := s.importConnectorConfig(ctx, c.Config, document.Secrets, sensitive, existing)
+		if err != nil {
+			return nil, handleEngineErrors(fmt.Errorf("failed to import config of connector %q: %w", c.Name, err))
+		}
+
+		if len(redacted) > 0 {
+			imp.change.Action = models.CONNECTORS_IMPORT_ACTION_SKIP
+			imp.change.Reason = fmt.Sprintf("missing redacted secrets: %s", strings.Join(redacted, ", "))
+			continue
+		}
+		imp.config = config
+
+		if existing == nil {
+			imp.change.Action = models.CONNECTORS_IMPORT_ACTION_CREATE
+			continue
+		}
+
+		// The stored config went through the plugin validation, compare it
+		// with the desired one once normalized the same way.
+		desired, err := s.engine.ValidateConnectorConfig(ctx, c.Provider, config)
+		if err != nil {
+			return nil, handleEngineErrors(fmt.Errorf("invalid config of connector %q: %w", c.Name, err))
+		}
+
+		changes, err := configChanges(existing.Config, desired)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compare config of connector %q: %w", c.Name, err)
+		}
+
+		webhooksConfigs, redacted, err := s.importWebhooksConfigs(ctx, c.WebhooksConfigs, document.Secrets, existing.ID)
+		if err != nil {
+			return nil, handleEngineErrors(fmt.Errorf("failed to import webhooks configs of connector %q: %w", c.Name, err))
+		}
+
+		if len(redacted) > 0 {
+			imp.change.Action = models.CONNECTORS_IMPORT_ACTION_SKIP
+			imp.change.Reason = fmt.Sprintf("missing redacted secrets of webhooks configs: %s", strings.Join(redacted, ", "))
+			continue
+		}
+		imp.webhooksConfigs = webhooksConfigs
+		if len(webhooksConfigs) > 0 {
+			changes = append(changes, "webhooksConfigs")
+			sort.Strings(changes)
+		}
+
+		imp.change.Changes = changes
+		imp.change.Action = models.CONNECTORS_IMPORT_ACTION_UNCHANGED
+		if len(changes) > 0 {
+			imp.change.Action = models.CONNECTORS_IMPORT_ACTION_UPDATE
+		}
+	}
+
+	return res, nil
+}
+
+// importConnectorConfig resolves the secrets of an imported config: encrypted
+// secrets are decrypted with the environment key, and redacted secrets are
+// taken from the existing connector. The secrets which cannot be resolved
+// are returned.
+func (s *Service) importConnectorConfig(
+	ctx context.Context,
+	rawConfig json.RawMessage,
+	secrets models.ConnectorsExportSecrets,
+	sensitive map[string]struct{},
+	existing *models.Connector,
+) (json.RawMessage, []string, error) {
+	if secrets == models.CONNECTORS_EXPORT_SECRETS_PLAIN {
+		return rawConfig, nil, nil
+	}
+
+	var config map[string]json.RawMessage
+	if err := json.Unmarshal(rawConfig, &config); err != nil {
+		return nil, nil, err
+	}
+
+	var existingConfig map[string]json.RawMessage
+	if existing != nil {
+		if err := json.Unmarshal(existing.Config, &existingConfig); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var redacted []string
+	for field, value := range config {
+		if _, ok := sensitive[field]; !ok || isEmptyConfigValue(value) {
+			continue
+		}
+
+		switch secrets {
+		case models.CONNECTORS_EXPORT_SECRETS_REDACTED:
+			var v string
+			if err := json.Unmarshal(value, &v); err != nil || v != models.ConnectorsExportRedactedValue {
+				continue
+			}
+
+			if existingValue, ok := existingConfig[field]; ok && !isEmptyConfigValue(existingValue) {
+				config[field] = existingValue
+				continue
+			}
+			redacted = append(redacted, field)
+
+		case models.CONNECTORS_EXPORT_SECRETS_ENCRYPTED:
+			decrypted, err := s.storage.DecryptRaw(ctx, value)
+			if err != nil {
+				if errors.Is(err, storage.ErrNotEncrypted) {
+					return nil, nil, fmt.Errorf("secret %s is not encrypted: %w", field, models.ErrValidation)
+				}
+				return nil, nil, fmt.Errorf("cannot decrypt secret %s, it must be encrypted with the config encryption key of this environment: %w", field, models.ErrValidation)
+			}
+			config[field] = decrypted
+		}
+	}
+	sort.Strings(redacted)
+
+	res, err := json.Marshal(config)
+	if err != nil {
+		return nil, nil, err
+	}
+	return res, redacted, nil
+}
+
+// importWebhooksConfigs returns the webhooks configs of the document missing
+// on the existing connector, with their metadata resolved. The webhooks
+// configs the connector already has are left untouched since they match its
+// own registration at the PSP, for the same reason the ones of a connector
+// created by the import are registered by its installation, and the document
+// only fills the gaps on the next import. The webhooks configs whose redacted
+// metadata cannot be resolved are returned.
+func (s *Service) importWebhooksConfigs(
+	ctx context.Context,
+	webhooksConfigs []models.WebhookConfigExport,
+	secrets models.ConnectorsExportSecrets,
+	connectorID models.ConnectorID,
+) ([]models.WebhookConfig, []string, error) {
+	if len(webhooksConfigs) == 0 {
+		return nil, nil, nil
+	}
+
+	existing, err := s.storage.WebhooksConfigsGetFromConnectorID(ctx, connectorID)
+	if err != nil {
+		return nil, nil, newStorageError(err, "cannot get webhooks configs")
+	}
+
+	names := make(map[string]struct{}, len(existing))
+	for _, webhookConfig := range existing {
+		names[webhookConfig.Name] = struct{}{}
+	}
+
+	var res []models.WebhookConfig
+	var redacted []string
+	for _, w := range webhooksConfigs {
+		if _, ok := names[w.Name]; ok {
+			continue
+		}
+
+		webhookConfig := models.WebhookConfig{
+			Name:        w.Name,
+			ConnectorID: connectorID,
+			URLPath:     w.URLPath,
+			Metadata:    make(map[string]string, len(w.Metadata)),
+		}
+
+		isRedacted := false
+		for key, value := range w.Metadata {
+			switch secrets {
+			case models.CONNECTORS_EXPORT_SECRETS_REDACTED:
+				if value == models.ConnectorsExportRedactedValue {
+					isRedacted = true
+				}
+
+			case models.CONNECTORS_EXPORT_SECRETS_ENCRYPTED:
+				raw, err := json.Marshal(value)
+				if err != nil {
+					return nil, nil, err
+				}
+
+				decrypted, err := s.storage.DecryptRaw(ctx, raw)
+				if err != nil {
+					return nil, nil, fmt.Errorf("cannot decrypt metadata %s of webhooks config %s, it must be encrypted with the config encryption key of this environment: %w", key, w.Name, models.ErrValidation)
+				}
+
+				if err := json.Unmarshal(decrypted, &value); err != nil {
+					return nil, nil, fmt.Errorf("invalid metadata %s of webhooks config %s: %w", key, w.Name, models.ErrValidation)
+				}
+			}
+			webhookConfig.Metadata[key] = value
+		}
+
+		if isRedacted {
+			redacted = append(redacted, w.Name)
+			continue
+		}
+		res = append(res, webhookConfig)
+	}
+	sort.Strings(redacted)
+
+	return res, redacted, nil
+}
+
+// configChanges returns the names of the config fields which differ between
+// the two configs.
+func configChanges(from, to json.RawMessage) ([]string, error) {
+	var fromConfig, toConfig map[string]any
+	if err := json.Unmarshal(from, &fromConfig); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(to, &toConfig); err != nil {
+		return nil, err
+	}
+
+	var changes []string
+	for field, value := range toConfig {
+		if !reflect.DeepEqual(fromConfig[field], value) {
+			changes = append(changes, field)
+		}
+	}
+	for field := range fromConfig {
+		if _, ok := toConfig[field]; !ok {
+			changes = append(changes, field)
+		}
+	}
+	sort.Strings(changes)
+
+	return changes, nil
+}
+
+func (s *Service) planPoolsImport(ctx context.Context, document models.ConnectorsExport, connectors []*connectorImport) ([]*poolImport, error) {
+	existingPools, err := s.listPools(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]models.Pool, len(existingPools))
+	for _, pool := range existingPools {
+		byName[pool.Name] = pool
+	}
+
+	connectorIDs := make(map[string]models.ConnectorID, len(connectors))
+	for _, c := range connectors {
+		if c.change.ConnectorID != nil {
+			connectorIDs[c.change.Name] = *c.change.ConnectorID
+		}
+	}
+
+	res := make([]*poolImport, 0, len(document.Pools))
+	for _, p := range document.Pools {
+		imp := &poolImport{
+			change: models.PoolImportChange{
+				Name: p.Name,
+			},
+		}
+		res = append(res, imp)
+
+		existing, exists := byName[p.Name]
+		if exists {
+			imp.change.PoolID = &existing.ID
+		}
+
+		if exists && existing.Type != p.Type {
+			imp.change.Action = models.CONNECTORS_IMPORT_ACTION_SKIP
+			imp.change.Reason = fmt.Sprintf("pool already exists with type %s", existing.Type)
+			continue
+		}
+
+		if p.Type == models.POOL_TYPE_DYNAMIC {
+			imp.query = p.Query
+			switch {
+			case !exists:
+				imp.change.Action = models.CONNECTORS_IMPORT_ACTION_CREATE
+			case reflect.DeepEqual(normalizeQuery(existing.Query), normalizeQuery(p.Query)):
+				imp.change.Action = models.CONNECTORS_IMPORT_ACTION_UNCHANGED
+			default:
+				imp.change.Action = models.CONNECTORS_IMPORT_ACTION_UPDATE
+				imp.change.Changes = []string{"query"}
+			}
+		} else {
+			accounts, reason, err := s.resolvePoolAccounts(ctx, p.Accounts, connectorIDs)
+			if err != nil {
+				return nil, err
+			}
+			if reason != "" {
+				imp.change.Action = models.CONNECTORS_IMPORT_ACTION_SKIP
+				imp.change.Reason = reason
+				continue
+			}
+
+			imp.accounts = accounts
+			switch {
+			case !exists:
+				imp.change.Action = models.CONNECTORS_IMPORT_ACTION_CREATE
+			case sameAccounts(existing.PoolAccounts, accounts):
+				imp.change.Action = models.CONNECTORS_IMPORT_ACTION_UNCHANGED
+			default:
+				imp.change.Action = models.CONNECTORS_IMPORT_ACTION_UPDATE
+				imp.change.Changes = []string{"accounts"}
+			}
+		}
+
+		if exists {
+			imp.pool = existing
+		}
+	}
+
+	return res, nil
+}
+
+// resolvePoolAccounts finds the accounts of a static pool on this environment.
+// Accounts are only known once fetched by their connector, the reason why
+// they cannot be resolved is returned otherwise.
+func (s *Service) resolvePoolAccounts(ctx context.Context, accounts []models.PoolAccountExport, connectorIDs map[string]models.ConnectorID) ([]models.AccountID, string, error) {
+	res := make([]models.AccountID, 0, len(accounts))
+	for _, a := range accounts {
+		connectorID, ok := connectorIDs[a.Connector]
+		if !ok {
+			return nil, fmt.Sprintf("connector %s is not installed yet, import again once its accounts are fetched", a.Connector), nil
+		}
+
+		accountID := models.AccountID{
+			Reference:   a.Reference,
+			ConnectorID: connectorID,
+		}
+		if _, err := s.storage.AccountsGet(ctx, accountID); err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				return nil, fmt.Sprintf("account %s of connector %s is not fetched yet", a.Reference, a.Connector), nil
+			}
+			return nil, "", newStorageError(err, "cannot get account")
+		}
+
+		res = append(res, accountID)
+	}
+
+	return res, "", nil
+}
+
+func sameAccounts(a, b []models.AccountID) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	ids := make(map[string]struct{}, len(a))
+	for _, id := range a {
+		ids[id.String()] = struct{}{}
+	}
+	for _, id := range b {
+		if _, ok := ids[id.String()]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeQuery makes queries comparable whatever their origin, numbers
+// being float64 once decoded from JSON.
+func normalizeQuery(query map[string]any) map[string]any {
+	data, err := json.Marshal(query)
+	if err != nil {
+		return query
+	}
+
+	var res map[string]any
+	if err := json.Unmarshal(data, &res); err != nil {
+		return query
+	}
+	return res
+}
+
+func (s *Service) applyConnectorsImport(ctx context.Context, connectors []*connectorImport) error {
+	for _, c := range connectors {
+		switch c.change.Action {
+		case models.CONNECTORS_IMPORT_ACTION_CREATE:
+			connectorID, err := s.engine.InstallConnector(ctx, c.change.Provider, c.config)
+			if err != nil {
+				return fmt.Errorf("failed to install connector %q: %w", c.change.Name, handleEngineErrors(err))
+			}
+			c.change.ConnectorID = &connectorID
+
+		case models.CONNECTORS_IMPORT_ACTION_UPDATE:
+			if !slices.Equal(c.change.Changes, []string{"webhooksConfigs"}) {
+				if err := s.engine.UpdateConnector(ctx, *c.change.ConnectorID, c.config); err != nil {
+					return fmt.Errorf("failed to update connector %q: %w", c.change.Name, handleEngineErrors(err))
+				}
+			}
+
+			if len(c.webhooksConfigs) > 0 {
+				if err := s.storage.WebhooksConfigsUpsert(ctx, c.webhooksConfigs); err != nil {
+					return fmt.Errorf("failed to import webhooks configs of connector %q: %w", c.change.Name, newStorageError(err, "cannot upsert webhooks configs"))
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) applyPoolsImport(ctx context.Context, pools []*poolImport) error {
+	for _, p := range pools {
+		switch p.change.Action {
+		case models.CONNECTORS_IMPORT_ACTION_CREATE:
+			pool := models.Pool{
+				ID:           uuid.New(),
+				Name:         p.change.Name,
+				CreatedAt:    time.Now().UTC(),
+				Type:         models.POOL_TYPE_STATIC,
+				PoolAccounts: p.accounts,
+			}
+			if p.query != nil {
+				pool.Type = models.POOL_TYPE_DYNAMIC
+				pool.Query = p.query
+			}
+
+			if err := s.engine.CreatePool(ctx, pool); err != nil {
+				return fmt.Errorf("failed to create pool %q: %w", p.change.Name, handleEngineErrors(err))
+			}
+			p.change.PoolID = &pool.ID
+
+		case models.CONNECTORS_IMPORT_ACTION_UPDATE:
+			if err := s.updateImportedPool(ctx, p); err != nil {
+				return fmt.Errorf("failed to update pool %q: %w", p.change.Name, handleEngineErrors(err))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) updateImportedPool(ctx context.Context, p *poolImport) error {
+	if p.pool.Type == models.POOL_TYPE_DYNAMIC {
+		return s.engine.UpdatePoolQuery(ctx, p.pool.ID, p.query)
+	}
+
+	current := make(map[string]struct{}, len(p.pool.PoolAccounts))
+	for _, id := range p.pool.PoolAccounts {
+		current[id.String()] = struct{}{}
+	}
+
+	wanted := make(map[string]struct{}, len(p.accounts))
+	for _, id := range p.accounts {
+		wanted[id.String()] = struct{}{}
+		if _, ok := current[id.String()]; ok {
+			continue
+		}
+		if err := s.engine.AddAccountToPool(ctx, p.pool.ID, id); err != nil {
+			return err
+		}
+	}
+
+	for _, id := range p.pool.PoolAccounts {
+		if _, ok := wanted[id.String()]; ok {
+			continue
+		}
+		if err := s.engine.RemoveAccountFromPool(ctx, p.pool.ID, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,327 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/formancehq/go-libs/v5/pkg/storage/bun/paginate"
+	"github.com/formancehq/payments/internal/connectors/engine"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestConnectorsImport(t *testing.T) {
+	t.Parallel()
+
+	existingID := models.ConnectorID{Reference: uuid.New(), Provider: "stripe"}
+	adyenID := models.ConnectorID{Reference: uuid.New(), Provider: "adyen"}
+	newID := models.ConnectorID{Reference: uuid.New(), Provider: "generic"}
+	dynamicPoolID := uuid.New()
+
+	installed := &paginate.Cursor[models.Connector]{
+		Data: []models.Connector{
+			{
+				ConnectorBase: models.ConnectorBase{ID: existingID, Name: "stripe-eu", Provider: "stripe"},
+				Config:        json.RawMessage(`{"apiKey":"sk_live","name":"stripe-eu","pollingPeriod":"30m0s"}`),
+			},
+			{
+				ConnectorBase: models.ConnectorBase{ID: adyenID, Name: "psp", Provider: "adyen"},
+				Config:        json.RawMessage(`{"apiKey":"key","name":"psp"}`),
+			},
+		},
+	}
+	existingPools := &paginate.Cursor[models.Pool]{
+		Data: []models.Pool{
+			{
+				ID:    dynamicPoolID,
+				Name:  "eur",
+				Type:  models.POOL_TYPE_DYNAMIC,
+				Query: map[string]any{"$match": map[string]any{"default_asset": "USD/2"}},
+			},
+		},
+	}
+
+	document := models.ConnectorsExport{
+		Version: models.ConnectorsExportVersion,
+		Secrets: models.CONNECTORS_EXPORT_SECRETS_REDACTED,
+		Connectors: []models.ConnectorExport{
+			{Name: "stripe-eu", Provider: "stripe", Config: json.RawMessage(`{"apiKey":"<redacted>","name":"stripe-eu","pollingPeriod":"1h"}`)},
+			{Name: "psp", Provider: "stripe", Config: json.RawMessage(`{"apiKey":"<redacted>","name":"psp"}`)},
+			{Name: "wise", Provider: "wise", Config: json.RawMessage(`{"apiKey":"<redacted>","name":"wise"}`)},
+			{Name: "generic", Provider: "generic", Config: json.RawMessage(`{"endpoint":"http://localhost","name":"generic"}`)},
+		},
+		Pools: []models.PoolExport{
+			{Name: "treasury", Type: models.POOL_TYPE_STATIC, Accounts: []models.PoolAccountExport{{Connector: "stripe-eu", Reference: "acc_1"}}},
+			{Name: "generic", Type: models.POOL_TYPE_STATIC, Accounts: []models.PoolAccountExport{{Connector: "generic", Reference: "acc_2"}}},
+			{Name: "eur", Type: models.POOL_TYPE_DYNAMIC, Query: map[string]any{"$match": map[string]any{"default_asset": "EUR/2"}}},
+		},
+	}
+
+	expectPlan := func(t *testing.T, plan models.ConnectorsImportPlan) {
+		require.Len(t, plan.Connectors, 4)
+
+		require.Equal(t, models.CONNECTORS_IMPORT_ACTION_UPDATE, plan.Connectors[0].Action)
+		require.Equal(t, []string{"pollingPeriod"}, plan.Connectors[0].Changes)
+		require.Equal(t, &existingID, plan.Connectors[0].ConnectorID)
+
+		require.Equal(t, models.CONNECTORS_IMPORT_ACTION_SKIP, plan.Connectors[1].Action)
+		require.Equal(t, "connector already exists with provider adyen", plan.Connectors[1].Reason)
+
+		require.Equal(t, models.CONNECTORS_IMPORT_ACTION_SKIP, plan.Connectors[2].Action)
+		require.Equal(t, "missing redacted secrets: apiKey", plan.Connectors[2].Reason)
+
+		require.Equal(t, models.CONNECTORS_IMPORT_ACTION_CREATE, plan.Connectors[3].Action)
+
+		require.Len(t, plan.Pools, 3)
+		require.Equal(t, models.CONNECTORS_IMPORT_ACTION_CREATE, plan.Pools[0].Action)
+		require.Equal(t, models.CONNECTORS_IMPORT_ACTION_SKIP, plan.Pools[1].Action)
+		require.Contains(t, plan.Pools[1].Reason, "connector generic is not installed yet")
+		require.Equal(t, models.CONNECTORS_IMPORT_ACTION_UPDATE, plan.Pools[2].Action)
+		require.Equal(t, []string{"query"}, plan.Pools[2].Changes)
+	}
+
+	t.Run("invalid document", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		s := New(storage.NewMockStorage(ctrl), engine.NewMockEngine(ctrl), false)
+
+		_, err := s.ConnectorsImport(context.Background(), models.ConnectorsExport{Version: 2}, true)
+		require.ErrorIs(t, err, ErrValidation)
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		store := storage.NewMockStorage(ctrl)
+		eng := engine.NewMockEngine(ctrl)
+		s := New(store, eng, false)
+
+		store.EXPECT().ConnectorsList(gomock.Any(), gomock.Any()).Return(installed, nil)
+		store.EXPECT().PoolsList(gomock.Any(), gomock.Any()).Return(existingPools, nil)
+		store.EXPECT().AccountsGet(gomock.Any(), models.AccountID{Reference: "acc_1", ConnectorID: existingID}).Return(&models.Account{}, nil)
+		eng.EXPECT().ValidateConnectorConfig(gomock.Any(), "stripe", gomock.Any()).
+			Return(json.RawMessage(`{"apiKey":"sk_live","name":"stripe-eu","pollingPeriod":"1h0m0s"}`), nil)
+
+		plan, err := s.ConnectorsImport(context.Background(), document, true)
+		require.NoError(t, err)
+		require.False(t, plan.Applied)
+		expectPlan(t, plan)
+	})
+
+	t.Run("apply", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		store := storage.NewMockStorage(ctrl)
+		eng := engine.NewMockEngine(ctrl)
+		s := New(store, eng, false)
+
+		store.EXPECT().ConnectorsList(gomock.Any(), gomock.Any()).Return(installed, nil)
+		store.EXPECT().PoolsList(gomock.Any(), gomock.Any()).Return(existingPools, nil)
+		store.EXPECT().AccountsGet(gomock.Any(), models.AccountID{Reference: "acc_1", ConnectorID: existingID}).Return(&models.Account{}, nil)
+		eng.EXPECT().ValidateConnectorConfig(gomock.Any(), "stripe", gomock.Any()).
+			Return(json.RawMessage(`{"apiKey":"sk_live","name":"stripe-eu","pollingPeriod":"1h0m0s"}`), nil)
+
+		// Redacted secrets keep the value of the existing connector
+		eng.EXPECT().UpdateConnector(gomock.Any(), existingID, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ models.ConnectorID, config json.RawMessage) error {
+				require.JSONEq(t, `{"apiKey":"sk_live","name":"stripe-eu","pollingPeriod":"1h"}`, string(config))
+				return nil
+			},
+		)
+		eng.EXPECT().InstallConnector(gomock.Any(), "generic", gomock.Any()).Return(newID, nil)
+		eng.EXPECT().CreatePool(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, pool models.Pool) error {
+				require.Equal(t, "treasury", pool.Name)
+				require.Equal(t, models.POOL_TYPE_STATIC, pool.Type)
+				require.Equal(t, []models.AccountID{{Reference: "acc_1", ConnectorID: existingID}}, pool.PoolAccounts)
+				return nil
+			},
+		)
+		eng.EXPECT().UpdatePoolQuery(gomock.Any(), dynamicPoolID, document.Pools[2].Query).Return(nil)
+
+		plan, err := s.ConnectorsImport(context.Background(), document, false)
+		require.NoError(t, err)
+		require.True(t, plan.Applied)
+		expectPlan(t, plan)
+		require.Equal(t, &newID, plan.Connectors[3].ConnectorID)
+		require.NotNil(t, plan.Pools[0].PoolID)
+	})
+
+	t.Run("encrypted secrets", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		store := storage.NewMockStorage(ctrl)
+		eng := engine.NewMockEngine(ctrl)
+		s := New(store, eng, false)
+
+		store.EXPECT().ConnectorsList(gomock.Any(), gomock.Any()).Return(installed, nil)
+		store.EXPECT().PoolsList(gomock.Any(), gomock.Any()).Return(&paginate.Cursor[models.Pool]{}, nil)
+		store.EXPECT().DecryptRaw(gomock.Any(), json.RawMessage(`"encrypted"`)).Return(json.RawMessage(`"sk_live"`), nil)
+		// The stored config is normalized, so is the imported one before the comparison
+		eng.EXPECT().ValidateConnectorConfig(gomock.Any(), "stripe", json.RawMessage(`{"apiKey":"sk_live","name":"stripe-eu","pollingPeriod":"30m"}`)).
+			Return(json.RawMessage(`{"apiKey":"sk_live","name":"stripe-eu","pollingPeriod":"30m0s"}`), nil)
+
+		plan, err := s.ConnectorsImport(context.Background(), models.ConnectorsExport{
+			Version: models.ConnectorsExportVersion,
+			Secrets: models.CONNECTORS_EXPORT_SECRETS_ENCRYPTED,
+			Connectors: []models.ConnectorExport{
+				{Name: "stripe-eu", Provider: "stripe", Config: json.RawMessage(`{"apiKey":"encrypted","name":"stripe-eu","pollingPeriod":"30m"}`)},
+			},
+		}, true)
+		require.NoError(t, err)
+		require.Len(t, plan.Connectors, 1)
+		require.Equal(t, models.CONNECTORS_IMPORT_ACTION_UNCHANGED, plan.Connectors[0].Action)
+	})
+
+	t.Run("webhooks configs", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		store := storage.NewMockStorage(ctrl)
+		eng := engine.NewMockEngine(ctrl)
+		s := New(store, eng, false)
+
+		store.EXPECT().ConnectorsList(gomock.Any(), gomock.Any()).Return(installed, nil)
+		store.EXPECT().PoolsList(gomock.Any(), gomock.Any()).Return(&paginate.Cursor[models.Pool]{}, nil)
+		store.EXPECT().DecryptRaw(gomock.Any(), json.RawMessage(`"encrypted"`)).Return(json.RawMessage(`"sk_live"`), nil)
+		store.EXPECT().DecryptRaw(gomock.Any(), json.RawMessage(`"encrypted_secret"`)).Return(json.RawMessage(`"whsec"`), nil)
+		eng.EXPECT().ValidateConnectorConfig(gomock.Any(), "stripe", gomock.Any()).
+			Return(json.RawMessage(`{"apiKey":"sk_live","name":"stripe-eu","pollingPeriod":"30m0s"}`), nil)
+		store.EXPECT().WebhooksConfigsGetFromConnectorID(gomock.Any(), existingID).Return([]models.WebhookConfig{
+			{Name: "payments", ConnectorID: existingID, URLPath: "/payments", Metadata: map[string]string{"secret": "target"}},
+		}, nil)
+		// Only the missing webhooks config is stored, the connector config is
+		// left as is.
+		store.EXPECT().WebhooksConfigsUpsert(gomock.Any(), []models.WebhookConfig{
+			{Name: "refunds", ConnectorID: existingID, URLPath: "/refunds", Metadata: map[string]string{"secret": "whsec"}},
+		}).Return(nil)
+
+		plan, err := s.ConnectorsImport(context.Background(), models.ConnectorsExport{
+			Version: models.ConnectorsExportVersion,
+			Secrets: models.CONNECTORS_EXPORT_SECRETS_ENCRYPTED,
+			Connectors: []models.ConnectorExport{
+				{
+					Name:     "stripe-eu",
+					Provider: "stripe",
+					Config:   json.RawMessage(`{"apiKey":"encrypted","name":"stripe-eu","pollingPeriod":"30m"}`),
+					WebhooksConfigs: []models.WebhookConfigExport{
+						{Name: "payments", URLPath: "/payments", Metadata: map[string]string{"secret": "encrypted_source"}},
+						{Name: "refunds", URLPath: "/refunds", Metadata: map[string]string{"secret": "encrypted_secret"}},
+					},
+				},
+			},
+		}, false)
+		require.NoError(t, err)
+		require.Len(t, plan.Connectors, 1)
+		require.Equal(t, models.CONNECTORS_IMPORT_ACTION_UPDATE, plan.Connectors[0].Action)
+		require.Equal(t, []string{"webhooksConfigs"}, plan.Connectors[0].Changes)
+	})
+
+	t.Run("redacted webhooks configs metadata", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		store := storage.NewMockStorage(ctrl)
+		eng := engine.NewMockEngine(ctrl)
+		s := New(store, eng, false)
+
+		store.EXPECT().ConnectorsList(gomock.Any(), gomock.Any()).Return(installed, nil)
+		store.EXPECT().PoolsList(gomock.Any(), gomock.Any()).Return(&paginate.Cursor[models.Pool]{}, nil)
+		eng.EXPECT().ValidateConnectorConfig(gomock.Any(), "stripe", gomock.Any()).
+			Return(json.RawMessage(`{"apiKey":"sk_live","name":"stripe-eu","pollingPeriod":"30m0s"}`), nil)
+		store.EXPECT().WebhooksConfigsGetFromConnectorID(gomock.Any(), existingID).Return(nil, nil)
+
+		plan, err := s.ConnectorsImport(context.Background(), models.ConnectorsExport{
+			Version: models.ConnectorsExportVersion,
+			Secrets: models.CONNECTORS_EXPORT_SECRETS_REDACTED,
+			Connectors: []models.ConnectorExport{
+				{
+					Name:     "stripe-eu",
+					Provider: "stripe",
+					Config:   json.RawMessage(`{"apiKey":"<redacted>","name":"stripe-eu","pollingPeriod":"30m"}`),
+					WebhooksConfigs: []models.WebhookConfigExport{
+						{Name: "payments", URLPath: "/payments", Metadata: map[string]string{"secret": "<redacted>"}},
+					},
+				},
+			},
+		}, false)
+		require.NoError(t, err)
+		require.Len(t, plan.Connectors, 1)
+		require.Equal(t, models.CONNECTORS_IMPORT_ACTION_SKIP, plan.Connectors[0].Action)
+		require.Equal(t, "missing redacted secrets of webhooks configs: payments", plan.Connectors[0].Reason)
+	})
+
+	t.Run("secret encrypted with another key", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		store := storage.NewMockStorage(ctrl)
+		s := New(store, engine.NewMockEngine(ctrl), false)
+
+		store.EXPECT().ConnectorsList(gomock.Any(), gomock.Any()).Return(installed, nil)
+		store.EXPECT().DecryptRaw(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("wrong key"))
+
+		_, err := s.ConnectorsImport(context.Background(), models.ConnectorsExport{
+			Version: models.ConnectorsExportVersion,
+			Secrets: models.CONNECTORS_EXPORT_SECRETS_ENCRYPTED,
+			Connectors: []models.ConnectorExport{
+				{Name: "stripe-eu", Provider: "stripe", Config: json.RawMessage(`{"apiKey":"encrypted","name":"stripe-eu"}`)},
+			},
+		}, true)
+		require.ErrorIs(t, err, ErrValidation)
+	})
+
+	t.Run("unknown provider", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		store := storage.NewMockStorage(ctrl)
+		s := New(store, engine.NewMockEngine(ctrl), false)
+
+		store.EXPECT().ConnectorsList(gomock.Any(), gomock.Any()).Return(&paginate.Cursor[models.Connector]{}, nil)
+		store.EXPECT().PoolsList(gomock.Any(), gomock.Any()).Return(&paginate.Cursor[models.Pool]{}, nil)
+
+		plan, err := s.ConnectorsImport(context.Background(), models.ConnectorsExport{
+			Version: models.ConnectorsExportVersion,
+			Secrets: models.CONNECTORS_EXPORT_SECRETS_REDACTED,
+			Connectors: []models.ConnectorExport{
+				{Name: "unknown", Provider: "unknown", Config: json.RawMessage(`{"apiKey":"<redacted>","name":"unknown"}`)},
+			},
+		}, true)
+		require.NoError(t, err)
+		require.Len(t, plan.Connectors, 1)
+		require.Equal(t, models.CONNECTORS_IMPORT_ACTION_SKIP, plan.Connectors[0].Action)
+		require.Equal(t, "unknown provider unknown", plan.Connectors[0].Reason)
+	})
+
+	t.Run("engine error", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		store := storage.NewMockStorage(ctrl)
+		eng := engine.NewMockEngine(ctrl)
+		s := New(store, eng, false)
+
+		store.EXPECT().ConnectorsList(gomock.Any(), gomock.Any()).Return(&paginate.Cursor[models.Connector]{}, nil)
+		store.EXPECT().PoolsList(gomock.Any(), gomock.Any()).Return(&paginate.Cursor[models.Pool]{}, nil)
+		eng.EXPECT().InstallConnector(gomock.Any(), "generic", gomock.Any()).Return(models.ConnectorID{}, engine.ErrValidation)
+
+		_, err := s.ConnectorsImport(context.Background(), models.ConnectorsExport{
+			Version: models.ConnectorsExportVersion,
+			Secrets: models.CONNECTORS_EXPORT_SECRETS_PLAIN,
+			Connectors: []models.ConnectorExport{
+				{Name: "generic", Provider: "generic", Config: json.RawMessage(`{"name":"generic"}`)},
+			},
+		}, false)
+		require.ErrorIs(t, err, ErrValidation)
+	})
+}
@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+)
+
+// ConnectorsReconcile makes the installed connectors match the document:
+// missing connectors are installed and changed configs are updated, as with
+// ConnectorsImport. When prune is set, the installed connectors missing from
+// the document are uninstalled as well.
+func (s *Service) ConnectorsReconcile(ctx context.Context, document models.ConnectorsExport, prune bool) (models.ConnectorsImportPlan, error) {
+	if prune && len(document.Connectors) == 0 {
+		// Most likely a mistake, pruning would uninstall every connector
+		return models.ConnectorsImportPlan{}, fmt.Errorf("cannot prune connectors when none are declared: %w", ErrValidation)
+	}
+
+	plan, err := s.ConnectorsImport(ctx, document, false)
+	if err != nil {
+		return models.ConnectorsImportPlan{}, err
+	}
+
+	if !prune {
+		return plan, nil
+	}
+
+	declared := make(map[string]struct{}, len(document.Connectors))
+	for _, c := range document.Connectors {
+		declared[c.Name] = struct{}{}
+	}
+
+	installed, err := s.listInstalledConnectors(ctx)
+	if err != nil {
+		return models.ConnectorsImportPlan{}, err
+	}
+
+	for _, connector := range installed {
+		if _, ok := declared[connector.Name]; ok {
+			continue
+		}
+
+		if _, err := s.engine.UninstallConnector(ctx, connector.ID); err != nil {
+			return models.ConnectorsImportPlan{}, fmt.Errorf("failed to uninstall connector %q: %w", connector.Name, handleEngineErrors(err))
+		}
+
+		connectorID := connector.ID
+		plan.Connectors = append(plan.Connectors, models.ConnectorImportChange{
+			Name:        connector.Name,
+			Provider:    connector.Provider,
+			Action:      models.CONNECTORS_IMPORT_ACTION_UNINSTALL,
+			ConnectorID: &connectorID,
+		})
+	}
+
+	return plan, nil
+}
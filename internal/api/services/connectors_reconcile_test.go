@@ -0,0 +1,122 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/formancehq/go-libs/v5/pkg/storage/bun/paginate"
+	"github.com/formancehq/payments/internal/connectors/engine"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestConnectorsReconcile(t *testing.T) {
+	t.Parallel()
+
+	declaredID := models.ConnectorID{Reference: uuid.New(), Provider: "generic"}
+	removedID := models.ConnectorID{Reference: uuid.New(), Provider: "wise"}
+
+	installed := &paginate.Cursor[models.Connector]{
+		Data: []models.Connector{
+			{
+				ConnectorBase: models.ConnectorBase{ID: declaredID, Name: "generic", Provider: "generic"},
+				Config:        json.RawMessage(`{"endpoint":"http://localhost","name":"generic","pollingPeriod":"2m0s"}`),
+			},
+			{
+				ConnectorBase: models.ConnectorBase{ID: removedID, Name: "wise", Provider: "wise"},
+				Config:        json.RawMessage(`{"apiKey":"key","name":"wise"}`),
+			},
+		},
+	}
+
+	document := models.ConnectorsExport{
+		Version: models.ConnectorsExportVersion,
+		Secrets: models.CONNECTORS_EXPORT_SECRETS_PLAIN,
+		Connectors: []models.ConnectorExport{
+			{Name: "generic", Provider: "generic", Config: json.RawMessage(`{"endpoint":"http://localhost","name":"generic"}`)},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		document       models.ConnectorsExport
+		prune          bool
+		uninstallErr   error
+		expectedPlan   []models.ConnectorImportChange
+		expectedError  error
+		noStorageCalls bool
+	}{
+		{
+			name:     "without pruning",
+			document: document,
+			expectedPlan: []models.ConnectorImportChange{
+				{Name: "generic", Provider: "generic", Action: models.CONNECTORS_IMPORT_ACTION_UNCHANGED, ConnectorID: &declaredID},
+			},
+		},
+		{
+			name:     "with pruning",
+			document: document,
+			prune:    true,
+			expectedPlan: []models.ConnectorImportChange{
+				{Name: "generic", Provider: "generic", Action: models.CONNECTORS_IMPORT_ACTION_UNCHANGED, ConnectorID: &declaredID},
+				{Name: "wise", Provider: "wise", Action: models.CONNECTORS_IMPORT_ACTION_UNINSTALL, ConnectorID: &removedID},
+			},
+		},
+		{
+			name:          "uninstall error",
+			document:      document,
+			prune:         true,
+			uninstallErr:  engine.ErrNotFound,
+			expectedError: ErrNotFound,
+		},
+		{
+			name: "pruning without declared connectors",
+			document: models.ConnectorsExport{
+				Version: models.ConnectorsExportVersion,
+				Secrets: models.CONNECTORS_EXPORT_SECRETS_PLAIN,
+			},
+			prune:          true,
+			expectedError:  ErrValidation,
+			noStorageCalls: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			store := storage.NewMockStorage(ctrl)
+			eng := engine.NewMockEngine(ctrl)
+			s := New(store, eng, false)
+
+			if !test.noStorageCalls {
+				calls := 1
+				if test.prune {
+					calls = 2
+				}
+				store.EXPECT().ConnectorsList(gomock.Any(), gomock.Any()).Return(installed, nil).Times(calls)
+				store.EXPECT().PoolsList(gomock.Any(), gomock.Any()).Return(&paginate.Cursor[models.Pool]{}, nil)
+				eng.EXPECT().ValidateConnectorConfig(gomock.Any(), "generic", json.RawMessage(`{"endpoint":"http://localhost","name":"generic"}`)).
+					Return(installed.Data[0].Config, nil)
+			}
+			if test.prune && !test.noStorageCalls {
+				eng.EXPECT().UninstallConnector(gomock.Any(), removedID).Return(models.Task{}, test.uninstallErr)
+			}
+
+			plan, err := s.ConnectorsReconcile(context.Background(), test.document, test.prune)
+			if test.expectedError != nil {
+				require.ErrorIs(t, err, test.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, plan.Applied)
+			require.Equal(t, test.expectedPlan, plan.Connectors)
+		})
+	}
+}
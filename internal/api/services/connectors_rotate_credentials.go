@@ -0,0 +1,16 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+)
+
+func (s *Service) ConnectorsRotateCredentials(ctx context.Context, connectorID models.ConnectorID, credentials json.RawMessage) (models.Task, error) {
+	task, err := s.engine.RotateConnectorCredentials(ctx, connectorID, credentials)
+	if err != nil {
+		return models.Task{}, handleEngineErrors(err)
+	}
+	return task, nil
+}
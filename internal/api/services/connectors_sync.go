@@ -0,0 +1,15 @@
+package services
+
+import (
+	"context"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+)
+
+func (s *Service) ConnectorsSync(ctx context.Context, connectorID models.ConnectorID) (models.Task, error) {
+	task, err := s.engine.SyncConnector(ctx, connectorID)
+	if err != nil {
+		return models.Task{}, handleEngineErrors(err)
+	}
+	return task, nil
+}
@@ -0,0 +1,68 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/formancehq/payments/internal/connectors/engine"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestConnectorsSync(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := storage.NewMockStorage(ctrl)
+	eng := engine.NewMockEngine(ctrl)
+
+	s := New(store, eng, false)
+
+	tests := []struct {
+		name          string
+		err           error
+		expectedError error
+		typedError    bool
+	}{
+		{
+			name:          "success",
+			err:           nil,
+			expectedError: nil,
+		},
+		{
+			name:          "validation error",
+			err:           engine.ErrValidation,
+			expectedError: ErrValidation,
+			typedError:    true,
+		},
+		{
+			name:          "not found error",
+			err:           engine.ErrNotFound,
+			expectedError: ErrNotFound,
+			typedError:    true,
+		},
+		{
+			name:          "other error",
+			err:           fmt.Errorf("error"),
+			expectedError: fmt.Errorf("error"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			eng.EXPECT().SyncConnector(gomock.Any(), models.ConnectorID{}).Return(models.Task{}, test.err)
+			_, err := s.ConnectorsSync(context.Background(), models.ConnectorID{})
+			if test.expectedError == nil {
+				require.NoError(t, err)
+			} else if test.typedError {
+				require.ErrorIs(t, err, test.expectedError)
+			} else {
+				require.Equal(t, test.expectedError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+)
+
+func (s *Service) ConnectorsTest(ctx context.Context, provider string, config json.RawMessage) (models.ConnectorTestResult, error) {
+	result, err := s.engine.TestConnector(ctx, provider, config)
+	return result, handleEngineErrors(err)
+}
@@ -0,0 +1,17 @@
+package services
+
+import (
+	"context"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+)
+
+func (s *Service) CounterpartiesGet(ctx context.Context, id uuid.UUID) (*models.Counterparty, error) {
+	c, err := s.storage.CounterpartiesGet(ctx, id)
+	if err != nil {
+		return nil, newStorageError(err, "cannot get counterparty")
+	}
+
+	return c, nil
+}
@@ -0,0 +1,62 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/formancehq/payments/internal/connectors/engine"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestCounterpartiesGet(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := storage.NewMockStorage(ctrl)
+	eng := engine.NewMockEngine(ctrl)
+
+	s := New(store, eng, false)
+
+	id := uuid.New()
+
+	tests := []struct {
+		name          string
+		err           error
+		expectedError error
+	}{
+		{
+			name:          "success",
+			err:           nil,
+			expectedError: nil,
+		},
+		{
+			name:          "storage error not found",
+			err:           storage.ErrNotFound,
+			expectedError: newStorageError(storage.ErrNotFound, "cannot get counterparty"),
+		},
+		{
+			name:          "other error",
+			err:           fmt.Errorf("error"),
+			expectedError: newStorageError(fmt.Errorf("error"), "cannot get counterparty"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			store.EXPECT().CounterpartiesGet(gomock.Any(), id).Return(&models.Counterparty{}, test.err)
+			counterparty, err := s.CounterpartiesGet(context.Background(), id)
+			if test.expectedError == nil {
+				require.NotNil(t, counterparty)
+				require.NoError(t, err)
+			} else {
+				require.Equal(t, test.expectedError, err)
+			}
+		})
+	}
+}
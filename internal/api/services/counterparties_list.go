@@ -0,0 +1,18 @@
+package services
+
+import (
+	"context"
+
+	"github.com/formancehq/go-libs/v5/pkg/storage/bun/paginate"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+)
+
+func (s *Service) CounterpartiesList(ctx context.Context, query storage.ListCounterpartiesQuery) (*paginate.Cursor[models.Counterparty], error) {
+	cs, err := s.storage.CounterpartiesList(ctx, query)
+	if err != nil {
+		return nil, newStorageError(err, "cannot list counterparties")
+	}
+
+	return cs, nil
+}
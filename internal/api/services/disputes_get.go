@@ -0,0 +1,16 @@
+package services
+
+import (
+	"context"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+)
+
+func (s *Service) DisputesGet(ctx context.Context, id models.DisputeID) (*models.Dispute, error) {
+	dispute, err := s.storage.DisputesGet(ctx, id)
+	if err != nil {
+		return nil, newStorageError(err, "cannot get dispute")
+	}
+
+	return dispute, nil
+}
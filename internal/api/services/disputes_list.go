@@ -0,0 +1,18 @@
+package services
+
+import (
+	"context"
+
+	"github.com/formancehq/go-libs/v5/pkg/storage/bun/paginate"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+)
+
+func (s *Service) DisputesList(ctx context.Context, query storage.ListDisputesQuery) (*paginate.Cursor[models.Dispute], error) {
+	cursor, err := s.storage.DisputesList(ctx, query)
+	if err != nil {
+		return nil, newStorageError(err, "cannot list disputes")
+	}
+
+	return cursor, nil
+}
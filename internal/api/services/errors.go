@@ -2,6 +2,7 @@ package services
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/formancehq/payments/internal/connectors/engine"
 	"github.com/formancehq/payments/pkg/domain/models"
@@ -14,6 +15,41 @@ var (
 	ErrNotFound   = errors.New("not found")
 )
 
+// ErrPaymentInitiationLimitsExceeded is returned when a payment initiation
+// exceeds limits with the REJECT action.
+type ErrPaymentInitiationLimitsExceeded struct {
+	Limits []models.PaymentInitiationLimit
+}
+
+func (e *ErrPaymentInitiationLimitsExceeded) Error() string {
+	limits := make([]string, 0, len(e.Limits))
+	for _, l := range e.Limits {
+		limits = append(limits, fmt.Sprintf("%s (%s %s)", l.Name, l.Type, l.ID))
+	}
+	return fmt.Sprintf("payment initiation exceeds limits: %s", strings.Join(limits, ", "))
+}
+
+func (e *ErrPaymentInitiationLimitsExceeded) Unwrap() error {
+	return ErrValidation
+}
+
+// paymentInitiationLimitsError returns an ErrPaymentInitiationLimitsExceeded
+// if one of the exceeded limits rejects the payment initiation.
+func paymentInitiationLimitsError(exceeded []models.PaymentInitiationLimit) error {
+	var rejecting []models.PaymentInitiationLimit
+	for _, l := range exceeded {
+		if l.Action == models.PAYMENT_INITIATION_LIMIT_ACTION_REJECT {
+			rejecting = append(rejecting, l)
+		}
+	}
+
+	if len(rejecting) == 0 {
+		return nil
+	}
+
+	return &ErrPaymentInitiationLimitsExceeded{Limits: rejecting}
+}
+
 type storageError struct {
 	err error
 	msg string
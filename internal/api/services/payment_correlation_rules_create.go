@@ -0,0 +1,15 @@
+package services
+
+import (
+	"context"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+)
+
+func (s *Service) PaymentCorrelationRulesCreate(ctx context.Context, rule models.PaymentCorrelationRule) error {
+	if err := rule.Validate(); err != nil {
+		return handleEngineErrors(err)
+	}
+
+	return newStorageError(s.storage.PaymentCorrelationRulesCreate(ctx, rule), "cannot create payment correlation rule")
+}
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/formancehq/payments/internal/connectors/engine"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestPaymentCorrelationRulesCreate(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := storage.NewMockStorage(ctrl)
+	eng := engine.NewMockEngine(ctrl)
+
+	s := New(store, eng, false)
+
+	rule := models.PaymentCorrelationRule{
+		ID:          uuid.New(),
+		Name:        "test",
+		MatchAmount: true,
+	}
+
+	tests := []struct {
+		name          string
+		err           error
+		expectedError error
+	}{
+		{
+			name:          "success",
+			err:           nil,
+			expectedError: nil,
+		},
+		{
+			name:          "storage error duplicate",
+			err:           storage.ErrDuplicateKeyValue,
+			expectedError: newStorageError(storage.ErrDuplicateKeyValue, "cannot create payment correlation rule"),
+		},
+		{
+			name:          "other error",
+			err:           fmt.Errorf("error"),
+			expectedError: newStorageError(fmt.Errorf("error"), "cannot create payment correlation rule"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			store.EXPECT().PaymentCorrelationRulesCreate(gomock.Any(), rule).Return(test.err)
+			err := s.PaymentCorrelationRulesCreate(context.Background(), rule)
+			if test.expectedError == nil {
+				require.NoError(t, err)
+			} else {
+				require.Equal(t, test.expectedError, err)
+			}
+		})
+	}
+
+	t.Run("invalid rule", func(t *testing.T) {
+		err := s.PaymentCorrelationRulesCreate(context.Background(), models.PaymentCorrelationRule{Name: "test"})
+		require.ErrorIs(t, err, ErrValidation)
+	})
+}
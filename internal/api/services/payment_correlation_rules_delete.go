@@ -0,0 +1,11 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+func (s *Service) PaymentCorrelationRulesDelete(ctx context.Context, id uuid.UUID) error {
+	return newStorageError(s.storage.PaymentCorrelationRulesDelete(ctx, id), "cannot delete payment correlation rule")
+}
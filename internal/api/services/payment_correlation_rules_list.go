@@ -0,0 +1,18 @@
+package services
+
+import (
+	"context"
+
+	"github.com/formancehq/go-libs/v5/pkg/storage/bun/paginate"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+)
+
+func (s *Service) PaymentCorrelationRulesList(ctx context.Context, query storage.ListPaymentCorrelationRulesQuery) (*paginate.Cursor[models.PaymentCorrelationRule], error) {
+	rules, err := s.storage.PaymentCorrelationRulesList(ctx, query)
+	if err != nil {
+		return nil, newStorageError(err, "cannot list payment correlation rules")
+	}
+
+	return rules, nil
+}
@@ -0,0 +1,15 @@
+package services
+
+import (
+	"context"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+)
+
+func (s *Service) PaymentInitiationLimitsCreate(ctx context.Context, limit models.PaymentInitiationLimit) error {
+	if err := limit.Validate(); err != nil {
+		return handleEngineErrors(err)
+	}
+
+	return newStorageError(s.storage.PaymentInitiationLimitsCreate(ctx, limit), "cannot create payment initiation limit")
+}
@@ -0,0 +1,76 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/formancehq/payments/internal/connectors/engine"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestPaymentInitiationLimitsCreate(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := storage.NewMockStorage(ctrl)
+	eng := engine.NewMockEngine(ctrl)
+
+	s := New(store, eng, false)
+
+	limit := models.PaymentInitiationLimit{
+		ID:        uuid.New(),
+		Name:      "test",
+		Type:      models.PAYMENT_INITIATION_LIMIT_TYPE_MAX_AMOUNT,
+		Action:    models.PAYMENT_INITIATION_LIMIT_ACTION_REJECT,
+		Asset:     "EUR/2",
+		MaxAmount: big.NewInt(1000),
+	}
+
+	tests := []struct {
+		name          string
+		err           error
+		expectedError error
+	}{
+		{
+			name:          "success",
+			err:           nil,
+			expectedError: nil,
+		},
+		{
+			name:          "storage error foreign key violation",
+			err:           storage.ErrForeignKeyViolation,
+			expectedError: newStorageError(storage.ErrForeignKeyViolation, "cannot create payment initiation limit"),
+		},
+		{
+			name:          "other error",
+			err:           fmt.Errorf("error"),
+			expectedError: newStorageError(fmt.Errorf("error"), "cannot create payment initiation limit"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			store.EXPECT().PaymentInitiationLimitsCreate(gomock.Any(), limit).Return(test.err)
+			err := s.PaymentInitiationLimitsCreate(context.Background(), limit)
+			if test.expectedError == nil {
+				require.NoError(t, err)
+			} else {
+				require.Equal(t, test.expectedError, err)
+			}
+		})
+	}
+
+	t.Run("invalid limit", func(t *testing.T) {
+		invalid := limit
+		invalid.Asset = ""
+		err := s.PaymentInitiationLimitsCreate(context.Background(), invalid)
+		require.ErrorIs(t, err, ErrValidation)
+	})
+}
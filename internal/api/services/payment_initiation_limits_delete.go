@@ -0,0 +1,11 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+func (s *Service) PaymentInitiationLimitsDelete(ctx context.Context, id uuid.UUID) error {
+	return newStorageError(s.storage.PaymentInitiationLimitsDelete(ctx, id), "cannot delete payment initiation limit")
+}
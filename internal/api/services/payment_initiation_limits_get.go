@@ -0,0 +1,17 @@
+package services
+
+import (
+	"context"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+)
+
+func (s *Service) PaymentInitiationLimitsGet(ctx context.Context, id uuid.UUID) (*models.PaymentInitiationLimit, error) {
+	limit, err := s.storage.PaymentInitiationLimitsGet(ctx, id)
+	if err != nil {
+		return nil, newStorageError(err, "cannot get payment initiation limit")
+	}
+
+	return limit, nil
+}
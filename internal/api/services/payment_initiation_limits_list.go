@@ -0,0 +1,18 @@
+package services
+
+import (
+	"context"
+
+	"github.com/formancehq/go-libs/v5/pkg/storage/bun/paginate"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+)
+
+func (s *Service) PaymentInitiationLimitsList(ctx context.Context, query storage.ListPaymentInitiationLimitsQuery) (*paginate.Cursor[models.PaymentInitiationLimit], error) {
+	limits, err := s.storage.PaymentInitiationLimitsList(ctx, query)
+	if err != nil {
+		return nil, newStorageError(err, "cannot list payment initiation limits")
+	}
+
+	return limits, nil
+}
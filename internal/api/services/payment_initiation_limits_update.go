@@ -0,0 +1,22 @@
+package services
+
+import (
+	"context"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+)
+
+func (s *Service) PaymentInitiationLimitsUpdate(ctx context.Context, id uuid.UUID, update models.PaymentInitiationLimitUpdate) error {
+	limit, err := s.storage.PaymentInitiationLimitsGet(ctx, id)
+	if err != nil {
+		return newStorageError(err, "cannot get payment initiation limit")
+	}
+
+	updated := update.Apply(*limit)
+	if err := updated.Validate(); err != nil {
+		return handleEngineErrors(err)
+	}
+
+	return newStorageError(s.storage.PaymentInitiationLimitsUpdate(ctx, updated), "cannot update payment initiation limit")
+}
@@ -0,0 +1,99 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/payments/internal/connectors/engine"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestPaymentInitiationLimitsUpdate(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := storage.NewMockStorage(ctrl)
+	eng := engine.NewMockEngine(ctrl)
+
+	s := New(store, eng, false)
+
+	limit := models.PaymentInitiationLimit{
+		ID:       uuid.New(),
+		Name:     "test",
+		Type:     models.PAYMENT_INITIATION_LIMIT_TYPE_HOURLY_COUNT,
+		Scope:    models.PAYMENT_INITIATION_LIMIT_SCOPE_CONNECTOR,
+		Action:   models.PAYMENT_INITIATION_LIMIT_ACTION_REJECT,
+		MaxCount: 10,
+	}
+
+	update := models.PaymentInitiationLimitUpdate{
+		Action:   pointer.For(models.PAYMENT_INITIATION_LIMIT_ACTION_REQUIRE_APPROVAL),
+		MaxCount: pointer.For(20),
+	}
+
+	updated := limit
+	updated.Action = models.PAYMENT_INITIATION_LIMIT_ACTION_REQUIRE_APPROVAL
+	updated.MaxCount = 20
+
+	tests := []struct {
+		name          string
+		getErr        error
+		err           error
+		expectedError error
+	}{
+		{
+			name:          "success",
+			expectedError: nil,
+		},
+		{
+			name:          "storage error not found on get",
+			getErr:        storage.ErrNotFound,
+			expectedError: newStorageError(storage.ErrNotFound, "cannot get payment initiation limit"),
+		},
+		{
+			name:          "storage error not found on update",
+			err:           storage.ErrNotFound,
+			expectedError: newStorageError(storage.ErrNotFound, "cannot update payment initiation limit"),
+		},
+		{
+			name:          "other error",
+			err:           fmt.Errorf("error"),
+			expectedError: newStorageError(fmt.Errorf("error"), "cannot update payment initiation limit"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.getErr != nil {
+				store.EXPECT().PaymentInitiationLimitsGet(gomock.Any(), limit.ID).Return(nil, test.getErr)
+			} else {
+				store.EXPECT().PaymentInitiationLimitsGet(gomock.Any(), limit.ID).Return(&limit, nil)
+				store.EXPECT().PaymentInitiationLimitsUpdate(gomock.Any(), updated).Return(test.err)
+			}
+
+			err := s.PaymentInitiationLimitsUpdate(context.Background(), limit.ID, update)
+			if test.expectedError == nil {
+				require.NoError(t, err)
+			} else {
+				require.Equal(t, test.expectedError, err)
+			}
+		})
+	}
+
+	t.Run("invalid update", func(t *testing.T) {
+		store.EXPECT().PaymentInitiationLimitsGet(gomock.Any(), limit.ID).Return(&limit, nil)
+		err := s.PaymentInitiationLimitsUpdate(context.Background(), limit.ID, models.PaymentInitiationLimitUpdate{
+			MaxAmount: big.NewInt(-1),
+			MaxCount:  pointer.For(0),
+		})
+		require.ErrorIs(t, err, ErrValidation)
+	})
+}
@@ -0,0 +1,30 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+)
+
+// PaymentInitiationLimitsUsage returns the usage counters of the current
+// window of the limit, MAX_AMOUNT limits have none.
+func (s *Service) PaymentInitiationLimitsUsage(ctx context.Context, id uuid.UUID) ([]models.PaymentInitiationLimitUsage, error) {
+	limit, err := s.storage.PaymentInitiationLimitsGet(ctx, id)
+	if err != nil {
+		return nil, newStorageError(err, "cannot get payment initiation limit")
+	}
+
+	windowStart := limit.Type.WindowStart(time.Now())
+	if windowStart.IsZero() {
+		return []models.PaymentInitiationLimitUsage{}, nil
+	}
+
+	usages, err := s.storage.PaymentInitiationLimitUsagesList(ctx, id, windowStart)
+	if err != nil {
+		return nil, newStorageError(err, "cannot list payment initiation limit usages")
+	}
+
+	return usages, nil
+}
@@ -0,0 +1,91 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/formancehq/payments/internal/connectors/engine"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestPaymentInitiationLimitsUsage(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := storage.NewMockStorage(ctrl)
+	eng := engine.NewMockEngine(ctrl)
+
+	s := New(store, eng, false)
+
+	daily := models.PaymentInitiationLimit{
+		ID:        uuid.New(),
+		Name:      "daily",
+		Type:      models.PAYMENT_INITIATION_LIMIT_TYPE_DAILY_AMOUNT,
+		Scope:     models.PAYMENT_INITIATION_LIMIT_SCOPE_SOURCE_ACCOUNT,
+		Action:    models.PAYMENT_INITIATION_LIMIT_ACTION_REJECT,
+		Asset:     "EUR/2",
+		MaxAmount: big.NewInt(1000),
+	}
+
+	usages := []models.PaymentInitiationLimitUsage{
+		{LimitID: daily.ID, ScopeKey: "account", Amount: big.NewInt(100), Count: 1},
+	}
+
+	tests := []struct {
+		name          string
+		getErr        error
+		err           error
+		expectedError error
+	}{
+		{
+			name:          "success",
+			expectedError: nil,
+		},
+		{
+			name:          "storage error not found",
+			getErr:        storage.ErrNotFound,
+			expectedError: newStorageError(storage.ErrNotFound, "cannot get payment initiation limit"),
+		},
+		{
+			name:          "other error",
+			err:           fmt.Errorf("error"),
+			expectedError: newStorageError(fmt.Errorf("error"), "cannot list payment initiation limit usages"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.getErr != nil {
+				store.EXPECT().PaymentInitiationLimitsGet(gomock.Any(), daily.ID).Return(nil, test.getErr)
+			} else {
+				store.EXPECT().PaymentInitiationLimitsGet(gomock.Any(), daily.ID).Return(&daily, nil)
+				store.EXPECT().PaymentInitiationLimitUsagesList(gomock.Any(), daily.ID, gomock.Any()).Return(usages, test.err)
+			}
+
+			res, err := s.PaymentInitiationLimitsUsage(context.Background(), daily.ID)
+			if test.expectedError == nil {
+				require.NoError(t, err)
+				require.Equal(t, usages, res)
+			} else {
+				require.Equal(t, test.expectedError, err)
+			}
+		})
+	}
+
+	t.Run("max amount limit has no usage", func(t *testing.T) {
+		maxAmount := daily
+		maxAmount.Type = models.PAYMENT_INITIATION_LIMIT_TYPE_MAX_AMOUNT
+		store.EXPECT().PaymentInitiationLimitsGet(gomock.Any(), maxAmount.ID).Return(&maxAmount, nil)
+
+		res, err := s.PaymentInitiationLimitsUsage(context.Background(), maxAmount.ID)
+		require.NoError(t, err)
+		require.Empty(t, res)
+	})
+}
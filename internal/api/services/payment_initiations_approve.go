@@ -39,6 +39,18 @@ func (s *Service) PaymentInitiationsApprove(ctx context.Context, id models.Payme
 		return models.Task{}, newStorageError(err, "cannot get payment initiation")
 	}
 
+	// An approved payment initiation can go over the limits requiring an
+	// approval, not over the ones rejecting it. Approving it again after a
+	// failure to send it to the connector does not consume them twice.
+	exceeded, err := s.storage.PaymentInitiationLimitsConsume(ctx, *pi, time.Now().UTC(), true)
+	if err != nil {
+		return models.Task{}, newStorageError(err, "cannot consume payment initiation limits")
+	}
+
+	if err := paymentInitiationLimitsError(exceeded); err != nil {
+		return models.Task{}, err
+	}
+
 	if !pi.ScheduledAt.IsZero() && pi.ScheduledAt.After(time.Now()) {
 		// In any case, if the payment initiation is scheduled for the future,
 		// we do not want to wait for the results
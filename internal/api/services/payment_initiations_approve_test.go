@@ -43,6 +43,11 @@ func TestPaymentInitiationsApprove(t *testing.T) {
 		Type:        models.PAYMENT_INITIATION_TYPE_PAYOUT,
 		ScheduledAt: time.Now().Add(time.Hour),
 	}
+	rejectLimit := models.PaymentInitiationLimit{
+		Name:   "max",
+		Type:   models.PAYMENT_INITIATION_LIMIT_TYPE_MAX_AMOUNT,
+		Action: models.PAYMENT_INITIATION_LIMIT_ACTION_REJECT,
+	}
 
 	tests := []struct {
 		name                string
@@ -51,8 +56,11 @@ func TestPaymentInitiationsApprove(t *testing.T) {
 		engineErr           error
 		adjListStorageErr   error
 		piGetStorageErr     error
+		limitsExceeded      []models.PaymentInitiationLimit
+		limitsStorageErr    error
 		expectedAdjError    error
 		expectedPIError     error
+		expectedLimitsError error
 		expectedEngineError error
 		typedError          bool
 	}{
@@ -122,6 +130,21 @@ func TestPaymentInitiationsApprove(t *testing.T) {
 			piGetStorageErr: fmt.Errorf("error"),
 			expectedPIError: newStorageError(fmt.Errorf("error"), "cannot get payment initiation"),
 		},
+		{
+			name:                "limit exceeded",
+			adj:                 &rightLastAdj,
+			pi:                  piWithoutScheduledAt,
+			limitsExceeded:      []models.PaymentInitiationLimit{rejectLimit},
+			expectedLimitsError: ErrValidation,
+			typedError:          true,
+		},
+		{
+			name:                "limits storage error",
+			adj:                 &rightLastAdj,
+			pi:                  piWithoutScheduledAt,
+			limitsStorageErr:    fmt.Errorf("error"),
+			expectedLimitsError: newStorageError(fmt.Errorf("error"), "cannot consume payment initiation limits"),
+		},
 	}
 
 	for _, test := range tests {
@@ -147,6 +170,10 @@ func TestPaymentInitiationsApprove(t *testing.T) {
 				}
 
 				if test.piGetStorageErr == nil {
+					store.EXPECT().PaymentInitiationLimitsConsume(gomock.Any(), test.pi, gomock.Any(), true).Return(test.limitsExceeded, test.limitsStorageErr)
+				}
+
+				if test.piGetStorageErr == nil && test.expectedLimitsError == nil {
 					switch test.pi.Type {
 					case models.PAYMENT_INITIATION_TYPE_TRANSFER:
 						eng.EXPECT().CreateTransfer(gomock.Any(), pid, 1, waitResult).Return(models.Task{}, test.engineErr)
@@ -158,7 +185,7 @@ func TestPaymentInitiationsApprove(t *testing.T) {
 
 			_, err := s.PaymentInitiationsApprove(context.Background(), pid, true)
 			switch {
-			case test.expectedAdjError == nil && test.expectedPIError == nil && test.expectedEngineError == nil:
+			case test.expectedAdjError == nil && test.expectedPIError == nil && test.expectedLimitsError == nil && test.expectedEngineError == nil:
 				require.NoError(t, err)
 			case test.expectedAdjError != nil:
 				if test.typedError {
@@ -168,6 +195,12 @@ func TestPaymentInitiationsApprove(t *testing.T) {
 				}
 			case test.expectedPIError != nil:
 				require.Equal(t, test.expectedPIError.Error(), err.Error())
+			case test.expectedLimitsError != nil:
+				if test.typedError {
+					require.ErrorIs(t, err, test.expectedLimitsError)
+				} else {
+					require.Equal(t, test.expectedLimitsError.Error(), err.Error())
+				}
 			case test.expectedEngineError != nil:
 				if test.typedError {
 					require.ErrorIs(t, err, test.expectedEngineError)
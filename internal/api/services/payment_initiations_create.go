@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"time"
 
 	"github.com/formancehq/payments/pkg/domain/models"
 )
@@ -19,12 +20,37 @@ func (s *Service) PaymentInitiationsCreate(ctx context.Context, paymentInitiatio
 		Status:    models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_WAITING_FOR_VALIDATION,
 	}
 
+	// The limits are consumed when the payment initiation is sent to the
+	// connector, the ones waiting for a validation consume them on approval.
+	now := time.Now().UTC()
 	if !sendToPSP {
+		exceeded, err := s.storage.PaymentInitiationLimitsCheck(ctx, paymentInitiation, now)
+		if err != nil {
+			return models.Task{}, newStorageError(err, "cannot check payment initiation limits")
+		}
+
+		if err := paymentInitiationLimitsError(exceeded); err != nil {
+			return models.Task{}, err
+		}
+
 		return models.Task{}, handleEngineErrors(s.engine.CreateFormancePaymentInitiation(ctx, paymentInitiation, waitingForValidationAdjustment))
 	}
 
-	if err := s.engine.CreateFormancePaymentInitiation(ctx, paymentInitiation, waitingForValidationAdjustment); err != nil {
-		return models.Task{}, handleEngineErrors(err)
+	// The payment initiation is stored in the same transaction as the
+	// consumption of its limits, so that a failed insert consumes nothing.
+	exceeded, err := s.storage.PaymentInitiationsInsertAndConsumeLimits(ctx, paymentInitiation, now, waitingForValidationAdjustment)
+	if err != nil {
+		return models.Task{}, newStorageError(err, "cannot create payment initiation")
+	}
+
+	if err := paymentInitiationLimitsError(exceeded); err != nil {
+		return models.Task{}, err
+	}
+
+	if len(exceeded) > 0 {
+		// Only limits requiring an approval are left, the payment initiation
+		// waits for it instead of being sent to the connector.
+		return models.Task{}, nil
 	}
 
 	switch paymentInitiation.Type {
@@ -88,7 +88,12 @@ func TestPaymentInitiationsCreate(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			eng.EXPECT().CreateFormancePaymentInitiation(gomock.Any(), test.pi, gomock.Any()).Return(test.engineCreatePaymentInitiation)
+			if test.sendToPSP {
+				store.EXPECT().PaymentInitiationsInsertAndConsumeLimits(gomock.Any(), test.pi, gomock.Any(), gomock.Any()).Return(nil, nil)
+			} else {
+				store.EXPECT().PaymentInitiationLimitsCheck(gomock.Any(), test.pi, gomock.Any()).Return(nil, nil)
+				eng.EXPECT().CreateFormancePaymentInitiation(gomock.Any(), test.pi, gomock.Any()).Return(test.engineCreatePaymentInitiation)
+			}
 			if test.sendToPSP {
 				switch test.pi.Type {
 				case models.PAYMENT_INITIATION_TYPE_TRANSFER:
@@ -118,3 +123,65 @@ func TestPaymentInitiationsCreate(t *testing.T) {
 		})
 	}
 }
+
+func TestPaymentInitiationsCreateLimits(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := storage.NewMockStorage(ctrl)
+	eng := engine.NewMockEngine(ctrl)
+
+	s := New(store, eng, false)
+
+	pi := models.PaymentInitiation{
+		Type: models.PAYMENT_INITIATION_TYPE_PAYOUT,
+	}
+	rejectLimit := models.PaymentInitiationLimit{
+		Name:   "hourly",
+		Type:   models.PAYMENT_INITIATION_LIMIT_TYPE_HOURLY_COUNT,
+		Action: models.PAYMENT_INITIATION_LIMIT_ACTION_REJECT,
+	}
+	approvalLimit := models.PaymentInitiationLimit{
+		Name:   "daily",
+		Type:   models.PAYMENT_INITIATION_LIMIT_TYPE_DAILY_AMOUNT,
+		Action: models.PAYMENT_INITIATION_LIMIT_ACTION_REQUIRE_APPROVAL,
+	}
+
+	t.Run("rejected", func(t *testing.T) {
+		store.EXPECT().PaymentInitiationsInsertAndConsumeLimits(gomock.Any(), pi, gomock.Any(), gomock.Any()).
+			Return([]models.PaymentInitiationLimit{approvalLimit, rejectLimit}, nil)
+
+		_, err := s.PaymentInitiationsCreate(context.Background(), pi, true, false)
+		require.ErrorIs(t, err, ErrValidation)
+
+		var limitsErr *ErrPaymentInitiationLimitsExceeded
+		require.ErrorAs(t, err, &limitsErr)
+		require.Equal(t, []models.PaymentInitiationLimit{rejectLimit}, limitsErr.Limits)
+	})
+
+	t.Run("rejected without sending to PSP", func(t *testing.T) {
+		store.EXPECT().PaymentInitiationLimitsCheck(gomock.Any(), pi, gomock.Any()).
+			Return([]models.PaymentInitiationLimit{rejectLimit}, nil)
+
+		_, err := s.PaymentInitiationsCreate(context.Background(), pi, false, false)
+		require.ErrorIs(t, err, ErrValidation)
+	})
+
+	t.Run("routed to manual approval", func(t *testing.T) {
+		store.EXPECT().PaymentInitiationsInsertAndConsumeLimits(gomock.Any(), pi, gomock.Any(), gomock.Any()).
+			Return([]models.PaymentInitiationLimit{approvalLimit}, nil)
+
+		task, err := s.PaymentInitiationsCreate(context.Background(), pi, true, false)
+		require.NoError(t, err)
+		require.Equal(t, models.Task{}, task)
+	})
+
+	t.Run("storage error", func(t *testing.T) {
+		store.EXPECT().PaymentInitiationsInsertAndConsumeLimits(gomock.Any(), pi, gomock.Any(), gomock.Any()).
+			Return(nil, fmt.Errorf("error"))
+
+		_, err := s.PaymentInitiationsCreate(context.Background(), pi, true, false)
+		require.Equal(t, newStorageError(fmt.Errorf("error"), "cannot create payment initiation").Error(), err.Error())
+	})
+}
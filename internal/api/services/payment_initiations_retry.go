@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/formancehq/go-libs/v5/pkg/storage/bun/paginate"
 	"github.com/formancehq/payments/pkg/domain/models"
@@ -33,6 +34,17 @@ func (s *Service) PaymentInitiationsRetry(ctx context.Context, id models.Payment
 		return models.Task{}, newStorageError(err, "cannot get payment initiation")
 	}
 
+	// The failed attempt released the limits, the retry consumes them again.
+	// It was accepted once so only the limits rejecting it apply.
+	exceeded, err := s.storage.PaymentInitiationLimitsConsume(ctx, *pi, time.Now().UTC(), true)
+	if err != nil {
+		return models.Task{}, newStorageError(err, "cannot consume payment initiation limits")
+	}
+
+	if err := paymentInitiationLimitsError(exceeded); err != nil {
+		return models.Task{}, err
+	}
+
 	attempts := getAttemps(adjustments)
 
 	switch pi.Type {
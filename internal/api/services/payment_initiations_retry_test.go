@@ -41,6 +41,11 @@ func TestPaymentInitiationsRetry(t *testing.T) {
 	piPayout := models.PaymentInitiation{
 		Type: models.PAYMENT_INITIATION_TYPE_PAYOUT,
 	}
+	rejectLimit := models.PaymentInitiationLimit{
+		Name:   "max",
+		Type:   models.PAYMENT_INITIATION_LIMIT_TYPE_MAX_AMOUNT,
+		Action: models.PAYMENT_INITIATION_LIMIT_ACTION_REJECT,
+	}
 
 	tests := []struct {
 		name                string
@@ -49,8 +54,11 @@ func TestPaymentInitiationsRetry(t *testing.T) {
 		engineErr           error
 		adjListStorageErr   error
 		piGetStorageErr     error
+		limitsExceeded      []models.PaymentInitiationLimit
+		limitsStorageErr    error
 		expectedAdjError    error
 		expectedPIError     error
+		expectedLimitsError error
 		expectedEngineError error
 		typedError          bool
 	}{
@@ -120,6 +128,21 @@ func TestPaymentInitiationsRetry(t *testing.T) {
 			piGetStorageErr: fmt.Errorf("error"),
 			expectedPIError: newStorageError(fmt.Errorf("error"), "cannot get payment initiation"),
 		},
+		{
+			name:                "limit exceeded",
+			adj:                 &rightLastAdj,
+			pi:                  piTransfer,
+			limitsExceeded:      []models.PaymentInitiationLimit{rejectLimit},
+			expectedLimitsError: ErrValidation,
+			typedError:          true,
+		},
+		{
+			name:                "limits storage error",
+			adj:                 &rightLastAdj,
+			pi:                  piTransfer,
+			limitsStorageErr:    fmt.Errorf("error"),
+			expectedLimitsError: newStorageError(fmt.Errorf("error"), "cannot consume payment initiation limits"),
+		},
 	}
 
 	for _, test := range tests {
@@ -140,6 +163,10 @@ func TestPaymentInitiationsRetry(t *testing.T) {
 				store.EXPECT().PaymentInitiationsGet(gomock.Any(), pid).Return(&test.pi, test.piGetStorageErr)
 
 				if test.piGetStorageErr == nil {
+					store.EXPECT().PaymentInitiationLimitsConsume(gomock.Any(), test.pi, gomock.Any(), true).Return(test.limitsExceeded, test.limitsStorageErr)
+				}
+
+				if test.piGetStorageErr == nil && test.expectedLimitsError == nil {
 					switch test.pi.Type {
 					case models.PAYMENT_INITIATION_TYPE_TRANSFER:
 						eng.EXPECT().CreateTransfer(gomock.Any(), pid, 2, false).Return(models.Task{}, test.engineErr)
@@ -151,7 +178,7 @@ func TestPaymentInitiationsRetry(t *testing.T) {
 
 			_, err := s.PaymentInitiationsRetry(context.Background(), pid, false)
 			switch {
-			case test.expectedAdjError == nil && test.expectedPIError == nil && test.expectedEngineError == nil:
+			case test.expectedAdjError == nil && test.expectedPIError == nil && test.expectedLimitsError == nil && test.expectedEngineError == nil:
 				require.NoError(t, err)
 			case test.expectedAdjError != nil:
 				if test.typedError {
@@ -161,6 +188,12 @@ func TestPaymentInitiationsRetry(t *testing.T) {
 				}
 			case test.expectedPIError != nil:
 				require.Equal(t, test.expectedPIError.Error(), err.Error())
+			case test.expectedLimitsError != nil:
+				if test.typedError {
+					require.ErrorIs(t, err, test.expectedLimitsError)
+				} else {
+					require.Equal(t, test.expectedLimitsError.Error(), err.Error())
+				}
 			case test.expectedEngineError != nil:
 				if test.typedError {
 					require.ErrorIs(t, err, test.expectedEngineError)
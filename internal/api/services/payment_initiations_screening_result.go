@@ -0,0 +1,34 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+)
+
+func (s *Service) PaymentInitiationsScreeningResult(ctx context.Context, id models.PaymentInitiationID, result models.ScreeningResult) error {
+	adjustments, err := s.getAllPaymentInitiationAdjustments(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if len(adjustments) == 0 {
+		return errors.New("payment initiation adjustments not found")
+	}
+
+	if adjustments[0].Status != models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PENDING_SCREENING {
+		return fmt.Errorf("payment initiation is not pending screening: %w", ErrValidation)
+	}
+
+	pi, err := s.storage.PaymentInitiationsGet(ctx, id)
+	if err != nil {
+		return newStorageError(err, "cannot get payment initiation")
+	}
+
+	// The workflow holding the payment initiation is the one of the current
+	// attempt, same as the one a retry would start.
+	attempt := getAttemps(adjustments) + 1
+	return handleEngineErrors(s.engine.SubmitScreeningResult(ctx, *pi, attempt, result))
+}
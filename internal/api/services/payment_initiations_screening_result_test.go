@@ -0,0 +1,114 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/formancehq/go-libs/v5/pkg/storage/bun/paginate"
+	"github.com/formancehq/payments/internal/connectors/engine"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestPaymentInitiationsScreeningResult(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := storage.NewMockStorage(ctrl)
+	eng := engine.NewMockEngine(ctrl)
+
+	s := New(store, eng, false)
+
+	query := storage.NewListPaymentInitiationAdjustmentsQuery(
+		paginate.NewPaginatedQueryOptions(storage.PaymentInitiationAdjustmentsQuery{}).
+			WithPageSize(50),
+	)
+	pid := models.PaymentInitiationID{}
+	pi := models.PaymentInitiation{
+		Type: models.PAYMENT_INITIATION_TYPE_PAYOUT,
+	}
+	result := models.ScreeningResult{Decision: models.SCREENING_DECISION_APPROVED}
+	pendingAdjs := []models.PaymentInitiationAdjustment{
+		{Status: models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PENDING_SCREENING},
+		{Status: models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_FAILED},
+		{Status: models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PROCESSING},
+	}
+	engineErr := fmt.Errorf("error")
+	processingAdjs := []models.PaymentInitiationAdjustment{
+		{Status: models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PROCESSING},
+	}
+
+	tests := []struct {
+		name              string
+		adjs              []models.PaymentInitiationAdjustment
+		adjListStorageErr error
+		piGetStorageErr   error
+		engineErr         error
+		expectedError     error
+	}{
+		{
+			name: "success",
+			adjs: pendingAdjs,
+		},
+		{
+			name:          "not pending screening",
+			adjs:          processingAdjs,
+			expectedError: ErrValidation,
+		},
+		{
+			name:              "list adj storage error not found",
+			adjListStorageErr: storage.ErrNotFound,
+			expectedError:     storage.ErrNotFound,
+		},
+		{
+			name:            "get pi storage error not found",
+			adjs:            pendingAdjs,
+			piGetStorageErr: storage.ErrNotFound,
+			expectedError:   storage.ErrNotFound,
+		},
+		{
+			name:          "workflow not found",
+			adjs:          pendingAdjs,
+			engineErr:     fmt.Errorf("payment initiation workflow %w", engine.ErrNotFound),
+			expectedError: ErrNotFound,
+		},
+		{
+			name:          "engine other error",
+			adjs:          pendingAdjs,
+			engineErr:     engineErr,
+			expectedError: engineErr,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			store.EXPECT().PaymentInitiationAdjustmentsList(gomock.Any(), pid, query).Return(
+				&paginate.Cursor[models.PaymentInitiationAdjustment]{
+					Data: test.adjs,
+				},
+				test.adjListStorageErr,
+			)
+
+			if test.adjListStorageErr == nil && test.adjs[0].Status == models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PENDING_SCREENING {
+				store.EXPECT().PaymentInitiationsGet(gomock.Any(), pid).Return(&pi, test.piGetStorageErr)
+
+				if test.piGetStorageErr == nil {
+					// one failed adjustment, the workflow of the second attempt
+					// is holding the payment initiation
+					eng.EXPECT().SubmitScreeningResult(gomock.Any(), pi, 2, result).Return(test.engineErr)
+				}
+			}
+
+			err := s.PaymentInitiationsScreeningResult(context.Background(), pid, result)
+			if test.expectedError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, test.expectedError)
+			}
+		})
+	}
+}
@@ -18,6 +18,10 @@ func (s *Service) PaymentServiceUsersCompleteLinkFlow(ctx context.Context, conne
 		return "", fmt.Errorf("failed to parse state: %w", err)
 	}
 
+	if state.PaymentInitiation {
+		return s.paymentServiceUsersCompletePaymentLinkFlow(ctx, state)
+	}
+
 	attempt, err := s.storage.OpenBankingConnectionAttemptsGet(ctx, state.AttemptID)
 	if err != nil {
 		return "", newStorageError(err, "failed to get attempt")
@@ -30,3 +34,22 @@ func (s *Service) PaymentServiceUsersCompleteLinkFlow(ctx context.Context, conne
 
 	return *attempt.ClientRedirectURL, nil
 }
+
+func (s *Service) paymentServiceUsersCompletePaymentLinkFlow(ctx context.Context, state models.CallbackState) (string, error) {
+	attempt, err := s.storage.OpenBankingPaymentAttemptsGet(ctx, state.AttemptID)
+	if err != nil {
+		return "", newStorageError(err, "failed to get payment attempt")
+	}
+
+	if attempt.State.Randomized != state.Randomized {
+		return "", fmt.Errorf("invalid state: %w", ErrValidation)
+	}
+
+	// The payment status will be updated by the provider's webhooks, we only
+	// need to redirect the user to the client.
+	if attempt.ClientRedirectURL == nil {
+		return "", nil
+	}
+
+	return *attempt.ClientRedirectURL, nil
+}
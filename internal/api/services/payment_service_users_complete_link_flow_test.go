@@ -135,3 +135,65 @@ func TestPSUCompleteLinkFlow(t *testing.T) {
 		})
 	}
 }
+
+func TestPSUCompletePaymentLinkFlow(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := storage.NewMockStorage(ctrl)
+	eng := engine.NewMockEngine(ctrl)
+
+	s := New(store, eng, false)
+
+	connectorID := models.ConnectorID{
+		Reference: uuid.New(),
+		Provider:  "test",
+	}
+
+	state := models.CallbackState{
+		Randomized:        uuid.New().String(),
+		AttemptID:         uuid.New(),
+		PaymentInitiation: true,
+	}
+
+	httpCallInformation := models.HTTPCallInformation{
+		QueryValues: map[string][]string{
+			"state": {state.String()},
+		},
+	}
+
+	clientRedirectURL := "https://example.com"
+
+	t.Run("success", func(t *testing.T) {
+		store.EXPECT().OpenBankingPaymentAttemptsGet(gomock.Any(), state.AttemptID).Return(&models.OpenBankingPaymentAttempt{
+			State:             state,
+			ClientRedirectURL: &clientRedirectURL,
+		}, nil)
+
+		redirectURL, err := s.PaymentServiceUsersCompleteLinkFlow(context.Background(), connectorID, httpCallInformation)
+		require.NoError(t, err)
+		require.Equal(t, clientRedirectURL, redirectURL)
+	})
+
+	t.Run("invalid state", func(t *testing.T) {
+		store.EXPECT().OpenBankingPaymentAttemptsGet(gomock.Any(), state.AttemptID).Return(&models.OpenBankingPaymentAttempt{
+			State: models.CallbackState{
+				Randomized:        "other",
+				AttemptID:         state.AttemptID,
+				PaymentInitiation: true,
+			},
+			ClientRedirectURL: &clientRedirectURL,
+		}, nil)
+
+		_, err := s.PaymentServiceUsersCompleteLinkFlow(context.Background(), connectorID, httpCallInformation)
+		require.ErrorIs(t, err, ErrValidation)
+	})
+
+	t.Run("storage error not found", func(t *testing.T) {
+		store.EXPECT().OpenBankingPaymentAttemptsGet(gomock.Any(), state.AttemptID).Return(nil, storage.ErrNotFound)
+
+		_, err := s.PaymentServiceUsersCompleteLinkFlow(context.Background(), connectorID, httpCallInformation)
+		require.ErrorIs(t, err, newStorageError(storage.ErrNotFound, "failed to get payment attempt"))
+	})
+}
@@ -0,0 +1,17 @@
+package services
+
+import (
+	"context"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+)
+
+func (s *Service) PaymentServiceUsersCreatePaymentLink(ctx context.Context, ApplicationName string, psuID uuid.UUID, pi models.PaymentInitiation, bankAccountID uuid.UUID, idempotencyKey *uuid.UUID, ClientRedirectURL *string) (string, string, error) {
+	attemptID, link, err := s.engine.CreatePaymentServiceUserPaymentLink(ctx, ApplicationName, psuID, pi, bankAccountID, idempotencyKey, ClientRedirectURL)
+	if err != nil {
+		return "", "", handleEngineErrors(err)
+	}
+
+	return attemptID, link, nil
+}
@@ -0,0 +1,17 @@
+package services
+
+import (
+	"context"
+
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+)
+
+func (s *Service) PaymentsAggregate(ctx context.Context, query storage.PaymentsAggregateQuery) ([]models.PaymentAggregate, error) {
+	aggregates, err := s.storage.PaymentsAggregate(ctx, query)
+	if err != nil {
+		return nil, newStorageError(err, "cannot aggregate payments")
+	}
+
+	return aggregates, nil
+}
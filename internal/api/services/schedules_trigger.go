@@ -0,0 +1,11 @@
+package services
+
+import (
+	"context"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+)
+
+func (s *Service) SchedulesTrigger(ctx context.Context, id string, connectorID models.ConnectorID) error {
+	return handleEngineErrors(s.engine.TriggerSchedule(ctx, connectorID, id))
+}
@@ -140,10 +140,12 @@ func translateAdjustments(from []models.PaymentInitiationAdjustment) []transferI
 
 func translateStatus(from models.PaymentInitiationAdjustmentStatus) (string, bool) {
 	switch from {
-	case models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_SCHEDULED_FOR_PROCESSING:
-		// PAYMENT_INITIATION_ADJUSTMENT_STATUS_SCHEDULED_FOR_PROCESSING is not supported
-		// in v2 as it is introduced in v3. Since we're gonna list all adjustments
-		// we can drop this one
+	case models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_SCHEDULED_FOR_PROCESSING,
+		models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PENDING_SCREENING:
+		// PAYMENT_INITIATION_ADJUSTMENT_STATUS_SCHEDULED_FOR_PROCESSING and
+		// PAYMENT_INITIATION_ADJUSTMENT_STATUS_PENDING_SCREENING are not supported
+		// in v2 as they are introduced in v3. Since we're gonna list all adjustments
+		// we can drop them
 		return "", false
 	default:
 		return from.String(), true
@@ -52,9 +52,11 @@ func transferInitiationsList(backend backend.Backend) http.HandlerFunc {
 
 			status := ""
 			switch lastAdjustment.Status {
-			case models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_SCHEDULED_FOR_PROCESSING:
-				// PAYMENT_INITIATION_ADJUSTMENT_STATUS_SCHEDULED_FOR_PROCESSING is not supported
-				// in v2 as it is introduced in v3. We map it to PROCESSING for backward compatibility.
+			case models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_SCHEDULED_FOR_PROCESSING,
+				models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PENDING_SCREENING:
+				// PAYMENT_INITIATION_ADJUSTMENT_STATUS_SCHEDULED_FOR_PROCESSING and
+				// PAYMENT_INITIATION_ADJUSTMENT_STATUS_PENDING_SCREENING are not supported
+				// in v2 as they are introduced in v3. We map them to PROCESSING for backward compatibility.
 				status = models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PROCESSING.String()
 			default:
 				status = lastAdjustment.Status.String()
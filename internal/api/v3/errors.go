@@ -18,10 +18,12 @@ const (
 	ErrMissingOrInvalidBody            = "MISSING_OR_INVALID_BODY"
 	ErrUniqueReference                 = "CONFLICT"
 	ErrConnectorCapabilityNotSupported = "CONNECTOR_CAPABILITY_NOT_SUPPORTED"
+	ErrLimitExceeded                   = "LIMIT_EXCEEDED"
 )
 
 func handleServiceErrors(w http.ResponseWriter, r *http.Request, err error) {
 	var capabilityNotSupported *engine.ErrConnectorCapabilityNotSupported
+	var limitsExceeded *services.ErrPaymentInitiationLimitsExceeded
 
 	switch {
 	case errors.Is(err, storage.ErrDuplicateKeyValue):
@@ -32,6 +34,8 @@ func handleServiceErrors(w http.ResponseWriter, r *http.Request, err error) {
 		api.BadRequest(w, ErrValidation, errors.Cause(err))
 	case errors.Is(err, storage.ErrValidation):
 		api.BadRequest(w, ErrValidation, err)
+	case errors.As(err, &limitsExceeded):
+		api.BadRequest(w, ErrLimitExceeded, err)
 	case errors.Is(err, services.ErrValidation), errors.Is(err, connectors.ErrValidation):
 		api.BadRequest(w, ErrValidation, err)
 	case errors.Is(err, services.ErrNotFound):
@@ -0,0 +1,90 @@
+package v3
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/formancehq/go-libs/v5/pkg/transport/api"
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/api/validation"
+	"github.com/formancehq/payments/internal/otel"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type AccountsCreateVirtualRequest struct {
+	ConnectorID  string            `json:"connectorID" validate:"required,connectorID"`
+	Reference    string            `json:"reference" validate:"required,gte=3,lte=1000"`
+	Name         string            `json:"accountName" validate:"required,lte=1000"`
+	DefaultAsset string            `json:"defaultAsset" validate:"omitempty,asset"`
+	PsuID        string            `json:"psuID" validate:"omitempty,uuid"`
+	Metadata     map[string]string `json:"metadata" validate:""`
+}
+
+type AccountsCreateVirtualResponse struct {
+	TaskID string `json:"taskID"`
+}
+
+func accountsCreateVirtual(backend backend.Backend, validator *validation.Validator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := otel.Tracer().Start(r.Context(), "v3_accountsCreateVirtual")
+		defer span.End()
+
+		var req AccountsCreateVirtualRequest
+		err := json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrMissingOrInvalidBody, err)
+			return
+		}
+
+		populateSpanFromAccountsCreateVirtualRequest(span, req)
+
+		if _, err := validator.Validate(req); err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrValidation, err)
+			return
+		}
+
+		var psuID *uuid.UUID
+		if req.PsuID != "" {
+			id := uuid.MustParse(req.PsuID)
+			psuID = &id
+		}
+
+		createAccount := models.CreateAccountRequest{
+			Reference: req.Reference,
+			Name:      req.Name,
+			Metadata:  req.Metadata,
+		}
+		if req.DefaultAsset != "" {
+			createAccount.DefaultAsset = &req.DefaultAsset
+		}
+
+		connectorID := models.MustConnectorIDFromString(req.ConnectorID)
+		task, err := backend.AccountsCreateVirtual(ctx, connectorID, psuID, createAccount, false)
+		if err != nil {
+			otel.RecordError(span, err)
+			handleServiceErrors(w, r, err)
+			return
+		}
+
+		api.Accepted(w, AccountsCreateVirtualResponse{
+			TaskID: task.ID.String(),
+		})
+	}
+}
+
+func populateSpanFromAccountsCreateVirtualRequest(span trace.Span, req AccountsCreateVirtualRequest) {
+	span.SetAttributes(attribute.String("connectorID", req.ConnectorID))
+	span.SetAttributes(attribute.String("reference", req.Reference))
+	span.SetAttributes(attribute.String("accountName", req.Name))
+	span.SetAttributes(attribute.String("defaultAsset", req.DefaultAsset))
+	span.SetAttributes(attribute.String("psuID", req.PsuID))
+	for k, v := range req.Metadata {
+		span.SetAttributes(attribute.String(fmt.Sprintf("metadata[%s]", k), v))
+	}
+}
@@ -0,0 +1,94 @@
+package v3
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/api/validation"
+	"github.com/formancehq/payments/internal/connectors/engine"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("API v3 Accounts Create Virtual", func() {
+	var (
+		handlerFn http.HandlerFunc
+		connID    models.ConnectorID
+		psuID     uuid.UUID
+	)
+	BeforeEach(func() {
+		connID = models.ConnectorID{Reference: uuid.New(), Provider: "psp"}
+		psuID = uuid.New()
+	})
+
+	Context("create virtual accounts", func() {
+		var (
+			w    *httptest.ResponseRecorder
+			m    *backend.MockBackend
+			creq AccountsCreateVirtualRequest
+		)
+		BeforeEach(func() {
+			w = httptest.NewRecorder()
+			ctrl := gomock.NewController(GinkgoT())
+			m = backend.NewMockBackend(ctrl)
+			handlerFn = accountsCreateVirtual(m, validation.NewValidator())
+			creq = AccountsCreateVirtualRequest{
+				ConnectorID:  connID.String(),
+				Reference:    "virtual-1",
+				Name:         "test",
+				DefaultAsset: "GBP/2",
+			}
+		})
+
+		validConnID := (&models.ConnectorID{Reference: uuid.New(), Provider: "psp"}).String()
+		DescribeTable("validation errors",
+			func(expected string, cReq AccountsCreateVirtualRequest) {
+				handlerFn(w, prepareJSONRequest(http.MethodPost, &cReq))
+				assertExpectedResponse(w.Result(), http.StatusBadRequest, expected)
+			},
+			Entry("connector ID missing", ErrValidation, AccountsCreateVirtualRequest{Reference: "virtual-1", Name: "test"}),
+			Entry("connector ID invalid", ErrValidation, AccountsCreateVirtualRequest{ConnectorID: "blah", Reference: "virtual-1", Name: "test"}),
+			Entry("reference missing", ErrValidation, AccountsCreateVirtualRequest{ConnectorID: validConnID, Name: "test"}),
+			Entry("name missing", ErrValidation, AccountsCreateVirtualRequest{ConnectorID: validConnID, Reference: "virtual-1"}),
+			Entry("asset invalid", ErrValidation, AccountsCreateVirtualRequest{ConnectorID: validConnID, Reference: "virtual-1", Name: "test", DefaultAsset: "invalid"}),
+			Entry("psu ID invalid", ErrValidation, AccountsCreateVirtualRequest{ConnectorID: validConnID, Reference: "virtual-1", Name: "test", PsuID: "invalid"}),
+		)
+
+		It("should return an internal server error when backend returns error", func(ctx SpecContext) {
+			m.EXPECT().AccountsCreateVirtual(gomock.Any(), connID, nil, gomock.Any(), false).Return(
+				models.Task{},
+				fmt.Errorf("account create err"),
+			)
+			handlerFn(w, prepareJSONRequest(http.MethodPost, &creq))
+			assertExpectedResponse(w.Result(), http.StatusInternalServerError, "INTERNAL")
+		})
+
+		It("should return a bad request when the connector cannot create accounts", func(ctx SpecContext) {
+			m.EXPECT().AccountsCreateVirtual(gomock.Any(), connID, nil, gomock.Any(), false).Return(
+				models.Task{},
+				&engine.ErrConnectorCapabilityNotSupported{Capability: "CREATE_ACCOUNT", Provider: "psp"},
+			)
+			handlerFn(w, prepareJSONRequest(http.MethodPost, &creq))
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrConnectorCapabilityNotSupported)
+		})
+
+		It("should return status accepted on success", func(ctx SpecContext) {
+			creq.PsuID = psuID.String()
+			asset := "GBP/2"
+			m.EXPECT().AccountsCreateVirtual(gomock.Any(), connID, &psuID, models.CreateAccountRequest{
+				Reference:    "virtual-1",
+				Name:         "test",
+				DefaultAsset: &asset,
+			}, false).Return(
+				models.Task{},
+				nil,
+			)
+			handlerFn(w, prepareJSONRequest(http.MethodPost, &creq))
+			assertExpectedResponse(w.Result(), http.StatusAccepted, "data")
+		})
+	})
+})
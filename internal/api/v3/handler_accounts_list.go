@@ -17,7 +17,12 @@ func accountsList(backend backend.Backend) http.HandlerFunc {
 		defer span.End()
 
 		query, err := paginate.Extract[storage.ListAccountsQuery](r, func() (*storage.ListAccountsQuery, error) {
-			options, err := getPagination(span, r, storage.AccountQuery{})
+			sort, err := getSort(span, r)
+			if err != nil {
+				return nil, err
+			}
+
+			options, err := getPagination(span, r, storage.AccountQuery{Sort: sort})
 			if err != nil {
 				return nil, err
 			}
@@ -18,7 +18,12 @@ func bankAccountsList(backend backend.Backend) http.HandlerFunc {
 		defer span.End()
 
 		query, err := paginate.Extract[storage.ListBankAccountsQuery](r, func() (*storage.ListBankAccountsQuery, error) {
-			options, err := getPagination(span, r, storage.BankAccountQuery{})
+			sort, err := getSort(span, r)
+			if err != nil {
+				return nil, err
+			}
+
+			options, err := getPagination(span, r, storage.BankAccountQuery{Sort: sort})
 			if err != nil {
 				return nil, err
 			}
@@ -0,0 +1,66 @@
+package v3
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/formancehq/go-libs/v5/pkg/transport/api"
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/api/validation"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/formancehq/payments/internal/otel"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type BankAccountsVerifyRequest struct {
+	ConnectorID string `json:"connectorID" validate:"required,connectorID"`
+}
+
+type BankAccountsVerifyResponse struct {
+	TaskID string `json:"taskID"`
+}
+
+func bankAccountsVerify(backend backend.Backend, validator *validation.Validator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := otel.Tracer().Start(r.Context(), "v3_bankAccountsVerify")
+		defer span.End()
+
+		span.SetAttributes(attribute.String("bankAccountID", bankAccountID(r)))
+		id, err := uuid.Parse(bankAccountID(r))
+		if err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrInvalidID, err)
+			return
+		}
+
+		var req BankAccountsVerifyRequest
+		err = json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrMissingOrInvalidBody, err)
+			return
+		}
+
+		span.SetAttributes(attribute.String("connectorID", req.ConnectorID))
+
+		_, err = validator.Validate(req)
+		if err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrValidation, err)
+			return
+		}
+
+		connectorID := models.MustConnectorIDFromString(req.ConnectorID)
+		task, err := backend.BankAccountsVerify(ctx, id, connectorID, false)
+		if err != nil {
+			otel.RecordError(span, err)
+			handleServiceErrors(w, r, err)
+			return
+		}
+
+		api.Accepted(w, BankAccountsVerifyResponse{
+			TaskID: task.ID.String(),
+		})
+	}
+}
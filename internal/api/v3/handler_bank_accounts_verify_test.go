@@ -0,0 +1,86 @@
+package v3
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/api/validation"
+	"github.com/formancehq/payments/internal/connectors/engine"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("API v3 Bank Accounts Verify", func() {
+	var (
+		handlerFn     http.HandlerFunc
+		bankAccountID uuid.UUID
+		connID        models.ConnectorID
+	)
+	BeforeEach(func() {
+		bankAccountID = uuid.New()
+		connID = models.ConnectorID{Reference: uuid.New(), Provider: "psp"}
+	})
+
+	Context("verify bank accounts", func() {
+		var (
+			w    *httptest.ResponseRecorder
+			m    *backend.MockBackend
+			freq BankAccountsVerifyRequest
+		)
+		BeforeEach(func() {
+			w = httptest.NewRecorder()
+			ctrl := gomock.NewController(GinkgoT())
+			m = backend.NewMockBackend(ctrl)
+			handlerFn = bankAccountsVerify(m, validation.NewValidator())
+		})
+
+		DescribeTable("validation errors",
+			func(expected string, freq BankAccountsVerifyRequest) {
+				handlerFn(w, prepareJSONRequestWithQuery(http.MethodPost, "bankAccountID", bankAccountID.String(), &freq))
+				assertExpectedResponse(w.Result(), http.StatusBadRequest, expected)
+			},
+			Entry("connector ID missing", ErrValidation, BankAccountsVerifyRequest{}),
+			Entry("connector ID invalid", ErrValidation, BankAccountsVerifyRequest{ConnectorID: "blah"}),
+		)
+
+		It("should return an internal server error when backend returns error", func(ctx SpecContext) {
+			m.EXPECT().BankAccountsVerify(gomock.Any(), bankAccountID, connID, false).Return(
+				models.Task{},
+				fmt.Errorf("bank account verify err"),
+			)
+			freq = BankAccountsVerifyRequest{
+				ConnectorID: connID.String(),
+			}
+			handlerFn(w, prepareJSONRequestWithQuery(http.MethodPost, "bankAccountID", bankAccountID.String(), &freq))
+			assertExpectedResponse(w.Result(), http.StatusInternalServerError, "INTERNAL")
+		})
+
+		It("should return a bad request when the connector cannot verify bank accounts", func(ctx SpecContext) {
+			m.EXPECT().BankAccountsVerify(gomock.Any(), bankAccountID, connID, false).Return(
+				models.Task{},
+				&engine.ErrConnectorCapabilityNotSupported{Capability: "VERIFY_BANK_ACCOUNT", Provider: "psp"},
+			)
+			freq = BankAccountsVerifyRequest{
+				ConnectorID: connID.String(),
+			}
+			handlerFn(w, prepareJSONRequestWithQuery(http.MethodPost, "bankAccountID", bankAccountID.String(), &freq))
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrConnectorCapabilityNotSupported)
+		})
+
+		It("should return status accepted on success", func(ctx SpecContext) {
+			m.EXPECT().BankAccountsVerify(gomock.Any(), bankAccountID, connID, false).Return(
+				models.Task{},
+				nil,
+			)
+			freq = BankAccountsVerifyRequest{
+				ConnectorID: connID.String(),
+			}
+			handlerFn(w, prepareJSONRequestWithQuery(http.MethodPost, "bankAccountID", bankAccountID.String(), &freq))
+			assertExpectedResponse(w.Result(), http.StatusAccepted, "data")
+		})
+	})
+})
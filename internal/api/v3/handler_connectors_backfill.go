@@ -0,0 +1,72 @@
+package v3
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/transport/api"
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/api/validation"
+	"github.com/formancehq/payments/internal/otel"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type ConnectorsBackfillRequest struct {
+	Capability string    `json:"capability" validate:"required,capability"`
+	From       time.Time `json:"from" validate:"required"`
+	To         time.Time `json:"to" validate:"required"`
+}
+
+func connectorsBackfill(backend backend.Backend, validator *validation.Validator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := otel.Tracer().Start(r.Context(), "v3_connectorsBackfill")
+		defer span.End()
+
+		span.SetAttributes(attribute.String("connectorID", connectorID(r)))
+		connectorID, err := models.ConnectorIDFromString(connectorID(r))
+		if err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrInvalidID, err)
+			return
+		}
+
+		var req ConnectorsBackfillRequest
+		err = json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrMissingOrInvalidBody, err)
+			return
+		}
+
+		span.SetAttributes(
+			attribute.String("capability", req.Capability),
+			attribute.String("from", req.From.String()),
+			attribute.String("to", req.To.String()),
+		)
+
+		_, err = validator.Validate(req)
+		if err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrValidation, err)
+			return
+		}
+
+		var capability models.Capability
+		// already checked by the validator
+		_ = capability.Scan(req.Capability)
+
+		task, err := backend.ConnectorsBackfill(ctx, connectorID, capability, models.FetchWindow{
+			From: req.From,
+			To:   req.To,
+		})
+		if err != nil {
+			otel.RecordError(span, err)
+			handleServiceErrors(w, r, err)
+			return
+		}
+
+		api.Accepted(w, task.ID.String())
+	}
+}
@@ -0,0 +1,93 @@
+package v3
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/api/services"
+	"github.com/formancehq/payments/internal/api/validation"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("API v3 Connectors backfill", func() {
+	var (
+		handlerFn http.HandlerFunc
+		connID    models.ConnectorID
+		from      time.Time
+		to        time.Time
+	)
+	BeforeEach(func() {
+		connID = models.ConnectorID{Reference: uuid.New(), Provider: "psp"}
+		from = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		to = time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	})
+
+	Context("backfill connectors", func() {
+		var (
+			w *httptest.ResponseRecorder
+			m *backend.MockBackend
+		)
+		BeforeEach(func() {
+			w = httptest.NewRecorder()
+			ctrl := gomock.NewController(GinkgoT())
+			m = backend.NewMockBackend(ctrl)
+			handlerFn = connectorsBackfill(m, validation.NewValidator())
+		})
+
+		It("should return a bad request error when connector ID is invalid", func(ctx SpecContext) {
+			req := prepareQueryRequest(http.MethodPost, "connectorID", "invalid")
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrInvalidID)
+		})
+
+		It("should return a bad request error when body is missing", func(ctx SpecContext) {
+			req := prepareQueryRequest(http.MethodPost, "connectorID", connID.String())
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrMissingOrInvalidBody)
+		})
+
+		DescribeTable("validation errors",
+			func(req ConnectorsBackfillRequest) {
+				handlerFn(w, prepareJSONRequestWithQuery(http.MethodPost, "connectorID", connID.String(), &req))
+				assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrValidation)
+			},
+			Entry("capability missing", ConnectorsBackfillRequest{From: time.Now().Add(-time.Hour), To: time.Now()}),
+			Entry("capability invalid", ConnectorsBackfillRequest{Capability: "invalid", From: time.Now().Add(-time.Hour), To: time.Now()}),
+			Entry("from missing", ConnectorsBackfillRequest{Capability: "FETCH_PAYMENTS", To: time.Now()}),
+			Entry("to missing", ConnectorsBackfillRequest{Capability: "FETCH_PAYMENTS", From: time.Now()}),
+		)
+
+		It("should return a bad request error when backend returns a validation error", func(ctx SpecContext) {
+			m.EXPECT().ConnectorsBackfill(gomock.Any(), connID, models.CAPABILITY_FETCH_PAYMENTS, gomock.Any()).
+				Return(models.Task{}, fmt.Errorf("from must be before to: %w", services.ErrValidation))
+			req := ConnectorsBackfillRequest{Capability: "FETCH_PAYMENTS", From: to, To: from}
+			handlerFn(w, prepareJSONRequestWithQuery(http.MethodPost, "connectorID", connID.String(), &req))
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrValidation)
+		})
+
+		It("should return an internal server error when backend returns error", func(ctx SpecContext) {
+			expectedErr := errors.New("connectors backfill err")
+			m.EXPECT().ConnectorsBackfill(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(models.Task{}, expectedErr)
+			req := ConnectorsBackfillRequest{Capability: "FETCH_PAYMENTS", From: from, To: to}
+			handlerFn(w, prepareJSONRequestWithQuery(http.MethodPost, "connectorID", connID.String(), &req))
+			assertExpectedResponse(w.Result(), http.StatusInternalServerError, "INTERNAL")
+		})
+
+		It("should return status accepted on success", func(ctx SpecContext) {
+			m.EXPECT().ConnectorsBackfill(gomock.Any(), connID, models.CAPABILITY_FETCH_PAYMENTS, models.FetchWindow{From: from, To: to}).
+				Return(models.Task{}, nil)
+			req := ConnectorsBackfillRequest{Capability: "FETCH_PAYMENTS", From: from, To: to}
+			handlerFn(w, prepareJSONRequestWithQuery(http.MethodPost, "connectorID", connID.String(), &req))
+			assertExpectedResponse(w.Result(), http.StatusAccepted, "data")
+		})
+	})
+})
@@ -0,0 +1,69 @@
+package v3
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/formancehq/go-libs/v5/pkg/transport/api"
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/api/validation"
+	"github.com/formancehq/payments/internal/otel"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type ConnectorsCloneRequest struct {
+	Name string `json:"name" validate:"required,gte=3,lte=500"`
+	// Config fields overriding the ones of the cloned connector, e.g. the
+	// credentials or the environment
+	Config map[string]json.RawMessage `json:"config,omitempty"`
+}
+
+func connectorsClone(backend backend.Backend, validator *validation.Validator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := otel.Tracer().Start(r.Context(), "v3_connectorsClone")
+		defer span.End()
+
+		span.SetAttributes(attribute.String("connectorID", connectorID(r)))
+		connectorID, err := models.ConnectorIDFromString(connectorID(r))
+		if err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrInvalidID, err)
+			return
+		}
+
+		var req ConnectorsCloneRequest
+		err = json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrMissingOrInvalidBody, err)
+			return
+		}
+
+		// overridden values may be credentials, they are never added to the span
+		span.SetAttributes(attribute.String("name", req.Name))
+
+		_, err = validator.Validate(req)
+		if err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrValidation, err)
+			return
+		}
+
+		overrides, err := json.Marshal(req.Config)
+		if err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrMissingOrInvalidBody, err)
+			return
+		}
+
+		clone, err := backend.ConnectorsClone(ctx, connectorID, req.Name, overrides)
+		if err != nil {
+			otel.RecordError(span, err)
+			handleServiceErrors(w, r, err)
+			return
+		}
+
+		api.Created(w, clone)
+	}
+}
@@ -0,0 +1,104 @@
+package v3
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/api/services"
+	"github.com/formancehq/payments/internal/api/validation"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("API v3 Connectors clone", func() {
+	var (
+		handlerFn http.HandlerFunc
+		connID    models.ConnectorID
+	)
+	BeforeEach(func() {
+		connID = models.ConnectorID{Reference: uuid.New(), Provider: "psp"}
+	})
+
+	Context("clone connector", func() {
+		var (
+			w *httptest.ResponseRecorder
+			m *backend.MockBackend
+		)
+		BeforeEach(func() {
+			w = httptest.NewRecorder()
+			ctrl := gomock.NewController(GinkgoT())
+			m = backend.NewMockBackend(ctrl)
+			handlerFn = connectorsClone(m, validation.NewValidator())
+		})
+
+		It("should return a bad request error when connector ID is invalid", func(ctx SpecContext) {
+			req := prepareQueryRequest(http.MethodPost, "connectorID", "invalid")
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrInvalidID)
+		})
+
+		It("should return a bad request error when body is missing", func(ctx SpecContext) {
+			req := prepareQueryRequest(http.MethodPost, "connectorID", connID.String())
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrMissingOrInvalidBody)
+		})
+
+		DescribeTable("validation errors",
+			func(req ConnectorsCloneRequest) {
+				handlerFn(w, prepareJSONRequestWithQuery(http.MethodPost, "connectorID", connID.String(), &req))
+				assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrValidation)
+			},
+			Entry("name missing", ConnectorsCloneRequest{}),
+			Entry("name too short", ConnectorsCloneRequest{Name: "ab"}),
+		)
+
+		It("should return a not found error when backend returns a not found error", func(ctx SpecContext) {
+			m.EXPECT().ConnectorsClone(gomock.Any(), connID, "psp-prod", gomock.Any()).
+				Return(models.ConnectorClone{}, fmt.Errorf("connector: %w", services.ErrNotFound))
+			req := ConnectorsCloneRequest{Name: "psp-prod"}
+			handlerFn(w, prepareJSONRequestWithQuery(http.MethodPost, "connectorID", connID.String(), &req))
+
+			assertExpectedResponse(w.Result(), http.StatusNotFound, "NOT_FOUND")
+		})
+
+		It("should return an internal server error when backend returns error", func(ctx SpecContext) {
+			m.EXPECT().ConnectorsClone(gomock.Any(), connID, "psp-prod", gomock.Any()).
+				Return(models.ConnectorClone{}, fmt.Errorf("clone error"))
+			req := ConnectorsCloneRequest{Name: "psp-prod"}
+			handlerFn(w, prepareJSONRequestWithQuery(http.MethodPost, "connectorID", connID.String(), &req))
+
+			assertExpectedResponse(w.Result(), http.StatusInternalServerError, "INTERNAL")
+		})
+
+		It("should return the clone with status created", func(ctx SpecContext) {
+			clone := models.ConnectorClone{
+				ConnectorID: models.ConnectorID{Reference: uuid.New(), Provider: "psp"},
+				PoolIDs:     []uuid.UUID{uuid.New()},
+			}
+			m.EXPECT().ConnectorsClone(gomock.Any(), connID, "psp-prod", gomock.Any()).DoAndReturn(
+				func(_ any, _ models.ConnectorID, _ string, overrides json.RawMessage) (models.ConnectorClone, error) {
+					Expect(overrides).To(MatchJSON(`{"apiKey":"live","environment":"production"}`))
+					return clone, nil
+				},
+			)
+			req := ConnectorsCloneRequest{
+				Name: "psp-prod",
+				Config: map[string]json.RawMessage{
+					"apiKey":      json.RawMessage(`"live"`),
+					"environment": json.RawMessage(`"production"`),
+				},
+			}
+			handlerFn(w, prepareJSONRequestWithQuery(http.MethodPost, "connectorID", connID.String(), &req))
+
+			assertExpectedResponse(w.Result(), http.StatusCreated, "data")
+		})
+	})
+})
@@ -0,0 +1,62 @@
+package v3
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/formancehq/go-libs/v5/pkg/transport/api"
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/api/validation"
+	"github.com/formancehq/payments/internal/otel"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type ConnectorsExportRequest struct {
+	// Defaults to REDACTED
+	Secrets string `json:"secrets" validate:"omitempty,oneof=PLAIN REDACTED ENCRYPTED"`
+	// Config encryption key of the target environment, required to export
+	// ENCRYPTED secrets.
+	EncryptionKey string `json:"encryptionKey" validate:"required_if=Secrets ENCRYPTED"`
+}
+
+func connectorsExport(backend backend.Backend, validator *validation.Validator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := otel.Tracer().Start(r.Context(), "v3_connectorsExport")
+		defer span.End()
+
+		var req ConnectorsExportRequest
+		err := json.NewDecoder(r.Body).Decode(&req)
+		if err != nil && !errors.Is(err, io.EOF) {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrMissingOrInvalidBody, err)
+			return
+		}
+
+		if req.Secrets == "" {
+			req.Secrets = string(models.CONNECTORS_EXPORT_SECRETS_REDACTED)
+		}
+		span.SetAttributes(attribute.String("secrets", req.Secrets))
+
+		_, err = validator.Validate(req)
+		if err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrValidation, err)
+			return
+		}
+
+		export, err := backend.ConnectorsExport(ctx, models.ConnectorsExportOptions{
+			Secrets:       models.ConnectorsExportSecrets(req.Secrets),
+			EncryptionKey: req.EncryptionKey,
+		})
+		if err != nil {
+			otel.RecordError(span, err)
+			handleServiceErrors(w, r, err)
+			return
+		}
+
+		api.Ok(w, export)
+	}
+}
@@ -0,0 +1,73 @@
+package v3
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/api/validation"
+	"github.com/formancehq/payments/pkg/domain/models"
+	. "github.com/onsi/ginkgo/v2"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("API v3 Connectors Export", func() {
+	var (
+		handlerFn http.HandlerFunc
+	)
+
+	Context("export connectors", func() {
+		var (
+			w *httptest.ResponseRecorder
+			m *backend.MockBackend
+		)
+		BeforeEach(func() {
+			w = httptest.NewRecorder()
+			ctrl := gomock.NewController(GinkgoT())
+			m = backend.NewMockBackend(ctrl)
+			handlerFn = connectorsExport(m, validation.NewValidator())
+		})
+
+		It("should return a bad request error when body is invalid", func(ctx SpecContext) {
+			handlerFn(w, prepareJSONRequest(http.MethodPost, "invalid"))
+
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrMissingOrInvalidBody)
+		})
+
+		DescribeTable("validation errors",
+			func(req ConnectorsExportRequest) {
+				handlerFn(w, prepareJSONRequest(http.MethodPost, &req))
+				assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrValidation)
+			},
+			Entry("unknown secrets mode", ConnectorsExportRequest{Secrets: "HIDDEN"}),
+			Entry("missing encryption key", ConnectorsExportRequest{Secrets: "ENCRYPTED"}),
+		)
+
+		It("should redact secrets by default", func(ctx SpecContext) {
+			m.EXPECT().ConnectorsExport(gomock.Any(), models.ConnectorsExportOptions{
+				Secrets: models.CONNECTORS_EXPORT_SECRETS_REDACTED,
+			}).Return(models.ConnectorsExport{}, nil)
+			handlerFn(w, httptest.NewRequest(http.MethodPost, "/", nil))
+
+			assertExpectedResponse(w.Result(), http.StatusOK, "data")
+		})
+
+		It("should return an internal server error when backend returns error", func(ctx SpecContext) {
+			m.EXPECT().ConnectorsExport(gomock.Any(), gomock.Any()).Return(models.ConnectorsExport{}, fmt.Errorf("export error"))
+			handlerFn(w, prepareJSONRequest(http.MethodPost, &ConnectorsExportRequest{Secrets: "PLAIN"}))
+
+			assertExpectedResponse(w.Result(), http.StatusInternalServerError, "INTERNAL")
+		})
+
+		It("should return the export", func(ctx SpecContext) {
+			m.EXPECT().ConnectorsExport(gomock.Any(), models.ConnectorsExportOptions{
+				Secrets:       models.CONNECTORS_EXPORT_SECRETS_ENCRYPTED,
+				EncryptionKey: "target",
+			}).Return(models.ConnectorsExport{Version: models.ConnectorsExportVersion}, nil)
+			handlerFn(w, prepareJSONRequest(http.MethodPost, &ConnectorsExportRequest{Secrets: "ENCRYPTED", EncryptionKey: "target"}))
+
+			assertExpectedResponse(w.Result(), http.StatusOK, "data")
+		})
+	})
+})
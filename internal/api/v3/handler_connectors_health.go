@@ -0,0 +1,35 @@
+package v3
+
+import (
+	"net/http"
+
+	"github.com/formancehq/go-libs/v5/pkg/transport/api"
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/otel"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func connectorsHealth(backend backend.Backend) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := otel.Tracer().Start(r.Context(), "v3_connectorsHealth")
+		defer span.End()
+
+		span.SetAttributes(attribute.String("connectorID", connectorID(r)))
+		id, err := models.ConnectorIDFromString(connectorID(r))
+		if err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrInvalidID, err)
+			return
+		}
+
+		health, err := backend.ConnectorsHealth(ctx, id)
+		if err != nil {
+			otel.RecordError(span, err)
+			handleServiceErrors(w, r, err)
+			return
+		}
+
+		api.Ok(w, health)
+	}
+}
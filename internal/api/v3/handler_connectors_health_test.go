@@ -0,0 +1,71 @@
+package v3
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/api/services"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("API v3 Connectors Health", func() {
+	var (
+		handlerFn http.HandlerFunc
+		connID    models.ConnectorID
+	)
+	BeforeEach(func() {
+		connID = models.ConnectorID{Reference: uuid.New(), Provider: "psp"}
+	})
+
+	Context("get connector health", func() {
+		var (
+			w *httptest.ResponseRecorder
+			m *backend.MockBackend
+		)
+		BeforeEach(func() {
+			w = httptest.NewRecorder()
+			ctrl := gomock.NewController(GinkgoT())
+			m = backend.NewMockBackend(ctrl)
+			handlerFn = connectorsHealth(m)
+		})
+
+		It("should return an invalid ID error when connector ID is invalid", func(ctx SpecContext) {
+			req := prepareQueryRequest(http.MethodGet, "connectorID", "invalidvalue")
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrInvalidID)
+		})
+
+		It("should return a not found error when the connector does not exist", func(ctx SpecContext) {
+			req := prepareQueryRequest(http.MethodGet, "connectorID", connID.String())
+			m.EXPECT().ConnectorsHealth(gomock.Any(), connID).Return(nil, services.ErrNotFound)
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusNotFound, "NOT_FOUND")
+		})
+
+		It("should return an internal server error when backend returns error", func(ctx SpecContext) {
+			req := prepareQueryRequest(http.MethodGet, "connectorID", connID.String())
+			m.EXPECT().ConnectorsHealth(gomock.Any(), connID).Return(nil, fmt.Errorf("connector health error"))
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusInternalServerError, "INTERNAL")
+		})
+
+		It("should return data object", func(ctx SpecContext) {
+			req := prepareQueryRequest(http.MethodGet, "connectorID", connID.String())
+			m.EXPECT().ConnectorsHealth(gomock.Any(), connID).Return(&models.ConnectorHealth{
+				ConnectorID: connID,
+				Status:      models.CONNECTOR_HEALTH_STATUS_HEALTHY,
+			}, nil)
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusOK, "data")
+		})
+	})
+})
@@ -0,0 +1,39 @@
+package v3
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/formancehq/go-libs/v5/pkg/transport/api"
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/otel"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func connectorsImport(backend backend.Backend) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := otel.Tracer().Start(r.Context(), "v3_connectorsImport")
+		defer span.End()
+
+		dryRun := r.URL.Query().Get("dryRun") == "true"
+		span.SetAttributes(attribute.Bool("dryRun", dryRun))
+
+		var document models.ConnectorsExport
+		err := json.NewDecoder(r.Body).Decode(&document)
+		if err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrMissingOrInvalidBody, err)
+			return
+		}
+
+		plan, err := backend.ConnectorsImport(ctx, document, dryRun)
+		if err != nil {
+			otel.RecordError(span, err)
+			handleServiceErrors(w, r, err)
+			return
+		}
+
+		api.Ok(w, plan)
+	}
+}
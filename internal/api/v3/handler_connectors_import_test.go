@@ -0,0 +1,75 @@
+package v3
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/api/services"
+	"github.com/formancehq/payments/pkg/domain/models"
+	. "github.com/onsi/ginkgo/v2"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("API v3 Connectors Import", func() {
+	var (
+		handlerFn http.HandlerFunc
+		document  models.ConnectorsExport
+	)
+	BeforeEach(func() {
+		document = models.ConnectorsExport{
+			Version: models.ConnectorsExportVersion,
+			Secrets: models.CONNECTORS_EXPORT_SECRETS_REDACTED,
+		}
+	})
+
+	Context("import connectors", func() {
+		var (
+			w *httptest.ResponseRecorder
+			m *backend.MockBackend
+		)
+		BeforeEach(func() {
+			w = httptest.NewRecorder()
+			ctrl := gomock.NewController(GinkgoT())
+			m = backend.NewMockBackend(ctrl)
+			handlerFn = connectorsImport(m)
+		})
+
+		It("should return a bad request error when body is missing", func(ctx SpecContext) {
+			handlerFn(w, httptest.NewRequest(http.MethodPost, "/", nil))
+
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrMissingOrInvalidBody)
+		})
+
+		It("should return a validation error when the document is invalid", func(ctx SpecContext) {
+			m.EXPECT().ConnectorsImport(gomock.Any(), gomock.Any(), false).Return(models.ConnectorsImportPlan{}, services.ErrValidation)
+			handlerFn(w, prepareJSONRequest(http.MethodPost, &document))
+
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrValidation)
+		})
+
+		It("should return an internal server error when backend returns error", func(ctx SpecContext) {
+			m.EXPECT().ConnectorsImport(gomock.Any(), gomock.Any(), false).Return(models.ConnectorsImportPlan{}, fmt.Errorf("import error"))
+			handlerFn(w, prepareJSONRequest(http.MethodPost, &document))
+
+			assertExpectedResponse(w.Result(), http.StatusInternalServerError, "INTERNAL")
+		})
+
+		It("should only compute the plan on dry run", func(ctx SpecContext) {
+			m.EXPECT().ConnectorsImport(gomock.Any(), gomock.Any(), true).Return(models.ConnectorsImportPlan{}, nil)
+			req := prepareJSONRequest(http.MethodPost, &document)
+			req.URL.RawQuery = "dryRun=true"
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusOK, "data")
+		})
+
+		It("should return the applied plan", func(ctx SpecContext) {
+			m.EXPECT().ConnectorsImport(gomock.Any(), document, false).Return(models.ConnectorsImportPlan{Applied: true}, nil)
+			handlerFn(w, prepareJSONRequest(http.MethodPost, &document))
+
+			assertExpectedResponse(w.Result(), http.StatusOK, "data")
+		})
+	})
+})
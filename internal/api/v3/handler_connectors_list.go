@@ -66,6 +66,7 @@ type v3Connector struct {
 	ScheduledForDeletion bool                `json:"scheduledForDeletion"`
 	Capabilities         []models.Capability `json:"capabilities"`
 	UpdatedAt            *time.Time          `json:"updatedAt,omitempty"`
+	Environment          string              `json:"environment,omitempty"`
 }
 
 func newV3Connector(c models.Connector, provider string, caps []models.Capability) v3Connector {
@@ -85,5 +86,6 @@ func newV3Connector(c models.Connector, provider string, caps []models.Capabilit
 		ScheduledForDeletion: c.ScheduledForDeletion,
 		Capabilities:         caps,
 		UpdatedAt:            c.UpdatedAt,
+		Environment:          c.Environment,
 	}
 }
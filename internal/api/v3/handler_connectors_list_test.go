@@ -117,5 +117,33 @@ var _ = Describe("API v3 Connectors List", func() {
 			Expect(json.NewDecoder(res.Body).Decode(&body)).To(Succeed())
 			Expect(body.Cursor.Data[0].Capabilities).To(BeEmpty())
 		})
+
+		It("should emit the environment of the connector", func(ctx SpecContext) {
+			connectorID := models.ConnectorID{Reference: uuid.New(), Provider: "stripe"}
+			m.EXPECT().ConnectorsList(gomock.Any(), gomock.Any()).Return(
+				&paginate.Cursor[models.Connector]{Data: []models.Connector{{
+					ConnectorBase: models.ConnectorBase{ID: connectorID, Provider: "stripe"},
+					Config:        json.RawMessage(`{}`),
+					Environment:   "sandbox",
+				}}},
+				nil,
+			)
+			m.EXPECT().ConnectorsCapabilities().Return(map[string][]models.Capability{})
+
+			handlerFn(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			var body struct {
+				Cursor struct {
+					Data []struct {
+						Environment string `json:"environment"`
+					} `json:"data"`
+				} `json:"cursor"`
+			}
+			Expect(json.NewDecoder(res.Body).Decode(&body)).To(Succeed())
+			Expect(body.Cursor.Data[0].Environment).To(Equal("sandbox"))
+		})
 	})
 })
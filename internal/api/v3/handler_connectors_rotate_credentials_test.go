@@ -0,0 +1,97 @@
+package v3
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/api/services"
+	"github.com/formancehq/payments/internal/api/validation"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("API v3 Connectors rotate credentials", func() {
+	var (
+		handlerFn   http.HandlerFunc
+		connID      models.ConnectorID
+		credentials map[string]json.RawMessage
+	)
+	BeforeEach(func() {
+		connID = models.ConnectorID{Reference: uuid.New(), Provider: "psp"}
+		credentials = map[string]json.RawMessage{"apiKey": json.RawMessage(`"new"`)}
+	})
+
+	Context("rotate connector credentials", func() {
+		var (
+			w *httptest.ResponseRecorder
+			m *backend.MockBackend
+		)
+		BeforeEach(func() {
+			w = httptest.NewRecorder()
+			ctrl := gomock.NewController(GinkgoT())
+			m = backend.NewMockBackend(ctrl)
+			handlerFn = connectorsRotateCredentials(m, validation.NewValidator())
+		})
+
+		It("should return a bad request error when connector ID is invalid", func(ctx SpecContext) {
+			req := prepareQueryRequest(http.MethodPost, "connectorID", "invalid")
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrInvalidID)
+		})
+
+		It("should return a bad request error when body is missing", func(ctx SpecContext) {
+			req := prepareQueryRequest(http.MethodPost, "connectorID", connID.String())
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrMissingOrInvalidBody)
+		})
+
+		DescribeTable("validation errors",
+			func(req ConnectorsRotateCredentialsRequest) {
+				handlerFn(w, prepareJSONRequestWithQuery(http.MethodPost, "connectorID", connID.String(), &req))
+				assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrValidation)
+			},
+			Entry("credentials missing", ConnectorsRotateCredentialsRequest{}),
+			Entry("credentials empty", ConnectorsRotateCredentialsRequest{Credentials: map[string]json.RawMessage{}}),
+		)
+
+		It("should return a bad request error when backend returns a validation error", func(ctx SpecContext) {
+			m.EXPECT().ConnectorsRotateCredentials(gomock.Any(), connID, gomock.Any()).
+				Return(models.Task{}, fmt.Errorf("unknown config field: %w", services.ErrValidation))
+			req := ConnectorsRotateCredentialsRequest{Credentials: credentials}
+			handlerFn(w, prepareJSONRequestWithQuery(http.MethodPost, "connectorID", connID.String(), &req))
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrValidation)
+		})
+
+		It("should return a not found error when backend returns a not found error", func(ctx SpecContext) {
+			m.EXPECT().ConnectorsRotateCredentials(gomock.Any(), connID, gomock.Any()).
+				Return(models.Task{}, fmt.Errorf("connector: %w", services.ErrNotFound))
+			req := ConnectorsRotateCredentialsRequest{Credentials: credentials}
+			handlerFn(w, prepareJSONRequestWithQuery(http.MethodPost, "connectorID", connID.String(), &req))
+			assertExpectedResponse(w.Result(), http.StatusNotFound, "NOT_FOUND")
+		})
+
+		It("should return an internal server error when backend returns error", func(ctx SpecContext) {
+			expectedErr := errors.New("connectors rotate credentials err")
+			m.EXPECT().ConnectorsRotateCredentials(gomock.Any(), gomock.Any(), gomock.Any()).Return(models.Task{}, expectedErr)
+			req := ConnectorsRotateCredentialsRequest{Credentials: credentials}
+			handlerFn(w, prepareJSONRequestWithQuery(http.MethodPost, "connectorID", connID.String(), &req))
+			assertExpectedResponse(w.Result(), http.StatusInternalServerError, "INTERNAL")
+		})
+
+		It("should return status accepted on success", func(ctx SpecContext) {
+			m.EXPECT().ConnectorsRotateCredentials(gomock.Any(), connID, json.RawMessage(`{"apiKey":"new"}`)).
+				Return(models.Task{}, nil)
+			req := ConnectorsRotateCredentialsRequest{Credentials: credentials}
+			handlerFn(w, prepareJSONRequestWithQuery(http.MethodPost, "connectorID", connID.String(), &req))
+			assertExpectedResponse(w.Result(), http.StatusAccepted, "data")
+		})
+	})
+})
@@ -0,0 +1,44 @@
+package v3
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/formancehq/go-libs/v5/pkg/transport/api"
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func connectorsTest(backend backend.Backend) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := otel.Tracer().Start(r.Context(), "v3_connectorsTest")
+		defer span.End()
+
+		body := http.MaxBytesReader(w, r.Body, connectorConfigMaxBytes)
+		config, err := io.ReadAll(body)
+		if err != nil {
+			otel.RecordError(span, err)
+			if _, ok := err.(*http.MaxBytesError); ok {
+				api.WriteErrorResponse(w, http.StatusRequestEntityTooLarge, ErrMissingOrInvalidBody, err)
+				return
+			}
+			api.BadRequest(w, ErrMissingOrInvalidBody, err)
+			return
+		}
+
+		span.SetAttributes(attribute.String("provider", connector(r)))
+
+		provider := strings.ToLower(connector(r))
+
+		result, err := backend.ConnectorsTest(ctx, provider, config)
+		if err != nil {
+			otel.RecordError(span, err)
+			handleServiceErrors(w, r, err)
+			return
+		}
+
+		api.Ok(w, result)
+	}
+}
@@ -0,0 +1,85 @@
+package v3
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/api/services"
+	"github.com/formancehq/payments/pkg/domain/models"
+	. "github.com/onsi/ginkgo/v2"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("API v3 Connector Test", func() {
+	var (
+		handlerFn http.HandlerFunc
+		conn      string
+		config    json.RawMessage
+	)
+	BeforeEach(func() {
+		conn = "psp"
+		config = json.RawMessage("{}")
+	})
+
+	Context("test connector", func() {
+		var (
+			w *httptest.ResponseRecorder
+			m *backend.MockBackend
+		)
+		BeforeEach(func() {
+			w = httptest.NewRecorder()
+			ctrl := gomock.NewController(GinkgoT())
+			m = backend.NewMockBackend(ctrl)
+			handlerFn = connectorsTest(m)
+		})
+
+		It("should return a bad request error when the provider is unknown", func(ctx SpecContext) {
+			m.EXPECT().ConnectorsTest(gomock.Any(), conn, config).Return(
+				models.ConnectorTestResult{},
+				fmt.Errorf("plugin not found: %w", services.ErrValidation),
+			)
+			handlerFn(w, prepareJSONRequestWithQuery(http.MethodPost, "connector", conn, &config))
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrValidation)
+		})
+
+		It("should return an internal server error when backend returns error", func(ctx SpecContext) {
+			m.EXPECT().ConnectorsTest(gomock.Any(), conn, config).Return(
+				models.ConnectorTestResult{},
+				fmt.Errorf("connector test err"),
+			)
+			handlerFn(w, prepareJSONRequestWithQuery(http.MethodPost, "connector", conn, &config))
+			assertExpectedResponse(w.Result(), http.StatusInternalServerError, "INTERNAL")
+		})
+
+		It("should return a validation error when request body is too big", func(ctx SpecContext) {
+			data := oversizeRequestBody()
+			handlerFn(w, prepareJSONRequestWithQuery(http.MethodPost, "connector", conn, &data))
+
+			assertExpectedResponse(w.Result(), http.StatusRequestEntityTooLarge, "MISSING_OR_INVALID_BODY")
+		})
+
+		It("should return the diagnostics even when a check failed", func(ctx SpecContext) {
+			m.EXPECT().ConnectorsTest(gomock.Any(), conn, config).Return(
+				models.ConnectorTestResult{
+					Provider: conn,
+					Success:  false,
+					Checks: []models.ConnectorTestCheck{
+						{Name: models.ConnectorTestCheckConfig, Status: models.CONNECTOR_TEST_CHECK_STATUS_PASSED},
+						{
+							Name:      models.ConnectorTestCheckConnection,
+							Status:    models.CONNECTOR_TEST_CHECK_STATUS_FAILED,
+							ErrorType: models.CONNECTOR_TEST_ERROR_TYPE_CLIENT_ERROR,
+							Error:     "unauthorized",
+						},
+					},
+				},
+				nil,
+			)
+			handlerFn(w, prepareJSONRequestWithQuery(http.MethodPost, "connector", conn, &config))
+			assertExpectedResponse(w.Result(), http.StatusOK, "CLIENT_ERROR")
+		})
+	})
+})
@@ -17,7 +17,12 @@ func conversionsList(backend backend.Backend) http.HandlerFunc {
 		defer span.End()
 
 		query, err := paginate.Extract[storage.ListConversionsQuery](r, func() (*storage.ListConversionsQuery, error) {
-			options, err := getPagination(span, r, storage.ConversionQuery{})
+			sort, err := getSort(span, r)
+			if err != nil {
+				return nil, err
+			}
+
+			options, err := getPagination(span, r, storage.ConversionQuery{Sort: sort})
 			if err != nil {
 				return nil, err
 			}
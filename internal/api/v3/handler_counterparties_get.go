@@ -0,0 +1,42 @@
+package v3
+
+import (
+	"net/http"
+
+	"github.com/formancehq/go-libs/v5/pkg/transport/api"
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/api/common"
+	"github.com/formancehq/payments/internal/otel"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func counterpartiesGet(backend backend.Backend) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := otel.Tracer().Start(r.Context(), "v3_counterpartiesGet")
+		defer span.End()
+
+		span.SetAttributes(attribute.String("counterpartyID", counterpartyID(r)))
+		id, err := uuid.Parse(counterpartyID(r))
+		if err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrInvalidID, err)
+			return
+		}
+
+		counterparty, err := backend.CounterpartiesGet(ctx, id)
+		if err != nil {
+			otel.RecordError(span, err)
+			handleServiceErrors(w, r, err)
+			return
+		}
+
+		if err := counterparty.Obfuscate(); err != nil {
+			otel.RecordError(span, err)
+			common.InternalServerError(w, r, err)
+			return
+		}
+
+		api.Ok(w, counterparty)
+	}
+}
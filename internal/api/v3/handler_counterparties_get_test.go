@@ -0,0 +1,63 @@
+package v3
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("API v3 Counterparties", func() {
+	var (
+		handlerFn http.HandlerFunc
+		cpID      uuid.UUID
+	)
+	BeforeEach(func() {
+		cpID = uuid.New()
+	})
+
+	Context("get counterparties", func() {
+		var (
+			w *httptest.ResponseRecorder
+			m *backend.MockBackend
+		)
+		BeforeEach(func() {
+			w = httptest.NewRecorder()
+			ctrl := gomock.NewController(GinkgoT())
+			m = backend.NewMockBackend(ctrl)
+			handlerFn = counterpartiesGet(m)
+		})
+
+		It("should return an invalid ID error when counterparty ID is invalid", func(ctx SpecContext) {
+			req := prepareQueryRequest(http.MethodGet, "counterpartyID", "invalidvalue")
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrInvalidID)
+		})
+
+		It("should return an internal server error when backend returns error", func(ctx SpecContext) {
+			req := prepareQueryRequest(http.MethodGet, "counterpartyID", cpID.String())
+			m.EXPECT().CounterpartiesGet(gomock.Any(), cpID).Return(
+				&models.Counterparty{}, fmt.Errorf("counterparties get error"),
+			)
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusInternalServerError, "INTERNAL")
+		})
+
+		It("should return data object", func(ctx SpecContext) {
+			req := prepareQueryRequest(http.MethodGet, "counterpartyID", cpID.String())
+			m.EXPECT().CounterpartiesGet(gomock.Any(), cpID).Return(
+				&models.Counterparty{}, nil,
+			)
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusOK, "data")
+		})
+	})
+})
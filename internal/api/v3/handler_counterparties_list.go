@@ -0,0 +1,55 @@
+package v3
+
+import (
+	"net/http"
+
+	"github.com/formancehq/go-libs/v5/pkg/storage/bun/paginate"
+	"github.com/formancehq/go-libs/v5/pkg/transport/api"
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/api/common"
+	"github.com/formancehq/payments/internal/otel"
+	"github.com/formancehq/payments/internal/storage"
+)
+
+func counterpartiesList(backend backend.Backend) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := otel.Tracer().Start(r.Context(), "v3_counterpartiesList")
+		defer span.End()
+
+		query, err := paginate.Extract[storage.ListCounterpartiesQuery](r, func() (*storage.ListCounterpartiesQuery, error) {
+			sort, err := getSort(span, r)
+			if err != nil {
+				return nil, err
+			}
+
+			options, err := getPagination(span, r, storage.CounterpartyQuery{Sort: sort})
+			if err != nil {
+				return nil, err
+			}
+			return pointer.For(storage.NewListCounterpartiesQuery(*options)), nil
+		})
+		if err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrValidation, err)
+			return
+		}
+
+		cursor, err := backend.CounterpartiesList(ctx, *query)
+		if err != nil {
+			otel.RecordError(span, err)
+			handleServiceErrors(w, r, err)
+			return
+		}
+
+		for i := range cursor.Data {
+			if err := cursor.Data[i].Obfuscate(); err != nil {
+				otel.RecordError(span, err)
+				common.InternalServerError(w, r, err)
+				return
+			}
+		}
+
+		api.RenderCursor(w, *cursor)
+	}
+}
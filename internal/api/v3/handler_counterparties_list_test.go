@@ -0,0 +1,52 @@
+package v3
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/formancehq/go-libs/v5/pkg/storage/bun/paginate"
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/pkg/domain/models"
+	. "github.com/onsi/ginkgo/v2"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("API v3 Counterparties List", func() {
+	var (
+		handlerFn http.HandlerFunc
+	)
+
+	Context("list counterparties", func() {
+		var (
+			w *httptest.ResponseRecorder
+			m *backend.MockBackend
+		)
+		BeforeEach(func() {
+			w = httptest.NewRecorder()
+			ctrl := gomock.NewController(GinkgoT())
+			m = backend.NewMockBackend(ctrl)
+			handlerFn = counterpartiesList(m)
+		})
+
+		It("should return an internal server error when backend returns error", func(ctx SpecContext) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			m.EXPECT().CounterpartiesList(gomock.Any(), gomock.Any()).Return(
+				&paginate.Cursor[models.Counterparty]{}, fmt.Errorf("counterparties list error"),
+			)
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusInternalServerError, "INTERNAL")
+		})
+
+		It("should return a cursor object", func(ctx SpecContext) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			m.EXPECT().CounterpartiesList(gomock.Any(), gomock.Any()).Return(
+				&paginate.Cursor[models.Counterparty]{}, nil,
+			)
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusOK, "cursor")
+		})
+	})
+})
@@ -0,0 +1,58 @@
+package v3
+
+import (
+	"net/http"
+
+	"github.com/formancehq/go-libs/v5/pkg/query"
+	"github.com/formancehq/go-libs/v5/pkg/storage/bun/paginate"
+	"github.com/formancehq/go-libs/v5/pkg/transport/api"
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/otel"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func counterpartiesPaymentsList(backend backend.Backend) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := otel.Tracer().Start(r.Context(), "v3_counterpartiesPaymentsList")
+		defer span.End()
+
+		span.SetAttributes(attribute.String("counterpartyID", counterpartyID(r)))
+		id, err := uuid.Parse(counterpartyID(r))
+		if err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrInvalidID, err)
+			return
+		}
+
+		query, err := paginate.Extract[storage.ListPaymentsQuery](r, func() (*storage.ListPaymentsQuery, error) {
+			sort, err := getSort(span, r)
+			if err != nil {
+				return nil, err
+			}
+
+			builder := query.Match("counterparty_id", id.String())
+			options, err := getPaginationWithBuilder(span, r, builder, storage.PaymentQuery{Sort: sort})
+			if err != nil {
+				return nil, err
+			}
+			return pointer.For(storage.NewListPaymentsQuery(*options)), nil
+		})
+		if err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrValidation, err)
+			return
+		}
+
+		cursor, err := backend.PaymentsList(ctx, *query)
+		if err != nil {
+			otel.RecordError(span, err)
+			handleServiceErrors(w, r, err)
+			return
+		}
+
+		api.RenderCursor(w, *cursor)
+	}
+}
@@ -0,0 +1,69 @@
+package v3
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/formancehq/go-libs/v5/pkg/storage/bun/paginate"
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("API v3 Counterparties Payments List", func() {
+	var (
+		handlerFn http.HandlerFunc
+		cpID      uuid.UUID
+	)
+	BeforeEach(func() {
+		cpID = uuid.New()
+	})
+
+	Context("list counterparty payments", func() {
+		var (
+			w *httptest.ResponseRecorder
+			m *backend.MockBackend
+		)
+		BeforeEach(func() {
+			w = httptest.NewRecorder()
+			ctrl := gomock.NewController(GinkgoT())
+			m = backend.NewMockBackend(ctrl)
+			handlerFn = counterpartiesPaymentsList(m)
+		})
+
+		It("should return an invalid ID error when counterparty ID is invalid", func(ctx SpecContext) {
+			req := prepareQueryRequest(http.MethodGet, "counterpartyID", "invalidvalue")
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrInvalidID)
+		})
+
+		It("should return an internal server error when backend returns error", func(ctx SpecContext) {
+			req := prepareQueryRequest(http.MethodGet, "counterpartyID", cpID.String())
+			m.EXPECT().PaymentsList(gomock.Any(), gomock.Any()).Return(
+				&paginate.Cursor[models.Payment]{}, fmt.Errorf("payments list error"),
+			)
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusInternalServerError, "INTERNAL")
+		})
+
+		It("should filter the payments on the counterparty", func(ctx SpecContext) {
+			req := prepareQueryRequest(http.MethodGet, "counterpartyID", cpID.String())
+			m.EXPECT().PaymentsList(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ any, q storage.ListPaymentsQuery) (*paginate.Cursor[models.Payment], error) {
+					Expect(q.Options.QueryBuilder).NotTo(BeNil())
+					return &paginate.Cursor[models.Payment]{}, nil
+				},
+			)
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusOK, "cursor")
+		})
+	})
+})
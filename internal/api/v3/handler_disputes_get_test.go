@@ -0,0 +1,64 @@
+package v3
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("API v3 Disputes", func() {
+	var (
+		handlerFn http.HandlerFunc
+		disputeID models.DisputeID
+	)
+	BeforeEach(func() {
+		connID := models.ConnectorID{Reference: uuid.New(), Provider: "psp"}
+		disputeID = models.DisputeID{Reference: "dp-ref", ConnectorID: connID}
+	})
+
+	Context("get disputes", func() {
+		var (
+			w *httptest.ResponseRecorder
+			m *backend.MockBackend
+		)
+		BeforeEach(func() {
+			w = httptest.NewRecorder()
+			ctrl := gomock.NewController(GinkgoT())
+			m = backend.NewMockBackend(ctrl)
+			handlerFn = disputesGet(m)
+		})
+
+		It("should return an invalid ID error when dispute ID is invalid", func(ctx SpecContext) {
+			req := prepareQueryRequest(http.MethodGet, "disputeID", "invalidvalue")
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrInvalidID)
+		})
+
+		It("should return an internal server error when backend returns error", func(ctx SpecContext) {
+			req := prepareQueryRequest(http.MethodGet, "disputeID", disputeID.String())
+			m.EXPECT().DisputesGet(gomock.Any(), disputeID).Return(
+				&models.Dispute{}, fmt.Errorf("disputes get error"),
+			)
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusInternalServerError, "INTERNAL")
+		})
+
+		It("should return data object", func(ctx SpecContext) {
+			req := prepareQueryRequest(http.MethodGet, "disputeID", disputeID.String())
+			m.EXPECT().DisputesGet(gomock.Any(), disputeID).Return(
+				&models.Dispute{}, nil,
+			)
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusOK, "data")
+		})
+	})
+})
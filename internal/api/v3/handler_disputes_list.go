@@ -0,0 +1,46 @@
+package v3
+
+import (
+	"net/http"
+
+	"github.com/formancehq/go-libs/v5/pkg/storage/bun/paginate"
+	"github.com/formancehq/go-libs/v5/pkg/transport/api"
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/otel"
+	"github.com/formancehq/payments/internal/storage"
+)
+
+func disputesList(backend backend.Backend) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := otel.Tracer().Start(r.Context(), "v3_disputesList")
+		defer span.End()
+
+		query, err := paginate.Extract[storage.ListDisputesQuery](r, func() (*storage.ListDisputesQuery, error) {
+			sort, err := getSort(span, r)
+			if err != nil {
+				return nil, err
+			}
+
+			options, err := getPagination(span, r, storage.DisputeQuery{Sort: sort})
+			if err != nil {
+				return nil, err
+			}
+			return pointer.For(storage.NewListDisputesQuery(*options)), nil
+		})
+		if err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrValidation, err)
+			return
+		}
+
+		cursor, err := backend.DisputesList(ctx, *query)
+		if err != nil {
+			otel.RecordError(span, err)
+			handleServiceErrors(w, r, err)
+			return
+		}
+
+		api.RenderCursor(w, *cursor)
+	}
+}
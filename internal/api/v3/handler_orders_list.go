@@ -17,7 +17,12 @@ func ordersList(backend backend.Backend) http.HandlerFunc {
 		defer span.End()
 
 		query, err := paginate.Extract[storage.ListOrdersQuery](r, func() (*storage.ListOrdersQuery, error) {
-			options, err := getPagination(span, r, storage.OrderQuery{})
+			sort, err := getSort(span, r)
+			if err != nil {
+				return nil, err
+			}
+
+			options, err := getPagination(span, r, storage.OrderQuery{Sort: sort})
 			if err != nil {
 				return nil, err
 			}
@@ -0,0 +1,85 @@
+package v3
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/transport/api"
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/api/validation"
+	"github.com/formancehq/payments/internal/otel"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type CreatePaymentCorrelationRuleRequest struct {
+	Name           string   `json:"name" validate:"required"`
+	MatchReference bool     `json:"matchReference"`
+	MatchAmount    bool     `json:"matchAmount"`
+	MetadataKeys   []string `json:"metadataKeys" validate:"omitempty,dive,required"`
+	// Go duration, e.g. 72h
+	DateWindow string `json:"dateWindow"`
+}
+
+func paymentCorrelationRulesCreate(backend backend.Backend, validator *validation.Validator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := otel.Tracer().Start(r.Context(), "v3_paymentCorrelationRulesCreate")
+		defer span.End()
+
+		var req CreatePaymentCorrelationRuleRequest
+		err := json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrMissingOrInvalidBody, err)
+			return
+		}
+
+		populateSpanFromCreatePaymentCorrelationRuleRequest(span, req)
+
+		if _, err := validator.Validate(req); err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrValidation, err)
+			return
+		}
+
+		var dateWindow time.Duration
+		if req.DateWindow != "" {
+			dateWindow, err = time.ParseDuration(req.DateWindow)
+			if err != nil {
+				otel.RecordError(span, err)
+				api.BadRequest(w, ErrValidation, err)
+				return
+			}
+		}
+
+		rule := models.PaymentCorrelationRule{
+			ID:             uuid.New(),
+			Name:           req.Name,
+			CreatedAt:      time.Now().UTC(),
+			MatchReference: req.MatchReference,
+			MatchAmount:    req.MatchAmount,
+			MetadataKeys:   req.MetadataKeys,
+			DateWindow:     dateWindow,
+		}
+
+		err = backend.PaymentCorrelationRulesCreate(ctx, rule)
+		if err != nil {
+			otel.RecordError(span, err)
+			handleServiceErrors(w, r, err)
+			return
+		}
+
+		api.Created(w, rule.ID.String())
+	}
+}
+
+func populateSpanFromCreatePaymentCorrelationRuleRequest(span trace.Span, req CreatePaymentCorrelationRuleRequest) {
+	span.SetAttributes(attribute.String("name", req.Name))
+	span.SetAttributes(attribute.Bool("matchReference", req.MatchReference))
+	span.SetAttributes(attribute.Bool("matchAmount", req.MatchAmount))
+	span.SetAttributes(attribute.StringSlice("metadataKeys", req.MetadataKeys))
+	span.SetAttributes(attribute.String("dateWindow", req.DateWindow))
+}
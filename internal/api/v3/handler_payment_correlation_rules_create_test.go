@@ -0,0 +1,83 @@
+package v3
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/api/validation"
+	"github.com/formancehq/payments/pkg/domain/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("API v3 Payment Correlation Rules Create", func() {
+	var (
+		handlerFn http.HandlerFunc
+	)
+
+	Context("create payment correlation rules", func() {
+		var (
+			w   *httptest.ResponseRecorder
+			m   *backend.MockBackend
+			cpr CreatePaymentCorrelationRuleRequest
+		)
+		BeforeEach(func() {
+			w = httptest.NewRecorder()
+			ctrl := gomock.NewController(GinkgoT())
+			m = backend.NewMockBackend(ctrl)
+			handlerFn = paymentCorrelationRulesCreate(m, validation.NewValidator())
+		})
+
+		It("should return a bad request error when body is missing", func(ctx SpecContext) {
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrMissingOrInvalidBody)
+		})
+
+		DescribeTable("validation errors",
+			func(cpr CreatePaymentCorrelationRuleRequest) {
+				handlerFn(w, prepareJSONRequest(http.MethodPost, &cpr))
+				assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrValidation)
+			},
+			Entry("name missing", CreatePaymentCorrelationRuleRequest{MatchAmount: true}),
+			Entry("empty metadata key", CreatePaymentCorrelationRuleRequest{Name: "test", MetadataKeys: []string{""}}),
+			Entry("invalid date window", CreatePaymentCorrelationRuleRequest{Name: "test", MatchAmount: true, DateWindow: "3 days"}),
+		)
+
+		It("should return an internal server error when backend returns error", func(ctx SpecContext) {
+			expectedErr := errors.New("payment correlation rule create err")
+			m.EXPECT().PaymentCorrelationRulesCreate(gomock.Any(), gomock.Any()).Return(expectedErr)
+			cpr = CreatePaymentCorrelationRuleRequest{
+				Name:        "name",
+				MatchAmount: true,
+			}
+			handlerFn(w, prepareJSONRequest(http.MethodPost, &cpr))
+			assertExpectedResponse(w.Result(), http.StatusInternalServerError, "INTERNAL")
+		})
+
+		It("should return status created on success", func(ctx SpecContext) {
+			m.EXPECT().PaymentCorrelationRulesCreate(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ any, rule models.PaymentCorrelationRule) error {
+					Expect(rule.Name).To(Equal("name"))
+					Expect(rule.MatchAmount).To(BeTrue())
+					Expect(rule.MetadataKeys).To(Equal([]string{"end_to_end_id"}))
+					Expect(rule.DateWindow).To(Equal(72 * time.Hour))
+					return nil
+				},
+			)
+			cpr = CreatePaymentCorrelationRuleRequest{
+				Name:         "name",
+				MatchAmount:  true,
+				MetadataKeys: []string{"end_to_end_id"},
+				DateWindow:   "72h",
+			}
+			handlerFn(w, prepareJSONRequest(http.MethodPost, &cpr))
+			assertExpectedResponse(w.Result(), http.StatusCreated, "data")
+		})
+	})
+})
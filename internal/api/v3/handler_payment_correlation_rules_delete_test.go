@@ -0,0 +1,55 @@
+package v3
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("API v3 Payment Correlation Rules Deletion", func() {
+	var (
+		handlerFn http.HandlerFunc
+		ruleID    uuid.UUID
+	)
+	BeforeEach(func() {
+		ruleID = uuid.New()
+	})
+
+	Context("delete payment correlation rule", func() {
+		var (
+			w *httptest.ResponseRecorder
+			m *backend.MockBackend
+		)
+		BeforeEach(func() {
+			w = httptest.NewRecorder()
+			ctrl := gomock.NewController(GinkgoT())
+			m = backend.NewMockBackend(ctrl)
+			handlerFn = paymentCorrelationRulesDelete(m)
+		})
+
+		It("should return a bad request error when paymentCorrelationRuleID is invalid", func(ctx SpecContext) {
+			req := prepareQueryRequest(http.MethodDelete, "paymentCorrelationRuleID", "invalid")
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrInvalidID)
+		})
+
+		It("should return an internal server error when backend returns error", func(ctx SpecContext) {
+			expectedErr := errors.New("payment correlation rule delete err")
+			m.EXPECT().PaymentCorrelationRulesDelete(gomock.Any(), ruleID).Return(expectedErr)
+			handlerFn(w, prepareQueryRequest(http.MethodDelete, "paymentCorrelationRuleID", ruleID.String()))
+			assertExpectedResponse(w.Result(), http.StatusInternalServerError, "INTERNAL")
+		})
+
+		It("should return status no content on success", func(ctx SpecContext) {
+			m.EXPECT().PaymentCorrelationRulesDelete(gomock.Any(), ruleID).Return(nil)
+			handlerFn(w, prepareQueryRequest(http.MethodDelete, "paymentCorrelationRuleID", ruleID.String()))
+			assertExpectedResponse(w.Result(), http.StatusNoContent, "")
+		})
+	})
+})
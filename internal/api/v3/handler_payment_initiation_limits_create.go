@@ -0,0 +1,103 @@
+package v3
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/transport/api"
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/api/validation"
+	"github.com/formancehq/payments/internal/otel"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type CreatePaymentInitiationLimitRequest struct {
+	Name        string   `json:"name" validate:"required,lte=1000"`
+	Type        string   `json:"type" validate:"required,oneof=MAX_AMOUNT DAILY_AMOUNT MONTHLY_AMOUNT HOURLY_COUNT"`
+	Scope       string   `json:"scope" validate:"omitempty,oneof=CONNECTOR SOURCE_ACCOUNT"`
+	Action      string   `json:"action" validate:"required,oneof=REJECT REQUIRE_APPROVAL"`
+	ConnectorID *string  `json:"connectorID" validate:"omitempty,connectorID"`
+	Asset       string   `json:"asset" validate:"omitempty,asset"`
+	MaxAmount   *big.Int `json:"maxAmount"`
+	MaxCount    int      `json:"maxCount" validate:"gte=0"`
+}
+
+func paymentInitiationLimitsCreate(backend backend.Backend, validator *validation.Validator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := otel.Tracer().Start(r.Context(), "v3_paymentInitiationLimitsCreate")
+		defer span.End()
+
+		var req CreatePaymentInitiationLimitRequest
+		err := json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrMissingOrInvalidBody, err)
+			return
+		}
+
+		populateSpanFromCreatePaymentInitiationLimitRequest(span, req)
+
+		if _, err := validator.Validate(req); err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrValidation, err)
+			return
+		}
+
+		// Already checked by the validator
+		limitType, _ := models.PaymentInitiationLimitTypeFromString(req.Type)
+		action, _ := models.PaymentInitiationLimitActionFromString(req.Action)
+
+		scope := models.PAYMENT_INITIATION_LIMIT_SCOPE_UNKNOWN
+		if req.Scope != "" {
+			scope, _ = models.PaymentInitiationLimitScopeFromString(req.Scope)
+		}
+
+		var connectorID *models.ConnectorID
+		if req.ConnectorID != nil {
+			connectorID = pointer.For(models.MustConnectorIDFromString(*req.ConnectorID))
+		}
+
+		limit := models.PaymentInitiationLimit{
+			ID:          uuid.New(),
+			Name:        req.Name,
+			CreatedAt:   time.Now().UTC(),
+			Type:        limitType,
+			Scope:       scope,
+			Action:      action,
+			ConnectorID: connectorID,
+			Asset:       req.Asset,
+			MaxAmount:   req.MaxAmount,
+			MaxCount:    req.MaxCount,
+		}
+
+		err = backend.PaymentInitiationLimitsCreate(ctx, limit)
+		if err != nil {
+			otel.RecordError(span, err)
+			handleServiceErrors(w, r, err)
+			return
+		}
+
+		api.Created(w, limit.ID.String())
+	}
+}
+
+func populateSpanFromCreatePaymentInitiationLimitRequest(span trace.Span, req CreatePaymentInitiationLimitRequest) {
+	span.SetAttributes(attribute.String("name", req.Name))
+	span.SetAttributes(attribute.String("type", req.Type))
+	span.SetAttributes(attribute.String("scope", req.Scope))
+	span.SetAttributes(attribute.String("action", req.Action))
+	if req.ConnectorID != nil {
+		span.SetAttributes(attribute.String("connectorID", *req.ConnectorID))
+	}
+	span.SetAttributes(attribute.String("asset", req.Asset))
+	if req.MaxAmount != nil {
+		span.SetAttributes(attribute.String("maxAmount", req.MaxAmount.String()))
+	}
+	span.SetAttributes(attribute.Int("maxCount", req.MaxCount))
+}
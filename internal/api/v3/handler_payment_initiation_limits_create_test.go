@@ -0,0 +1,117 @@
+package v3
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/api/services"
+	"github.com/formancehq/payments/internal/api/validation"
+	"github.com/formancehq/payments/pkg/domain/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("API v3 Payment Initiation Limits Create", func() {
+	var (
+		handlerFn   http.HandlerFunc
+		connectorID models.ConnectorID
+	)
+	BeforeEach(func() {
+		connectorID = models.ConnectorID{Reference: [16]byte{1}, Provider: "dummypay"}
+	})
+
+	Context("create payment initiation limits", func() {
+		var (
+			w   *httptest.ResponseRecorder
+			m   *backend.MockBackend
+			cpl CreatePaymentInitiationLimitRequest
+		)
+		BeforeEach(func() {
+			w = httptest.NewRecorder()
+			ctrl := gomock.NewController(GinkgoT())
+			m = backend.NewMockBackend(ctrl)
+			handlerFn = paymentInitiationLimitsCreate(m, validation.NewValidator())
+		})
+
+		It("should return a bad request error when body is missing", func(ctx SpecContext) {
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrMissingOrInvalidBody)
+		})
+
+		DescribeTable("validation errors",
+			func(cpl CreatePaymentInitiationLimitRequest) {
+				handlerFn(w, prepareJSONRequest(http.MethodPost, &cpl))
+				assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrValidation)
+			},
+			Entry("name missing", CreatePaymentInitiationLimitRequest{Type: "MAX_AMOUNT", Action: "REJECT"}),
+			Entry("type missing", CreatePaymentInitiationLimitRequest{Name: "test", Action: "REJECT"}),
+			Entry("type invalid", CreatePaymentInitiationLimitRequest{Name: "test", Type: "WEEKLY_AMOUNT", Action: "REJECT"}),
+			Entry("scope invalid", CreatePaymentInitiationLimitRequest{Name: "test", Type: "DAILY_AMOUNT", Scope: "ASSET", Action: "REJECT"}),
+			Entry("action missing", CreatePaymentInitiationLimitRequest{Name: "test", Type: "MAX_AMOUNT"}),
+			Entry("connector ID invalid", CreatePaymentInitiationLimitRequest{Name: "test", Type: "MAX_AMOUNT", Action: "REJECT", ConnectorID: pointer.For("invalid")}),
+			Entry("asset invalid", CreatePaymentInitiationLimitRequest{Name: "test", Type: "MAX_AMOUNT", Action: "REJECT", Asset: "invalid"}),
+			Entry("max count negative", CreatePaymentInitiationLimitRequest{Name: "test", Type: "HOURLY_COUNT", Action: "REJECT", MaxCount: -1}),
+		)
+
+		It("should return a validation error when the limit is invalid", func(ctx SpecContext) {
+			expectedErr := fmt.Errorf("missing limit asset: %w", services.ErrValidation)
+			m.EXPECT().PaymentInitiationLimitsCreate(gomock.Any(), gomock.Any()).Return(expectedErr)
+			cpl = CreatePaymentInitiationLimitRequest{
+				Name:      "name",
+				Type:      "MAX_AMOUNT",
+				Action:    "REJECT",
+				MaxAmount: big.NewInt(100),
+			}
+			handlerFn(w, prepareJSONRequest(http.MethodPost, &cpl))
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrValidation)
+		})
+
+		It("should return an internal server error when backend returns error", func(ctx SpecContext) {
+			expectedErr := errors.New("payment initiation limit create err")
+			m.EXPECT().PaymentInitiationLimitsCreate(gomock.Any(), gomock.Any()).Return(expectedErr)
+			cpl = CreatePaymentInitiationLimitRequest{
+				Name:     "name",
+				Type:     "HOURLY_COUNT",
+				Scope:    "CONNECTOR",
+				Action:   "REJECT",
+				MaxCount: 10,
+			}
+			handlerFn(w, prepareJSONRequest(http.MethodPost, &cpl))
+			assertExpectedResponse(w.Result(), http.StatusInternalServerError, "INTERNAL")
+		})
+
+		It("should return status created on success", func(ctx SpecContext) {
+			m.EXPECT().PaymentInitiationLimitsCreate(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ any, limit models.PaymentInitiationLimit) error {
+					Expect(limit.Name).To(Equal("name"))
+					Expect(limit.Type).To(Equal(models.PAYMENT_INITIATION_LIMIT_TYPE_DAILY_AMOUNT))
+					Expect(limit.Scope).To(Equal(models.PAYMENT_INITIATION_LIMIT_SCOPE_SOURCE_ACCOUNT))
+					Expect(limit.Action).To(Equal(models.PAYMENT_INITIATION_LIMIT_ACTION_REQUIRE_APPROVAL))
+					Expect(limit.ConnectorID).To(Equal(&connectorID))
+					Expect(limit.Asset).To(Equal("EUR/2"))
+					Expect(limit.MaxAmount).To(Equal(big.NewInt(100000)))
+					return nil
+				},
+			)
+			cpl = CreatePaymentInitiationLimitRequest{
+				Name:        "name",
+				Type:        "DAILY_AMOUNT",
+				Scope:       "SOURCE_ACCOUNT",
+				Action:      "REQUIRE_APPROVAL",
+				ConnectorID: pointer.For(connectorID.String()),
+				Asset:       "EUR/2",
+				MaxAmount:   big.NewInt(100000),
+			}
+			handlerFn(w, prepareJSONRequest(http.MethodPost, &cpl))
+			assertExpectedResponse(w.Result(), http.StatusCreated, "data")
+		})
+	})
+})
@@ -0,0 +1,35 @@
+package v3
+
+import (
+	"net/http"
+
+	"github.com/formancehq/go-libs/v5/pkg/transport/api"
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/otel"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func paymentInitiationLimitsDelete(backend backend.Backend) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := otel.Tracer().Start(r.Context(), "v3_paymentInitiationLimitsDelete")
+		defer span.End()
+
+		span.SetAttributes(attribute.String("paymentInitiationLimitID", paymentInitiationLimitID(r)))
+		id, err := uuid.Parse(paymentInitiationLimitID(r))
+		if err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrInvalidID, err)
+			return
+		}
+
+		err = backend.PaymentInitiationLimitsDelete(ctx, id)
+		if err != nil {
+			otel.RecordError(span, err)
+			handleServiceErrors(w, r, err)
+			return
+		}
+
+		api.NoContent(w)
+	}
+}
@@ -0,0 +1,55 @@
+package v3
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("API v3 Payment Initiation Limits Deletion", func() {
+	var (
+		handlerFn http.HandlerFunc
+		limitID   uuid.UUID
+	)
+	BeforeEach(func() {
+		limitID = uuid.New()
+	})
+
+	Context("delete payment initiation limit", func() {
+		var (
+			w *httptest.ResponseRecorder
+			m *backend.MockBackend
+		)
+		BeforeEach(func() {
+			w = httptest.NewRecorder()
+			ctrl := gomock.NewController(GinkgoT())
+			m = backend.NewMockBackend(ctrl)
+			handlerFn = paymentInitiationLimitsDelete(m)
+		})
+
+		It("should return a bad request error when paymentInitiationLimitID is invalid", func(ctx SpecContext) {
+			req := prepareQueryRequest(http.MethodDelete, "paymentInitiationLimitID", "invalid")
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrInvalidID)
+		})
+
+		It("should return an internal server error when backend returns error", func(ctx SpecContext) {
+			expectedErr := errors.New("payment initiation limit delete err")
+			m.EXPECT().PaymentInitiationLimitsDelete(gomock.Any(), limitID).Return(expectedErr)
+			handlerFn(w, prepareQueryRequest(http.MethodDelete, "paymentInitiationLimitID", limitID.String()))
+			assertExpectedResponse(w.Result(), http.StatusInternalServerError, "INTERNAL")
+		})
+
+		It("should return status no content on success", func(ctx SpecContext) {
+			m.EXPECT().PaymentInitiationLimitsDelete(gomock.Any(), limitID).Return(nil)
+			handlerFn(w, prepareQueryRequest(http.MethodDelete, "paymentInitiationLimitID", limitID.String()))
+			assertExpectedResponse(w.Result(), http.StatusNoContent, "")
+		})
+	})
+})
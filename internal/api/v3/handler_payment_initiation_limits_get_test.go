@@ -0,0 +1,63 @@
+package v3
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("API v3 Get Payment Initiation Limit", func() {
+	var (
+		handlerFn http.HandlerFunc
+		limitID   uuid.UUID
+	)
+	BeforeEach(func() {
+		limitID = uuid.New()
+	})
+
+	Context("get payment initiation limits", func() {
+		var (
+			w *httptest.ResponseRecorder
+			m *backend.MockBackend
+		)
+		BeforeEach(func() {
+			w = httptest.NewRecorder()
+			ctrl := gomock.NewController(GinkgoT())
+			m = backend.NewMockBackend(ctrl)
+			handlerFn = paymentInitiationLimitsGet(m)
+		})
+
+		It("should return an invalid ID error when paymentInitiationLimitID is invalid", func(ctx SpecContext) {
+			req := prepareQueryRequest(http.MethodGet, "paymentInitiationLimitID", "invalidvalue")
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrInvalidID)
+		})
+
+		It("should return an internal server error when backend returns error", func(ctx SpecContext) {
+			req := prepareQueryRequest(http.MethodGet, "paymentInitiationLimitID", limitID.String())
+			m.EXPECT().PaymentInitiationLimitsGet(gomock.Any(), limitID).Return(
+				&models.PaymentInitiationLimit{}, fmt.Errorf("payment initiation limit get error"),
+			)
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusInternalServerError, "INTERNAL")
+		})
+
+		It("should return data object", func(ctx SpecContext) {
+			req := prepareQueryRequest(http.MethodGet, "paymentInitiationLimitID", limitID.String())
+			m.EXPECT().PaymentInitiationLimitsGet(gomock.Any(), limitID).Return(
+				&models.PaymentInitiationLimit{}, nil,
+			)
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusOK, "data")
+		})
+	})
+})
@@ -0,0 +1,72 @@
+package v3
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+
+	"github.com/formancehq/go-libs/v5/pkg/transport/api"
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/api/validation"
+	"github.com/formancehq/payments/internal/otel"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type UpdatePaymentInitiationLimitRequest struct {
+	Name      *string  `json:"name" validate:"omitempty,min=1,lte=1000"`
+	Action    *string  `json:"action" validate:"omitempty,oneof=REJECT REQUIRE_APPROVAL"`
+	MaxAmount *big.Int `json:"maxAmount"`
+	MaxCount  *int     `json:"maxCount" validate:"omitempty,gt=0"`
+}
+
+func paymentInitiationLimitsUpdate(backend backend.Backend, validator *validation.Validator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := otel.Tracer().Start(r.Context(), "v3_paymentInitiationLimitsUpdate")
+		defer span.End()
+
+		span.SetAttributes(attribute.String("paymentInitiationLimitID", paymentInitiationLimitID(r)))
+		id, err := uuid.Parse(paymentInitiationLimitID(r))
+		if err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrInvalidID, err)
+			return
+		}
+
+		var req UpdatePaymentInitiationLimitRequest
+		err = json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrMissingOrInvalidBody, err)
+			return
+		}
+
+		if _, err := validator.Validate(req); err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrValidation, err)
+			return
+		}
+
+		update := models.PaymentInitiationLimitUpdate{
+			Name:      req.Name,
+			MaxAmount: req.MaxAmount,
+			MaxCount:  req.MaxCount,
+		}
+		if req.Action != nil {
+			// Already checked by the validator
+			action, _ := models.PaymentInitiationLimitActionFromString(*req.Action)
+			update.Action = pointer.For(action)
+		}
+
+		err = backend.PaymentInitiationLimitsUpdate(ctx, id, update)
+		if err != nil {
+			otel.RecordError(span, err)
+			handleServiceErrors(w, r, err)
+			return
+		}
+
+		api.NoContent(w)
+	}
+}
@@ -0,0 +1,99 @@
+package v3
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/api/validation"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("API v3 Payment Initiation Limits Update", func() {
+	var (
+		handlerFn http.HandlerFunc
+		limitID   uuid.UUID
+	)
+	BeforeEach(func() {
+		limitID = uuid.New()
+	})
+
+	Context("update payment initiation limits", func() {
+		var (
+			w   *httptest.ResponseRecorder
+			m   *backend.MockBackend
+			upl UpdatePaymentInitiationLimitRequest
+		)
+		BeforeEach(func() {
+			w = httptest.NewRecorder()
+			ctrl := gomock.NewController(GinkgoT())
+			m = backend.NewMockBackend(ctrl)
+			handlerFn = paymentInitiationLimitsUpdate(m, validation.NewValidator())
+		})
+
+		It("should return a bad request error when paymentInitiationLimitID is invalid", func(ctx SpecContext) {
+			handlerFn(w, prepareJSONRequestWithQuery(http.MethodPatch, "paymentInitiationLimitID", "invalid", &upl))
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrInvalidID)
+		})
+
+		It("should return a bad request error when body is missing", func(ctx SpecContext) {
+			req := prepareQueryRequest(http.MethodPatch, "paymentInitiationLimitID", limitID.String())
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrMissingOrInvalidBody)
+		})
+
+		DescribeTable("validation errors",
+			func(upl UpdatePaymentInitiationLimitRequest) {
+				handlerFn(w, prepareJSONRequestWithQuery(http.MethodPatch, "paymentInitiationLimitID", limitID.String(), &upl))
+				assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrValidation)
+			},
+			Entry("empty name", UpdatePaymentInitiationLimitRequest{Name: pointer.For("")}),
+			Entry("invalid action", UpdatePaymentInitiationLimitRequest{Action: pointer.For("NOTIFY")}),
+			Entry("max count not positive", UpdatePaymentInitiationLimitRequest{MaxCount: pointer.For(0)}),
+		)
+
+		It("should return not found when the limit does not exist", func(ctx SpecContext) {
+			expectedErr := fmt.Errorf("payment initiation limit: %w", storage.ErrNotFound)
+			m.EXPECT().PaymentInitiationLimitsUpdate(gomock.Any(), limitID, gomock.Any()).Return(expectedErr)
+			upl = UpdatePaymentInitiationLimitRequest{Name: pointer.For("name")}
+			handlerFn(w, prepareJSONRequestWithQuery(http.MethodPatch, "paymentInitiationLimitID", limitID.String(), &upl))
+			assertExpectedResponse(w.Result(), http.StatusNotFound, "NOT_FOUND")
+		})
+
+		It("should return an internal server error when backend returns error", func(ctx SpecContext) {
+			expectedErr := errors.New("payment initiation limit update err")
+			m.EXPECT().PaymentInitiationLimitsUpdate(gomock.Any(), limitID, gomock.Any()).Return(expectedErr)
+			upl = UpdatePaymentInitiationLimitRequest{Name: pointer.For("name")}
+			handlerFn(w, prepareJSONRequestWithQuery(http.MethodPatch, "paymentInitiationLimitID", limitID.String(), &upl))
+			assertExpectedResponse(w.Result(), http.StatusInternalServerError, "INTERNAL")
+		})
+
+		It("should return status no content on success", func(ctx SpecContext) {
+			m.EXPECT().PaymentInitiationLimitsUpdate(gomock.Any(), limitID, gomock.Any()).DoAndReturn(
+				func(_ any, _ uuid.UUID, update models.PaymentInitiationLimitUpdate) error {
+					Expect(update.Name).To(BeNil())
+					Expect(update.Action).To(Equal(pointer.For(models.PAYMENT_INITIATION_LIMIT_ACTION_REJECT)))
+					Expect(update.MaxAmount).To(Equal(big.NewInt(5000)))
+					Expect(update.MaxCount).To(BeNil())
+					return nil
+				},
+			)
+			upl = UpdatePaymentInitiationLimitRequest{
+				Action:    pointer.For("REJECT"),
+				MaxAmount: big.NewInt(5000),
+			}
+			handlerFn(w, prepareJSONRequestWithQuery(http.MethodPatch, "paymentInitiationLimitID", limitID.String(), &upl))
+			assertExpectedResponse(w.Result(), http.StatusNoContent, "")
+		})
+	})
+})
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/api/services"
 	"github.com/formancehq/payments/internal/api/validation"
 	"github.com/formancehq/payments/pkg/domain/models"
 	"github.com/formancehq/payments/internal/storage"
@@ -94,6 +95,27 @@ var _ = Describe("API v3 Payment Initiation Creation", func() {
 			assertExpectedResponse(w.Result(), http.StatusBadRequest, "CONFLICT")
 		})
 
+		It("should return a LIMIT_EXCEEDED error when the payment initiation exceeds a limit", func(ctx SpecContext) {
+			expectedErr := &services.ErrPaymentInitiationLimitsExceeded{
+				Limits: []models.PaymentInitiationLimit{{Name: "max amount", Type: models.PAYMENT_INITIATION_LIMIT_TYPE_MAX_AMOUNT}},
+			}
+			m.EXPECT().PaymentInitiationsCreate(gomock.Any(), gomock.Any(), false, false).Return(
+				models.Task{},
+				expectedErr,
+			)
+			picr = PaymentInitiationsCreateRequest{
+				Reference:            "ref-limit",
+				ConnectorID:          connID.String(),
+				SourceAccountID:      &sourceID,
+				DestinationAccountID: &destID,
+				Type:                 "TRANSFER",
+				Amount:               big.NewInt(144),
+				Asset:                "EUR/2",
+			}
+			handlerFn(w, prepareJSONRequest(http.MethodPost, &picr))
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrLimitExceeded)
+		})
+
 		It("should return an internal server error when backend returns error", func(ctx SpecContext) {
 			expectedErr := errors.New("payment initiation create err")
 			m.EXPECT().PaymentInitiationsCreate(gomock.Any(), gomock.Any(), false, false).Return(
@@ -18,7 +18,12 @@ func paymentInitiationsList(backend backend.Backend) http.HandlerFunc {
 		defer span.End()
 
 		query, err := paginate.Extract[storage.ListPaymentInitiationsQuery](r, func() (*storage.ListPaymentInitiationsQuery, error) {
-			options, err := getPagination(span, r, storage.PaymentInitiationQuery{})
+			sort, err := getSort(span, r)
+			if err != nil {
+				return nil, err
+			}
+
+			options, err := getPagination(span, r, storage.PaymentInitiationQuery{Sort: sort})
 			if err != nil {
 				return nil, err
 			}
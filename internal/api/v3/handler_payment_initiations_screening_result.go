@@ -0,0 +1,70 @@
+package v3
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/formancehq/go-libs/v5/pkg/transport/api"
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/api/validation"
+	"github.com/formancehq/payments/internal/otel"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type PaymentInitiationsScreeningResultRequest struct {
+	Decision string `json:"decision" validate:"required,oneof=APPROVED REJECTED"`
+	Reason   string `json:"reason" validate:"omitempty,lte=1000"`
+}
+
+func paymentInitiationsScreeningResult(backend backend.Backend, validator *validation.Validator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := otel.Tracer().Start(r.Context(), "v3_paymentInitiationsScreeningResult")
+		defer span.End()
+
+		span.SetAttributes(attribute.String("paymentInitiationID", paymentInitiationID(r)))
+		id, err := models.PaymentInitiationIDFromString(paymentInitiationID(r))
+		if err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrInvalidID, err)
+			return
+		}
+
+		payload := PaymentInitiationsScreeningResultRequest{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrMissingOrInvalidBody, err)
+			return
+		}
+
+		span.SetAttributes(
+			attribute.String("decision", payload.Decision),
+			attribute.String("reason", payload.Reason),
+		)
+
+		if _, err := validator.Validate(payload); err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrValidation, err)
+			return
+		}
+
+		decision, err := models.ScreeningDecisionFromString(payload.Decision)
+		if err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrValidation, err)
+			return
+		}
+
+		err = backend.PaymentInitiationsScreeningResult(ctx, id, models.ScreeningResult{
+			Decision: decision,
+			Reason:   payload.Reason,
+		})
+		if err != nil {
+			otel.RecordError(span, err)
+			handleServiceErrors(w, r, err)
+			return
+		}
+
+		api.NoContent(w)
+	}
+}
@@ -0,0 +1,82 @@
+package v3
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/api/validation"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("API v3 Payment Initiation Screening Result", func() {
+	var (
+		handlerFn http.HandlerFunc
+		paymentID models.PaymentInitiationID
+	)
+	BeforeEach(func() {
+		connID := models.ConnectorID{Reference: uuid.New(), Provider: "psp"}
+		paymentID = models.PaymentInitiationID{Reference: "ref", ConnectorID: connID}
+	})
+
+	Context("submit payment initiation screening result", func() {
+		var (
+			w *httptest.ResponseRecorder
+			m *backend.MockBackend
+		)
+		BeforeEach(func() {
+			w = httptest.NewRecorder()
+			ctrl := gomock.NewController(GinkgoT())
+			m = backend.NewMockBackend(ctrl)
+			handlerFn = paymentInitiationsScreeningResult(m, validation.NewValidator())
+		})
+
+		It("should return a bad request error when paymentInitiationID is invalid", func(ctx SpecContext) {
+			req := prepareQueryRequest(http.MethodPost, "paymentInitiationID", "invalid")
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrInvalidID)
+		})
+
+		It("should return a bad request error when body is missing", func(ctx SpecContext) {
+			handlerFn(w, prepareQueryRequest(http.MethodPost, "paymentInitiationID", paymentID.String()))
+
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrMissingOrInvalidBody)
+		})
+
+		DescribeTable("validation errors",
+			func(r PaymentInitiationsScreeningResultRequest) {
+				handlerFn(w, prepareJSONRequestWithQuery(http.MethodPost, "paymentInitiationID", paymentID.String(), &r))
+				assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrValidation)
+			},
+			Entry("decision missing", PaymentInitiationsScreeningResultRequest{}),
+			Entry("decision pending", PaymentInitiationsScreeningResultRequest{Decision: "PENDING"}),
+			Entry("decision unknown", PaymentInitiationsScreeningResultRequest{Decision: "MAYBE"}),
+		)
+
+		It("should return an internal server error when backend returns error", func(ctx SpecContext) {
+			expectedErr := errors.New("payment initiation screening result err")
+			m.EXPECT().PaymentInitiationsScreeningResult(gomock.Any(), gomock.Any(), gomock.Any()).Return(expectedErr)
+			handlerFn(w, prepareJSONRequestWithQuery(http.MethodPost, "paymentInitiationID", paymentID.String(), &PaymentInitiationsScreeningResultRequest{
+				Decision: "APPROVED",
+			}))
+			assertExpectedResponse(w.Result(), http.StatusInternalServerError, "INTERNAL")
+		})
+
+		It("should return status no content on success", func(ctx SpecContext) {
+			m.EXPECT().PaymentInitiationsScreeningResult(gomock.Any(), paymentID, models.ScreeningResult{
+				Decision: models.SCREENING_DECISION_REJECTED,
+				Reason:   "sanctioned beneficiary",
+			}).Return(nil)
+			handlerFn(w, prepareJSONRequestWithQuery(http.MethodPost, "paymentInitiationID", paymentID.String(), &PaymentInitiationsScreeningResultRequest{
+				Decision: "REJECTED",
+				Reason:   "sanctioned beneficiary",
+			}))
+			assertExpectedResponse(w.Result(), http.StatusNoContent, "")
+		})
+	})
+})
@@ -0,0 +1,156 @@
+package v3
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/transport/api"
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/api/validation"
+	"github.com/formancehq/payments/internal/otel"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type PaymentServiceUserCreatePaymentLinkRequest struct {
+	ApplicationName   string `json:"applicationName"` // Note: might be mandatory for some open banking providers
+	ClientRedirectURL string `json:"clientRedirectURL" validate:"required,url"`
+
+	Reference     string   `json:"reference" validate:"required,gte=3,lte=1000"`
+	Description   string   `json:"description" validate:"omitempty,lte=10000"`
+	Amount        *big.Int `json:"amount" validate:"required,gtZero"`
+	Asset         string   `json:"asset" validate:"required,asset"`
+	BankAccountID string   `json:"bankAccountID" validate:"required,uuid"`
+
+	Metadata map[string]string `json:"metadata" validate:""`
+}
+
+type PaymentServiceUserCreatePaymentLinkResponse struct {
+	AttemptID           string `json:"attemptID"`
+	PaymentInitiationID string `json:"paymentInitiationID"`
+	Link                string `json:"link"`
+}
+
+func paymentServiceUsersCreatePaymentLink(backend backend.Backend, validator *validation.Validator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := otel.Tracer().Start(r.Context(), "v3_paymentServiceUsersCreatePaymentLink")
+		defer span.End()
+
+		span.SetAttributes(attribute.String("paymentServiceUserID", paymentServiceUserID(r)))
+		id, err := uuid.Parse(paymentServiceUserID(r))
+		if err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrInvalidID, err)
+			return
+		}
+
+		span.SetAttributes(attribute.String("connectorID", connectorID(r)))
+		connectorID, err := models.ConnectorIDFromString(connectorID(r))
+		if err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrInvalidID, err)
+			return
+		}
+
+		queryValues := r.URL.Query()
+		var ik *uuid.UUID
+		idempotencyKey, ok := queryValues["Idempotency-Key"]
+		if !ok || len(idempotencyKey) == 0 || idempotencyKey[0] == "" {
+			ik = nil
+		} else {
+			u, err := uuid.Parse(idempotencyKey[0])
+			if err != nil {
+				err = fmt.Errorf("parsing idempotency key (need uuid): %w", err)
+				otel.RecordError(span, err)
+				api.BadRequest(w, ErrInvalidID, err)
+				return
+			}
+			ik = &u
+		}
+
+		if r.Body == nil {
+			otel.RecordError(span, fmt.Errorf("body is nil"))
+			api.BadRequest(w, ErrMissingOrInvalidBody, fmt.Errorf("body is nil"))
+			return
+		}
+
+		var req PaymentServiceUserCreatePaymentLinkRequest
+		err = json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrMissingOrInvalidBody, err)
+			return
+		}
+
+		span.SetAttributes(attribute.String("clientRedirectURL", req.ClientRedirectURL))
+		span.SetAttributes(attribute.String("reference", req.Reference))
+		span.SetAttributes(attribute.String("asset", req.Asset))
+		span.SetAttributes(attribute.String("bankAccountID", req.BankAccountID))
+		if req.Amount != nil {
+			span.SetAttributes(attribute.String("amount", req.Amount.String()))
+		}
+
+		_, err = validator.Validate(req)
+		if err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrValidation, err)
+			return
+		}
+
+		bankAccountID, err := uuid.Parse(req.BankAccountID)
+		if err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrValidation, err)
+			return
+		}
+
+		pi := models.PaymentInitiation{
+			ID: models.PaymentInitiationID{
+				Reference:   req.Reference,
+				ConnectorID: connectorID,
+			},
+			ConnectorID: connectorID,
+			Reference:   req.Reference,
+			CreatedAt:   time.Now(),
+			Description: req.Description,
+			Type:        models.PAYMENT_INITIATION_TYPE_PAYOUT,
+			Amount:      req.Amount,
+			Asset:       req.Asset,
+			Metadata:    req.Metadata,
+		}
+
+		attemptID, link, err := backend.PaymentServiceUsersCreatePaymentLink(ctx, req.ApplicationName, id, pi, bankAccountID, ik, &req.ClientRedirectURL)
+		if err != nil {
+			otel.RecordError(span, err)
+			handleServiceErrors(w, r, err)
+			return
+		}
+
+		// Since we send a link to the client, we need to disable HTML escaping
+		// Encode to a buffer first to avoid sending 201 if encoding fails
+		var buf bytes.Buffer
+		encoder := json.NewEncoder(&buf)
+		encoder.SetEscapeHTML(false)
+		if err := encoder.Encode(PaymentServiceUserCreatePaymentLinkResponse{
+			AttemptID:           attemptID,
+			PaymentInitiationID: pi.ID.String(),
+			Link:                link,
+		}); err != nil {
+			otel.RecordError(span, err)
+			api.InternalServerError(w, r, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			// Headers already sent; best effort logging only.
+			otel.RecordError(span, err)
+			return
+		}
+	}
+}
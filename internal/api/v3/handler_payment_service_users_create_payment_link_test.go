@@ -0,0 +1,143 @@
+package v3
+
+import (
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/api/validation"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("API v3 Payment Service Users Create Payment Link", func() {
+	var (
+		handlerFn     http.HandlerFunc
+		psuID         uuid.UUID
+		connectorID   models.ConnectorID
+		bankAccountID uuid.UUID
+	)
+	BeforeEach(func() {
+		psuID = uuid.New()
+		connectorID = models.ConnectorID{Reference: uuid.New(), Provider: "test"}
+		bankAccountID = uuid.New()
+	})
+
+	Context("create payment link", func() {
+		var (
+			w          *httptest.ResponseRecorder
+			m          *backend.MockBackend
+			linkReq    PaymentServiceUserCreatePaymentLinkRequest
+			expectedPI models.PaymentInitiationID
+		)
+		BeforeEach(func() {
+			w = httptest.NewRecorder()
+			ctrl := gomock.NewController(GinkgoT())
+			m = backend.NewMockBackend(ctrl)
+			handlerFn = paymentServiceUsersCreatePaymentLink(m, validation.NewValidator())
+			linkReq = PaymentServiceUserCreatePaymentLinkRequest{
+				ApplicationName:   "Test",
+				ClientRedirectURL: "https://example.com/callback",
+				Reference:         "ref123",
+				Amount:            big.NewInt(100),
+				Asset:             "EUR/2",
+				BankAccountID:     bankAccountID.String(),
+			}
+			expectedPI = models.PaymentInitiationID{
+				Reference:   "ref123",
+				ConnectorID: connectorID,
+			}
+		})
+
+		It("should return an invalid ID error when psu ID is invalid", func(ctx SpecContext) {
+			req := prepareQueryRequest(http.MethodPost, "paymentServiceUserID", "invalidvalue", "connectorID", connectorID.String())
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrInvalidID)
+		})
+
+		It("should return an invalid ID error when connector ID is invalid", func(ctx SpecContext) {
+			req := prepareQueryRequest(http.MethodPost, "paymentServiceUserID", psuID.String(), "connectorID", "invalidvalue")
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrInvalidID)
+		})
+
+		It("should return an invalid ID error when idempotency key is invalid", func(ctx SpecContext) {
+			req := prepareQueryRequestWithPath("/?Idempotency-Key=invalid", "paymentServiceUserID", psuID.String(), "connectorID", connectorID.String())
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrInvalidID)
+		})
+
+		It("should return a bad request error when body is missing", func(ctx SpecContext) {
+			req := prepareQueryRequest(http.MethodPost, "paymentServiceUserID", psuID.String(), "connectorID", connectorID.String())
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrMissingOrInvalidBody)
+		})
+
+		DescribeTable("validation errors",
+			func(update func(r *PaymentServiceUserCreatePaymentLinkRequest)) {
+				update(&linkReq)
+				req := prepareJSONRequest(http.MethodPost, &linkReq)
+				req = prepareQueryRequestWithBody(http.MethodPost, req.Body, "paymentServiceUserID", psuID.String(), "connectorID", connectorID.String())
+				handlerFn(w, req)
+				assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrValidation)
+			},
+			Entry("client redirect URL missing", func(r *PaymentServiceUserCreatePaymentLinkRequest) { r.ClientRedirectURL = "" }),
+			Entry("client redirect URL invalid", func(r *PaymentServiceUserCreatePaymentLinkRequest) { r.ClientRedirectURL = "invalid-url" }),
+			Entry("reference missing", func(r *PaymentServiceUserCreatePaymentLinkRequest) { r.Reference = "" }),
+			Entry("amount missing", func(r *PaymentServiceUserCreatePaymentLinkRequest) { r.Amount = nil }),
+			Entry("amount negative", func(r *PaymentServiceUserCreatePaymentLinkRequest) { r.Amount = big.NewInt(-1) }),
+			Entry("asset invalid", func(r *PaymentServiceUserCreatePaymentLinkRequest) { r.Asset = "invalid" }),
+			Entry("bank account ID missing", func(r *PaymentServiceUserCreatePaymentLinkRequest) { r.BankAccountID = "" }),
+			Entry("bank account ID invalid", func(r *PaymentServiceUserCreatePaymentLinkRequest) { r.BankAccountID = "invalid" }),
+		)
+
+		It("should return an internal server error when backend returns error", func(ctx SpecContext) {
+			req := prepareJSONRequest(http.MethodPost, linkReq)
+			req = prepareQueryRequestWithBody(http.MethodPost, req.Body, "paymentServiceUserID", psuID.String(), "connectorID", connectorID.String())
+			expectedErr := errors.New("create payment link error")
+			m.EXPECT().PaymentServiceUsersCreatePaymentLink(gomock.Any(), "Test", psuID, gomock.Any(), bankAccountID, nil, gomock.Any()).Return(
+				"", "", expectedErr,
+			)
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusInternalServerError, "INTERNAL")
+		})
+
+		It("should return created status with link", func(ctx SpecContext) {
+			req := prepareJSONRequest(http.MethodPost, linkReq)
+			req = prepareQueryRequestWithBody(http.MethodPost, req.Body, "paymentServiceUserID", psuID.String(), "connectorID", connectorID.String())
+			m.EXPECT().PaymentServiceUsersCreatePaymentLink(gomock.Any(), "Test", psuID, gomock.Any(), bankAccountID, nil, gomock.Any()).DoAndReturn(
+				func(_ any, _ string, _ uuid.UUID, pi models.PaymentInitiation, _ uuid.UUID, _ *uuid.UUID, _ *string) (string, string, error) {
+					Expect(pi.ID).To(Equal(expectedPI))
+					Expect(pi.Type).To(Equal(models.PAYMENT_INITIATION_TYPE_PAYOUT))
+					Expect(pi.Amount).To(Equal(big.NewInt(100)))
+					Expect(pi.Asset).To(Equal("EUR/2"))
+					return "test", "https://link?a=1&b=2", nil
+				},
+			)
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusCreated, `"link":"https://link?a=1&b=2"`)
+		})
+
+		It("should return created status with link when idempotency key is provided", func(ctx SpecContext) {
+			idempotencyKey := uuid.New()
+			req := prepareJSONRequest(http.MethodPost, linkReq)
+			req = prepareQueryRequestWithBody(http.MethodPost, req.Body, "paymentServiceUserID", psuID.String(), "connectorID", connectorID.String())
+			req.URL.RawQuery = "Idempotency-Key=" + idempotencyKey.String()
+			m.EXPECT().PaymentServiceUsersCreatePaymentLink(gomock.Any(), "Test", psuID, gomock.Any(), bankAccountID, &idempotencyKey, gomock.Any()).Return("test", "link", nil)
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusCreated, `"attemptID":"test"`)
+		})
+	})
+})
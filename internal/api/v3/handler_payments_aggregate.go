@@ -0,0 +1,42 @@
+package v3
+
+import (
+	"net/http"
+
+	"github.com/formancehq/go-libs/v5/pkg/transport/api"
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/otel"
+	"github.com/formancehq/payments/internal/storage"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func paymentsAggregate(backend backend.Backend) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := otel.Tracer().Start(r.Context(), "v3_paymentsAggregate")
+		defer span.End()
+
+		qb, err := getQueryBuilder(span, r)
+		if err != nil {
+			otel.RecordError(span, err)
+			api.BadRequest(w, ErrValidation, err)
+			return
+		}
+
+		query := storage.PaymentsAggregateQuery{
+			QueryBuilder: qb,
+			GroupBy:      r.URL.Query()["groupBy"],
+			Interval:     r.URL.Query().Get("interval"),
+		}
+		span.SetAttributes(attribute.StringSlice("groupBy", query.GroupBy))
+		span.SetAttributes(attribute.String("interval", query.Interval))
+
+		aggregates, err := backend.PaymentsAggregate(ctx, query)
+		if err != nil {
+			otel.RecordError(span, err)
+			handleServiceErrors(w, r, err)
+			return
+		}
+
+		api.Ok(w, aggregates)
+	}
+}
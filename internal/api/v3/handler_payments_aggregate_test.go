@@ -0,0 +1,75 @@
+package v3
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("API v3 Payments Aggregate", func() {
+	var (
+		handlerFn http.HandlerFunc
+	)
+
+	Context("aggregate payments", func() {
+		var (
+			w *httptest.ResponseRecorder
+			m *backend.MockBackend
+		)
+		BeforeEach(func() {
+			w = httptest.NewRecorder()
+			ctrl := gomock.NewController(GinkgoT())
+			m = backend.NewMockBackend(ctrl)
+			handlerFn = paymentsAggregate(m)
+		})
+
+		It("should return a bad request error when the query is invalid", func(ctx SpecContext) {
+			req := httptest.NewRequest(http.MethodGet, "/", strings.NewReader("{invalid"))
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrValidation)
+		})
+
+		It("should return a bad request error when backend returns a validation error", func(ctx SpecContext) {
+			req := httptest.NewRequest(http.MethodGet, "/?groupBy=unknown", nil)
+			m.EXPECT().PaymentsAggregate(gomock.Any(), gomock.Any()).Return(nil, storage.ErrValidation)
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrValidation)
+		})
+
+		It("should return an internal server error when backend returns error", func(ctx SpecContext) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			m.EXPECT().PaymentsAggregate(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("payments aggregate error"))
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusInternalServerError, "INTERNAL")
+		})
+
+		It("should return data object", func(ctx SpecContext) {
+			req := httptest.NewRequest(http.MethodGet, "/?groupBy=status&groupBy=connector_id&interval=day", strings.NewReader(`{"$match": {"asset": "USD/2"}}`))
+			m.EXPECT().PaymentsAggregate(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ any, q storage.PaymentsAggregateQuery) ([]models.PaymentAggregate, error) {
+					Expect(q.QueryBuilder).NotTo(BeNil())
+					Expect(q.GroupBy).To(Equal([]string{"status", "connector_id"}))
+					Expect(q.Interval).To(Equal("day"))
+					return []models.PaymentAggregate{
+						{Asset: "USD/2", Count: 1, Amount: big.NewInt(100), InitialAmount: big.NewInt(100)},
+					}, nil
+				},
+			)
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusOK, "data")
+		})
+	})
+})
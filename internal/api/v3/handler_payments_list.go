@@ -17,7 +17,12 @@ func paymentsList(backend backend.Backend) http.HandlerFunc {
 		defer span.End()
 
 		query, err := paginate.Extract[storage.ListPaymentsQuery](r, func() (*storage.ListPaymentsQuery, error) {
-			options, err := getPagination(span, r, storage.PaymentQuery{})
+			sort, err := getSort(span, r)
+			if err != nil {
+				return nil, err
+			}
+
+			options, err := getPagination(span, r, storage.PaymentQuery{Sort: sort})
 			if err != nil {
 				return nil, err
 			}
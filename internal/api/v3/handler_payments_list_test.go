@@ -7,8 +7,10 @@ import (
 
 	"github.com/formancehq/go-libs/v5/pkg/storage/bun/paginate"
 	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/storage"
 	"github.com/formancehq/payments/pkg/domain/models"
 	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
 )
 
@@ -48,5 +50,28 @@ var _ = Describe("API v3 Payments List", func() {
 
 			assertExpectedResponse(w.Result(), http.StatusOK, "cursor")
 		})
+
+		It("should return a bad request error when sort is invalid", func(ctx SpecContext) {
+			req := httptest.NewRequest(http.MethodGet, "/?sort=created_at:sideways", nil)
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrValidation)
+		})
+
+		It("should pass the sort keys to the backend", func(ctx SpecContext) {
+			req := httptest.NewRequest(http.MethodGet, "/?sort=amount:asc&sort=created_at", nil)
+			m.EXPECT().PaymentsList(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ any, q storage.ListPaymentsQuery) (*paginate.Cursor[models.Payment], error) {
+					Expect(q.Options.Options.Sort).To(Equal([]storage.SortKey{
+						{Key: "amount", Order: paginate.OrderAsc},
+						{Key: "created_at", Order: paginate.OrderDesc},
+					}))
+					return &paginate.Cursor[models.Payment]{}, nil
+				},
+			)
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusOK, "cursor")
+		})
 	})
 })
@@ -0,0 +1,67 @@
+package v3
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/formancehq/payments/internal/api/backend"
+	"github.com/formancehq/payments/internal/api/services"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("API v3 Schedules resume", func() {
+	var (
+		handlerFn  http.HandlerFunc
+		connID     models.ConnectorID
+		scheduleID string
+	)
+	BeforeEach(func() {
+		connID = models.ConnectorID{Reference: uuid.New(), Provider: "psp"}
+		scheduleID = "schedule-id"
+	})
+
+	Context("resume schedules", func() {
+		var (
+			w *httptest.ResponseRecorder
+			m *backend.MockBackend
+		)
+		BeforeEach(func() {
+			w = httptest.NewRecorder()
+			ctrl := gomock.NewController(GinkgoT())
+			m = backend.NewMockBackend(ctrl)
+			handlerFn = schedulesResume(m)
+		})
+
+		It("should return a bad request error when connector ID is invalid", func(ctx SpecContext) {
+			req := prepareQueryRequest(http.MethodPost, "connectorID", "invalid", "scheduleID", scheduleID)
+			handlerFn(w, req)
+
+			assertExpectedResponse(w.Result(), http.StatusBadRequest, ErrInvalidID)
+		})
+
+		It("should return a not found error when schedule does not exist", func(ctx SpecContext) {
+			expectedErr := fmt.Errorf("schedule not found: %w", services.ErrNotFound)
+			m.EXPECT().SchedulesResume(gomock.Any(), scheduleID, connID).Return(expectedErr)
+			handlerFn(w, prepareQueryRequest(http.MethodPost, "connectorID", connID.String(), "scheduleID", scheduleID))
+			assertExpectedResponse(w.Result(), http.StatusNotFound, "NOT_FOUND")
+		})
+
+		It("should return an internal server error when backend returns error", func(ctx SpecContext) {
+			expectedErr := errors.New("schedules resume err")
+			m.EXPECT().SchedulesResume(gomock.Any(), scheduleID, connID).Return(expectedErr)
+			handlerFn(w, prepareQueryRequest(http.MethodPost, "connectorID", connID.String(), "scheduleID", scheduleID))
+			assertExpectedResponse(w.Result(), http.StatusInternalServerError, "INTERNAL")
+		})
+
+		It("should return status no content on success", func(ctx SpecContext) {
+			m.EXPECT().SchedulesResume(gomock.Any(), scheduleID, connID).Return(nil)
+			handlerFn(w, prepareQueryRequest(http.MethodPost, "connectorID", connID.String(), "scheduleID", scheduleID))
+			assertExpectedResponse(w.Result(), http.StatusNoContent, "")
+		})
+	})
+})
@@ -28,6 +28,7 @@ func newRouter(backend backend.Backend, a jwt.Authenticator, debug bool) *chi.Mu
 			r.Route("/accounts", func(r chi.Router) {
 				r.Get("/", accountsList(backend))
 				r.Post("/", accountsCreate(backend, validator))
+				r.Post("/virtual", accountsCreateVirtual(backend, validator))
 
 				r.Route("/{accountID}", func(r chi.Router) {
 					r.Get("/", accountsGet(backend))
@@ -44,6 +45,17 @@ func newRouter(backend backend.Backend, a jwt.Authenticator, debug bool) *chi.Mu
 					r.Get("/", bankAccountsGet(backend))
 					r.Patch("/metadata", bankAccountsUpdateMetadata(backend))
 					r.Post("/forward", bankAccountsForwardToConnector(backend, validator))
+					r.Post("/verify", bankAccountsVerify(backend, validator))
+				})
+			})
+
+			// Counterparties
+			r.Route("/counterparties", func(r chi.Router) {
+				r.Get("/", counterpartiesList(backend))
+
+				r.Route("/{counterpartyID}", func(r chi.Router) {
+					r.Get("/", counterpartiesGet(backend))
+					r.Get("/payments", counterpartiesPaymentsList(backend))
 				})
 			})
 
@@ -51,6 +63,7 @@ func newRouter(backend backend.Backend, a jwt.Authenticator, debug bool) *chi.Mu
 			r.Route("/payments", func(r chi.Router) {
 				r.Post("/", paymentsCreate(backend, validator))
 				r.Get("/", paymentsList(backend))
+				r.Get("/aggregate", paymentsAggregate(backend))
 
 				r.Route("/{paymentID}", func(r chi.Router) {
 					r.Get("/", paymentsGet(backend))
@@ -81,6 +94,9 @@ func newRouter(backend backend.Backend, a jwt.Authenticator, debug bool) *chi.Mu
 			r.Route("/connectors", func(r chi.Router) {
 				r.Get("/", connectorsList(backend))
 				r.Post("/install/{connector}", connectorsInstall(backend))
+				r.Post("/test/{connector}", connectorsTest(backend))
+				r.Post("/export", connectorsExport(backend, validator))
+				r.Post("/import", connectorsImport(backend))
 
 				r.Get("/configs", connectorsConfigs(backend))
 				r.Get("/capabilities", connectorsCapabilities(backend))
@@ -90,11 +106,19 @@ func newRouter(backend backend.Backend, a jwt.Authenticator, debug bool) *chi.Mu
 					r.Get("/config", connectorsConfig(backend))
 					r.Patch("/config", connectorsConfigUpdate(backend))
 					r.Get("/capabilities", connectorsCapabilitiesGet(backend))
+					r.Get("/health", connectorsHealth(backend))
 					r.Post("/reset", connectorsReset(backend))
+					r.Post("/sync", connectorsSync(backend))
+					r.Post("/backfill", connectorsBackfill(backend, validator))
+					r.Post("/rotate-credentials", connectorsRotateCredentials(backend, validator))
+					r.Post("/clone", connectorsClone(backend, validator))
 
 					r.Get("/schedules", schedulesList(backend))
 					r.Route("/schedules/{scheduleID}", func(r chi.Router) {
 						r.Get("/", schedulesGet(backend))
+						r.Post("/pause", schedulesPause(backend))
+						r.Post("/resume", schedulesResume(backend))
+						r.Post("/trigger", schedulesTrigger(backend))
 						r.Get("/instances", workflowsInstancesList(backend))
 					})
 				})
@@ -125,6 +149,35 @@ func newRouter(backend backend.Backend, a jwt.Authenticator, debug bool) *chi.Mu
 				})
 			})
 
+			// Disputes
+			r.Route("/disputes", func(r chi.Router) {
+				r.Get("/", disputesList(backend))
+
+				r.Route("/{disputeID}", func(r chi.Router) {
+					r.Get("/", disputesGet(backend))
+				})
+			})
+
+			// Payment Correlation Rules
+			r.Route("/payment-correlation-rules", func(r chi.Router) {
+				r.Post("/", paymentCorrelationRulesCreate(backend, validator))
+				r.Get("/", paymentCorrelationRulesList(backend))
+				r.Delete("/{paymentCorrelationRuleID}", paymentCorrelationRulesDelete(backend))
+			})
+
+			// Payment Initiation Limits
+			r.Route("/limits", func(r chi.Router) {
+				r.Post("/", paymentInitiationLimitsCreate(backend, validator))
+				r.Get("/", paymentInitiationLimitsList(backend))
+
+				r.Route("/{paymentInitiationLimitID}", func(r chi.Router) {
+					r.Get("/", paymentInitiationLimitsGet(backend))
+					r.Patch("/", paymentInitiationLimitsUpdate(backend, validator))
+					r.Delete("/", paymentInitiationLimitsDelete(backend))
+					r.Get("/usage", paymentInitiationLimitsUsage(backend))
+				})
+			})
+
 			// Payment Initiations
 			r.Route("/payment-initiations", func(r chi.Router) {
 				r.Post("/", paymentInitiationsCreate(backend, validator))
@@ -137,6 +190,7 @@ func newRouter(backend backend.Backend, a jwt.Authenticator, debug bool) *chi.Mu
 					r.Post("/approve", paymentInitiationsApprove(backend))
 					r.Post("/reject", paymentInitiationsReject(backend))
 					r.Post("/reverse", paymentInitiationsReverse(backend, validator))
+					r.Post("/screening", paymentInitiationsScreeningResult(backend, validator))
 
 					r.Get("/adjustments", paymentInitiationAdjustmentsList(backend))
 					r.Get("/payments", paymentInitiationPaymentsList(backend))
@@ -157,6 +211,7 @@ func newRouter(backend backend.Backend, a jwt.Authenticator, debug bool) *chi.Mu
 						r.Delete("/", paymentServiceUsersDeleteConnector(backend))
 						r.Post("/forward", paymentServiceUsersForwardToProvider(backend))
 						r.Post("/create-link", paymentServiceUsersCreateLink(backend, validator))
+						r.Post("/create-payment-link", paymentServiceUsersCreatePaymentLink(backend, validator))
 
 						r.Get("/connections", paymentServiceUsersConnectionsListFromConnectorID(backend))
 						r.Get("/link-attempts", paymentServiceUsersLinkAttemptList(backend))
@@ -210,6 +265,18 @@ func bankAccountID(r *http.Request) string {
 	return chi.URLParam(r, "bankAccountID")
 }
 
+func counterpartyID(r *http.Request) string {
+	return chi.URLParam(r, "counterpartyID")
+}
+
+func paymentCorrelationRuleID(r *http.Request) string {
+	return chi.URLParam(r, "paymentCorrelationRuleID")
+}
+
+func paymentInitiationLimitID(r *http.Request) string {
+	return chi.URLParam(r, "paymentInitiationLimitID")
+}
+
 func paymentServiceUserID(r *http.Request) string {
 	return chi.URLParam(r, "paymentServiceUserID")
 }
@@ -237,3 +304,7 @@ func orderID(r *http.Request) string {
 func conversionID(r *http.Request) string {
 	return chi.URLParam(r, "conversionID")
 }
+
+func disputeID(r *http.Request) string {
+	return chi.URLParam(r, "disputeID")
+}
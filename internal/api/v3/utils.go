@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 
 	"github.com/formancehq/go-libs/v5/pkg/query"
 	"github.com/formancehq/go-libs/v5/pkg/storage/bun/paginate"
 	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/payments/internal/storage"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -42,6 +44,36 @@ func getQueryBuilder(span trace.Span, r *http.Request) (query.Builder, error) {
 	}
 }
 
+// getSort parses the sort query parameters, e.g. ?sort=created_at:asc&sort=reference.
+// The order defaults to descending when omitted.
+func getSort(span trace.Span, r *http.Request) ([]storage.SortKey, error) {
+	values := r.URL.Query()["sort"]
+	if len(values) == 0 {
+		return nil, nil
+	}
+	span.SetAttributes(attribute.StringSlice("sort", values))
+
+	sort := make([]storage.SortKey, 0, len(values))
+	for _, value := range values {
+		key, order, _ := strings.Cut(value, ":")
+		if key == "" {
+			return nil, fmt.Errorf("invalid sort '%s': missing key", value)
+		}
+
+		sortKey := storage.SortKey{Key: key, Order: paginate.OrderDesc}
+		switch strings.ToLower(order) {
+		case "", "desc":
+		case "asc":
+			sortKey.Order = paginate.OrderAsc
+		default:
+			return nil, fmt.Errorf("invalid sort '%s': order must be asc or desc", value)
+		}
+		sort = append(sort, sortKey)
+	}
+
+	return sort, nil
+}
+
 func getPagination[T any](span trace.Span, r *http.Request, options T) (*paginate.PaginatedQueryOptions[T], error) {
 	return getPaginationWithBuilder[T](span, r, nil, options)
 }
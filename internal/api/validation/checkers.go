@@ -71,6 +71,19 @@ func IsAccountType(fl validator.FieldLevel) bool {
 	return true
 }
 
+func IsCapability(fl validator.FieldLevel) bool {
+	str, err := fieldLevelToString(fl)
+	if err != nil {
+		return false
+	}
+
+	var capability models.Capability
+	if err := capability.Scan(str); err != nil {
+		return false
+	}
+	return true
+}
+
 func IsPaymentScheme(fl validator.FieldLevel) bool {
 	_, ok := fl.Field().Interface().(models.PaymentScheme)
 	if ok {
@@ -37,6 +37,7 @@ func NewValidator() *Validator {
 	registerCustomChecker("accountID", IsAccountID, "", validate, translator)
 	registerCustomChecker("accountType", IsAccountType, "", validate, translator)
 	registerCustomChecker("connectorID", IsConnectorID, "", validate, translator)
+	registerCustomChecker("capability", IsCapability, "", validate, translator)
 	registerCustomChecker("paymentType", IsPaymentType, "", validate, translator)
 	registerCustomChecker("paymentScheme", IsPaymentScheme, "", validate, translator)
 	registerCustomChecker("paymentStatus", IsPaymentStatus, "", validate, translator)
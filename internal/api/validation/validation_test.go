@@ -110,6 +110,17 @@ var _ = Describe("Validator custom type checks", func() {
 				FieldName int `validate:"accountType"`
 			}{FieldName: 0}),
 
+			// capability
+			Entry("capability: invalid value of string on required field", "capability", "StringFieldName", struct {
+				StringFieldName string `validate:"required,capability"`
+			}{StringFieldName: "invalid"}),
+			Entry("capability: invalid value of string", "capability", "StringFieldName", struct {
+				StringFieldName string `validate:"omitempty,capability"`
+			}{StringFieldName: "invalid"}),
+			Entry("capability: unsupported type for this matcher", "capability", "FieldName", struct {
+				FieldName int `validate:"capability"`
+			}{FieldName: 0}),
+
 			// paymentType
 			Entry("paymentType: invalid value of string on required field", "paymentType", "StringFieldName", struct {
 				StringFieldName string `validate:"required,paymentType"`
@@ -42,5 +42,20 @@ func (c *Configurer) Validate(conf models.Config) error {
 	if conf.PollingPeriod < c.connectorPollingPeriodMinimum {
 		return fmt.Errorf("%w: polling period cannot be lower than minimum of %s", ErrPollingPeriod, c.connectorPollingPeriodMinimum)
 	}
+
+	for capability, pollingPeriod := range conf.PollingPeriods {
+		if !capability.IsFetch() {
+			return fmt.Errorf("%w: polling period cannot be set for non fetch capability %s", models.ErrInvalidConfig, capability)
+		}
+		if pollingPeriod < c.connectorPollingPeriodMinimum {
+			return fmt.Errorf("%w: polling period of %s cannot be lower than minimum of %s", ErrPollingPeriod, capability, c.connectorPollingPeriodMinimum)
+		}
+	}
+
+	for _, window := range conf.BlackoutWindows {
+		if err := window.Validate(); err != nil {
+			return fmt.Errorf("%w: %w", models.ErrInvalidConfig, err)
+		}
+	}
 	return nil
 }
@@ -66,3 +66,40 @@ func TestValidateConfig(t *testing.T) {
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, models.ErrInvalidConfig)
 }
+
+func TestValidateConfigSchedulePolicy(t *testing.T) {
+	t.Parallel()
+
+	configurer, err := connectors.NewConfigurer(13*time.Minute, 5*time.Minute)
+	require.NoError(t, err)
+
+	validConfig := models.Config{
+		Name:          "name",
+		PollingPeriod: 7 * time.Minute,
+		PollingPeriods: map[models.Capability]time.Duration{
+			models.CAPABILITY_FETCH_EXTERNAL_ACCOUNTS: 24 * time.Hour,
+		},
+		BlackoutWindows: []models.BlackoutWindow{{Start: "23:00", End: "01:00"}},
+	}
+	err = configurer.Validate(validConfig)
+	assert.NoError(t, err)
+
+	invalidConfig := validConfig
+	invalidConfig.PollingPeriods = map[models.Capability]time.Duration{
+		models.CAPABILITY_FETCH_BALANCES: time.Minute,
+	}
+	err = configurer.Validate(invalidConfig)
+	assert.ErrorIs(t, err, connectors.ErrPollingPeriod)
+
+	invalidConfig = validConfig
+	invalidConfig.PollingPeriods = map[models.Capability]time.Duration{
+		models.CAPABILITY_CREATE_PAYOUT: 24 * time.Hour,
+	}
+	err = configurer.Validate(invalidConfig)
+	assert.ErrorIs(t, err, models.ErrInvalidConfig)
+
+	invalidConfig = validConfig
+	invalidConfig.BlackoutWindows = []models.BlackoutWindow{{Start: "23:00", End: "24:30"}}
+	err = configurer.Validate(invalidConfig)
+	assert.ErrorIs(t, err, models.ErrInvalidConfig)
+}
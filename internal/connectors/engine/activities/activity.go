@@ -7,6 +7,7 @@ import (
 	"github.com/formancehq/go-libs/v5/pkg/observe/log"
 	temporalworker "github.com/formancehq/go-libs/v5/pkg/workflow/temporal"
 	"github.com/formancehq/payments/internal/connectors"
+	"github.com/formancehq/payments/internal/connectors/engine/screening"
 	"github.com/formancehq/payments/internal/events"
 	"github.com/formancehq/payments/internal/storage"
 	"go.temporal.io/sdk/client"
@@ -24,6 +25,9 @@ type Activities struct {
 	healthCheckErrorThreshold int
 
 	connectors connectors.Manager
+
+	// nil when no screening service is configured
+	screening screening.Client
 }
 
 func (a Activities) DefinitionSet() temporalworker.DefinitionSet {
@@ -36,6 +40,10 @@ func (a Activities) DefinitionSet() temporalworker.DefinitionSet {
 			Name: "PluginUninstallConnector",
 			Func: a.PluginUninstallConnector,
 		}).
+		Append(temporalworker.Definition{
+			Name: "PluginTestConnection",
+			Func: a.PluginTestConnection,
+		}).
 		Append(temporalworker.Definition{
 			Name: "PluginFetchNextAccounts",
 			Func: a.PluginFetchNextAccounts,
@@ -64,10 +72,22 @@ func (a Activities) DefinitionSet() temporalworker.DefinitionSet {
 			Name: "PluginFetchNextConversions",
 			Func: a.PluginFetchNextConversions,
 		}).
+		Append(temporalworker.Definition{
+			Name: "PluginFetchNextDisputes",
+			Func: a.PluginFetchNextDisputes,
+		}).
 		Append(temporalworker.Definition{
 			Name: "PluginCreateBankAccount",
 			Func: a.PluginCreateBankAccount,
 		}).
+		Append(temporalworker.Definition{
+			Name: "PluginVerifyBankAccount",
+			Func: a.PluginVerifyBankAccount,
+		}).
+		Append(temporalworker.Definition{
+			Name: "PluginCreateAccount",
+			Func: a.PluginCreateAccount,
+		}).
 		Append(temporalworker.Definition{
 			Name: "PluginCreateTransfert",
 			Func: a.PluginCreateTransfer,
@@ -212,10 +232,18 @@ func (a Activities) DefinitionSet() temporalworker.DefinitionSet {
 			Name: "StorageStatesDelete",
 			Func: a.StorageStatesDelete,
 		}).
+		Append(temporalworker.Definition{
+			Name: "StorageStatesDeleteFromID",
+			Func: a.StorageStatesDeleteFromID,
+		}).
 		Append(temporalworker.Definition{
 			Name: "StorageConnectorTasksTreeStore",
 			Func: a.StorageConnectorTasksTreeStore,
 		}).
+		Append(temporalworker.Definition{
+			Name: "StorageConnectorTasksTreeGet",
+			Func: a.StorageConnectorTasksTreeGet,
+		}).
 		Append(temporalworker.Definition{
 			Name: "StorageConnectorTasksTreeDelete",
 			Func: a.StorageConnectorTasksTreeDelete,
@@ -224,6 +252,14 @@ func (a Activities) DefinitionSet() temporalworker.DefinitionSet {
 			Name: "StorageConnectorsStore",
 			Func: a.StorageConnectorsStore,
 		}).
+		Append(temporalworker.Definition{
+			Name: "StorageConnectorsRotateCredentials",
+			Func: a.StorageConnectorsRotateCredentials,
+		}).
+		Append(temporalworker.Definition{
+			Name: "StorageConnectorHealthRefresh",
+			Func: a.StorageConnectorHealthRefresh,
+		}).
 		Append(temporalworker.Definition{
 			Name: "StorageConnectorsGet",
 			Func: a.StorageConnectorsGet,
@@ -240,6 +276,10 @@ func (a Activities) DefinitionSet() temporalworker.DefinitionSet {
 			Name: "StorageConnectorsGetPollingPeriod",
 			Func: a.StorageConnectorsGetPollingPeriod,
 		}).
+		Append(temporalworker.Definition{
+			Name: "StorageConnectorsGetSchedulePolicy",
+			Func: a.StorageConnectorsGetSchedulePolicy,
+		}).
 		Append(temporalworker.Definition{
 			Name: "StorageSchedulesGet",
 			Func: a.StorageSchedulesGet,
@@ -288,6 +328,14 @@ func (a Activities) DefinitionSet() temporalworker.DefinitionSet {
 			Name: "StorageBankAccountsGet",
 			Func: a.StorageBankAccountsGet,
 		}).
+		Append(temporalworker.Definition{
+			Name: "StorageBankAccountsGetFromRelatedAccountID",
+			Func: a.StorageBankAccountsGetFromRelatedAccountID,
+		}).
+		Append(temporalworker.Definition{
+			Name: "StorageBankAccountsAddVerification",
+			Func: a.StorageBankAccountsAddVerification,
+		}).
 		Append(temporalworker.Definition{
 			Name: "StoragePaymentServiceUsersGet",
 			Func: a.StoragePaymentServiceUsersGet,
@@ -308,6 +356,10 @@ func (a Activities) DefinitionSet() temporalworker.DefinitionSet {
 			Name: "StorageConversionsUpsert",
 			Func: a.StorageConversionsUpsert,
 		}).
+		Append(temporalworker.Definition{
+			Name: "StorageDisputesUpsert",
+			Func: a.StorageDisputesUpsert,
+		}).
 		Append(temporalworker.Definition{
 			Name: "StorageWebhooksConfigsStore",
 			Func: a.StorageWebhooksConfigsStore,
@@ -444,6 +496,18 @@ func (a Activities) DefinitionSet() temporalworker.DefinitionSet {
 			Name: "StorageOpenBankingConnectionsGetFromConnectionID",
 			Func: a.StorageOpenBankingConnectionsGetFromConnectionID,
 		}).
+		Append(temporalworker.Definition{
+			Name: "StorageOpenBankingPaymentAttemptsUpdateStatus",
+			Func: a.StorageOpenBankingPaymentAttemptsUpdateStatus,
+		}).
+		Append(temporalworker.Definition{
+			Name: "StorageOpenBankingPaymentAttemptsGetFromPaymentRequestReference",
+			Func: a.StorageOpenBankingPaymentAttemptsGetFromPaymentRequestReference,
+		}).
+		Append(temporalworker.Definition{
+			Name: "ScreeningSubmit",
+			Func: a.ScreeningSubmit,
+		}).
 		Append(temporalworker.Definition{
 			Name: "TemporalScheduleCreate",
 			Func: a.TemporalScheduleCreate,
@@ -452,6 +516,10 @@ func (a Activities) DefinitionSet() temporalworker.DefinitionSet {
 			Name: "TemporalScheduleUpdatePollingPeriod",
 			Func: a.TemporalScheduleUpdatePollingPeriod,
 		}).
+		Append(temporalworker.Definition{
+			Name: "TemporalScheduleUpdateSchedulePolicy",
+			Func: a.TemporalScheduleUpdateSchedulePolicy,
+		}).
 		Append(temporalworker.Definition{
 			Name: "TemporalDeleteSchedule",
 			Func: a.TemporalScheduleDelete,
@@ -464,6 +532,10 @@ func (a Activities) DefinitionSet() temporalworker.DefinitionSet {
 			Name: "TemporalSchedulesUnpause",
 			Func: a.TemporalSchedulesUnpause,
 		}).
+		Append(temporalworker.Definition{
+			Name: "TemporalSchedulesTrigger",
+			Func: a.TemporalSchedulesTrigger,
+		}).
 		Append(temporalworker.Definition{
 			Name: "TemporalWorkflowTerminate",
 			Func: a.TemporalWorkflowTerminate,
@@ -484,6 +556,10 @@ func (a Activities) DefinitionSet() temporalworker.DefinitionSet {
 			Name: "StorageOutboxEventsInsert",
 			Func: a.StorageOutboxEventsInsert,
 		}).
+		Append(temporalworker.Definition{
+			Name: "CounterpartiesLinkPendingAccounts",
+			Func: a.CounterpartiesLinkPendingAccounts,
+		}).
 		// TODO sendEvents activity should be removed in the next version (3.2)
 		// We need to keep it a while more until all RunSendEvent workflows are completed.
 		Append(temporalworker.Definition{
@@ -560,6 +636,13 @@ func New(
 	}
 }
 
+// WithScreeningClient sets the client of the sanctions/AML screening service
+// payment initiations are submitted to before reaching the PSP.
+func (a Activities) WithScreeningClient(client screening.Client) Activities {
+	a.screening = client
+	return a
+}
+
 func executeActivity(ctx workflow.Context, activity any, ret any, args ...any) error {
 	if err := workflow.ExecuteActivity(ctx, activity, args...).Get(ctx, ret); err != nil {
 		var timeoutError *temporal.TimeoutError
@@ -0,0 +1,27 @@
+package activities
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/sdk/workflow"
+)
+
+func (a Activities) CounterpartiesLinkPendingAccounts(ctx context.Context, limit int) (int, error) {
+	linked, err := a.storage.CounterpartiesLinkPendingAccounts(ctx, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to link accounts to counterparties: %w", err)
+	}
+
+	return linked, nil
+}
+
+var CounterpartiesLinkPendingAccountsActivity = Activities{}.CounterpartiesLinkPendingAccounts
+
+func CounterpartiesLinkPendingAccounts(ctx workflow.Context, limit int) (int, error) {
+	var linked int
+	if err := executeActivity(ctx, CounterpartiesLinkPendingAccountsActivity, &linked, limit); err != nil {
+		return 0, err
+	}
+	return linked, nil
+}
@@ -0,0 +1,43 @@
+package activities_test
+
+import (
+	"errors"
+
+	"github.com/formancehq/go-libs/v5/pkg/observe/log"
+	"github.com/formancehq/payments/internal/connectors/engine/activities"
+	internalevents "github.com/formancehq/payments/internal/events"
+	"github.com/formancehq/payments/internal/storage"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("CounterpartiesLinkPendingAccounts", func() {
+	var (
+		act    activities.Activities
+		s      *storage.MockStorage
+		logger = logging.NewDefaultLogger(GinkgoWriter, true, false, false)
+	)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		s = storage.NewMockStorage(ctrl)
+		act = activities.New(logger, nil, s, internalevents.New(nil, "http://localhost"), nil, 0, 0)
+	})
+
+	It("returns the number of linked accounts", func(ctx SpecContext) {
+		s.EXPECT().CounterpartiesLinkPendingAccounts(ctx, 500).Return(12, nil)
+
+		linked, err := act.CounterpartiesLinkPendingAccounts(ctx, 500)
+		Expect(err).To(BeNil())
+		Expect(linked).To(Equal(12))
+	})
+
+	It("handles storage error", func(ctx SpecContext) {
+		s.EXPECT().CounterpartiesLinkPendingAccounts(ctx, 500).Return(0, errors.New("database error"))
+
+		_, err := act.CounterpartiesLinkPendingAccounts(ctx, 500)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("failed to link accounts to counterparties"))
+	})
+})
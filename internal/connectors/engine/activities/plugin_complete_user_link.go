@@ -18,6 +18,10 @@ func (a Activities) PluginCompleteUserLink(ctx context.Context, request Complete
 		return nil, a.temporalPluginError(ctx, err)
 	}
 
+	if err := a.takeRateBudget(ctx, request.ConnectorID); err != nil {
+		return nil, err
+	}
+
 	resp, err := plugin.CompleteUserLink(ctx, request.Req)
 	if err != nil {
 		return nil, a.temporalPluginError(ctx, err)
@@ -0,0 +1,49 @@
+package activities
+
+import (
+	"context"
+
+	"github.com/formancehq/payments/internal/connectors/plugins"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"go.temporal.io/sdk/workflow"
+)
+
+type CreateAccountRequest struct {
+	ConnectorID models.ConnectorID
+	Req         models.CreateAccountRequest
+}
+
+func (a Activities) PluginCreateAccount(ctx context.Context, request CreateAccountRequest) (*models.CreateAccountResponse, error) {
+	plugin, err := a.connectors.Get(request.ConnectorID)
+	if err != nil {
+		return nil, a.temporalPluginError(ctx, err)
+	}
+
+	creator, ok := plugin.(models.PluginWithAccountCreation)
+	if !ok {
+		return nil, a.temporalPluginError(ctx, plugins.ErrNotImplemented)
+	}
+
+	if err := a.takeRateBudget(ctx, request.ConnectorID); err != nil {
+		return nil, err
+	}
+
+	resp, err := creator.CreateAccount(ctx, request.Req)
+	if err != nil {
+		return nil, a.temporalPluginError(ctx, err)
+	}
+	return &resp, nil
+}
+
+var PluginCreateAccountActivity = Activities{}.PluginCreateAccount
+
+func PluginCreateAccount(ctx workflow.Context, connectorID models.ConnectorID, request models.CreateAccountRequest) (*models.CreateAccountResponse, error) {
+	ret := models.CreateAccountResponse{}
+	if err := executeActivity(ctx, PluginCreateAccountActivity, &ret, CreateAccountRequest{
+		ConnectorID: connectorID,
+		Req:         request,
+	}); err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
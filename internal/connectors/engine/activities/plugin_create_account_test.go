@@ -0,0 +1,108 @@
+package activities_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/observe/log"
+	"github.com/formancehq/payments/internal/connectors"
+	"github.com/formancehq/payments/internal/connectors/engine/activities"
+	pluginsError "github.com/formancehq/payments/internal/connectors/plugins"
+	"github.com/formancehq/payments/internal/events"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.temporal.io/sdk/temporal"
+	gomock "go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Plugin Create Account", func() {
+	var (
+		act            activities.Activities
+		p              *connectors.MockManager
+		s              *storage.MockStorage
+		evts           *events.Events
+		sampleResponse models.CreateAccountResponse
+	)
+
+	BeforeEach(func() {
+		evts = &events.Events{}
+		sampleResponse = models.CreateAccountResponse{
+			Account: models.PSPAccount{
+				Reference: "acc",
+			},
+		}
+	})
+
+	Context("plugin create account", func() {
+		var (
+			plugin  *models.MockPlugin
+			creator *models.MockPluginWithAccountCreation
+			req     activities.CreateAccountRequest
+			logger  = logging.NewDefaultLogger(GinkgoWriter, true, false, false)
+			delay   = 50 * time.Millisecond
+		)
+
+		BeforeEach(func() {
+			ctrl := gomock.NewController(GinkgoT())
+			p = connectors.NewMockManager(ctrl)
+			s = storage.NewMockStorage(ctrl)
+			plugin = models.NewMockPlugin(ctrl)
+			creator = models.NewMockPluginWithAccountCreation(ctrl)
+			act = activities.New(logger, nil, s, evts, p, delay, 0)
+			req = activities.CreateAccountRequest{
+				ConnectorID: models.ConnectorID{
+					Provider: "some_provider",
+				},
+			}
+		})
+
+		withAccountCreation := func() models.Plugin {
+			return struct {
+				*models.MockPlugin
+				*models.MockPluginWithAccountCreation
+			}{plugin, creator}
+		}
+
+		It("calls underlying plugin", func(ctx SpecContext) {
+			p.EXPECT().Get(req.ConnectorID).Return(withAccountCreation(), nil)
+			creator.EXPECT().CreateAccount(ctx, req.Req).Return(sampleResponse, nil)
+			res, err := act.PluginCreateAccount(ctx, req)
+			Expect(err).To(BeNil())
+			Expect(res.Account).To(Equal(sampleResponse.Account))
+		})
+
+		It("returns a non-retryable error when the plugin does not support account creation", func(ctx SpecContext) {
+			p.EXPECT().Get(req.ConnectorID).Return(plugin, nil)
+			_, err := act.PluginCreateAccount(ctx, req)
+			Expect(err).ToNot(BeNil())
+			temporalErr, ok := err.(*temporal.ApplicationError)
+			Expect(ok).To(BeTrue())
+			Expect(temporalErr.NonRetryable()).To(BeTrue())
+			Expect(temporalErr.Type()).To(Equal(activities.ErrTypeUnimplemented))
+		})
+
+		It("returns a retryable temporal error", func(ctx SpecContext) {
+			p.EXPECT().Get(req.ConnectorID).Return(withAccountCreation(), nil)
+			creator.EXPECT().CreateAccount(ctx, req.Req).Return(sampleResponse, fmt.Errorf("some string"))
+			_, err := act.PluginCreateAccount(ctx, req)
+			Expect(err).ToNot(BeNil())
+			temporalErr, ok := err.(*temporal.ApplicationError)
+			Expect(ok).To(BeTrue())
+			Expect(temporalErr.NonRetryable()).To(BeFalse())
+			Expect(temporalErr.Type()).To(Equal(activities.ErrTypeDefault))
+		})
+
+		It("returns a non-retryable temporal error on invalid requests", func(ctx SpecContext) {
+			p.EXPECT().Get(req.ConnectorID).Return(withAccountCreation(), nil)
+			creator.EXPECT().CreateAccount(ctx, req.Req).Return(sampleResponse, fmt.Errorf("invalid: %w", pluginsError.ErrInvalidClientRequest))
+			_, err := act.PluginCreateAccount(ctx, req)
+			Expect(err).ToNot(BeNil())
+			temporalErr, ok := err.(*temporal.ApplicationError)
+			Expect(ok).To(BeTrue())
+			Expect(temporalErr.NonRetryable()).To(BeTrue())
+			Expect(temporalErr.Type()).To(Equal(activities.ErrTypeInvalidArgument))
+		})
+	})
+})
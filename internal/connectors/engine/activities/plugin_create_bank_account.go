@@ -18,6 +18,10 @@ func (a Activities) PluginCreateBankAccount(ctx context.Context, request CreateB
 		return nil, a.temporalPluginError(ctx, err)
 	}
 
+	if err := a.takeRateBudget(ctx, request.ConnectorID); err != nil {
+		return nil, err
+	}
+
 	resp, err := plugin.CreateBankAccount(ctx, request.Req)
 	if err != nil {
 		return nil, a.temporalPluginError(ctx, err)
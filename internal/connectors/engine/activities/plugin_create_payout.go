@@ -18,6 +18,17 @@ func (a Activities) PluginCreatePayout(ctx context.Context, request CreatePayout
 		return nil, a.temporalPluginError(ctx, err)
 	}
 
+	psu, connection, err := a.openBankingConnectionFromPI(ctx, request.ConnectorID, request.Req.PaymentInitiation)
+	if err != nil {
+		return nil, err
+	}
+	request.Req.PaymentServiceUser = psu
+	request.Req.OpenBankingConnection = connection
+
+	if err := a.takeRateBudget(ctx, request.ConnectorID); err != nil {
+		return nil, err
+	}
+
 	resp, err := plugin.CreatePayout(ctx, request.Req)
 	if err != nil {
 		return nil, a.temporalPluginError(ctx, err)
@@ -1,6 +1,7 @@
 package activities_test
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/formancehq/payments/internal/events"
 	"github.com/formancehq/payments/pkg/domain/models"
 	"github.com/formancehq/payments/internal/storage"
+	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.temporal.io/sdk/temporal"
@@ -62,6 +64,52 @@ var _ = Describe("Plugin Create Payout", func() {
 			Expect(res.Payment.Reference).To(Equal(sampleResponse.Payment.Reference))
 		})
 
+		It("resolves the open banking connection of the payment initiation accounts", func(ctx SpecContext) {
+			psuID := uuid.New()
+			connectionID := "connection"
+			connection := &models.OpenBankingConnection{
+				ConnectionID: connectionID,
+				ConnectorID:  req.ConnectorID,
+				AccessToken:  &models.Token{Token: "access-token"},
+			}
+			req.Req.PaymentInitiation.DestinationAccount = &models.PSPAccount{
+				Reference:               "account",
+				PsuID:                   &psuID,
+				OpenBankingConnectionID: &connectionID,
+			}
+
+			p.EXPECT().Get(req.ConnectorID).Return(plugin, nil)
+			s.EXPECT().PaymentServiceUsersGet(ctx, psuID).Return(&models.PaymentServiceUser{ID: psuID}, nil)
+			s.EXPECT().OpenBankingConnectionsGetFromConnectionID(ctx, req.ConnectorID, connectionID).Return(connection, psuID, nil)
+			plugin.EXPECT().CreatePayout(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, r models.CreatePayoutRequest) (models.CreatePayoutResponse, error) {
+				Expect(r.PaymentServiceUser).ToNot(BeNil())
+				Expect(r.PaymentServiceUser.ID).To(Equal(psuID))
+				Expect(r.OpenBankingConnection).To(Equal(connection))
+				return sampleResponse, nil
+			})
+			_, err := act.PluginCreatePayout(ctx, req)
+			Expect(err).To(BeNil())
+		})
+
+		It("returns a storage error when the open banking connection cannot be found", func(ctx SpecContext) {
+			psuID := uuid.New()
+			connectionID := "connection"
+			req.Req.PaymentInitiation.DestinationAccount = &models.PSPAccount{
+				Reference:               "account",
+				PsuID:                   &psuID,
+				OpenBankingConnectionID: &connectionID,
+			}
+
+			p.EXPECT().Get(req.ConnectorID).Return(plugin, nil)
+			s.EXPECT().PaymentServiceUsersGet(ctx, psuID).Return(&models.PaymentServiceUser{ID: psuID}, nil)
+			s.EXPECT().OpenBankingConnectionsGetFromConnectionID(ctx, req.ConnectorID, connectionID).Return(nil, uuid.Nil, storage.ErrNotFound)
+			_, err := act.PluginCreatePayout(ctx, req)
+			Expect(err).ToNot(BeNil())
+			temporalErr, ok := err.(*temporal.ApplicationError)
+			Expect(ok).To(BeTrue())
+			Expect(temporalErr.Type()).To(Equal(activities.ErrTypeStorage))
+		})
+
 		It("returns a retryable temporal error", func(ctx SpecContext) {
 			p.EXPECT().Get(req.ConnectorID).Return(plugin, nil)
 			plugin.EXPECT().CreatePayout(ctx, req.Req).Return(sampleResponse, fmt.Errorf("some string"))
@@ -18,6 +18,10 @@ func (a Activities) PluginCreateUser(ctx context.Context, request CreateUserRequ
 		return nil, a.temporalPluginError(ctx, err)
 	}
 
+	if err := a.takeRateBudget(ctx, request.ConnectorID); err != nil {
+		return nil, err
+	}
+
 	resp, err := plugin.CreateUser(ctx, request.Req)
 	if err != nil {
 		return nil, a.temporalPluginError(ctx, err)
@@ -18,6 +18,10 @@ func (a Activities) PluginCreateUserLink(ctx context.Context, request CreateUser
 		return nil, a.temporalPluginError(ctx, err)
 	}
 
+	if err := a.takeRateBudget(ctx, request.ConnectorID); err != nil {
+		return nil, err
+	}
+
 	resp, err := plugin.CreateUserLink(ctx, request.Req)
 	if err != nil {
 		return nil, a.temporalPluginError(ctx, err)
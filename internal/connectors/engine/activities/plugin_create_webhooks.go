@@ -18,6 +18,10 @@ func (a Activities) PluginCreateWebhooks(ctx context.Context, request CreateWebh
 		return nil, a.temporalPluginError(ctx, err)
 	}
 
+	if err := a.takeRateBudget(ctx, request.ConnectorID); err != nil {
+		return nil, err
+	}
+
 	resp, err := plugin.CreateWebhooks(ctx, request.Req)
 	if err != nil {
 		return nil, a.temporalPluginError(ctx, err)
@@ -18,6 +18,10 @@ func (a Activities) PluginDeleteUser(ctx context.Context, request DeleteUserRequ
 		return nil, a.temporalPluginError(ctx, err)
 	}
 
+	if err := a.takeRateBudget(ctx, request.ConnectorID); err != nil {
+		return nil, err
+	}
+
 	resp, err := plugin.DeleteUser(ctx, request.Req)
 	if err != nil {
 		return nil, a.temporalPluginError(ctx, err)
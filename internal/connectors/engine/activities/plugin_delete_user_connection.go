@@ -18,6 +18,10 @@ func (a Activities) PluginDeleteUserConnection(ctx context.Context, request Dele
 		return nil, a.temporalPluginError(ctx, err)
 	}
 
+	if err := a.takeRateBudget(ctx, request.ConnectorID); err != nil {
+		return nil, err
+	}
+
 	resp, err := plugin.DeleteUserConnection(ctx, request.Req)
 	if err != nil {
 		return nil, a.temporalPluginError(ctx, err)
@@ -20,6 +20,10 @@ func (a Activities) PluginFetchNextAccounts(ctx context.Context, request FetchNe
 		return nil, a.temporalPluginError(ctx, err)
 	}
 
+	if err := a.takeRateBudget(ctx, request.ConnectorID); err != nil {
+		return nil, err
+	}
+
 	resp, err := plugin.FetchNextAccounts(ctx, request.Req)
 	if err != nil {
 		return nil, a.temporalPluginPollingError(ctx, err, request.Periodic)
@@ -29,7 +33,7 @@ func (a Activities) PluginFetchNextAccounts(ctx context.Context, request FetchNe
 
 var PluginFetchNextAccountsActivity = Activities{}.PluginFetchNextAccounts
 
-func PluginFetchNextAccounts(ctx workflow.Context, connectorID models.ConnectorID, fromPayload, state json.RawMessage, pageSize int, periodic bool) (*models.FetchNextAccountsResponse, error) {
+func PluginFetchNextAccounts(ctx workflow.Context, connectorID models.ConnectorID, fromPayload, state json.RawMessage, pageSize int, window *models.FetchWindow, periodic bool) (*models.FetchNextAccountsResponse, error) {
 	ret := models.FetchNextAccountsResponse{}
 	if err := executeActivity(ctx, PluginFetchNextAccountsActivity, &ret, FetchNextAccountsRequest{
 		ConnectorID: connectorID,
@@ -37,6 +41,7 @@ func PluginFetchNextAccounts(ctx workflow.Context, connectorID models.ConnectorI
 			FromPayload: fromPayload,
 			State:       state,
 			PageSize:    pageSize,
+			Window:      window,
 		},
 		Periodic: periodic,
 	},
@@ -20,6 +20,10 @@ func (a Activities) PluginFetchNextBalances(ctx context.Context, request FetchNe
 		return nil, a.temporalPluginError(ctx, err)
 	}
 
+	if err := a.takeRateBudget(ctx, request.ConnectorID); err != nil {
+		return nil, err
+	}
+
 	resp, err := plugin.FetchNextBalances(ctx, request.Req)
 	if err != nil {
 		return nil, a.temporalPluginPollingError(ctx, err, request.Periodic)
@@ -20,6 +20,10 @@ func (a Activities) PluginFetchNextConversions(ctx context.Context, request Fetc
 		return nil, a.temporalPluginError(ctx, err)
 	}
 
+	if err := a.takeRateBudget(ctx, request.ConnectorID); err != nil {
+		return nil, err
+	}
+
 	resp, err := plugin.FetchNextConversions(ctx, request.Req)
 	if err != nil {
 		return nil, a.temporalPluginPollingError(ctx, err, request.Periodic)
@@ -0,0 +1,51 @@
+package activities
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+	"go.temporal.io/sdk/workflow"
+)
+
+type FetchNextDisputesRequest struct {
+	ConnectorID models.ConnectorID
+	Req         models.FetchNextDisputesRequest
+	Periodic    bool
+}
+
+func (a Activities) PluginFetchNextDisputes(ctx context.Context, request FetchNextDisputesRequest) (*models.FetchNextDisputesResponse, error) {
+	plugin, err := a.connectors.Get(request.ConnectorID)
+	if err != nil {
+		return nil, a.temporalPluginError(ctx, err)
+	}
+
+	if err := a.takeRateBudget(ctx, request.ConnectorID); err != nil {
+		return nil, err
+	}
+
+	resp, err := plugin.FetchNextDisputes(ctx, request.Req)
+	if err != nil {
+		return nil, a.temporalPluginPollingError(ctx, err, request.Periodic)
+	}
+	return &resp, nil
+}
+
+var PluginFetchNextDisputesActivity = Activities{}.PluginFetchNextDisputes
+
+func PluginFetchNextDisputes(ctx workflow.Context, connectorID models.ConnectorID, fromPayload, state json.RawMessage, pageSize int, periodic bool) (*models.FetchNextDisputesResponse, error) {
+	ret := models.FetchNextDisputesResponse{}
+	if err := executeActivity(ctx, PluginFetchNextDisputesActivity, &ret, FetchNextDisputesRequest{
+		ConnectorID: connectorID,
+		Req: models.FetchNextDisputesRequest{
+			FromPayload: fromPayload,
+			State:       state,
+			PageSize:    pageSize,
+		},
+		Periodic: periodic,
+	},
+	); err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
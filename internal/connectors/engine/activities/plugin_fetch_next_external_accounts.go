@@ -20,6 +20,10 @@ func (a Activities) PluginFetchNextExternalAccounts(ctx context.Context, request
 		return nil, a.temporalPluginError(ctx, err)
 	}
 
+	if err := a.takeRateBudget(ctx, request.ConnectorID); err != nil {
+		return nil, err
+	}
+
 	resp, err := plugin.FetchNextExternalAccounts(ctx, request.Req)
 	if err != nil {
 		return nil, a.temporalPluginPollingError(ctx, err, request.Periodic)
@@ -30,7 +34,7 @@ func (a Activities) PluginFetchNextExternalAccounts(ctx context.Context, request
 
 var PluginFetchNextExternalAccountsActivity = Activities{}.PluginFetchNextExternalAccounts
 
-func PluginFetchNextExternalAccounts(ctx workflow.Context, connectorID models.ConnectorID, fromPayload, state json.RawMessage, pageSize int, periodic bool) (*models.FetchNextExternalAccountsResponse, error) {
+func PluginFetchNextExternalAccounts(ctx workflow.Context, connectorID models.ConnectorID, fromPayload, state json.RawMessage, pageSize int, window *models.FetchWindow, periodic bool) (*models.FetchNextExternalAccountsResponse, error) {
 	ret := models.FetchNextExternalAccountsResponse{}
 	if err := executeActivity(ctx, PluginFetchNextExternalAccountsActivity, &ret, FetchNextExternalAccountsRequest{
 		ConnectorID: connectorID,
@@ -38,6 +42,7 @@ func PluginFetchNextExternalAccounts(ctx workflow.Context, connectorID models.Co
 			FromPayload: fromPayload,
 			State:       state,
 			PageSize:    pageSize,
+			Window:      window,
 		},
 		Periodic: periodic,
 	}); err != nil {
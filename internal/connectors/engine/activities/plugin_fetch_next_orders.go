@@ -20,6 +20,10 @@ func (a Activities) PluginFetchNextOrders(ctx context.Context, request FetchNext
 		return nil, a.temporalPluginError(ctx, err)
 	}
 
+	if err := a.takeRateBudget(ctx, request.ConnectorID); err != nil {
+		return nil, err
+	}
+
 	resp, err := plugin.FetchNextOrders(ctx, request.Req)
 	if err != nil {
 		return nil, a.temporalPluginPollingError(ctx, err, request.Periodic)
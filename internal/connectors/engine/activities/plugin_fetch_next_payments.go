@@ -20,6 +20,10 @@ func (a Activities) PluginFetchNextPayments(ctx context.Context, request FetchNe
 		return nil, a.temporalPluginError(ctx, err)
 	}
 
+	if err := a.takeRateBudget(ctx, request.ConnectorID); err != nil {
+		return nil, err
+	}
+
 	resp, err := plugin.FetchNextPayments(ctx, request.Req)
 	if err != nil {
 		return nil, a.temporalPluginPollingError(ctx, err, request.Periodic)
@@ -30,7 +34,7 @@ func (a Activities) PluginFetchNextPayments(ctx context.Context, request FetchNe
 
 var PluginFetchNextPaymentsActivity = Activities{}.PluginFetchNextPayments
 
-func PluginFetchNextPayments(ctx workflow.Context, connectorID models.ConnectorID, fromPayload, state json.RawMessage, pageSize int, periodic bool) (*models.FetchNextPaymentsResponse, error) {
+func PluginFetchNextPayments(ctx workflow.Context, connectorID models.ConnectorID, fromPayload, state json.RawMessage, pageSize int, window *models.FetchWindow, periodic bool) (*models.FetchNextPaymentsResponse, error) {
 	ret := models.FetchNextPaymentsResponse{}
 	if err := executeActivity(ctx, PluginFetchNextPaymentsActivity, &ret, FetchNextPaymentsRequest{
 		ConnectorID: connectorID,
@@ -38,6 +42,7 @@ func PluginFetchNextPayments(ctx workflow.Context, connectorID models.ConnectorI
 			FromPayload: fromPayload,
 			State:       state,
 			PageSize:    pageSize,
+			Window:      window,
 		},
 		Periodic: periodic,
 	}); err != nil {
@@ -0,0 +1,43 @@
+package activities
+
+import (
+	"context"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+)
+
+// openBankingConnectionFromPI returns the payment service user and the open
+// banking connection related to the payment initiation accounts, if any. The
+// source account takes precedence over the destination account.
+//
+// They are resolved inside the plugin activities rather than in the workflows
+// so that the connection access tokens never end up in the workflow history.
+func (a Activities) openBankingConnectionFromPI(
+	ctx context.Context,
+	connectorID models.ConnectorID,
+	pi models.PSPPaymentInitiation,
+) (*models.PSPPaymentServiceUser, *models.OpenBankingConnection, error) {
+	for _, account := range []*models.PSPAccount{pi.SourceAccount, pi.DestinationAccount} {
+		if account == nil || account.PsuID == nil || account.OpenBankingConnectionID == nil {
+			continue
+		}
+
+		psu, err := a.storage.PaymentServiceUsersGet(ctx, *account.PsuID)
+		if err != nil {
+			return nil, nil, temporalStorageError(err)
+		}
+
+		connection, _, err := a.storage.OpenBankingConnectionsGetFromConnectionID(
+			ctx,
+			connectorID,
+			*account.OpenBankingConnectionID,
+		)
+		if err != nil {
+			return nil, nil, temporalStorageError(err)
+		}
+
+		return models.ToPSPPaymentServiceUser(psu), connection, nil
+	}
+
+	return nil, nil, nil
+}
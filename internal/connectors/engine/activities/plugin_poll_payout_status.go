@@ -18,6 +18,10 @@ func (a Activities) PluginPollPayoutStatus(ctx context.Context, request PollPayo
 		return nil, a.temporalPluginError(ctx, err)
 	}
 
+	if err := a.takeRateBudget(ctx, request.ConnectorID); err != nil {
+		return nil, err
+	}
+
 	resp, err := plugin.PollPayoutStatus(ctx, request.Req)
 	if err != nil {
 		return nil, a.temporalPluginError(ctx, err)
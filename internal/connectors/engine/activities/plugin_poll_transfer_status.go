@@ -18,6 +18,10 @@ func (a Activities) PluginPollTransferStatus(ctx context.Context, request PollTr
 		return nil, a.temporalPluginError(ctx, err)
 	}
 
+	if err := a.takeRateBudget(ctx, request.ConnectorID); err != nil {
+		return nil, err
+	}
+
 	resp, err := plugin.PollTransferStatus(ctx, request.Req)
 	if err != nil {
 		return nil, a.temporalPluginError(ctx, err)
@@ -18,6 +18,10 @@ func (a Activities) PluginReversePayout(ctx context.Context, request ReversePayo
 		return nil, a.temporalPluginError(ctx, err)
 	}
 
+	if err := a.takeRateBudget(ctx, request.ConnectorID); err != nil {
+		return nil, err
+	}
+
 	resp, err := plugin.ReversePayout(ctx, request.Req)
 	if err != nil {
 		return nil, a.temporalPluginError(ctx, err)
@@ -18,6 +18,10 @@ func (a Activities) PluginReverseTransfer(ctx context.Context, request ReverseTr
 		return nil, a.temporalPluginError(ctx, err)
 	}
 
+	if err := a.takeRateBudget(ctx, request.ConnectorID); err != nil {
+		return nil, err
+	}
+
 	resp, err := plugin.ReverseTransfer(ctx, request.Req)
 	if err != nil {
 		return nil, a.temporalPluginError(ctx, err)
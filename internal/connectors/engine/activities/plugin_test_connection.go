@@ -0,0 +1,64 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/formancehq/payments/internal/connectors/plugins"
+	"github.com/formancehq/payments/internal/connectors/plugins/registry"
+	"github.com/formancehq/payments/internal/storage"
+	errorsutils "github.com/formancehq/payments/pkg/domain/errors"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// PluginTestConnection checks a connector config against the PSP with a
+// plugin instance built for this call only, leaving the loaded one untouched.
+func (a Activities) PluginTestConnection(ctx context.Context, connector models.Connector) error {
+	decryptedConfig, err := a.storage.DecryptRaw(ctx, connector.Config)
+	switch {
+	case err == nil:
+		connector.Config = decryptedConfig
+	case errors.Is(err, storage.ErrNotEncrypted):
+		// Payload is already plain JSON; leave as-is
+	default:
+		return temporalStorageError(err)
+	}
+
+	plugin, err := registry.GetPlugin(connector.ID, a.logger, connector.Provider, connector.Name, connector.Config)
+	if err != nil {
+		return temporal.NewNonRetryableApplicationError(err.Error(), ErrTypeInvalidArgument, err)
+	}
+
+	if err := a.takeRateBudget(ctx, connector.ID); err != nil {
+		return err
+	}
+
+	_, err = plugin.TestConnection(ctx, models.TestConnectionRequest{})
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, plugins.ErrNotImplemented):
+		// Neither a dedicated check nor accounts fetching without a payment
+		// service user: the credentials cannot be verified, so they must not
+		// replace the current ones.
+		err = fmt.Errorf("connection cannot be tested for provider %s: %w", connector.Provider, err)
+		return temporal.NewNonRetryableApplicationError(err.Error(), ErrTypeInvalidArgument, err)
+	case errors.Is(err, plugins.ErrUpstreamRatelimit),
+		errors.Is(err, plugins.ErrUpstreamTimeout),
+		errors.Is(err, plugins.ErrUpstreamRetryAfter):
+		return a.temporalPluginError(ctx, err)
+	default:
+		// Retrying will not make the PSP accept a config it just rejected,
+		// only transient upstream errors are worth another attempt.
+		return temporal.NewNonRetryableApplicationError(err.Error(), ErrTypeInvalidArgument, errorsutils.Cause(err))
+	}
+}
+
+var PluginTestConnectionActivity = Activities{}.PluginTestConnection
+
+func PluginTestConnection(ctx workflow.Context, connector models.Connector) error {
+	return executeActivity(ctx, PluginTestConnectionActivity, nil, connector)
+}
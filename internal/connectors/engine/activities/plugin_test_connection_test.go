@@ -0,0 +1,155 @@
+package activities_test
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/observe/log"
+	"github.com/formancehq/payments/internal/connectors"
+	"github.com/formancehq/payments/internal/connectors/engine/activities"
+	"github.com/formancehq/payments/internal/connectors/plugins"
+	"github.com/formancehq/payments/internal/connectors/plugins/registry"
+	"github.com/formancehq/payments/internal/events"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.temporal.io/sdk/temporal"
+	gomock "go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Plugin Test Connection", func() {
+	var (
+		act       activities.Activities
+		p         *connectors.MockManager
+		s         *storage.MockStorage
+		plugin    *models.MockPlugin
+		logger    = logging.NewDefaultLogger(GinkgoWriter, true, false, false)
+		connector models.Connector
+		decrypted = json.RawMessage(`{"name":"test","apiKey":"new"}`)
+		sampleErr = errors.New("some string")
+	)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		p = connectors.NewMockManager(ctrl)
+		s = storage.NewMockStorage(ctrl)
+		plugin = models.NewMockPlugin(ctrl)
+		act = activities.New(logger, nil, s, &events.Events{}, p, time.Millisecond, 0)
+
+		provider := "test-connection-" + uuid.NewString()
+		registry.RegisterPlugin(provider, models.PluginTypePSP, func(_ models.ConnectorID, _ string, _ logging.Logger, rawConfig json.RawMessage) (models.Plugin, error) {
+			Expect(string(rawConfig)).To(Equal(string(decrypted)))
+			return plugin, nil
+		}, []models.Capability{models.CAPABILITY_FETCH_ACCOUNTS}, struct{}{}, 25)
+
+		connectorID := models.ConnectorID{Provider: provider, Reference: uuid.New()}
+		connector = models.Connector{
+			ConnectorBase: models.ConnectorBase{
+				ID:       connectorID,
+				Name:     "test",
+				Provider: provider,
+			},
+			Config: json.RawMessage(`"encrypted"`),
+		}
+
+		plugin.EXPECT().Name().Return("test").AnyTimes()
+	})
+
+	It("builds a plugin from the decrypted config and tests the connection", func(ctx SpecContext) {
+		s.EXPECT().DecryptRaw(gomock.Any(), connector.Config).Return(decrypted, nil)
+		plugin.EXPECT().TestConnection(gomock.Any(), models.TestConnectionRequest{}).Return(models.TestConnectionResponse{}, nil)
+
+		err := act.PluginTestConnection(ctx, connector)
+		Expect(err).To(BeNil())
+	})
+
+	It("falls back to fetching a single account when the plugin has no dedicated check", func(ctx SpecContext) {
+		s.EXPECT().DecryptRaw(gomock.Any(), connector.Config).Return(decrypted, nil)
+		plugin.EXPECT().TestConnection(gomock.Any(), models.TestConnectionRequest{}).Return(models.TestConnectionResponse{}, plugins.ErrNotImplemented)
+		plugin.EXPECT().FetchNextAccounts(gomock.Any(), models.FetchNextAccountsRequest{PageSize: 1}).Return(models.FetchNextAccountsResponse{}, nil)
+
+		err := act.PluginTestConnection(ctx, connector)
+		Expect(err).To(BeNil())
+	})
+
+	It("returns a non retryable error when the plugin cannot be probed at all", func(ctx SpecContext) {
+		s.EXPECT().DecryptRaw(gomock.Any(), connector.Config).Return(decrypted, nil)
+		plugin.EXPECT().TestConnection(gomock.Any(), models.TestConnectionRequest{}).Return(models.TestConnectionResponse{}, plugins.ErrNotImplemented)
+		plugin.EXPECT().FetchNextAccounts(gomock.Any(), models.FetchNextAccountsRequest{PageSize: 1}).Return(models.FetchNextAccountsResponse{}, plugins.ErrNotImplemented)
+
+		err := act.PluginTestConnection(ctx, connector)
+		Expect(err).ToNot(BeNil())
+		var temporalErr *temporal.ApplicationError
+		Expect(errors.As(err, &temporalErr)).To(BeTrue())
+		Expect(temporalErr.NonRetryable()).To(BeTrue())
+		Expect(temporalErr.Type()).To(Equal(activities.ErrTypeInvalidArgument))
+	})
+
+	It("returns a non retryable error for open banking plugins without a dedicated check", func(ctx SpecContext) {
+		provider := "test-connection-open-banking-" + uuid.NewString()
+		registry.RegisterPlugin(provider, models.PluginTypeOpenBanking, func(_ models.ConnectorID, _ string, _ logging.Logger, _ json.RawMessage) (models.Plugin, error) {
+			return plugin, nil
+		}, []models.Capability{models.CAPABILITY_FETCH_ACCOUNTS}, struct{}{}, 25)
+		connector.ID.Provider = provider
+		connector.Provider = provider
+
+		s.EXPECT().DecryptRaw(gomock.Any(), connector.Config).Return(decrypted, nil)
+		plugin.EXPECT().TestConnection(gomock.Any(), models.TestConnectionRequest{}).Return(models.TestConnectionResponse{}, plugins.ErrNotImplemented)
+
+		err := act.PluginTestConnection(ctx, connector)
+		Expect(err).ToNot(BeNil())
+		var temporalErr *temporal.ApplicationError
+		Expect(errors.As(err, &temporalErr)).To(BeTrue())
+		Expect(temporalErr.NonRetryable()).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("connection cannot be tested"))
+	})
+
+	It("returns a non retryable error when the credentials are rejected", func(ctx SpecContext) {
+		s.EXPECT().DecryptRaw(gomock.Any(), connector.Config).Return(decrypted, nil)
+		plugin.EXPECT().TestConnection(gomock.Any(), models.TestConnectionRequest{}).Return(models.TestConnectionResponse{}, plugins.ErrInvalidClientRequest)
+
+		err := act.PluginTestConnection(ctx, connector)
+		Expect(err).ToNot(BeNil())
+		var temporalErr *temporal.ApplicationError
+		Expect(errors.As(err, &temporalErr)).To(BeTrue())
+		Expect(temporalErr.NonRetryable()).To(BeTrue())
+		Expect(temporalErr.Type()).To(Equal(activities.ErrTypeInvalidArgument))
+	})
+
+	It("returns a non retryable error when the PSP fails", func(ctx SpecContext) {
+		s.EXPECT().DecryptRaw(gomock.Any(), connector.Config).Return(decrypted, nil)
+		plugin.EXPECT().TestConnection(gomock.Any(), models.TestConnectionRequest{}).Return(models.TestConnectionResponse{}, sampleErr)
+
+		err := act.PluginTestConnection(ctx, connector)
+		Expect(err).ToNot(BeNil())
+		var temporalErr *temporal.ApplicationError
+		Expect(errors.As(err, &temporalErr)).To(BeTrue())
+		Expect(temporalErr.NonRetryable()).To(BeTrue())
+		Expect(temporalErr.Type()).To(Equal(activities.ErrTypeInvalidArgument))
+	})
+
+	It("returns a retryable error when the PSP times out", func(ctx SpecContext) {
+		s.EXPECT().DecryptRaw(gomock.Any(), connector.Config).Return(decrypted, nil)
+		plugin.EXPECT().TestConnection(gomock.Any(), models.TestConnectionRequest{}).Return(models.TestConnectionResponse{}, plugins.ErrUpstreamTimeout)
+
+		err := act.PluginTestConnection(ctx, connector)
+		Expect(err).ToNot(BeNil())
+		var temporalErr *temporal.ApplicationError
+		Expect(errors.As(err, &temporalErr)).To(BeTrue())
+		Expect(temporalErr.NonRetryable()).To(BeFalse())
+		Expect(temporalErr.Type()).To(Equal(activities.ErrTypeTimeout))
+	})
+
+	It("returns a storage error when the config cannot be decrypted", func(ctx SpecContext) {
+		s.EXPECT().DecryptRaw(gomock.Any(), connector.Config).Return(nil, sampleErr)
+
+		err := act.PluginTestConnection(ctx, connector)
+		Expect(err).ToNot(BeNil())
+		var temporalErr *temporal.ApplicationError
+		Expect(errors.As(err, &temporalErr)).To(BeTrue())
+		Expect(temporalErr.Type()).To(Equal(activities.ErrTypeStorage))
+	})
+})
@@ -18,6 +18,10 @@ func (a Activities) PluginUpdateUserLink(ctx context.Context, request UpdateUser
 		return nil, a.temporalPluginError(ctx, err)
 	}
 
+	if err := a.takeRateBudget(ctx, request.ConnectorID); err != nil {
+		return nil, err
+	}
+
 	resp, err := plugin.UpdateUserLink(ctx, request.Req)
 	if err != nil {
 		return nil, a.temporalPluginError(ctx, err)
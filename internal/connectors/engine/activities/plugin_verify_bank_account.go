@@ -0,0 +1,49 @@
+package activities
+
+import (
+	"context"
+
+	"github.com/formancehq/payments/internal/connectors/plugins"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"go.temporal.io/sdk/workflow"
+)
+
+type VerifyBankAccountRequest struct {
+	ConnectorID models.ConnectorID
+	Req         models.VerifyBankAccountRequest
+}
+
+func (a Activities) PluginVerifyBankAccount(ctx context.Context, request VerifyBankAccountRequest) (*models.VerifyBankAccountResponse, error) {
+	plugin, err := a.connectors.Get(request.ConnectorID)
+	if err != nil {
+		return nil, a.temporalPluginError(ctx, err)
+	}
+
+	verifier, ok := plugin.(models.PluginWithBankAccountVerification)
+	if !ok {
+		return nil, a.temporalPluginError(ctx, plugins.ErrNotImplemented)
+	}
+
+	if err := a.takeRateBudget(ctx, request.ConnectorID); err != nil {
+		return nil, err
+	}
+
+	resp, err := verifier.VerifyBankAccount(ctx, request.Req)
+	if err != nil {
+		return nil, a.temporalPluginError(ctx, err)
+	}
+	return &resp, nil
+}
+
+var PluginVerifyBankAccountActivity = Activities{}.PluginVerifyBankAccount
+
+func PluginVerifyBankAccount(ctx workflow.Context, connectorID models.ConnectorID, request models.VerifyBankAccountRequest) (*models.VerifyBankAccountResponse, error) {
+	ret := models.VerifyBankAccountResponse{}
+	if err := executeActivity(ctx, PluginVerifyBankAccountActivity, &ret, VerifyBankAccountRequest{
+		ConnectorID: connectorID,
+		Req:         request,
+	}); err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
@@ -0,0 +1,106 @@
+package activities_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/observe/log"
+	"github.com/formancehq/payments/internal/connectors"
+	"github.com/formancehq/payments/internal/connectors/engine/activities"
+	pluginsError "github.com/formancehq/payments/internal/connectors/plugins"
+	"github.com/formancehq/payments/internal/events"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.temporal.io/sdk/temporal"
+	gomock "go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Plugin Verify Bank Account", func() {
+	var (
+		act            activities.Activities
+		p              *connectors.MockManager
+		s              *storage.MockStorage
+		evts           *events.Events
+		sampleResponse models.VerifyBankAccountResponse
+	)
+
+	BeforeEach(func() {
+		evts = &events.Events{}
+		sampleResponse = models.VerifyBankAccountResponse{
+			Result: models.BANK_ACCOUNT_VERIFICATION_RESULT_MATCH,
+		}
+	})
+
+	Context("plugin verify bank account", func() {
+		var (
+			plugin   *models.MockPlugin
+			verifier *models.MockPluginWithBankAccountVerification
+			req      activities.VerifyBankAccountRequest
+			logger   = logging.NewDefaultLogger(GinkgoWriter, true, false, false)
+			delay    = 50 * time.Millisecond
+		)
+
+		BeforeEach(func() {
+			ctrl := gomock.NewController(GinkgoT())
+			p = connectors.NewMockManager(ctrl)
+			s = storage.NewMockStorage(ctrl)
+			plugin = models.NewMockPlugin(ctrl)
+			verifier = models.NewMockPluginWithBankAccountVerification(ctrl)
+			act = activities.New(logger, nil, s, evts, p, delay, 0)
+			req = activities.VerifyBankAccountRequest{
+				ConnectorID: models.ConnectorID{
+					Provider: "some_provider",
+				},
+			}
+		})
+
+		withVerification := func() models.Plugin {
+			return struct {
+				*models.MockPlugin
+				*models.MockPluginWithBankAccountVerification
+			}{plugin, verifier}
+		}
+
+		It("calls underlying plugin", func(ctx SpecContext) {
+			p.EXPECT().Get(req.ConnectorID).Return(withVerification(), nil)
+			verifier.EXPECT().VerifyBankAccount(ctx, req.Req).Return(sampleResponse, nil)
+			res, err := act.PluginVerifyBankAccount(ctx, req)
+			Expect(err).To(BeNil())
+			Expect(res.Result).To(Equal(sampleResponse.Result))
+		})
+
+		It("returns a non-retryable error when the plugin does not support verification", func(ctx SpecContext) {
+			p.EXPECT().Get(req.ConnectorID).Return(plugin, nil)
+			_, err := act.PluginVerifyBankAccount(ctx, req)
+			Expect(err).ToNot(BeNil())
+			temporalErr, ok := err.(*temporal.ApplicationError)
+			Expect(ok).To(BeTrue())
+			Expect(temporalErr.NonRetryable()).To(BeTrue())
+			Expect(temporalErr.Type()).To(Equal(activities.ErrTypeUnimplemented))
+		})
+
+		It("returns a retryable temporal error", func(ctx SpecContext) {
+			p.EXPECT().Get(req.ConnectorID).Return(withVerification(), nil)
+			verifier.EXPECT().VerifyBankAccount(ctx, req.Req).Return(sampleResponse, fmt.Errorf("some string"))
+			_, err := act.PluginVerifyBankAccount(ctx, req)
+			Expect(err).ToNot(BeNil())
+			temporalErr, ok := err.(*temporal.ApplicationError)
+			Expect(ok).To(BeTrue())
+			Expect(temporalErr.NonRetryable()).To(BeFalse())
+			Expect(temporalErr.Type()).To(Equal(activities.ErrTypeDefault))
+		})
+
+		It("returns a non-retryable temporal error on invalid requests", func(ctx SpecContext) {
+			p.EXPECT().Get(req.ConnectorID).Return(withVerification(), nil)
+			verifier.EXPECT().VerifyBankAccount(ctx, req.Req).Return(sampleResponse, fmt.Errorf("invalid: %w", pluginsError.ErrInvalidClientRequest))
+			_, err := act.PluginVerifyBankAccount(ctx, req)
+			Expect(err).ToNot(BeNil())
+			temporalErr, ok := err.(*temporal.ApplicationError)
+			Expect(ok).To(BeTrue())
+			Expect(temporalErr.NonRetryable()).To(BeTrue())
+			Expect(temporalErr.Type()).To(Equal(activities.ErrTypeInvalidArgument))
+		})
+	})
+})
@@ -0,0 +1,63 @@
+package activities
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/formancehq/payments/internal/connectors/plugins/registry"
+	"github.com/formancehq/payments/pkg/domain/metrics"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.temporal.io/sdk/temporal"
+)
+
+// takeRateBudget takes one call out of the connector's rate budget before the
+// activity calls its plugin. It is a per-activity budget: the PSP requests the
+// plugin sends during the call are not counted individually. The budget lives
+// in storage so it is shared by every workflow and every worker of the
+// connector: once exhausted, the activity is retried by temporal when the
+// budget has refilled instead of reaching the PSP and being rate limited there.
+//
+// Install, uninstall and incoming webhooks are not subject to the budget.
+func (a Activities) takeRateBudget(ctx context.Context, connectorID models.ConnectorID) error {
+	budget, err := registry.GetRateBudget(connectorID.Provider)
+	if err != nil || budget == nil {
+		// Unknown providers have no budget, getting their plugin already failed
+		return nil
+	}
+
+	usage, wait, err := a.storage.ConnectorRateBudgetsTake(ctx, connectorID, *budget, time.Now())
+	if err != nil {
+		return temporalStorageError(err)
+	}
+
+	outcome := "granted"
+	if wait > 0 {
+		outcome = "throttled"
+	}
+
+	attrs := []attribute.KeyValue{
+		attribute.String("connector_id", connectorID.String()),
+		attribute.String("provider", connectorID.Provider),
+	}
+	metricsRegistry := metrics.GetMetricsRegistry()
+	metricsRegistry.ConnectorRateBudgetTokens().Record(ctx, usage.Tokens, metric.WithAttributes(attrs...))
+	metricsRegistry.ConnectorRateBudgetDailyUsage().Record(ctx, int64(usage.DailyCount), metric.WithAttributes(attrs...))
+	metricsRegistry.ConnectorRateBudgetCalls().Add(ctx, 1, metric.WithAttributes(
+		append(attrs, attribute.String("outcome", outcome))...,
+	))
+
+	if wait > 0 {
+		return temporal.NewApplicationErrorWithOptions(
+			fmt.Sprintf("rate budget of connector %s exhausted", connectorID.String()),
+			ErrTypeRateLimited,
+			temporal.ApplicationErrorOptions{
+				NextRetryDelay: wait,
+			},
+		)
+	}
+
+	return nil
+}
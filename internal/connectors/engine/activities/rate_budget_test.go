@@ -0,0 +1,87 @@
+package activities_test
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/observe/log"
+	"github.com/formancehq/payments/internal/connectors"
+	"github.com/formancehq/payments/internal/connectors/engine/activities"
+	"github.com/formancehq/payments/internal/connectors/plugins/registry"
+	"github.com/formancehq/payments/internal/events"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.temporal.io/sdk/temporal"
+	gomock "go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Plugin Rate Budget", func() {
+	var (
+		act    activities.Activities
+		p      *connectors.MockManager
+		s      *storage.MockStorage
+		plugin *models.MockPlugin
+		logger = logging.NewDefaultLogger(GinkgoWriter, true, false, false)
+		req    activities.FetchNextPaymentsRequest
+		budget = models.RateBudget{CallsPerSecond: 2, Burst: 4}
+	)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		p = connectors.NewMockManager(ctrl)
+		s = storage.NewMockStorage(ctrl)
+		plugin = models.NewMockPlugin(ctrl)
+		act = activities.New(logger, nil, s, &events.Events{}, p, 50*time.Millisecond, 0)
+
+		provider := "rate-budget-" + uuid.NewString()
+		registry.RegisterPlugin(provider, models.PluginTypePSP, func(models.ConnectorID, string, logging.Logger, json.RawMessage) (models.Plugin, error) {
+			return plugin, nil
+		}, []models.Capability{models.CAPABILITY_FETCH_PAYMENTS}, struct{}{}, 25)
+		registry.RegisterRateBudget(provider, &budget)
+
+		req = activities.FetchNextPaymentsRequest{
+			ConnectorID: models.ConnectorID{Provider: provider, Reference: uuid.New()},
+		}
+	})
+
+	It("calls the plugin when the budget allows it", func(ctx SpecContext) {
+		p.EXPECT().Get(req.ConnectorID).Return(plugin, nil)
+		s.EXPECT().ConnectorRateBudgetsTake(gomock.Any(), req.ConnectorID, budget, gomock.Any()).
+			Return(models.RateBudgetUsage{Tokens: 3, DailyCount: 1}, time.Duration(0), nil)
+		plugin.EXPECT().FetchNextPayments(ctx, req.Req).Return(models.FetchNextPaymentsResponse{HasMore: true}, nil)
+
+		res, err := act.PluginFetchNextPayments(ctx, req)
+		Expect(err).To(BeNil())
+		Expect(res.HasMore).To(BeTrue())
+	})
+
+	It("returns a rate limited error retried once the budget refilled", func(ctx SpecContext) {
+		p.EXPECT().Get(req.ConnectorID).Return(plugin, nil)
+		s.EXPECT().ConnectorRateBudgetsTake(gomock.Any(), req.ConnectorID, budget, gomock.Any()).
+			Return(models.RateBudgetUsage{Tokens: 0.5}, 250*time.Millisecond, nil)
+
+		_, err := act.PluginFetchNextPayments(ctx, req)
+		Expect(err).ToNot(BeNil())
+		var temporalErr *temporal.ApplicationError
+		Expect(errors.As(err, &temporalErr)).To(BeTrue())
+		Expect(temporalErr.NonRetryable()).To(BeFalse())
+		Expect(temporalErr.Type()).To(Equal(activities.ErrTypeRateLimited))
+		Expect(temporalErr.NextRetryDelay()).To(Equal(250 * time.Millisecond))
+	})
+
+	It("returns a storage error when the budget cannot be read", func(ctx SpecContext) {
+		p.EXPECT().Get(req.ConnectorID).Return(plugin, nil)
+		s.EXPECT().ConnectorRateBudgetsTake(gomock.Any(), req.ConnectorID, budget, gomock.Any()).
+			Return(models.RateBudgetUsage{}, time.Duration(0), errors.New("boom"))
+
+		_, err := act.PluginFetchNextPayments(ctx, req)
+		Expect(err).ToNot(BeNil())
+		var temporalErr *temporal.ApplicationError
+		Expect(errors.As(err, &temporalErr)).To(BeTrue())
+		Expect(temporalErr.Type()).To(Equal(activities.ErrTypeStorage))
+	})
+})
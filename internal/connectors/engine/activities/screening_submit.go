@@ -0,0 +1,53 @@
+package activities
+
+import (
+	"context"
+	"errors"
+
+	"github.com/formancehq/payments/internal/connectors/engine/screening"
+	errorsutils "github.com/formancehq/payments/pkg/domain/errors"
+	"github.com/formancehq/payments/pkg/domain/httpwrapper"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+func (a Activities) ScreeningSubmit(ctx context.Context, req models.ScreeningRequest) (*models.ScreeningResult, error) {
+	if a.screening == nil {
+		return nil, temporal.NewNonRetryableApplicationError("screening service is not configured", ErrTypeInvalidArgument, nil)
+	}
+
+	res, err := a.screening.Screen(ctx, req)
+	if err != nil {
+		return nil, temporalScreeningError(err)
+	}
+
+	return &res, nil
+}
+
+func temporalScreeningError(err error) error {
+	cause := errorsutils.Cause(err)
+
+	switch {
+	case errors.Is(err, screening.ErrMissingDecision):
+		// The screening service answered without a decision, it will not
+		// answer differently to the same request.
+		return temporal.NewNonRetryableApplicationError(err.Error(), ErrTypeInvalidArgument, cause)
+	case errors.Is(err, httpwrapper.ErrStatusCodeTooManyRequests):
+		return temporal.NewApplicationErrorWithCause(err.Error(), ErrTypeRateLimited, cause)
+	case errors.Is(err, httpwrapper.ErrStatusCodeClientError):
+		// The screening service rejected the request itself, sending it again
+		// will not help.
+		return temporal.NewNonRetryableApplicationError(err.Error(), ErrTypeInvalidArgument, cause)
+	default:
+		return temporal.NewApplicationErrorWithCause(err.Error(), ErrTypeDefault, cause)
+	}
+}
+
+var ScreeningSubmitActivity = Activities{}.ScreeningSubmit
+
+func ScreeningSubmit(ctx workflow.Context, req models.ScreeningRequest) (*models.ScreeningResult, error) {
+	var result models.ScreeningResult
+	err := executeActivity(ctx, ScreeningSubmitActivity, &result, req)
+	return &result, err
+}
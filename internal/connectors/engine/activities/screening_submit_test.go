@@ -0,0 +1,76 @@
+package activities_test
+
+import (
+	"fmt"
+
+	"github.com/formancehq/go-libs/v5/pkg/observe/log"
+	"github.com/formancehq/payments/internal/connectors/engine/activities"
+	"github.com/formancehq/payments/internal/connectors/engine/screening"
+	"github.com/formancehq/payments/internal/events"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/httpwrapper"
+	"github.com/formancehq/payments/pkg/domain/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.temporal.io/sdk/temporal"
+	gomock "go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Screening Submit", func() {
+	var (
+		act    activities.Activities
+		client *screening.MockClient
+		req    models.ScreeningRequest
+		logger = logging.NewDefaultLogger(GinkgoWriter, true, false, false)
+	)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		client = screening.NewMockClient(ctrl)
+		act = activities.New(logger, nil, storage.NewMockStorage(ctrl), &events.Events{}, nil, 0, 0).
+			WithScreeningClient(client)
+		req = models.ScreeningRequest{Reference: "pi1"}
+	})
+
+	It("returns the screening decision", func(ctx SpecContext) {
+		client.EXPECT().Screen(ctx, req).Return(models.ScreeningResult{Decision: models.SCREENING_DECISION_PENDING}, nil)
+		res, err := act.ScreeningSubmit(ctx, req)
+		Expect(err).To(BeNil())
+		Expect(res.Decision).To(Equal(models.SCREENING_DECISION_PENDING))
+	})
+
+	It("returns a retryable temporal error", func(ctx SpecContext) {
+		client.EXPECT().Screen(ctx, req).Return(models.ScreeningResult{}, fmt.Errorf("screening: %w", httpwrapper.ErrStatusCodeServerError))
+		_, err := act.ScreeningSubmit(ctx, req)
+		temporalErr, ok := err.(*temporal.ApplicationError)
+		Expect(ok).To(BeTrue())
+		Expect(temporalErr.NonRetryable()).To(BeFalse())
+		Expect(temporalErr.Type()).To(Equal(activities.ErrTypeDefault))
+	})
+
+	It("returns a non-retryable temporal error on client errors", func(ctx SpecContext) {
+		client.EXPECT().Screen(ctx, req).Return(models.ScreeningResult{}, fmt.Errorf("screening: %w", httpwrapper.ErrStatusCodeClientError))
+		_, err := act.ScreeningSubmit(ctx, req)
+		temporalErr, ok := err.(*temporal.ApplicationError)
+		Expect(ok).To(BeTrue())
+		Expect(temporalErr.NonRetryable()).To(BeTrue())
+		Expect(temporalErr.Type()).To(Equal(activities.ErrTypeInvalidArgument))
+	})
+
+	It("returns a non-retryable temporal error when the decision is missing", func(ctx SpecContext) {
+		client.EXPECT().Screen(ctx, req).Return(models.ScreeningResult{}, screening.ErrMissingDecision)
+		_, err := act.ScreeningSubmit(ctx, req)
+		temporalErr, ok := err.(*temporal.ApplicationError)
+		Expect(ok).To(BeTrue())
+		Expect(temporalErr.NonRetryable()).To(BeTrue())
+		Expect(temporalErr.Type()).To(Equal(activities.ErrTypeInvalidArgument))
+	})
+
+	It("fails when the screening service is not configured", func(ctx SpecContext) {
+		act = activities.New(logger, nil, nil, &events.Events{}, nil, 0, 0)
+		_, err := act.ScreeningSubmit(ctx, req)
+		temporalErr, ok := err.(*temporal.ApplicationError)
+		Expect(ok).To(BeTrue())
+		Expect(temporalErr.NonRetryable()).To(BeTrue())
+	})
+})
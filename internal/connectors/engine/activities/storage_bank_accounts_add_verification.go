@@ -0,0 +1,18 @@
+package activities
+
+import (
+	"context"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+	"go.temporal.io/sdk/workflow"
+)
+
+func (a Activities) StorageBankAccountsAddVerification(ctx context.Context, verification models.BankAccountVerification) error {
+	return temporalStorageError(a.storage.BankAccountsAddVerification(ctx, verification))
+}
+
+var StorageBankAccountsAddVerificationActivity = Activities{}.StorageBankAccountsAddVerification
+
+func StorageBankAccountsAddVerification(ctx workflow.Context, verification models.BankAccountVerification) error {
+	return executeActivity(ctx, StorageBankAccountsAddVerificationActivity, nil, verification)
+}
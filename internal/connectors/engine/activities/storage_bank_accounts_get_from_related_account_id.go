@@ -0,0 +1,24 @@
+package activities
+
+import (
+	"context"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+	"go.temporal.io/sdk/workflow"
+)
+
+func (a Activities) StorageBankAccountsGetFromRelatedAccountID(ctx context.Context, accountID models.AccountID) (*models.BankAccount, error) {
+	ba, err := a.storage.BankAccountsGetFromRelatedAccountID(ctx, accountID)
+	if err != nil {
+		return nil, temporalStorageError(err)
+	}
+	return ba, nil
+}
+
+var StorageBankAccountsGetFromRelatedAccountIDActivity = Activities{}.StorageBankAccountsGetFromRelatedAccountID
+
+func StorageBankAccountsGetFromRelatedAccountID(ctx workflow.Context, accountID models.AccountID) (*models.BankAccount, error) {
+	var result models.BankAccount
+	err := executeActivity(ctx, StorageBankAccountsGetFromRelatedAccountIDActivity, &result, accountID)
+	return &result, err
+}
@@ -0,0 +1,19 @@
+package activities
+
+import (
+	"context"
+	"time"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+	"go.temporal.io/sdk/workflow"
+)
+
+func (a Activities) StorageConnectorHealthRefresh(ctx context.Context, connectorID models.ConnectorID) error {
+	return temporalStorageError(a.storage.ConnectorHealthRefresh(ctx, connectorID, time.Now().UTC()))
+}
+
+var StorageConnectorHealthRefreshActivity = Activities{}.StorageConnectorHealthRefresh
+
+func StorageConnectorHealthRefresh(ctx workflow.Context, connectorID models.ConnectorID) error {
+	return executeActivity(ctx, StorageConnectorHealthRefreshActivity, nil, connectorID)
+}
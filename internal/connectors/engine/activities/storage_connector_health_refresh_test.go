@@ -0,0 +1,60 @@
+package activities_test
+
+import (
+	"errors"
+
+	"github.com/formancehq/go-libs/v5/pkg/observe/log"
+	"github.com/formancehq/payments/internal/connectors"
+	"github.com/formancehq/payments/internal/connectors/engine/activities"
+	"github.com/formancehq/payments/internal/events"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gomock "go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Activity StorageConnectorHealthRefresh", func() {
+	var (
+		act       activities.Activities
+		p         *connectors.MockManager
+		s         *storage.MockStorage
+		evts      *events.Events
+		publisher *TestPublisher
+		logger    = logging.NewDefaultLogger(GinkgoWriter, true, false, false)
+
+		connectorID models.ConnectorID
+	)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		p = connectors.NewMockManager(ctrl)
+		s = storage.NewMockStorage(ctrl)
+		publisher = newTestPublisher()
+		evts = events.New(publisher, "")
+
+		act = activities.New(logger, nil, s, evts, p, 0, 0)
+
+		connectorID = models.ConnectorID{Provider: "test", Reference: uuid.New()}
+	})
+
+	AfterEach(func() {
+		publisher.Close()
+	})
+
+	It("returns error when storage.ConnectorHealthRefresh fails", func(ctx SpecContext) {
+		s.EXPECT().ConnectorHealthRefresh(gomock.Any(), connectorID, gomock.Any()).Return(errors.New("boom"))
+
+		err := act.StorageConnectorHealthRefresh(ctx, connectorID)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("boom"))
+	})
+
+	It("refreshes the connector health", func(ctx SpecContext) {
+		s.EXPECT().ConnectorHealthRefresh(gomock.Any(), connectorID, gomock.Any()).Return(nil)
+
+		err := act.StorageConnectorHealthRefresh(ctx, connectorID)
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
@@ -0,0 +1,26 @@
+package activities
+
+import (
+	"context"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+	"go.temporal.io/sdk/workflow"
+)
+
+func (a Activities) StorageConnectorTasksTreeGet(ctx context.Context, connectorID models.ConnectorID) (*models.ConnectorTasksTree, error) {
+	tree, err := a.storage.ConnectorTasksTreeGet(ctx, connectorID)
+	if err != nil {
+		return nil, temporalStorageError(err)
+	}
+	return tree, nil
+}
+
+var StorageConnectorTasksTreeGetActivity = Activities{}.StorageConnectorTasksTreeGet
+
+func StorageConnectorTasksTreeGet(ctx workflow.Context, connectorID models.ConnectorID) (*models.ConnectorTasksTree, error) {
+	ret := models.ConnectorTasksTree{}
+	if err := executeActivity(ctx, StorageConnectorTasksTreeGetActivity, &ret, connectorID); err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
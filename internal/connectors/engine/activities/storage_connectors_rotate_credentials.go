@@ -0,0 +1,30 @@
+package activities
+
+import (
+	"context"
+	"errors"
+
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"go.temporal.io/sdk/workflow"
+)
+
+func (a Activities) StorageConnectorsRotateCredentials(ctx context.Context, connector models.Connector, rotation models.ConnectorCredentialsRotation) error {
+	decryptedConfig, err := a.storage.DecryptRaw(ctx, connector.Config)
+	switch {
+	case err == nil:
+		connector.Config = decryptedConfig
+	case errors.Is(err, storage.ErrNotEncrypted):
+		// Payload is already plain JSON; leave as-is
+	default:
+		return temporalStorageError(err)
+	}
+
+	return temporalStorageError(a.storage.ConnectorsRotateCredentials(ctx, connector, rotation))
+}
+
+var StorageConnectorsRotateCredentialsActivity = Activities{}.StorageConnectorsRotateCredentials
+
+func StorageConnectorsRotateCredentials(ctx workflow.Context, connector models.Connector, rotation models.ConnectorCredentialsRotation) error {
+	return executeActivity(ctx, StorageConnectorsRotateCredentialsActivity, nil, connector, rotation)
+}
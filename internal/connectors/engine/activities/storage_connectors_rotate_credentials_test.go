@@ -0,0 +1,84 @@
+package activities_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/observe/log"
+	"github.com/formancehq/payments/internal/connectors"
+	"github.com/formancehq/payments/internal/connectors/engine/activities"
+	"github.com/formancehq/payments/internal/events"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gomock "go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Activity StorageConnectorsRotateCredentials", func() {
+	var (
+		act      activities.Activities
+		p        *connectors.MockManager
+		s        *storage.MockStorage
+		logger   = logging.NewDefaultLogger(GinkgoWriter, true, false, false)
+		rotation models.ConnectorCredentialsRotation
+
+		connector models.Connector
+	)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		p = connectors.NewMockManager(ctrl)
+		s = storage.NewMockStorage(ctrl)
+		act = activities.New(logger, nil, s, &events.Events{}, p, 0, 0)
+
+		connectorID := models.ConnectorID{Provider: "test", Reference: uuid.New()}
+		connector = models.Connector{
+			ConnectorBase: models.ConnectorBase{
+				ID:       connectorID,
+				Name:     "name",
+				Provider: "test",
+			},
+			Config: json.RawMessage(`"encrypted"`),
+		}
+		rotation = models.ConnectorCredentialsRotation{
+			ConnectorID: connectorID,
+			Fields:      []string{"apiKey"},
+			RotatedAt:   time.Now().UTC(),
+		}
+	})
+
+	It("returns error when storage.DecryptRaw fails", func(ctx SpecContext) {
+		s.EXPECT().DecryptRaw(gomock.Any(), connector.Config).Return(nil, errors.New("dec-fail"))
+
+		err := act.StorageConnectorsRotateCredentials(ctx, connector, rotation)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("dec-fail"))
+	})
+
+	It("rotates the credentials with the decrypted config", func(ctx SpecContext) {
+		decrypted := json.RawMessage(`{"apiKey":"new"}`)
+
+		s.EXPECT().DecryptRaw(gomock.Any(), connector.Config).Return(decrypted, nil)
+		s.EXPECT().ConnectorsRotateCredentials(gomock.Any(), gomock.Any(), rotation).
+			DoAndReturn(func(_ context.Context, c models.Connector, _ models.ConnectorCredentialsRotation) error {
+				Expect(string(c.Config)).To(Equal(string(decrypted)))
+				return nil
+			})
+
+		err := act.StorageConnectorsRotateCredentials(ctx, connector, rotation)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("returns error when storage.ConnectorsRotateCredentials fails", func(ctx SpecContext) {
+		s.EXPECT().DecryptRaw(gomock.Any(), connector.Config).Return(nil, storage.ErrNotEncrypted)
+		s.EXPECT().ConnectorsRotateCredentials(gomock.Any(), connector, rotation).Return(errors.New("rotate-fail"))
+
+		err := act.StorageConnectorsRotateCredentials(ctx, connector, rotation)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("rotate-fail"))
+	})
+})
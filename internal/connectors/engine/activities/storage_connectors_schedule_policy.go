@@ -0,0 +1,41 @@
+package activities
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// StorageConnectorsGetSchedulePolicy returns the polling period and blackout
+// windows of a connector's capability. Like StorageConnectorsGetPollingPeriod,
+// its result is recorded in workflow history so it MUST never carry the
+// connector config or any secret.
+func (a Activities) StorageConnectorsGetSchedulePolicy(ctx context.Context, connectorID models.ConnectorID, capability models.Capability) (models.SchedulePolicy, error) {
+	connector, err := a.storage.ConnectorsGet(ctx, connectorID)
+	if err != nil {
+		return models.SchedulePolicy{}, temporalStorageError(err)
+	}
+
+	cfg := a.connectors.DefaultConfig()
+	if len(connector.Config) > 0 {
+		if err := json.Unmarshal(connector.Config, &cfg); err != nil {
+			// Corrupt config will not self-heal; fail fast instead of retrying forever.
+			return models.SchedulePolicy{}, temporal.NewNonRetryableApplicationError("invalid connector config", ErrTypeInvalidArgument, err)
+		}
+	}
+
+	return cfg.SchedulePolicy(capability), nil
+}
+
+var StorageConnectorsGetSchedulePolicyActivity = Activities{}.StorageConnectorsGetSchedulePolicy
+
+func StorageConnectorsGetSchedulePolicy(ctx workflow.Context, connectorID models.ConnectorID, capability models.Capability) (models.SchedulePolicy, error) {
+	var policy models.SchedulePolicy
+	if err := executeActivity(ctx, StorageConnectorsGetSchedulePolicyActivity, &policy, connectorID, capability); err != nil {
+		return models.SchedulePolicy{}, err
+	}
+	return policy, nil
+}
@@ -0,0 +1,94 @@
+package activities_test
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/observe/log"
+	"github.com/formancehq/payments/internal/connectors"
+	"github.com/formancehq/payments/internal/connectors/engine/activities"
+	"github.com/formancehq/payments/internal/events"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gomock "go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Activity StorageConnectorsGetSchedulePolicy", func() {
+	var (
+		act       activities.Activities
+		p         *connectors.MockManager
+		s         *storage.MockStorage
+		evts      *events.Events
+		publisher *TestPublisher
+		logger    = logging.NewDefaultLogger(GinkgoWriter, true, false, false)
+
+		connectorID models.ConnectorID
+	)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		p = connectors.NewMockManager(ctrl)
+		s = storage.NewMockStorage(ctrl)
+		publisher = newTestPublisher()
+		evts = events.New(publisher, "")
+
+		act = activities.New(logger, nil, s, evts, p, 0, 0)
+
+		connectorID = models.ConnectorID{Provider: "test", Reference: uuid.New()}
+	})
+
+	AfterEach(func() {
+		publisher.Close()
+	})
+
+	It("returns error when storage.ConnectorsGet fails", func(ctx SpecContext) {
+		s.EXPECT().ConnectorsGet(gomock.Any(), connectorID).Return(nil, errors.New("boom"))
+
+		_, err := act.StorageConnectorsGetSchedulePolicy(ctx, connectorID, models.CAPABILITY_FETCH_ACCOUNTS)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("boom"))
+	})
+
+	It("returns the capability polling period and the blackout windows", func(ctx SpecContext) {
+		connector := &models.Connector{
+			ConnectorBase: models.ConnectorBase{ID: connectorID, Provider: "test"},
+			Config: json.RawMessage(`{
+				"name":"test",
+				"pollingPeriod":"5m",
+				"pollingPeriods":{"FETCH_EXTERNAL_ACCOUNTS":"24h"},
+				"blackoutWindows":[{"start":"23:00","end":"01:00"}],
+				"apiKey":"super-secret"
+			}`),
+		}
+		s.EXPECT().ConnectorsGet(gomock.Any(), connectorID).Return(connector, nil).Times(2)
+		p.EXPECT().DefaultConfig().Return(models.Config{PollingPeriod: time.Minute}).Times(2)
+
+		got, err := act.StorageConnectorsGetSchedulePolicy(ctx, connectorID, models.CAPABILITY_FETCH_EXTERNAL_ACCOUNTS)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got).To(Equal(models.SchedulePolicy{
+			PollingPeriod:   24 * time.Hour,
+			BlackoutWindows: []models.BlackoutWindow{{Start: "23:00", End: "01:00"}},
+		}))
+
+		got, err = act.StorageConnectorsGetSchedulePolicy(ctx, connectorID, models.CAPABILITY_FETCH_BALANCES)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got.PollingPeriod).To(Equal(5 * time.Minute))
+	})
+
+	It("falls back to the default polling period when the config omits it", func(ctx SpecContext) {
+		connector := &models.Connector{
+			ConnectorBase: models.ConnectorBase{ID: connectorID, Provider: "test"},
+			Config:        json.RawMessage(`{"name":"test"}`),
+		}
+		s.EXPECT().ConnectorsGet(gomock.Any(), connectorID).Return(connector, nil)
+		p.EXPECT().DefaultConfig().Return(models.Config{PollingPeriod: 90 * time.Second})
+
+		got, err := act.StorageConnectorsGetSchedulePolicy(ctx, connectorID, models.CAPABILITY_FETCH_PAYMENTS)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got).To(Equal(models.SchedulePolicy{PollingPeriod: 90 * time.Second}))
+	})
+})
@@ -0,0 +1,18 @@
+package activities
+
+import (
+	"context"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+	"go.temporal.io/sdk/workflow"
+)
+
+func (a Activities) StorageDisputesUpsert(ctx context.Context, disputes []models.Dispute) error {
+	return temporalStorageError(a.storage.DisputesUpsert(ctx, disputes))
+}
+
+var StorageDisputesUpsertActivity = Activities{}.StorageDisputesUpsert
+
+func StorageDisputesUpsert(ctx workflow.Context, disputes []models.Dispute) error {
+	return executeActivity(ctx, StorageDisputesUpsertActivity, nil, disputes)
+}
@@ -0,0 +1,29 @@
+package activities
+
+import (
+	"context"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+	"go.temporal.io/sdk/workflow"
+)
+
+func (a Activities) StorageOpenBankingPaymentAttemptsGetFromPaymentRequestReference(ctx context.Context, connectorID models.ConnectorID, reference string) (*models.OpenBankingPaymentAttempt, error) {
+	attempt, err := a.storage.OpenBankingPaymentAttemptsGetFromPaymentRequestReference(ctx, connectorID, reference)
+	if err != nil {
+		return nil, temporalStorageError(err)
+	}
+
+	return attempt, nil
+}
+
+var StorageOpenBankingPaymentAttemptsGetFromPaymentRequestReferenceActivity = Activities{}.StorageOpenBankingPaymentAttemptsGetFromPaymentRequestReference
+
+func StorageOpenBankingPaymentAttemptsGetFromPaymentRequestReference(ctx workflow.Context, connectorID models.ConnectorID, reference string) (*models.OpenBankingPaymentAttempt, error) {
+	var ret *models.OpenBankingPaymentAttempt
+	err := executeActivity(ctx, StorageOpenBankingPaymentAttemptsGetFromPaymentRequestReferenceActivity, &ret, connectorID, reference)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,19 @@
+package activities
+
+import (
+	"context"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"go.temporal.io/sdk/workflow"
+)
+
+func (a Activities) StorageOpenBankingPaymentAttemptsUpdateStatus(ctx context.Context, id uuid.UUID, status models.OpenBankingConnectionAttemptStatus, error *string) error {
+	return temporalStorageError(a.storage.OpenBankingPaymentAttemptsUpdateStatus(ctx, id, status, error))
+}
+
+var StorageOpenBankingPaymentAttemptsUpdateStatusActivity = Activities{}.StorageOpenBankingPaymentAttemptsUpdateStatus
+
+func StorageOpenBankingPaymentAttemptsUpdateStatus(ctx workflow.Context, id uuid.UUID, status models.OpenBankingConnectionAttemptStatus, error *string) error {
+	return executeActivity(ctx, StorageOpenBankingPaymentAttemptsUpdateStatusActivity, nil, id, status, error)
+}
@@ -0,0 +1,18 @@
+package activities
+
+import (
+	"context"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+	"go.temporal.io/sdk/workflow"
+)
+
+func (a Activities) StorageStatesDeleteFromID(ctx context.Context, id models.StateID) error {
+	return temporalStorageError(a.storage.StatesDelete(ctx, id))
+}
+
+var StorageStatesDeleteFromIDActivity = Activities{}.StorageStatesDeleteFromID
+
+func StorageStatesDeleteFromID(ctx workflow.Context, id models.StateID) error {
+	return executeActivity(ctx, StorageStatesDeleteFromIDActivity, nil, id)
+}
@@ -0,0 +1,71 @@
+package activities
+
+import (
+	"github.com/formancehq/payments/pkg/domain/models"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+)
+
+const minutesPerDay = 24 * 60
+
+// blackoutCalendarSpecs translates blackout windows into calendar specs that
+// temporal skips when computing the next run of a schedule.
+func blackoutCalendarSpecs(windows []models.BlackoutWindow) ([]client.ScheduleCalendarSpec, error) {
+	var specs []client.ScheduleCalendarSpec
+	for _, window := range windows {
+		if err := window.Validate(); err != nil {
+			return nil, temporal.NewNonRetryableApplicationError("invalid blackout window", ErrTypeInvalidArgument, err)
+		}
+
+		start, end, _ := window.Minutes()
+		if end < start {
+			// The window spans midnight
+			specs = append(specs, minutesCalendarSpecs(start, minutesPerDay)...)
+			specs = append(specs, minutesCalendarSpecs(0, end)...)
+			continue
+		}
+		specs = append(specs, minutesCalendarSpecs(start, end)...)
+	}
+	return specs, nil
+}
+
+// minutesCalendarSpecs covers [start, end), both being minutes since midnight.
+// Temporal ranges are hour/minute based, so the window is split into a partial
+// first hour, the full hours in between and a partial last hour.
+func minutesCalendarSpecs(start, end int) []client.ScheduleCalendarSpec {
+	if start >= end {
+		return nil
+	}
+
+	startHour, startMinute := start/60, start%60
+	endHour, endMinute := end/60, end%60
+
+	if startHour == endHour {
+		return []client.ScheduleCalendarSpec{
+			calendarSpec(startHour, startHour, startMinute, endMinute-1),
+		}
+	}
+
+	var specs []client.ScheduleCalendarSpec
+	if startMinute > 0 {
+		specs = append(specs, calendarSpec(startHour, startHour, startMinute, 59))
+		startHour++
+	}
+	if startHour < endHour {
+		specs = append(specs, calendarSpec(startHour, endHour-1, 0, 59))
+	}
+	if endMinute > 0 {
+		specs = append(specs, calendarSpec(endHour, endHour, 0, endMinute-1))
+	}
+	return specs
+}
+
+func calendarSpec(startHour, endHour, startMinute, endMinute int) client.ScheduleCalendarSpec {
+	// Seconds and minutes default to 0 and hours to midnight, they all have to
+	// be set explicitly.
+	return client.ScheduleCalendarSpec{
+		Second: []client.ScheduleRange{{Start: 0, End: 59}},
+		Minute: []client.ScheduleRange{{Start: startMinute, End: endMinute}},
+		Hour:   []client.ScheduleRange{{Start: startHour, End: endHour}},
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"time"
 
+	"github.com/formancehq/payments/pkg/domain/models"
 	"go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/sdk/client"
@@ -18,6 +19,7 @@ type ScheduleCreateOptions struct {
 	Action             client.ScheduleWorkflowAction
 	Overlap            enums.ScheduleOverlapPolicy
 	Jitter             time.Duration
+	BlackoutWindows    []models.BlackoutWindow
 	TriggerImmediately bool
 	SearchAttributes   map[string]interface{}
 }
@@ -48,14 +50,20 @@ func (a Activities) TemporalScheduleCreate(ctx context.Context, options Schedule
 	}
 	options.Action.TypedSearchAttributes = temporal.NewSearchAttributes(attributes...)
 
+	skip, err := blackoutCalendarSpecs(options.BlackoutWindows)
+	if err != nil {
+		return err
+	}
+
 	spec := client.ScheduleSpec{
 		Jitter: options.Jitter,
+		Skip:   skip,
 	}
 	if options.Interval != nil {
 		spec.Intervals = []client.ScheduleIntervalSpec{*options.Interval}
 	}
 
-	_, err := a.temporalClient.ScheduleClient().Create(ctx, client.ScheduleOptions{
+	_, err = a.temporalClient.ScheduleClient().Create(ctx, client.ScheduleOptions{
 		ID:                 options.ScheduleID,
 		Spec:               spec,
 		Action:             &options.Action,
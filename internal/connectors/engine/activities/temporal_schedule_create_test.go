@@ -2,6 +2,7 @@ package activities_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/formancehq/payments/internal/connectors/engine/activities"
 	"github.com/formancehq/payments/internal/events"
 	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	enums "go.temporal.io/api/enums/v1"
@@ -134,6 +136,43 @@ var _ = Describe("Temporal Schedule Creation", func() {
 		Expect(err).To(BeNil())
 	})
 
+	It("skips the blackout windows", func(ctx SpecContext) {
+		t.EXPECT().ScheduleClient().Return(sc)
+
+		createOpts := activities.ScheduleCreateOptions{
+			ScheduleID: scheduleID,
+			Interval:   &client.ScheduleIntervalSpec{Every: 5 * time.Minute},
+			BlackoutWindows: []models.BlackoutWindow{
+				{Start: "22:30", End: "01:15"},
+			},
+		}
+		sc.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, opts client.ScheduleOptions) (client.ScheduleHandle, error) {
+			allSeconds := []client.ScheduleRange{{Start: 0, End: 59}}
+			Expect(opts.Spec.Skip).To(Equal([]client.ScheduleCalendarSpec{
+				{Second: allSeconds, Minute: []client.ScheduleRange{{Start: 30, End: 59}}, Hour: []client.ScheduleRange{{Start: 22, End: 22}}},
+				{Second: allSeconds, Minute: []client.ScheduleRange{{Start: 0, End: 59}}, Hour: []client.ScheduleRange{{Start: 23, End: 23}}},
+				{Second: allSeconds, Minute: []client.ScheduleRange{{Start: 0, End: 59}}, Hour: []client.ScheduleRange{{Start: 0, End: 0}}},
+				{Second: allSeconds, Minute: []client.ScheduleRange{{Start: 0, End: 14}}, Hour: []client.ScheduleRange{{Start: 1, End: 1}}},
+			}))
+			return activities.NewMockScheduleHandle(ctrl), nil
+		})
+		err := act.TemporalScheduleCreate(ctx, createOpts)
+		Expect(err).To(BeNil())
+	})
+
+	It("rejects invalid blackout windows", func(ctx SpecContext) {
+		createOpts := activities.ScheduleCreateOptions{
+			ScheduleID:      scheduleID,
+			Interval:        &client.ScheduleIntervalSpec{Every: 5 * time.Minute},
+			BlackoutWindows: []models.BlackoutWindow{{Start: "10:00", End: "10:00"}},
+		}
+		err := act.TemporalScheduleCreate(ctx, createOpts)
+		Expect(err).NotTo(BeNil())
+		var appErr *temporal.ApplicationError
+		Expect(errors.As(err, &appErr)).To(BeTrue())
+		Expect(appErr.NonRetryable()).To(BeTrue())
+	})
+
 	It("rejects options with no Interval and TriggerImmediately false", func(ctx SpecContext) {
 		// Guard against a no-op schedule (never fires). Must NOT call
 		// ScheduleClient().Create — the validation returns before the client
@@ -0,0 +1,41 @@
+package activities
+
+import (
+	"context"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/workflow"
+)
+
+func (a Activities) TemporalScheduleUpdateSchedulePolicy(ctx context.Context, scheduleID string, policy models.SchedulePolicy) error {
+	skip, err := blackoutCalendarSpecs(policy.BlackoutWindows)
+	if err != nil {
+		return err
+	}
+
+	handle := a.temporalClient.ScheduleClient().GetHandle(ctx, scheduleID)
+	err = handle.Update(ctx, client.ScheduleUpdateOptions{
+		DoUpdate: func(input client.ScheduleUpdateInput) (*client.ScheduleUpdate, error) {
+			input.Description.Schedule.Spec.Intervals = []client.ScheduleIntervalSpec{
+				{
+					Every: policy.PollingPeriod,
+				},
+			}
+			input.Description.Schedule.Spec.Skip = skip
+			return &client.ScheduleUpdate{
+				Schedule: &input.Description.Schedule,
+			}, nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+var TemporalScheduleUpdateSchedulePolicyActivity = Activities{}.TemporalScheduleUpdateSchedulePolicy
+
+func TemporalScheduleUpdateSchedulePolicy(ctx workflow.Context, scheduleID string, policy models.SchedulePolicy) error {
+	return executeActivity(ctx, TemporalScheduleUpdateSchedulePolicyActivity, nil, scheduleID, policy)
+}
@@ -0,0 +1,78 @@
+package activities_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/observe/log"
+	"github.com/formancehq/payments/internal/connectors"
+	"github.com/formancehq/payments/internal/connectors/engine/activities"
+	"github.com/formancehq/payments/internal/events"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.temporal.io/sdk/client"
+	gomock "go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Temporal Schedule Update Schedule Policy", func() {
+	var (
+		act    activities.Activities
+		p      *connectors.MockManager
+		s      *storage.MockStorage
+		t      *activities.MockClient
+		sc     *activities.MockScheduleClient
+		sh     *activities.MockScheduleHandle
+		evts   *events.Events
+		logger = logging.NewDefaultLogger(GinkgoWriter, true, false, false)
+		delay  = 50 * time.Millisecond
+	)
+
+	BeforeEach(func() {
+		evts = &events.Events{}
+		ctrl := gomock.NewController(GinkgoT())
+		p = connectors.NewMockManager(ctrl)
+		s = storage.NewMockStorage(ctrl)
+		t = activities.NewMockClient(ctrl)
+		sc = activities.NewMockScheduleClient(ctrl)
+		sh = activities.NewMockScheduleHandle(ctrl)
+		act = activities.New(logger, t, s, evts, p, delay, 0)
+	})
+
+	It("updates the interval and the skipped times of the schedule", func(ctx SpecContext) {
+		t.EXPECT().ScheduleClient().Return(sc)
+		sc.EXPECT().GetHandle(gomock.Any(), "scheduleID").Return(sh)
+		sh.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, options client.ScheduleUpdateOptions) error {
+			update, err := options.DoUpdate(client.ScheduleUpdateInput{
+				Description: client.ScheduleDescription{
+					Schedule: client.Schedule{Spec: &client.ScheduleSpec{}},
+				},
+			})
+			Expect(err).To(BeNil())
+			Expect(update.Schedule.Spec.Intervals).To(Equal([]client.ScheduleIntervalSpec{{Every: time.Hour}}))
+			Expect(update.Schedule.Spec.Skip).To(Equal([]client.ScheduleCalendarSpec{
+				{
+					Second: []client.ScheduleRange{{Start: 0, End: 59}},
+					Minute: []client.ScheduleRange{{Start: 0, End: 59}},
+					Hour:   []client.ScheduleRange{{Start: 2, End: 3}},
+				},
+			}))
+			return nil
+		})
+
+		err := act.TemporalScheduleUpdateSchedulePolicy(ctx, "scheduleID", models.SchedulePolicy{
+			PollingPeriod:   time.Hour,
+			BlackoutWindows: []models.BlackoutWindow{{Start: "02:00", End: "04:00"}},
+		})
+		Expect(err).To(BeNil())
+	})
+
+	It("rejects invalid blackout windows before touching the schedule", func(ctx SpecContext) {
+		err := act.TemporalScheduleUpdateSchedulePolicy(ctx, "scheduleID", models.SchedulePolicy{
+			PollingPeriod:   time.Hour,
+			BlackoutWindows: []models.BlackoutWindow{{Start: "02:00"}},
+		})
+		Expect(err).NotTo(BeNil())
+	})
+})
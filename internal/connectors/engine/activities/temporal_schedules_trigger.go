@@ -0,0 +1,25 @@
+package activities
+
+import (
+	"context"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/workflow"
+)
+
+func (a Activities) TemporalSchedulesTrigger(ctx context.Context, schedules []models.Schedule) error {
+	for _, s := range schedules {
+		handle := a.temporalClient.ScheduleClient().GetHandle(ctx, s.ID)
+		if err := handle.Trigger(ctx, client.ScheduleTriggerOptions{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var TemporalSchedulesTriggerActivity = Activities{}.TemporalSchedulesTrigger
+
+func TemporalSchedulesTrigger(ctx workflow.Context, schedules []models.Schedule) error {
+	return executeActivity(ctx, TemporalSchedulesTriggerActivity, nil, schedules)
+}
@@ -0,0 +1,68 @@
+package activities_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/observe/log"
+	"github.com/formancehq/payments/internal/connectors"
+	"github.com/formancehq/payments/internal/connectors/engine/activities"
+	"github.com/formancehq/payments/internal/events"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.temporal.io/sdk/client"
+	gomock "go.uber.org/mock/gomock"
+)
+
+var _ = Describe("TemporalSchedulesTrigger", func() {
+	var (
+		act    activities.Activities
+		tc     *activities.MockClient
+		sc     *activities.MockScheduleClient
+		sh     *activities.MockScheduleHandle
+		p      *connectors.MockManager
+		s      *storage.MockStorage
+		evts   *events.Events
+		logger = logging.NewDefaultLogger(GinkgoWriter, true, false, false)
+	)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		p = connectors.NewMockManager(ctrl)
+		tc = activities.NewMockClient(ctrl)
+		sc = activities.NewMockScheduleClient(ctrl)
+		sh = activities.NewMockScheduleHandle(ctrl)
+		s = storage.NewMockStorage(ctrl)
+		evts = &events.Events{}
+		act = activities.New(logger, tc, s, evts, p, time.Millisecond, 0)
+	})
+
+	It("triggers every schedule in the slice", func(ctx SpecContext) {
+		schedules := []models.Schedule{
+			{ID: "test-connector-FETCH_ACCOUNTS"},
+			{ID: "test-connector-FETCH_PAYMENTS"},
+		}
+
+		tc.EXPECT().ScheduleClient().Return(sc).Times(2)
+		sc.EXPECT().GetHandle(ctx, schedules[0].ID).Return(sh)
+		sc.EXPECT().GetHandle(ctx, schedules[1].ID).Return(sh)
+		sh.EXPECT().Trigger(ctx, client.ScheduleTriggerOptions{}).Return(nil).Times(2)
+
+		err := act.TemporalSchedulesTrigger(ctx, schedules)
+		Expect(err).To(BeNil())
+	})
+
+	It("returns an error when temporal trigger fails", func(ctx SpecContext) {
+		schedule := models.Schedule{ID: "test-connector-FETCH_ACCOUNTS"}
+		expectedErr := fmt.Errorf("temporal error")
+
+		tc.EXPECT().ScheduleClient().Return(sc)
+		sc.EXPECT().GetHandle(ctx, schedule.ID).Return(sh)
+		sh.EXPECT().Trigger(ctx, client.ScheduleTriggerOptions{}).Return(expectedErr)
+
+		err := act.TemporalSchedulesTrigger(ctx, []models.Schedule{schedule})
+		Expect(err).To(MatchError(expectedErr))
+	})
+})
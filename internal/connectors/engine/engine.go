@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -16,6 +17,7 @@ import (
 	"github.com/formancehq/payments/internal/connectors"
 	"github.com/formancehq/payments/internal/connectors/engine/utils"
 	"github.com/formancehq/payments/internal/connectors/engine/workflow"
+	"github.com/formancehq/payments/internal/connectors/plugins"
 	"github.com/formancehq/payments/internal/connectors/plugins/registry"
 	"github.com/formancehq/payments/internal/otel"
 	"github.com/formancehq/payments/internal/storage"
@@ -25,6 +27,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/sdk/client"
 	"golang.org/x/sync/errgroup"
 )
@@ -39,6 +42,26 @@ type Engine interface {
 	ResetConnector(ctx context.Context, connectorID models.ConnectorID) (models.Task, error)
 	// Update a connector with the given configuration.
 	UpdateConnector(ctx context.Context, connectorID models.ConnectorID, rawConfig json.RawMessage) error
+	// Trigger an immediate run of every fetch schedule of the connector.
+	SyncConnector(ctx context.Context, connectorID models.ConnectorID) (models.Task, error)
+	// Fetch again the objects of a capability created within a time window,
+	// without touching the periodic fetch state.
+	BackfillConnector(ctx context.Context, connectorID models.ConnectorID, capability models.Capability, window models.FetchWindow) (models.Task, error)
+	// Rotate the credentials of a connector once validated against the PSP.
+	RotateConnectorCredentials(ctx context.Context, connectorID models.ConnectorID, credentials json.RawMessage) (models.Task, error)
+	// Dry run a connector config: validate it and test the connection to the
+	// PSP without persisting anything.
+	TestConnector(ctx context.Context, provider string, rawConfig json.RawMessage) (models.ConnectorTestResult, error)
+	// Validate a connector config and return it as it would be stored, with
+	// the defaults applied, without persisting anything.
+	ValidateConnectorConfig(ctx context.Context, provider string, rawConfig json.RawMessage) (json.RawMessage, error)
+
+	// Pause a connector schedule, both in temporal and in the database.
+	PauseSchedule(ctx context.Context, connectorID models.ConnectorID, scheduleID string) error
+	// Resume a paused connector schedule, both in temporal and in the database.
+	ResumeSchedule(ctx context.Context, connectorID models.ConnectorID, scheduleID string) error
+	// Trigger an immediate run of a connector schedule.
+	TriggerSchedule(ctx context.Context, connectorID models.ConnectorID, scheduleID string) error
 
 	// Create a Formance account, no call to the plugin, just a creation
 	// of an account in the database related to the provided connector id.
@@ -53,6 +76,10 @@ type Engine interface {
 	// Forward a bank account to the given connector, which will create it
 	// in the external system (PSP).
 	ForwardBankAccount(ctx context.Context, ba models.BankAccount, connectorID models.ConnectorID, waitResult bool) (models.Task, error)
+	// Verify the ownership of a bank account against a connector
+	VerifyBankAccount(ctx context.Context, ba models.BankAccount, connectorID models.ConnectorID, waitResult bool) (models.Task, error)
+	// Issue a new account (e.g. a virtual account) on the given connector
+	CreateAccount(ctx context.Context, connectorID models.ConnectorID, req models.CreateAccountRequest, waitResult bool) (models.Task, error)
 	// Create a transfer between two accounts on the given connector (PSP).
 	CreateTransfer(ctx context.Context, piID models.PaymentInitiationID, attempt int, waitResult bool) (models.Task, error)
 	// Reverse a transfer on the given connector (PSP).
@@ -61,6 +88,10 @@ type Engine interface {
 	CreatePayout(ctx context.Context, piID models.PaymentInitiationID, attempt int, waitResult bool) (models.Task, error)
 	// Reverse a payout on the given connector (PSP).
 	ReversePayout(ctx context.Context, reversal models.PaymentInitiationReversal, waitResult bool) (models.Task, error)
+	// Send the decision of the screening service to the create transfer or
+	// create payout workflow holding the payment initiation in the
+	// PENDING_SCREENING status.
+	SubmitScreeningResult(ctx context.Context, pi models.PaymentInitiation, attempt int, result models.ScreeningResult) error
 
 	// Create a user on the given connector (PSP).
 	ForwardPaymentServiceUser(ctx context.Context, psuID uuid.UUID, connectorID models.ConnectorID) error
@@ -76,6 +107,10 @@ type Engine interface {
 	UpdatePaymentServiceUserLink(ctx context.Context, applicationName string, psuID uuid.UUID, connectorID models.ConnectorID, connectionID string, idempotencyKey *uuid.UUID, ClientRedirectURL *string) (string, string, error)
 	// Complete a payment service user link on the given connector (PSP).
 	CompletePaymentServiceUserLink(ctx context.Context, connectorID models.ConnectorID, attemptID uuid.UUID, httpCallInformation models.HTTPCallInformation) error
+	// Create a payment initiation and the related payment link for a payment
+	// service user on the given connector (PSP). The payment is sent to the
+	// given bank account.
+	CreatePaymentServiceUserPaymentLink(ctx context.Context, applicationName string, psuID uuid.UUID, pi models.PaymentInitiation, bankAccountID uuid.UUID, idempotencyKey *uuid.UUID, ClientRedirectURL *string) (string, string, error)
 
 	// We received a webhook, handle it by calling the corresponding plugin to
 	// translate it to a formance object and store it.
@@ -167,7 +202,8 @@ func (e *engine) InstallConnector(ctx context.Context, provider string, rawConfi
 			CreatedAt: time.Now().UTC(),
 			Provider:  provider,
 		},
-		Config: validatedConfig,
+		Config:      validatedConfig,
+		Environment: connectorEnvironment(validatedConfig),
 	}
 
 	// Detached the context to avoid being in a weird state if request is
@@ -362,6 +398,7 @@ func (e *engine) UpdateConnector(ctx context.Context, connectorID models.Connect
 	}
 	connector.Name = connectorName
 	connector.Config = validatedConfig
+	connector.Environment = connectorEnvironment(validatedConfig)
 
 	if err := e.storage.ConnectorsConfigUpdate(ctx, *connector); err != nil {
 		otel.RecordError(span, err)
@@ -398,6 +435,511 @@ func (e *engine) UpdateConnector(ctx context.Context, connectorID models.Connect
 	return nil
 }
 
+func (e *engine) SyncConnector(ctx context.Context, connectorID models.ConnectorID) (models.Task, error) {
+	ctx, span := otel.Tracer().Start(ctx, "engine.SyncConnector")
+	defer span.End()
+
+	if _, err := e.storage.ConnectorsGet(ctx, connectorID); err != nil {
+		otel.RecordError(span, err)
+		if errors.Is(err, storage.ErrNotFound) {
+			return models.Task{}, fmt.Errorf("connector %w", ErrNotFound)
+		}
+		return models.Task{}, err
+	}
+
+	now := time.Now()
+	id := e.taskIDReferenceFor(IDPrefixConnectorSync, connectorID, uuid.New().String())
+	task := models.Task{
+		ID: models.TaskID{
+			Reference:   id,
+			ConnectorID: connectorID,
+		},
+		ConnectorID: &connectorID,
+		Status:      models.TASK_STATUS_PROCESSING,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	if err := e.storage.TasksUpsert(ctx, task); err != nil {
+		otel.RecordError(span, err)
+		return models.Task{}, err
+	}
+
+	_, err := e.temporalClient.ExecuteWorkflow(
+		ctx,
+		client.StartWorkflowOptions{
+			ID:                                       id,
+			TaskQueue:                                GetDefaultTaskQueue(e.stack),
+			WorkflowIDReusePolicy:                    enums.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE_FAILED_ONLY,
+			WorkflowExecutionErrorWhenAlreadyStarted: false,
+			SearchAttributes: map[string]interface{}{
+				workflow.SearchAttributeStack:       e.stack,
+				workflow.SearchAttributeConnectorID: connectorID.String(),
+			},
+		},
+		workflow.RunSyncConnector,
+		workflow.SyncConnector{
+			ConnectorID: connectorID,
+			TaskID:      task.ID,
+		},
+	)
+	if err != nil {
+		task.Status = models.TASK_STATUS_FAILED
+		task.UpdatedAt = time.Now()
+		if err := e.storage.TasksUpsert(ctx, task); err != nil {
+			e.logger.Errorf("failed to update task status to failed: %v", err)
+		}
+
+		otel.RecordError(span, err)
+		return models.Task{}, err
+	}
+
+	return task, nil
+}
+
+func (e *engine) BackfillConnector(ctx context.Context, connectorID models.ConnectorID, capability models.Capability, window models.FetchWindow) (models.Task, error) {
+	ctx, span := otel.Tracer().Start(ctx, "engine.BackfillConnector")
+	defer span.End()
+
+	if err := window.Validate(); err != nil {
+		otel.RecordError(span, err)
+		return models.Task{}, errorsutils.NewWrappedError(err, ErrValidation)
+	}
+
+	if !workflow.IsBackfillSupported(capability) {
+		err := fmt.Errorf("capability %s cannot be backfilled: %w", capability, ErrValidation)
+		otel.RecordError(span, err)
+		return models.Task{}, err
+	}
+
+	if _, err := e.storage.ConnectorsGet(ctx, connectorID); err != nil {
+		otel.RecordError(span, err)
+		if errors.Is(err, storage.ErrNotFound) {
+			return models.Task{}, fmt.Errorf("connector %w", ErrNotFound)
+		}
+		return models.Task{}, err
+	}
+
+	provider := models.ToV3Provider(connectorID.Provider)
+	capabilities, err := registry.GetCapabilities(provider)
+	if err != nil {
+		otel.RecordError(span, err)
+		return models.Task{}, err
+	}
+
+	if !slices.Contains(capabilities, capability) {
+		err := &ErrConnectorCapabilityNotSupported{Capability: capability.String(), Provider: provider}
+		otel.RecordError(span, err)
+		return models.Task{}, err
+	}
+
+	now := time.Now()
+	id := e.taskIDReferenceFor(IDPrefixConnectorBackfill, connectorID, uuid.New().String())
+	task := models.Task{
+		ID: models.TaskID{
+			Reference:   id,
+			ConnectorID: connectorID,
+		},
+		ConnectorID: &connectorID,
+		Status:      models.TASK_STATUS_PROCESSING,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	if err := e.storage.TasksUpsert(ctx, task); err != nil {
+		otel.RecordError(span, err)
+		return models.Task{}, err
+	}
+
+	_, err = e.temporalClient.ExecuteWorkflow(
+		ctx,
+		client.StartWorkflowOptions{
+			ID:                                       id,
+			TaskQueue:                                GetDefaultTaskQueue(e.stack),
+			WorkflowIDReusePolicy:                    enums.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE_FAILED_ONLY,
+			WorkflowExecutionErrorWhenAlreadyStarted: false,
+			SearchAttributes: map[string]interface{}{
+				workflow.SearchAttributeStack:       e.stack,
+				workflow.SearchAttributeConnectorID: connectorID.String(),
+			},
+		},
+		workflow.RunBackfillConnector,
+		workflow.BackfillConnector{
+			ConnectorID: connectorID,
+			TaskID:      task.ID,
+			Capability:  capability,
+			Window:      window,
+		},
+	)
+	if err != nil {
+		task.Status = models.TASK_STATUS_FAILED
+		task.UpdatedAt = time.Now()
+		if err := e.storage.TasksUpsert(ctx, task); err != nil {
+			e.logger.Errorf("failed to update task status to failed: %v", err)
+		}
+
+		otel.RecordError(span, err)
+		return models.Task{}, err
+	}
+
+	return task, nil
+}
+
+func (e *engine) RotateConnectorCredentials(ctx context.Context, connectorID models.ConnectorID, credentials json.RawMessage) (models.Task, error) {
+	ctx, span := otel.Tracer().Start(ctx, "engine.RotateConnectorCredentials")
+	defer span.End()
+
+	connector, err := e.storage.ConnectorsGet(ctx, connectorID)
+	if err != nil {
+		otel.RecordError(span, err)
+		if errors.Is(err, storage.ErrNotFound) {
+			return models.Task{}, fmt.Errorf("connector %w", ErrNotFound)
+		}
+		return models.Task{}, err
+	}
+
+	rawConfig, fields, err := mergeConnectorCredentials(connector.Config, credentials)
+	if err != nil {
+		otel.RecordError(span, err)
+		return models.Task{}, errorsutils.NewWrappedError(err, ErrValidation)
+	}
+
+	// The config is only validated here: the plugin is reloaded with it by
+	// every instance once the credentials have been checked against the PSP.
+	connector.Config = rawConfig
+	connectorName, validatedConfig, err := e.connectors.Validate(*connector)
+	if err != nil {
+		otel.RecordError(span, err)
+		if _, ok := err.(validator.ValidationErrors); ok || errors.Is(err, models.ErrInvalidConfig) || errors.Is(err, connectors.ErrValidation) {
+			return models.Task{}, errorsutils.NewWrappedError(err, ErrValidation)
+		}
+		return models.Task{}, err
+	}
+	connector.Name = connectorName
+
+	// The config goes through the workflow history, make sure the credentials
+	// are not stored in clear there.
+	connector.Config, err = e.storage.EncryptRaw(ctx, validatedConfig)
+	if err != nil {
+		otel.RecordError(span, err)
+		return models.Task{}, err
+	}
+
+	now := time.Now()
+	id := e.taskIDReferenceFor(IDPrefixConnectorCredentialsRotation, connectorID, uuid.New().String())
+	task := models.Task{
+		ID: models.TaskID{
+			Reference:   id,
+			ConnectorID: connectorID,
+		},
+		ConnectorID: &connectorID,
+		Status:      models.TASK_STATUS_PROCESSING,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	if err := e.storage.TasksUpsert(ctx, task); err != nil {
+		otel.RecordError(span, err)
+		return models.Task{}, err
+	}
+
+	_, err = e.temporalClient.ExecuteWorkflow(
+		ctx,
+		client.StartWorkflowOptions{
+			ID:                                       id,
+			TaskQueue:                                GetDefaultTaskQueue(e.stack),
+			WorkflowIDReusePolicy:                    enums.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE_FAILED_ONLY,
+			WorkflowExecutionErrorWhenAlreadyStarted: false,
+			SearchAttributes: map[string]interface{}{
+				workflow.SearchAttributeStack:       e.stack,
+				workflow.SearchAttributeConnectorID: connectorID.String(),
+			},
+		},
+		workflow.RunRotateConnectorCredentials,
+		workflow.RotateConnectorCredentials{
+			TaskID:    task.ID,
+			Connector: *connector,
+			Fields:    fields,
+		},
+	)
+	if err != nil {
+		task.Status = models.TASK_STATUS_FAILED
+		task.UpdatedAt = time.Now()
+		if err := e.storage.TasksUpsert(ctx, task); err != nil {
+			e.logger.Errorf("failed to update task status to failed: %v", err)
+		}
+
+		otel.RecordError(span, err)
+		return models.Task{}, err
+	}
+
+	return task, nil
+}
+
+// connectorSettingsFields are the fields of the generic connector config,
+// they cannot be changed through a credentials rotation.
+var connectorSettingsFields = []string{"name", "pollingPeriod", "pollingPeriods", "blackoutWindows", "environment"}
+
+// connectorEnvironment returns the environment set in a validated connector
+// config.
+func connectorEnvironment(validatedConfig json.RawMessage) string {
+	var config struct {
+		Environment string `json:"environment"`
+	}
+	// The config was validated, it cannot be malformed
+	_ = json.Unmarshal(validatedConfig, &config)
+	return config.Environment
+}
+
+// mergeConnectorCredentials overrides fields of the connector config with the
+// given credentials. Only fields already present in the config can be rotated.
+// It returns the merged config and the sorted names of the rotated fields.
+func mergeConnectorCredentials(rawConfig json.RawMessage, credentials json.RawMessage) (json.RawMessage, []string, error) {
+	var config map[string]json.RawMessage
+	if err := json.Unmarshal(rawConfig, &config); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal connector config: %w", err)
+	}
+
+	var rotated map[string]json.RawMessage
+	if err := json.Unmarshal(credentials, &rotated); err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", models.ErrInvalidConfig, err)
+	}
+
+	if len(rotated) == 0 {
+		return nil, nil, fmt.Errorf("%w: no credentials to rotate", models.ErrInvalidConfig)
+	}
+
+	fields := make([]string, 0, len(rotated))
+	for field, value := range rotated {
+		if slices.Contains(connectorSettingsFields, field) {
+			return nil, nil, fmt.Errorf("%w: %s is not a credential", models.ErrInvalidConfig, field)
+		}
+
+		if _, ok := config[field]; !ok {
+			return nil, nil, fmt.Errorf("%w: unknown config field %s", models.ErrInvalidConfig, field)
+		}
+
+		config[field] = value
+		fields = append(fields, field)
+	}
+	slices.Sort(fields)
+
+	merged, err := json.Marshal(config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return merged, fields, nil
+}
+
+func (e *engine) TestConnector(ctx context.Context, provider string, rawConfig json.RawMessage) (models.ConnectorTestResult, error) {
+	ctx, span := otel.Tracer().Start(ctx, "engine.TestConnector")
+	defer span.End()
+
+	// The connector is never stored, the ID only exists for the plugin.
+	connector := models.Connector{
+		ConnectorBase: models.ConnectorBase{
+			ID: models.ConnectorID{
+				Reference: uuid.New(),
+				Provider:  provider,
+			},
+			Provider: provider,
+		},
+		Config: rawConfig,
+	}
+
+	result := models.ConnectorTestResult{
+		Provider: provider,
+		Success:  true,
+	}
+
+	start := time.Now()
+	connectorName, _, err := e.connectors.Validate(connector)
+	configCheck := models.ConnectorTestCheck{
+		Name:     models.ConnectorTestCheckConfig,
+		Status:   models.CONNECTOR_TEST_CHECK_STATUS_PASSED,
+		Duration: time.Since(start),
+	}
+	if err != nil {
+		otel.RecordError(span, err)
+		switch {
+		case errors.Is(err, registry.ErrPluginNotFound), errors.Is(err, registry.ErrPluginEnterpriseOnly):
+			return models.ConnectorTestResult{}, errorsutils.NewWrappedError(err, ErrValidation)
+		case isConfigValidationError(err):
+			configCheck.Status = models.CONNECTOR_TEST_CHECK_STATUS_FAILED
+			configCheck.ErrorType = models.CONNECTOR_TEST_ERROR_TYPE_INVALID_CONFIG
+			configCheck.Error = err.Error()
+		default:
+			return models.ConnectorTestResult{}, err
+		}
+	}
+	result.Checks = append(result.Checks, configCheck)
+
+	if configCheck.Status == models.CONNECTOR_TEST_CHECK_STATUS_FAILED {
+		result.Success = false
+		result.Checks = append(result.Checks, models.ConnectorTestCheck{
+			Name:   models.ConnectorTestCheckConnection,
+			Status: models.CONNECTOR_TEST_CHECK_STATUS_SKIPPED,
+		})
+		return result, nil
+	}
+
+	plugin, err := registry.GetPlugin(connector.ID, e.logger, provider, connectorName, rawConfig)
+	if err != nil {
+		otel.RecordError(span, err)
+		return models.ConnectorTestResult{}, err
+	}
+
+	start = time.Now()
+	_, err = plugin.TestConnection(ctx, models.TestConnectionRequest{})
+	connectionCheck := models.ConnectorTestCheck{
+		Name:     models.ConnectorTestCheckConnection,
+		Status:   models.CONNECTOR_TEST_CHECK_STATUS_PASSED,
+		Duration: time.Since(start),
+	}
+	switch {
+	case err == nil:
+	case errors.Is(err, plugins.ErrNotImplemented):
+		// Nothing to call on the PSP side for this plugin.
+		connectionCheck.Status = models.CONNECTOR_TEST_CHECK_STATUS_SKIPPED
+	default:
+		otel.RecordError(span, err)
+		result.Success = false
+		connectionCheck.Status = models.CONNECTOR_TEST_CHECK_STATUS_FAILED
+		connectionCheck.ErrorType = connectorTestErrorType(err)
+		connectionCheck.Error = err.Error()
+	}
+	result.Checks = append(result.Checks, connectionCheck)
+
+	return result, nil
+}
+
+func (e *engine) ValidateConnectorConfig(ctx context.Context, provider string, rawConfig json.RawMessage) (json.RawMessage, error) {
+	_, span := otel.Tracer().Start(ctx, "engine.ValidateConnectorConfig")
+	defer span.End()
+
+	// The connector is never stored, the ID only exists for the plugin.
+	connector := models.Connector{
+		ConnectorBase: models.ConnectorBase{
+			ID: models.ConnectorID{
+				Reference: uuid.New(),
+				Provider:  provider,
+			},
+			Provider: provider,
+		},
+		Config: rawConfig,
+	}
+
+	_, validatedConfig, err := e.connectors.Validate(connector)
+	if err != nil {
+		otel.RecordError(span, err)
+		if isConfigValidationError(err) || errors.Is(err, registry.ErrPluginNotFound) || errors.Is(err, registry.ErrPluginEnterpriseOnly) {
+			return nil, errorsutils.NewWrappedError(err, ErrValidation)
+		}
+		return nil, err
+	}
+
+	return validatedConfig, nil
+}
+
+func isConfigValidationError(err error) bool {
+	_, ok := err.(validator.ValidationErrors)
+	return ok || errors.Is(err, models.ErrInvalidConfig) || errors.Is(err, connectors.ErrValidation)
+}
+
+func connectorTestErrorType(err error) models.ConnectorTestErrorType {
+	switch {
+	case errors.Is(err, plugins.ErrInvalidClientRequest):
+		return models.CONNECTOR_TEST_ERROR_TYPE_CLIENT_ERROR
+	case errors.Is(err, plugins.ErrUpstreamRatelimit):
+		return models.CONNECTOR_TEST_ERROR_TYPE_RATE_LIMITED
+	case errors.Is(err, plugins.ErrUpstreamTimeout), errors.Is(err, context.DeadlineExceeded):
+		return models.CONNECTOR_TEST_ERROR_TYPE_TIMEOUT
+	default:
+		return models.CONNECTOR_TEST_ERROR_TYPE_UPSTREAM_ERROR
+	}
+}
+
+const schedulePausedManuallyReason = "paused manually"
+
+func (e *engine) PauseSchedule(ctx context.Context, connectorID models.ConnectorID, scheduleID string) error {
+	ctx, span := otel.Tracer().Start(ctx, "engine.PauseSchedule")
+	defer span.End()
+
+	if err := e.checkScheduleExists(ctx, connectorID, scheduleID); err != nil {
+		otel.RecordError(span, err)
+		return err
+	}
+
+	handle := e.temporalClient.ScheduleClient().GetHandle(ctx, scheduleID)
+	if err := handle.Pause(ctx, client.SchedulePauseOptions{
+		Note: schedulePausedManuallyReason,
+	}); err != nil {
+		otel.RecordError(span, err)
+		return err
+	}
+
+	if err := e.storage.SchedulesPause(ctx, scheduleID, connectorID, time.Now(), schedulePausedManuallyReason); err != nil {
+		otel.RecordError(span, err)
+		return err
+	}
+
+	return nil
+}
+
+func (e *engine) ResumeSchedule(ctx context.Context, connectorID models.ConnectorID, scheduleID string) error {
+	ctx, span := otel.Tracer().Start(ctx, "engine.ResumeSchedule")
+	defer span.End()
+
+	if err := e.checkScheduleExists(ctx, connectorID, scheduleID); err != nil {
+		otel.RecordError(span, err)
+		return err
+	}
+
+	handle := e.temporalClient.ScheduleClient().GetHandle(ctx, scheduleID)
+	if err := handle.Unpause(ctx, client.ScheduleUnpauseOptions{}); err != nil {
+		otel.RecordError(span, err)
+		return err
+	}
+
+	if err := e.storage.SchedulesUnpause(ctx, scheduleID, connectorID); err != nil {
+		otel.RecordError(span, err)
+		return err
+	}
+
+	return nil
+}
+
+func (e *engine) TriggerSchedule(ctx context.Context, connectorID models.ConnectorID, scheduleID string) error {
+	ctx, span := otel.Tracer().Start(ctx, "engine.TriggerSchedule")
+	defer span.End()
+
+	if err := e.checkScheduleExists(ctx, connectorID, scheduleID); err != nil {
+		otel.RecordError(span, err)
+		return err
+	}
+
+	handle := e.temporalClient.ScheduleClient().GetHandle(ctx, scheduleID)
+	if err := handle.Trigger(ctx, client.ScheduleTriggerOptions{}); err != nil {
+		otel.RecordError(span, err)
+		return err
+	}
+
+	return nil
+}
+
+// schedules are looked up in the database first so that a schedule belonging
+// to another connector cannot be modified through this connector
+func (e *engine) checkScheduleExists(ctx context.Context, connectorID models.ConnectorID, scheduleID string) error {
+	if _, err := e.storage.SchedulesGet(ctx, scheduleID, connectorID); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return fmt.Errorf("schedule %w", ErrNotFound)
+		}
+		return err
+	}
+	return nil
+}
+
 func (e *engine) CreateFormanceAccount(ctx context.Context, account models.Account) error {
 	ctx, span := otel.Tracer().Start(ctx, "engine.CreateFormanceAccount")
 	defer span.End()
@@ -420,67 +962,214 @@ func (e *engine) CreateFormanceAccount(ctx context.Context, account models.Accou
 	if !found {
 		err := &ErrConnectorCapabilityNotSupported{Capability: "CreateFormanceAccount", Provider: provider}
 		otel.RecordError(span, err)
-		return err
+		return err
+	}
+
+	// AccountsUpsert now handles outbox events transactionally
+	if err := e.storage.AccountsUpsert(ctx, []models.Account{account}); err != nil {
+		otel.RecordError(span, err)
+		return err
+	}
+
+	return nil
+}
+
+func (e *engine) CreateFormancePayment(ctx context.Context, payment models.Payment) error {
+	ctx, span := otel.Tracer().Start(ctx, "engine.CreateFormancePayment")
+	defer span.End()
+
+	provider := models.ToV3Provider(payment.ConnectorID.Provider)
+	capabilities, err := registry.GetCapabilities(provider)
+	if err != nil {
+		otel.RecordError(span, err)
+		return err
+	}
+
+	found := false
+	for _, c := range capabilities {
+		if c == models.CAPABILITY_ALLOW_FORMANCE_PAYMENT_CREATION {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		err := &ErrConnectorCapabilityNotSupported{Capability: "CreateFormancePayment", Provider: provider}
+		otel.RecordError(span, err)
+		return err
+	}
+
+	if err := e.storage.PaymentsUpsert(ctx, []models.Payment{payment}); err != nil {
+		otel.RecordError(span, err)
+		return err
+	}
+
+	// Payment events are now sent via outbox pattern in PaymentsUpsert
+	return nil
+}
+
+func (e *engine) CreateFormancePaymentInitiation(ctx context.Context, pi models.PaymentInitiation, adj models.PaymentInitiationAdjustment) error {
+	ctx, span := otel.Tracer().Start(ctx, "engine.CreateFormancePaymentInitiation")
+	defer span.End()
+
+	if err := e.storage.PaymentInitiationsInsert(ctx, pi, adj); err != nil {
+		otel.RecordError(span, err)
+		return err
+	}
+
+	// Payment initiation events are now sent via outbox pattern in PaymentInitiationsInsert
+	return nil
+}
+
+func (e *engine) ForwardBankAccount(ctx context.Context, ba models.BankAccount, connectorID models.ConnectorID, waitResult bool) (models.Task, error) {
+	ctx, span := otel.Tracer().Start(ctx, "engine.ForwardBankAccount")
+	defer span.End()
+
+	if _, err := e.storage.ConnectorsGet(ctx, connectorID); err != nil {
+		otel.RecordError(span, err)
+		if errors.Is(err, storage.ErrNotFound) {
+			return models.Task{}, fmt.Errorf("connector %w", ErrNotFound)
+		}
+		return models.Task{}, err
+	}
+
+	id := e.taskIDReferenceFor(IDPrefixBankAccountCreate, connectorID, ba.ID.String())
+	now := time.Now().UTC()
+	task := models.Task{
+		ID: models.TaskID{
+			Reference:   id,
+			ConnectorID: connectorID,
+		},
+		ConnectorID: &connectorID,
+		Status:      models.TASK_STATUS_PROCESSING,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	if err := e.storage.TasksUpsert(ctx, task); err != nil {
+		otel.RecordError(span, err)
+		return models.Task{}, err
+	}
+
+	run, err := e.temporalClient.ExecuteWorkflow(
+		ctx,
+		client.StartWorkflowOptions{
+			ID:                                       id,
+			TaskQueue:                                GetDefaultTaskQueue(e.stack),
+			WorkflowIDReusePolicy:                    enums.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
+			WorkflowExecutionErrorWhenAlreadyStarted: false,
+			SearchAttributes: map[string]interface{}{
+				workflow.SearchAttributeStack:       e.stack,
+				workflow.SearchAttributeConnectorID: connectorID.String(),
+			},
+		},
+		workflow.RunCreateBankAccount,
+		workflow.CreateBankAccount{
+			TaskID:      task.ID,
+			ConnectorID: connectorID,
+			BankAccount: ba,
+		},
+	)
+	if err != nil {
+		otel.RecordError(span, err)
+		return models.Task{}, err
 	}
 
-	// AccountsUpsert now handles outbox events transactionally
-	if err := e.storage.AccountsUpsert(ctx, []models.Account{account}); err != nil {
-		otel.RecordError(span, err)
-		return err
+	if waitResult {
+		// Wait for bank account creation to complete
+		if err := run.Get(ctx, nil); err != nil {
+			otel.RecordError(span, err)
+			return models.Task{}, err
+		}
 	}
 
-	return nil
+	return task, nil
 }
 
-func (e *engine) CreateFormancePayment(ctx context.Context, payment models.Payment) error {
-	ctx, span := otel.Tracer().Start(ctx, "engine.CreateFormancePayment")
+func (e *engine) VerifyBankAccount(ctx context.Context, ba models.BankAccount, connectorID models.ConnectorID, waitResult bool) (models.Task, error) {
+	ctx, span := otel.Tracer().Start(ctx, "engine.VerifyBankAccount")
 	defer span.End()
 
-	provider := models.ToV3Provider(payment.ConnectorID.Provider)
-	capabilities, err := registry.GetCapabilities(provider)
-	if err != nil {
+	if _, err := e.storage.ConnectorsGet(ctx, connectorID); err != nil {
 		otel.RecordError(span, err)
-		return err
-	}
-
-	found := false
-	for _, c := range capabilities {
-		if c == models.CAPABILITY_ALLOW_FORMANCE_PAYMENT_CREATION {
-			found = true
-			break
+		if errors.Is(err, storage.ErrNotFound) {
+			return models.Task{}, fmt.Errorf("connector %w", ErrNotFound)
 		}
+		return models.Task{}, err
 	}
 
-	if !found {
-		err := &ErrConnectorCapabilityNotSupported{Capability: "CreateFormancePayment", Provider: provider}
+	provider := models.ToV3Provider(connectorID.Provider)
+	capabilities, err := registry.GetCapabilities(provider)
+	if err != nil {
 		otel.RecordError(span, err)
-		return err
+		return models.Task{}, err
 	}
 
-	if err := e.storage.PaymentsUpsert(ctx, []models.Payment{payment}); err != nil {
+	if !slices.Contains(capabilities, models.CAPABILITY_VERIFY_BANK_ACCOUNT) {
+		err := &ErrConnectorCapabilityNotSupported{Capability: models.CAPABILITY_VERIFY_BANK_ACCOUNT.String(), Provider: provider}
 		otel.RecordError(span, err)
-		return err
+		return models.Task{}, err
 	}
 
-	// Payment events are now sent via outbox pattern in PaymentsUpsert
-	return nil
-}
+	// A bank account can be verified several times, each verification is
+	// kept in its history.
+	verificationID := uuid.New()
+	id := e.taskIDReferenceFor(IDPrefixBankAccountVerify, connectorID, verificationID.String())
+	now := time.Now().UTC()
+	task := models.Task{
+		ID: models.TaskID{
+			Reference:   id,
+			ConnectorID: connectorID,
+		},
+		ConnectorID: &connectorID,
+		Status:      models.TASK_STATUS_PROCESSING,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
 
-func (e *engine) CreateFormancePaymentInitiation(ctx context.Context, pi models.PaymentInitiation, adj models.PaymentInitiationAdjustment) error {
-	ctx, span := otel.Tracer().Start(ctx, "engine.CreateFormancePaymentInitiation")
-	defer span.End()
+	if err := e.storage.TasksUpsert(ctx, task); err != nil {
+		otel.RecordError(span, err)
+		return models.Task{}, err
+	}
 
-	if err := e.storage.PaymentInitiationsInsert(ctx, pi, adj); err != nil {
+	run, err := e.temporalClient.ExecuteWorkflow(
+		ctx,
+		client.StartWorkflowOptions{
+			ID:                                       id,
+			TaskQueue:                                GetDefaultTaskQueue(e.stack),
+			WorkflowIDReusePolicy:                    enums.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
+			WorkflowExecutionErrorWhenAlreadyStarted: false,
+			SearchAttributes: map[string]interface{}{
+				workflow.SearchAttributeStack:       e.stack,
+				workflow.SearchAttributeConnectorID: connectorID.String(),
+			},
+		},
+		workflow.RunVerifyBankAccount,
+		workflow.VerifyBankAccount{
+			TaskID:         task.ID,
+			ConnectorID:    connectorID,
+			BankAccount:    ba,
+			VerificationID: verificationID,
+		},
+	)
+	if err != nil {
 		otel.RecordError(span, err)
-		return err
+		return models.Task{}, err
 	}
 
-	// Payment initiation events are now sent via outbox pattern in PaymentInitiationsInsert
-	return nil
+	if waitResult {
+		// Wait for bank account verification to complete
+		if err := run.Get(ctx, nil); err != nil {
+			otel.RecordError(span, err)
+			return models.Task{}, handleWorkflowError(err)
+		}
+	}
+
+	return task, nil
 }
 
-func (e *engine) ForwardBankAccount(ctx context.Context, ba models.BankAccount, connectorID models.ConnectorID, waitResult bool) (models.Task, error) {
-	ctx, span := otel.Tracer().Start(ctx, "engine.ForwardBankAccount")
+func (e *engine) CreateAccount(ctx context.Context, connectorID models.ConnectorID, req models.CreateAccountRequest, waitResult bool) (models.Task, error) {
+	ctx, span := otel.Tracer().Start(ctx, "engine.CreateAccount")
 	defer span.End()
 
 	if _, err := e.storage.ConnectorsGet(ctx, connectorID); err != nil {
@@ -491,7 +1180,22 @@ func (e *engine) ForwardBankAccount(ctx context.Context, ba models.BankAccount,
 		return models.Task{}, err
 	}
 
-	id := e.taskIDReferenceFor(IDPrefixBankAccountCreate, connectorID, ba.ID.String())
+	provider := models.ToV3Provider(connectorID.Provider)
+	capabilities, err := registry.GetCapabilities(provider)
+	if err != nil {
+		otel.RecordError(span, err)
+		return models.Task{}, err
+	}
+
+	if !slices.Contains(capabilities, models.CAPABILITY_CREATE_ACCOUNT) {
+		err := &ErrConnectorCapabilityNotSupported{Capability: models.CAPABILITY_CREATE_ACCOUNT.String(), Provider: provider}
+		otel.RecordError(span, err)
+		return models.Task{}, err
+	}
+
+	// The reference given by the caller is unique per connector, creating the
+	// same account twice returns the task of the first creation.
+	id := e.taskIDReferenceFor(IDPrefixAccountCreate, connectorID, req.Reference)
 	now := time.Now().UTC()
 	task := models.Task{
 		ID: models.TaskID{
@@ -521,11 +1225,11 @@ func (e *engine) ForwardBankAccount(ctx context.Context, ba models.BankAccount,
 				workflow.SearchAttributeConnectorID: connectorID.String(),
 			},
 		},
-		workflow.RunCreateBankAccount,
-		workflow.CreateBankAccount{
+		workflow.RunCreateAccount,
+		workflow.CreateAccount{
 			TaskID:      task.ID,
 			ConnectorID: connectorID,
-			BankAccount: ba,
+			Request:     req,
 		},
 	)
 	if err != nil {
@@ -534,10 +1238,10 @@ func (e *engine) ForwardBankAccount(ctx context.Context, ba models.BankAccount,
 	}
 
 	if waitResult {
-		// Wait for bank account creation to complete
+		// Wait for account creation to complete
 		if err := run.Get(ctx, nil); err != nil {
 			otel.RecordError(span, err)
-			return models.Task{}, err
+			return models.Task{}, handleWorkflowError(err)
 		}
 	}
 
@@ -559,7 +1263,7 @@ func (e *engine) CreateTransfer(ctx context.Context, piID models.PaymentInitiati
 	ctx, span := otel.Tracer().Start(ctx, "engine.CreateTransfer")
 	defer span.End()
 
-	id := models.TaskIDReference(fmt.Sprintf("create-transfer-%s-%d", e.stack, attempt), piID.ConnectorID, piID.String())
+	id := e.paymentInitiationTaskIDReferenceFor(IDPrefixTransferCreate, piID, attempt)
 
 	now := time.Now().UTC()
 	task := models.Task{
@@ -682,7 +1386,7 @@ func (e *engine) CreatePayout(ctx context.Context, piID models.PaymentInitiation
 	ctx, span := otel.Tracer().Start(ctx, "engine.CreatePayout")
 	defer span.End()
 
-	id := models.TaskIDReference(fmt.Sprintf("create-payout-%s-%d", e.stack, attempt), piID.ConnectorID, piID.String())
+	id := e.paymentInitiationTaskIDReferenceFor(IDPrefixPayoutCreate, piID, attempt)
 
 	now := time.Now().UTC()
 	task := models.Task{
@@ -802,6 +1506,35 @@ func (e *engine) ReversePayout(ctx context.Context, reversal models.PaymentIniti
 	return task, nil
 }
 
+func (e *engine) SubmitScreeningResult(ctx context.Context, pi models.PaymentInitiation, attempt int, result models.ScreeningResult) error {
+	ctx, span := otel.Tracer().Start(ctx, "engine.SubmitScreeningResult")
+	defer span.End()
+
+	var prefix string
+	switch pi.Type {
+	case models.PAYMENT_INITIATION_TYPE_TRANSFER:
+		prefix = IDPrefixTransferCreate
+	case models.PAYMENT_INITIATION_TYPE_PAYOUT:
+		prefix = IDPrefixPayoutCreate
+	default:
+		err := fmt.Errorf("unsupported payment initiation type %s: %w", pi.Type, ErrValidation)
+		otel.RecordError(span, err)
+		return err
+	}
+
+	id := e.paymentInitiationTaskIDReferenceFor(prefix, pi.ID, attempt)
+	if err := e.temporalClient.SignalWorkflow(ctx, id, "", workflow.SignalScreeningResult, result); err != nil {
+		otel.RecordError(span, err)
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			return fmt.Errorf("payment initiation workflow %w", ErrNotFound)
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (e *engine) ForwardPaymentServiceUser(ctx context.Context, psuID uuid.UUID, connectorID models.ConnectorID) error {
 	ctx, span := otel.Tracer().Start(ctx, "engine.ForwardPaymentServiceUser")
 	defer span.End()
@@ -1098,6 +1831,238 @@ func (e *engine) CreatePaymentServiceUserLink(ctx context.Context, applicationNa
 	return attempt.ID.String(), resp.Link, nil
 }
 
+func (e *engine) CreatePaymentServiceUserPaymentLink(ctx context.Context, applicationName string, psuID uuid.UUID, pi models.PaymentInitiation, bankAccountID uuid.UUID, idempotencyKey *uuid.UUID, ClientRedirectURL *string) (string, string, error) {
+	ctx, span := otel.Tracer().Start(ctx, "engine.CreateUserPaymentLink")
+	defer span.End()
+
+	connectorID := pi.ConnectorID
+	plugin, err := e.connectors.Get(connectorID)
+	if err != nil {
+		otel.RecordError(span, err)
+		if errors.Is(err, connectors.ErrNotFound) {
+			return "", "", fmt.Errorf("connector %w", ErrNotFound)
+		}
+		return "", "", err
+	}
+
+	if idempotencyKey != nil {
+		// A retried call must not create a second payment initiation nor a
+		// second payment request on the provider: return the link of the
+		// attempt created by the first call.
+		attempt, err := e.storage.OpenBankingPaymentAttemptsGet(ctx, *idempotencyKey)
+		switch {
+		case err == nil:
+			link, err := existingPaymentLink(attempt, psuID, pi.ID)
+			if err != nil {
+				otel.RecordError(span, err)
+				return "", "", err
+			}
+			return attempt.ID.String(), link, nil
+		case !errors.Is(err, storage.ErrNotFound):
+			otel.RecordError(span, err)
+			return "", "", err
+		}
+	}
+
+	psu, err := e.storage.PaymentServiceUsersGet(ctx, psuID)
+	if err != nil {
+		otel.RecordError(span, err)
+		return "", "", err
+	}
+
+	openBankingForwardedUser, err := e.storage.OpenBankingForwardedUserGet(ctx, psuID, connectorID)
+	if err != nil {
+		otel.RecordError(span, err)
+		return "", "", err
+	}
+
+	bankAccount, err := e.storage.BankAccountsGet(ctx, bankAccountID, true)
+	if err != nil {
+		otel.RecordError(span, err)
+		return "", "", err
+	}
+
+	id := uuid.New()
+	if idempotencyKey != nil {
+		id = *idempotencyKey
+	}
+
+	now := time.Now().UTC()
+
+	// The beneficiary of the payment is a formance bank account, we need to
+	// create the related external account on the connector in order to link
+	// it to the payment initiation.
+	destinationAccount := models.Account{
+		ID: models.AccountID{
+			Reference:   bankAccount.ID.String(),
+			ConnectorID: connectorID,
+		},
+		ConnectorID: connectorID,
+		Reference:   bankAccount.ID.String(),
+		CreatedAt:   now,
+		Type:        models.ACCOUNT_TYPE_EXTERNAL,
+		Name:        &bankAccount.Name,
+		Metadata:    make(map[string]string),
+	}
+	models.FillBankAccountDetailsToAccountMetadata(&destinationAccount, bankAccount)
+
+	detachedCtx := context.WithoutCancel(ctx)
+	e.wg.Add(1)
+	defer e.wg.Done()
+
+	if err := e.storage.AccountsUpsert(detachedCtx, []models.Account{destinationAccount}); err != nil {
+		otel.RecordError(span, err)
+		return "", "", err
+	}
+
+	if err := e.storage.BankAccountsAddRelatedAccount(detachedCtx, bankAccount.ID, models.BankAccountRelatedAccount{
+		AccountID: destinationAccount.ID,
+		CreatedAt: now,
+	}); err != nil {
+		otel.RecordError(span, err)
+		return "", "", err
+	}
+
+	pi.DestinationAccountID = &destinationAccount.ID
+	if err := e.storage.PaymentInitiationsInsert(detachedCtx, pi, models.PaymentInitiationAdjustment{
+		ID: models.PaymentInitiationAdjustmentID{
+			PaymentInitiationID: pi.ID,
+			CreatedAt:           pi.CreatedAt,
+			Status:              models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_WAITING_FOR_VALIDATION,
+		},
+		CreatedAt: pi.CreatedAt,
+		Status:    models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_WAITING_FOR_VALIDATION,
+		Amount:    pi.Amount,
+		Asset:     &pi.Asset,
+	}); err != nil {
+		otel.RecordError(span, err)
+		return "", "", err
+	}
+
+	attempt := models.OpenBankingPaymentAttempt{
+		ID:                  id,
+		PsuID:               psuID,
+		ConnectorID:         connectorID,
+		PaymentInitiationID: pi.ID,
+		CreatedAt:           now,
+		Status:              models.OpenBankingConnectionAttemptStatusPending,
+		State: models.CallbackState{
+			Randomized:        uuid.New().String(),
+			AttemptID:         id,
+			PaymentInitiation: true,
+		},
+		ClientRedirectURL: ClientRedirectURL,
+	}
+
+	err = e.storage.OpenBankingPaymentAttemptsUpsert(
+		detachedCtx,
+		attempt,
+	)
+	if err != nil {
+		otel.RecordError(span, err)
+		return "", "", err
+	}
+
+	webhookBaseURL, err := utils.GetWebhookBaseURL(e.stackPublicURL, connectorID)
+	if err != nil {
+		return "", "", fmt.Errorf("joining webhook base URL: %w", err)
+	}
+
+	formanceRedirectURL, err := utils.GetFormanceRedirectURL(e.stackPublicURL, connectorID)
+	if err != nil {
+		return "", "", fmt.Errorf("joining formance redirect URI: %w", err)
+	}
+
+	resp, err := plugin.CreateUserPaymentLink(detachedCtx, models.CreateUserPaymentLinkRequest{
+		ApplicationName:          applicationName,
+		AttemptID:                attempt.ID.String(),
+		PaymentServiceUser:       models.ToPSPPaymentServiceUser(psu),
+		OpenBankingForwardedUser: openBankingForwardedUser,
+		PaymentInitiation: models.FromPaymentInitiationToPSPPaymentInitiation(
+			&pi,
+			nil,
+			models.ToPSPAccount(&destinationAccount),
+		),
+		ClientRedirectURL:   ClientRedirectURL,
+		FormanceRedirectURL: &formanceRedirectURL,
+		CallBackState:       attempt.State.String(),
+		WebhookBaseURL:      webhookBaseURL,
+	})
+	if err != nil {
+		otel.RecordError(span, err)
+
+		errMsg := err.Error()
+		attempt.Status = models.OpenBankingConnectionAttemptStatusExited
+		attempt.Error = &errMsg
+		if errUpsert := e.storage.OpenBankingPaymentAttemptsUpsert(detachedCtx, attempt); errUpsert != nil {
+			return "", "", errUpsert
+		}
+
+		if errUpsert := e.storage.PaymentInitiationAdjustmentsUpsert(detachedCtx, models.PaymentInitiationAdjustment{
+			ID: models.PaymentInitiationAdjustmentID{
+				PaymentInitiationID: pi.ID,
+				CreatedAt:           time.Now().UTC(),
+				Status:              models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_FAILED,
+			},
+			CreatedAt: time.Now().UTC(),
+			Status:    models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_FAILED,
+			Amount:    pi.Amount,
+			Asset:     &pi.Asset,
+			Error:     err,
+		}); errUpsert != nil {
+			return "", "", errUpsert
+		}
+
+		return "", "", handlePluginErrors(err)
+	}
+
+	attempt.PaymentRequestReference = &resp.PaymentRequestReference
+	attempt.Link = &resp.Link
+	err = e.storage.OpenBankingPaymentAttemptsUpsert(
+		detachedCtx,
+		attempt,
+	)
+	if err != nil {
+		return "", "", err
+	}
+
+	// The payout does not go through the payment initiation approval flow:
+	// the payment service user authorizes it themselves at their bank when
+	// following the link, so it goes straight to processing.
+	processingAt := time.Now().UTC()
+	err = e.storage.PaymentInitiationAdjustmentsUpsert(detachedCtx, models.PaymentInitiationAdjustment{
+		ID: models.PaymentInitiationAdjustmentID{
+			PaymentInitiationID: pi.ID,
+			CreatedAt:           processingAt,
+			Status:              models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PROCESSING,
+		},
+		CreatedAt: processingAt,
+		Status:    models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PROCESSING,
+		Amount:    pi.Amount,
+		Asset:     &pi.Asset,
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	return attempt.ID.String(), resp.Link, nil
+}
+
+func existingPaymentLink(attempt *models.OpenBankingPaymentAttempt, psuID uuid.UUID, piID models.PaymentInitiationID) (string, error) {
+	if attempt.PsuID != psuID || attempt.PaymentInitiationID != piID {
+		return "", fmt.Errorf("idempotency key already used for another payment link: %w", ErrValidation)
+	}
+
+	if attempt.Link == nil {
+		if attempt.Error != nil {
+			return "", fmt.Errorf("payment link creation failed for this idempotency key: %s: %w", *attempt.Error, ErrValidation)
+		}
+		return "", fmt.Errorf("payment link creation still in progress for this idempotency key: %w", ErrValidation)
+	}
+
+	return *attempt.Link, nil
+}
+
 func (e *engine) UpdatePaymentServiceUserLink(ctx context.Context, applicationName string, psuID uuid.UUID, connectorID models.ConnectorID, connectionID string, idempotencyKey *uuid.UUID, ClientRedirectURL *string) (string, string, error) {
 	ctx, span := otel.Tracer().Start(ctx, "engine.UpdateUserLink")
 	defer span.End()
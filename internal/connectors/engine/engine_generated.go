@@ -57,6 +57,21 @@ func (mr *MockEngineMockRecorder) AddAccountToPool(ctx, id, accountID any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAccountToPool", reflect.TypeOf((*MockEngine)(nil).AddAccountToPool), ctx, id, accountID)
 }
 
+// BackfillConnector mocks base method.
+func (m *MockEngine) BackfillConnector(ctx context.Context, connectorID models.ConnectorID, capability models.Capability, window models.FetchWindow) (models.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackfillConnector", ctx, connectorID, capability, window)
+	ret0, _ := ret[0].(models.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BackfillConnector indicates an expected call of BackfillConnector.
+func (mr *MockEngineMockRecorder) BackfillConnector(ctx, connectorID, capability, window any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackfillConnector", reflect.TypeOf((*MockEngine)(nil).BackfillConnector), ctx, connectorID, capability, window)
+}
+
 // CompletePaymentServiceUserLink mocks base method.
 func (m *MockEngine) CompletePaymentServiceUserLink(ctx context.Context, connectorID models.ConnectorID, attemptID uuid.UUID, httpCallInformation models.HTTPCallInformation) error {
 	m.ctrl.T.Helper()
@@ -71,6 +86,21 @@ func (mr *MockEngineMockRecorder) CompletePaymentServiceUserLink(ctx, connectorI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompletePaymentServiceUserLink", reflect.TypeOf((*MockEngine)(nil).CompletePaymentServiceUserLink), ctx, connectorID, attemptID, httpCallInformation)
 }
 
+// CreateAccount mocks base method.
+func (m *MockEngine) CreateAccount(ctx context.Context, connectorID models.ConnectorID, req models.CreateAccountRequest, waitResult bool) (models.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccount", ctx, connectorID, req, waitResult)
+	ret0, _ := ret[0].(models.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccount indicates an expected call of CreateAccount.
+func (mr *MockEngineMockRecorder) CreateAccount(ctx, connectorID, req, waitResult any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockEngine)(nil).CreateAccount), ctx, connectorID, req, waitResult)
+}
+
 // CreateFormanceAccount mocks base method.
 func (m *MockEngine) CreateFormanceAccount(ctx context.Context, account models.Account) error {
 	m.ctrl.T.Helper()
@@ -129,6 +159,22 @@ func (mr *MockEngineMockRecorder) CreatePaymentServiceUserLink(ctx, applicationN
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentServiceUserLink", reflect.TypeOf((*MockEngine)(nil).CreatePaymentServiceUserLink), ctx, applicationName, psuID, connectorID, idempotencyKey, ClientRedirectURL)
 }
 
+// CreatePaymentServiceUserPaymentLink mocks base method.
+func (m *MockEngine) CreatePaymentServiceUserPaymentLink(ctx context.Context, applicationName string, psuID uuid.UUID, pi models.PaymentInitiation, bankAccountID uuid.UUID, idempotencyKey *uuid.UUID, ClientRedirectURL *string) (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePaymentServiceUserPaymentLink", ctx, applicationName, psuID, pi, bankAccountID, idempotencyKey, ClientRedirectURL)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreatePaymentServiceUserPaymentLink indicates an expected call of CreatePaymentServiceUserPaymentLink.
+func (mr *MockEngineMockRecorder) CreatePaymentServiceUserPaymentLink(ctx, applicationName, psuID, pi, bankAccountID, idempotencyKey, ClientRedirectURL any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentServiceUserPaymentLink", reflect.TypeOf((*MockEngine)(nil).CreatePaymentServiceUserPaymentLink), ctx, applicationName, psuID, pi, bankAccountID, idempotencyKey, ClientRedirectURL)
+}
+
 // CreatePayout mocks base method.
 func (m *MockEngine) CreatePayout(ctx context.Context, piID models.PaymentInitiationID, attempt int, waitResult bool) (models.Task, error) {
 	m.ctrl.T.Helper()
@@ -316,6 +362,20 @@ func (mr *MockEngineMockRecorder) OnStop(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnStop", reflect.TypeOf((*MockEngine)(nil).OnStop), ctx)
 }
 
+// PauseSchedule mocks base method.
+func (m *MockEngine) PauseSchedule(ctx context.Context, connectorID models.ConnectorID, scheduleID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseSchedule", ctx, connectorID, scheduleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseSchedule indicates an expected call of PauseSchedule.
+func (mr *MockEngineMockRecorder) PauseSchedule(ctx, connectorID, scheduleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseSchedule", reflect.TypeOf((*MockEngine)(nil).PauseSchedule), ctx, connectorID, scheduleID)
+}
+
 // RemoveAccountFromPool mocks base method.
 func (m *MockEngine) RemoveAccountFromPool(ctx context.Context, id uuid.UUID, accountID models.AccountID) error {
 	m.ctrl.T.Helper()
@@ -345,6 +405,20 @@ func (mr *MockEngineMockRecorder) ResetConnector(ctx, connectorID any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetConnector", reflect.TypeOf((*MockEngine)(nil).ResetConnector), ctx, connectorID)
 }
 
+// ResumeSchedule mocks base method.
+func (m *MockEngine) ResumeSchedule(ctx context.Context, connectorID models.ConnectorID, scheduleID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeSchedule", ctx, connectorID, scheduleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeSchedule indicates an expected call of ResumeSchedule.
+func (mr *MockEngineMockRecorder) ResumeSchedule(ctx, connectorID, scheduleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeSchedule", reflect.TypeOf((*MockEngine)(nil).ResumeSchedule), ctx, connectorID, scheduleID)
+}
+
 // ReversePayout mocks base method.
 func (m *MockEngine) ReversePayout(ctx context.Context, reversal models.PaymentInitiationReversal, waitResult bool) (models.Task, error) {
 	m.ctrl.T.Helper()
@@ -375,6 +449,79 @@ func (mr *MockEngineMockRecorder) ReverseTransfer(ctx, reversal, waitResult any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReverseTransfer", reflect.TypeOf((*MockEngine)(nil).ReverseTransfer), ctx, reversal, waitResult)
 }
 
+// RotateConnectorCredentials mocks base method.
+func (m *MockEngine) RotateConnectorCredentials(ctx context.Context, connectorID models.ConnectorID, credentials json.RawMessage) (models.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateConnectorCredentials", ctx, connectorID, credentials)
+	ret0, _ := ret[0].(models.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateConnectorCredentials indicates an expected call of RotateConnectorCredentials.
+func (mr *MockEngineMockRecorder) RotateConnectorCredentials(ctx, connectorID, credentials any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateConnectorCredentials", reflect.TypeOf((*MockEngine)(nil).RotateConnectorCredentials), ctx, connectorID, credentials)
+}
+
+// SubmitScreeningResult mocks base method.
+func (m *MockEngine) SubmitScreeningResult(ctx context.Context, pi models.PaymentInitiation, attempt int, result models.ScreeningResult) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitScreeningResult", ctx, pi, attempt, result)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubmitScreeningResult indicates an expected call of SubmitScreeningResult.
+func (mr *MockEngineMockRecorder) SubmitScreeningResult(ctx, pi, attempt, result any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitScreeningResult", reflect.TypeOf((*MockEngine)(nil).SubmitScreeningResult), ctx, pi, attempt, result)
+}
+
+// SyncConnector mocks base method.
+func (m *MockEngine) SyncConnector(ctx context.Context, connectorID models.ConnectorID) (models.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncConnector", ctx, connectorID)
+	ret0, _ := ret[0].(models.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncConnector indicates an expected call of SyncConnector.
+func (mr *MockEngineMockRecorder) SyncConnector(ctx, connectorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncConnector", reflect.TypeOf((*MockEngine)(nil).SyncConnector), ctx, connectorID)
+}
+
+// TestConnector mocks base method.
+func (m *MockEngine) TestConnector(ctx context.Context, provider string, rawConfig json.RawMessage) (models.ConnectorTestResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestConnector", ctx, provider, rawConfig)
+	ret0, _ := ret[0].(models.ConnectorTestResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TestConnector indicates an expected call of TestConnector.
+func (mr *MockEngineMockRecorder) TestConnector(ctx, provider, rawConfig any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestConnector", reflect.TypeOf((*MockEngine)(nil).TestConnector), ctx, provider, rawConfig)
+}
+
+// TriggerSchedule mocks base method.
+func (m *MockEngine) TriggerSchedule(ctx context.Context, connectorID models.ConnectorID, scheduleID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TriggerSchedule", ctx, connectorID, scheduleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TriggerSchedule indicates an expected call of TriggerSchedule.
+func (mr *MockEngineMockRecorder) TriggerSchedule(ctx, connectorID, scheduleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerSchedule", reflect.TypeOf((*MockEngine)(nil).TriggerSchedule), ctx, connectorID, scheduleID)
+}
+
 // UninstallConnector mocks base method.
 func (m *MockEngine) UninstallConnector(ctx context.Context, connectorID models.ConnectorID) (models.Task, error) {
 	m.ctrl.T.Helper()
@@ -433,3 +580,33 @@ func (mr *MockEngineMockRecorder) UpdatePoolQuery(ctx, id, query any) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePoolQuery", reflect.TypeOf((*MockEngine)(nil).UpdatePoolQuery), ctx, id, query)
 }
+
+// ValidateConnectorConfig mocks base method.
+func (m *MockEngine) ValidateConnectorConfig(ctx context.Context, provider string, rawConfig json.RawMessage) (json.RawMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateConnectorConfig", ctx, provider, rawConfig)
+	ret0, _ := ret[0].(json.RawMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateConnectorConfig indicates an expected call of ValidateConnectorConfig.
+func (mr *MockEngineMockRecorder) ValidateConnectorConfig(ctx, provider, rawConfig any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateConnectorConfig", reflect.TypeOf((*MockEngine)(nil).ValidateConnectorConfig), ctx, provider, rawConfig)
+}
+
+// VerifyBankAccount mocks base method.
+func (m *MockEngine) VerifyBankAccount(ctx context.Context, ba models.BankAccount, connectorID models.ConnectorID, waitResult bool) (models.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyBankAccount", ctx, ba, connectorID, waitResult)
+	ret0, _ := ret[0].(models.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyBankAccount indicates an expected call of VerifyBankAccount.
+func (mr *MockEngineMockRecorder) VerifyBankAccount(ctx, ba, connectorID, waitResult any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyBankAccount", reflect.TypeOf((*MockEngine)(nil).VerifyBankAccount), ctx, ba, connectorID, waitResult)
+}
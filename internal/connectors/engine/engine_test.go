@@ -1,23 +1,30 @@
 package engine_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/formancehq/go-libs/v5/pkg/observe/log"
 	"github.com/formancehq/go-libs/v5/pkg/storage/bun/paginate"
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
 	"github.com/formancehq/payments/internal/connectors"
 	"github.com/formancehq/payments/internal/connectors/engine"
 	"github.com/formancehq/payments/internal/connectors/engine/activities"
 	"github.com/formancehq/payments/internal/connectors/engine/workflow"
+	"github.com/formancehq/payments/internal/connectors/plugins"
+	"github.com/formancehq/payments/internal/connectors/plugins/registry"
 	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/httpwrapper"
 	"github.com/formancehq/payments/pkg/domain/models"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/sdk/client"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -210,6 +217,24 @@ var _ = Describe("Engine Tests", func() {
 			_, err := eng.InstallConnector(ctx, "psp", config)
 			Expect(err).To(BeNil())
 		})
+
+		It("should store the environment of the config", func(ctx SpecContext) {
+			manager.EXPECT().Load(gomock.Any(), false, true).Return("connectorname", json.RawMessage(`{"name":"connectorname","environment":"sandbox"}`), nil)
+			manager.EXPECT().GetConfig(gomock.Any()).Return(models.Config{}, nil)
+			store.EXPECT().ConnectorsInstall(gomock.Any(), gomock.Any(), gomock.Nil()).DoAndReturn(
+				func(_ context.Context, connector models.Connector, _ *models.ConnectorID) error {
+					Expect(connector.Environment).To(Equal("sandbox"))
+					return nil
+				},
+			)
+			cl.EXPECT().ExecuteWorkflow(gomock.Any(), WithWorkflowOptions(engine.IDPrefixConnectorInstall, defaultTaskQueue),
+				workflow.RunInstallConnector,
+				gomock.AssignableToTypeOf(workflow.InstallConnector{}),
+			).Return(wr, nil)
+			wr.EXPECT().Get(gomock.Any(), nil).Return(nil)
+			_, err := eng.InstallConnector(ctx, "psp", config)
+			Expect(err).To(BeNil())
+		})
 	})
 
 	Context("uninstalling a connector", func() {
@@ -315,6 +340,386 @@ var _ = Describe("Engine Tests", func() {
 		})
 	})
 
+	Context("syncing a connector", func() {
+		var (
+			connID models.ConnectorID
+		)
+		BeforeEach(func() {
+			connID = models.ConnectorID{Reference: uuid.New(), Provider: "dummypay"}
+		})
+
+		It("should return not found error when connector does not exist", func(ctx SpecContext) {
+			store.EXPECT().ConnectorsGet(gomock.Any(), connID).Return(nil, storage.ErrNotFound)
+			_, err := eng.SyncConnector(ctx, connID)
+			Expect(err).NotTo(BeNil())
+			Expect(err).To(MatchError(engine.ErrNotFound))
+		})
+
+		It("calls task upsert twice on workflow failure", func(ctx SpecContext) {
+			expectedErr := fmt.Errorf("workflow err")
+			store.EXPECT().ConnectorsGet(gomock.Any(), connID).Return(&models.Connector{}, nil)
+			store.EXPECT().TasksUpsert(gomock.Any(), gomock.AssignableToTypeOf(models.Task{})).Return(nil).MinTimes(2)
+			cl.EXPECT().ExecuteWorkflow(gomock.Any(), WithWorkflowOptions(engine.IDPrefixConnectorSync, defaultTaskQueue),
+				workflow.RunSyncConnector,
+				gomock.AssignableToTypeOf(workflow.SyncConnector{}),
+			).Return(nil, expectedErr)
+
+			_, err := eng.SyncConnector(ctx, connID)
+			Expect(err).NotTo(BeNil())
+			Expect(err).To(MatchError(expectedErr))
+		})
+
+		It("returns a task without waiting for workflow run", func(ctx SpecContext) {
+			store.EXPECT().ConnectorsGet(gomock.Any(), connID).Return(&models.Connector{}, nil)
+			store.EXPECT().TasksUpsert(gomock.Any(), gomock.AssignableToTypeOf(models.Task{})).Return(nil)
+			cl.EXPECT().ExecuteWorkflow(gomock.Any(), WithWorkflowOptions(engine.IDPrefixConnectorSync, defaultTaskQueue),
+				workflow.RunSyncConnector,
+				gomock.AssignableToTypeOf(workflow.SyncConnector{}),
+			).Return(nil, nil)
+
+			task, err := eng.SyncConnector(ctx, connID)
+			Expect(err).To(BeNil())
+			Expect(task.ID.Reference).To(ContainSubstring(engine.IDPrefixConnectorSync))
+			Expect(task.ID.ConnectorID).To(Equal(connID))
+			Expect(*task.ConnectorID).To(Equal(connID))
+			Expect(task.Status).To(Equal(models.TASK_STATUS_PROCESSING))
+		})
+	})
+
+	Context("backfilling a connector", func() {
+		var (
+			connID models.ConnectorID
+			window models.FetchWindow
+		)
+		BeforeEach(func() {
+			connID = models.ConnectorID{Reference: uuid.New(), Provider: "dummypay"}
+			window = models.FetchWindow{
+				From: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				To:   time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			}
+		})
+
+		It("should return validation error when window is invalid", func(ctx SpecContext) {
+			_, err := eng.BackfillConnector(ctx, connID, models.CAPABILITY_FETCH_PAYMENTS, models.FetchWindow{From: window.To, To: window.From})
+			Expect(err).NotTo(BeNil())
+			Expect(err).To(MatchError(engine.ErrValidation))
+		})
+
+		It("should return validation error when capability cannot be backfilled", func(ctx SpecContext) {
+			_, err := eng.BackfillConnector(ctx, connID, models.CAPABILITY_FETCH_BALANCES, window)
+			Expect(err).NotTo(BeNil())
+			Expect(err).To(MatchError(engine.ErrValidation))
+		})
+
+		It("should return not found error when connector does not exist", func(ctx SpecContext) {
+			store.EXPECT().ConnectorsGet(gomock.Any(), connID).Return(nil, storage.ErrNotFound)
+			_, err := eng.BackfillConnector(ctx, connID, models.CAPABILITY_FETCH_PAYMENTS, window)
+			Expect(err).NotTo(BeNil())
+			Expect(err).To(MatchError(engine.ErrNotFound))
+		})
+
+		It("calls task upsert twice on workflow failure", func(ctx SpecContext) {
+			expectedErr := fmt.Errorf("workflow err")
+			store.EXPECT().ConnectorsGet(gomock.Any(), connID).Return(&models.Connector{}, nil)
+			store.EXPECT().TasksUpsert(gomock.Any(), gomock.AssignableToTypeOf(models.Task{})).Return(nil).MinTimes(2)
+			cl.EXPECT().ExecuteWorkflow(gomock.Any(), WithWorkflowOptions(engine.IDPrefixConnectorBackfill, defaultTaskQueue),
+				workflow.RunBackfillConnector,
+				gomock.AssignableToTypeOf(workflow.BackfillConnector{}),
+			).Return(nil, expectedErr)
+
+			_, err := eng.BackfillConnector(ctx, connID, models.CAPABILITY_FETCH_PAYMENTS, window)
+			Expect(err).NotTo(BeNil())
+			Expect(err).To(MatchError(expectedErr))
+		})
+
+		It("returns a task without waiting for workflow run", func(ctx SpecContext) {
+			store.EXPECT().ConnectorsGet(gomock.Any(), connID).Return(&models.Connector{}, nil)
+			store.EXPECT().TasksUpsert(gomock.Any(), gomock.AssignableToTypeOf(models.Task{})).Return(nil)
+			cl.EXPECT().ExecuteWorkflow(gomock.Any(), WithWorkflowOptions(engine.IDPrefixConnectorBackfill, defaultTaskQueue),
+				workflow.RunBackfillConnector,
+				gomock.AssignableToTypeOf(workflow.BackfillConnector{}),
+			).DoAndReturn(func(_ context.Context, _ client.StartWorkflowOptions, _ interface{}, args ...interface{}) (client.WorkflowRun, error) {
+				req := args[0].(workflow.BackfillConnector)
+				Expect(req.ConnectorID).To(Equal(connID))
+				Expect(req.Capability).To(Equal(models.CAPABILITY_FETCH_PAYMENTS))
+				Expect(req.Window).To(Equal(window))
+				return nil, nil
+			})
+
+			task, err := eng.BackfillConnector(ctx, connID, models.CAPABILITY_FETCH_PAYMENTS, window)
+			Expect(err).To(BeNil())
+			Expect(task.ID.Reference).To(ContainSubstring(engine.IDPrefixConnectorBackfill))
+			Expect(task.Status).To(Equal(models.TASK_STATUS_PROCESSING))
+		})
+	})
+
+	Context("rotating connector credentials", func() {
+		var (
+			connID      models.ConnectorID
+			connector   *models.Connector
+			credentials json.RawMessage
+		)
+		BeforeEach(func() {
+			connID = models.ConnectorID{Reference: uuid.New(), Provider: "dummypay"}
+			connector = &models.Connector{
+				ConnectorBase: models.ConnectorBase{
+					ID:       connID,
+					Name:     "somename",
+					Provider: connID.Provider,
+				},
+				Config: json.RawMessage(`{"name":"somename","pollingPeriod":"30m","apiKey":"old","directory":"/tmp"}`),
+			}
+			credentials = json.RawMessage(`{"apiKey":"new"}`)
+		})
+
+		It("should return not found error when connector does not exist", func(ctx SpecContext) {
+			store.EXPECT().ConnectorsGet(gomock.Any(), connID).Return(nil, storage.ErrNotFound)
+			_, err := eng.RotateConnectorCredentials(ctx, connID, credentials)
+			Expect(err).NotTo(BeNil())
+			Expect(err).To(MatchError(engine.ErrNotFound))
+		})
+
+		DescribeTable("should return validation error when credentials are invalid",
+			func(ctx SpecContext, credentials string) {
+				store.EXPECT().ConnectorsGet(gomock.Any(), connID).Return(connector, nil)
+				_, err := eng.RotateConnectorCredentials(ctx, connID, json.RawMessage(credentials))
+				Expect(err).NotTo(BeNil())
+				Expect(err).To(MatchError(engine.ErrValidation))
+			},
+			Entry("no credentials", `{}`),
+			Entry("not an object", `"apiKey"`),
+			Entry("generic connector setting", `{"pollingPeriod":"1m"}`),
+			Entry("unknown field", `{"apiKye":"new"}`),
+		)
+
+		It("should return validation error when the plugin rejects the config", func(ctx SpecContext) {
+			store.EXPECT().ConnectorsGet(gomock.Any(), connID).Return(connector, nil)
+			manager.EXPECT().Validate(gomock.Any()).Return("", nil, models.ErrInvalidConfig)
+			_, err := eng.RotateConnectorCredentials(ctx, connID, credentials)
+			Expect(err).NotTo(BeNil())
+			Expect(err).To(MatchError(engine.ErrValidation))
+		})
+
+		It("calls task upsert twice on workflow failure", func(ctx SpecContext) {
+			expectedErr := fmt.Errorf("workflow err")
+			store.EXPECT().ConnectorsGet(gomock.Any(), connID).Return(connector, nil)
+			manager.EXPECT().Validate(gomock.Any()).Return("somename", json.RawMessage(`{}`), nil)
+			store.EXPECT().EncryptRaw(gomock.Any(), gomock.Any()).Return(json.RawMessage(`"encrypted"`), nil)
+			store.EXPECT().TasksUpsert(gomock.Any(), gomock.AssignableToTypeOf(models.Task{})).Return(nil).MinTimes(2)
+			cl.EXPECT().ExecuteWorkflow(gomock.Any(), WithWorkflowOptions(engine.IDPrefixConnectorCredentialsRotation, defaultTaskQueue),
+				workflow.RunRotateConnectorCredentials,
+				gomock.AssignableToTypeOf(workflow.RotateConnectorCredentials{}),
+			).Return(nil, expectedErr)
+
+			_, err := eng.RotateConnectorCredentials(ctx, connID, credentials)
+			Expect(err).NotTo(BeNil())
+			Expect(err).To(MatchError(expectedErr))
+		})
+
+		It("validates the merged config and passes it encrypted to the workflow", func(ctx SpecContext) {
+			validated := json.RawMessage(`{"name":"somename","pollingPeriod":"30m0s","apiKey":"new","directory":"/tmp"}`)
+			store.EXPECT().ConnectorsGet(gomock.Any(), connID).Return(connector, nil)
+			manager.EXPECT().Validate(gomock.Any()).DoAndReturn(func(c models.Connector) (string, json.RawMessage, error) {
+				Expect(c.ID).To(Equal(connID))
+				Expect(string(c.Config)).To(MatchJSON(`{"name":"somename","pollingPeriod":"30m","apiKey":"new","directory":"/tmp"}`))
+				return "somename", validated, nil
+			})
+			store.EXPECT().EncryptRaw(gomock.Any(), validated).Return(json.RawMessage(`"encrypted"`), nil)
+			store.EXPECT().TasksUpsert(gomock.Any(), gomock.AssignableToTypeOf(models.Task{})).Return(nil)
+			cl.EXPECT().ExecuteWorkflow(gomock.Any(), WithWorkflowOptions(engine.IDPrefixConnectorCredentialsRotation, defaultTaskQueue),
+				workflow.RunRotateConnectorCredentials,
+				gomock.AssignableToTypeOf(workflow.RotateConnectorCredentials{}),
+			).DoAndReturn(func(_ context.Context, _ client.StartWorkflowOptions, _ interface{}, args ...interface{}) (client.WorkflowRun, error) {
+				req := args[0].(workflow.RotateConnectorCredentials)
+				Expect(req.Connector.ID).To(Equal(connID))
+				Expect(string(req.Connector.Config)).To(Equal(`"encrypted"`))
+				Expect(req.Fields).To(Equal([]string{"apiKey"}))
+				return nil, nil
+			})
+
+			task, err := eng.RotateConnectorCredentials(ctx, connID, credentials)
+			Expect(err).To(BeNil())
+			Expect(task.ID.Reference).To(ContainSubstring(engine.IDPrefixConnectorCredentialsRotation))
+			Expect(task.Status).To(Equal(models.TASK_STATUS_PROCESSING))
+		})
+	})
+
+	Context("testing a connector", func() {
+		var (
+			provider string
+			config   json.RawMessage
+			plugin   *models.MockPlugin
+		)
+		BeforeEach(func() {
+			plugin = models.NewMockPlugin(gomock.NewController(GinkgoT()))
+			plugin.EXPECT().Name().Return("somename").AnyTimes()
+
+			provider = "connector-test-" + uuid.NewString()
+			registry.RegisterPlugin(provider, models.PluginTypePSP, func(models.ConnectorID, string, logging.Logger, json.RawMessage) (models.Plugin, error) {
+				return plugin, nil
+			}, []models.Capability{models.CAPABILITY_FETCH_ACCOUNTS}, struct{}{}, 25)
+			config = json.RawMessage(`{"name":"somename","pollingPeriod":"30m","apiKey":"key"}`)
+		})
+
+		It("should return validation error when the provider is unknown", func(ctx SpecContext) {
+			manager.EXPECT().Validate(gomock.Any()).Return("", nil, fmt.Errorf("%w: %w", registry.ErrPluginNotFound, connectors.ErrValidation))
+			_, err := eng.TestConnector(ctx, "unknown", config)
+			Expect(err).NotTo(BeNil())
+			Expect(err).To(MatchError(engine.ErrValidation))
+		})
+
+		It("should return exact error when validation fails with misc error", func(ctx SpecContext) {
+			expectedErr := fmt.Errorf("validate err")
+			manager.EXPECT().Validate(gomock.Any()).Return("", nil, expectedErr)
+			_, err := eng.TestConnector(ctx, provider, config)
+			Expect(err).To(MatchError(expectedErr))
+		})
+
+		It("fails the config check and skips the connection one when the config is invalid", func(ctx SpecContext) {
+			manager.EXPECT().Validate(gomock.Any()).Return("", nil, models.ErrInvalidConfig)
+			res, err := eng.TestConnector(ctx, provider, config)
+			Expect(err).To(BeNil())
+			Expect(res.Success).To(BeFalse())
+			Expect(res.Checks).To(HaveLen(2))
+			Expect(res.Checks[0].Status).To(Equal(models.CONNECTOR_TEST_CHECK_STATUS_FAILED))
+			Expect(res.Checks[0].ErrorType).To(Equal(models.CONNECTOR_TEST_ERROR_TYPE_INVALID_CONFIG))
+			Expect(res.Checks[1].Name).To(Equal(models.ConnectorTestCheckConnection))
+			Expect(res.Checks[1].Status).To(Equal(models.CONNECTOR_TEST_CHECK_STATUS_SKIPPED))
+		})
+
+		It("tests the connection without persisting anything", func(ctx SpecContext) {
+			manager.EXPECT().Validate(gomock.Any()).DoAndReturn(func(c models.Connector) (string, json.RawMessage, error) {
+				Expect(c.ID.Provider).To(Equal(provider))
+				Expect(string(c.Config)).To(Equal(string(config)))
+				return "somename", config, nil
+			})
+			plugin.EXPECT().TestConnection(gomock.Any(), models.TestConnectionRequest{}).Return(models.TestConnectionResponse{}, nil)
+
+			res, err := eng.TestConnector(ctx, provider, config)
+			Expect(err).To(BeNil())
+			Expect(res.Provider).To(Equal(provider))
+			Expect(res.Success).To(BeTrue())
+			Expect(res.Checks).To(HaveLen(2))
+			Expect(res.Checks[0].Name).To(Equal(models.ConnectorTestCheckConfig))
+			Expect(res.Checks[0].Status).To(Equal(models.CONNECTOR_TEST_CHECK_STATUS_PASSED))
+			Expect(res.Checks[1].Name).To(Equal(models.ConnectorTestCheckConnection))
+			Expect(res.Checks[1].Status).To(Equal(models.CONNECTOR_TEST_CHECK_STATUS_PASSED))
+		})
+
+		It("skips the connection check when the plugin cannot be probed", func(ctx SpecContext) {
+			manager.EXPECT().Validate(gomock.Any()).Return("somename", config, nil)
+			plugin.EXPECT().TestConnection(gomock.Any(), gomock.Any()).Return(models.TestConnectionResponse{}, plugins.ErrNotImplemented)
+			plugin.EXPECT().FetchNextAccounts(gomock.Any(), models.FetchNextAccountsRequest{PageSize: 1}).Return(models.FetchNextAccountsResponse{}, plugins.ErrNotImplemented)
+
+			res, err := eng.TestConnector(ctx, provider, config)
+			Expect(err).To(BeNil())
+			Expect(res.Success).To(BeTrue())
+			Expect(res.Checks[1].Status).To(Equal(models.CONNECTOR_TEST_CHECK_STATUS_SKIPPED))
+		})
+
+		DescribeTable("reports connection failures",
+			func(ctx SpecContext, pluginErr error, expectedType models.ConnectorTestErrorType) {
+				manager.EXPECT().Validate(gomock.Any()).Return("somename", config, nil)
+				plugin.EXPECT().TestConnection(gomock.Any(), gomock.Any()).Return(models.TestConnectionResponse{}, pluginErr)
+
+				res, err := eng.TestConnector(ctx, provider, config)
+				Expect(err).To(BeNil())
+				Expect(res.Success).To(BeFalse())
+				Expect(res.Checks[1].Status).To(Equal(models.CONNECTOR_TEST_CHECK_STATUS_FAILED))
+				Expect(res.Checks[1].ErrorType).To(Equal(expectedType))
+				Expect(res.Checks[1].Error).NotTo(BeEmpty())
+			},
+			Entry("rejected credentials", fmt.Errorf("401: %w", httpwrapper.ErrStatusCodeClientError), models.CONNECTOR_TEST_ERROR_TYPE_CLIENT_ERROR),
+			Entry("rate limited", httpwrapper.ErrStatusCodeTooManyRequests, models.CONNECTOR_TEST_ERROR_TYPE_RATE_LIMITED),
+			Entry("timeout", context.DeadlineExceeded, models.CONNECTOR_TEST_ERROR_TYPE_TIMEOUT),
+			Entry("server error", httpwrapper.ErrStatusCodeServerError, models.CONNECTOR_TEST_ERROR_TYPE_UPSTREAM_ERROR),
+		)
+	})
+
+	Context("validating a connector config", func() {
+		config := json.RawMessage(`{"name":"somename","pollingPeriod":"30m","apiKey":"key"}`)
+
+		It("should return validation error when the config is invalid", func(ctx SpecContext) {
+			manager.EXPECT().Validate(gomock.Any()).Return("", nil, models.ErrInvalidConfig)
+			_, err := eng.ValidateConnectorConfig(ctx, "psp", config)
+			Expect(err).To(MatchError(engine.ErrValidation))
+		})
+
+		It("should return the normalized config", func(ctx SpecContext) {
+			validated := json.RawMessage(`{"name":"somename","pollingPeriod":"30m0s","apiKey":"key"}`)
+			manager.EXPECT().Validate(gomock.Any()).DoAndReturn(func(c models.Connector) (string, json.RawMessage, error) {
+				Expect(c.Provider).To(Equal("psp"))
+				Expect(string(c.Config)).To(Equal(string(config)))
+				return "somename", validated, nil
+			})
+			res, err := eng.ValidateConnectorConfig(ctx, "psp", config)
+			Expect(err).To(BeNil())
+			Expect(res).To(Equal(validated))
+		})
+	})
+
+	Context("managing a schedule", func() {
+		var (
+			connID     models.ConnectorID
+			scheduleID string
+			sc         *activities.MockScheduleClient
+			sh         *activities.MockScheduleHandle
+		)
+		BeforeEach(func() {
+			connID = models.ConnectorID{Reference: uuid.New(), Provider: "dummypay"}
+			scheduleID = "schedule-id"
+			ctrl := gomock.NewController(GinkgoT())
+			sc = activities.NewMockScheduleClient(ctrl)
+			sh = activities.NewMockScheduleHandle(ctrl)
+		})
+
+		It("should return not found error when schedule does not exist", func(ctx SpecContext) {
+			store.EXPECT().SchedulesGet(gomock.Any(), scheduleID, connID).Return(nil, storage.ErrNotFound).Times(3)
+			Expect(eng.PauseSchedule(ctx, connID, scheduleID)).To(MatchError(engine.ErrNotFound))
+			Expect(eng.ResumeSchedule(ctx, connID, scheduleID)).To(MatchError(engine.ErrNotFound))
+			Expect(eng.TriggerSchedule(ctx, connID, scheduleID)).To(MatchError(engine.ErrNotFound))
+		})
+
+		It("pauses the schedule in temporal and storage", func(ctx SpecContext) {
+			store.EXPECT().SchedulesGet(gomock.Any(), scheduleID, connID).Return(&models.Schedule{}, nil)
+			cl.EXPECT().ScheduleClient().Return(sc)
+			sc.EXPECT().GetHandle(gomock.Any(), scheduleID).Return(sh)
+			sh.EXPECT().Pause(gomock.Any(), gomock.Any()).Return(nil)
+			store.EXPECT().SchedulesPause(gomock.Any(), scheduleID, connID, gomock.Any(), gomock.Any()).Return(nil)
+
+			Expect(eng.PauseSchedule(ctx, connID, scheduleID)).To(Succeed())
+		})
+
+		It("does not update storage when temporal pause fails", func(ctx SpecContext) {
+			expectedErr := fmt.Errorf("temporal err")
+			store.EXPECT().SchedulesGet(gomock.Any(), scheduleID, connID).Return(&models.Schedule{}, nil)
+			cl.EXPECT().ScheduleClient().Return(sc)
+			sc.EXPECT().GetHandle(gomock.Any(), scheduleID).Return(sh)
+			sh.EXPECT().Pause(gomock.Any(), gomock.Any()).Return(expectedErr)
+
+			Expect(eng.PauseSchedule(ctx, connID, scheduleID)).To(MatchError(expectedErr))
+		})
+
+		It("resumes the schedule in temporal and storage", func(ctx SpecContext) {
+			store.EXPECT().SchedulesGet(gomock.Any(), scheduleID, connID).Return(&models.Schedule{}, nil)
+			cl.EXPECT().ScheduleClient().Return(sc)
+			sc.EXPECT().GetHandle(gomock.Any(), scheduleID).Return(sh)
+			sh.EXPECT().Unpause(gomock.Any(), client.ScheduleUnpauseOptions{}).Return(nil)
+			store.EXPECT().SchedulesUnpause(gomock.Any(), scheduleID, connID).Return(nil)
+
+			Expect(eng.ResumeSchedule(ctx, connID, scheduleID)).To(Succeed())
+		})
+
+		It("triggers the schedule", func(ctx SpecContext) {
+			store.EXPECT().SchedulesGet(gomock.Any(), scheduleID, connID).Return(&models.Schedule{}, nil)
+			cl.EXPECT().ScheduleClient().Return(sc)
+			sc.EXPECT().GetHandle(gomock.Any(), scheduleID).Return(sh)
+			sh.EXPECT().Trigger(gomock.Any(), client.ScheduleTriggerOptions{}).Return(nil)
+
+			Expect(eng.TriggerSchedule(ctx, connID, scheduleID)).To(Succeed())
+		})
+	})
+
 	Context("forwarding a bank account to a connector", func() {
 		var (
 			ba     models.BankAccount
@@ -388,6 +793,145 @@ var _ = Describe("Engine Tests", func() {
 		})
 	})
 
+	Context("verifying a bank account", func() {
+		var (
+			ba     models.BankAccount
+			connID models.ConnectorID
+		)
+		BeforeEach(func() {
+			provider := "connector-test-" + uuid.NewString()
+			registry.RegisterPlugin(provider, models.PluginTypePSP, func(models.ConnectorID, string, logging.Logger, json.RawMessage) (models.Plugin, error) {
+				return nil, nil
+			}, []models.Capability{models.CAPABILITY_VERIFY_BANK_ACCOUNT}, struct{}{}, 25)
+			connID = models.ConnectorID{Reference: uuid.New(), Provider: provider}
+			ba = models.BankAccount{ID: uuid.New()}
+		})
+
+		It("should return not found error when storage doesn't find connector", func(ctx SpecContext) {
+			store.EXPECT().ConnectorsGet(gomock.Any(), connID).Return(
+				nil, fmt.Errorf("some not found err: %w", storage.ErrNotFound),
+			)
+			_, err := eng.VerifyBankAccount(ctx, ba, connID, false)
+			Expect(err).NotTo(BeNil())
+			Expect(err).To(MatchError(engine.ErrNotFound))
+		})
+
+		It("should return capability error when the connector cannot verify bank accounts", func(ctx SpecContext) {
+			// column and increase have no name check API
+			for _, provider := range []string{"dummypay", "column", "increase"} {
+				connID = models.ConnectorID{Reference: uuid.New(), Provider: provider}
+				store.EXPECT().ConnectorsGet(gomock.Any(), connID).Return(
+					&models.Connector{ConnectorBase: models.ConnectorBase{ID: connID}}, nil,
+				)
+				_, err := eng.VerifyBankAccount(ctx, ba, connID, false)
+				Expect(err).NotTo(BeNil())
+				Expect(err).To(BeAssignableToTypeOf(&engine.ErrConnectorCapabilityNotSupported{}))
+			}
+		})
+
+		It("should return storage error when task cannot be upserted", func(ctx SpecContext) {
+			store.EXPECT().ConnectorsGet(gomock.Any(), connID).Return(nil, nil)
+			expectedErr := fmt.Errorf("fffff")
+			store.EXPECT().TasksUpsert(gomock.Any(), gomock.AssignableToTypeOf(models.Task{})).Return(
+				expectedErr,
+			)
+			_, err := eng.VerifyBankAccount(ctx, ba, connID, false)
+			Expect(err).NotTo(BeNil())
+			Expect(err).To(MatchError(expectedErr))
+		})
+
+		It("should launch workflow and return task", func(ctx SpecContext) {
+			store.EXPECT().ConnectorsGet(gomock.Any(), connID).Return(
+				&models.Connector{ConnectorBase: models.ConnectorBase{ID: connID}}, nil,
+			)
+			store.EXPECT().TasksUpsert(gomock.Any(), gomock.AssignableToTypeOf(models.Task{})).Return(nil)
+			cl.EXPECT().ExecuteWorkflow(gomock.Any(), WithWorkflowOptions(engine.IDPrefixBankAccountVerify, defaultTaskQueue),
+				workflow.RunVerifyBankAccount,
+				gomock.AssignableToTypeOf(workflow.VerifyBankAccount{}),
+			).DoAndReturn(func(_ context.Context, _ client.StartWorkflowOptions, _ interface{}, args ...interface{}) (client.WorkflowRun, error) {
+				req := args[0].(workflow.VerifyBankAccount)
+				Expect(req.BankAccount.ID).To(Equal(ba.ID))
+				Expect(req.VerificationID).NotTo(Equal(uuid.Nil))
+				return nil, nil
+			})
+
+			task, err := eng.VerifyBankAccount(ctx, ba, connID, false)
+			Expect(err).To(BeNil())
+			Expect(task.ID.Reference).To(ContainSubstring(engine.IDPrefixBankAccountVerify))
+			Expect(task.ID.Reference).To(ContainSubstring(stackName))
+			Expect(task.ConnectorID.String()).To(Equal(connID.String()))
+			Expect(task.Status).To(Equal(models.TASK_STATUS_PROCESSING))
+		})
+	})
+
+	Context("creating an account", func() {
+		var (
+			req    models.CreateAccountRequest
+			connID models.ConnectorID
+		)
+		BeforeEach(func() {
+			provider := "connector-test-" + uuid.NewString()
+			registry.RegisterPlugin(provider, models.PluginTypePSP, func(models.ConnectorID, string, logging.Logger, json.RawMessage) (models.Plugin, error) {
+				return nil, nil
+			}, []models.Capability{models.CAPABILITY_CREATE_ACCOUNT}, struct{}{}, 25)
+			connID = models.ConnectorID{Reference: uuid.New(), Provider: provider}
+			req = models.CreateAccountRequest{Reference: "virtual-1", Name: "test"}
+		})
+
+		It("should return not found error when storage doesn't find connector", func(ctx SpecContext) {
+			store.EXPECT().ConnectorsGet(gomock.Any(), connID).Return(
+				nil, fmt.Errorf("some not found err: %w", storage.ErrNotFound),
+			)
+			_, err := eng.CreateAccount(ctx, connID, req, false)
+			Expect(err).NotTo(BeNil())
+			Expect(err).To(MatchError(engine.ErrNotFound))
+		})
+
+		It("should return capability error when the connector cannot create accounts", func(ctx SpecContext) {
+			connID = models.ConnectorID{Reference: uuid.New(), Provider: "dummypay"}
+			store.EXPECT().ConnectorsGet(gomock.Any(), connID).Return(
+				&models.Connector{ConnectorBase: models.ConnectorBase{ID: connID}}, nil,
+			)
+			_, err := eng.CreateAccount(ctx, connID, req, false)
+			Expect(err).NotTo(BeNil())
+			Expect(err).To(BeAssignableToTypeOf(&engine.ErrConnectorCapabilityNotSupported{}))
+		})
+
+		It("should return storage error when task cannot be upserted", func(ctx SpecContext) {
+			store.EXPECT().ConnectorsGet(gomock.Any(), connID).Return(nil, nil)
+			expectedErr := fmt.Errorf("fffff")
+			store.EXPECT().TasksUpsert(gomock.Any(), gomock.AssignableToTypeOf(models.Task{})).Return(
+				expectedErr,
+			)
+			_, err := eng.CreateAccount(ctx, connID, req, false)
+			Expect(err).NotTo(BeNil())
+			Expect(err).To(MatchError(expectedErr))
+		})
+
+		It("should launch workflow and return task", func(ctx SpecContext) {
+			store.EXPECT().ConnectorsGet(gomock.Any(), connID).Return(
+				&models.Connector{ConnectorBase: models.ConnectorBase{ID: connID}}, nil,
+			)
+			store.EXPECT().TasksUpsert(gomock.Any(), gomock.AssignableToTypeOf(models.Task{})).Return(nil)
+			cl.EXPECT().ExecuteWorkflow(gomock.Any(), WithWorkflowOptions(engine.IDPrefixAccountCreate, defaultTaskQueue),
+				workflow.RunCreateAccount,
+				gomock.AssignableToTypeOf(workflow.CreateAccount{}),
+			).DoAndReturn(func(_ context.Context, _ client.StartWorkflowOptions, _ interface{}, args ...interface{}) (client.WorkflowRun, error) {
+				r := args[0].(workflow.CreateAccount)
+				Expect(r.Request).To(Equal(req))
+				return nil, nil
+			})
+
+			task, err := eng.CreateAccount(ctx, connID, req, false)
+			Expect(err).To(BeNil())
+			Expect(task.ID.Reference).To(ContainSubstring(engine.IDPrefixAccountCreate))
+			Expect(task.ID.Reference).To(ContainSubstring(stackName))
+			Expect(task.ID.Reference).To(ContainSubstring(req.Reference))
+			Expect(task.ConnectorID.String()).To(Equal(connID.String()))
+			Expect(task.Status).To(Equal(models.TASK_STATUS_PROCESSING))
+		})
+	})
+
 	Context("updating a connector", func() {
 		var (
 			config      json.RawMessage
@@ -1004,6 +1548,219 @@ var _ = Describe("Engine Tests", func() {
 		})
 	})
 
+	Context("create payment service user payment link", func() {
+		var (
+			psuID                    uuid.UUID
+			connectorID              models.ConnectorID
+			psu                      *models.PaymentServiceUser
+			openBankingForwardedUser *models.OpenBankingForwardedUser
+			bankAccount              *models.BankAccount
+			pi                       models.PaymentInitiation
+			idempotencyKey           *uuid.UUID
+			clientRedirectURL        *string
+		)
+
+		BeforeEach(func() {
+			psuID = uuid.New()
+			connectorID = models.ConnectorID{Reference: uuid.New(), Provider: "psp"}
+			psu = &models.PaymentServiceUser{
+				ID:   psuID,
+				Name: "Test User",
+			}
+			openBankingForwardedUser = &models.OpenBankingForwardedUser{
+				ConnectorID: connectorID,
+			}
+			bankAccount = &models.BankAccount{
+				ID:   uuid.New(),
+				Name: "Merchant",
+				IBAN: pointer.For("FR7630006000011234567890189"),
+			}
+			pi = models.PaymentInitiation{
+				ID: models.PaymentInitiationID{
+					Reference:   "ref",
+					ConnectorID: connectorID,
+				},
+				ConnectorID: connectorID,
+				Reference:   "ref",
+				CreatedAt:   time.Now().UTC(),
+				Type:        models.PAYMENT_INITIATION_TYPE_PAYOUT,
+				Amount:      big.NewInt(100),
+				Asset:       "EUR/2",
+			}
+			redirectURL := "https://example.com/redirect"
+			clientRedirectURL = &redirectURL
+		})
+
+		It("should return error when plugin not found", func(ctx SpecContext) {
+			expectedErr := fmt.Errorf("plugin not found")
+			manager.EXPECT().Get(connectorID).Return(nil, expectedErr)
+			_, _, err := eng.CreatePaymentServiceUserPaymentLink(ctx, "Test", psuID, pi, bankAccount.ID, idempotencyKey, clientRedirectURL)
+			Expect(err).NotTo(BeNil())
+			Expect(err).To(MatchError(expectedErr))
+		})
+
+		It("should return error when bank account not found", func(ctx SpecContext) {
+			plugin := models.NewMockPlugin(gomock.NewController(GinkgoT()))
+			manager.EXPECT().Get(connectorID).Return(plugin, nil)
+			store.EXPECT().PaymentServiceUsersGet(gomock.Any(), psuID).Return(psu, nil)
+			store.EXPECT().OpenBankingForwardedUserGet(gomock.Any(), psuID, connectorID).Return(openBankingForwardedUser, nil)
+			store.EXPECT().BankAccountsGet(gomock.Any(), bankAccount.ID, true).Return(nil, storage.ErrNotFound)
+			_, _, err := eng.CreatePaymentServiceUserPaymentLink(ctx, "Test", psuID, pi, bankAccount.ID, idempotencyKey, clientRedirectURL)
+			Expect(err).NotTo(BeNil())
+			Expect(err).To(MatchError(storage.ErrNotFound))
+		})
+
+		It("should mark the payment initiation as failed when plugin CreateUserPaymentLink fails", func(ctx SpecContext) {
+			plugin := models.NewMockPlugin(gomock.NewController(GinkgoT()))
+			manager.EXPECT().Get(connectorID).Return(plugin, nil)
+			store.EXPECT().PaymentServiceUsersGet(gomock.Any(), psuID).Return(psu, nil)
+			store.EXPECT().OpenBankingForwardedUserGet(gomock.Any(), psuID, connectorID).Return(openBankingForwardedUser, nil)
+			store.EXPECT().BankAccountsGet(gomock.Any(), bankAccount.ID, true).Return(bankAccount, nil)
+			store.EXPECT().AccountsUpsert(gomock.Any(), gomock.Any()).Return(nil)
+			store.EXPECT().BankAccountsAddRelatedAccount(gomock.Any(), bankAccount.ID, gomock.Any()).Return(nil)
+			store.EXPECT().PaymentInitiationsInsert(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			store.EXPECT().OpenBankingPaymentAttemptsUpsert(gomock.Any(), gomock.Any()).Return(nil)
+			plugin.EXPECT().CreateUserPaymentLink(gomock.Any(), gomock.Any()).Return(models.CreateUserPaymentLinkResponse{}, models.ErrInvalidRequest)
+			store.EXPECT().OpenBankingPaymentAttemptsUpsert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, attempt models.OpenBankingPaymentAttempt) error {
+				Expect(attempt.Status).To(Equal(models.OpenBankingConnectionAttemptStatusExited))
+				Expect(attempt.Error).NotTo(BeNil())
+				return nil
+			})
+			store.EXPECT().PaymentInitiationAdjustmentsUpsert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, adj models.PaymentInitiationAdjustment) error {
+				Expect(adj.Status).To(Equal(models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_FAILED))
+				return nil
+			})
+			_, _, err := eng.CreatePaymentServiceUserPaymentLink(ctx, "Test", psuID, pi, bankAccount.ID, idempotencyKey, clientRedirectURL)
+			Expect(err).NotTo(BeNil())
+			Expect(err).To(MatchError(engine.ErrValidation))
+		})
+
+		It("should successfully create payment service user payment link", func(ctx SpecContext) {
+			plugin := models.NewMockPlugin(gomock.NewController(GinkgoT()))
+			manager.EXPECT().Get(connectorID).Return(plugin, nil)
+			store.EXPECT().PaymentServiceUsersGet(gomock.Any(), psuID).Return(psu, nil)
+			store.EXPECT().OpenBankingForwardedUserGet(gomock.Any(), psuID, connectorID).Return(openBankingForwardedUser, nil)
+			store.EXPECT().BankAccountsGet(gomock.Any(), bankAccount.ID, true).Return(bankAccount, nil)
+			store.EXPECT().AccountsUpsert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, accounts []models.Account) error {
+				Expect(accounts).To(HaveLen(1))
+				Expect(accounts[0].Type).To(Equal(models.ACCOUNT_TYPE_EXTERNAL))
+				Expect(accounts[0].Reference).To(Equal(bankAccount.ID.String()))
+				Expect(accounts[0].Metadata[models.AccountIBANMetadataKey]).To(Equal(*bankAccount.IBAN))
+				return nil
+			})
+			store.EXPECT().BankAccountsAddRelatedAccount(gomock.Any(), bankAccount.ID, gomock.Any()).Return(nil)
+			store.EXPECT().PaymentInitiationsInsert(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, p models.PaymentInitiation, adjs ...models.PaymentInitiationAdjustment) error {
+				Expect(p.DestinationAccountID).NotTo(BeNil())
+				Expect(adjs).To(HaveLen(1))
+				Expect(adjs[0].Status).To(Equal(models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_WAITING_FOR_VALIDATION))
+				return nil
+			})
+			store.EXPECT().OpenBankingPaymentAttemptsUpsert(gomock.Any(), gomock.Any()).Return(nil)
+			plugin.EXPECT().CreateUserPaymentLink(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req models.CreateUserPaymentLinkRequest) (models.CreateUserPaymentLinkResponse, error) {
+				state, err := models.CallbackStateFromString(req.CallBackState)
+				Expect(err).To(BeNil())
+				Expect(state.PaymentInitiation).To(BeTrue())
+				Expect(req.PaymentInitiation.DestinationAccount).NotTo(BeNil())
+				return models.CreateUserPaymentLinkResponse{
+					Link:                    "https://example.com/link",
+					PaymentRequestReference: "payment_request_123",
+				}, nil
+			})
+			store.EXPECT().OpenBankingPaymentAttemptsUpsert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, attempt models.OpenBankingPaymentAttempt) error {
+				Expect(attempt.PaymentRequestReference).NotTo(BeNil())
+				Expect(*attempt.PaymentRequestReference).To(Equal("payment_request_123"))
+				return nil
+			})
+			store.EXPECT().PaymentInitiationAdjustmentsUpsert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, adj models.PaymentInitiationAdjustment) error {
+				Expect(adj.Status).To(Equal(models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PROCESSING))
+				return nil
+			})
+			attemptID, link, err := eng.CreatePaymentServiceUserPaymentLink(ctx, "Test", psuID, pi, bankAccount.ID, idempotencyKey, clientRedirectURL)
+			Expect(err).To(BeNil())
+			Expect(attemptID).NotTo(BeEmpty())
+			Expect(link).To(Equal("https://example.com/link"))
+		})
+
+		It("should return the existing payment link when the idempotency key was already used", func(ctx SpecContext) {
+			key := uuid.New()
+			plugin := models.NewMockPlugin(gomock.NewController(GinkgoT()))
+			manager.EXPECT().Get(connectorID).Return(plugin, nil)
+			store.EXPECT().OpenBankingPaymentAttemptsGet(gomock.Any(), key).Return(&models.OpenBankingPaymentAttempt{
+				ID:                  key,
+				PsuID:               psuID,
+				ConnectorID:         connectorID,
+				PaymentInitiationID: pi.ID,
+				Link:                pointer.For("https://example.com/link"),
+			}, nil)
+			attemptID, link, err := eng.CreatePaymentServiceUserPaymentLink(ctx, "Test", psuID, pi, bankAccount.ID, &key, clientRedirectURL)
+			Expect(err).To(BeNil())
+			Expect(attemptID).To(Equal(key.String()))
+			Expect(link).To(Equal("https://example.com/link"))
+		})
+
+		It("should return a validation error when the attempt of the idempotency key has no link", func(ctx SpecContext) {
+			key := uuid.New()
+			plugin := models.NewMockPlugin(gomock.NewController(GinkgoT()))
+			manager.EXPECT().Get(connectorID).Return(plugin, nil)
+			store.EXPECT().OpenBankingPaymentAttemptsGet(gomock.Any(), key).Return(&models.OpenBankingPaymentAttempt{
+				ID:                  key,
+				PsuID:               psuID,
+				ConnectorID:         connectorID,
+				PaymentInitiationID: pi.ID,
+				Status:              models.OpenBankingConnectionAttemptStatusExited,
+				Error:               pointer.For("invalid request"),
+			}, nil)
+			_, _, err := eng.CreatePaymentServiceUserPaymentLink(ctx, "Test", psuID, pi, bankAccount.ID, &key, clientRedirectURL)
+			Expect(err).To(MatchError(engine.ErrValidation))
+		})
+
+		It("should return a validation error when the idempotency key was used for another payment initiation", func(ctx SpecContext) {
+			key := uuid.New()
+			plugin := models.NewMockPlugin(gomock.NewController(GinkgoT()))
+			manager.EXPECT().Get(connectorID).Return(plugin, nil)
+			store.EXPECT().OpenBankingPaymentAttemptsGet(gomock.Any(), key).Return(&models.OpenBankingPaymentAttempt{
+				ID:          key,
+				PsuID:       psuID,
+				ConnectorID: connectorID,
+				PaymentInitiationID: models.PaymentInitiationID{
+					Reference:   "other",
+					ConnectorID: connectorID,
+				},
+				Link: pointer.For("https://example.com/link"),
+			}, nil)
+			_, _, err := eng.CreatePaymentServiceUserPaymentLink(ctx, "Test", psuID, pi, bankAccount.ID, &key, clientRedirectURL)
+			Expect(err).To(MatchError(engine.ErrValidation))
+		})
+
+		It("should store the link on the attempt when the idempotency key is new", func(ctx SpecContext) {
+			key := uuid.New()
+			plugin := models.NewMockPlugin(gomock.NewController(GinkgoT()))
+			manager.EXPECT().Get(connectorID).Return(plugin, nil)
+			store.EXPECT().OpenBankingPaymentAttemptsGet(gomock.Any(), key).Return(nil, storage.ErrNotFound)
+			store.EXPECT().PaymentServiceUsersGet(gomock.Any(), psuID).Return(psu, nil)
+			store.EXPECT().OpenBankingForwardedUserGet(gomock.Any(), psuID, connectorID).Return(openBankingForwardedUser, nil)
+			store.EXPECT().BankAccountsGet(gomock.Any(), bankAccount.ID, true).Return(bankAccount, nil)
+			store.EXPECT().AccountsUpsert(gomock.Any(), gomock.Any()).Return(nil)
+			store.EXPECT().BankAccountsAddRelatedAccount(gomock.Any(), bankAccount.ID, gomock.Any()).Return(nil)
+			store.EXPECT().PaymentInitiationsInsert(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			store.EXPECT().OpenBankingPaymentAttemptsUpsert(gomock.Any(), gomock.Any()).Return(nil)
+			plugin.EXPECT().CreateUserPaymentLink(gomock.Any(), gomock.Any()).Return(models.CreateUserPaymentLinkResponse{
+				Link:                    "https://example.com/link",
+				PaymentRequestReference: "payment_request_123",
+			}, nil)
+			store.EXPECT().OpenBankingPaymentAttemptsUpsert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, attempt models.OpenBankingPaymentAttempt) error {
+				Expect(attempt.ID).To(Equal(key))
+				Expect(attempt.Link).To(Equal(pointer.For("https://example.com/link")))
+				return nil
+			})
+			store.EXPECT().PaymentInitiationAdjustmentsUpsert(gomock.Any(), gomock.Any()).Return(nil)
+			attemptID, link, err := eng.CreatePaymentServiceUserPaymentLink(ctx, "Test", psuID, pi, bankAccount.ID, &key, clientRedirectURL)
+			Expect(err).To(BeNil())
+			Expect(attemptID).To(Equal(key.String()))
+			Expect(link).To(Equal("https://example.com/link"))
+		})
+	})
+
 	Context("update payment service user link", func() {
 		var (
 			psuID                    uuid.UUID
@@ -1303,6 +2060,51 @@ var _ = Describe("Engine Tests", func() {
 		})
 	})
 
+	Context("submitting a screening result", func() {
+		var (
+			pi     models.PaymentInitiation
+			result models.ScreeningResult
+		)
+		BeforeEach(func() {
+			connID := models.ConnectorID{Reference: uuid.New(), Provider: "dummypay"}
+			pi = models.PaymentInitiation{
+				ID:          models.PaymentInitiationID{Reference: "ref", ConnectorID: connID},
+				ConnectorID: connID,
+				Reference:   "ref",
+				Type:        models.PAYMENT_INITIATION_TYPE_PAYOUT,
+			}
+			result = models.ScreeningResult{Decision: models.SCREENING_DECISION_APPROVED}
+		})
+
+		It("should signal the create payout workflow of the given attempt", func(ctx SpecContext) {
+			id := models.TaskIDReference(fmt.Sprintf("create-payout-%s-2", stackName), pi.ConnectorID, pi.ID.String())
+			cl.EXPECT().SignalWorkflow(gomock.Any(), id, "", workflow.SignalScreeningResult, result).Return(nil)
+			err := eng.SubmitScreeningResult(ctx, pi, 2, result)
+			Expect(err).To(BeNil())
+		})
+
+		It("should signal the create transfer workflow", func(ctx SpecContext) {
+			pi.Type = models.PAYMENT_INITIATION_TYPE_TRANSFER
+			id := models.TaskIDReference(fmt.Sprintf("create-transfer-%s-1", stackName), pi.ConnectorID, pi.ID.String())
+			cl.EXPECT().SignalWorkflow(gomock.Any(), id, "", workflow.SignalScreeningResult, result).Return(nil)
+			err := eng.SubmitScreeningResult(ctx, pi, 1, result)
+			Expect(err).To(BeNil())
+		})
+
+		It("should return not found when the workflow is not running", func(ctx SpecContext) {
+			cl.EXPECT().SignalWorkflow(gomock.Any(), gomock.Any(), "", workflow.SignalScreeningResult, result).
+				Return(serviceerror.NewNotFound("workflow not found"))
+			err := eng.SubmitScreeningResult(ctx, pi, 1, result)
+			Expect(err).To(MatchError(engine.ErrNotFound))
+		})
+
+		It("should reject unsupported payment initiation types", func(ctx SpecContext) {
+			pi.Type = models.PAYMENT_INITIATION_TYPE_UNKNOWN
+			err := eng.SubmitScreeningResult(ctx, pi, 1, result)
+			Expect(err).To(MatchError(engine.ErrValidation))
+		})
+	})
+
 	Context("complete payment service user link", func() {
 		var (
 			connectorID  models.ConnectorID
@@ -7,13 +7,27 @@ import (
 )
 
 const (
-	IDPrefixBankAccountCreate  = "create-bank-account"
-	IDPrefixConnectorInstall   = "install"
-	IDPrefixConnectorUninstall = "uninstall"
-	IDPrefixConnectorReset     = "reset"
+	IDPrefixAccountCreate                = "create-account"
+	IDPrefixBankAccountCreate            = "create-bank-account"
+	IDPrefixBankAccountVerify            = "verify-bank-account"
+	IDPrefixConnectorInstall             = "install"
+	IDPrefixConnectorUninstall           = "uninstall"
+	IDPrefixConnectorReset               = "reset"
+	IDPrefixConnectorSync                = "sync"
+	IDPrefixConnectorBackfill            = "backfill"
+	IDPrefixConnectorCredentialsRotation = "rotate-credentials"
+	IDPrefixTransferCreate               = "create-transfer"
+	IDPrefixPayoutCreate                 = "create-payout"
 )
 
 func (e *engine) taskIDReferenceFor(prefix string, connectorID models.ConnectorID, objectID string) string {
 	withStack := fmt.Sprintf("%s-%s", prefix, e.stack)
 	return models.TaskIDReference(withStack, connectorID, objectID)
 }
+
+// The payment initiation tasks are also the IDs of their workflows, which
+// receive the screening results.
+func (e *engine) paymentInitiationTaskIDReferenceFor(prefix string, piID models.PaymentInitiationID, attempt int) string {
+	withStackAndAttempt := fmt.Sprintf("%s-%s-%d", prefix, e.stack, attempt)
+	return models.TaskIDReference(withStackAndAttempt, piID.ConnectorID, piID.String())
+}
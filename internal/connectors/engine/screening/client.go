@@ -0,0 +1,62 @@
+package screening
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/formancehq/payments/pkg/domain/httpwrapper"
+	"github.com/formancehq/payments/pkg/domain/models"
+)
+
+var ErrMissingDecision = errors.New("missing screening decision")
+
+//go:generate mockgen -source client.go -destination client_generated.go -package screening . Client
+type Client interface {
+	// Submit the payment initiation to the sanctions/AML screening service.
+	// A PENDING decision means the final decision will be posted later on the
+	// callback URL of the request.
+	Screen(ctx context.Context, req models.ScreeningRequest) (models.ScreeningResult, error)
+}
+
+type client struct {
+	url        string
+	httpClient httpwrapper.Client
+}
+
+func NewClient(url string, timeout time.Duration) Client {
+	return &client{
+		url: url,
+		httpClient: httpwrapper.NewClient(&httpwrapper.Config{
+			Timeout: timeout,
+		}),
+	}
+}
+
+func (c *client) Screen(ctx context.Context, req models.ScreeningRequest) (models.ScreeningResult, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return models.ScreeningResult{}, fmt.Errorf("failed to marshal screening request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return models.ScreeningResult{}, fmt.Errorf("failed to create screening request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	var res models.ScreeningResult
+	if _, err := c.httpClient.Do(ctx, httpReq, &res, nil); err != nil {
+		return models.ScreeningResult{}, fmt.Errorf("failed to screen payment initiation: %w", err)
+	}
+
+	if res.Decision == models.SCREENING_DECISION_UNKNOWN {
+		return models.ScreeningResult{}, ErrMissingDecision
+	}
+
+	return res, nil
+}
@@ -0,0 +1,94 @@
+package screening_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/formancehq/payments/internal/connectors/engine/screening"
+	"github.com/formancehq/payments/pkg/domain/httpwrapper"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestScreening(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Screening Suite")
+}
+
+var _ = Describe("Screening client", func() {
+	var (
+		server   *httptest.Server
+		handler  http.HandlerFunc
+		received models.ScreeningRequest
+		req      models.ScreeningRequest
+	)
+
+	BeforeEach(func() {
+		connectorID := models.ConnectorID{Provider: "wise", Reference: uuid.New()}
+		req = models.ScreeningRequest{
+			PaymentInitiationID: models.PaymentInitiationID{Reference: "pi1", ConnectorID: connectorID},
+			Type:                models.PAYMENT_INITIATION_TYPE_PAYOUT,
+			Reference:           "pi1",
+			Amount:              big.NewInt(100),
+			Asset:               "EUR/2",
+			Beneficiary:         models.BankAccount{Name: "John Doe", Metadata: map[string]string{}},
+			CallbackURL:         "http://localhost/screening",
+		}
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			Expect(r.Method).To(Equal(http.MethodPost))
+			Expect(r.Header.Get("Content-Type")).To(Equal("application/json"))
+			Expect(json.NewDecoder(r.Body).Decode(&received)).To(Succeed())
+			handler(w, r)
+		}))
+		DeferCleanup(server.Close)
+	})
+
+	respond := func(status int, body string) http.HandlerFunc {
+		return func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(body))
+		}
+	}
+
+	It("returns the decision of the screening service", func(ctx SpecContext) {
+		handler = respond(http.StatusOK, `{"decision":"REJECTED","reason":"sanctioned beneficiary"}`)
+
+		res, err := screening.NewClient(server.URL, time.Second).Screen(ctx, req)
+		Expect(err).To(BeNil())
+		Expect(res).To(Equal(models.ScreeningResult{
+			Decision: models.SCREENING_DECISION_REJECTED,
+			Reason:   "sanctioned beneficiary",
+		}))
+		Expect(received).To(Equal(req))
+	})
+
+	It("accepts pending decisions", func(ctx SpecContext) {
+		handler = respond(http.StatusAccepted, `{"decision":"PENDING"}`)
+
+		res, err := screening.NewClient(server.URL, time.Second).Screen(ctx, req)
+		Expect(err).To(BeNil())
+		Expect(res.Decision).To(Equal(models.SCREENING_DECISION_PENDING))
+	})
+
+	It("fails without a decision", func(ctx SpecContext) {
+		handler = respond(http.StatusOK, `{}`)
+
+		_, err := screening.NewClient(server.URL, time.Second).Screen(ctx, req)
+		Expect(err).To(MatchError(screening.ErrMissingDecision))
+	})
+
+	It("fails on error status codes", func(ctx SpecContext) {
+		handler = respond(http.StatusBadRequest, `{}`)
+
+		_, err := screening.NewClient(server.URL, time.Second).Screen(ctx, req)
+		Expect(err).To(MatchError(httpwrapper.ErrStatusCodeClientError))
+	})
+})
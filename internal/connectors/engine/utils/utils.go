@@ -24,3 +24,11 @@ func GetFormanceRedirectURL(stackPublicURL string, connectorID models.ConnectorI
 
 	return formanceRedirectURL, nil
 }
+
+func GetScreeningCallbackURL(stackPublicURL string, paymentInitiationID models.PaymentInitiationID) (string, error) {
+	screeningCallbackURL, err := url.JoinPath(stackPublicURL, "api/payments/v3/payment-initiations", paymentInitiationID.String(), "screening")
+	if err != nil {
+		return "", fmt.Errorf("joining screening callback URL: %w", err)
+	}
+	return screeningCallbackURL, nil
+}
@@ -47,6 +47,10 @@ type WorkerPool struct {
 	outboxCleanupPeriod  time.Duration
 }
 
+// Period of the background linking of the external accounts to their
+// counterparty.
+const counterpartiesLinkPeriod = time.Minute
+
 type Worker struct {
 	worker worker.Worker
 }
@@ -134,6 +138,9 @@ func (w *WorkerPool) OnStart(ctx context.Context) error {
 		if err := w.CreateOutboxCleanupSchedule(ctx); err != nil {
 			return fmt.Errorf("failed to create outbox cleanup schedule: %w", err)
 		}
+		if err := w.CreateCounterpartiesLinkSchedule(ctx); err != nil {
+			return fmt.Errorf("failed to create counterparties link schedule: %w", err)
+		}
 	}
 
 	return nil
@@ -421,6 +428,18 @@ func (w *WorkerPool) CreateOutboxCleanupSchedule(ctx context.Context) error {
 	)
 }
 
+// CreateCounterpartiesLinkSchedule links the external accounts to their
+// counterparty in the background, out of the fetch of the accounts.
+func (w *WorkerPool) CreateCounterpartiesLinkSchedule(ctx context.Context) error {
+	return w.createSchedule(
+		ctx,
+		"counterparties-link",
+		"CounterpartiesLink",
+		counterpartiesLinkPeriod,
+		"failed to create counterparties link schedule",
+	)
+}
+
 // SetSkipScheduleCreation sets whether to skip creating the outbox publisher schedule.
 // Useful for tests that don't have a Temporal server available.
 func (w *WorkerPool) SetSkipScheduleCreation(skip bool) {
@@ -323,4 +323,65 @@ var _ = Describe("Worker Tests", func() {
 			Expect(err.Error()).To(ContainSubstring("failed to create outbox cleanup schedule"))
 		})
 	})
+
+	Context("createCounterpartiesLinkSchedule", func() {
+		var (
+			pool               *engine.WorkerPool
+			mockClient         *activities.MockClient
+			mockScheduleClient *activities.MockScheduleClient
+			mockHandle         *activities.MockScheduleHandle
+			stackName          string
+		)
+
+		BeforeEach(func() {
+			ctrl := gomock.NewController(GinkgoT())
+			logger := logging.NewDefaultLogger(GinkgoWriter, false, false, false)
+			stackName = "test-stack"
+			mockClient = activities.NewMockClient(ctrl)
+			mockScheduleClient = activities.NewMockScheduleClient(ctrl)
+			mockHandle = activities.NewMockScheduleHandle(ctrl)
+			store := storage.NewMockStorage(ctrl)
+			manager := connectors.NewMockManager(ctrl)
+			pool = engine.NewWorkerPool(
+				logger,
+				stackName,
+				mockClient,
+				[]temporal.DefinitionSet{},
+				[]temporal.DefinitionSet{},
+				store,
+				manager,
+				worker.Options{},
+				time.Second,
+				time.Hour,
+			)
+			pool.SetSkipScheduleCreation(false)
+		})
+
+		It("should successfully create schedule when it does not exist", func(ctx SpecContext) {
+			scheduleID := fmt.Sprintf("%s-counterparties-link", stackName)
+			mockClient.EXPECT().ScheduleClient().Return(mockScheduleClient).AnyTimes()
+			mockScheduleClient.EXPECT().Create(ctx, gomock.Any()).Do(func(_ context.Context, opts client.ScheduleOptions) {
+				Expect(opts.ID).To(Equal(scheduleID))
+				Expect(opts.Overlap).To(Equal(enums.SCHEDULE_OVERLAP_POLICY_SKIP))
+				Expect(opts.Spec.Intervals).To(HaveLen(1))
+				Expect(opts.Spec.Intervals[0].Every).To(Equal(time.Minute))
+				action, ok := opts.Action.(*client.ScheduleWorkflowAction)
+				Expect(ok).To(BeTrue())
+				Expect(action.Workflow).To(Equal("CounterpartiesLink"))
+				Expect(action.TaskQueue).To(Equal(fmt.Sprintf("%s-default", stackName)))
+			}).Return(mockHandle, nil)
+
+			err := pool.CreateCounterpartiesLinkSchedule(ctx)
+			Expect(err).To(BeNil())
+		})
+
+		It("should return error when Create fails with non-AlreadyExists error", func(ctx SpecContext) {
+			mockClient.EXPECT().ScheduleClient().Return(mockScheduleClient).AnyTimes()
+			mockScheduleClient.EXPECT().Create(ctx, gomock.Any()).Return(nil, fmt.Errorf("create error"))
+
+			err := pool.CreateCounterpartiesLinkSchedule(ctx)
+			Expect(err).NotTo(BeNil())
+			Expect(err.Error()).To(ContainSubstring("failed to create counterparties link schedule"))
+		})
+	})
 })
@@ -0,0 +1,320 @@
+package workflow
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/query"
+	"github.com/formancehq/go-libs/v5/pkg/storage/bun/paginate"
+	"github.com/formancehq/payments/internal/connectors/engine/activities"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/pkg/errors"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// Backfill marks a fetch workflow as a one-off run bounded by Window. It uses
+// its own state so the periodic cursor of the capability is left untouched.
+type Backfill struct {
+	ID     string             `json:"id"`
+	Window models.FetchWindow `json:"window"`
+}
+
+func (b *Backfill) GetWindow() *models.FetchWindow {
+	if b == nil {
+		return nil
+	}
+	return &b.Window
+}
+
+func (b *Backfill) stateReference(reference string) string {
+	if b == nil {
+		return reference
+	}
+	return fmt.Sprintf("%s-backfill-%s", reference, b.ID)
+}
+
+// inBackfillWindow keeps the objects created within the backfill window.
+// Most plugins ignore the window and return their whole history, which a
+// backfill must not upsert.
+func inBackfillWindow[T any](b *Backfill, objects []T, createdAt func(T) time.Time) []T {
+	if b == nil {
+		return objects
+	}
+
+	res := make([]T, 0, len(objects))
+	for _, object := range objects {
+		if b.Window.Contains(createdAt(object)) {
+			res = append(res, object)
+		}
+	}
+	return res
+}
+
+// deleteBackfillState removes the state of a backfill fetch once it is
+// done: unlike the periodic fetches, a backfill never resumes from it.
+func deleteBackfillState(ctx workflow.Context, b *Backfill, stateID models.StateID) error {
+	if b == nil {
+		return nil
+	}
+
+	if err := activities.StorageStatesDeleteFromID(infiniteRetryContext(ctx), stateID); err != nil {
+		return errors.Wrap(err, "deleting backfill state")
+	}
+	return nil
+}
+
+type BackfillConnector struct {
+	ConnectorID   models.ConnectorID
+	TaskID        models.TaskID
+	Capability    models.Capability
+	Window        models.FetchWindow
+	NextPageToken string
+}
+
+var backfillTaskTypes = map[models.Capability]models.TaskType{
+	models.CAPABILITY_FETCH_ACCOUNTS:          models.TASK_FETCH_ACCOUNTS,
+	models.CAPABILITY_FETCH_EXTERNAL_ACCOUNTS: models.TASK_FETCH_EXTERNAL_ACCOUNTS,
+	models.CAPABILITY_FETCH_PAYMENTS:          models.TASK_FETCH_PAYMENTS,
+}
+
+// IsBackfillSupported tells whether a capability can be backfilled.
+func IsBackfillSupported(capability models.Capability) bool {
+	_, ok := backfillTaskTypes[capability]
+	return ok
+}
+
+func (w Workflow) runBackfillConnector(
+	ctx workflow.Context,
+	backfillConnector BackfillConnector,
+) error {
+	err := w.backfillConnector(ctx, backfillConnector)
+	if err != nil {
+		if workflow.IsContinueAsNewError(err) {
+			return err
+		}
+
+		if errUpdateTask := w.updateTasksError(
+			ctx,
+			backfillConnector.TaskID,
+			&backfillConnector.ConnectorID,
+			err,
+		); errUpdateTask != nil {
+			return errUpdateTask
+		}
+
+		return err
+	}
+
+	return w.updateTaskSuccess(
+		ctx,
+		backfillConnector.TaskID,
+		&backfillConnector.ConnectorID,
+		backfillConnector.ConnectorID.String(),
+	)
+}
+
+func (w Workflow) backfillConnector(
+	ctx workflow.Context,
+	backfillConnector BackfillConnector,
+) error {
+	taskType, ok := backfillTaskTypes[backfillConnector.Capability]
+	if !ok {
+		return temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("capability %s cannot be backfilled", backfillConnector.Capability),
+			ErrValidation,
+			nil,
+		)
+	}
+
+	tree, err := activities.StorageConnectorTasksTreeGet(infiniteRetryContext(ctx), backfillConnector.ConnectorID)
+	if err != nil {
+		return err
+	}
+
+	parent, found := findTaskParent(*tree, nil, taskType)
+	if !found {
+		return temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("connector has no task for capability %s", backfillConnector.Capability),
+			ErrValidation,
+			nil,
+		)
+	}
+
+	backfill := &Backfill{
+		ID:     backfillConnector.TaskID.Reference,
+		Window: backfillConnector.Window,
+	}
+
+	if parent == nil {
+		return w.runBackfillFetch(ctx, backfillConnector.ConnectorID, taskType, backfill, nil)
+	}
+
+	// The task depends on accounts already fetched by the connector, replay it
+	// for each of them.
+	var accountType models.AccountType
+	switch parent.TaskType {
+	case models.TASK_FETCH_ACCOUNTS:
+		accountType = models.ACCOUNT_TYPE_INTERNAL
+	case models.TASK_FETCH_EXTERNAL_ACCOUNTS:
+		accountType = models.ACCOUNT_TYPE_EXTERNAL
+	default:
+		return temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("capability %s cannot be backfilled for this connector", backfillConnector.Capability),
+			ErrValidation,
+			nil,
+		)
+	}
+
+	var q storage.ListAccountsQuery
+	if backfillConnector.NextPageToken != "" {
+		err := paginate.UnmarshalCursor(backfillConnector.NextPageToken, &q)
+		if err != nil {
+			return err
+		}
+	} else {
+		q = storage.NewListAccountsQuery(
+			paginate.NewPaginatedQueryOptions(storage.AccountQuery{}).
+				WithPageSize(100).
+				WithQueryBuilder(
+					query.And(
+						query.Match("connector_id", backfillConnector.ConnectorID.String()),
+						query.Match("type", string(accountType)),
+					),
+				),
+		)
+	}
+
+	for {
+		accounts, err := activities.StorageAccountsList(infiniteRetryContext(ctx), q)
+		if err != nil {
+			return err
+		}
+
+		for _, account := range accounts.Data {
+			payload, err := json.Marshal(models.ToPSPAccount(&account))
+			if err != nil {
+				return errors.Wrap(err, "marshalling account")
+			}
+
+			if err := w.runBackfillFetch(
+				ctx,
+				backfillConnector.ConnectorID,
+				taskType,
+				backfill,
+				&FromPayload{
+					ID:      account.Reference,
+					Payload: payload,
+				},
+			); err != nil {
+				return err
+			}
+		}
+
+		if !accounts.HasMore {
+			break
+		}
+
+		err = paginate.UnmarshalCursor(accounts.Next, &q)
+		if err != nil {
+			return err
+		}
+
+		if w.shouldContinueAsNew(ctx) {
+			next := backfillConnector
+			next.NextPageToken = accounts.Next
+			return workflow.NewContinueAsNewError(
+				ctx,
+				RunBackfillConnector,
+				next,
+			)
+		}
+	}
+
+	return nil
+}
+
+func (w Workflow) runBackfillFetch(
+	ctx workflow.Context,
+	connectorID models.ConnectorID,
+	taskType models.TaskType,
+	backfill *Backfill,
+	fromPayload *FromPayload,
+) error {
+	var (
+		name    string
+		request interface{}
+	)
+	switch taskType {
+	case models.TASK_FETCH_ACCOUNTS:
+		name = RunFetchNextAccounts
+		request = FetchNextAccounts{
+			ConnectorID: connectorID,
+			FromPayload: fromPayload,
+			Backfill:    backfill,
+		}
+	case models.TASK_FETCH_EXTERNAL_ACCOUNTS:
+		name = RunFetchNextExternalAccounts
+		request = FetchNextExternalAccounts{
+			ConnectorID: connectorID,
+			FromPayload: fromPayload,
+			Backfill:    backfill,
+		}
+	case models.TASK_FETCH_PAYMENTS:
+		name = RunFetchNextPayments
+		request = FetchNextPayments{
+			ConnectorID: connectorID,
+			FromPayload: fromPayload,
+			Backfill:    backfill,
+		}
+	default:
+		return fmt.Errorf("unsupported backfill task type %d", taskType)
+	}
+
+	// Next tasks are not run on purpose: a backfill only upserts the objects
+	// of the requested capability.
+	if err := workflow.ExecuteChildWorkflow(
+		workflow.WithChildOptions(
+			ctx,
+			workflow.ChildWorkflowOptions{
+				TaskQueue:         w.getDefaultTaskQueue(),
+				ParentClosePolicy: enums.PARENT_CLOSE_POLICY_ABANDON,
+				SearchAttributes:  w.SearchAttributes(ctx, &connectorID),
+			},
+		),
+		name,
+		request,
+		[]models.ConnectorTaskTree{},
+	).Get(ctx, nil); err != nil {
+		return errors.Wrap(err, "running backfill fetch")
+	}
+
+	return nil
+}
+
+// findTaskParent walks the tree looking for the first task of the given type.
+// It returns the parent of that task, nil meaning it is a root task.
+func findTaskParent(
+	tasks []models.ConnectorTaskTree,
+	parent *models.ConnectorTaskTree,
+	taskType models.TaskType,
+) (*models.ConnectorTaskTree, bool) {
+	for i := range tasks {
+		if tasks[i].TaskType == taskType {
+			return parent, true
+		}
+	}
+
+	for i := range tasks {
+		if p, found := findTaskParent(tasks[i].NextTasks, &tasks[i], taskType); found {
+			return p, true
+		}
+	}
+
+	return nil, false
+}
+
+const RunBackfillConnector = "BackfillConnector"
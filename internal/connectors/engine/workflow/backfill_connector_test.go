@@ -0,0 +1,120 @@
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/storage/bun/paginate"
+	"github.com/formancehq/payments/internal/connectors/engine/activities"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/stretchr/testify/mock"
+	"go.temporal.io/sdk/workflow"
+)
+
+func (s *UnitTestSuite) backfillTasksTree() *models.ConnectorTasksTree {
+	return &models.ConnectorTasksTree{
+		{
+			TaskType: models.TASK_FETCH_ACCOUNTS,
+			NextTasks: []models.ConnectorTaskTree{
+				{TaskType: models.TASK_FETCH_PAYMENTS},
+			},
+		},
+	}
+}
+
+func (s *UnitTestSuite) backfillConnector(capability models.Capability) BackfillConnector {
+	return BackfillConnector{
+		ConnectorID: s.connectorID,
+		TaskID: models.TaskID{
+			Reference:   "test",
+			ConnectorID: s.connectorID,
+		},
+		Capability: capability,
+		Window: models.FetchWindow{
+			From: s.env.Now().UTC().Add(-24 * time.Hour),
+			To:   s.env.Now().UTC(),
+		},
+	}
+}
+
+func (s *UnitTestSuite) Test_BackfillConnector_RootTask_Success() {
+	req := s.backfillConnector(models.CAPABILITY_FETCH_ACCOUNTS)
+
+	s.env.OnActivity(activities.StorageConnectorTasksTreeGetActivity, mock.Anything, s.connectorID).Once().Return(s.backfillTasksTree(), nil)
+	s.env.OnWorkflow(RunFetchNextAccounts, mock.Anything, mock.Anything, mock.Anything).Once().Return(func(ctx workflow.Context, fetch FetchNextAccounts, nextTasks []models.ConnectorTaskTree) error {
+		s.Nil(fetch.FromPayload)
+		s.Empty(nextTasks)
+		s.Equal(&Backfill{ID: "test", Window: req.Window}, fetch.Backfill)
+		return nil
+	})
+	s.env.OnActivity(activities.StorageTasksStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, task models.Task) error {
+		s.Equal(models.TASK_STATUS_SUCCEEDED, task.Status)
+		return nil
+	})
+
+	s.env.ExecuteWorkflow(RunBackfillConnector, req)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *UnitTestSuite) Test_BackfillConnector_NestedTask_Success() {
+	req := s.backfillConnector(models.CAPABILITY_FETCH_PAYMENTS)
+
+	s.env.OnActivity(activities.StorageConnectorTasksTreeGetActivity, mock.Anything, s.connectorID).Once().Return(s.backfillTasksTree(), nil)
+	s.env.OnActivity(activities.StorageAccountsListActivity, mock.Anything, mock.Anything).Once().Return(
+		&paginate.Cursor[models.Account]{HasMore: false, Data: []models.Account{s.account}},
+		nil,
+	)
+	s.env.OnWorkflow(RunFetchNextPayments, mock.Anything, mock.Anything, mock.Anything).Once().Return(func(ctx workflow.Context, fetch FetchNextPayments, nextTasks []models.ConnectorTaskTree) error {
+		s.NotNil(fetch.FromPayload)
+		s.Equal(s.account.Reference, fetch.FromPayload.ID)
+		s.NotNil(fetch.Backfill)
+		return nil
+	})
+	s.env.OnActivity(activities.StorageTasksStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, task models.Task) error {
+		s.Equal(models.TASK_STATUS_SUCCEEDED, task.Status)
+		return nil
+	})
+
+	s.env.ExecuteWorkflow(RunBackfillConnector, req)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *UnitTestSuite) Test_BackfillConnector_CapabilityNotInTree_Error() {
+	req := s.backfillConnector(models.CAPABILITY_FETCH_EXTERNAL_ACCOUNTS)
+
+	s.env.OnActivity(activities.StorageConnectorTasksTreeGetActivity, mock.Anything, s.connectorID).Once().Return(s.backfillTasksTree(), nil)
+	s.env.OnActivity(activities.StorageTasksStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, task models.Task) error {
+		s.Equal(models.TASK_STATUS_FAILED, task.Status)
+		return nil
+	})
+
+	s.env.ExecuteWorkflow(RunBackfillConnector, req)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	s.ErrorContains(err, ErrValidation)
+}
+
+func (s *UnitTestSuite) Test_BackfillConnector_FetchWorkflow_Error() {
+	req := s.backfillConnector(models.CAPABILITY_FETCH_ACCOUNTS)
+
+	s.env.OnActivity(activities.StorageConnectorTasksTreeGetActivity, mock.Anything, s.connectorID).Once().Return(s.backfillTasksTree(), nil)
+	s.env.OnWorkflow(RunFetchNextAccounts, mock.Anything, mock.Anything, mock.Anything).Once().Return(fmt.Errorf("error-test"))
+	s.env.OnActivity(activities.StorageTasksStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, task models.Task) error {
+		s.Equal(models.TASK_STATUS_FAILED, task.Status)
+		return nil
+	})
+
+	s.env.ExecuteWorkflow(RunBackfillConnector, req)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	s.ErrorContains(err, "error-test")
+}
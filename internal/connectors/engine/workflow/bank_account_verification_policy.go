@@ -0,0 +1,83 @@
+package workflow
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/formancehq/payments/internal/connectors/engine/activities"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// checkBankAccountVerification rejects the payout when the bank account
+// behind its destination account was not verified with a good enough result.
+// Only the latest verification of the bank account is taken into account.
+func (w Workflow) checkBankAccountVerification(
+	ctx workflow.Context,
+	pi *models.PaymentInitiation,
+) error {
+	if !IsBankAccountVerificationPolicyEnabled(ctx) {
+		return nil
+	}
+
+	// The worker configuration can change between two replays of the
+	// workflow, the one seen by the first execution is kept in its history.
+	var minimum models.BankAccountVerificationResult
+	err := workflow.SideEffect(ctx, func(ctx workflow.Context) any {
+		return w.bankAccountVerification
+	}).Get(&minimum)
+	if err != nil {
+		return err
+	}
+
+	if minimum == models.BANK_ACCOUNT_VERIFICATION_RESULT_UNKNOWN {
+		return nil
+	}
+
+	if pi.DestinationAccountID == nil {
+		return nil
+	}
+
+	var errRejected error
+	bankAccount, err := activities.StorageBankAccountsGetFromRelatedAccountID(
+		infiniteRetryContext(ctx),
+		*pi.DestinationAccountID,
+	)
+	switch {
+	case err != nil && isStorageNotFoundError(err):
+		errRejected = errors.New("destination account is not linked to a verified bank account")
+	case err != nil:
+		return err
+	case len(bankAccount.Verifications) == 0:
+		errRejected = fmt.Errorf("bank account %s was never verified", bankAccount.ID)
+	default:
+		latest := bankAccount.Verifications[0]
+		if latest.Result.Accepts(minimum) {
+			return nil
+		}
+		errRejected = fmt.Errorf("bank account %s verification result is %s", bankAccount.ID, latest.Result)
+	}
+
+	err = w.addPIAdjustment(
+		ctx,
+		models.PaymentInitiationAdjustmentID{
+			PaymentInitiationID: pi.ID,
+			CreatedAt:           workflow.Now(ctx),
+			Status:              models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_REJECTED,
+		},
+		pi.Amount,
+		&pi.Asset,
+		errRejected,
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	return temporal.NewNonRetryableApplicationError(
+		"payment initiation rejected by bank account verification policy",
+		ErrValidation,
+		errRejected,
+	)
+}
@@ -0,0 +1,146 @@
+package workflow
+
+import (
+	"context"
+
+	"github.com/formancehq/payments/internal/connectors/engine/activities"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/stretchr/testify/mock"
+	"go.temporal.io/sdk/temporal"
+	temporalworkflow "go.temporal.io/sdk/workflow"
+)
+
+func (s *UnitTestSuite) enableBankAccountVerification(minimum models.BankAccountVerificationResult) {
+	w := s.w.WithBankAccountVerification(minimum)
+	s.env.RegisterWorkflowWithOptions(w.runCreatePayout, temporalworkflow.RegisterOptions{
+		Name:                          RunCreatePayout,
+		DisableAlreadyRegisteredCheck: true,
+	})
+}
+
+func (s *UnitTestSuite) verifiedBankAccount(results ...models.BankAccountVerificationResult) *models.BankAccount {
+	ba := s.bankAccount
+	for _, result := range results {
+		ba.Verifications = append(ba.Verifications, models.BankAccountVerification{
+			BankAccountID: ba.ID,
+			ConnectorID:   s.connectorID,
+			Name:          ba.Name,
+			Result:        result,
+		})
+	}
+	return &ba
+}
+
+func (s *UnitTestSuite) Test_CreatePayout_BankAccountVerification_Match_Success() {
+	s.enableBankAccountVerification(models.BANK_ACCOUNT_VERIFICATION_RESULT_MATCH)
+
+	s.env.OnActivity(activities.StoragePaymentInitiationsGetActivity, mock.Anything, s.paymentInitiationID).Once().Return(&s.paymentInitiationPayout, nil)
+	s.env.OnActivity(activities.StorageAccountsGetActivity, mock.Anything, *s.paymentInitiationPayout.SourceAccountID).Once().Return(&s.account, nil)
+	s.env.OnActivity(activities.StorageAccountsGetActivity, mock.Anything, *s.paymentInitiationPayout.DestinationAccountID).Once().Return(&s.account, nil)
+	s.env.OnActivity(activities.StorageBankAccountsGetFromRelatedAccountIDActivity, mock.Anything, *s.paymentInitiationPayout.DestinationAccountID).Once().Return(
+		// Only the latest verification counts
+		s.verifiedBankAccount(models.BANK_ACCOUNT_VERIFICATION_RESULT_MATCH, models.BANK_ACCOUNT_VERIFICATION_RESULT_NO_MATCH),
+		nil,
+	)
+	s.env.OnActivity(activities.StoragePaymentInitiationsAdjustmentsStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, adj models.PaymentInitiationAdjustment) error {
+		s.Equal(models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PROCESSING, adj.Status)
+		return nil
+	})
+	s.env.OnActivity(activities.PluginCreatePayoutActivity, mock.Anything, mock.Anything).Once().Return(&models.CreatePayoutResponse{
+		Payment: &s.pspPayment,
+	}, nil)
+	s.env.OnActivity(activities.StoragePaymentsStoreActivity, mock.Anything, mock.Anything).Once().Return(nil)
+	s.env.OnActivity(activities.StoragePaymentInitiationsRelatedPaymentsStoreActivity, mock.Anything, mock.Anything).Once().Return(nil)
+	s.env.OnActivity(activities.StoragePaymentInitiationsAdjustmentsStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, adj models.PaymentInitiationAdjustment) error {
+		s.Equal(models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PROCESSED, adj.Status)
+		return nil
+	})
+	s.env.OnActivity(activities.StorageTasksStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, task models.Task) error {
+		s.Equal(models.TASK_STATUS_SUCCEEDED, task.Status)
+		return nil
+	})
+
+	s.env.ExecuteWorkflow(RunCreatePayout, CreatePayout{
+		TaskID: models.TaskID{
+			Reference:   "test",
+			ConnectorID: s.connectorID,
+		},
+		ConnectorID:         s.connectorID,
+		PaymentInitiationID: s.paymentInitiationID,
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *UnitTestSuite) Test_CreatePayout_BankAccountVerification_Rejected_Error() {
+	tests := []struct {
+		name          string
+		minimum       models.BankAccountVerificationResult
+		bankAccount   *models.BankAccount
+		storageErr    error
+		expectedError string
+	}{
+		{
+			name:          "no match",
+			minimum:       models.BANK_ACCOUNT_VERIFICATION_RESULT_CLOSE_MATCH,
+			bankAccount:   s.verifiedBankAccount(models.BANK_ACCOUNT_VERIFICATION_RESULT_NO_MATCH, models.BANK_ACCOUNT_VERIFICATION_RESULT_MATCH),
+			expectedError: "verification result is NO_MATCH",
+		},
+		{
+			name:          "close match when a match is required",
+			minimum:       models.BANK_ACCOUNT_VERIFICATION_RESULT_MATCH,
+			bankAccount:   s.verifiedBankAccount(models.BANK_ACCOUNT_VERIFICATION_RESULT_CLOSE_MATCH),
+			expectedError: "verification result is CLOSE_MATCH",
+		},
+		{
+			name:          "never verified",
+			minimum:       models.BANK_ACCOUNT_VERIFICATION_RESULT_MATCH,
+			bankAccount:   s.verifiedBankAccount(),
+			expectedError: "was never verified",
+		},
+		{
+			name:          "not linked to a bank account",
+			minimum:       models.BANK_ACCOUNT_VERIFICATION_RESULT_MATCH,
+			storageErr:    temporal.NewNonRetryableApplicationError(storage.ErrNotFound.Error(), activities.ErrTypeStorage, storage.ErrNotFound),
+			expectedError: "not linked to a verified bank account",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.SetupTest()
+			s.enableBankAccountVerification(tt.minimum)
+
+			s.env.OnActivity(activities.StoragePaymentInitiationsGetActivity, mock.Anything, s.paymentInitiationID).Once().Return(&s.paymentInitiationPayout, nil)
+			s.env.OnActivity(activities.StorageAccountsGetActivity, mock.Anything, *s.paymentInitiationPayout.SourceAccountID).Once().Return(&s.account, nil)
+			s.env.OnActivity(activities.StorageAccountsGetActivity, mock.Anything, *s.paymentInitiationPayout.DestinationAccountID).Once().Return(&s.account, nil)
+			s.env.OnActivity(activities.StorageBankAccountsGetFromRelatedAccountIDActivity, mock.Anything, *s.paymentInitiationPayout.DestinationAccountID).Once().Return(tt.bankAccount, tt.storageErr)
+			s.env.OnActivity(activities.StoragePaymentInitiationsAdjustmentsStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, adj models.PaymentInitiationAdjustment) error {
+				s.Equal(models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_REJECTED, adj.Status)
+				s.ErrorContains(adj.Error, tt.expectedError)
+				return nil
+			})
+			s.env.OnActivity(activities.StorageTasksStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, task models.Task) error {
+				s.Equal(models.TASK_STATUS_FAILED, task.Status)
+				return nil
+			})
+
+			s.env.ExecuteWorkflow(RunCreatePayout, CreatePayout{
+				TaskID: models.TaskID{
+					Reference:   "test",
+					ConnectorID: s.connectorID,
+				},
+				ConnectorID:         s.connectorID,
+				PaymentInitiationID: s.paymentInitiationID,
+			})
+
+			s.True(s.env.IsWorkflowCompleted())
+			err := s.env.GetWorkflowError()
+			s.Error(err)
+			s.ErrorContains(err, "payment initiation rejected by bank account verification policy")
+			s.env.AssertNotCalled(s.T(), "PluginCreatePayout", mock.Anything, mock.Anything)
+		})
+	}
+}
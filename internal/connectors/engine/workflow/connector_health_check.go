@@ -19,6 +19,7 @@ var fetchCapabilities = []models.Capability{
 	models.CAPABILITY_FETCH_OTHERS,
 	models.CAPABILITY_FETCH_CONVERSIONS,
 	models.CAPABILITY_FETCH_ORDERS,
+	models.CAPABILITY_FETCH_DISPUTES,
 }
 
 type ConnectorHealthCheck struct {
@@ -69,6 +70,12 @@ func (w Workflow) connectorHealthCheck(ctx workflow.Context, req ConnectorHealth
 			if err := activities.TemporalSchedulesPause(infiniteRetryContext(ctx), toPause); err != nil {
 				return err
 			}
+
+			if IsConnectorHealthRefreshEnabled(ctx) {
+				if err := activities.StorageConnectorHealthRefresh(infiniteRetryContext(ctx), req.ConnectorID); err != nil {
+					return err
+				}
+			}
 		}
 
 		if !result.HasMore {
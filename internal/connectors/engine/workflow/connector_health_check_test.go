@@ -35,6 +35,7 @@ func (s *UnitTestSuite) Test_ConnectorHealthCheck_NoErrors_Success() {
 
 	s.True(s.env.IsWorkflowCompleted())
 	s.NoError(s.env.GetWorkflowError())
+	s.env.AssertActivityNotCalled(s.T(), "StorageConnectorHealthRefresh", mock.Anything, mock.Anything)
 }
 
 func (s *UnitTestSuite) Test_ConnectorHealthCheck_PausesFetchSchedules_Success() {
@@ -56,6 +57,7 @@ func (s *UnitTestSuite) Test_ConnectorHealthCheck_PausesFetchSchedules_Success()
 
 	s.True(s.env.IsWorkflowCompleted())
 	s.NoError(s.env.GetWorkflowError())
+	s.env.AssertActivityCalled(s.T(), "StorageConnectorHealthRefresh", mock.Anything, s.connectorID)
 }
 
 func (s *UnitTestSuite) Test_ConnectorHealthCheck_AllCapabilities_Success() {
@@ -67,6 +69,7 @@ func (s *UnitTestSuite) Test_ConnectorHealthCheck_AllCapabilities_Success() {
 		fmt.Sprintf("test-%s-FETCH_OTHERS", s.connectorID.String()),
 		fmt.Sprintf("test-%s-FETCH_CONVERSIONS", s.connectorID.String()),
 		fmt.Sprintf("test-%s-FETCH_ORDERS", s.connectorID.String()),
+		fmt.Sprintf("test-%s-FETCH_DISPUTES", s.connectorID.String()),
 	}
 	instances := make([]models.Instance, len(wantIDs))
 	for i, id := range wantIDs {
@@ -36,3 +36,26 @@ func (w Workflow) connectorPollingPeriod(ctx workflow.Context, connectorID model
 	}
 	return config.PollingPeriod, nil
 }
+
+// connectorSchedulePolicy returns the polling period and blackout windows to
+// schedule the given capability with. Workflows started before per-capability
+// policies existed keep the connector-wide polling period and no blackout.
+func (w Workflow) connectorSchedulePolicy(
+	ctx workflow.Context,
+	connectorID models.ConnectorID,
+	capability models.Capability,
+) (models.SchedulePolicy, error) {
+	if IsCapabilitySchedulePolicyEnabled(ctx) {
+		policy, err := activities.StorageConnectorsGetSchedulePolicy(infiniteRetryContext(ctx), connectorID, capability)
+		if err != nil {
+			return models.SchedulePolicy{}, fmt.Errorf("getting connector schedule policy: %w", err)
+		}
+		return policy, nil
+	}
+
+	pollingPeriod, err := w.connectorPollingPeriod(ctx, connectorID)
+	if err != nil {
+		return models.SchedulePolicy{}, err
+	}
+	return models.SchedulePolicy{PollingPeriod: pollingPeriod}, nil
+}
@@ -24,6 +24,21 @@ func infiniteRetryWithLongTimeoutContext(ctx workflow.Context) workflow.Context
 	)
 }
 
+// maximumAttemptsRetryContext retries the activity with the same backoff as
+// infiniteRetryContext, giving up after the given number of attempts.
+func maximumAttemptsRetryContext(ctx workflow.Context, maximumAttempts int32) workflow.Context {
+	return workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: models.ActivityStartToCloseTimeoutMinutesDefault * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:        time.Second,
+			BackoffCoefficient:     2,
+			MaximumInterval:        100 * time.Second,
+			MaximumAttempts:        maximumAttempts,
+			NonRetryableErrorTypes: []string{},
+		},
+	})
+}
+
 func infiniteRetryWithCustomStartToCloseAndHeartbeatContext(ctx workflow.Context, startToCloseTimeout, heartbeatTimeout time.Duration) workflow.Context {
 	ao := workflow.ActivityOptions{
 		RetryPolicy: &temporal.RetryPolicy{
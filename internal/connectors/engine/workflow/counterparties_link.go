@@ -0,0 +1,44 @@
+package workflow
+
+import (
+	"time"
+
+	"github.com/formancehq/payments/internal/connectors/engine/activities"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+const (
+	LINK_COUNTERPARTIES_BATCH_SIZE = 500
+	// Bounds the history of a run when many accounts are pending, the next
+	// run picks up the remaining ones.
+	LINK_COUNTERPARTIES_MAX_BATCHES = 10
+)
+
+func (w Workflow) runCounterpartiesLink(ctx workflow.Context) error {
+	activityOptions := workflow.ActivityOptions{
+		StartToCloseTimeout: 1 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 1, // No retries - the next run picks them up
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, activityOptions)
+
+	for i := 0; i < LINK_COUNTERPARTIES_MAX_BATCHES; i++ {
+		linked, err := activities.CounterpartiesLinkPendingAccounts(
+			ctx,
+			LINK_COUNTERPARTIES_BATCH_SIZE,
+		)
+		if err != nil {
+			return err
+		}
+
+		if linked < LINK_COUNTERPARTIES_BATCH_SIZE {
+			break
+		}
+	}
+
+	return nil
+}
+
+const RunCounterpartiesLink = "CounterpartiesLink"
@@ -0,0 +1,40 @@
+package workflow
+
+import (
+	"errors"
+
+	"github.com/formancehq/payments/internal/connectors/engine/activities"
+	"github.com/stretchr/testify/mock"
+	"go.temporal.io/sdk/temporal"
+)
+
+func (s *UnitTestSuite) Test_RunCounterpartiesLink_Success() {
+	s.env.OnActivity(activities.CounterpartiesLinkPendingAccountsActivity, mock.Anything, LINK_COUNTERPARTIES_BATCH_SIZE).Once().Return(LINK_COUNTERPARTIES_BATCH_SIZE, nil)
+	s.env.OnActivity(activities.CounterpartiesLinkPendingAccountsActivity, mock.Anything, LINK_COUNTERPARTIES_BATCH_SIZE).Once().Return(3, nil)
+
+	s.env.ExecuteWorkflow(RunCounterpartiesLink)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *UnitTestSuite) Test_RunCounterpartiesLink_StopsAfterMaxBatches() {
+	s.env.OnActivity(activities.CounterpartiesLinkPendingAccountsActivity, mock.Anything, LINK_COUNTERPARTIES_BATCH_SIZE).Times(LINK_COUNTERPARTIES_MAX_BATCHES).Return(LINK_COUNTERPARTIES_BATCH_SIZE, nil)
+
+	s.env.ExecuteWorkflow(RunCounterpartiesLink)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *UnitTestSuite) Test_RunCounterpartiesLink_ActivityError() {
+	expectedErr := temporal.NewNonRetryableApplicationError("error-test", "ACTIVITY", errors.New("error-test"))
+	s.env.OnActivity(activities.CounterpartiesLinkPendingAccountsActivity, mock.Anything, LINK_COUNTERPARTIES_BATCH_SIZE).Once().Return(0, expectedErr)
+
+	s.env.ExecuteWorkflow(RunCounterpartiesLink)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	s.ErrorContains(err, "error-test")
+}
@@ -0,0 +1,88 @@
+package workflow
+
+import (
+	"github.com/formancehq/payments/internal/connectors/engine/activities"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+type CreateAccount struct {
+	TaskID      models.TaskID
+	ConnectorID models.ConnectorID
+	Request     models.CreateAccountRequest
+}
+
+func (w Workflow) runCreateAccount(
+	ctx workflow.Context,
+	createAccount CreateAccount,
+) error {
+	accountID, err := w.createAccount(ctx, createAccount)
+	if err != nil {
+		if errUpdateTask := w.updateTasksError(
+			ctx,
+			createAccount.TaskID,
+			&createAccount.ConnectorID,
+			err,
+		); errUpdateTask != nil {
+			return errUpdateTask
+		}
+
+		return err
+	}
+
+	return w.updateTaskSuccess(
+		ctx,
+		createAccount.TaskID,
+		&createAccount.ConnectorID,
+		accountID,
+	)
+}
+
+func (w Workflow) createAccount(
+	ctx workflow.Context,
+	createAccount CreateAccount,
+) (string, error) {
+	// Plugins send the request reference as the idempotency key of the
+	// creation, retrying does not issue a second account on the PSP.
+	createAccountResponse, err := activities.PluginCreateAccount(
+		infiniteRetryContext(ctx),
+		createAccount.ConnectorID,
+		createAccount.Request,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	pspAccount := createAccountResponse.Account
+	// The account is issued for the payment service user, the incoming
+	// payments received on it are then attributed to them.
+	if pspAccount.PsuID == nil && createAccount.Request.PaymentServiceUser != nil {
+		pspAccount.PsuID = &createAccount.Request.PaymentServiceUser.ID
+	}
+
+	account, err := models.FromPSPAccount(
+		pspAccount,
+		models.ACCOUNT_TYPE_INTERNAL,
+		createAccount.ConnectorID,
+	)
+	if err != nil {
+		return "", temporal.NewNonRetryableApplicationError(
+			"failed to translate accounts",
+			ErrValidation,
+			err,
+		)
+	}
+
+	err = activities.StorageAccountsStore(
+		infiniteRetryContext(ctx),
+		[]models.Account{account},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return account.ID.String(), nil
+}
+
+const RunCreateAccount = "CreateAccount"
@@ -0,0 +1,112 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+
+	"github.com/formancehq/payments/internal/connectors/engine/activities"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"go.temporal.io/sdk/temporal"
+)
+
+func (s *UnitTestSuite) Test_CreateAccount_Success() {
+	psuID := uuid.New()
+	s.env.OnActivity(activities.PluginCreateAccountActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, request activities.CreateAccountRequest) (*models.CreateAccountResponse, error) {
+		s.Equal(s.connectorID, request.ConnectorID)
+		s.Equal("virtual-1", request.Req.Reference)
+		s.Equal(psuID, request.Req.PaymentServiceUser.ID)
+		return &models.CreateAccountResponse{
+			Account: s.pspAccount,
+		}, nil
+	})
+	s.env.OnActivity(activities.StorageAccountsStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, accounts []models.Account) error {
+		s.Equal(1, len(accounts))
+		s.Equal(s.accountID, accounts[0].ID)
+		s.Equal(models.ACCOUNT_TYPE_INTERNAL, accounts[0].Type)
+		s.NotNil(accounts[0].PsuID)
+		s.Equal(psuID, *accounts[0].PsuID)
+		return nil
+	})
+	s.env.OnActivity(activities.StorageTasksStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, task models.Task) error {
+		s.Equal(models.TASK_STATUS_SUCCEEDED, task.Status)
+		s.Equal(s.accountID.String(), *task.CreatedObjectID)
+		return nil
+	})
+
+	s.env.ExecuteWorkflow(RunCreateAccount, CreateAccount{
+		TaskID: models.TaskID{
+			Reference:   "test",
+			ConnectorID: s.connectorID,
+		},
+		ConnectorID: s.connectorID,
+		Request: models.CreateAccountRequest{
+			Reference: "virtual-1",
+			Name:      "test",
+			PaymentServiceUser: &models.PSPPaymentServiceUser{
+				ID:   psuID,
+				Name: "test",
+			},
+		},
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *UnitTestSuite) Test_CreateAccount_PluginCreateAccount_Error() {
+	s.env.OnActivity(activities.PluginCreateAccountActivity, mock.Anything, mock.Anything).Once().Return(
+		nil,
+		temporal.NewNonRetryableApplicationError("error-test", "error-test", errors.New("error-test")),
+	)
+	s.env.OnActivity(activities.StorageTasksStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, task models.Task) error {
+		s.Equal(models.TASK_STATUS_FAILED, task.Status)
+		return nil
+	})
+
+	s.env.ExecuteWorkflow(RunCreateAccount, CreateAccount{
+		TaskID: models.TaskID{
+			Reference:   "test",
+			ConnectorID: s.connectorID,
+		},
+		ConnectorID: s.connectorID,
+		Request: models.CreateAccountRequest{
+			Reference: "virtual-1",
+		},
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	s.ErrorContains(err, "error-test")
+}
+
+func (s *UnitTestSuite) Test_CreateAccount_StorageAccountsStore_Error() {
+	s.env.OnActivity(activities.PluginCreateAccountActivity, mock.Anything, mock.Anything).Once().Return(&models.CreateAccountResponse{
+		Account: s.pspAccount,
+	}, nil)
+	s.env.OnActivity(activities.StorageAccountsStoreActivity, mock.Anything, mock.Anything).Once().Return(
+		temporal.NewNonRetryableApplicationError("error-test", "error-test", errors.New("error-test")),
+	)
+	s.env.OnActivity(activities.StorageTasksStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, task models.Task) error {
+		s.Equal(models.TASK_STATUS_FAILED, task.Status)
+		return nil
+	})
+
+	s.env.ExecuteWorkflow(RunCreateAccount, CreateAccount{
+		TaskID: models.TaskID{
+			Reference:   "test",
+			ConnectorID: s.connectorID,
+		},
+		ConnectorID: s.connectorID,
+		Request: models.CreateAccountRequest{
+			Reference: "virtual-1",
+		},
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	s.ErrorContains(err, "error-test")
+}
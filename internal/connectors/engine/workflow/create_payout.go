@@ -87,6 +87,14 @@ func (w Workflow) createPayout(
 		return err
 	}
 
+	if err := w.checkBankAccountVerification(ctx, pi); err != nil {
+		return err
+	}
+
+	if err := w.screenPaymentInitiation(ctx, pi, pspPI); err != nil {
+		return err
+	}
+
 	err = w.addPIAdjustment(
 		ctx,
 		models.PaymentInitiationAdjustmentID{
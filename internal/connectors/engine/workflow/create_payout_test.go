@@ -73,6 +73,49 @@ func (s *UnitTestSuite) Test_CreatePayout_WithPayment_Success() {
 	s.NoError(s.env.GetWorkflowError())
 }
 
+func (s *UnitTestSuite) Test_CreatePayout_WithOpenBankingAccount_Success() {
+	connectionID := "test-connection"
+	destinationAccount := s.account
+	destinationAccount.PsuID = &s.paymentServiceUser.ID
+	destinationAccount.OpenBankingConnectionID = &connectionID
+
+	s.env.OnActivity(activities.StoragePaymentInitiationsGetActivity, mock.Anything, s.paymentInitiationID).Once().Return(&s.paymentInitiationPayout, nil)
+	s.env.OnActivity(activities.StorageAccountsGetActivity, mock.Anything, *s.paymentInitiationPayout.SourceAccountID).Once().Return(&s.account, nil)
+	s.env.OnActivity(activities.StorageAccountsGetActivity, mock.Anything, *s.paymentInitiationPayout.DestinationAccountID).Once().Return(&destinationAccount, nil)
+	s.env.OnActivity(activities.StoragePaymentInitiationsAdjustmentsStoreActivity, mock.Anything, mock.Anything).Once().Return(nil)
+	s.env.OnActivity(activities.PluginCreatePayoutActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, req activities.CreatePayoutRequest) (*models.CreatePayoutResponse, error) {
+		// The connection is resolved by the plugin activity itself, so that
+		// the access token is not recorded in the workflow history.
+		s.Nil(req.Req.PaymentServiceUser)
+		s.Nil(req.Req.OpenBankingConnection)
+		s.NotNil(req.Req.PaymentInitiation.DestinationAccount)
+		s.Equal(&connectionID, req.Req.PaymentInitiation.DestinationAccount.OpenBankingConnectionID)
+		s.Equal(&s.paymentServiceUser.ID, req.Req.PaymentInitiation.DestinationAccount.PsuID)
+		return &models.CreatePayoutResponse{
+			Payment: &s.pspPayment,
+		}, nil
+	})
+	s.env.OnActivity(activities.StoragePaymentsStoreActivity, mock.Anything, mock.Anything).Once().Return(nil)
+	s.env.OnActivity(activities.StoragePaymentInitiationsRelatedPaymentsStoreActivity, mock.Anything, mock.Anything).Once().Return(nil)
+	s.env.OnActivity(activities.StoragePaymentInitiationsAdjustmentsStoreActivity, mock.Anything, mock.Anything).Once().Return(nil)
+	s.env.OnActivity(activities.StorageTasksStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, task models.Task) error {
+		s.Equal(models.TASK_STATUS_SUCCEEDED, task.Status)
+		return nil
+	})
+
+	s.env.ExecuteWorkflow(RunCreatePayout, CreatePayout{
+		TaskID: models.TaskID{
+			Reference:   "test",
+			ConnectorID: s.connectorID,
+		},
+		ConnectorID:         s.connectorID,
+		PaymentInitiationID: s.paymentInitiationID,
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
 func (s *UnitTestSuite) Test_CreatePayout_WithScheduledAt_WithPayment_Success() {
 	paymentInitiationPayout := s.paymentInitiationPayout
 	paymentInitiationPayout.ScheduledAt = s.env.Now().Add(1 * time.Hour)
@@ -87,6 +87,10 @@ func (w Workflow) createTransfer(
 		return err
 	}
 
+	if err := w.screenPaymentInitiation(ctx, pi, pspPI); err != nil {
+		return err
+	}
+
 	err = w.addPIAdjustment(
 		ctx,
 		models.PaymentInitiationAdjustmentID{
@@ -70,6 +70,49 @@ func (s *UnitTestSuite) Test_CreateTransfer_WithPayment_Success() {
 	s.NoError(s.env.GetWorkflowError())
 }
 
+func (s *UnitTestSuite) Test_CreateTransfer_WithOpenBankingAccount_Success() {
+	connectionID := "test-connection"
+	sourceAccount := s.account
+	sourceAccount.PsuID = &s.paymentServiceUser.ID
+	sourceAccount.OpenBankingConnectionID = &connectionID
+
+	s.env.OnActivity(activities.StoragePaymentInitiationsGetActivity, mock.Anything, s.paymentInitiationID).Once().Return(&s.paymentInitiationTransfer, nil)
+	s.env.OnActivity(activities.StorageAccountsGetActivity, mock.Anything, *s.paymentInitiationTransfer.SourceAccountID).Once().Return(&sourceAccount, nil)
+	s.env.OnActivity(activities.StorageAccountsGetActivity, mock.Anything, *s.paymentInitiationTransfer.DestinationAccountID).Once().Return(&s.account, nil)
+	s.env.OnActivity(activities.StoragePaymentInitiationsAdjustmentsStoreActivity, mock.Anything, mock.Anything).Once().Return(nil)
+	s.env.OnActivity(activities.PluginCreateTransferActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, req activities.CreateTransferRequest) (*models.CreateTransferResponse, error) {
+		// The connection is resolved by the plugin activity itself, so that
+		// the access token is not recorded in the workflow history.
+		s.Nil(req.Req.PaymentServiceUser)
+		s.Nil(req.Req.OpenBankingConnection)
+		s.NotNil(req.Req.PaymentInitiation.SourceAccount)
+		s.Equal(&connectionID, req.Req.PaymentInitiation.SourceAccount.OpenBankingConnectionID)
+		s.Equal(&s.paymentServiceUser.ID, req.Req.PaymentInitiation.SourceAccount.PsuID)
+		return &models.CreateTransferResponse{
+			Payment: &s.pspPayment,
+		}, nil
+	})
+	s.env.OnActivity(activities.StoragePaymentsStoreActivity, mock.Anything, mock.Anything).Once().Return(nil)
+	s.env.OnActivity(activities.StoragePaymentInitiationsRelatedPaymentsStoreActivity, mock.Anything, mock.Anything).Once().Return(nil)
+	s.env.OnActivity(activities.StoragePaymentInitiationsAdjustmentsStoreActivity, mock.Anything, mock.Anything).Once().Return(nil)
+	s.env.OnActivity(activities.StorageTasksStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, task models.Task) error {
+		s.Equal(models.TASK_STATUS_SUCCEEDED, task.Status)
+		return nil
+	})
+
+	s.env.ExecuteWorkflow(RunCreateTransfer, CreateTransfer{
+		TaskID: models.TaskID{
+			Reference:   "test",
+			ConnectorID: s.connectorID,
+		},
+		ConnectorID:         s.connectorID,
+		PaymentInitiationID: s.paymentInitiationID,
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
 func (s *UnitTestSuite) Test_CreateTransfer_WithScheduledAt_WithPayment_Success() {
 	paymentInitiationTransfer := s.paymentInitiationTransfer
 	paymentInitiationTransfer.ScheduledAt = s.env.Now().Add(1 * time.Hour)
@@ -3,6 +3,7 @@ package workflow
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/formancehq/payments/internal/connectors/engine/activities"
 	"github.com/formancehq/payments/internal/connectors/plugins/registry"
@@ -16,6 +17,7 @@ type FetchNextAccounts struct {
 	ConnectorID  models.ConnectorID `json:"connectorID"`
 	FromPayload  *FromPayload       `json:"fromPayload"`
 	Periodically bool               `json:"periodically"`
+	Backfill     *Backfill          `json:"backfill,omitempty"`
 }
 
 func (w Workflow) runFetchNextAccounts(
@@ -41,7 +43,7 @@ func (w Workflow) fetchAccounts(
 	}
 
 	stateID := models.StateID{
-		Reference:   stateReference,
+		Reference:   fetchNextAccount.Backfill.stateReference(stateReference),
 		ConnectorID: fetchNextAccount.ConnectorID,
 	}
 	state, err := activities.StorageStatesGet(infiniteRetryContext(ctx), stateID)
@@ -63,12 +65,19 @@ func (w Workflow) fetchAccounts(
 			fetchNextAccount.FromPayload.GetPayload(),
 			state.State,
 			int(pageSize),
+			fetchNextAccount.Backfill.GetWindow(),
 			fetchNextAccount.Periodically,
 		)
 		if err != nil {
 			return errors.Wrap(err, "fetching next accounts")
 		}
 
+		accountsResponse.Accounts = inBackfillWindow(
+			fetchNextAccount.Backfill,
+			accountsResponse.Accounts,
+			func(o models.PSPAccount) time.Time { return o.CreatedAt },
+		)
+
 		accounts, err := models.FromPSPAccounts(
 			accountsResponse.Accounts,
 			models.ACCOUNT_TYPE_INTERNAL,
@@ -191,7 +200,7 @@ func (w Workflow) fetchAccounts(
 		}
 	}
 
-	return nil
+	return deleteBackfillState(ctx, fetchNextAccount.Backfill, stateID)
 }
 
 const RunFetchNextAccounts = "FetchAccounts"
@@ -206,6 +206,7 @@ func (s *UnitTestSuite) Test_FetchNextAccounts_Success() {
 	s.True(s.env.IsWorkflowCompleted())
 	err = s.env.GetWorkflowError()
 	s.NoError(err)
+	s.env.AssertActivityCalled(s.T(), "StorageConnectorHealthRefresh", mock.Anything, s.connectorID)
 }
 
 func (s *UnitTestSuite) Test_FetchNextAccounts_WithoutNextTasks_Success() {
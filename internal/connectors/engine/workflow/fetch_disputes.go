@@ -0,0 +1,117 @@
+package workflow
+
+import (
+	"fmt"
+
+	"github.com/formancehq/payments/internal/connectors/engine/activities"
+	"github.com/formancehq/payments/internal/connectors/plugins/registry"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/pkg/errors"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+type FetchNextDisputes struct {
+	ConnectorID  models.ConnectorID `json:"connectorID"`
+	FromPayload  *FromPayload       `json:"fromPayload"`
+	Periodically bool               `json:"periodically"`
+}
+
+func (w Workflow) runFetchNextDisputes(
+	ctx workflow.Context,
+	fetchNextDisputes FetchNextDisputes,
+	nextTasks []models.ConnectorTaskTree,
+) error {
+	if err := w.createInstance(ctx, fetchNextDisputes.ConnectorID); err != nil {
+		return errors.Wrap(err, "creating instance")
+	}
+	err := w.fetchDisputes(ctx, fetchNextDisputes, nextTasks)
+	return w.terminateInstance(ctx, fetchNextDisputes.ConnectorID, err)
+}
+
+func (w Workflow) fetchDisputes(
+	ctx workflow.Context,
+	fetchNextDisputes FetchNextDisputes,
+	nextTasks []models.ConnectorTaskTree,
+) error {
+	stateReference := models.CAPABILITY_FETCH_DISPUTES.String()
+	if fetchNextDisputes.FromPayload != nil {
+		stateReference = fmt.Sprintf("%s-%s", models.CAPABILITY_FETCH_DISPUTES.String(), fetchNextDisputes.FromPayload.ID)
+	}
+
+	stateID := models.StateID{
+		Reference:   stateReference,
+		ConnectorID: fetchNextDisputes.ConnectorID,
+	}
+	state, err := activities.StorageStatesGet(infiniteRetryContext(ctx), stateID)
+	if err != nil {
+		return fmt.Errorf("retrieving state %s: %w", stateID.String(), err)
+	}
+
+	// Get pageSize from registry using provider from ConnectorID (no DB call needed)
+	pageSize, err := registry.GetPageSize(fetchNextDisputes.ConnectorID.Provider)
+	if err != nil {
+		return fmt.Errorf("getting page size: %w", err)
+	}
+
+	hasMore := true
+	for hasMore {
+		disputesResponse, err := activities.PluginFetchNextDisputes(
+			infiniteRetryWithLongTimeoutContext(ctx),
+			fetchNextDisputes.ConnectorID,
+			fetchNextDisputes.FromPayload.GetPayload(),
+			state.State,
+			int(pageSize),
+			fetchNextDisputes.Periodically,
+		)
+		if err != nil {
+			return errors.Wrap(err, "fetching next disputes")
+		}
+
+		disputes, err := models.FromPSPDisputes(
+			disputesResponse.Disputes,
+			fetchNextDisputes.ConnectorID,
+		)
+		if err != nil {
+			return temporal.NewNonRetryableApplicationError(
+				"failed to translate psp disputes",
+				ErrValidation,
+				err,
+			)
+		}
+
+		if len(disputesResponse.Disputes) > 0 {
+			err = activities.StorageDisputesUpsert(
+				infiniteRetryContext(ctx),
+				disputes,
+			)
+			if err != nil {
+				return errors.Wrap(err, "storing next disputes")
+			}
+		}
+
+		state.State = disputesResponse.NewState
+		err = activities.StorageStatesStore(
+			infiniteRetryContext(ctx),
+			*state,
+		)
+		if err != nil {
+			return errors.Wrap(err, "storing state")
+		}
+
+		hasMore = disputesResponse.HasMore
+
+		if w.shouldContinueAsNew(ctx) {
+			return workflow.NewContinueAsNewError(
+				ctx,
+				RunFetchNextDisputes,
+				fetchNextDisputes,
+				nextTasks,
+			)
+		}
+	}
+
+	return nil
+}
+
+const RunFetchNextDisputes = "FetchDisputes"
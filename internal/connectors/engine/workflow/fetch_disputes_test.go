@@ -0,0 +1,381 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/formancehq/payments/internal/connectors/engine/activities"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/stretchr/testify/mock"
+	"go.temporal.io/sdk/temporal"
+)
+
+func (s *UnitTestSuite) Test_FetchNextDisputes_WithoutInstance_Success() {
+	s.env.OnActivity(activities.StorageStatesGetActivity, mock.Anything, mock.Anything).Once().Return(
+		&models.State{
+			ID: models.StateID{
+				Reference:   fmt.Sprintf("%s-%s", models.CAPABILITY_FETCH_DISPUTES.String(), "1"),
+				ConnectorID: s.connectorID,
+			},
+			ConnectorID: s.connectorID,
+			State:       []byte(`{}`),
+		},
+		nil,
+	)
+	s.env.OnActivity(activities.PluginFetchNextDisputesActivity, mock.Anything, mock.Anything).Once().Return(
+		&models.FetchNextDisputesResponse{
+			Disputes: []models.PSPDispute{},
+			NewState: []byte(`{}`),
+			HasMore:  false,
+		},
+		nil,
+	)
+	s.env.OnActivity(activities.StorageStatesStoreActivity, mock.Anything, mock.Anything).Once().Return(nil)
+
+	s.env.ExecuteWorkflow(RunFetchNextDisputes, FetchNextDisputes{
+		ConnectorID: s.connectorID,
+		FromPayload: &FromPayload{
+			ID:      "1",
+			Payload: []byte(`{}`),
+		},
+		Periodically: false,
+	}, []models.ConnectorTaskTree{})
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.NoError(err)
+}
+
+func (s *UnitTestSuite) Test_FetchNextDisputes_Success() {
+	s.env.OnActivity(activities.StorageInstancesStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, instance models.Instance) error {
+		s.Equal("test", instance.ScheduleID)
+		s.Equal(s.connectorID, instance.ConnectorID)
+		s.False(instance.Terminated)
+		return nil
+	})
+	s.env.OnActivity(activities.StorageStatesGetActivity, mock.Anything, mock.Anything).Once().Return(
+		&models.State{
+			ID: models.StateID{
+				Reference:   models.CAPABILITY_FETCH_DISPUTES.String(),
+				ConnectorID: s.connectorID,
+			},
+			ConnectorID: s.connectorID,
+			State:       []byte(`{}`),
+		},
+		nil,
+	)
+	s.env.OnActivity(activities.PluginFetchNextDisputesActivity, mock.Anything, mock.Anything).Once().Return(
+		&models.FetchNextDisputesResponse{
+			Disputes: []models.PSPDispute{},
+			NewState: []byte(`{}`),
+			HasMore:  false,
+		},
+		nil,
+	)
+	s.env.OnActivity(activities.StorageStatesStoreActivity, mock.Anything, mock.Anything).Once().Return(nil)
+	s.env.OnActivity(activities.StorageInstancesUpdateActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, instance models.Instance) error {
+		s.Equal("test", instance.ScheduleID)
+		s.Equal(s.connectorID, instance.ConnectorID)
+		s.True(instance.Terminated)
+		return nil
+	})
+
+	err := s.env.SetTypedSearchAttributesOnStart(temporal.NewSearchAttributes(temporal.NewSearchAttributeKeyKeyword(SearchAttributeScheduleID).ValueSet("test")))
+	s.NoError(err)
+	s.env.ExecuteWorkflow(RunFetchNextDisputes, FetchNextDisputes{
+		ConnectorID:  s.connectorID,
+		FromPayload:  nil,
+		Periodically: false,
+	}, []models.ConnectorTaskTree{})
+
+	s.True(s.env.IsWorkflowCompleted())
+	err = s.env.GetWorkflowError()
+	s.NoError(err)
+}
+
+func (s *UnitTestSuite) Test_FetchNextDisputes_HasMoreLoop_Success() {
+	s.env.OnActivity(activities.StorageInstancesStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, instance models.Instance) error {
+		s.Equal("test", instance.ScheduleID)
+		s.Equal(s.connectorID, instance.ConnectorID)
+		s.False(instance.Terminated)
+		return nil
+	})
+	s.env.OnActivity(activities.StorageStatesGetActivity, mock.Anything, mock.Anything).Once().Return(
+		&models.State{
+			ID: models.StateID{
+				Reference:   models.CAPABILITY_FETCH_DISPUTES.String(),
+				ConnectorID: s.connectorID,
+			},
+			ConnectorID: s.connectorID,
+			State:       []byte(`{}`),
+		},
+		nil,
+	)
+	// First page: HasMore = true
+	s.env.OnActivity(activities.PluginFetchNextDisputesActivity, mock.Anything, mock.Anything).Once().Return(
+		&models.FetchNextDisputesResponse{
+			Disputes: []models.PSPDispute{},
+			NewState: []byte(`{"cursor":"page2"}`),
+			HasMore:  true,
+		},
+		nil,
+	)
+	s.env.OnActivity(activities.StorageStatesStoreActivity, mock.Anything, mock.Anything).Once().Return(nil)
+
+	// Second page: HasMore = false
+	s.env.OnActivity(activities.PluginFetchNextDisputesActivity, mock.Anything, mock.Anything).Once().Return(
+		&models.FetchNextDisputesResponse{
+			Disputes: []models.PSPDispute{},
+			NewState: []byte(`{}`),
+			HasMore:  false,
+		},
+		nil,
+	)
+	s.env.OnActivity(activities.StorageStatesStoreActivity, mock.Anything, mock.Anything).Once().Return(nil)
+	s.env.OnActivity(activities.StorageInstancesUpdateActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, instance models.Instance) error {
+		s.Equal("test", instance.ScheduleID)
+		s.Equal(s.connectorID, instance.ConnectorID)
+		s.True(instance.Terminated)
+		return nil
+	})
+
+	err := s.env.SetTypedSearchAttributesOnStart(temporal.NewSearchAttributes(temporal.NewSearchAttributeKeyKeyword(SearchAttributeScheduleID).ValueSet("test")))
+	s.NoError(err)
+	s.env.ExecuteWorkflow(RunFetchNextDisputes, FetchNextDisputes{
+		ConnectorID:  s.connectorID,
+		FromPayload:  nil,
+		Periodically: false,
+	}, []models.ConnectorTaskTree{})
+
+	s.True(s.env.IsWorkflowCompleted())
+	err = s.env.GetWorkflowError()
+	s.NoError(err)
+}
+
+func (s *UnitTestSuite) Test_FetchNextDisputes_StorageInstancesStore_Error() {
+	expectedErr := errors.New("error-test")
+	s.env.OnActivity(activities.StorageInstancesStoreActivity, mock.Anything, mock.Anything).Once().Return(
+		temporal.NewNonRetryableApplicationError("error-test", "STORAGE", expectedErr),
+	)
+
+	err := s.env.SetTypedSearchAttributesOnStart(temporal.NewSearchAttributes(temporal.NewSearchAttributeKeyKeyword(SearchAttributeScheduleID).ValueSet("test")))
+	s.NoError(err)
+	s.env.ExecuteWorkflow(RunFetchNextDisputes, FetchNextDisputes{
+		ConnectorID:  s.connectorID,
+		FromPayload:  nil,
+		Periodically: false,
+	}, []models.ConnectorTaskTree{})
+
+	s.True(s.env.IsWorkflowCompleted())
+	err = s.env.GetWorkflowError()
+	s.Error(err)
+	s.ErrorContains(err, expectedErr.Error())
+}
+
+func (s *UnitTestSuite) Test_FetchNextDisputes_StorageStatesGet_Error() {
+	s.env.OnActivity(activities.StorageInstancesStoreActivity, mock.Anything, mock.Anything).Once().Return(nil)
+	expectedErr := errors.New("error-test")
+	s.env.OnActivity(activities.StorageStatesGetActivity, mock.Anything, mock.Anything).Once().Return(
+		nil,
+		temporal.NewNonRetryableApplicationError("error-test", "STORAGE", expectedErr),
+	)
+	s.env.OnActivity(activities.StorageInstancesUpdateActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, instance models.Instance) error {
+		s.True(instance.Terminated)
+		s.NotNil(instance.Error)
+		return nil
+	})
+
+	err := s.env.SetTypedSearchAttributesOnStart(temporal.NewSearchAttributes(temporal.NewSearchAttributeKeyKeyword(SearchAttributeScheduleID).ValueSet("test")))
+	s.NoError(err)
+	s.env.ExecuteWorkflow(RunFetchNextDisputes, FetchNextDisputes{
+		ConnectorID:  s.connectorID,
+		FromPayload:  nil,
+		Periodically: false,
+	}, []models.ConnectorTaskTree{})
+
+	s.True(s.env.IsWorkflowCompleted())
+	err = s.env.GetWorkflowError()
+	s.Error(err)
+	s.ErrorContains(err, expectedErr.Error())
+}
+
+func (s *UnitTestSuite) Test_FetchNextDisputes_PluginFetchNextDisputes_Error() {
+	s.env.OnActivity(activities.StorageInstancesStoreActivity, mock.Anything, mock.Anything).Once().Return(nil)
+	s.env.OnActivity(activities.StorageStatesGetActivity, mock.Anything, mock.Anything).Once().Return(
+		&models.State{
+			ID: models.StateID{
+				Reference:   models.CAPABILITY_FETCH_DISPUTES.String(),
+				ConnectorID: s.connectorID,
+			},
+			ConnectorID: s.connectorID,
+			State:       []byte(`{}`),
+		},
+		nil,
+	)
+	expectedErr := errors.New("error-test")
+	s.env.OnActivity(activities.PluginFetchNextDisputesActivity, mock.Anything, mock.Anything).Once().Return(
+		nil,
+		temporal.NewNonRetryableApplicationError("error-test", "PLUGIN", expectedErr),
+	)
+	s.env.OnActivity(activities.StorageInstancesUpdateActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, instance models.Instance) error {
+		s.True(instance.Terminated)
+		s.NotNil(instance.Error)
+		return nil
+	})
+
+	err := s.env.SetTypedSearchAttributesOnStart(temporal.NewSearchAttributes(temporal.NewSearchAttributeKeyKeyword(SearchAttributeScheduleID).ValueSet("test")))
+	s.NoError(err)
+	s.env.ExecuteWorkflow(RunFetchNextDisputes, FetchNextDisputes{
+		ConnectorID:  s.connectorID,
+		FromPayload:  nil,
+		Periodically: false,
+	}, []models.ConnectorTaskTree{})
+
+	s.True(s.env.IsWorkflowCompleted())
+	err = s.env.GetWorkflowError()
+	s.Error(err)
+	s.ErrorContains(err, expectedErr.Error())
+}
+
+func (s *UnitTestSuite) Test_FetchNextDisputes_StorageDisputesUpsert_Error() {
+	s.env.OnActivity(activities.StorageInstancesStoreActivity, mock.Anything, mock.Anything).Once().Return(nil)
+	s.env.OnActivity(activities.StorageStatesGetActivity, mock.Anything, mock.Anything).Once().Return(
+		&models.State{
+			ID: models.StateID{
+				Reference:   models.CAPABILITY_FETCH_DISPUTES.String(),
+				ConnectorID: s.connectorID,
+			},
+			ConnectorID: s.connectorID,
+			State:       []byte(`{}`),
+		},
+		nil,
+	)
+	s.env.OnActivity(activities.PluginFetchNextDisputesActivity, mock.Anything, mock.Anything).Once().Return(
+		&models.FetchNextDisputesResponse{
+			Disputes: []models.PSPDispute{
+				{
+					Reference: "dp-1",
+					CreatedAt: time.Now().UTC(),
+					Amount:    big.NewInt(10000),
+					Asset:     "USD/2",
+					Status:    models.DISPUTE_STATUS_NEEDS_RESPONSE,
+					Raw:       []byte(`{}`),
+				},
+			},
+			NewState: []byte(`{}`),
+			HasMore:  false,
+		},
+		nil,
+	)
+	expectedErr := errors.New("error-test")
+	s.env.OnActivity(activities.StorageDisputesUpsertActivity, mock.Anything, mock.Anything).Once().Return(
+		temporal.NewNonRetryableApplicationError("error-test", "STORAGE", expectedErr),
+	)
+	s.env.OnActivity(activities.StorageInstancesUpdateActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, instance models.Instance) error {
+		s.True(instance.Terminated)
+		s.NotNil(instance.Error)
+		return nil
+	})
+
+	err := s.env.SetTypedSearchAttributesOnStart(temporal.NewSearchAttributes(temporal.NewSearchAttributeKeyKeyword(SearchAttributeScheduleID).ValueSet("test")))
+	s.NoError(err)
+	s.env.ExecuteWorkflow(RunFetchNextDisputes, FetchNextDisputes{
+		ConnectorID:  s.connectorID,
+		FromPayload:  nil,
+		Periodically: false,
+	}, []models.ConnectorTaskTree{})
+
+	s.True(s.env.IsWorkflowCompleted())
+	err = s.env.GetWorkflowError()
+	s.Error(err)
+	s.ErrorContains(err, expectedErr.Error())
+}
+
+func (s *UnitTestSuite) Test_FetchNextDisputes_StorageStatesStore_Error() {
+	s.env.OnActivity(activities.StorageInstancesStoreActivity, mock.Anything, mock.Anything).Once().Return(nil)
+	s.env.OnActivity(activities.StorageStatesGetActivity, mock.Anything, mock.Anything).Once().Return(
+		&models.State{
+			ID: models.StateID{
+				Reference:   models.CAPABILITY_FETCH_DISPUTES.String(),
+				ConnectorID: s.connectorID,
+			},
+			ConnectorID: s.connectorID,
+			State:       []byte(`{}`),
+		},
+		nil,
+	)
+	s.env.OnActivity(activities.PluginFetchNextDisputesActivity, mock.Anything, mock.Anything).Once().Return(
+		&models.FetchNextDisputesResponse{
+			Disputes: []models.PSPDispute{},
+			NewState: []byte(`{}`),
+			HasMore:  false,
+		},
+		nil,
+	)
+	expectedErr := errors.New("error-test")
+	s.env.OnActivity(activities.StorageStatesStoreActivity, mock.Anything, mock.Anything).Once().Return(
+		temporal.NewNonRetryableApplicationError("error-test", "STORAGE", expectedErr),
+	)
+	s.env.OnActivity(activities.StorageInstancesUpdateActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, instance models.Instance) error {
+		s.True(instance.Terminated)
+		s.NotNil(instance.Error)
+		return nil
+	})
+
+	err := s.env.SetTypedSearchAttributesOnStart(temporal.NewSearchAttributes(temporal.NewSearchAttributeKeyKeyword(SearchAttributeScheduleID).ValueSet("test")))
+	s.NoError(err)
+	s.env.ExecuteWorkflow(RunFetchNextDisputes, FetchNextDisputes{
+		ConnectorID:  s.connectorID,
+		FromPayload:  nil,
+		Periodically: false,
+	}, []models.ConnectorTaskTree{})
+
+	s.True(s.env.IsWorkflowCompleted())
+	err = s.env.GetWorkflowError()
+	s.Error(err)
+	s.ErrorContains(err, expectedErr.Error())
+}
+
+func (s *UnitTestSuite) Test_FetchNextDisputes_StorageInstancesUpdate_Error() {
+	s.env.OnActivity(activities.StorageInstancesStoreActivity, mock.Anything, mock.Anything).Once().Return(nil)
+	s.env.OnActivity(activities.StorageStatesGetActivity, mock.Anything, mock.Anything).Once().Return(
+		&models.State{
+			ID: models.StateID{
+				Reference:   models.CAPABILITY_FETCH_DISPUTES.String(),
+				ConnectorID: s.connectorID,
+			},
+			ConnectorID: s.connectorID,
+			State:       []byte(`{}`),
+		},
+		nil,
+	)
+	s.env.OnActivity(activities.PluginFetchNextDisputesActivity, mock.Anything, mock.Anything).Once().Return(
+		&models.FetchNextDisputesResponse{
+			Disputes: []models.PSPDispute{},
+			NewState: []byte(`{}`),
+			HasMore:  false,
+		},
+		nil,
+	)
+	s.env.OnActivity(activities.StorageStatesStoreActivity, mock.Anything, mock.Anything).Once().Return(nil)
+	expectedErr := errors.New("error-test")
+	s.env.OnActivity(activities.StorageInstancesUpdateActivity, mock.Anything, mock.Anything).Once().Return(
+		temporal.NewNonRetryableApplicationError("error-test", "STORAGE", expectedErr),
+	)
+
+	err := s.env.SetTypedSearchAttributesOnStart(temporal.NewSearchAttributes(temporal.NewSearchAttributeKeyKeyword(SearchAttributeScheduleID).ValueSet("test")))
+	s.NoError(err)
+	s.env.ExecuteWorkflow(RunFetchNextDisputes, FetchNextDisputes{
+		ConnectorID:  s.connectorID,
+		FromPayload:  nil,
+		Periodically: false,
+	}, []models.ConnectorTaskTree{})
+
+	s.True(s.env.IsWorkflowCompleted())
+	err = s.env.GetWorkflowError()
+	s.Error(err)
+	s.ErrorContains(err, expectedErr.Error())
+}
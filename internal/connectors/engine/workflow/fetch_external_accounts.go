@@ -3,6 +3,7 @@ package workflow
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/formancehq/payments/internal/connectors/engine/activities"
 	"github.com/formancehq/payments/internal/connectors/plugins/registry"
@@ -16,6 +17,7 @@ type FetchNextExternalAccounts struct {
 	ConnectorID  models.ConnectorID `json:"connectorID"`
 	FromPayload  *FromPayload       `json:"fromPayload"`
 	Periodically bool               `json:"periodically"`
+	Backfill     *Backfill          `json:"backfill,omitempty"`
 }
 
 func (w Workflow) runFetchNextExternalAccounts(
@@ -41,7 +43,7 @@ func (w Workflow) fetchExternalAccounts(
 	}
 
 	stateID := models.StateID{
-		Reference:   stateReference,
+		Reference:   fetchNextExternalAccount.Backfill.stateReference(stateReference),
 		ConnectorID: fetchNextExternalAccount.ConnectorID,
 	}
 	state, err := activities.StorageStatesGet(infiniteRetryContext(ctx), stateID)
@@ -63,12 +65,19 @@ func (w Workflow) fetchExternalAccounts(
 			fetchNextExternalAccount.FromPayload.GetPayload(),
 			state.State,
 			int(pageSize),
+			fetchNextExternalAccount.Backfill.GetWindow(),
 			fetchNextExternalAccount.Periodically,
 		)
 		if err != nil {
 			return errors.Wrap(err, "fetching next accounts")
 		}
 
+		externalAccountsResponse.ExternalAccounts = inBackfillWindow(
+			fetchNextExternalAccount.Backfill,
+			externalAccountsResponse.ExternalAccounts,
+			func(o models.PSPAccount) time.Time { return o.CreatedAt },
+		)
+
 		accounts, err := models.FromPSPAccounts(
 			externalAccountsResponse.ExternalAccounts,
 			models.ACCOUNT_TYPE_EXTERNAL,
@@ -190,7 +199,7 @@ func (w Workflow) fetchExternalAccounts(
 		}
 	}
 
-	return nil
+	return deleteBackfillState(ctx, fetchNextExternalAccount.Backfill, stateID)
 }
 
 const RunFetchNextExternalAccounts = "FetchExternalAccounts"
@@ -3,6 +3,7 @@ package workflow
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/formancehq/payments/internal/connectors/engine/activities"
 	"github.com/formancehq/payments/internal/connectors/plugins/registry"
@@ -17,6 +18,7 @@ type FetchNextPayments struct {
 	ConnectorID  models.ConnectorID `json:"connectorID"`
 	FromPayload  *FromPayload       `json:"fromPayload"`
 	Periodically bool               `json:"periodically"`
+	Backfill     *Backfill          `json:"backfill,omitempty"`
 }
 
 func (w Workflow) runFetchNextPayments(
@@ -42,7 +44,7 @@ func (w Workflow) fetchNextPayments(
 	}
 
 	stateID := models.StateID{
-		Reference:   stateReference,
+		Reference:   fetchNextPayments.Backfill.stateReference(stateReference),
 		ConnectorID: fetchNextPayments.ConnectorID,
 	}
 	state, err := activities.StorageStatesGet(infiniteRetryContext(ctx), stateID)
@@ -64,12 +66,19 @@ func (w Workflow) fetchNextPayments(
 			fetchNextPayments.FromPayload.GetPayload(),
 			state.State,
 			int(pageSize),
+			fetchNextPayments.Backfill.GetWindow(),
 			fetchNextPayments.Periodically,
 		)
 		if err != nil {
 			return errors.Wrap(err, "fetching next payments")
 		}
 
+		paymentsResponse.Payments = inBackfillWindow(
+			fetchNextPayments.Backfill,
+			paymentsResponse.Payments,
+			func(o models.PSPPayment) time.Time { return o.CreatedAt },
+		)
+
 		payments, err := models.FromPSPPayments(
 			paymentsResponse.Payments,
 			fetchNextPayments.ConnectorID,
@@ -241,7 +250,7 @@ func (w Workflow) fetchNextPayments(
 		}
 	}
 
-	return nil
+	return deleteBackfillState(ctx, fetchNextPayments.Backfill, stateID)
 }
 
 const RunFetchNextPayments = "FetchPayments"
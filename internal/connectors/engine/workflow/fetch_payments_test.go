@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/formancehq/payments/internal/connectors/engine/activities"
 	"github.com/formancehq/payments/pkg/domain/models"
@@ -592,3 +593,103 @@ func (s *UnitTestSuite) Test_FetchNextPayments_StorageInstancesUpdate_Error() {
 	s.Error(err)
 	s.ErrorContains(err, expectedErr.Error())
 }
+
+func (s *UnitTestSuite) Test_FetchNextPayments_Backfill_Success() {
+	window := models.FetchWindow{
+		From: s.pspPayment.CreatedAt.Add(-24 * time.Hour),
+		To:   s.pspPayment.CreatedAt.Add(time.Hour),
+	}
+	stateID := models.StateID{
+		Reference:   fmt.Sprintf("%s-%s-backfill-%s", models.CAPABILITY_FETCH_PAYMENTS.String(), "1", "task"),
+		ConnectorID: s.connectorID,
+	}
+
+	s.env.OnActivity(activities.StorageStatesGetActivity, mock.Anything, stateID).Once().Return(
+		&models.State{
+			ID:          stateID,
+			ConnectorID: s.connectorID,
+		},
+		nil,
+	)
+	s.env.OnActivity(activities.PluginFetchNextPaymentsActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, req activities.FetchNextPaymentsRequest) (*models.FetchNextPaymentsResponse, error) {
+		s.Equal(&window, req.Req.Window)
+		return &models.FetchNextPaymentsResponse{
+			Payments: []models.PSPPayment{
+				s.pspPayment,
+			},
+			NewState: []byte(`{}`),
+			HasMore:  false,
+		}, nil
+	})
+	s.env.OnActivity(activities.StoragePaymentsStoreActivity, mock.Anything, mock.Anything).Once().Return(nil)
+	s.env.OnActivity(activities.StoragePaymentInitiationUpdateFromPaymentActivity, mock.Anything, s.pspPayment.Status, s.pspPayment.CreatedAt, s.paymentPayoutID).Once().Return(nil)
+	s.env.OnActivity(activities.StorageStatesStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, state models.State) error {
+		s.Equal(stateID, state.ID)
+		return nil
+	})
+	s.env.OnActivity(activities.StorageStatesDeleteFromIDActivity, mock.Anything, stateID).Once().Return(nil)
+
+	s.env.ExecuteWorkflow(RunFetchNextPayments, FetchNextPayments{
+		ConnectorID: s.connectorID,
+		FromPayload: &FromPayload{
+			ID:      "1",
+			Payload: []byte(`{}`),
+		},
+		Backfill: &Backfill{
+			ID:     "task",
+			Window: window,
+		},
+	}, []models.ConnectorTaskTree{})
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *UnitTestSuite) Test_FetchNextPayments_Backfill_DropsPaymentsOutOfWindow() {
+	window := models.FetchWindow{
+		From: s.pspPayment.CreatedAt.Add(time.Hour),
+		To:   s.pspPayment.CreatedAt.Add(24 * time.Hour),
+	}
+	stateID := models.StateID{
+		Reference:   fmt.Sprintf("%s-%s-backfill-%s", models.CAPABILITY_FETCH_PAYMENTS.String(), "1", "task"),
+		ConnectorID: s.connectorID,
+	}
+
+	s.env.OnActivity(activities.StorageStatesGetActivity, mock.Anything, stateID).Once().Return(
+		&models.State{
+			ID:          stateID,
+			ConnectorID: s.connectorID,
+		},
+		nil,
+	)
+	s.env.OnActivity(activities.PluginFetchNextPaymentsActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, req activities.FetchNextPaymentsRequest) (*models.FetchNextPaymentsResponse, error) {
+		s.Equal(&window, req.Req.Window)
+		return &models.FetchNextPaymentsResponse{
+			Payments: []models.PSPPayment{
+				s.pspPayment,
+			},
+			NewState: []byte(`{}`),
+			HasMore:  false,
+		}, nil
+	})
+	s.env.OnActivity(activities.StorageStatesStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, state models.State) error {
+		s.Equal(stateID, state.ID)
+		return nil
+	})
+	s.env.OnActivity(activities.StorageStatesDeleteFromIDActivity, mock.Anything, stateID).Once().Return(nil)
+
+	s.env.ExecuteWorkflow(RunFetchNextPayments, FetchNextPayments{
+		ConnectorID: s.connectorID,
+		FromPayload: &FromPayload{
+			ID:      "1",
+			Payload: []byte(`{}`),
+		},
+		Backfill: &Backfill{
+			ID:     "task",
+			Window: window,
+		},
+	}, []models.ConnectorTaskTree{})
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
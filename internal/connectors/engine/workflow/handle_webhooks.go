@@ -3,6 +3,7 @@ package workflow
 import (
 	"encoding/json"
 	"fmt"
+	"math/big"
 
 	"github.com/formancehq/payments/internal/connectors/engine/activities"
 	internalEvents "github.com/formancehq/payments/internal/events"
@@ -117,6 +118,14 @@ func (w Workflow) runHandleWebhooks(
 				return fmt.Errorf("handling open banking payment webhook: %w", err)
 			}
 
+		case response.UserPaymentUpdated != nil:
+			// OpenBanking specific webhook. A payment initiated by a user
+			// through a payment link has been updated on the provider. We
+			// need to update the related payment initiation.
+			if err := w.handleUserPaymentUpdatedWebhook(ctx, handleWebhooks, response); err != nil {
+				return fmt.Errorf("handling user payment updated webhook: %w", err)
+			}
+
 		default:
 			// Default case, all the other webhooks are to store data
 			if err := w.handleDataToStoreWebhook(ctx, i, handleWebhooks, response); err != nil {
@@ -156,6 +165,7 @@ func (w Workflow) handleDataToStoreWebhook(
 			PaymentToDelete: response.PaymentToDelete,
 			PaymentToCancel: response.PaymentToCancel,
 			Balance:         response.Balance,
+			Dispute:         response.Dispute,
 		},
 	).Get(ctx, nil); err != nil {
 		applicationError := &temporal.ApplicationError{}
@@ -363,6 +373,101 @@ func (w Workflow) handleUserLinkSessionFinishedWebhook(
 	return nil
 }
 
+func (w Workflow) handleUserPaymentUpdatedWebhook(
+	ctx workflow.Context,
+	handleWebhooks HandleWebhooks,
+	response models.WebhookResponse,
+) error {
+	attempt, err := activities.StorageOpenBankingPaymentAttemptsGetFromPaymentRequestReference(
+		infiniteRetryContext(ctx),
+		handleWebhooks.ConnectorID,
+		response.UserPaymentUpdated.PaymentRequestReference,
+	)
+	if err != nil {
+		return fmt.Errorf("getting open banking payment attempt: %w", err)
+	}
+
+	var (
+		amount *big.Int
+		asset  *string
+	)
+	if response.UserPaymentUpdated.Payment != nil {
+		payment, err := models.FromPSPPaymentToPayment(*response.UserPaymentUpdated.Payment, handleWebhooks.ConnectorID)
+		if err != nil {
+			return temporal.NewNonRetryableApplicationError(
+				"failed to translate psp payment",
+				ErrValidation,
+				err,
+			)
+		}
+		payment.PsuID = &attempt.PsuID
+
+		if err := activities.StoragePaymentsStore(
+			infiniteRetryContext(ctx),
+			[]models.Payment{payment},
+		); err != nil {
+			return fmt.Errorf("storing payment: %w", err)
+		}
+
+		if err := activities.StoragePaymentInitiationsRelatedPaymentsStore(
+			infiniteRetryContext(ctx),
+			attempt.PaymentInitiationID,
+			payment.ID,
+			payment.CreatedAt,
+		); err != nil {
+			return fmt.Errorf("storing payment initiation related payment: %w", err)
+		}
+
+		amount = payment.Amount
+		asset = &payment.Asset
+	}
+
+	// The adjustment is added here rather than through storePIPaymentWithStatus
+	// in order to keep the error given by the provider on failed payments.
+	var adjErr error
+	if response.UserPaymentUpdated.Error != nil {
+		adjErr = errors.New(*response.UserPaymentUpdated.Error)
+	}
+
+	if err := w.addPIAdjustment(
+		ctx,
+		models.PaymentInitiationAdjustmentID{
+			PaymentInitiationID: attempt.PaymentInitiationID,
+			CreatedAt:           response.UserPaymentUpdated.At,
+			Status:              response.UserPaymentUpdated.Status,
+		},
+		amount,
+		asset,
+		adjErr,
+		nil,
+	); err != nil {
+		return fmt.Errorf("adding payment initiation adjustment: %w", err)
+	}
+
+	var attemptStatus models.OpenBankingConnectionAttemptStatus
+	switch response.UserPaymentUpdated.Status {
+	case models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PROCESSED:
+		attemptStatus = models.OpenBankingConnectionAttemptStatusCompleted
+	case models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_FAILED,
+		models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_REJECTED:
+		attemptStatus = models.OpenBankingConnectionAttemptStatusExited
+	default:
+		// The payment is still ongoing, nothing to update on the attempt
+		return nil
+	}
+
+	if err := activities.StorageOpenBankingPaymentAttemptsUpdateStatus(
+		infiniteRetryContext(ctx),
+		attempt.ID,
+		attemptStatus,
+		response.UserPaymentUpdated.Error,
+	); err != nil {
+		return fmt.Errorf("updating open banking payment attempt status: %w", err)
+	}
+
+	return nil
+}
+
 func (w Workflow) handleUserPendingDisconnectWebhook(
 	ctx workflow.Context,
 	handleWebhooks HandleWebhooks,
@@ -596,6 +701,7 @@ type StoreWebhookTranslation struct {
 	PaymentToDelete *models.PSPPaymentsToDelete
 	PaymentToCancel *models.PSPPaymentsToCancel
 	Balance         *models.PSPBalance
+	Dispute         *models.PSPDispute
 }
 
 func (w Workflow) runStoreWebhookTranslation(
@@ -751,6 +857,28 @@ func (w Workflow) runStoreWebhookTranslation(
 		}
 	}
 
+	if storeWebhookTranslation.Dispute != nil {
+		disputes, err := models.FromPSPDisputes(
+			[]models.PSPDispute{*storeWebhookTranslation.Dispute},
+			storeWebhookTranslation.ConnectorID,
+		)
+		if err != nil {
+			return temporal.NewNonRetryableApplicationError(
+				"failed to translate psp disputes",
+				ErrValidation,
+				err,
+			)
+		}
+
+		err = activities.StorageDisputesUpsert(
+			infiniteRetryContext(ctx),
+			disputes,
+		)
+		if err != nil {
+			return fmt.Errorf("storing dispute: %w", err)
+		}
+	}
+
 	// All events now use outbox pattern - Account, Balance, Payment, and BankAccount events
 	// are created in storage methods, and other events are handled via outbox in workflows
 	return nil
@@ -437,6 +437,259 @@ func (s *UnitTestSuite) Test_HandleWebhooks_DataReadyToFetch_AccountsAndBalances
 	s.NoError(err)
 }
 
+// UserPaymentUpdated webhook tests
+func (s *UnitTestSuite) Test_HandleWebhooks_UserPaymentUpdated_WithPayment_Success() {
+	attempt := models.OpenBankingPaymentAttempt{
+		ID:                  uuid.New(),
+		PsuID:               uuid.New(),
+		ConnectorID:         s.connectorID,
+		PaymentInitiationID: s.paymentInitiationID,
+	}
+
+	s.env.OnActivity(activities.StorageWebhooksStoreActivity, mock.Anything, mock.Anything).Once().Return(nil)
+	s.env.OnActivity(activities.PluginTranslateWebhookActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, req activities.TranslateWebhookRequest) (*models.TranslateWebhookResponse, error) {
+		return &models.TranslateWebhookResponse{
+			Responses: []models.WebhookResponse{
+				{
+					UserPaymentUpdated: &models.PSPUserPaymentUpdated{
+						PaymentRequestReference: "payment_request_123",
+						Status:                  models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PROCESSED,
+						At:                      s.env.Now(),
+						Payment:                 &s.pspPayment,
+					},
+				},
+			},
+		}, nil
+	})
+	s.env.OnActivity(activities.StorageOpenBankingPaymentAttemptsGetFromPaymentRequestReferenceActivity, mock.Anything, s.connectorID, "payment_request_123").Once().Return(&attempt, nil)
+	s.env.OnActivity(activities.StoragePaymentsStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, payments []models.Payment) error {
+		s.Len(payments, 1)
+		s.NotNil(payments[0].PsuID)
+		s.Equal(attempt.PsuID, *payments[0].PsuID)
+		return nil
+	})
+	s.env.OnActivity(activities.StoragePaymentInitiationsRelatedPaymentsStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, relatedPayment activities.RelatedPayment) error {
+		s.Equal(s.paymentInitiationID, relatedPayment.PiID)
+		return nil
+	})
+	s.env.OnActivity(activities.StoragePaymentInitiationsAdjustmentsStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, adj models.PaymentInitiationAdjustment) error {
+		s.Equal(s.paymentInitiationID, adj.ID.PaymentInitiationID)
+		s.Equal(models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PROCESSED, adj.Status)
+		return nil
+	})
+	s.env.OnActivity(activities.StorageOpenBankingPaymentAttemptsUpdateStatusActivity, mock.Anything, attempt.ID, models.OpenBankingConnectionAttemptStatusCompleted, mock.Anything).Once().Return(nil)
+
+	s.env.ExecuteWorkflow(RunHandleWebhooks, HandleWebhooks{
+		ConnectorID: s.connectorID,
+		URLPath:     "/test",
+		Webhook: models.Webhook{
+			ID:          "test",
+			ConnectorID: s.connectorID,
+			Body:        []byte(`{}`),
+		},
+		Config: &models.WebhookConfig{
+			Name:        "test",
+			ConnectorID: s.connectorID,
+			URLPath:     "/test",
+		},
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *UnitTestSuite) Test_HandleWebhooks_UserPaymentUpdated_WithPayment_Failed_KeepsError() {
+	attempt := models.OpenBankingPaymentAttempt{
+		ID:                  uuid.New(),
+		PsuID:               uuid.New(),
+		ConnectorID:         s.connectorID,
+		PaymentInitiationID: s.paymentInitiationID,
+	}
+	errorMsg := "insufficient funds"
+
+	s.env.OnActivity(activities.StorageWebhooksStoreActivity, mock.Anything, mock.Anything).Once().Return(nil)
+	s.env.OnActivity(activities.PluginTranslateWebhookActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, req activities.TranslateWebhookRequest) (*models.TranslateWebhookResponse, error) {
+		return &models.TranslateWebhookResponse{
+			Responses: []models.WebhookResponse{
+				{
+					UserPaymentUpdated: &models.PSPUserPaymentUpdated{
+						PaymentRequestReference: "payment_request_123",
+						Status:                  models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_FAILED,
+						At:                      s.env.Now(),
+						Payment:                 &s.pspPayment,
+						Error:                   &errorMsg,
+					},
+				},
+			},
+		}, nil
+	})
+	s.env.OnActivity(activities.StorageOpenBankingPaymentAttemptsGetFromPaymentRequestReferenceActivity, mock.Anything, s.connectorID, "payment_request_123").Once().Return(&attempt, nil)
+	s.env.OnActivity(activities.StoragePaymentsStoreActivity, mock.Anything, mock.Anything).Once().Return(nil)
+	s.env.OnActivity(activities.StoragePaymentInitiationsRelatedPaymentsStoreActivity, mock.Anything, mock.Anything).Once().Return(nil)
+	s.env.OnActivity(activities.StoragePaymentInitiationsAdjustmentsStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, adj models.PaymentInitiationAdjustment) error {
+		s.Equal(models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_FAILED, adj.Status)
+		s.Equal(s.pspPayment.Amount, adj.Amount)
+		s.NotNil(adj.Error)
+		s.Equal(errorMsg, adj.Error.Error())
+		return nil
+	})
+	s.env.OnActivity(activities.StorageOpenBankingPaymentAttemptsUpdateStatusActivity, mock.Anything, attempt.ID, models.OpenBankingConnectionAttemptStatusExited, &errorMsg).Once().Return(nil)
+
+	s.env.ExecuteWorkflow(RunHandleWebhooks, HandleWebhooks{
+		ConnectorID: s.connectorID,
+		URLPath:     "/test",
+		Webhook: models.Webhook{
+			ID:          "test",
+			ConnectorID: s.connectorID,
+			Body:        []byte(`{}`),
+		},
+		Config: &models.WebhookConfig{
+			Name:        "test",
+			ConnectorID: s.connectorID,
+			URLPath:     "/test",
+		},
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *UnitTestSuite) Test_HandleWebhooks_UserPaymentUpdated_WithoutPayment_Success() {
+	attempt := models.OpenBankingPaymentAttempt{
+		ID:                  uuid.New(),
+		PsuID:               uuid.New(),
+		ConnectorID:         s.connectorID,
+		PaymentInitiationID: s.paymentInitiationID,
+	}
+	errorMsg := "payment rejected by the bank"
+
+	s.env.OnActivity(activities.StorageWebhooksStoreActivity, mock.Anything, mock.Anything).Once().Return(nil)
+	s.env.OnActivity(activities.PluginTranslateWebhookActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, req activities.TranslateWebhookRequest) (*models.TranslateWebhookResponse, error) {
+		return &models.TranslateWebhookResponse{
+			Responses: []models.WebhookResponse{
+				{
+					UserPaymentUpdated: &models.PSPUserPaymentUpdated{
+						PaymentRequestReference: "payment_request_123",
+						Status:                  models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_REJECTED,
+						At:                      s.env.Now(),
+						Error:                   &errorMsg,
+					},
+				},
+			},
+		}, nil
+	})
+	s.env.OnActivity(activities.StorageOpenBankingPaymentAttemptsGetFromPaymentRequestReferenceActivity, mock.Anything, s.connectorID, "payment_request_123").Once().Return(&attempt, nil)
+	s.env.OnActivity(activities.StoragePaymentInitiationsAdjustmentsStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, adj models.PaymentInitiationAdjustment) error {
+		s.Equal(s.paymentInitiationID, adj.ID.PaymentInitiationID)
+		s.Equal(models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_REJECTED, adj.Status)
+		s.NotNil(adj.Error)
+		return nil
+	})
+	s.env.OnActivity(activities.StorageOpenBankingPaymentAttemptsUpdateStatusActivity, mock.Anything, attempt.ID, models.OpenBankingConnectionAttemptStatusExited, &errorMsg).Once().Return(nil)
+
+	s.env.ExecuteWorkflow(RunHandleWebhooks, HandleWebhooks{
+		ConnectorID: s.connectorID,
+		URLPath:     "/test",
+		Webhook: models.Webhook{
+			ID:          "test",
+			ConnectorID: s.connectorID,
+			Body:        []byte(`{}`),
+		},
+		Config: &models.WebhookConfig{
+			Name:        "test",
+			ConnectorID: s.connectorID,
+			URLPath:     "/test",
+		},
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *UnitTestSuite) Test_HandleWebhooks_UserPaymentUpdated_Pending_NoAttemptUpdate() {
+	attempt := models.OpenBankingPaymentAttempt{
+		ID:                  uuid.New(),
+		PsuID:               uuid.New(),
+		ConnectorID:         s.connectorID,
+		PaymentInitiationID: s.paymentInitiationID,
+	}
+
+	s.env.OnActivity(activities.StorageWebhooksStoreActivity, mock.Anything, mock.Anything).Once().Return(nil)
+	s.env.OnActivity(activities.PluginTranslateWebhookActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, req activities.TranslateWebhookRequest) (*models.TranslateWebhookResponse, error) {
+		return &models.TranslateWebhookResponse{
+			Responses: []models.WebhookResponse{
+				{
+					UserPaymentUpdated: &models.PSPUserPaymentUpdated{
+						PaymentRequestReference: "payment_request_123",
+						Status:                  models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PROCESSING,
+						At:                      s.env.Now(),
+					},
+				},
+			},
+		}, nil
+	})
+	s.env.OnActivity(activities.StorageOpenBankingPaymentAttemptsGetFromPaymentRequestReferenceActivity, mock.Anything, s.connectorID, "payment_request_123").Once().Return(&attempt, nil)
+	s.env.OnActivity(activities.StoragePaymentInitiationsAdjustmentsStoreActivity, mock.Anything, mock.Anything).Once().Return(nil)
+
+	s.env.ExecuteWorkflow(RunHandleWebhooks, HandleWebhooks{
+		ConnectorID: s.connectorID,
+		URLPath:     "/test",
+		Webhook: models.Webhook{
+			ID:          "test",
+			ConnectorID: s.connectorID,
+			Body:        []byte(`{}`),
+		},
+		Config: &models.WebhookConfig{
+			Name:        "test",
+			ConnectorID: s.connectorID,
+			URLPath:     "/test",
+		},
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+	s.env.AssertNotCalled(s.T(), "StorageOpenBankingPaymentAttemptsUpdateStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *UnitTestSuite) Test_HandleWebhooks_UserPaymentUpdated_GetAttempt_Error() {
+	s.env.OnActivity(activities.StorageWebhooksStoreActivity, mock.Anything, mock.Anything).Once().Return(nil)
+	s.env.OnActivity(activities.PluginTranslateWebhookActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, req activities.TranslateWebhookRequest) (*models.TranslateWebhookResponse, error) {
+		return &models.TranslateWebhookResponse{
+			Responses: []models.WebhookResponse{
+				{
+					UserPaymentUpdated: &models.PSPUserPaymentUpdated{
+						PaymentRequestReference: "payment_request_123",
+						Status:                  models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PROCESSED,
+					},
+				},
+			},
+		}, nil
+	})
+	s.env.OnActivity(activities.StorageOpenBankingPaymentAttemptsGetFromPaymentRequestReferenceActivity, mock.Anything, s.connectorID, "payment_request_123").Once().Return(nil,
+		temporal.NewNonRetryableApplicationError("test", "STORAGE", errors.New("not found")),
+	)
+
+	s.env.ExecuteWorkflow(RunHandleWebhooks, HandleWebhooks{
+		ConnectorID: s.connectorID,
+		URLPath:     "/test",
+		Webhook: models.Webhook{
+			ID:          "test",
+			ConnectorID: s.connectorID,
+			Body:        []byte(`{}`),
+		},
+		Config: &models.WebhookConfig{
+			Name:        "test",
+			ConnectorID: s.connectorID,
+			URLPath:     "/test",
+		},
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	s.ErrorContains(err, "getting open banking payment attempt")
+}
+
 // UserLinkSessionFinished webhook tests
 func (s *UnitTestSuite) Test_HandleWebhooks_UserLinkSessionFinished_Success() {
 	attemptID := uuid.New()
@@ -1492,3 +1745,72 @@ func (s *UnitTestSuite) Test_StoreWebhookTranslation_Payment_StoragePaymentsStor
 	s.Error(err)
 	s.ErrorContains(err, expectedErr.Error())
 }
+
+func (s *UnitTestSuite) Test_StoreWebhookTranslation_Dispute_Success() {
+	s.env.OnActivity(activities.StorageDisputesUpsertActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, disputes []models.Dispute) error {
+		s.Len(disputes, 1)
+		s.Equal("dp-1", disputes[0].Reference)
+		s.Equal(models.DISPUTE_STATUS_NEEDS_RESPONSE, disputes[0].Status)
+		s.NotNil(disputes[0].PaymentID)
+		return nil
+	})
+
+	s.env.ExecuteWorkflow(RunStoreWebhookTranslation, StoreWebhookTranslation{
+		ConnectorID: s.connectorID,
+		Dispute: &models.PSPDispute{
+			Reference:        "dp-1",
+			CreatedAt:        time.Now().UTC(),
+			PaymentReference: &s.pspPayment.Reference,
+			Amount:           big.NewInt(100),
+			Asset:            "EUR/2",
+			Status:           models.DISPUTE_STATUS_NEEDS_RESPONSE,
+			Raw:              []byte(`{}`),
+		},
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.NoError(err)
+}
+
+func (s *UnitTestSuite) Test_StoreWebhookTranslation_Dispute_Validation_Error() {
+	s.env.ExecuteWorkflow(RunStoreWebhookTranslation, StoreWebhookTranslation{
+		ConnectorID: s.connectorID,
+		Dispute: &models.PSPDispute{
+			Reference: "dp-1",
+			CreatedAt: time.Now().UTC(),
+			Amount:    big.NewInt(100),
+			Asset:     "EUR/2",
+			Raw:       []byte(`{}`),
+		},
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	s.ErrorContains(err, ErrValidation)
+}
+
+func (s *UnitTestSuite) Test_StoreWebhookTranslation_Dispute_StorageDisputesUpsert_Error() {
+	expectedErr := errors.New("error-test")
+	s.env.OnActivity(activities.StorageDisputesUpsertActivity, mock.Anything, mock.Anything).Once().Return(
+		temporal.NewNonRetryableApplicationError("error-test", "STORAGE", expectedErr),
+	)
+
+	s.env.ExecuteWorkflow(RunStoreWebhookTranslation, StoreWebhookTranslation{
+		ConnectorID: s.connectorID,
+		Dispute: &models.PSPDispute{
+			Reference: "dp-1",
+			CreatedAt: time.Now().UTC(),
+			Amount:    big.NewInt(100),
+			Asset:     "EUR/2",
+			Status:    models.DISPUTE_STATUS_LOST,
+			Raw:       []byte(`{}`),
+		},
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	s.ErrorContains(err, expectedErr.Error())
+}
@@ -76,8 +76,18 @@ func (w Workflow) terminateInstance(
 	err = activities.StorageInstancesUpdate(infiniteRetryContext(ctx), instance)
 	if err != nil {
 		w.logger.WithField("workflow_id", info.WorkflowExecution.ID).Errorf("failed to update workflow instance: %w", err)
+		return selectError(terminateError, err)
 	}
-	return selectError(terminateError, err)
+
+	if IsConnectorHealthRefreshEnabled(ctx) {
+		// The health status is only used for reporting, failing to refresh it
+		// must not fail the instance.
+		if err := activities.StorageConnectorHealthRefresh(infiniteRetryContext(ctx), connectorID); err != nil {
+			w.logger.WithField("workflow_id", info.WorkflowExecution.ID).Errorf("failed to refresh connector health: %w", err)
+		}
+	}
+
+	return selectError(terminateError, nil)
 }
 
 func selectError(err1, err2 error) error {
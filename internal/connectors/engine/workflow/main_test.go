@@ -1,6 +1,7 @@
 package workflow
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"log/slog"
@@ -53,6 +54,9 @@ type UnitTestSuite struct {
 	pspPaymentReversed models.PSPPayment
 	pspBalance         models.PSPBalance
 	pspOther           models.PSPOther
+
+	// schedulingConfig backs the StorageConnectorsGetSchedulePolicy mock
+	schedulingConfig models.Config
 }
 
 func (s *UnitTestSuite) SetupTest() {
@@ -71,6 +75,10 @@ func (s *UnitTestSuite) SetupTest() {
 	// activity (the test env always takes the activity branch). Register it globally so any
 	// test that schedules is covered; .Maybe() leaves non-scheduling tests unaffected.
 	s.mockPollingPeriod(2 * time.Minute)
+
+	// Every scheduled instance refreshes the connector health when it
+	// terminates, tests asserting it use AssertActivityCalled.
+	s.env.OnActivity(activities.StorageConnectorHealthRefreshActivity, mock.Anything, mock.Anything).Maybe().Return(nil)
 }
 
 func (s *UnitTestSuite) AfterTest(suiteName, testName string) {
@@ -81,11 +89,19 @@ func (s *UnitTestSuite) AfterTest(suiteName, testName string) {
 // (EN-1093/H12). Temporal's TestWorkflowEnvironment always reports the newest GetVersion, so
 // scheduleNextWorkflow / create_payout / create_transfer always take the activity branch in
 // tests. Registered with .Maybe() since not every test reaches the polling-period read.
+// The same goes for StorageConnectorsGetSchedulePolicy, read by scheduleNextWorkflow, which
+// tests can tune through s.schedulingConfig.
 func (s *UnitTestSuite) mockPollingPeriod(pollingPeriod time.Duration) {
 	s.env.OnActivity(activities.StorageConnectorsGetPollingPeriodActivity, mock.Anything, mock.Anything).Maybe().Return(
 		pollingPeriod,
 		nil,
 	)
+	s.schedulingConfig = models.Config{PollingPeriod: pollingPeriod}
+	s.env.OnActivity(activities.StorageConnectorsGetSchedulePolicyActivity, mock.Anything, mock.Anything, mock.Anything).Maybe().Return(
+		func(_ context.Context, _ models.ConnectorID, capability models.Capability) (models.SchedulePolicy, error) {
+			return s.schedulingConfig.SchedulePolicy(capability), nil
+		},
+	)
 }
 
 func TestUnitTestSuite(t *testing.T) {
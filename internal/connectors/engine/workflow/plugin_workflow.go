@@ -102,6 +102,17 @@ func (w Workflow) runNextTasksV3_1(
 			request = req
 			capability = models.CAPABILITY_FETCH_CONVERSIONS
 
+		case models.TASK_FETCH_DISPUTES:
+			req := FetchNextDisputes{
+				ConnectorID:  connectorID,
+				FromPayload:  fromPayload,
+				Periodically: task.Periodically,
+			}
+
+			nextWorkflow = RunFetchNextDisputes
+			request = req
+			capability = models.CAPABILITY_FETCH_DISPUTES
+
 		case models.TASK_CREATE_WEBHOOKS:
 			req := CreateWebhooks{
 				ConnectorID: connectorID,
@@ -185,7 +196,7 @@ func (w Workflow) scheduleNextWorkflow(
 		return err
 	}
 
-	pollingPeriod, err := w.connectorPollingPeriod(ctx, connectorID)
+	policy, err := w.connectorSchedulePolicy(ctx, connectorID, capability)
 	if err != nil {
 		return err
 	}
@@ -194,10 +205,11 @@ func (w Workflow) scheduleNextWorkflow(
 		infiniteRetryContext(ctx),
 		activities.ScheduleCreateOptions{
 			ScheduleID: scheduleID,
-			Jitter:     calculateJitter(pollingPeriod),
+			Jitter:     calculateJitter(policy.PollingPeriod),
 			Interval: &client.ScheduleIntervalSpec{
-				Every: pollingPeriod,
+				Every: policy.PollingPeriod,
 			},
+			BlackoutWindows: policy.BlackoutWindows,
 			Action: client.ScheduleWorkflowAction{
 				// Use the same ID as the schedule ID, so we can identify the workflows running.
 				// This is useful for debugging purposes.
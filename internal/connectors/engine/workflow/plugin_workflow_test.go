@@ -39,6 +39,40 @@ func (s *UnitTestSuite) Test_Run_Periodically_UsesActivityPollingPeriod() {
 	s.NoError(s.env.GetWorkflowError())
 }
 
+func (s *UnitTestSuite) Test_Run_Periodically_UsesCapabilitySchedulePolicy() {
+	s.schedulingConfig.PollingPeriods = map[models.Capability]time.Duration{
+		models.CAPABILITY_FETCH_EXTERNAL_ACCOUNTS: 24 * time.Hour,
+	}
+	s.schedulingConfig.BlackoutWindows = []models.BlackoutWindow{{Start: "22:00", End: "02:00"}}
+
+	s.env.OnActivity(activities.StorageSchedulesStoreActivity, mock.Anything, mock.Anything).Times(2).Return(nil)
+	s.env.OnActivity(activities.TemporalScheduleCreateActivity, mock.Anything, mock.Anything).Times(2).Return(func(ctx context.Context, req activities.ScheduleCreateOptions) error {
+		switch req.Action.Workflow {
+		case RunFetchNextExternalAccounts:
+			s.Equal(24*time.Hour, req.Interval.Every)
+		case RunFetchNextBalances:
+			s.Equal(2*time.Minute, req.Interval.Every)
+		default:
+			s.Failf("unexpected workflow", "%v", req.Action.Workflow)
+		}
+		s.Equal(s.schedulingConfig.BlackoutWindows, req.BlackoutWindows)
+		return nil
+	})
+
+	s.env.ExecuteWorkflow(
+		RunNextTasksV3_1,
+		s.connectorID,
+		&FromPayload{ID: "1", Payload: []byte(`{}`)},
+		[]models.ConnectorTaskTree{
+			{TaskType: models.TASK_FETCH_EXTERNAL_ACCOUNTS, Name: "test", Periodically: true, NextTasks: []models.ConnectorTaskTree{}},
+			{TaskType: models.TASK_FETCH_BALANCES, Name: "test2", Periodically: true, NextTasks: []models.ConnectorTaskTree{}},
+		},
+	)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
 func (s *UnitTestSuite) Test_Run_Periodically_FetchAccounts_Success() {
 	s.env.OnActivity(activities.StorageSchedulesStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, schedule models.Schedule) error {
 		s.Equal(fmt.Sprintf("test-%s-FETCH_ACCOUNTS-1", s.connectorID.String()), schedule.ID)
@@ -108,6 +108,7 @@ func (w Workflow) resetConnector(
 		},
 		ScheduledForDeletion: false,
 		Config:               connector.Config,
+		Environment:          connector.Environment,
 	}
 
 	if err := activities.StorageConnectorsStore(
@@ -0,0 +1,77 @@
+package workflow
+
+import (
+	"github.com/formancehq/payments/internal/connectors/engine/activities"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/pkg/errors"
+	"go.temporal.io/sdk/workflow"
+)
+
+const testConnectionMaximumAttempts = 5
+
+type RotateConnectorCredentials struct {
+	TaskID models.TaskID
+	// Connector carries the rotated config, encrypted.
+	Connector models.Connector
+	Fields    []string
+}
+
+func (w Workflow) runRotateConnectorCredentials(
+	ctx workflow.Context,
+	rotateConnectorCredentials RotateConnectorCredentials,
+) error {
+	connectorID := rotateConnectorCredentials.Connector.ID
+	err := w.rotateConnectorCredentials(ctx, rotateConnectorCredentials)
+	if err != nil {
+		if errUpdateTask := w.updateTasksError(
+			ctx,
+			rotateConnectorCredentials.TaskID,
+			&connectorID,
+			err,
+		); errUpdateTask != nil {
+			return errUpdateTask
+		}
+
+		return err
+	}
+
+	return w.updateTaskSuccess(
+		ctx,
+		rotateConnectorCredentials.TaskID,
+		&connectorID,
+		connectorID.String(),
+	)
+}
+
+func (w Workflow) rotateConnectorCredentials(
+	ctx workflow.Context,
+	rotateConnectorCredentials RotateConnectorCredentials,
+) error {
+	// The new credentials are only retried on transient upstream errors, and a
+	// few times at most: the caller is waiting on the task to know whether they
+	// were accepted.
+	err := activities.PluginTestConnection(
+		maximumAttemptsRetryContext(ctx, testConnectionMaximumAttempts),
+		rotateConnectorCredentials.Connector,
+	)
+	if err != nil {
+		return errors.Wrap(err, "testing new credentials")
+	}
+
+	// There is no grace period: the previous credentials are owned by the PSP
+	// and nothing on our side can keep them valid. Every worker reloads the
+	// plugin once the config is stored, activities already running with the
+	// previous credentials fail once they are revoked and are retried with
+	// the new ones.
+	return activities.StorageConnectorsRotateCredentials(
+		infiniteRetryContext(ctx),
+		rotateConnectorCredentials.Connector,
+		models.ConnectorCredentialsRotation{
+			ConnectorID: rotateConnectorCredentials.Connector.ID,
+			Fields:      rotateConnectorCredentials.Fields,
+			RotatedAt:   workflow.Now(ctx).UTC(),
+		},
+	)
+}
+
+const RunRotateConnectorCredentials = "RotateConnectorCredentials"
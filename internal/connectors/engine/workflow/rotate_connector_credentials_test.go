@@ -0,0 +1,92 @@
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/formancehq/payments/internal/connectors/engine/activities"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/stretchr/testify/mock"
+	"go.temporal.io/sdk/temporal"
+)
+
+func (s *UnitTestSuite) rotateConnectorCredentialsRequest() RotateConnectorCredentials {
+	return RotateConnectorCredentials{
+		TaskID: models.TaskID{
+			Reference:   "test",
+			ConnectorID: s.connectorID,
+		},
+		Connector: models.Connector{
+			ConnectorBase: models.ConnectorBase{
+				ID:       s.connectorID,
+				Name:     "test",
+				Provider: s.connectorID.Provider,
+			},
+			Config: json.RawMessage(`"encrypted"`),
+		},
+		Fields: []string{"apiKey"},
+	}
+}
+
+func (s *UnitTestSuite) Test_RotateConnectorCredentials_Success() {
+	req := s.rotateConnectorCredentialsRequest()
+
+	s.env.OnActivity(activities.PluginTestConnectionActivity, mock.Anything, req.Connector).Once().Return(nil)
+	s.env.OnActivity(activities.StorageConnectorsRotateCredentialsActivity, mock.Anything, req.Connector, mock.Anything).Once().Return(
+		func(ctx context.Context, _ models.Connector, rotation models.ConnectorCredentialsRotation) error {
+			s.Equal(s.connectorID, rotation.ConnectorID)
+			s.Equal([]string{"apiKey"}, rotation.Fields)
+			return nil
+		},
+	)
+	s.env.OnActivity(activities.StorageTasksStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, task models.Task) error {
+		s.Equal(models.TASK_STATUS_SUCCEEDED, task.Status)
+		s.Equal(s.connectorID, *task.ConnectorID)
+		return nil
+	})
+
+	s.env.ExecuteWorkflow(RunRotateConnectorCredentials, req)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *UnitTestSuite) Test_RotateConnectorCredentials_TestConnection_Error() {
+	req := s.rotateConnectorCredentialsRequest()
+
+	s.env.OnActivity(activities.PluginTestConnectionActivity, mock.Anything, req.Connector).Once().Return(
+		temporal.NewNonRetryableApplicationError("invalid credentials", activities.ErrTypeInvalidArgument, fmt.Errorf("invalid credentials")),
+	)
+	s.env.OnActivity(activities.StorageTasksStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, task models.Task) error {
+		s.Equal(models.TASK_STATUS_FAILED, task.Status)
+		return nil
+	})
+
+	s.env.ExecuteWorkflow(RunRotateConnectorCredentials, req)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	s.ErrorContains(err, "invalid credentials")
+}
+
+func (s *UnitTestSuite) Test_RotateConnectorCredentials_StorageConnectorsRotateCredentials_Error() {
+	req := s.rotateConnectorCredentialsRequest()
+
+	s.env.OnActivity(activities.PluginTestConnectionActivity, mock.Anything, req.Connector).Once().Return(nil)
+	s.env.OnActivity(activities.StorageConnectorsRotateCredentialsActivity, mock.Anything, req.Connector, mock.Anything).Once().Return(
+		temporal.NewNonRetryableApplicationError("error-test", "STORAGE", fmt.Errorf("error-test")),
+	)
+	s.env.OnActivity(activities.StorageTasksStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, task models.Task) error {
+		s.Equal(models.TASK_STATUS_FAILED, task.Status)
+		return nil
+	})
+
+	s.env.ExecuteWorkflow(RunRotateConnectorCredentials, req)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	s.ErrorContains(err, "error-test")
+}
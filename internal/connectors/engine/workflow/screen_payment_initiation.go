@@ -0,0 +1,196 @@
+package workflow
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/formancehq/payments/internal/connectors/engine/activities"
+	"github.com/formancehq/payments/internal/connectors/engine/utils"
+	errorsutils "github.com/formancehq/payments/pkg/domain/errors"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// SignalScreeningResult is sent to the create payout and create transfer
+// workflows with the models.ScreeningResult received on the screening callback.
+const SignalScreeningResult = "ScreeningResult"
+
+const (
+	// screeningSubmitMaximumAttempts bounds the attempts to reach the
+	// screening service, the payment initiation fails afterwards and can be
+	// retried.
+	screeningSubmitMaximumAttempts = 5
+
+	// screeningDecisionTimeout is how long a payment initiation is held in
+	// the PENDING_SCREENING status waiting for the decision of the screening
+	// service before failing.
+	screeningDecisionTimeout = 72 * time.Hour
+)
+
+// screenPaymentInitiation submits the payment initiation to the screening
+// service before it reaches the PSP. When the service cannot decide right away,
+// the payment initiation is held in the PENDING_SCREENING status until the
+// decision is received on the screening callback.
+func (w Workflow) screenPaymentInitiation(
+	ctx workflow.Context,
+	pi *models.PaymentInitiation,
+	pspPI models.PSPPaymentInitiation,
+) error {
+	if !IsPaymentInitiationScreeningEnabled(ctx) {
+		return nil
+	}
+
+	// The worker configuration can change between two replays of the
+	// workflow, the one seen by the first execution is kept in its history.
+	var enabled bool
+	err := workflow.SideEffect(ctx, func(ctx workflow.Context) any {
+		return w.screening
+	}).Get(&enabled)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		return nil
+	}
+
+	callbackURL, err := utils.GetScreeningCallbackURL(w.stackPublicURL, pi.ID)
+	if err != nil {
+		return err
+	}
+
+	result, err := activities.ScreeningSubmit(
+		maximumAttemptsRetryContext(ctx, screeningSubmitMaximumAttempts),
+		models.ScreeningRequest{
+			PaymentInitiationID: pi.ID,
+			Type:                pi.Type,
+			Reference:           pi.Reference,
+			Description:         pi.Description,
+			Amount:              pi.Amount,
+			Asset:               pi.Asset,
+			Beneficiary:         models.ScreeningBeneficiaryFromPSPAccount(pspPI.DestinationAccount),
+			CallbackURL:         callbackURL,
+		},
+	)
+	if err != nil {
+		// Same as a plugin error, the payment initiation can be retried once
+		// the screening service is reachable again.
+		errAdj := w.addPIAdjustment(
+			ctx,
+			models.PaymentInitiationAdjustmentID{
+				PaymentInitiationID: pi.ID,
+				CreatedAt:           workflow.Now(ctx),
+				Status:              models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_FAILED,
+			},
+			pi.Amount,
+			&pi.Asset,
+			errorsutils.Cause(err),
+			nil,
+		)
+		if errAdj != nil {
+			return errAdj
+		}
+		return err
+	}
+
+	if result.Decision == models.SCREENING_DECISION_PENDING {
+		err = w.addPIAdjustment(
+			ctx,
+			models.PaymentInitiationAdjustmentID{
+				PaymentInitiationID: pi.ID,
+				CreatedAt:           workflow.Now(ctx),
+				Status:              models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PENDING_SCREENING,
+			},
+			pi.Amount,
+			&pi.Asset,
+			nil,
+			nil,
+		)
+		if err != nil {
+			return err
+		}
+
+		received, err := w.waitScreeningDecision(ctx, result)
+		if err != nil {
+			return err
+		}
+
+		if !received {
+			errTimeout := fmt.Errorf("no screening decision received after %s", screeningDecisionTimeout)
+			err = w.addPIAdjustment(
+				ctx,
+				models.PaymentInitiationAdjustmentID{
+					PaymentInitiationID: pi.ID,
+					CreatedAt:           workflow.Now(ctx),
+					Status:              models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_FAILED,
+				},
+				pi.Amount,
+				&pi.Asset,
+				errTimeout,
+				nil,
+			)
+			if err != nil {
+				return err
+			}
+
+			return temporal.NewNonRetryableApplicationError(
+				"screening decision timed out",
+				ErrValidation,
+				errTimeout,
+			)
+		}
+	}
+
+	switch result.Decision {
+	case models.SCREENING_DECISION_APPROVED:
+		return nil
+	default:
+		reason := result.Reason
+		if reason == "" {
+			reason = fmt.Sprintf("screening decision %s", result.Decision)
+		}
+		errRejected := errors.New(reason)
+
+		err = w.addPIAdjustment(
+			ctx,
+			models.PaymentInitiationAdjustmentID{
+				PaymentInitiationID: pi.ID,
+				CreatedAt:           workflow.Now(ctx),
+				Status:              models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_REJECTED,
+			},
+			pi.Amount,
+			&pi.Asset,
+			errRejected,
+			nil,
+		)
+		if err != nil {
+			return err
+		}
+
+		return temporal.NewNonRetryableApplicationError(
+			"payment initiation rejected by screening",
+			ErrValidation,
+			errRejected,
+		)
+	}
+}
+
+// waitScreeningDecision waits for the decision of the screening service on the
+// SignalScreeningResult channel. It returns false if none was received before
+// screeningDecisionTimeout.
+func (w Workflow) waitScreeningDecision(ctx workflow.Context, result *models.ScreeningResult) (bool, error) {
+	timerCtx, cancelTimer := workflow.WithCancel(ctx)
+	defer cancelTimer()
+
+	received := false
+	selector := workflow.NewSelector(ctx)
+	selector.AddReceive(workflow.GetSignalChannel(ctx, SignalScreeningResult), func(c workflow.ReceiveChannel, _ bool) {
+		c.Receive(ctx, result)
+		received = true
+	})
+	selector.AddFuture(workflow.NewTimer(timerCtx, screeningDecisionTimeout), func(f workflow.Future) {})
+	selector.Select(ctx)
+
+	return received, ctx.Err()
+}
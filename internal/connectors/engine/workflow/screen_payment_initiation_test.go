@@ -0,0 +1,267 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/formancehq/payments/internal/connectors/engine/activities"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/stretchr/testify/mock"
+	"go.temporal.io/sdk/temporal"
+	temporalworkflow "go.temporal.io/sdk/workflow"
+)
+
+func (s *UnitTestSuite) enableScreening() {
+	w := s.w.WithScreening(true)
+	s.env.RegisterWorkflowWithOptions(w.runCreatePayout, temporalworkflow.RegisterOptions{
+		Name:                          RunCreatePayout,
+		DisableAlreadyRegisteredCheck: true,
+	})
+	s.env.RegisterWorkflowWithOptions(w.runCreateTransfer, temporalworkflow.RegisterOptions{
+		Name:                          RunCreateTransfer,
+		DisableAlreadyRegisteredCheck: true,
+	})
+}
+
+func (s *UnitTestSuite) Test_CreatePayout_Screening_Approved_Success() {
+	s.enableScreening()
+
+	s.env.OnActivity(activities.StoragePaymentInitiationsGetActivity, mock.Anything, s.paymentInitiationID).Once().Return(&s.paymentInitiationPayout, nil)
+	s.env.OnActivity(activities.StorageAccountsGetActivity, mock.Anything, *s.paymentInitiationPayout.SourceAccountID).Once().Return(&s.account, nil)
+	s.env.OnActivity(activities.StorageAccountsGetActivity, mock.Anything, *s.paymentInitiationPayout.DestinationAccountID).Once().Return(&s.account, nil)
+	s.env.OnActivity(activities.ScreeningSubmitActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, req models.ScreeningRequest) (*models.ScreeningResult, error) {
+		s.Equal(s.paymentInitiationID, req.PaymentInitiationID)
+		s.Equal(models.PAYMENT_INITIATION_TYPE_PAYOUT, req.Type)
+		s.Equal(s.paymentInitiationPayout.Amount, req.Amount)
+		s.Equal(s.paymentInitiationPayout.Asset, req.Asset)
+		s.Equal("http://localhost:8080/api/payments/v3/payment-initiations/"+s.paymentInitiationID.String()+"/screening", req.CallbackURL)
+		return &models.ScreeningResult{Decision: models.SCREENING_DECISION_APPROVED}, nil
+	})
+	s.env.OnActivity(activities.StoragePaymentInitiationsAdjustmentsStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, adj models.PaymentInitiationAdjustment) error {
+		s.Equal(models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PROCESSING, adj.Status)
+		return nil
+	})
+	s.env.OnActivity(activities.PluginCreatePayoutActivity, mock.Anything, mock.Anything).Once().Return(&models.CreatePayoutResponse{
+		Payment: &s.pspPayment,
+	}, nil)
+	s.env.OnActivity(activities.StoragePaymentsStoreActivity, mock.Anything, mock.Anything).Once().Return(nil)
+	s.env.OnActivity(activities.StoragePaymentInitiationsRelatedPaymentsStoreActivity, mock.Anything, mock.Anything).Once().Return(nil)
+	s.env.OnActivity(activities.StoragePaymentInitiationsAdjustmentsStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, adj models.PaymentInitiationAdjustment) error {
+		s.Equal(models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PROCESSED, adj.Status)
+		return nil
+	})
+	s.env.OnActivity(activities.StorageTasksStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, task models.Task) error {
+		s.Equal(models.TASK_STATUS_SUCCEEDED, task.Status)
+		return nil
+	})
+
+	s.env.ExecuteWorkflow(RunCreatePayout, CreatePayout{
+		TaskID: models.TaskID{
+			Reference:   "test",
+			ConnectorID: s.connectorID,
+		},
+		ConnectorID:         s.connectorID,
+		PaymentInitiationID: s.paymentInitiationID,
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *UnitTestSuite) Test_CreatePayout_Screening_Rejected_Error() {
+	s.enableScreening()
+
+	s.env.OnActivity(activities.StoragePaymentInitiationsGetActivity, mock.Anything, s.paymentInitiationID).Once().Return(&s.paymentInitiationPayout, nil)
+	s.env.OnActivity(activities.StorageAccountsGetActivity, mock.Anything, *s.paymentInitiationPayout.SourceAccountID).Once().Return(&s.account, nil)
+	s.env.OnActivity(activities.StorageAccountsGetActivity, mock.Anything, *s.paymentInitiationPayout.DestinationAccountID).Once().Return(&s.account, nil)
+	s.env.OnActivity(activities.ScreeningSubmitActivity, mock.Anything, mock.Anything).Once().Return(&models.ScreeningResult{
+		Decision: models.SCREENING_DECISION_REJECTED,
+		Reason:   "sanctioned beneficiary",
+	}, nil)
+	s.env.OnActivity(activities.StoragePaymentInitiationsAdjustmentsStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, adj models.PaymentInitiationAdjustment) error {
+		s.Equal(models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_REJECTED, adj.Status)
+		s.EqualError(adj.Error, "sanctioned beneficiary")
+		return nil
+	})
+	s.env.OnActivity(activities.StorageTasksStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, task models.Task) error {
+		s.Equal(models.TASK_STATUS_FAILED, task.Status)
+		return nil
+	})
+
+	s.env.ExecuteWorkflow(RunCreatePayout, CreatePayout{
+		TaskID: models.TaskID{
+			Reference:   "test",
+			ConnectorID: s.connectorID,
+		},
+		ConnectorID:         s.connectorID,
+		PaymentInitiationID: s.paymentInitiationID,
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	s.ErrorContains(err, "payment initiation rejected by screening")
+	s.env.AssertNotCalled(s.T(), "PluginCreatePayout", mock.Anything, mock.Anything)
+}
+
+func (s *UnitTestSuite) Test_CreatePayout_Screening_Error() {
+	s.enableScreening()
+
+	s.env.OnActivity(activities.StoragePaymentInitiationsGetActivity, mock.Anything, s.paymentInitiationID).Once().Return(&s.paymentInitiationPayout, nil)
+	s.env.OnActivity(activities.StorageAccountsGetActivity, mock.Anything, *s.paymentInitiationPayout.SourceAccountID).Once().Return(&s.account, nil)
+	s.env.OnActivity(activities.StorageAccountsGetActivity, mock.Anything, *s.paymentInitiationPayout.DestinationAccountID).Once().Return(&s.account, nil)
+	s.env.OnActivity(activities.ScreeningSubmitActivity, mock.Anything, mock.Anything).Once().Return(
+		nil,
+		temporal.NewNonRetryableApplicationError("error-test", activities.ErrTypeInvalidArgument, errors.New("error-test")),
+	)
+	s.env.OnActivity(activities.StoragePaymentInitiationsAdjustmentsStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, adj models.PaymentInitiationAdjustment) error {
+		s.Equal(models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_FAILED, adj.Status)
+		return nil
+	})
+	s.env.OnActivity(activities.StorageTasksStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, task models.Task) error {
+		s.Equal(models.TASK_STATUS_FAILED, task.Status)
+		return nil
+	})
+
+	s.env.ExecuteWorkflow(RunCreatePayout, CreatePayout{
+		TaskID: models.TaskID{
+			Reference:   "test",
+			ConnectorID: s.connectorID,
+		},
+		ConnectorID:         s.connectorID,
+		PaymentInitiationID: s.paymentInitiationID,
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	s.ErrorContains(err, "error-test")
+}
+
+func (s *UnitTestSuite) Test_CreateTransfer_Screening_Pending_Approved_Success() {
+	s.enableScreening()
+
+	s.env.OnActivity(activities.StoragePaymentInitiationsGetActivity, mock.Anything, s.paymentInitiationID).Once().Return(&s.paymentInitiationTransfer, nil)
+	s.env.OnActivity(activities.StorageAccountsGetActivity, mock.Anything, *s.paymentInitiationTransfer.SourceAccountID).Once().Return(&s.account, nil)
+	s.env.OnActivity(activities.StorageAccountsGetActivity, mock.Anything, *s.paymentInitiationTransfer.DestinationAccountID).Once().Return(&s.account, nil)
+	s.env.OnActivity(activities.ScreeningSubmitActivity, mock.Anything, mock.Anything).Once().Return(&models.ScreeningResult{
+		Decision: models.SCREENING_DECISION_PENDING,
+	}, nil)
+	s.env.OnActivity(activities.StoragePaymentInitiationsAdjustmentsStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, adj models.PaymentInitiationAdjustment) error {
+		s.Equal(models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PENDING_SCREENING, adj.Status)
+		return nil
+	})
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalScreeningResult, models.ScreeningResult{Decision: models.SCREENING_DECISION_APPROVED})
+	}, time.Hour)
+	s.env.OnActivity(activities.StoragePaymentInitiationsAdjustmentsStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, adj models.PaymentInitiationAdjustment) error {
+		s.Equal(models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PROCESSING, adj.Status)
+		return nil
+	})
+	s.env.OnActivity(activities.PluginCreateTransferActivity, mock.Anything, mock.Anything).Once().Return(&models.CreateTransferResponse{
+		Payment: &s.pspPayment,
+	}, nil)
+	s.env.OnActivity(activities.StoragePaymentsStoreActivity, mock.Anything, mock.Anything).Once().Return(nil)
+	s.env.OnActivity(activities.StoragePaymentInitiationsRelatedPaymentsStoreActivity, mock.Anything, mock.Anything).Once().Return(nil)
+	s.env.OnActivity(activities.StoragePaymentInitiationsAdjustmentsStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, adj models.PaymentInitiationAdjustment) error {
+		s.Equal(models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PROCESSED, adj.Status)
+		return nil
+	})
+	s.env.OnActivity(activities.StorageTasksStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, task models.Task) error {
+		s.Equal(models.TASK_STATUS_SUCCEEDED, task.Status)
+		return nil
+	})
+
+	s.env.ExecuteWorkflow(RunCreateTransfer, CreateTransfer{
+		TaskID: models.TaskID{
+			Reference:   "test",
+			ConnectorID: s.connectorID,
+		},
+		ConnectorID:         s.connectorID,
+		PaymentInitiationID: s.paymentInitiationID,
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *UnitTestSuite) Test_CreateTransfer_Screening_Pending_Rejected_Error() {
+	s.enableScreening()
+
+	s.env.OnActivity(activities.StoragePaymentInitiationsGetActivity, mock.Anything, s.paymentInitiationID).Once().Return(&s.paymentInitiationTransfer, nil)
+	s.env.OnActivity(activities.StorageAccountsGetActivity, mock.Anything, *s.paymentInitiationTransfer.SourceAccountID).Once().Return(&s.account, nil)
+	s.env.OnActivity(activities.StorageAccountsGetActivity, mock.Anything, *s.paymentInitiationTransfer.DestinationAccountID).Once().Return(&s.account, nil)
+	s.env.OnActivity(activities.ScreeningSubmitActivity, mock.Anything, mock.Anything).Once().Return(&models.ScreeningResult{
+		Decision: models.SCREENING_DECISION_PENDING,
+	}, nil)
+	s.env.OnActivity(activities.StoragePaymentInitiationsAdjustmentsStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, adj models.PaymentInitiationAdjustment) error {
+		s.Equal(models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PENDING_SCREENING, adj.Status)
+		return nil
+	})
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalScreeningResult, models.ScreeningResult{Decision: models.SCREENING_DECISION_REJECTED})
+	}, time.Hour)
+	s.env.OnActivity(activities.StoragePaymentInitiationsAdjustmentsStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, adj models.PaymentInitiationAdjustment) error {
+		s.Equal(models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_REJECTED, adj.Status)
+		s.EqualError(adj.Error, "screening decision REJECTED")
+		return nil
+	})
+	s.env.OnActivity(activities.StorageTasksStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, task models.Task) error {
+		s.Equal(models.TASK_STATUS_FAILED, task.Status)
+		return nil
+	})
+
+	s.env.ExecuteWorkflow(RunCreateTransfer, CreateTransfer{
+		TaskID: models.TaskID{
+			Reference:   "test",
+			ConnectorID: s.connectorID,
+		},
+		ConnectorID:         s.connectorID,
+		PaymentInitiationID: s.paymentInitiationID,
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	s.ErrorContains(err, "payment initiation rejected by screening")
+}
+
+func (s *UnitTestSuite) Test_CreateTransfer_Screening_Pending_Timeout_Error() {
+	s.enableScreening()
+
+	s.env.OnActivity(activities.StoragePaymentInitiationsGetActivity, mock.Anything, s.paymentInitiationID).Once().Return(&s.paymentInitiationTransfer, nil)
+	s.env.OnActivity(activities.StorageAccountsGetActivity, mock.Anything, *s.paymentInitiationTransfer.SourceAccountID).Once().Return(&s.account, nil)
+	s.env.OnActivity(activities.StorageAccountsGetActivity, mock.Anything, *s.paymentInitiationTransfer.DestinationAccountID).Once().Return(&s.account, nil)
+	s.env.OnActivity(activities.ScreeningSubmitActivity, mock.Anything, mock.Anything).Once().Return(&models.ScreeningResult{
+		Decision: models.SCREENING_DECISION_PENDING,
+	}, nil)
+	s.env.OnActivity(activities.StoragePaymentInitiationsAdjustmentsStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, adj models.PaymentInitiationAdjustment) error {
+		s.Equal(models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PENDING_SCREENING, adj.Status)
+		return nil
+	})
+	s.env.OnActivity(activities.StoragePaymentInitiationsAdjustmentsStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, adj models.PaymentInitiationAdjustment) error {
+		s.Equal(models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_FAILED, adj.Status)
+		s.ErrorContains(adj.Error, "no screening decision received")
+		return nil
+	})
+	s.env.OnActivity(activities.StorageTasksStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, task models.Task) error {
+		s.Equal(models.TASK_STATUS_FAILED, task.Status)
+		return nil
+	})
+
+	s.env.ExecuteWorkflow(RunCreateTransfer, CreateTransfer{
+		TaskID: models.TaskID{
+			Reference:   "test",
+			ConnectorID: s.connectorID,
+		},
+		ConnectorID:         s.connectorID,
+		PaymentInitiationID: s.paymentInitiationID,
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	s.ErrorContains(err, "screening decision timed out")
+	s.env.AssertNotCalled(s.T(), "PluginCreateTransfer", mock.Anything, mock.Anything)
+}
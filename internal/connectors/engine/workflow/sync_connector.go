@@ -0,0 +1,126 @@
+package workflow
+
+import (
+	"strings"
+
+	"github.com/formancehq/go-libs/v5/pkg/query"
+	"github.com/formancehq/go-libs/v5/pkg/storage/bun/paginate"
+	"github.com/formancehq/payments/internal/connectors/engine/activities"
+	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"go.temporal.io/sdk/workflow"
+)
+
+type SyncConnector struct {
+	ConnectorID   models.ConnectorID
+	TaskID        models.TaskID
+	NextPageToken string
+}
+
+func (w Workflow) runSyncConnector(
+	ctx workflow.Context,
+	syncConnector SyncConnector,
+) error {
+	err := w.syncConnector(ctx, syncConnector)
+	if err != nil {
+		if workflow.IsContinueAsNewError(err) {
+			return err
+		}
+
+		if errUpdateTask := w.updateTasksError(
+			ctx,
+			syncConnector.TaskID,
+			&syncConnector.ConnectorID,
+			err,
+		); errUpdateTask != nil {
+			return errUpdateTask
+		}
+
+		return err
+	}
+
+	return w.updateTaskSuccess(
+		ctx,
+		syncConnector.TaskID,
+		&syncConnector.ConnectorID,
+		syncConnector.ConnectorID.String(),
+	)
+}
+
+func (w Workflow) syncConnector(
+	ctx workflow.Context,
+	syncConnector SyncConnector,
+) error {
+	var q storage.ListSchedulesQuery
+	if syncConnector.NextPageToken != "" {
+		err := paginate.UnmarshalCursor(syncConnector.NextPageToken, &q)
+		if err != nil {
+			return err
+		}
+	} else {
+		q = storage.NewListSchedulesQuery(
+			paginate.NewPaginatedQueryOptions(storage.ScheduleQuery{}).
+				WithPageSize(100).
+				WithQueryBuilder(
+					query.Match("connector_id", syncConnector.ConnectorID.String()),
+				),
+		)
+	}
+
+	for {
+		schedules, err := activities.StorageSchedulesList(infiniteRetryContext(ctx), q)
+		if err != nil {
+			return err
+		}
+
+		// paused schedules are left alone, they have to be resumed explicitly
+		var toTrigger []models.Schedule
+		for _, s := range schedules.Data {
+			if s.PausedAt != nil || !w.isFetchSchedule(s) {
+				continue
+			}
+			toTrigger = append(toTrigger, s)
+		}
+
+		if len(toTrigger) > 0 {
+			if err := activities.TemporalSchedulesTrigger(infiniteRetryContext(ctx), toTrigger); err != nil {
+				return err
+			}
+		}
+
+		if !schedules.HasMore {
+			break
+		}
+
+		err = paginate.UnmarshalCursor(schedules.Next, &q)
+		if err != nil {
+			return err
+		}
+
+		if w.shouldContinueAsNew(ctx) {
+			return workflow.NewContinueAsNewError(
+				ctx,
+				RunSyncConnector,
+				SyncConnector{
+					ConnectorID:   syncConnector.ConnectorID,
+					TaskID:        syncConnector.TaskID,
+					NextPageToken: schedules.Next,
+				},
+			)
+		}
+	}
+
+	return nil
+}
+
+func (w Workflow) isFetchSchedule(s models.Schedule) bool {
+	for _, capability := range fetchCapabilities {
+		prefix := fetchNextWorkflowScheduleID(w.stack, s.ConnectorID.String(), capability.String(), nil)
+		if strings.HasPrefix(s.ID, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+const RunSyncConnector = "SyncConnector"
@@ -0,0 +1,97 @@
+package workflow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/formancehq/go-libs/v5/pkg/storage/bun/paginate"
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/payments/internal/connectors/engine/activities"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/stretchr/testify/mock"
+	"go.temporal.io/sdk/temporal"
+)
+
+func (s *UnitTestSuite) Test_SyncConnector_Success() {
+	fetchAccounts := models.Schedule{ID: fmt.Sprintf("test-%s-FETCH_ACCOUNTS", s.connectorID.String()), ConnectorID: s.connectorID}
+	fetchPayments := models.Schedule{ID: fmt.Sprintf("test-%s-FETCH_PAYMENTS-acc1", s.connectorID.String()), ConnectorID: s.connectorID}
+	paused := models.Schedule{
+		ID:          fmt.Sprintf("test-%s-FETCH_BALANCES", s.connectorID.String()),
+		ConnectorID: s.connectorID,
+		PausedAt:    pointer.For(s.env.Now().UTC()),
+	}
+	nonFetch := models.Schedule{ID: fmt.Sprintf("test-%s-HEALTH_CHECK", s.connectorID.String()), ConnectorID: s.connectorID}
+
+	s.env.OnActivity(activities.StorageSchedulesListActivity, mock.Anything, mock.Anything).Once().Return(
+		&paginate.Cursor[models.Schedule]{HasMore: false, Data: []models.Schedule{fetchAccounts, fetchPayments, paused, nonFetch}},
+		nil,
+	)
+	s.env.OnActivity(activities.TemporalSchedulesTriggerActivity, mock.Anything, []models.Schedule{fetchAccounts, fetchPayments}).Once().Return(nil)
+	s.env.OnActivity(activities.StorageTasksStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, task models.Task) error {
+		s.Equal(models.TASK_STATUS_SUCCEEDED, task.Status)
+		s.Equal(s.connectorID, *task.ConnectorID)
+		return nil
+	})
+
+	s.env.ExecuteWorkflow(RunSyncConnector, SyncConnector{
+		ConnectorID: s.connectorID,
+		TaskID: models.TaskID{
+			Reference:   "test",
+			ConnectorID: s.connectorID,
+		},
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *UnitTestSuite) Test_SyncConnector_NoSchedules_Success() {
+	s.env.OnActivity(activities.StorageSchedulesListActivity, mock.Anything, mock.Anything).Once().Return(
+		&paginate.Cursor[models.Schedule]{HasMore: false, Data: []models.Schedule{}},
+		nil,
+	)
+	s.env.OnActivity(activities.StorageTasksStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, task models.Task) error {
+		s.Equal(models.TASK_STATUS_SUCCEEDED, task.Status)
+		return nil
+	})
+
+	s.env.ExecuteWorkflow(RunSyncConnector, SyncConnector{
+		ConnectorID: s.connectorID,
+		TaskID: models.TaskID{
+			Reference:   "test",
+			ConnectorID: s.connectorID,
+		},
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *UnitTestSuite) Test_SyncConnector_TemporalSchedulesTrigger_Error() {
+	schedule := models.Schedule{ID: fmt.Sprintf("test-%s-FETCH_ACCOUNTS", s.connectorID.String()), ConnectorID: s.connectorID}
+
+	s.env.OnActivity(activities.StorageSchedulesListActivity, mock.Anything, mock.Anything).Once().Return(
+		&paginate.Cursor[models.Schedule]{HasMore: false, Data: []models.Schedule{schedule}},
+		nil,
+	)
+	s.env.OnActivity(activities.TemporalSchedulesTriggerActivity, mock.Anything, mock.Anything).Once().Return(
+		temporal.NewNonRetryableApplicationError("error-test", "TEMPORAL", fmt.Errorf("error-test")),
+	)
+	s.env.OnActivity(activities.StorageTasksStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, task models.Task) error {
+		s.Equal(models.TASK_STATUS_FAILED, task.Status)
+		return nil
+	})
+
+	s.env.ExecuteWorkflow(RunSyncConnector, SyncConnector{
+		ConnectorID: s.connectorID,
+		TaskID: models.TaskID{
+			Reference:   "test",
+			ConnectorID: s.connectorID,
+		},
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	s.ErrorContains(err, "error-test")
+}
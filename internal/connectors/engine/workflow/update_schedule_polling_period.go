@@ -45,12 +45,14 @@ func (w Workflow) runUpdateSchedulePollingPeriod(
 
 		var paused []models.Schedule
 		var matching []models.Schedule
+		capabilities := make(map[string]models.Capability)
 		for _, s := range schedules.Data {
 			hasFetchCapability := false
 			for _, capability := range fetchCapabilities {
 				prefix := fetchNextWorkflowScheduleID(w.stack, s.ConnectorID.String(), capability.String(), nil)
 				if strings.HasPrefix(s.ID, prefix) {
 					hasFetchCapability = true
+					capabilities[s.ID] = capability
 					break
 				}
 			}
@@ -69,6 +71,7 @@ func (w Workflow) runUpdateSchedulePollingPeriod(
 			}
 		}
 
+		schedulePolicyEnabled := IsCapabilitySchedulePolicyEnabled(ctx)
 		wg := workflow.NewWaitGroup(ctx)
 
 		for _, schedule := range matching {
@@ -77,11 +80,21 @@ func (w Workflow) runUpdateSchedulePollingPeriod(
 			workflow.Go(ctx, func(ctx workflow.Context) {
 				defer wg.Done()
 
-				if err := activities.TemporalScheduleUpdatePollingPeriod(
-					infiniteRetryContext(ctx),
-					s.ID,
-					in.Config.PollingPeriod,
-				); err != nil {
+				var err error
+				if schedulePolicyEnabled {
+					err = activities.TemporalScheduleUpdateSchedulePolicy(
+						infiniteRetryContext(ctx),
+						s.ID,
+						in.Config.SchedulePolicy(capabilities[s.ID]),
+					)
+				} else {
+					err = activities.TemporalScheduleUpdatePollingPeriod(
+						infiniteRetryContext(ctx),
+						s.ID,
+						in.Config.PollingPeriod,
+					)
+				}
+				if err != nil {
 					workflow.GetLogger(ctx).Error("failed to update schedule polling period", "schedule_id", s.ID, "error", err)
 				}
 			})
@@ -32,7 +32,7 @@ func (s *UnitTestSuite) Test_UpdateSchedulePollingPeriod_NotPaused_Success() {
 
 	s.env.OnActivity(activities.StorageSchedulesListActivity, mock.Anything, mock.Anything).
 		Once().Return(&paginate.Cursor[models.Schedule]{HasMore: false, Data: []models.Schedule{schedule}}, nil)
-	s.env.OnActivity(activities.TemporalScheduleUpdatePollingPeriodActivity, mock.Anything, scheduleID, mock.Anything).
+	s.env.OnActivity(activities.TemporalScheduleUpdateSchedulePolicyActivity, mock.Anything, scheduleID, mock.Anything).
 		Once().Return(nil)
 
 	s.env.ExecuteWorkflow(RunUpdateSchedulePollingPeriod, UpdateSchedulePollingPeriod{
@@ -44,13 +44,48 @@ func (s *UnitTestSuite) Test_UpdateSchedulePollingPeriod_NotPaused_Success() {
 	s.NoError(s.env.GetWorkflowError())
 }
 
+func (s *UnitTestSuite) Test_UpdateSchedulePollingPeriod_CapabilitySchedulePolicy_Success() {
+	balancesID := fmt.Sprintf("test-%s-FETCH_BALANCES", s.connectorID.String())
+	externalAccountsID := fmt.Sprintf("test-%s-FETCH_EXTERNAL_ACCOUNTS-account", s.connectorID.String())
+	blackoutWindows := []models.BlackoutWindow{{Start: "01:00", End: "03:00"}}
+	schedules := []models.Schedule{
+		{ID: balancesID, ConnectorID: s.connectorID},
+		{ID: externalAccountsID, ConnectorID: s.connectorID},
+	}
+
+	s.env.OnActivity(activities.StorageSchedulesListActivity, mock.Anything, mock.Anything).
+		Once().Return(&paginate.Cursor[models.Schedule]{HasMore: false, Data: schedules}, nil)
+	s.env.OnActivity(activities.TemporalScheduleUpdateSchedulePolicyActivity, mock.Anything, balancesID, models.SchedulePolicy{
+		PollingPeriod:   5 * time.Minute,
+		BlackoutWindows: blackoutWindows,
+	}).Once().Return(nil)
+	s.env.OnActivity(activities.TemporalScheduleUpdateSchedulePolicyActivity, mock.Anything, externalAccountsID, models.SchedulePolicy{
+		PollingPeriod:   24 * time.Hour,
+		BlackoutWindows: blackoutWindows,
+	}).Once().Return(nil)
+
+	s.env.ExecuteWorkflow(RunUpdateSchedulePollingPeriod, UpdateSchedulePollingPeriod{
+		ConnectorID: s.connectorID,
+		Config: models.Config{
+			PollingPeriod: 5 * time.Minute,
+			PollingPeriods: map[models.Capability]time.Duration{
+				models.CAPABILITY_FETCH_EXTERNAL_ACCOUNTS: 24 * time.Hour,
+			},
+			BlackoutWindows: blackoutWindows,
+		},
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
 func (s *UnitTestSuite) Test_UpdateSchedulePollingPeriod_NonFetchSchedule_Skipped() {
 	// Schedules without a FETCH_ capability keyword must be ignored entirely.
 	nonFetch := models.Schedule{ID: fmt.Sprintf("test-%s-HEALTH_CHECK", s.connectorID.String()), ConnectorID: s.connectorID}
 
 	s.env.OnActivity(activities.StorageSchedulesListActivity, mock.Anything, mock.Anything).
 		Once().Return(&paginate.Cursor[models.Schedule]{HasMore: false, Data: []models.Schedule{nonFetch}}, nil)
-	// Neither TemporalSchedulesUnpause nor TemporalScheduleUpdatePollingPeriod should be called.
+	// Neither TemporalSchedulesUnpause nor TemporalScheduleUpdateSchedulePolicy should be called.
 
 	s.env.ExecuteWorkflow(RunUpdateSchedulePollingPeriod, UpdateSchedulePollingPeriod{
 		ConnectorID: s.connectorID,
@@ -74,7 +109,7 @@ func (s *UnitTestSuite) Test_UpdateSchedulePollingPeriod_PausedSchedule_Unpaused
 		Once().Return(&paginate.Cursor[models.Schedule]{HasMore: false, Data: []models.Schedule{schedule}}, nil)
 	s.env.OnActivity(activities.TemporalSchedulesUnpauseActivity, mock.Anything, []models.Schedule{schedule}).
 		Once().Return(nil)
-	s.env.OnActivity(activities.TemporalScheduleUpdatePollingPeriodActivity, mock.Anything, scheduleID, mock.Anything).
+	s.env.OnActivity(activities.TemporalScheduleUpdateSchedulePolicyActivity, mock.Anything, scheduleID, mock.Anything).
 		Once().Return(nil)
 
 	s.env.ExecuteWorkflow(RunUpdateSchedulePollingPeriod, UpdateSchedulePollingPeriod{
@@ -97,7 +132,7 @@ func (s *UnitTestSuite) Test_UpdateSchedulePollingPeriod_MixedPaused_OnlyPausedU
 		Once().Return(&paginate.Cursor[models.Schedule]{HasMore: false, Data: []models.Schedule{paused, active}}, nil)
 	s.env.OnActivity(activities.TemporalSchedulesUnpauseActivity, mock.Anything, []models.Schedule{paused}).
 		Once().Return(nil)
-	s.env.OnActivity(activities.TemporalScheduleUpdatePollingPeriodActivity, mock.Anything, mock.Anything, mock.Anything).
+	s.env.OnActivity(activities.TemporalScheduleUpdateSchedulePolicyActivity, mock.Anything, mock.Anything, mock.Anything).
 		Times(2).Return(nil)
 
 	s.env.ExecuteWorkflow(RunUpdateSchedulePollingPeriod, UpdateSchedulePollingPeriod{
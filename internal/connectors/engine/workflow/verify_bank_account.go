@@ -0,0 +1,73 @@
+package workflow
+
+import (
+	"github.com/formancehq/payments/internal/connectors/engine/activities"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"go.temporal.io/sdk/workflow"
+)
+
+type VerifyBankAccount struct {
+	TaskID      models.TaskID
+	ConnectorID models.ConnectorID
+	BankAccount models.BankAccount
+	// Generated by the caller to keep the workflow deterministic
+	VerificationID uuid.UUID
+}
+
+func (w Workflow) runVerifyBankAccount(
+	ctx workflow.Context,
+	verifyBankAccount VerifyBankAccount,
+) error {
+	err := w.verifyBankAccount(ctx, verifyBankAccount)
+	if err != nil {
+		if errUpdateTask := w.updateTasksError(
+			ctx,
+			verifyBankAccount.TaskID,
+			&verifyBankAccount.ConnectorID,
+			err,
+		); errUpdateTask != nil {
+			return errUpdateTask
+		}
+
+		return err
+	}
+
+	return w.updateTaskSuccess(
+		ctx,
+		verifyBankAccount.TaskID,
+		&verifyBankAccount.ConnectorID,
+		verifyBankAccount.VerificationID.String(),
+	)
+}
+
+func (w Workflow) verifyBankAccount(
+	ctx workflow.Context,
+	verifyBankAccount VerifyBankAccount,
+) error {
+	verifyResponse, err := activities.PluginVerifyBankAccount(
+		infiniteRetryContext(ctx),
+		verifyBankAccount.ConnectorID,
+		models.VerifyBankAccountRequest{
+			BankAccount: verifyBankAccount.BankAccount,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return activities.StorageBankAccountsAddVerification(
+		infiniteRetryContext(ctx),
+		models.BankAccountVerification{
+			ID:            verifyBankAccount.VerificationID,
+			BankAccountID: verifyBankAccount.BankAccount.ID,
+			ConnectorID:   verifyBankAccount.ConnectorID,
+			CreatedAt:     workflow.Now(ctx).UTC(),
+			Name:          verifyBankAccount.BankAccount.Name,
+			Result:        verifyResponse.Result,
+			MatchedName:   verifyResponse.MatchedName,
+		},
+	)
+}
+
+const RunVerifyBankAccount = "VerifyBankAccount"
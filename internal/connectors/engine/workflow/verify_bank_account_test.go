@@ -0,0 +1,104 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+
+	"github.com/formancehq/payments/internal/connectors/engine/activities"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"go.temporal.io/sdk/temporal"
+)
+
+func (s *UnitTestSuite) Test_VerifyBankAccount_Success() {
+	verificationID := uuid.New()
+	s.env.OnActivity(activities.PluginVerifyBankAccountActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, request activities.VerifyBankAccountRequest) (*models.VerifyBankAccountResponse, error) {
+		s.Equal(s.connectorID, request.ConnectorID)
+		s.Equal(s.bankAccount.ID, request.Req.BankAccount.ID)
+		return &models.VerifyBankAccountResponse{
+			Result:      models.BANK_ACCOUNT_VERIFICATION_RESULT_CLOSE_MATCH,
+			MatchedName: &s.bankAccount.Name,
+		}, nil
+	})
+	s.env.OnActivity(activities.StorageBankAccountsAddVerificationActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, verification models.BankAccountVerification) error {
+		s.Equal(verificationID, verification.ID)
+		s.Equal(s.bankAccount.ID, verification.BankAccountID)
+		s.Equal(s.connectorID, verification.ConnectorID)
+		s.Equal(s.bankAccount.Name, verification.Name)
+		s.Equal(models.BANK_ACCOUNT_VERIFICATION_RESULT_CLOSE_MATCH, verification.Result)
+		s.Equal(&s.bankAccount.Name, verification.MatchedName)
+		return nil
+	})
+	s.env.OnActivity(activities.StorageTasksStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, task models.Task) error {
+		s.Equal(models.TASK_STATUS_SUCCEEDED, task.Status)
+		return nil
+	})
+
+	s.env.ExecuteWorkflow(RunVerifyBankAccount, VerifyBankAccount{
+		TaskID: models.TaskID{
+			Reference:   "test",
+			ConnectorID: s.connectorID,
+		},
+		ConnectorID:    s.connectorID,
+		BankAccount:    s.bankAccount,
+		VerificationID: verificationID,
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *UnitTestSuite) Test_VerifyBankAccount_PluginVerifyBankAccount_Error() {
+	s.env.OnActivity(activities.PluginVerifyBankAccountActivity, mock.Anything, mock.Anything).Once().Return(
+		nil,
+		temporal.NewNonRetryableApplicationError("error-test", "error-test", errors.New("error-test")),
+	)
+	s.env.OnActivity(activities.StorageTasksStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, task models.Task) error {
+		s.Equal(models.TASK_STATUS_FAILED, task.Status)
+		return nil
+	})
+
+	s.env.ExecuteWorkflow(RunVerifyBankAccount, VerifyBankAccount{
+		TaskID: models.TaskID{
+			Reference:   "test",
+			ConnectorID: s.connectorID,
+		},
+		ConnectorID:    s.connectorID,
+		BankAccount:    s.bankAccount,
+		VerificationID: uuid.New(),
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	s.ErrorContains(err, "error-test")
+}
+
+func (s *UnitTestSuite) Test_VerifyBankAccount_StorageBankAccountsAddVerification_Error() {
+	s.env.OnActivity(activities.PluginVerifyBankAccountActivity, mock.Anything, mock.Anything).Once().Return(&models.VerifyBankAccountResponse{
+		Result: models.BANK_ACCOUNT_VERIFICATION_RESULT_MATCH,
+	}, nil)
+	s.env.OnActivity(activities.StorageBankAccountsAddVerificationActivity, mock.Anything, mock.Anything).Once().Return(
+		temporal.NewNonRetryableApplicationError("error-test", "error-test", errors.New("error-test")),
+	)
+	s.env.OnActivity(activities.StorageTasksStoreActivity, mock.Anything, mock.Anything).Once().Return(func(ctx context.Context, task models.Task) error {
+		s.Equal(models.TASK_STATUS_FAILED, task.Status)
+		return nil
+	})
+
+	s.env.ExecuteWorkflow(RunVerifyBankAccount, VerifyBankAccount{
+		TaskID: models.TaskID{
+			Reference:   "test",
+			ConnectorID: s.connectorID,
+		},
+		ConnectorID:    s.connectorID,
+		BankAccount:    s.bankAccount,
+		VerificationID: uuid.New(),
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	s.ErrorContains(err, "error-test")
+}
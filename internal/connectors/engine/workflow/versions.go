@@ -8,6 +8,10 @@ const (
 	versionFlagPaymentInitiationUpdateAsActivity = "storage_payment_initiation_update_as_activity"
 	versionFlagConnectorIDSearchAttributeEnabled = "connector_id_search_attribute_enabled"
 	versionFlagDeterministicPollingPeriod        = "deterministic_polling_period"
+	versionFlagCapabilitySchedulePolicy          = "capability_schedule_policy"
+	versionFlagConnectorHealthRefresh            = "connector_health_refresh"
+	versionFlagPaymentInitiationScreening        = "payment_initiation_screening"
+	versionFlagBankAccountVerificationPolicy     = "bank_account_verification_policy"
 )
 
 func IsEventOutboxPatternEnabled(ctx workflow.Context) bool {
@@ -34,3 +38,23 @@ func IsDeterministicPollingPeriodEnabled(ctx workflow.Context) bool {
 	version := workflow.GetVersion(ctx, versionFlagDeterministicPollingPeriod, workflow.DefaultVersion, 1)
 	return version > workflow.DefaultVersion
 }
+
+func IsCapabilitySchedulePolicyEnabled(ctx workflow.Context) bool {
+	version := workflow.GetVersion(ctx, versionFlagCapabilitySchedulePolicy, workflow.DefaultVersion, 1)
+	return version > workflow.DefaultVersion
+}
+
+func IsConnectorHealthRefreshEnabled(ctx workflow.Context) bool {
+	version := workflow.GetVersion(ctx, versionFlagConnectorHealthRefresh, workflow.DefaultVersion, 1)
+	return version > workflow.DefaultVersion
+}
+
+func IsPaymentInitiationScreeningEnabled(ctx workflow.Context) bool {
+	version := workflow.GetVersion(ctx, versionFlagPaymentInitiationScreening, workflow.DefaultVersion, 1)
+	return version > workflow.DefaultVersion
+}
+
+func IsBankAccountVerificationPolicyEnabled(ctx workflow.Context) bool {
+	version := workflow.GetVersion(ctx, versionFlagBankAccountVerificationPolicy, workflow.DefaultVersion, 1)
+	return version > workflow.DefaultVersion
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/formancehq/go-libs/v5/pkg/observe/log"
 	temporalworker "github.com/formancehq/go-libs/v5/pkg/workflow/temporal"
 	"github.com/formancehq/payments/internal/connectors"
+	"github.com/formancehq/payments/pkg/domain/models"
 	"go.temporal.io/sdk/client"
 	"go.temporal.io/sdk/workflow"
 )
@@ -39,6 +40,14 @@ type Workflow struct {
 	stack               string
 	healthCheckInterval time.Duration
 
+	// payment initiations are submitted to the screening service before
+	// reaching the PSP
+	screening bool
+
+	// minimum bank account verification result required on the destination
+	// of a payout, the policy is disabled when UNKNOWN
+	bankAccountVerification models.BankAccountVerificationResult
+
 	logger logging.Logger
 }
 
@@ -54,6 +63,16 @@ func New(temporalClient client.Client, temporalNamespace string, connectors conn
 	}
 }
 
+func (w Workflow) WithScreening(enabled bool) Workflow {
+	w.screening = enabled
+	return w
+}
+
+func (w Workflow) WithBankAccountVerification(minimum models.BankAccountVerificationResult) Workflow {
+	w.bankAccountVerification = minimum
+	return w
+}
+
 func (w Workflow) DefinitionSet() temporalworker.DefinitionSet {
 	return temporalworker.NewDefinitionSet().
 		Append(temporalworker.Definition{
@@ -84,6 +103,10 @@ func (w Workflow) DefinitionSet() temporalworker.DefinitionSet {
 			Name: RunFetchNextConversions,
 			Func: w.runFetchNextConversions,
 		}).
+		Append(temporalworker.Definition{
+			Name: RunFetchNextDisputes,
+			Func: w.runFetchNextDisputes,
+		}).
 		Append(temporalworker.Definition{
 			Name: RunListActiveSchedules,
 			Func: w.runListActiveSchedules,
@@ -120,6 +143,18 @@ func (w Workflow) DefinitionSet() temporalworker.DefinitionSet {
 			Name: RunResetConnector,
 			Func: w.runResetConnector,
 		}).
+		Append(temporalworker.Definition{
+			Name: RunSyncConnector,
+			Func: w.runSyncConnector,
+		}).
+		Append(temporalworker.Definition{
+			Name: RunBackfillConnector,
+			Func: w.runBackfillConnector,
+		}).
+		Append(temporalworker.Definition{
+			Name: RunRotateConnectorCredentials,
+			Func: w.runRotateConnectorCredentials,
+		}).
 		Append(temporalworker.Definition{
 			Name: RunUninstallConnector,
 			Func: w.runUninstallConnector,
@@ -128,6 +163,14 @@ func (w Workflow) DefinitionSet() temporalworker.DefinitionSet {
 			Name: RunCreateBankAccount,
 			Func: w.runCreateBankAccount,
 		}).
+		Append(temporalworker.Definition{
+			Name: RunVerifyBankAccount,
+			Func: w.runVerifyBankAccount,
+		}).
+		Append(temporalworker.Definition{
+			Name: RunCreateAccount,
+			Func: w.runCreateAccount,
+		}).
 		Append(temporalworker.Definition{
 			Name: RunCreatePayout,
 			Func: w.runCreatePayout,
@@ -200,6 +243,10 @@ func (w Workflow) DefinitionSet() temporalworker.DefinitionSet {
 			Name: RunOutboxCleanup,
 			Func: w.runOutboxCleanup,
 		}).
+		Append(temporalworker.Definition{
+			Name: RunCounterpartiesLink,
+			Func: w.runCounterpartiesLink,
+		}).
 		Append(temporalworker.Definition{
 			Name: RunConnectorHealthCheck,
 			Func: w.runConnectorHealthCheck,
@@ -24,6 +24,9 @@ var (
 //go:generate mockgen -source manager.go -destination manager_generated.go -package connectors . Manager
 type Manager interface {
 	Load(models.Connector, bool, bool) (string, json.RawMessage, error)
+	// Validate strictly validates a connector config the same way Load does,
+	// without loading the resulting plugin in the manager.
+	Validate(models.Connector) (string, json.RawMessage, error)
 	Unload(models.ConnectorID)
 	GetConfig(models.ConnectorID) (models.Config, error)
 	Get(models.ConnectorID) (models.Plugin, error)
@@ -89,15 +92,39 @@ func (m *manager) Load(connectorModel models.Connector, updateExisting bool, str
 		return config.Name, m.connectors[connectorModel.ID.String()].validatedConfigJson, nil
 	}
 
+	c, err := m.build(connectorModel, config, strictValidation)
+	if err != nil {
+		return "", nil, err
+	}
+
+	m.connectors[connectorModel.ID.String()] = c
+	return config.Name, c.validatedConfigJson, nil
+}
+
+func (m *manager) Validate(connectorModel models.Connector) (configName string, validatedConfigJson json.RawMessage, err error) {
+	config := m.configurer.DefaultConfig()
+	if err := json.Unmarshal(connectorModel.Config, &config); err != nil {
+		return "", nil, fmt.Errorf("%w: %w", models.ErrInvalidConfig, err)
+	}
+
+	c, err := m.build(connectorModel, config, true)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return config.Name, c.validatedConfigJson, nil
+}
+
+func (m *manager) build(connectorModel models.Connector, config models.Config, strictValidation bool) (connector, error) {
 	if err := m.configurer.Validate(config); err != nil {
 		if !errors.Is(err, ErrPollingPeriod) {
-			return "", nil, err
+			return connector{}, err
 		}
 
 		// strict validation takes place on install/update but not when launching a new instance of the app
 		// which is only loading a presumably already validated value from the DB
 		if strictValidation {
-			return "", nil, fmt.Errorf("%w: %w", models.ErrInvalidConfig, err)
+			return connector{}, fmt.Errorf("%w: %w", models.ErrInvalidConfig, err)
 		}
 		// if the polling period is lower that the current system default we should still load the plugin
 		// since creating validation errors will not change the schedule in temporal
@@ -112,25 +139,23 @@ func (m *manager) Load(connectorModel models.Connector, updateExisting bool, str
 		// client error, not a server error: surface it as a 400 rather than a 500.
 		errors.Is(err, registry.ErrPluginNotFound),
 		errors.Is(err, registry.ErrPluginEnterpriseOnly):
-		return "", nil, fmt.Errorf("%w: %w", err, ErrValidation)
+		return connector{}, fmt.Errorf("%w: %w", err, ErrValidation)
 	case err != nil:
-		return "", nil, err
+		return connector{}, err
 	}
 
 	b, err := combineConfigs(config, plugin.Config())
 	if err != nil {
-		return "", nil, fmt.Errorf("failed to combine configs: %w", err)
+		return connector{}, fmt.Errorf("failed to combine configs: %w", err)
 	}
 
 	plugin.ScheduleForDeletion(connectorModel.ScheduledForDeletion)
 
-	validatedConfigJson = json.RawMessage(b)
-	m.connectors[connectorModel.ID.String()] = connector{
+	return connector{
 		plugin:              plugin,
 		config:              config,
-		validatedConfigJson: validatedConfigJson,
-	}
-	return config.Name, validatedConfigJson, nil
+		validatedConfigJson: json.RawMessage(b),
+	}, nil
 }
 
 func (m *manager) Unload(connectorID models.ConnectorID) {
@@ -112,3 +112,19 @@ func (mr *MockManagerMockRecorder) Unload(arg0 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unload", reflect.TypeOf((*MockManager)(nil).Unload), arg0)
 }
+
+// Validate mocks base method.
+func (m *MockManager) Validate(arg0 models.Connector) (string, json.RawMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validate", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(json.RawMessage)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Validate indicates an expected call of Validate.
+func (mr *MockManagerMockRecorder) Validate(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockManager)(nil).Validate), arg0)
+}
@@ -190,6 +190,49 @@ func TestManager_Load_MalformedConfig(t *testing.T) {
 	assert.ErrorIs(t, err, models.ErrInvalidConfig)
 }
 
+func TestManager_Validate(t *testing.T) {
+	t.Parallel()
+
+	minimumPollingPeriod := time.Second
+	logger := logging.NewDefaultLogger(io.Discard, false, false, false)
+	manager := NewManager(logger, false, time.Minute, minimumPollingPeriod)
+
+	connectorID := models.ConnectorID{Reference: uuid.New(), Provider: registry.DummyPSPName}
+	connector := models.Connector{
+		ConnectorBase: models.ConnectorBase{
+			ID:       connectorID,
+			Provider: registry.DummyPSPName,
+		},
+		Config: json.RawMessage(`{"name":"validate","directory":"/tmp","pollingPeriod":"2m"}`),
+	}
+
+	t.Run("validates without loading the plugin", func(t *testing.T) {
+		name, validatedConfig, err := manager.Validate(connector)
+		require.NoError(t, err)
+		assert.Equal(t, "validate", name)
+		assert.Contains(t, string(validatedConfig), `"pollingPeriod":"2m0s"`)
+
+		_, err = manager.Get(connectorID)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("polling period is always strictly validated", func(t *testing.T) {
+		c := connector
+		c.Config = json.RawMessage(`{"name":"validate","directory":"/tmp","pollingPeriod":"1ms"}`)
+		_, _, err := manager.Validate(c)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, models.ErrInvalidConfig)
+	})
+
+	t.Run("malformed config", func(t *testing.T) {
+		c := connector
+		c.Config = json.RawMessage(`{bad-json`)
+		_, _, err := manager.Validate(c)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, models.ErrInvalidConfig)
+	})
+}
+
 func TestManager_Unload(t *testing.T) {
 	t.Parallel()
 
@@ -16,6 +16,9 @@ type Parameter struct {
 	DataType     Type   `json:"dataType"`
 	Required     bool   `json:"required"`
 	DefaultValue string `json:"defaultValue"`
+	// Sensitive parameters hold secrets, plugins flag them with the
+	// `sensitive:"true"` struct tag.
+	Sensitive bool `json:"sensitive,omitempty"`
 }
 
 var defaultParameters = map[string]Parameter{
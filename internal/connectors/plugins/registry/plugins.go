@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	logging "github.com/formancehq/go-libs/v5/pkg/observe/log"
+	"github.com/formancehq/payments/pkg/domain/bankvalidation"
 	"github.com/formancehq/payments/pkg/domain/models"
 	pkgplugins "github.com/formancehq/payments/pkg/domain/plugins"
 )
@@ -31,6 +32,14 @@ var (
 var pluginsRegistry map[string]pkgplugins.Registration
 
 func load(registrations map[string]pkgplugins.Registration) {
+	for provider, registration := range registrations {
+		if registration.RateBudget == nil {
+			continue
+		}
+		if err := registration.RateBudget.Validate(); err != nil {
+			log.Panicf("invalid rate budget for plugin %q: %v", provider, err)
+		}
+	}
 	pluginsRegistry = registrations
 }
 
@@ -56,6 +65,22 @@ func RegisterPlugin(
 	}
 }
 
+// RegisterRateBudget sets the rate budget of an already registered plugin.
+// Used in tests, plugins declare theirs in their Registration.
+func RegisterRateBudget(provider string, budget *models.RateBudget) {
+	info, ok := pluginsRegistry[provider]
+	if !ok {
+		log.Panicf("cannot register rate budget of unknown plugin %q", provider)
+	}
+	if budget != nil {
+		if err := budget.Validate(); err != nil {
+			log.Panicf("invalid rate budget for plugin %q: %v", provider, err)
+		}
+	}
+	info.RateBudget = budget
+	pluginsRegistry[provider] = info
+}
+
 func setupConfig(conf any) Config {
 	config := make(Config)
 	for paramName, param := range defaultParameters {
@@ -106,8 +131,9 @@ func setupConfig(conf any) Config {
 		}
 
 		config[fieldName] = Parameter{
-			DataType: dataType,
-			Required: checkRequired.MatchString(validatorTag),
+			DataType:  dataType,
+			Required:  checkRequired.MatchString(validatorTag),
+			Sensitive: field.Tag.Get("sensitive") == "true",
 		}
 	}
 	return config
@@ -195,3 +221,29 @@ func GetPageSize(provider string) (uint64, error) {
 	}
 	return info.PageSize, nil
 }
+
+// GetRoutingCodeMetadataKeys returns the routing code metadata keys of all
+// the plugins, sorted by key.
+func GetRoutingCodeMetadataKeys() []bankvalidation.RoutingCodeMetadataKey {
+	keys := make([]bankvalidation.RoutingCodeMetadataKey, 0)
+	for _, info := range pluginsRegistry {
+		keys = append(keys, info.RoutingCodeMetadataKeys...)
+	}
+	slices.SortFunc(keys, func(a, b bankvalidation.RoutingCodeMetadataKey) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+	return slices.CompactFunc(keys, func(a, b bankvalidation.RoutingCodeMetadataKey) bool {
+		return a == b
+	})
+}
+
+// GetRateBudget returns the rate budget of a provider, nil meaning its calls
+// are not throttled.
+func GetRateBudget(provider string) (*models.RateBudget, error) {
+	provider = strings.ToLower(provider)
+	info, ok := pluginsRegistry[provider]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", provider, ErrPluginNotFound)
+	}
+	return info.RateBudget, nil
+}
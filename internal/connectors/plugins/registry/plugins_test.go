@@ -27,6 +27,7 @@ var _ = Describe("Register Plugin", func() {
 		RequiredDuration time.Duration `json:"requiredDuration" validate:"required"`
 		OptionalDuration time.Duration `json:"optionalDuration" validate:""`
 		WithJsonMetadata string        `json:"withJsonMetadata,omitempty" validate:""`
+		SensitiveString  string        `json:"sensitiveString" validate:"required" sensitive:"true"`
 
 		NilJsonTag      UnhandledType `json:"-"`
 		unexportedField UnhandledType //nolint:unused
@@ -119,6 +120,15 @@ var _ = Describe("Register Plugin", func() {
 			Expect(c["withJsonMetadata"].DefaultValue).To(Equal(""))
 		})
 
+		It("flags sensitive parameters", func(ctx SpecContext) {
+			configs := GetConfigs(false)
+			c, ok := configs[name]
+			Expect(ok).To(BeTrue())
+			Expect(c["sensitiveString"].DataType).To(Equal(TypeString))
+			Expect(c["sensitiveString"].Sensitive).To(BeTrue())
+			Expect(c["requiredString"].Sensitive).To(BeFalse())
+		})
+
 		It("hides dummypay when not in debug mode", func(ctx SpecContext) {
 			configs := GetConfigs(false)
 			_, ok := configs[DummyPSPName]
@@ -153,4 +163,33 @@ var _ = Describe("Register Plugin", func() {
 			Expect(caps).To(HaveKey(DummyPSPName))
 		})
 	})
+
+	Context("rate budgets", func() {
+		provider := "rate-budget-plugin"
+		RegisterPlugin(provider, models.PluginTypePSP, fn, capabilities, conf, 25)
+
+		It("has no rate budget by default", func(ctx SpecContext) {
+			budget, err := GetRateBudget(provider)
+			Expect(err).To(BeNil())
+			Expect(budget).To(BeNil())
+		})
+
+		It("returns the registered rate budget", func(ctx SpecContext) {
+			RegisterRateBudget(provider, &models.RateBudget{CallsPerSecond: 5, Burst: 10})
+			DeferCleanup(func() { RegisterRateBudget(provider, nil) })
+
+			budget, err := GetRateBudget(provider)
+			Expect(err).To(BeNil())
+			Expect(budget).To(Equal(&models.RateBudget{CallsPerSecond: 5, Burst: 10}))
+		})
+
+		It("rejects invalid rate budgets", func(ctx SpecContext) {
+			Expect(func() { RegisterRateBudget(provider, &models.RateBudget{}) }).To(Panic())
+		})
+
+		It("fails for unknown plugins", func(ctx SpecContext) {
+			_, err := GetRateBudget("unknown")
+			Expect(err).To(MatchError(ErrPluginNotFound))
+		})
+	})
 })
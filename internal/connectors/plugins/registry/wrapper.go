@@ -2,8 +2,10 @@ package registry
 
 import (
 	"context"
+	"errors"
 
 	"github.com/formancehq/go-libs/v5/pkg/observe/log"
+	"github.com/formancehq/payments/internal/connectors/plugins"
 	"github.com/formancehq/payments/pkg/domain/models"
 	"github.com/formancehq/payments/internal/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -75,6 +77,39 @@ func (i *impl) Uninstall(ctx context.Context, req models.UninstallRequest) (mode
 	return resp, nil
 }
 
+func (i *impl) TestConnection(ctx context.Context, req models.TestConnectionRequest) (models.TestConnectionResponse, error) {
+	ctx, span := otel.StartSpan(ctx, "plugin.TestConnection", attribute.String("psp", i.connectorID.Provider), attribute.String("connector_id", i.connectorID.String()))
+	defer span.End()
+
+	i.logger.WithField("psp", i.connectorID.Provider).WithField("name", i.plugin.Name()).Info("testing connection...")
+
+	resp, err := i.plugin.TestConnection(ctx, req)
+	if errors.Is(err, plugins.ErrNotImplemented) && !i.fetchesAccountsFromParent() {
+		// Plugins without a dedicated check are probed with the smallest
+		// possible accounts page instead.
+		i.logger.WithField("psp", i.connectorID.Provider).WithField("name", i.plugin.Name()).Info("no connection check, fetching a single account...")
+		_, err = i.plugin.FetchNextAccounts(ctx, models.FetchNextAccountsRequest{PageSize: 1})
+	}
+	if err != nil {
+		i.logger.WithField("psp", i.connectorID.Provider).WithField("name", i.plugin.Name()).Error("testing connection failed:", err)
+		otel.RecordError(span, err)
+		return models.TestConnectionResponse{}, translateError(err)
+	}
+
+	i.logger.WithField("psp", i.connectorID.Provider).WithField("name", i.plugin.Name()).Info("connection tested!")
+
+	return resp, nil
+}
+
+// fetchesAccountsFromParent reports whether the plugin only fetches accounts
+// on behalf of a payment service user or one of their connections. Such
+// accounts cannot be listed without that parent payload, so they are no way to
+// probe the connection.
+func (i *impl) fetchesAccountsFromParent() bool {
+	pluginType, err := GetPluginType(i.connectorID.Provider)
+	return err == nil && pluginType != models.PluginTypePSP
+}
+
 func (i *impl) FetchNextAccounts(ctx context.Context, req models.FetchNextAccountsRequest) (models.FetchNextAccountsResponse, error) {
 	ctx, span := otel.StartSpan(ctx, "plugin.FetchNextAccounts", attribute.String("psp", i.connectorID.Provider), attribute.String("connector_id", i.connectorID.String()))
 	defer span.End()
@@ -201,6 +236,24 @@ func (i *impl) FetchNextConversions(ctx context.Context, req models.FetchNextCon
 	return resp, nil
 }
 
+func (i *impl) FetchNextDisputes(ctx context.Context, req models.FetchNextDisputesRequest) (models.FetchNextDisputesResponse, error) {
+	ctx, span := otel.StartSpan(ctx, "plugin.FetchNextDisputes", attribute.String("psp", i.connectorID.Provider), attribute.String("connector_id", i.connectorID.String()))
+	defer span.End()
+
+	i.logger.WithField("psp", i.connectorID.Provider).WithField("name", i.plugin.Name()).Info("fetching next disputes...")
+
+	resp, err := i.plugin.FetchNextDisputes(ctx, req)
+	if err != nil {
+		i.logger.WithField("psp", i.connectorID.Provider).WithField("name", i.plugin.Name()).Error("fetching next disputes failed:", err)
+		otel.RecordError(span, err)
+		return models.FetchNextDisputesResponse{}, translateError(err)
+	}
+
+	i.logger.WithField("psp", i.connectorID.Provider).WithField("name", i.plugin.Name()).Info("fetched next disputes succeeded!")
+
+	return resp, nil
+}
+
 func (i *impl) CreateBankAccount(ctx context.Context, req models.CreateBankAccountRequest) (models.CreateBankAccountResponse, error) {
 	ctx, span := otel.StartSpan(ctx, "plugin.CreateBankAccount", attribute.String("psp", i.connectorID.Provider), attribute.String("bankAccount.id", req.BankAccount.ID.String()))
 	defer span.End()
@@ -525,6 +578,24 @@ func (i *impl) DeleteUser(ctx context.Context, req models.DeleteUserRequest) (mo
 	return resp, nil
 }
 
+func (i *impl) CreateUserPaymentLink(ctx context.Context, req models.CreateUserPaymentLinkRequest) (models.CreateUserPaymentLinkResponse, error) {
+	ctx, span := otel.StartSpan(ctx, "plugin.CreateUserPaymentLink", attribute.String("psp", i.plugin.Name()))
+	defer span.End()
+
+	i.logger.WithField("name", i.plugin.Name()).Info("creating user payment link...")
+
+	resp, err := i.plugin.CreateUserPaymentLink(ctx, req)
+	if err != nil {
+		i.logger.WithField("name", i.plugin.Name()).Error("creating user payment link failed:", err)
+		otel.RecordError(span, err)
+		return models.CreateUserPaymentLinkResponse{}, translateError(err)
+	}
+
+	i.logger.WithField("name", i.plugin.Name()).Info("created user payment link succeeded!")
+
+	return resp, nil
+}
+
 var _ models.Plugin = &impl{}
 
 // BootstrapOnInstall forwards to the wrapped plugin if it opts in via
@@ -547,3 +618,57 @@ func (i *impl) PayoutsPerSecond() float64 {
 	}
 	return 0
 }
+
+// VerifyBankAccount forwards to the wrapped plugin if it opts in via
+// models.PluginWithBankAccountVerification; otherwise it returns
+// plugins.ErrNotImplemented.
+func (i *impl) VerifyBankAccount(ctx context.Context, req models.VerifyBankAccountRequest) (models.VerifyBankAccountResponse, error) {
+	ctx, span := otel.StartSpan(ctx, "plugin.VerifyBankAccount", attribute.String("psp", i.connectorID.Provider), attribute.String("bankAccount.id", req.BankAccount.ID.String()))
+	defer span.End()
+
+	p, ok := i.plugin.(models.PluginWithBankAccountVerification)
+	if !ok {
+		otel.RecordError(span, plugins.ErrNotImplemented)
+		return models.VerifyBankAccountResponse{}, plugins.ErrNotImplemented
+	}
+
+	i.logger.WithField("psp", i.connectorID.Provider).WithField("name", i.plugin.Name()).Info("verifying bank account...")
+
+	resp, err := p.VerifyBankAccount(ctx, req)
+	if err != nil {
+		i.logger.WithField("psp", i.connectorID.Provider).WithField("name", i.plugin.Name()).Error("verifying bank account failed:", err)
+		otel.RecordError(span, err)
+		return models.VerifyBankAccountResponse{}, translateError(err)
+	}
+
+	i.logger.WithField("psp", i.connectorID.Provider).WithField("name", i.plugin.Name()).Info("verified bank account succeeded!")
+
+	return resp, nil
+}
+
+// CreateAccount forwards to the wrapped plugin if it opts in via
+// models.PluginWithAccountCreation; otherwise it returns
+// plugins.ErrNotImplemented.
+func (i *impl) CreateAccount(ctx context.Context, req models.CreateAccountRequest) (models.CreateAccountResponse, error) {
+	ctx, span := otel.StartSpan(ctx, "plugin.CreateAccount", attribute.String("psp", i.connectorID.Provider), attribute.String("account.reference", req.Reference))
+	defer span.End()
+
+	p, ok := i.plugin.(models.PluginWithAccountCreation)
+	if !ok {
+		otel.RecordError(span, plugins.ErrNotImplemented)
+		return models.CreateAccountResponse{}, plugins.ErrNotImplemented
+	}
+
+	i.logger.WithField("psp", i.connectorID.Provider).WithField("name", i.plugin.Name()).Info("creating account...")
+
+	resp, err := p.CreateAccount(ctx, req)
+	if err != nil {
+		i.logger.WithField("psp", i.connectorID.Provider).WithField("name", i.plugin.Name()).Error("creating account failed:", err)
+		otel.RecordError(span, err)
+		return models.CreateAccountResponse{}, translateError(err)
+	}
+
+	i.logger.WithField("psp", i.connectorID.Provider).WithField("name", i.plugin.Name()).Info("created account succeeded!")
+
+	return resp, nil
+}
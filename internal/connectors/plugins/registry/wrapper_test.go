@@ -1,6 +1,7 @@
 package registry
 
 import (
+	"encoding/json"
 	"errors"
 
 	"github.com/formancehq/go-libs/v5/pkg/observe/log"
@@ -53,6 +54,41 @@ var _ = Describe("Wrapper", func() {
 		})
 	})
 
+	Context("test connection", func() {
+		It("calls underlying function", func(ctx SpecContext) {
+			wrapper := New(connectorID, logger, plg)
+			req := models.TestConnectionRequest{}
+			plg.EXPECT().Name().Return("dummy").MaxTimes(2)
+			plg.EXPECT().TestConnection(gomock.Any(), req).Return(models.TestConnectionResponse{}, nil)
+			_, err := wrapper.TestConnection(ctx, req)
+			Expect(err).To(BeNil())
+		})
+
+		It("falls back to fetching a single account", func(ctx SpecContext) {
+			wrapper := New(connectorID, logger, plg)
+			req := models.TestConnectionRequest{}
+			plg.EXPECT().Name().Return("dummy").MaxTimes(3)
+			plg.EXPECT().TestConnection(gomock.Any(), req).Return(models.TestConnectionResponse{}, plugins.ErrNotImplemented)
+			plg.EXPECT().FetchNextAccounts(gomock.Any(), models.FetchNextAccountsRequest{PageSize: 1}).Return(models.FetchNextAccountsResponse{}, nil)
+			_, err := wrapper.TestConnection(ctx, req)
+			Expect(err).To(BeNil())
+		})
+
+		It("does not fall back to fetching accounts for open banking plugins", func(ctx SpecContext) {
+			provider := "open-banking-" + uuid.NewString()
+			RegisterPlugin(provider, models.PluginTypeOpenBanking, func(models.ConnectorID, string, logging.Logger, json.RawMessage) (models.Plugin, error) {
+				return plg, nil
+			}, []models.Capability{models.CAPABILITY_FETCH_ACCOUNTS}, struct{}{}, 25)
+
+			wrapper := New(models.ConnectorID{Reference: uuid.New(), Provider: provider}, logger, plg)
+			req := models.TestConnectionRequest{}
+			plg.EXPECT().Name().Return("dummy").MaxTimes(2)
+			plg.EXPECT().TestConnection(gomock.Any(), req).Return(models.TestConnectionResponse{}, plugins.ErrNotImplemented)
+			_, err := wrapper.TestConnection(ctx, req)
+			Expect(err).To(MatchError(plugins.ErrNotImplemented))
+		})
+	})
+
 	Context("fetch next accounts", func() {
 		It("calls underlying function", func(ctx SpecContext) {
 			wrapper := New(connectorID, logger, plg)
@@ -119,6 +155,54 @@ var _ = Describe("Wrapper", func() {
 		})
 	})
 
+	Context("verify bank account", func() {
+		It("calls underlying function when the plugin supports it", func(ctx SpecContext) {
+			verifier := models.NewMockPluginWithBankAccountVerification(ctrl)
+			wrapper := New(connectorID, logger, struct {
+				*models.MockPlugin
+				*models.MockPluginWithBankAccountVerification
+			}{plg, verifier})
+			req := models.VerifyBankAccountRequest{}
+			plg.EXPECT().Name().Return("dummy").MaxTimes(2)
+			verifier.EXPECT().VerifyBankAccount(gomock.Any(), req).Return(models.VerifyBankAccountResponse{
+				Result: models.BANK_ACCOUNT_VERIFICATION_RESULT_MATCH,
+			}, nil)
+			res, err := wrapper.VerifyBankAccount(ctx, req)
+			Expect(err).To(BeNil())
+			Expect(res.Result).To(Equal(models.BANK_ACCOUNT_VERIFICATION_RESULT_MATCH))
+		})
+
+		It("returns not implemented when the plugin does not support it", func(ctx SpecContext) {
+			wrapper := New(connectorID, logger, plg)
+			_, err := wrapper.VerifyBankAccount(ctx, models.VerifyBankAccountRequest{})
+			Expect(err).To(MatchError(plugins.ErrNotImplemented))
+		})
+	})
+
+	Context("create account", func() {
+		It("calls underlying function when the plugin supports it", func(ctx SpecContext) {
+			creator := models.NewMockPluginWithAccountCreation(ctrl)
+			wrapper := New(connectorID, logger, struct {
+				*models.MockPlugin
+				*models.MockPluginWithAccountCreation
+			}{plg, creator})
+			req := models.CreateAccountRequest{Reference: "ref"}
+			plg.EXPECT().Name().Return("dummy").MaxTimes(2)
+			creator.EXPECT().CreateAccount(gomock.Any(), req).Return(models.CreateAccountResponse{
+				Account: models.PSPAccount{Reference: "acc"},
+			}, nil)
+			res, err := wrapper.CreateAccount(ctx, req)
+			Expect(err).To(BeNil())
+			Expect(res.Account.Reference).To(Equal("acc"))
+		})
+
+		It("returns not implemented when the plugin does not support it", func(ctx SpecContext) {
+			wrapper := New(connectorID, logger, plg)
+			_, err := wrapper.CreateAccount(ctx, models.CreateAccountRequest{})
+			Expect(err).To(MatchError(plugins.ErrNotImplemented))
+		})
+	})
+
 	Context("create transfer", func() {
 		It("calls underlying function", func(ctx SpecContext) {
 			wrapper := New(connectorID, logger, plg)
@@ -272,6 +356,25 @@ var _ = Describe("Wrapper", func() {
 		})
 	})
 
+	Context("fetch next disputes", func() {
+		It("calls underlying function", func(ctx SpecContext) {
+			wrapper := New(connectorID, logger, plg)
+			req := models.FetchNextDisputesRequest{}
+			plg.EXPECT().Name().Return("dummy").MaxTimes(2)
+			plg.EXPECT().FetchNextDisputes(gomock.Any(), req).Return(models.FetchNextDisputesResponse{}, nil)
+			_, err := wrapper.FetchNextDisputes(ctx, req)
+			Expect(err).To(BeNil())
+		})
+
+		It("translates plugin errors", func(ctx SpecContext) {
+			wrapper := New(connectorID, logger, plg)
+			plg.EXPECT().Name().Return("dummy").MaxTimes(2)
+			plg.EXPECT().FetchNextDisputes(gomock.Any(), gomock.Any()).Return(models.FetchNextDisputesResponse{}, plugins.ErrNotImplemented)
+			_, err := wrapper.FetchNextDisputes(ctx, models.FetchNextDisputesRequest{})
+			Expect(errors.Is(err, plugins.ErrNotImplemented)).To(BeTrue())
+		})
+	})
+
 	Context("poll payout status", func() {
 		It("calls underlying function", func(ctx SpecContext) {
 			wrapper := New(connectorID, logger, plg)
@@ -357,6 +460,15 @@ var _ = Describe("Wrapper", func() {
 			_, err := wrapper.DeleteUser(ctx, req)
 			Expect(err).To(BeNil())
 		})
+
+		It("CreateUserPaymentLink forwards", func(ctx SpecContext) {
+			wrapper := New(connectorID, logger, plg)
+			req := models.CreateUserPaymentLinkRequest{}
+			plg.EXPECT().Name().Return("dummy").AnyTimes()
+			plg.EXPECT().CreateUserPaymentLink(gomock.Any(), req).Return(models.CreateUserPaymentLinkResponse{}, nil)
+			_, err := wrapper.CreateUserPaymentLink(ctx, req)
+			Expect(err).To(BeNil())
+		})
 	})
 
 	Context("error translation", func() {
@@ -14,6 +14,19 @@ type ConnectorMessagePayload struct {
 	ConnectorID string    `json:"connectorID"`
 }
 
+type ConnectorCredentialsRotatedMessagePayload struct {
+	ConnectorID string    `json:"connectorID"`
+	Fields      []string  `json:"fields"`
+	RotatedAt   time.Time `json:"rotatedAt"`
+}
+
+type ConnectorHealthChangedMessagePayload struct {
+	ConnectorID    string    `json:"connectorID"`
+	PreviousStatus string    `json:"previousStatus"`
+	Status         string    `json:"status"`
+	ChangedAt      time.Time `json:"changedAt"`
+}
+
 func (e Events) NewEventResetConnector(connectorID models.ConnectorID, at time.Time) publish.EventMessage {
 	return publish.EventMessage{
 		IdempotencyKey: resetConnectorIdempotencyKey(connectorID, at),
@@ -31,3 +44,34 @@ func (e Events) NewEventResetConnector(connectorID models.ConnectorID, at time.T
 func resetConnectorIdempotencyKey(connectorID models.ConnectorID, at time.Time) string {
 	return fmt.Sprintf("%s-%s", connectorID.String(), at.Format(time.RFC3339Nano))
 }
+
+func (e Events) NewEventConnectorCredentialsRotated(rotation models.ConnectorCredentialsRotation) publish.EventMessage {
+	return publish.EventMessage{
+		IdempotencyKey: rotation.IdempotencyKey(),
+		Date:           time.Now().UTC(),
+		App:            events.EventApp,
+		Version:        events.EventVersion,
+		Type:           events.EventTypeConnectorCredentialsRotated,
+		Payload: ConnectorCredentialsRotatedMessagePayload{
+			ConnectorID: rotation.ConnectorID.String(),
+			Fields:      rotation.Fields,
+			RotatedAt:   rotation.RotatedAt,
+		},
+	}
+}
+
+func (e Events) NewEventConnectorHealthChanged(change models.ConnectorHealthChange) publish.EventMessage {
+	return publish.EventMessage{
+		IdempotencyKey: change.IdempotencyKey(),
+		Date:           time.Now().UTC(),
+		App:            events.EventApp,
+		Version:        events.EventVersion,
+		Type:           events.EventTypeConnectorHealthChanged,
+		Payload: ConnectorHealthChangedMessagePayload{
+			ConnectorID:    change.ConnectorID.String(),
+			PreviousStatus: string(change.PreviousStatus),
+			Status:         string(change.Status),
+			ChangedAt:      change.ChangedAt,
+		},
+	}
+}
@@ -0,0 +1,89 @@
+package events
+
+import (
+	"encoding/json"
+	"math/big"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/messaging/publish"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/formancehq/payments/pkg/events"
+)
+
+type DisputeMessagePayload struct {
+	ID              string            `json:"id"`
+	ConnectorID     string            `json:"connectorID"`
+	Provider        string            `json:"provider"`
+	Reference       string            `json:"reference"`
+	CreatedAt       time.Time         `json:"createdAt"`
+	UpdatedAt       time.Time         `json:"updatedAt"`
+	PaymentID       string            `json:"paymentID,omitempty"`
+	Amount          *big.Int          `json:"amount"`
+	Asset           string            `json:"asset"`
+	Status          string            `json:"status"`
+	Reason          string            `json:"reason,omitempty"`
+	EvidenceDueDate *time.Time        `json:"evidenceDueDate,omitempty"`
+	Metadata        map[string]string `json:"metadata,omitempty"`
+	Raw             json.RawMessage   `json:"raw"`
+}
+
+func (p *DisputeMessagePayload) MarshalJSON() ([]byte, error) {
+	type Alias DisputeMessagePayload
+	return json.Marshal(&struct {
+		Amount *string `json:"amount"`
+		*Alias
+	}{
+		Amount: bigIntToString(p.Amount),
+		Alias:  (*Alias)(p),
+	})
+}
+
+func (p *DisputeMessagePayload) UnmarshalJSON(data []byte) error {
+	type Alias DisputeMessagePayload
+	aux := &struct {
+		Amount *string `json:"amount"`
+		*Alias
+	}{
+		Alias: (*Alias)(p),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var err error
+	p.Amount, err = bigIntFromString(aux.Amount, "amount")
+	return err
+}
+
+func (e Events) NewEventSavedDispute(dispute models.Dispute) publish.EventMessage {
+	payload := DisputeMessagePayload{
+		ID:          dispute.ID.String(),
+		ConnectorID: dispute.ConnectorID.String(),
+		Provider:    models.ToV3Provider(dispute.ConnectorID.Provider),
+		Reference:   dispute.Reference,
+		CreatedAt:   dispute.CreatedAt,
+		UpdatedAt:   dispute.UpdatedAt,
+		PaymentID: func() string {
+			if dispute.PaymentID == nil {
+				return ""
+			}
+			return dispute.PaymentID.String()
+		}(),
+		Amount:          dispute.Amount,
+		Asset:           dispute.Asset,
+		Status:          dispute.Status.String(),
+		Reason:          dispute.Reason,
+		EvidenceDueDate: dispute.EvidenceDueDate,
+		Metadata:        dispute.Metadata,
+		Raw:             dispute.Raw,
+	}
+
+	return publish.EventMessage{
+		IdempotencyKey: dispute.IdempotencyKey(),
+		Date:           time.Now().UTC(),
+		App:            events.EventApp,
+		Version:        events.EventVersion,
+		Type:           events.EventTypeSavedDispute,
+		Payload:        payload,
+	}
+}
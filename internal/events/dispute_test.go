@@ -0,0 +1,128 @@
+package events
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDisputeMessagePayload_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("amount as string", func(t *testing.T) {
+		t.Parallel()
+
+		payload := DisputeMessagePayload{
+			ID:     "dp-1",
+			Amount: big.NewInt(10000),
+			Asset:  "USD/2",
+			Status: "NEEDS_RESPONSE",
+		}
+
+		data, err := json.Marshal(&payload)
+		require.NoError(t, err)
+
+		var result map[string]interface{}
+		err = json.Unmarshal(data, &result)
+		require.NoError(t, err)
+
+		assert.Equal(t, "dp-1", result["id"])
+		assert.Equal(t, "10000", result["amount"])
+		assert.Equal(t, "NEEDS_RESPONSE", result["status"])
+		assert.Nil(t, result["evidenceDueDate"])
+		assert.Nil(t, result["paymentID"])
+	})
+
+	t.Run("round-trip", func(t *testing.T) {
+		t.Parallel()
+
+		due := time.Date(2026, 2, 20, 0, 0, 0, 0, time.UTC)
+		original := DisputeMessagePayload{
+			ID:              "dp-rt",
+			ConnectorID:     "conn-1",
+			Provider:        "stripe",
+			Reference:       "dp_123",
+			PaymentID:       "eyJwYXkxIn0",
+			Amount:          big.NewInt(4200),
+			Asset:           "EUR/2",
+			Status:          "UNDER_REVIEW",
+			Reason:          "fraudulent",
+			EvidenceDueDate: &due,
+			Metadata:        map[string]string{"key": "value"},
+			Raw:             json.RawMessage(`{"raw":"data"}`),
+		}
+
+		data, err := json.Marshal(&original)
+		require.NoError(t, err)
+
+		var restored DisputeMessagePayload
+		err = json.Unmarshal(data, &restored)
+		require.NoError(t, err)
+
+		assert.Equal(t, original.ID, restored.ID)
+		assert.Equal(t, original.PaymentID, restored.PaymentID)
+		assert.Equal(t, 0, original.Amount.Cmp(restored.Amount))
+		assert.Equal(t, original.Reason, restored.Reason)
+		assert.True(t, original.EvidenceDueDate.Equal(*restored.EvidenceDueDate))
+		assert.Equal(t, original.Metadata["key"], restored.Metadata["key"])
+	})
+
+	t.Run("invalid amount", func(t *testing.T) {
+		t.Parallel()
+
+		var restored DisputeMessagePayload
+		err := json.Unmarshal([]byte(`{"amount":"abc"}`), &restored)
+		require.Error(t, err)
+	})
+}
+
+func TestNewEventSavedDispute(t *testing.T) {
+	t.Parallel()
+
+	connID := models.ConnectorID{Provider: "test", Reference: uuid.MustParse("00000000-0000-0000-0000-000000000001")}
+	paymentID := models.PaymentID{
+		PaymentReference: models.PaymentReference{Reference: "txn_1", Type: models.PAYMENT_TYPE_PAYIN},
+		ConnectorID:      connID,
+	}
+
+	dispute := models.Dispute{
+		ID:          models.DisputeID{Reference: "dp-1", ConnectorID: connID},
+		ConnectorID: connID,
+		Reference:   "dp-1",
+		CreatedAt:   time.Date(2026, 2, 9, 15, 33, 0, 0, time.UTC),
+		UpdatedAt:   time.Date(2026, 2, 9, 15, 34, 0, 0, time.UTC),
+		PaymentID:   &paymentID,
+		Amount:      big.NewInt(10000),
+		Asset:       "USD/2",
+		Status:      models.DISPUTE_STATUS_NEEDS_RESPONSE,
+		Reason:      "fraudulent",
+		Metadata:    map[string]string{"key": "val"},
+		Raw:         json.RawMessage(`{"raw":"data"}`),
+	}
+
+	e := Events{}
+	msg := e.NewEventSavedDispute(dispute)
+
+	assert.Equal(t, "SAVED_DISPUTE", msg.Type)
+	assert.Equal(t, dispute.IdempotencyKey(), msg.IdempotencyKey)
+
+	payload, ok := msg.Payload.(DisputeMessagePayload)
+	require.True(t, ok)
+	assert.Equal(t, "dp-1", payload.Reference)
+	assert.Equal(t, paymentID.String(), payload.PaymentID)
+	assert.Equal(t, 0, payload.Amount.Cmp(big.NewInt(10000)))
+	assert.Equal(t, "NEEDS_RESPONSE", payload.Status)
+	assert.Equal(t, "fraudulent", payload.Reason)
+
+	dispute.PaymentID = nil
+	msg = e.NewEventSavedDispute(dispute)
+	payload, ok = msg.Payload.(DisputeMessagePayload)
+	require.True(t, ok)
+	assert.Empty(t, payload.PaymentID)
+}
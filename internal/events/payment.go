@@ -28,10 +28,63 @@ type PaymentMessagePayload struct {
 	Amount        *big.Int        `json:"amount"`
 
 	// Optional fields
-	SourceAccountID      string            `json:"sourceAccountID,omitempty"`
-	DestinationAccountID string            `json:"destinationAccountID,omitempty"`
-	Links                []api.Link        `json:"links,omitempty"`
-	Metadata             map[string]string `json:"metadata,omitempty"`
+	SourceAccountID      string                     `json:"sourceAccountID,omitempty"`
+	DestinationAccountID string                     `json:"destinationAccountID,omitempty"`
+	Links                []api.Link                 `json:"links,omitempty"`
+	Metadata             map[string]string          `json:"metadata,omitempty"`
+	Fees                 []PaymentFeeMessagePayload `json:"fees,omitempty"`
+}
+
+type PaymentFeeMessagePayload struct {
+	Reference string   `json:"reference"`
+	Type      string   `json:"type"`
+	Amount    *big.Int `json:"amount"`
+	Asset     string   `json:"asset"`
+}
+
+func (p *PaymentFeeMessagePayload) MarshalJSON() ([]byte, error) {
+	type Alias PaymentFeeMessagePayload
+	return json.Marshal(&struct {
+		Amount *string `json:"amount"`
+		*Alias
+	}{
+		Amount: bigIntToString(p.Amount),
+		Alias:  (*Alias)(p),
+	})
+}
+
+func (p *PaymentFeeMessagePayload) UnmarshalJSON(data []byte) error {
+	type Alias PaymentFeeMessagePayload
+	aux := &struct {
+		Amount *string `json:"amount"`
+		*Alias
+	}{
+		Alias: (*Alias)(p),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var err error
+	p.Amount, err = bigIntFromString(aux.Amount, "amount")
+	return err
+}
+
+func NewPaymentFeesMessagePayload(fees []models.PaymentFee) []PaymentFeeMessagePayload {
+	if len(fees) == 0 {
+		return nil
+	}
+
+	payload := make([]PaymentFeeMessagePayload, 0, len(fees))
+	for _, fee := range fees {
+		payload = append(payload, PaymentFeeMessagePayload{
+			Reference: fee.Reference,
+			Type:      fee.Type.String(),
+			Amount:    fee.Amount,
+			Asset:     fee.Asset,
+		})
+	}
+	return payload
 }
 
 func (p *PaymentMessagePayload) MarshalJSON() ([]byte, error) {
@@ -97,6 +150,7 @@ func (e Events) NewEventSavedPayments(payment models.Payment, adjustment models.
 		}(),
 		RawData:  adjustment.Raw,
 		Metadata: payment.Metadata,
+		Fees:     NewPaymentFeesMessagePayload(payment.Fees),
 	}
 
 	if payment.SourceAccountID != nil {
@@ -0,0 +1,57 @@
+package events
+
+import (
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/messaging/publish"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/formancehq/payments/pkg/events"
+	"github.com/google/uuid"
+)
+
+type PaymentCorrelationMessagePayload struct {
+	GroupID     string    `json:"groupID"`
+	PaymentID   string    `json:"paymentID"`
+	ConnectorID string    `json:"connectorID"`
+	Provider    string    `json:"provider"`
+	RuleID      string    `json:"ruleID"`
+	CreatedAt   time.Time `json:"createdAt"`
+
+	// Other payments of the group
+	LinkedPaymentIDs []string `json:"linkedPaymentIDs"`
+}
+
+// NewEventSavedPaymentCorrelation is sent every time a payment joins a
+// correlation group.
+func (e Events) NewEventSavedPaymentCorrelation(
+	paymentID models.PaymentID,
+	ruleID uuid.UUID,
+	createdAt time.Time,
+	correlation models.PaymentCorrelation,
+) publish.EventMessage {
+	payload := PaymentCorrelationMessagePayload{
+		GroupID:          correlation.GroupID.String(),
+		PaymentID:        paymentID.String(),
+		ConnectorID:      paymentID.ConnectorID.String(),
+		Provider:         models.ToV3Provider(paymentID.ConnectorID.Provider),
+		RuleID:           ruleID.String(),
+		CreatedAt:        createdAt,
+		LinkedPaymentIDs: make([]string, 0, len(correlation.Payments)),
+	}
+
+	for _, l := range correlation.Payments {
+		payload.LinkedPaymentIDs = append(payload.LinkedPaymentIDs, l.PaymentID.String())
+	}
+
+	return publish.EventMessage{
+		IdempotencyKey: models.IdempotencyKey(struct {
+			PaymentID models.PaymentID `json:"PaymentID"`
+			GroupID   uuid.UUID        `json:"GroupID"`
+		}{paymentID, correlation.GroupID}),
+		Date:    time.Now().UTC(),
+		App:     events.EventApp,
+		Version: events.EventVersion,
+		Type:    events.EventTypeSavedPaymentCorrelation,
+		Payload: payload,
+	}
+}
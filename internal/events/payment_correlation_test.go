@@ -0,0 +1,51 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEventSavedPaymentCorrelation(t *testing.T) {
+	t.Parallel()
+
+	paymentID := models.PaymentID{
+		PaymentReference: models.PaymentReference{Reference: "payin_1", Type: models.PAYMENT_TYPE_PAYIN},
+		ConnectorID:      models.ConnectorID{Provider: "bankingcircle", Reference: uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+	}
+	linkedID := models.PaymentID{
+		PaymentReference: models.PaymentReference{Reference: "payout_1", Type: models.PAYMENT_TYPE_PAYOUT},
+		ConnectorID:      models.ConnectorID{Provider: "wise", Reference: uuid.MustParse("00000000-0000-0000-0000-000000000002")},
+	}
+	ruleID := uuid.New()
+	correlation := models.PaymentCorrelation{
+		GroupID: uuid.New(),
+		Payments: []models.PaymentCorrelationLink{
+			{PaymentID: linkedID, RuleID: &ruleID},
+		},
+	}
+	createdAt := time.Date(2026, 2, 9, 15, 33, 0, 0, time.UTC)
+
+	e := Events{}
+	msg := e.NewEventSavedPaymentCorrelation(paymentID, ruleID, createdAt, correlation)
+
+	assert.Equal(t, "SAVED_PAYMENT_CORRELATION", msg.Type)
+	assert.NotEmpty(t, msg.IdempotencyKey)
+
+	payload, ok := msg.Payload.(PaymentCorrelationMessagePayload)
+	require.True(t, ok)
+	assert.Equal(t, correlation.GroupID.String(), payload.GroupID)
+	assert.Equal(t, paymentID.String(), payload.PaymentID)
+	assert.Equal(t, "bankingcircle", payload.Provider)
+	assert.Equal(t, ruleID.String(), payload.RuleID)
+	assert.Equal(t, []string{linkedID.String()}, payload.LinkedPaymentIDs)
+
+	// Joining another group is another event.
+	correlation.GroupID = uuid.New()
+	other := e.NewEventSavedPaymentCorrelation(paymentID, ruleID, createdAt, correlation)
+	assert.NotEqual(t, msg.IdempotencyKey, other.IdempotencyKey)
+}
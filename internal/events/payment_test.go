@@ -219,6 +219,9 @@ func TestPaymentMessagePayload_UnmarshalJSON(t *testing.T) {
 			Asset:         "USD/2",
 			InitialAmount: big.NewInt(1000000),
 			Amount:        big.NewInt(999999),
+			Fees: []PaymentFeeMessagePayload{
+				{Reference: "fee123", Type: "PROCESSING", Amount: big.NewInt(250), Asset: "USD/2"},
+			},
 		}
 
 		data, err := json.Marshal(&original)
@@ -231,6 +234,39 @@ func TestPaymentMessagePayload_UnmarshalJSON(t *testing.T) {
 		assert.Equal(t, original.ID, unmarshaled.ID)
 		assert.Equal(t, original.InitialAmount.String(), unmarshaled.InitialAmount.String())
 		assert.Equal(t, original.Amount.String(), unmarshaled.Amount.String())
+		require.Len(t, unmarshaled.Fees, 1)
+		assert.Equal(t, original.Fees[0].Reference, unmarshaled.Fees[0].Reference)
+		assert.Equal(t, original.Fees[0].Type, unmarshaled.Fees[0].Type)
+		assert.Equal(t, original.Fees[0].Amount.String(), unmarshaled.Fees[0].Amount.String())
+		assert.Equal(t, original.Fees[0].Asset, unmarshaled.Fees[0].Asset)
 	})
 }
 
+func TestPaymentFeeMessagePayload_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	payload := PaymentMessagePayload{
+		ID:     "pay123",
+		Amount: big.NewInt(1000),
+		Fees: []PaymentFeeMessagePayload{
+			{Reference: "fee123", Type: "NETWORK", Amount: big.NewInt(12345678901), Asset: "BTC/8"},
+		},
+	}
+
+	data, err := json.Marshal(&payload)
+	require.NoError(t, err)
+
+	var result map[string]interface{}
+	err = json.Unmarshal(data, &result)
+	require.NoError(t, err)
+
+	fees, ok := result["fees"].([]interface{})
+	require.True(t, ok)
+	require.Len(t, fees, 1)
+	assert.Equal(t, map[string]interface{}{
+		"reference": "fee123",
+		"type":      "NETWORK",
+		"amount":    "12345678901",
+		"asset":     "BTC/8",
+	}, fees[0])
+}
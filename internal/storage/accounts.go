@@ -229,7 +229,9 @@ func (s *store) AccountsDelete(ctx context.Context, id models.AccountID) error {
 	return e("failed to delete account", err)
 }
 
-type AccountQuery struct{}
+type AccountQuery struct {
+	Sort []SortKey `json:"sort,omitempty"`
+}
 
 type ListAccountsQuery paginate.OffsetPaginatedQuery[paginate.PaginatedQueryOptions[AccountQuery]]
 
@@ -241,28 +243,29 @@ func NewListAccountsQuery(opts paginate.PaginatedQueryOptions[AccountQuery]) Lis
 	}
 }
 
+var accountsSortColumns = map[string]string{
+	"created_at": "account.created_at",
+	"reference":  "account.reference",
+	"name":       "account.name",
+}
+
 func (s *store) accountsQueryContext(qb query.Builder) (string, []any, error) {
 	return qb.Build(query.ContextFn(func(key, operator string, value any) (string, []any, error) {
 		switch {
+		case key == "reference",
+			key == "name":
+			return matchWithComparisons(matchText)("account."+key, key, operator, value)
 		case key == "id",
-			key == "reference",
 			key == "connector_id",
 			key == "type",
 			key == "default_asset",
-			key == "name",
 			key == "psu_id",
 			key == "open_banking_connection_id":
-			return fmt.Sprintf("account.%s %s ?", key, query.DefaultComparisonOperatorsMapping[operator]), []any{value}, nil
+			return matchWithComparisons(matchEqual)("account."+key, key, operator, value)
+		case key == "created_at":
+			return matchDate("account.created_at", key, operator, value)
 		case metadataRegex.Match([]byte(key)):
-			if operator != "$match" {
-				return "", nil, fmt.Errorf("'%s' column can only be used with $match: %w", key, ErrValidation)
-			}
-			match := metadataRegex.FindAllStringSubmatch(key, 3)
-
-			key := "account.metadata"
-			return key + " @> ?", []any{map[string]any{
-				match[0][1]: value,
-			}}, nil
+			return matchMetadata("account.metadata", key, operator, value)
 		default:
 			return "", nil, fmt.Errorf("unknown key '%s' when building query: %w", key, ErrValidation)
 		}
@@ -282,6 +285,11 @@ func (s *store) AccountsList(ctx context.Context, q ListAccountsQuery) (*paginat
 		}
 	}
 
+	orderBy, err := sortOrder(q.Options.Options.Sort, accountsSortColumns, "account.created_at", "account.sort_id")
+	if err != nil {
+		return nil, err
+	}
+
 	cursor, err := paginateWithOffset[paginate.PaginatedQueryOptions[AccountQuery], account](s, ctx,
 		(*paginate.OffsetPaginatedQuery[paginate.PaginatedQueryOptions[AccountQuery]])(&q),
 		func(query *bun.SelectQuery) *bun.SelectQuery {
@@ -290,8 +298,7 @@ func (s *store) AccountsList(ctx context.Context, q ListAccountsQuery) (*paginat
 			}
 			query = query.Relation("Connector")
 
-			// TODO(polo): sorter ?
-			query = query.Order("account.created_at DESC", "account.sort_id DESC")
+			query = query.Order(orderBy...)
 
 			return query
 		},
@@ -512,6 +512,20 @@ func TestAccountsList(t *testing.T) {
 		require.False(t, cursor.HasMore)
 	})
 
+	t.Run("list accounts by reference greater than", func(t *testing.T) {
+		q := NewListAccountsQuery(
+			paginate.NewPaginatedQueryOptions(AccountQuery{}).
+				WithPageSize(15).
+				WithQueryBuilder(query.Gt("reference", "test1")),
+		)
+
+		cursor, err := store.AccountsList(ctx, q)
+		require.NoError(t, err)
+		require.Len(t, cursor.Data, 2)
+		require.False(t, cursor.HasMore)
+		require.ElementsMatch(t, []models.Account{defaultAccounts()[1], defaultAccounts()[2]}, cursor.Data)
+	})
+
 	t.Run("list accounts by connector id", func(t *testing.T) {
 		q := NewListAccountsQuery(
 			paginate.NewPaginatedQueryOptions(AccountQuery{}).
@@ -0,0 +1,78 @@
+package storage
+
+import (
+	"context"
+
+	internalTime "github.com/formancehq/go-libs/v5/pkg/types/time"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type bankAccountVerification struct {
+	bun.BaseModel `bun:"table:bank_account_verifications"`
+
+	// Autoincrement fields
+	SortID int64 `bun:"sort_id,autoincrement"`
+
+	// Mandatory fields
+	ID            uuid.UUID          `bun:"id,pk,type:uuid,notnull"`
+	BankAccountID uuid.UUID          `bun:"bank_account_id,type:uuid,notnull"`
+	ConnectorID   models.ConnectorID `bun:"connector_id,type:character varying,notnull"`
+	CreatedAt     internalTime.Time  `bun:"created_at,type:timestamp without time zone,notnull"`
+	Name          string             `bun:"name,type:text,notnull"`
+	Result        string             `bun:"result,type:text,notnull"`
+
+	// Optional fields
+	// c.f.: https://bun.uptrace.dev/guide/models.html#nulls
+	MatchedName *string `bun:"matched_name,type:text,nullzero"`
+}
+
+// bankAccountVerificationsOrder loads the verification history of a bank
+// account most recent first.
+func bankAccountVerificationsOrder(q *bun.SelectQuery) *bun.SelectQuery {
+	return q.Order("created_at DESC", "sort_id DESC")
+}
+
+func (s *store) BankAccountsAddVerification(ctx context.Context, verification models.BankAccountVerification) error {
+	toInsert := fromBankAccountVerificationModels(verification)
+
+	_, err := s.db.NewInsert().
+		Model(&toInsert).
+		Column("id", "bank_account_id", "connector_id", "created_at", "name", "result", "matched_name").
+		On("CONFLICT (id) DO NOTHING").
+		Exec(ctx)
+	if err != nil {
+		return e("add bank account verification", err)
+	}
+
+	return nil
+}
+
+func fromBankAccountVerificationModels(from models.BankAccountVerification) bankAccountVerification {
+	return bankAccountVerification{
+		ID:            from.ID,
+		BankAccountID: from.BankAccountID,
+		ConnectorID:   from.ConnectorID,
+		CreatedAt:     internalTime.New(from.CreatedAt),
+		Name:          from.Name,
+		Result:        from.Result.String(),
+		MatchedName:   from.MatchedName,
+	}
+}
+
+func toBankAccountVerificationModels(from bankAccountVerification) models.BankAccountVerification {
+	// The result is always written from a known value, an unknown one can
+	// only come from a manual change and is kept as UNKNOWN.
+	result, _ := models.BankAccountVerificationResultFromString(from.Result)
+
+	return models.BankAccountVerification{
+		ID:            from.ID,
+		BankAccountID: from.BankAccountID,
+		ConnectorID:   from.ConnectorID,
+		CreatedAt:     from.CreatedAt.Time,
+		Name:          from.Name,
+		Result:        result,
+		MatchedName:   from.MatchedName,
+	}
+}
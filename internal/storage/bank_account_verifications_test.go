@@ -0,0 +1,101 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/observe/log"
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBankAccountsAddVerification(t *testing.T) {
+	t.Parallel()
+
+	ctx := logging.TestingContext()
+	store := newStore(t)
+	defer store.Close()
+
+	upsertConnector(t, ctx, store, defaultConnector)
+	upsertAccounts(t, ctx, store, defaultAccounts())
+	upsertBankAccount(t, ctx, store, defaultBankAccount)
+	upsertBankAccount(t, ctx, store, defaultBankAccount2)
+
+	first := models.BankAccountVerification{
+		ID:            uuid.New(),
+		BankAccountID: defaultBankAccount2.ID,
+		ConnectorID:   defaultConnector.ID,
+		CreatedAt:     now.Add(-20 * time.Minute).UTC().Time,
+		Name:          defaultBankAccount2.Name,
+		Result:        models.BANK_ACCOUNT_VERIFICATION_RESULT_NO_MATCH,
+	}
+	second := models.BankAccountVerification{
+		ID:            uuid.New(),
+		BankAccountID: defaultBankAccount2.ID,
+		ConnectorID:   defaultConnector.ID,
+		CreatedAt:     now.Add(-10 * time.Minute).UTC().Time,
+		Name:          defaultBankAccount2.Name,
+		Result:        models.BANK_ACCOUNT_VERIFICATION_RESULT_CLOSE_MATCH,
+		MatchedName:   pointer.For("test 2"),
+	}
+
+	t.Run("add verifications", func(t *testing.T) {
+		require.NoError(t, store.BankAccountsAddVerification(ctx, first))
+		require.NoError(t, store.BankAccountsAddVerification(ctx, second))
+
+		actual, err := store.BankAccountsGet(ctx, defaultBankAccount2.ID, false)
+		require.NoError(t, err)
+		require.Equal(t, []models.BankAccountVerification{second, first}, actual.Verifications)
+	})
+
+	t.Run("add same verification twice", func(t *testing.T) {
+		require.NoError(t, store.BankAccountsAddVerification(ctx, second))
+
+		actual, err := store.BankAccountsGet(ctx, defaultBankAccount2.ID, false)
+		require.NoError(t, err)
+		require.Len(t, actual.Verifications, 2)
+	})
+
+	t.Run("unknown bank account", func(t *testing.T) {
+		v := first
+		v.ID = uuid.New()
+		v.BankAccountID = uuid.New()
+
+		err := store.BankAccountsAddVerification(ctx, v)
+		require.Error(t, err)
+		require.ErrorIs(t, err, ErrForeignKeyViolation)
+	})
+
+	t.Run("bank account without verification", func(t *testing.T) {
+		actual, err := store.BankAccountsGet(ctx, defaultBankAccount.ID, false)
+		require.NoError(t, err)
+		require.Empty(t, actual.Verifications)
+	})
+}
+
+func TestBankAccountsGetFromRelatedAccountID(t *testing.T) {
+	t.Parallel()
+
+	ctx := logging.TestingContext()
+	store := newStore(t)
+	defer store.Close()
+
+	upsertConnector(t, ctx, store, defaultConnector)
+	upsertAccounts(t, ctx, store, defaultAccounts())
+	upsertBankAccount(t, ctx, store, defaultBankAccount)
+	upsertBankAccount(t, ctx, store, defaultBankAccount2)
+
+	t.Run("get bank account from related account", func(t *testing.T) {
+		actual, err := store.BankAccountsGetFromRelatedAccountID(ctx, defaultAccounts()[0].ID)
+		require.NoError(t, err)
+		require.Equal(t, defaultBankAccount2.ID, actual.ID)
+		require.Len(t, actual.RelatedAccounts, 1)
+	})
+
+	t.Run("account without bank account", func(t *testing.T) {
+		_, err := store.BankAccountsGetFromRelatedAccountID(ctx, defaultAccounts()[1].ID)
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+}
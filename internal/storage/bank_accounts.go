@@ -42,6 +42,7 @@ type bankAccount struct {
 
 	PSU             *paymentServiceUser          `bun:"rel:belongs-to,join:psu_id=id,scanonly"`
 	RelatedAccounts []*bankAccountRelatedAccount `bun:"rel:has-many,join:id=bank_account_id,scanonly"`
+	Verifications   []*bankAccountVerification   `bun:"rel:has-many,join:id=bank_account_id,scanonly"`
 }
 
 func (s *store) BankAccountsUpsert(ctx context.Context, ba models.BankAccount) error {
@@ -236,7 +237,8 @@ func (s *store) BankAccountsGet(ctx context.Context, id uuid.UUID, expand bool)
 	query := s.db.NewSelect().
 		Model(&account).
 		Column("id", "created_at", "name", "country", "metadata", "psu_id").
-		Relation("RelatedAccounts")
+		Relation("RelatedAccounts").
+		Relation("Verifications", bankAccountVerificationsOrder)
 	if expand {
 		query = query.ColumnExpr("pgp_sym_decrypt(account_number, ?, ?) AS decrypted_account_number", s.configEncryptionKey, encryptionOptions).
 			ColumnExpr("pgp_sym_decrypt(iban, ?, ?) AS decrypted_iban", s.configEncryptionKey, encryptionOptions).
@@ -250,7 +252,32 @@ func (s *store) BankAccountsGet(ctx context.Context, id uuid.UUID, expand bool)
 	return pointer.For(toBankAccountModels(account)), nil
 }
 
-type BankAccountQuery struct{}
+// BankAccountsGetFromRelatedAccountID returns the bank account that was
+// forwarded to a connector and resulted in the given related account.
+func (s *store) BankAccountsGetFromRelatedAccountID(ctx context.Context, accountID models.AccountID) (*models.BankAccount, error) {
+	var account bankAccount
+	err := s.db.NewSelect().
+		Model(&account).
+		Column("id", "created_at", "name", "country", "metadata", "psu_id").
+		Relation("RelatedAccounts").
+		Relation("Verifications", bankAccountVerificationsOrder).
+		Where("id = (?)", s.db.NewSelect().
+			Model((*bankAccountRelatedAccount)(nil)).
+			Column("bank_account_id").
+			Where("account_id = ?", accountID).
+			Limit(1),
+		).
+		Scan(ctx)
+	if err != nil {
+		return nil, e("get bank account from related account", err)
+	}
+
+	return pointer.For(toBankAccountModels(account)), nil
+}
+
+type BankAccountQuery struct {
+	Sort []SortKey `json:"sort,omitempty"`
+}
 
 type ListBankAccountsQuery paginate.OffsetPaginatedQuery[paginate.PaginatedQueryOptions[BankAccountQuery]]
 
@@ -262,24 +289,22 @@ func NewListBankAccountsQuery(opts paginate.PaginatedQueryOptions[BankAccountQue
 	}
 }
 
+var bankAccountsSortColumns = map[string]string{
+	"created_at": "bank_account.created_at",
+	"name":       "bank_account.name",
+}
+
 func (s *store) bankAccountsQueryContext(qb query.Builder) (string, []any, error) {
 	return qb.Build(query.ContextFn(func(key, operator string, value any) (string, []any, error) {
 		switch {
-		case key == "name", key == "country", key == "id", key == "psu_id":
-			if operator != "$match" {
-				return "", nil, fmt.Errorf("'%s' column can only be used with $match: %w", key, ErrValidation)
-			}
-			return fmt.Sprintf("%s = ?", key), []any{value}, nil
+		case key == "name":
+			return matchText("bank_account.name", key, operator, value)
+		case key == "country", key == "id", key == "psu_id":
+			return matchEqual("bank_account."+key, key, operator, value)
+		case key == "created_at":
+			return matchDate("bank_account.created_at", key, operator, value)
 		case metadataRegex.Match([]byte(key)):
-			if operator != "$match" {
-				return "", nil, fmt.Errorf("'%s' column can only be used with $match: %w", key, ErrValidation)
-			}
-			match := metadataRegex.FindAllStringSubmatch(key, 3)
-
-			key := "metadata"
-			return key + " @> ?", []any{map[string]any{
-				match[0][1]: value,
-			}}, nil
+			return matchMetadata("bank_account.metadata", key, operator, value)
 		default:
 			return "", nil, fmt.Errorf("unknown key '%s' when building query: %w", key, ErrValidation)
 		}
@@ -299,6 +324,11 @@ func (s *store) BankAccountsList(ctx context.Context, q ListBankAccountsQuery) (
 		}
 	}
 
+	orderBy, err := sortOrder(q.Options.Options.Sort, bankAccountsSortColumns, "bank_account.created_at", "bank_account.sort_id")
+	if err != nil {
+		return nil, err
+	}
+
 	cursor, err := paginateWithOffset[paginate.PaginatedQueryOptions[BankAccountQuery], bankAccount](s, ctx,
 		(*paginate.OffsetPaginatedQuery[paginate.PaginatedQueryOptions[BankAccountQuery]])(&q),
 		func(query *bun.SelectQuery) *bun.SelectQuery {
@@ -307,7 +337,7 @@ func (s *store) BankAccountsList(ctx context.Context, q ListBankAccountsQuery) (
 				query = query.Where(where, args...)
 			}
 
-			query = query.Order("created_at DESC", "sort_id DESC")
+			query = query.Order(orderBy...)
 
 			return query
 		},
@@ -481,6 +511,14 @@ func toBankAccountModels(from bankAccount) models.BankAccount {
 	}
 	ba.RelatedAccounts = relatedAccounts
 
+	if len(from.Verifications) > 0 {
+		verifications := make([]models.BankAccountVerification, 0, len(from.Verifications))
+		for _, v := range from.Verifications {
+			verifications = append(verifications, toBankAccountVerificationModels(*v))
+		}
+		ba.Verifications = verifications
+	}
+
 	return ba
 }
 
@@ -0,0 +1,231 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	gotime "time"
+
+	"github.com/formancehq/go-libs/v5/pkg/types/time"
+	internalEvents "github.com/formancehq/payments/internal/events"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/formancehq/payments/pkg/events"
+	"github.com/uptrace/bun"
+)
+
+type connectorHealth struct {
+	bun.BaseModel `bun:"table:connector_health"`
+
+	// Mandatory fields
+	ConnectorID models.ConnectorID           `bun:"connector_id,pk,type:character varying,notnull"`
+	Status      models.ConnectorHealthStatus `bun:"status,type:text,notnull"`
+	UpdatedAt   time.Time                    `bun:"updated_at,type:timestamp without time zone,notnull"`
+}
+
+type scheduleHealth struct {
+	ScheduleID          string     `bun:"schedule_id"`
+	PausedAt            *time.Time `bun:"paused_at"`
+	PausedReason        *string    `bun:"paused_reason"`
+	LastSuccessAt       *time.Time `bun:"last_success_at"`
+	LastErrorAt         *time.Time `bun:"last_error_at"`
+	LastError           *string    `bun:"last_error"`
+	ConsecutiveFailures int        `bun:"consecutive_failures"`
+}
+
+// ConnectorHealthGet computes the health of the connector from its schedules
+// and their workflow instances, along with the last instances which failed.
+func (s *store) ConnectorHealthGet(ctx context.Context, connectorID models.ConnectorID, recentErrorsLimit int) (*models.ConnectorHealth, error) {
+	if _, err := s.ConnectorsGet(ctx, connectorID); err != nil {
+		return nil, err
+	}
+
+	schedules, err := s.schedulesHealthList(ctx, s.db, connectorID)
+	if err != nil {
+		return nil, err
+	}
+
+	var instances []instance
+	err = s.db.NewSelect().
+		Model(&instances).
+		Where("connector_id = ?", connectorID).
+		Where("terminated = TRUE").
+		Where("error IS NOT NULL").
+		Order("terminated_at DESC").
+		Limit(recentErrorsLimit).
+		Scan(ctx)
+	if err != nil {
+		return nil, e("failed to fetch instance errors", err)
+	}
+
+	recentErrors := make([]models.Instance, 0, len(instances))
+	for _, i := range instances {
+		recentErrors = append(recentErrors, toInstanceModel(i))
+	}
+
+	health := models.NewConnectorHealth(connectorID, schedules, recentErrors)
+	return &health, nil
+}
+
+// ConnectorHealthRefresh computes the health status of the connector and
+// stores it. When it differs from the stored one, a health changed event is
+// inserted within the same transaction.
+func (s *store) ConnectorHealthRefresh(ctx context.Context, connectorID models.ConnectorID, at gotime.Time) error {
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return e("failed to begin transaction", err)
+	}
+	defer func() {
+		rollbackOnTxError(ctx, &tx, err)
+	}()
+
+	// The connector may have been uninstalled in the meantime, there is
+	// nothing to refresh then.
+	_, err = tx.NewRaw(`
+		INSERT INTO connector_health (connector_id, status, updated_at)
+		SELECT ?, ?, ?
+		WHERE EXISTS (SELECT 1 FROM connectors WHERE id = ?)
+		ON CONFLICT (connector_id) DO NOTHING`,
+		connectorID, models.CONNECTOR_HEALTH_STATUS_HEALTHY, time.New(at), connectorID,
+	).Exec(ctx)
+	if err != nil {
+		return e("failed to insert connector health", err)
+	}
+
+	var current connectorHealth
+	err = tx.NewSelect().
+		Model(&current).
+		Where("connector_id = ?", connectorID).
+		For("UPDATE").
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil
+			return tx.Commit()
+		}
+		return e("failed to get connector health", err)
+	}
+
+	var schedules []models.ScheduleHealth
+	schedules, err = s.schedulesHealthList(ctx, tx, connectorID)
+	if err != nil {
+		return err
+	}
+
+	health := models.NewConnectorHealth(connectorID, schedules, nil)
+	if health.Status == current.Status {
+		return e("failed to commit transaction", tx.Commit())
+	}
+
+	_, err = tx.NewUpdate().
+		Model((*connectorHealth)(nil)).
+		Set("status = ?", health.Status).
+		Set("updated_at = ?", time.New(at)).
+		Where("connector_id = ?", connectorID).
+		Exec(ctx)
+	if err != nil {
+		return e("failed to update connector health", err)
+	}
+
+	change := models.ConnectorHealthChange{
+		ConnectorID:    connectorID,
+		PreviousStatus: current.Status,
+		Status:         health.Status,
+		ChangedAt:      at.UTC(),
+	}
+
+	evtMsg := internalEvents.Events{}.NewEventConnectorHealthChanged(change)
+	var payloadBytes []byte
+	payloadBytes, err = json.Marshal(evtMsg.Payload)
+	if err != nil {
+		return e("failed to marshal connector health changed event payload", err)
+	}
+
+	outboxEvent := models.OutboxEvent{
+		ID: models.EventID{
+			EventIdempotencyKey: change.IdempotencyKey(),
+			ConnectorID:         &connectorID,
+		},
+		EventType:   events.EventTypeConnectorHealthChanged,
+		EntityID:    connectorID.String(),
+		Payload:     payloadBytes,
+		CreatedAt:   change.ChangedAt,
+		Status:      models.OUTBOX_STATUS_PENDING,
+		ConnectorID: &connectorID,
+	}
+
+	if err = s.OutboxEventsInsert(ctx, tx, []models.OutboxEvent{outboxEvent}); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return e("failed to commit transaction", err)
+	}
+	return nil
+}
+
+// schedulesHealthList summarizes the terminated workflow instances of every
+// schedule of the connector. Consecutive failures are the failed instances
+// created after the last successful one.
+func (s *store) schedulesHealthList(ctx context.Context, db bun.IDB, connectorID models.ConnectorID) ([]models.ScheduleHealth, error) {
+	var rows []scheduleHealth
+	err := db.NewRaw(`
+		SELECT
+			s.id AS schedule_id,
+			s.paused_at,
+			s.paused_reason,
+			ok.last_success_at,
+			ko.last_error_at,
+			ko.last_error,
+			COALESCE(ko.consecutive_failures, 0) AS consecutive_failures
+		FROM schedules s
+		LEFT JOIN LATERAL (
+			SELECT
+				MAX(created_at) AS created_at,
+				MAX(terminated_at) AS last_success_at
+			FROM workflows_instances
+			WHERE connector_id = s.connector_id AND schedule_id = s.id
+				AND terminated = TRUE AND error IS NULL
+		) ok ON TRUE
+		LEFT JOIN LATERAL (
+			SELECT
+				MAX(terminated_at) AS last_error_at,
+				(ARRAY_AGG(error ORDER BY created_at DESC))[1] AS last_error,
+				COUNT(*) FILTER (WHERE ok.created_at IS NULL OR created_at > ok.created_at) AS consecutive_failures
+			FROM workflows_instances
+			WHERE connector_id = s.connector_id AND schedule_id = s.id
+				AND terminated = TRUE AND error IS NOT NULL
+		) ko ON TRUE
+		WHERE s.connector_id = ?
+		ORDER BY s.id`,
+		connectorID,
+	).Scan(ctx, &rows)
+	if err != nil {
+		return nil, e("failed to fetch schedules health", err)
+	}
+
+	schedules := make([]models.ScheduleHealth, 0, len(rows))
+	for _, row := range rows {
+		schedules = append(schedules, toScheduleHealthModel(row))
+	}
+	return schedules, nil
+}
+
+func toScheduleHealthModel(from scheduleHealth) models.ScheduleHealth {
+	toTime := func(t *time.Time) *gotime.Time {
+		if t == nil {
+			return nil
+		}
+		return &t.Time
+	}
+
+	return models.ScheduleHealth{
+		ScheduleID:          from.ScheduleID,
+		PausedAt:            toTime(from.PausedAt),
+		PausedReason:        from.PausedReason,
+		LastSuccessAt:       toTime(from.LastSuccessAt),
+		LastErrorAt:         toTime(from.LastErrorAt),
+		LastError:           from.LastError,
+		ConsecutiveFailures: from.ConsecutiveFailures,
+	}
+}
@@ -0,0 +1,116 @@
+package storage
+
+import (
+	"fmt"
+	"testing"
+	gotime "time"
+
+	"github.com/formancehq/go-libs/v5/pkg/observe/log"
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/formancehq/payments/pkg/events"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectorHealth(t *testing.T) {
+	t.Parallel()
+
+	ctx := logging.TestingContext()
+	store := newStore(t)
+	defer store.Close()
+
+	upsertConnector(t, ctx, store, defaultConnector)
+
+	start := now.Add(-gotime.Hour).UTC().Time
+	accountsScheduleID := fmt.Sprintf("stack-%s-FETCH_ACCOUNTS", defaultConnector.ID.String())
+	paymentsScheduleID := fmt.Sprintf("stack-%s-FETCH_PAYMENTS", defaultConnector.ID.String())
+	upsertSchedule(t, ctx, store, models.Schedule{ID: accountsScheduleID, ConnectorID: defaultConnector.ID, CreatedAt: start})
+	upsertSchedule(t, ctx, store, models.Schedule{ID: paymentsScheduleID, ConnectorID: defaultConnector.ID, CreatedAt: start})
+
+	terminated := func(scheduleID string, minutes int, err *string) models.Instance {
+		at := start.Add(gotime.Duration(minutes) * gotime.Minute)
+		return models.Instance{
+			ID:           uuid.NewString(),
+			ScheduleID:   scheduleID,
+			ConnectorID:  defaultConnector.ID,
+			CreatedAt:    at,
+			UpdatedAt:    at,
+			Terminated:   true,
+			TerminatedAt: &at,
+			Error:        err,
+		}
+	}
+
+	upsertInstance(t, ctx, store, terminated(accountsScheduleID, 1, nil))
+	upsertInstance(t, ctx, store, terminated(paymentsScheduleID, 1, pointer.For("old error")))
+	upsertInstance(t, ctx, store, terminated(paymentsScheduleID, 2, nil))
+
+	t.Run("healthy connector", func(t *testing.T) {
+		health, err := store.ConnectorHealthGet(ctx, defaultConnector.ID, 10)
+		require.NoError(t, err)
+		require.Equal(t, models.CONNECTOR_HEALTH_STATUS_HEALTHY, health.Status)
+		require.Len(t, health.Capabilities, 2)
+		require.Len(t, health.RecentErrors, 1)
+
+		require.NoError(t, store.ConnectorHealthRefresh(ctx, defaultConnector.ID, start))
+	})
+
+	t.Run("failing capability", func(t *testing.T) {
+		upsertInstance(t, ctx, store, terminated(paymentsScheduleID, 3, pointer.For("error 1")))
+		upsertInstance(t, ctx, store, terminated(paymentsScheduleID, 4, pointer.For("error 2")))
+
+		health, err := store.ConnectorHealthGet(ctx, defaultConnector.ID, 1)
+		require.NoError(t, err)
+		require.Equal(t, models.CONNECTOR_HEALTH_STATUS_DEGRADED, health.Status)
+		require.Len(t, health.RecentErrors, 1)
+		require.Equal(t, "error 2", *health.RecentErrors[0].Error)
+
+		for _, c := range health.Capabilities {
+			switch c.Capability {
+			case models.CAPABILITY_FETCH_PAYMENTS:
+				require.Equal(t, 2, c.ConsecutiveFailures)
+				require.Equal(t, "error 2", *c.LastError)
+				require.NotNil(t, c.LastSuccessAt)
+			case models.CAPABILITY_FETCH_ACCOUNTS:
+				require.Zero(t, c.ConsecutiveFailures)
+				require.Nil(t, c.LastError)
+			}
+		}
+	})
+
+	t.Run("refresh inserts a health changed event once", func(t *testing.T) {
+		changedAt := start.Add(10 * gotime.Minute)
+		require.NoError(t, store.ConnectorHealthRefresh(ctx, defaultConnector.ID, changedAt))
+		require.NoError(t, store.ConnectorHealthRefresh(ctx, defaultConnector.ID, changedAt.Add(gotime.Minute)))
+
+		pendingEvents, err := store.OutboxEventsPollPending(ctx, 1000)
+		require.NoError(t, err)
+
+		var healthEvents []models.OutboxEvent
+		for _, evt := range pendingEvents {
+			if evt.EventType == events.EventTypeConnectorHealthChanged && evt.EntityID == defaultConnector.ID.String() {
+				healthEvents = append(healthEvents, evt)
+			}
+		}
+		require.Len(t, healthEvents, 1)
+		require.Contains(t, string(healthEvents[0].Payload), `"previousStatus":"HEALTHY"`)
+		require.Contains(t, string(healthEvents[0].Payload), `"status":"DEGRADED"`)
+	})
+
+	t.Run("paused capability", func(t *testing.T) {
+		require.NoError(t, store.SchedulesPause(ctx, paymentsScheduleID, defaultConnector.ID, start.Add(5*gotime.Minute), "error 2"))
+
+		health, err := store.ConnectorHealthGet(ctx, defaultConnector.ID, 10)
+		require.NoError(t, err)
+		require.Equal(t, models.CONNECTOR_HEALTH_STATUS_UNHEALTHY, health.Status)
+	})
+
+	t.Run("unknown connector", func(t *testing.T) {
+		unknown := models.ConnectorID{Reference: uuid.New(), Provider: "unknown"}
+		_, err := store.ConnectorHealthGet(ctx, unknown, 10)
+		require.ErrorIs(t, err, ErrNotFound)
+
+		require.NoError(t, store.ConnectorHealthRefresh(ctx, unknown, start))
+	})
+}
@@ -0,0 +1,97 @@
+package storage
+
+import (
+	"context"
+	gotime "time"
+
+	"github.com/formancehq/go-libs/v5/pkg/types/time"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/uptrace/bun"
+)
+
+type connectorRateBudget struct {
+	bun.BaseModel `bun:"table:connector_rate_budgets"`
+
+	// Mandatory fields
+	ConnectorID models.ConnectorID `bun:"connector_id,pk,type:character varying,notnull"`
+	Tokens      float64            `bun:"tokens,type:double precision,notnull"`
+	RefilledAt  time.Time          `bun:"refilled_at,type:timestamp without time zone,notnull"`
+	Day         time.Time          `bun:"day,type:timestamp without time zone,notnull"`
+	DailyCount  int                `bun:"daily_count,type:bigint,notnull"`
+}
+
+// ConnectorRateBudgetsTake takes one call out of the connector's rate
+// budget. The row is locked for the duration of the transaction so that every
+// worker sees the same budget. When the budget is exhausted, the returned
+// duration is how long to wait before trying again.
+func (s *store) ConnectorRateBudgetsTake(
+	ctx context.Context,
+	connectorID models.ConnectorID,
+	budget models.RateBudget,
+	now gotime.Time,
+) (models.RateBudgetUsage, gotime.Duration, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return models.RateBudgetUsage{}, 0, e("failed to begin transaction", err)
+	}
+	defer func() {
+		rollbackOnTxError(ctx, &tx, err)
+	}()
+
+	toInsert := fromRateBudgetUsageModels(budget.NewUsage(connectorID, now))
+	_, err = tx.NewInsert().
+		Model(&toInsert).
+		On("CONFLICT (connector_id) DO NOTHING").
+		Exec(ctx)
+	if err != nil {
+		return models.RateBudgetUsage{}, 0, e("failed to insert connector rate budget", err)
+	}
+
+	var current connectorRateBudget
+	err = tx.NewSelect().
+		Model(&current).
+		Where("connector_id = ?", connectorID).
+		For("UPDATE").
+		Scan(ctx)
+	if err != nil {
+		return models.RateBudgetUsage{}, 0, e("failed to get connector rate budget", err)
+	}
+
+	usage, wait, _ := budget.Take(toRateBudgetUsageModels(current), now)
+
+	toUpdate := fromRateBudgetUsageModels(usage)
+	_, err = tx.NewUpdate().
+		Model(&toUpdate).
+		Column("tokens", "refilled_at", "day", "daily_count").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return models.RateBudgetUsage{}, 0, e("failed to update connector rate budget", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return models.RateBudgetUsage{}, 0, e("failed to commit transaction", err)
+	}
+
+	return usage, wait, nil
+}
+
+func fromRateBudgetUsageModels(from models.RateBudgetUsage) connectorRateBudget {
+	return connectorRateBudget{
+		ConnectorID: from.ConnectorID,
+		Tokens:      from.Tokens,
+		RefilledAt:  time.New(from.RefilledAt),
+		Day:         time.New(from.Day),
+		DailyCount:  from.DailyCount,
+	}
+}
+
+func toRateBudgetUsageModels(from connectorRateBudget) models.RateBudgetUsage {
+	return models.RateBudgetUsage{
+		ConnectorID: from.ConnectorID,
+		Tokens:      from.Tokens,
+		RefilledAt:  from.RefilledAt.Time,
+		Day:         from.Day.Time,
+		DailyCount:  from.DailyCount,
+	}
+}
@@ -0,0 +1,63 @@
+package storage
+
+import (
+	"testing"
+	gotime "time"
+
+	"github.com/formancehq/go-libs/v5/pkg/observe/log"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectorRateBudgetsTake(t *testing.T) {
+	t.Parallel()
+
+	ctx := logging.TestingContext()
+	store := newStore(t)
+	defer store.Close()
+
+	upsertConnector(t, ctx, store, defaultConnector)
+
+	budget := models.RateBudget{CallsPerSecond: 1, Burst: 2, DailyQuota: 3}
+	start := now.UTC().Time
+
+	t.Run("takes from a full budget", func(t *testing.T) {
+		usage, wait, err := store.ConnectorRateBudgetsTake(ctx, defaultConnector.ID, budget, start)
+		require.NoError(t, err)
+		require.Zero(t, wait)
+		require.Equal(t, float64(1), usage.Tokens)
+		require.Equal(t, 1, usage.DailyCount)
+	})
+
+	t.Run("shares the budget between calls", func(t *testing.T) {
+		_, wait, err := store.ConnectorRateBudgetsTake(ctx, defaultConnector.ID, budget, start)
+		require.NoError(t, err)
+		require.Zero(t, wait)
+
+		_, wait, err = store.ConnectorRateBudgetsTake(ctx, defaultConnector.ID, budget, start)
+		require.NoError(t, err)
+		require.Equal(t, gotime.Second, wait)
+	})
+
+	t.Run("refills over time", func(t *testing.T) {
+		usage, wait, err := store.ConnectorRateBudgetsTake(ctx, defaultConnector.ID, budget, start.Add(gotime.Second))
+		require.NoError(t, err)
+		require.Zero(t, wait)
+		require.Equal(t, 3, usage.DailyCount)
+	})
+
+	t.Run("enforces the daily quota", func(t *testing.T) {
+		_, wait, err := store.ConnectorRateBudgetsTake(ctx, defaultConnector.ID, budget, start.Add(gotime.Minute))
+		require.NoError(t, err)
+		require.NotZero(t, wait)
+	})
+
+	t.Run("unknown connector", func(t *testing.T) {
+		_, _, err := store.ConnectorRateBudgetsTake(ctx, models.ConnectorID{
+			Reference: uuid.New(),
+			Provider:  "unknown",
+		}, budget, start)
+		require.Error(t, err)
+	})
+}
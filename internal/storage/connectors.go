@@ -52,6 +52,9 @@ type connector struct {
 
 	// UpdatedAt is set by a DB trigger on every UPDATE. Nullable until first update.
 	UpdatedAt *time.Time `bun:"updated_at,type:timestamp without time zone,nullzero"`
+
+	// Optional fields
+	Environment *string `bun:"environment,type:text,nullzero"`
 }
 
 func (s *store) ListenConnectorsChanges(ctx context.Context, handlers HandlerConnectorsChanges) error {
@@ -114,6 +117,7 @@ func (s *store) ConnectorsInstall(ctx context.Context, c models.Connector, oldCo
 		CreatedAt:            time.New(c.CreatedAt),
 		Provider:             c.Provider,
 		ScheduledForDeletion: false,
+		Environment:          fromConnectorEnvironment(c.Environment),
 	}
 
 	_, err = tx.NewInsert().
@@ -189,6 +193,7 @@ func (s *store) ConnectorsConfigUpdate(ctx context.Context, c models.Connector)
 	_, err = tx.NewUpdate().
 		Model((*connector)(nil)).
 		Set("name = ?", c.Name).
+		Set("environment = ?", fromConnectorEnvironment(c.Environment)).
 		Set("config = pgp_sym_encrypt(?::TEXT, ?, ?)", c.Config, s.configEncryptionKey, encryptionOptions).
 		Where("id = ?", c.ID).
 		Exec(ctx)
@@ -202,6 +207,70 @@ func (s *store) ConnectorsConfigUpdate(ctx context.Context, c models.Connector)
 	return nil
 }
 
+// ConnectorsRotateCredentials replaces the connector config with one holding
+// rotated credentials and records the rotation in the outbox within the same
+// transaction. The update fires the connectors trigger, so every instance
+// listening to connector changes reloads the plugin with the new credentials.
+func (s *store) ConnectorsRotateCredentials(ctx context.Context, c models.Connector, rotation models.ConnectorCredentialsRotation) error {
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("cannot begin transaction: %w", err)
+	}
+	defer func() {
+		rollbackOnTxError(ctx, &tx, err)
+	}()
+
+	// Lock the connector row so that concurrent rotations are serialized
+	var current connector
+	err = tx.NewSelect().
+		Model(&current).
+		Column("id").
+		Where("id = ?", c.ID).
+		For("UPDATE").
+		Scan(ctx)
+	if err != nil {
+		return e("connector not found", err)
+	}
+
+	_, err = tx.NewUpdate().
+		Model((*connector)(nil)).
+		Set("config = pgp_sym_encrypt(?::TEXT, ?, ?)", c.Config, s.configEncryptionKey, encryptionOptions).
+		Where("id = ?", c.ID).
+		Exec(ctx)
+	if err != nil {
+		return e("failed to encrypt config", err)
+	}
+
+	evtMsg := internalEvents.Events{}.NewEventConnectorCredentialsRotated(rotation)
+	var payloadBytes []byte
+	payloadBytes, err = json.Marshal(evtMsg.Payload)
+	if err != nil {
+		return e("failed to marshal connector credentials rotated event payload", err)
+	}
+
+	outboxEvent := models.OutboxEvent{
+		ID: models.EventID{
+			EventIdempotencyKey: rotation.IdempotencyKey(),
+			ConnectorID:         &c.ID,
+		},
+		EventType:   events.EventTypeConnectorCredentialsRotated,
+		EntityID:    c.ID.String(),
+		Payload:     payloadBytes,
+		CreatedAt:   rotation.RotatedAt.UTC(),
+		Status:      models.OUTBOX_STATUS_PENDING,
+		ConnectorID: &c.ID,
+	}
+
+	if err = s.OutboxEventsInsert(ctx, tx, []models.OutboxEvent{outboxEvent}); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return e("failed to commit transaction", err)
+	}
+	return nil
+}
+
 func (s *store) ConnectorsScheduleForDeletion(ctx context.Context, id models.ConnectorID) error {
 	_, err := s.db.NewUpdate().
 		Model((*connector)(nil)).
@@ -270,7 +339,7 @@ func (s *store) connectorsQueryContext(qb query.Builder) (string, []any, error)
 				return "", nil, fmt.Errorf("expected string type for provider, got %T: %w", value, ErrValidation)
 			}
 			return fmt.Sprintf("%s %s ?", key, query.DefaultComparisonOperatorsMapping[operator]), []any{strings.ToLower(models.ToV3Provider(v))}, nil
-		case "name", "id":
+		case "name", "id", "environment":
 			return fmt.Sprintf("%s %s ?", key, query.DefaultComparisonOperatorsMapping[operator]), []any{value}, nil
 		default:
 			return "", nil, fmt.Errorf("unknown key '%s' when building query: %w", key, ErrValidation)
@@ -330,12 +399,24 @@ func toConnectorModels(from connector) models.Connector {
 		t := from.UpdatedAt.Time
 		updatedAt = &t
 	}
+	var environment string
+	if from.Environment != nil {
+		environment = *from.Environment
+	}
 	return models.Connector{
 		ConnectorBase:        toConnectorBaseModels(from),
 		Config:               from.DecryptedConfig,
 		ScheduledForDeletion: from.ScheduledForDeletion,
 		UpdatedAt:            updatedAt,
+		Environment:          environment,
+	}
+}
+
+func fromConnectorEnvironment(environment string) *string {
+	if environment == "" {
+		return nil
 	}
+	return &environment
 }
 
 func toConnectorBaseModels(from connector) models.ConnectorBase {
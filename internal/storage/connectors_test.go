@@ -56,7 +56,8 @@ var (
 			CreatedAt: now.Add(-30 * time.Minute).UTC().Time,
 			Provider:  "default",
 		},
-		Config: []byte(`{}`),
+		Config:      []byte(`{}`),
+		Environment: "sandbox",
 	}
 )
 
@@ -264,7 +265,8 @@ func TestConnectorsConfigUpdate(t *testing.T) {
 				ID:   defaultConnector.ID,
 				Name: "new name",
 			},
-			Config: config,
+			Config:      config,
+			Environment: "production",
 		}
 
 		require.NoError(t, store.ConnectorsConfigUpdate(ctx, c))
@@ -273,6 +275,7 @@ func TestConnectorsConfigUpdate(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, connector)
 		assert.Equal(t, c.Name, connector.Name)
+		assert.Equal(t, c.Environment, connector.Environment)
 		assert.Equal(t, defaultConnector.CreatedAt, connector.CreatedAt)
 		assert.Equal(t, defaultConnector.Provider, connector.Provider)
 		assert.Equal(t, defaultConnector.ScheduledForDeletion, connector.ScheduledForDeletion)
@@ -299,6 +302,79 @@ func TestConnectorsConfigUpdate(t *testing.T) {
 	})
 }
 
+func TestConnectorsRotateCredentials(t *testing.T) {
+	t.Parallel()
+
+	ctx := logging.TestingContext()
+	store := newStore(t)
+	defer store.Close()
+
+	upsertConnector(t, ctx, store, defaultConnector)
+
+	t.Run("rotates config and records outbox event", func(t *testing.T) {
+		config := json.RawMessage(`{"apiKey":"sk_rotated_secret"}`)
+		c := models.Connector{
+			ConnectorBase: models.ConnectorBase{
+				ID:   defaultConnector.ID,
+				Name: defaultConnector.Name,
+			},
+			Config: config,
+		}
+		rotatedAt := now.Add(time.Minute).UTC().Time
+		rotation := models.ConnectorCredentialsRotation{
+			ConnectorID: defaultConnector.ID,
+			Fields:      []string{"apiKey"},
+			RotatedAt:   rotatedAt,
+		}
+
+		require.NoError(t, store.ConnectorsRotateCredentials(ctx, c, rotation))
+
+		connector, err := store.ConnectorsGet(ctx, c.ID)
+		require.NoError(t, err)
+		assert.Equal(t, defaultConnector.Name, connector.Name)
+
+		expectedData, err := canonicaljson.Marshal(config)
+		require.NoError(t, err)
+		data, err := canonicaljson.Marshal(connector.Config)
+		require.NoError(t, err)
+		assert.Equal(t, string(expectedData), string(data))
+
+		pendingEvents, err := store.OutboxEventsPollPending(ctx, 1000)
+		require.NoError(t, err)
+
+		var ourEvent *models.OutboxEvent
+		for i := range pendingEvents {
+			if pendingEvents[i].EventType == events.EventTypeConnectorCredentialsRotated &&
+				pendingEvents[i].EntityID == defaultConnector.ID.String() {
+				ourEvent = &pendingEvents[i]
+				break
+			}
+		}
+		require.NotNil(t, ourEvent, "expected outbox event for credentials rotation")
+		assert.Equal(t, rotation.IdempotencyKey(), ourEvent.ID.EventIdempotencyKey)
+
+		var payload map[string]interface{}
+		require.NoError(t, json.Unmarshal(ourEvent.Payload, &payload))
+		assert.Equal(t, defaultConnector.ID.String(), payload["connectorID"])
+		assert.Equal(t, []interface{}{"apiKey"}, payload["fields"])
+		assert.NotContains(t, string(ourEvent.Payload), "sk_rotated_secret")
+	})
+
+	t.Run("connector doesn't exist yet", func(t *testing.T) {
+		c := models.Connector{
+			ConnectorBase: models.ConnectorBase{
+				ID: models.ConnectorID{
+					Reference: uuid.New(),
+					Provider:  "test",
+				},
+			},
+			Config: []byte(`{}`),
+		}
+
+		require.Error(t, store.ConnectorsRotateCredentials(ctx, c, models.ConnectorCredentialsRotation{ConnectorID: c.ID}))
+	})
+}
+
 func TestConnectorsScheduleForDeletion(t *testing.T) {
 	t.Parallel()
 
@@ -384,6 +460,20 @@ func TestConnectorsList(t *testing.T) {
 		require.Equal(t, defaultConnector, withoutUpdatedAt(cursor.Data[0]))
 	})
 
+	t.Run("list connectors by environment", func(t *testing.T) {
+		q := NewListConnectorsQuery(
+			paginate.NewPaginatedQueryOptions(ConnectorQuery{}).
+				WithPageSize(15).
+				WithQueryBuilder(query.Match("environment", "sandbox")),
+		)
+
+		cursor, err := store.ConnectorsList(ctx, q)
+		require.NoError(t, err)
+		require.Len(t, cursor.Data, 1)
+		require.False(t, cursor.HasMore)
+		require.Equal(t, defaultConnector3, withoutUpdatedAt(cursor.Data[0]))
+	})
+
 	t.Run("list connectors by unknown name", func(t *testing.T) {
 		q := NewListConnectorsQuery(
 			paginate.NewPaginatedQueryOptions(ConnectorQuery{}).
@@ -142,7 +142,9 @@ func (s *store) ConversionsDeleteFromConnectorID(ctx context.Context, connectorI
 	return e("failed to delete conversions", err)
 }
 
-type ConversionQuery struct{}
+type ConversionQuery struct {
+	Sort []SortKey `json:"sort,omitempty"`
+}
 
 type ListConversionsQuery paginate.OffsetPaginatedQuery[paginate.PaginatedQueryOptions[ConversionQuery]]
 
@@ -154,34 +156,35 @@ func NewListConversionsQuery(opts paginate.PaginatedQueryOptions[ConversionQuery
 	}
 }
 
+var conversionsSortColumns = map[string]string{
+	"created_at":    "conversion.created_at",
+	"updated_at":    "conversion.updated_at",
+	"reference":     "conversion.reference",
+	"source_amount": "conversion.source_amount",
+}
+
 func (s *store) conversionsQueryContext(qb query.Builder) (string, []any, error) {
 	where, args, err := qb.Build(query.ContextFn(func(key, operator string, value any) (string, []any, error) {
 		switch {
-		case key == "reference",
-			key == "id",
+		case key == "reference":
+			return matchText("conversion.reference", key, operator, value)
+		case key == "id",
 			key == "connector_id",
 			key == "source_asset",
 			key == "destination_asset",
 			key == "status",
 			key == "source_account_id",
 			key == "destination_account_id":
-			if operator != "$match" {
-				return "", nil, e(fmt.Sprintf("'%s' column can only be used with $match", key), ErrValidation)
-			}
-			return fmt.Sprintf("conversion.%s = ?", key), []any{value}, nil
+			return matchEqual("conversion."+key, key, operator, value)
+		case key == "created_at",
+			key == "updated_at":
+			return matchDate("conversion."+key, key, operator, value)
 
 		case key == "source_amount",
 			key == "destination_amount":
 			return fmt.Sprintf("conversion.%s %s ?", key, query.DefaultComparisonOperatorsMapping[operator]), []any{value}, nil
 		case metadataRegex.Match([]byte(key)):
-			if operator != "$match" {
-				return "", nil, e("'metadata' column can only be used with $match", ErrValidation)
-			}
-			match := metadataRegex.FindAllStringSubmatch(key, 3)
-
-			return "conversion.metadata @> ?", []any{map[string]any{
-				match[0][1]: value,
-			}}, nil
+			return matchMetadata("conversion.metadata", key, operator, value)
 		default:
 			return "", nil, fmt.Errorf("unknown key '%s' when building query: %w", key, ErrValidation)
 		}
@@ -203,6 +206,11 @@ func (s *store) ConversionsList(ctx context.Context, q ListConversionsQuery) (*p
 		}
 	}
 
+	orderBy, err := sortOrder(q.Options.Options.Sort, conversionsSortColumns, "conversion.created_at", "conversion.sort_id")
+	if err != nil {
+		return nil, err
+	}
+
 	cursor, err := paginateWithOffset[paginate.PaginatedQueryOptions[ConversionQuery], conversion](s, ctx,
 		(*paginate.OffsetPaginatedQuery[paginate.PaginatedQueryOptions[ConversionQuery]])(&q),
 		func(query *bun.SelectQuery) *bun.SelectQuery {
@@ -210,7 +218,7 @@ func (s *store) ConversionsList(ctx context.Context, q ListConversionsQuery) (*p
 				query = query.Where(where, args...)
 			}
 
-			query = query.Order("created_at DESC", "sort_id DESC")
+			query = query.Order(orderBy...)
 
 			return query
 		},
@@ -0,0 +1,396 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/formancehq/go-libs/v5/pkg/query"
+	"github.com/formancehq/go-libs/v5/pkg/storage/bun/paginate"
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	internalTime "github.com/formancehq/go-libs/v5/pkg/types/time"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type counterparty struct {
+	bun.BaseModel `bun:"table:counterparties"`
+
+	// Mandatory fields
+	ID        uuid.UUID         `bun:"id,pk,type:uuid,notnull"`
+	CreatedAt internalTime.Time `bun:"created_at,type:timestamp without time zone,notnull"`
+	Name      string            `bun:"name,type:text,notnull"`
+
+	// Field encrypted
+	AccountNumber string `bun:"decrypted_account_number,scanonly"`
+	IBAN          string `bun:"decrypted_iban,scanonly"`
+	SwiftBicCode  string `bun:"decrypted_swift_bic_code,scanonly"`
+	RoutingCode   string `bun:"decrypted_routing_code,scanonly"`
+
+	// Optional fields
+	// c.f.: https://bun.uptrace.dev/guide/models.html#nulls
+	Country *string `bun:"country,type:text,nullzero"`
+
+	// Optional fields with default
+	// c.f. https://bun.uptrace.dev/guide/models.html#default
+	Metadata map[string]string `bun:"metadata,type:jsonb,nullzero,notnull,default:'{}'"`
+
+	RelatedAccounts []*counterpartyRelatedAccount `bun:"rel:has-many,join:id=counterparty_id,scanonly"`
+}
+
+type counterpartyRelatedAccount struct {
+	bun.BaseModel `bun:"table:counterparties_related_accounts"`
+
+	// Mandatory fields
+	CounterpartyID uuid.UUID          `bun:"counterparty_id,pk,type:uuid,notnull"`
+	AccountID      models.AccountID   `bun:"account_id,pk,type:character varying,notnull"`
+	ConnectorID    models.ConnectorID `bun:"connector_id,type:character varying,notnull"`
+	CreatedAt      internalTime.Time  `bun:"created_at,type:timestamp without time zone,notnull"`
+}
+
+func (s *store) CounterpartiesUpsert(ctx context.Context, counterparties []models.Counterparty) error {
+	if len(counterparties) == 0 {
+		return nil
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return e("begin transaction", err)
+	}
+	defer func() {
+		rollbackOnTxError(ctx, &tx, err)
+	}()
+
+	if err = s.counterpartiesUpsert(ctx, tx, counterparties); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return e("commit transaction", err)
+	}
+	return nil
+}
+
+// CounterpartiesLinkPendingAccounts links up to limit external accounts which
+// are not related to a counterparty yet, and returns how many were linked.
+// It runs in the background rather than when storing the accounts, to keep
+// the counterparties out of the fetch of the accounts.
+func (s *store) CounterpartiesLinkPendingAccounts(ctx context.Context, limit int) (int, error) {
+	var accounts []account
+	err := s.db.NewSelect().
+		Model(&accounts).
+		Where("account.type = ?", models.ACCOUNT_TYPE_EXTERNAL).
+		Where("NOT EXISTS (SELECT 1 FROM counterparties_related_accounts cra WHERE cra.account_id = account.id)").
+		Limit(limit).
+		Scan(ctx)
+	if err != nil {
+		return 0, e("list accounts to link to counterparties", err)
+	}
+
+	counterparties := make([]models.Counterparty, 0, len(accounts))
+	for _, a := range accounts {
+		if c := models.CounterpartyFromExternalAccount(s.configEncryptionKey, toAccountModels(a)); c != nil {
+			counterparties = append(counterparties, *c)
+		}
+	}
+
+	if err := s.CounterpartiesUpsert(ctx, counterparties); err != nil {
+		return 0, err
+	}
+
+	return len(counterparties), nil
+}
+
+// counterpartyBankDetails holds the bank details of a newly inserted
+// counterparty, they are encrypted in bulk once the rows exist.
+type counterpartyBankDetails struct {
+	ID            uuid.UUID `bun:"id,type:uuid"`
+	AccountNumber *string   `bun:"account_number,type:text"`
+	IBAN          *string   `bun:"iban,type:text"`
+	SwiftBicCode  *string   `bun:"swift_bic_code,type:text"`
+	RoutingCode   *string   `bun:"routing_code,type:text"`
+}
+
+// counterpartiesUpsert inserts the counterparties which do not exist yet,
+// links them to their related accounts and attaches the already stored
+// payments of those accounts to them. The bank details of an existing
+// counterparty are never updated, the first connector which reported them
+// wins.
+func (s *store) counterpartiesUpsert(ctx context.Context, tx bun.Tx, counterparties []models.Counterparty) error {
+	if len(counterparties) == 0 {
+		return nil
+	}
+
+	// Several accounts of the same batch can share a counterparty, it must be
+	// inserted once.
+	bankDetails := make(map[uuid.UUID]counterpartyBankDetails, len(counterparties))
+	toInsert := make([]counterparty, 0, len(counterparties))
+	relatedAccounts := make([]*counterpartyRelatedAccount, 0, len(counterparties))
+	accountIDs := make([]models.AccountID, 0, len(counterparties))
+	for _, c := range counterparties {
+		cp := fromCounterpartyModels(c)
+		for _, ra := range cp.RelatedAccounts {
+			relatedAccounts = append(relatedAccounts, ra)
+			accountIDs = append(accountIDs, ra.AccountID)
+		}
+
+		if _, ok := bankDetails[c.ID]; ok {
+			continue
+		}
+		bankDetails[c.ID] = counterpartyBankDetails{
+			ID:            c.ID,
+			AccountNumber: c.AccountNumber,
+			IBAN:          c.IBAN,
+			SwiftBicCode:  c.SwiftBicCode,
+			RoutingCode:   c.RoutingCode,
+		}
+		toInsert = append(toInsert, cp)
+	}
+
+	var inserted []counterparty
+	err := tx.NewInsert().
+		Model(&toInsert).
+		Column("id", "created_at", "name", "country", "metadata").
+		On("CONFLICT (id) DO NOTHING").
+		Returning("id").
+		Scan(ctx, &inserted)
+	if err != nil {
+		return e("insert counterparties", err)
+	}
+
+	if len(inserted) > 0 {
+		toEncrypt := make([]counterpartyBankDetails, 0, len(inserted))
+		for _, c := range inserted {
+			toEncrypt = append(toEncrypt, bankDetails[c.ID])
+		}
+
+		_, err = tx.NewUpdate().
+			With("_data", tx.NewValues(&toEncrypt)).
+			Model((*counterparty)(nil)).
+			TableExpr("_data").
+			Set("account_number = pgp_sym_encrypt(_data.account_number::TEXT, ?, ?)", s.configEncryptionKey, encryptionOptions).
+			Set("iban = pgp_sym_encrypt(_data.iban::TEXT, ?, ?)", s.configEncryptionKey, encryptionOptions).
+			Set("swift_bic_code = pgp_sym_encrypt(_data.swift_bic_code::TEXT, ?, ?)", s.configEncryptionKey, encryptionOptions).
+			Set("routing_code = pgp_sym_encrypt(_data.routing_code::TEXT, ?, ?)", s.configEncryptionKey, encryptionOptions).
+			Where("counterparty.id = _data.id::uuid").
+			Exec(ctx)
+		if err != nil {
+			return e("update counterparties", err)
+		}
+	}
+
+	if len(relatedAccounts) > 0 {
+		_, err = tx.NewInsert().
+			Model(&relatedAccounts).
+			On("CONFLICT (counterparty_id, account_id) DO NOTHING").
+			Exec(ctx)
+		if err != nil {
+			return e("insert counterparty related accounts", err)
+		}
+	}
+
+	return linkPaymentsToCounterparties(ctx, tx, "cra.account_id IN (?)", bun.In(accountIDs))
+}
+
+// linkPaymentsToCounterparties sets the counterparty of the payments matching
+// the given condition from the counterparty of their source or destination
+// account. The payments already linked to a counterparty are left untouched.
+func linkPaymentsToCounterparties(ctx context.Context, tx bun.Tx, where string, args ...any) error {
+	_, err := tx.NewRaw(`
+		UPDATE payments
+		SET counterparty_id = cra.counterparty_id
+		FROM counterparties_related_accounts cra
+		WHERE payments.counterparty_id IS NULL
+		AND (payments.source_account_id = cra.account_id OR payments.destination_account_id = cra.account_id)
+		AND `+where, args...).Exec(ctx)
+	if err != nil {
+		return e("link payments to counterparties", err)
+	}
+	return nil
+}
+
+func (s *store) CounterpartiesGet(ctx context.Context, id uuid.UUID) (*models.Counterparty, error) {
+	var c counterparty
+	err := s.db.NewSelect().
+		Model(&c).
+		Column("id", "created_at", "name", "country", "metadata").
+		ColumnExpr("pgp_sym_decrypt(account_number, ?, ?) AS decrypted_account_number", s.configEncryptionKey, encryptionOptions).
+		ColumnExpr("pgp_sym_decrypt(iban, ?, ?) AS decrypted_iban", s.configEncryptionKey, encryptionOptions).
+		ColumnExpr("pgp_sym_decrypt(swift_bic_code, ?, ?) AS decrypted_swift_bic_code", s.configEncryptionKey, encryptionOptions).
+		ColumnExpr("pgp_sym_decrypt(routing_code, ?, ?) AS decrypted_routing_code", s.configEncryptionKey, encryptionOptions).
+		Relation("RelatedAccounts").
+		Where("id = ?", id).
+		Scan(ctx)
+	if err != nil {
+		return nil, e("get counterparty", err)
+	}
+
+	return pointer.For(toCounterpartyModels(c)), nil
+}
+
+type CounterpartyQuery struct {
+	Sort []SortKey `json:"sort,omitempty"`
+}
+
+type ListCounterpartiesQuery paginate.OffsetPaginatedQuery[paginate.PaginatedQueryOptions[CounterpartyQuery]]
+
+func NewListCounterpartiesQuery(opts paginate.PaginatedQueryOptions[CounterpartyQuery]) ListCounterpartiesQuery {
+	return ListCounterpartiesQuery{
+		Order:    paginate.OrderAsc,
+		PageSize: opts.PageSize,
+		Options:  opts,
+	}
+}
+
+var counterpartiesSortColumns = map[string]string{
+	"created_at": "counterparty.created_at",
+	"name":       "counterparty.name",
+}
+
+func (s *store) counterpartiesQueryContext(qb query.Builder) (string, []any, error) {
+	return qb.Build(query.ContextFn(func(key, operator string, value any) (string, []any, error) {
+		switch {
+		case key == "name":
+			return matchText("counterparty.name", key, operator, value)
+		case key == "id", key == "country":
+			return matchEqual("counterparty."+key, key, operator, value)
+		case key == "iban":
+			// IBANs are encrypted, but they are the deduplication key of the
+			// counterparties that have one.
+			if operator != "$match" {
+				return "", nil, e(fmt.Sprintf("'%s' column can only be used with $match", key), ErrValidation)
+			}
+			iban, ok := value.(string)
+			if !ok {
+				return "", nil, e(fmt.Sprintf("'%s' value must be a string", key), ErrValidation)
+			}
+			return "counterparty.id = ?", []any{models.CounterpartyIDFromIBAN(s.configEncryptionKey, iban)}, nil
+		case key == "account_id", key == "connector_id":
+			clause, args, err := matchEqual("cra."+key, key, operator, value)
+			if err != nil {
+				return "", nil, err
+			}
+			return fmt.Sprintf("EXISTS (SELECT 1 FROM counterparties_related_accounts cra WHERE cra.counterparty_id = counterparty.id AND %s)", clause), args, nil
+		case key == "created_at":
+			return matchDate("counterparty.created_at", key, operator, value)
+		case metadataRegex.Match([]byte(key)):
+			return matchMetadata("counterparty.metadata", key, operator, value)
+		default:
+			return "", nil, fmt.Errorf("unknown key '%s' when building query: %w", key, ErrValidation)
+		}
+	}))
+}
+
+func (s *store) CounterpartiesList(ctx context.Context, q ListCounterpartiesQuery) (*paginate.Cursor[models.Counterparty], error) {
+	var (
+		where string
+		args  []any
+		err   error
+	)
+	if q.Options.QueryBuilder != nil {
+		where, args, err = s.counterpartiesQueryContext(q.Options.QueryBuilder)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	orderBy, err := sortOrder(q.Options.Options.Sort, counterpartiesSortColumns, "counterparty.created_at", "counterparty.sort_id")
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := paginateWithOffset[paginate.PaginatedQueryOptions[CounterpartyQuery], counterparty](s, ctx,
+		(*paginate.OffsetPaginatedQuery[paginate.PaginatedQueryOptions[CounterpartyQuery]])(&q),
+		func(query *bun.SelectQuery) *bun.SelectQuery {
+			query = query.Column("id", "created_at", "name", "country", "metadata").
+				ColumnExpr("pgp_sym_decrypt(account_number, ?, ?) AS decrypted_account_number", s.configEncryptionKey, encryptionOptions).
+				ColumnExpr("pgp_sym_decrypt(iban, ?, ?) AS decrypted_iban", s.configEncryptionKey, encryptionOptions).
+				ColumnExpr("pgp_sym_decrypt(swift_bic_code, ?, ?) AS decrypted_swift_bic_code", s.configEncryptionKey, encryptionOptions).
+				ColumnExpr("pgp_sym_decrypt(routing_code, ?, ?) AS decrypted_routing_code", s.configEncryptionKey, encryptionOptions).
+				Relation("RelatedAccounts")
+			if where != "" {
+				query = query.Where(where, args...)
+			}
+
+			query = query.Order(orderBy...)
+
+			return query
+		},
+	)
+	if err != nil {
+		return nil, e("failed to fetch counterparties", err)
+	}
+
+	counterparties := make([]models.Counterparty, 0, len(cursor.Data))
+	for _, c := range cursor.Data {
+		counterparties = append(counterparties, toCounterpartyModels(c))
+	}
+
+	return &paginate.Cursor[models.Counterparty]{
+		PageSize: cursor.PageSize,
+		HasMore:  cursor.HasMore,
+		Previous: cursor.Previous,
+		Next:     cursor.Next,
+		Data:     counterparties,
+	}, nil
+}
+
+func fromCounterpartyModels(from models.Counterparty) counterparty {
+	c := counterparty{
+		ID:        from.ID,
+		CreatedAt: internalTime.New(from.CreatedAt),
+		Name:      from.Name,
+		Country:   from.Country,
+		Metadata:  from.Metadata,
+	}
+
+	relatedAccounts := make([]*counterpartyRelatedAccount, 0, len(from.RelatedAccounts))
+	for _, ra := range from.RelatedAccounts {
+		relatedAccounts = append(relatedAccounts, &counterpartyRelatedAccount{
+			CounterpartyID: from.ID,
+			AccountID:      ra.AccountID,
+			ConnectorID:    ra.AccountID.ConnectorID,
+			CreatedAt:      internalTime.New(ra.CreatedAt),
+		})
+	}
+	c.RelatedAccounts = relatedAccounts
+
+	return c
+}
+
+func toCounterpartyModels(from counterparty) models.Counterparty {
+	c := models.Counterparty{
+		ID:        from.ID,
+		CreatedAt: from.CreatedAt.Time,
+		Name:      from.Name,
+		Country:   from.Country,
+		Metadata:  from.Metadata,
+	}
+
+	if from.AccountNumber != "" {
+		c.AccountNumber = &from.AccountNumber
+	}
+
+	if from.IBAN != "" {
+		c.IBAN = &from.IBAN
+	}
+
+	if from.SwiftBicCode != "" {
+		c.SwiftBicCode = &from.SwiftBicCode
+	}
+
+	if from.RoutingCode != "" {
+		c.RoutingCode = &from.RoutingCode
+	}
+
+	relatedAccounts := make([]models.CounterpartyRelatedAccount, 0, len(from.RelatedAccounts))
+	for _, ra := range from.RelatedAccounts {
+		relatedAccounts = append(relatedAccounts, models.CounterpartyRelatedAccount{
+			AccountID: ra.AccountID,
+			CreatedAt: ra.CreatedAt.Time,
+		})
+	}
+	c.RelatedAccounts = relatedAccounts
+
+	return c
+}
@@ -0,0 +1,369 @@
+package storage
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/observe/log"
+	"github.com/formancehq/go-libs/v5/pkg/query"
+	"github.com/formancehq/go-libs/v5/pkg/storage/bun/paginate"
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const counterpartyIBAN = "FR7630006000011234567890189"
+
+func counterpartyAccounts() []models.Account {
+	return []models.Account{
+		{
+			ID: models.AccountID{
+				Reference:   "ext1",
+				ConnectorID: defaultConnector.ID,
+			},
+			ConnectorID: defaultConnector.ID,
+			Reference:   "ext1",
+			CreatedAt:   now.Add(-60 * time.Minute).UTC().Time,
+			Type:        models.ACCOUNT_TYPE_EXTERNAL,
+			Metadata: map[string]string{
+				models.AccountIBANMetadataKey:               "FR76 3000 6000 0112 3456 7890 189",
+				models.AccountBankAccountNameMetadataKey:    "ACME",
+				models.AccountBankAccountCountryMetadataKey: "FR",
+			},
+			Raw: []byte(`{}`),
+		},
+		{
+			ID: models.AccountID{
+				Reference:   "ext1",
+				ConnectorID: defaultConnector2.ID,
+			},
+			ConnectorID: defaultConnector2.ID,
+			Reference:   "ext1",
+			CreatedAt:   now.Add(-30 * time.Minute).UTC().Time,
+			Type:        models.ACCOUNT_TYPE_EXTERNAL,
+			Name:        pointer.For("Acme Corp"),
+			Metadata: map[string]string{
+				models.AccountIBANMetadataKey: counterpartyIBAN,
+			},
+			Raw: []byte(`{}`),
+		},
+	}
+}
+
+func linkCounterparties(t *testing.T, ctx context.Context, storage Storage) {
+	t.Helper()
+	_, err := storage.CounterpartiesLinkPendingAccounts(ctx, 100)
+	require.NoError(t, err)
+}
+
+func counterpartySecret(storage Storage) string {
+	return storage.(*store).configEncryptionKey
+}
+
+func TestCounterpartiesLinkPendingAccounts(t *testing.T) {
+	t.Parallel()
+
+	ctx := logging.TestingContext()
+	store := newStore(t)
+	defer store.Close()
+
+	upsertConnector(t, ctx, store, defaultConnector)
+	upsertConnector(t, ctx, store, defaultConnector2)
+	upsertAccounts(t, ctx, store, counterpartyAccounts())
+
+	// Storing the accounts does not link them
+	cursor, err := store.CounterpartiesList(ctx, NewListCounterpartiesQuery(
+		paginate.NewPaginatedQueryOptions(CounterpartyQuery{}).WithPageSize(15),
+	))
+	require.NoError(t, err)
+	require.Len(t, cursor.Data, 0)
+
+	n, err := store.CounterpartiesLinkPendingAccounts(ctx, 100)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+
+	id := models.CounterpartyIDFromIBAN(counterpartySecret(store), counterpartyIBAN)
+
+	t.Run("deduplicated across connectors", func(t *testing.T) {
+		c, err := store.CounterpartiesGet(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, "ACME", c.Name)
+		require.Equal(t, counterpartyIBAN, *c.IBAN)
+		require.Equal(t, "FR", *c.Country)
+		require.Nil(t, c.AccountNumber)
+		require.Len(t, c.RelatedAccounts, 2)
+	})
+
+	t.Run("linking is idempotent", func(t *testing.T) {
+		upsertAccounts(t, ctx, store, counterpartyAccounts())
+
+		n, err := store.CounterpartiesLinkPendingAccounts(ctx, 100)
+		require.NoError(t, err)
+		require.Equal(t, 0, n)
+
+		c, err := store.CounterpartiesGet(ctx, id)
+		require.NoError(t, err)
+		require.Len(t, c.RelatedAccounts, 2)
+	})
+
+	t.Run("internal accounts have no counterparty", func(t *testing.T) {
+		upsertAccounts(t, ctx, store, defaultAccounts()[:1])
+		linkCounterparties(t, ctx, store)
+
+		cursor, err := store.CounterpartiesList(ctx, NewListCounterpartiesQuery(
+			paginate.NewPaginatedQueryOptions(CounterpartyQuery{}).
+				WithPageSize(15).
+				WithQueryBuilder(query.Match("account_id", defaultAccounts()[0].ID.String())),
+		))
+		require.NoError(t, err)
+		require.Len(t, cursor.Data, 0)
+	})
+
+	t.Run("deduplicated on account number and routing code", func(t *testing.T) {
+		accounts := []models.Account{
+			{
+				ID: models.AccountID{
+					Reference:   "ext2",
+					ConnectorID: defaultConnector.ID,
+				},
+				ConnectorID: defaultConnector.ID,
+				Reference:   "ext2",
+				CreatedAt:   now.Add(-60 * time.Minute).UTC().Time,
+				Type:        models.ACCOUNT_TYPE_EXTERNAL,
+				Metadata: map[string]string{
+					models.AccountAccountNumberMetadataKey:   "000123456789",
+					models.BankAccountRoutingCodeMetadataKey: "021000021",
+				},
+				Raw: []byte(`{}`),
+			},
+			{
+				ID: models.AccountID{
+					Reference:   "ext2",
+					ConnectorID: defaultConnector2.ID,
+				},
+				ConnectorID: defaultConnector2.ID,
+				Reference:   "ext2",
+				CreatedAt:   now.Add(-30 * time.Minute).UTC().Time,
+				Type:        models.ACCOUNT_TYPE_EXTERNAL,
+				Metadata: map[string]string{
+					models.AccountAccountNumberMetadataKey:   "000 123 456 789",
+					models.BankAccountRoutingCodeMetadataKey: "021000021",
+				},
+				Raw: []byte(`{}`),
+			},
+		}
+		upsertAccounts(t, ctx, store, accounts)
+		linkCounterparties(t, ctx, store)
+
+		c := models.CounterpartyFromExternalAccount(counterpartySecret(store), accounts[0])
+		require.NotNil(t, c)
+
+		got, err := store.CounterpartiesGet(ctx, c.ID)
+		require.NoError(t, err)
+		require.Equal(t, "000123456789", *got.AccountNumber)
+		require.Equal(t, "021000021", *got.RoutingCode)
+		require.Nil(t, got.IBAN)
+		require.Len(t, got.RelatedAccounts, 2)
+	})
+
+	t.Run("payments stored before the linking are linked afterwards", func(t *testing.T) {
+		// Without bank details, the account is deduplicated by its reference
+		account := models.Account{
+			ID: models.AccountID{
+				Reference:   "ext3",
+				ConnectorID: defaultConnector.ID,
+			},
+			ConnectorID: defaultConnector.ID,
+			Reference:   "ext3",
+			CreatedAt:   now.Add(-60 * time.Minute).UTC().Time,
+			Type:        models.ACCOUNT_TYPE_EXTERNAL,
+			Raw:         []byte(`{}`),
+		}
+		upsertAccounts(t, ctx, store, []models.Account{account})
+
+		paymentID := models.PaymentID{
+			PaymentReference: models.PaymentReference{Reference: "cp2", Type: models.PAYMENT_TYPE_PAYOUT},
+			ConnectorID:      defaultConnector.ID,
+		}
+		upsertPayments(t, ctx, store, []models.Payment{
+			{
+				ID:                   paymentID,
+				ConnectorID:          defaultConnector.ID,
+				Reference:            "cp2",
+				CreatedAt:            now.Add(-10 * time.Minute).UTC().Time,
+				Type:                 models.PAYMENT_TYPE_PAYOUT,
+				InitialAmount:        big.NewInt(100),
+				Amount:               big.NewInt(100),
+				Asset:                "EUR/2",
+				Scheme:               models.PAYMENT_SCHEME_SEPA,
+				Status:               models.PAYMENT_STATUS_SUCCEEDED,
+				DestinationAccountID: &account.ID,
+			},
+		})
+
+		p, err := store.PaymentsGet(ctx, paymentID)
+		require.NoError(t, err)
+		require.Nil(t, p.CounterpartyID)
+
+		linkCounterparties(t, ctx, store)
+
+		p, err = store.PaymentsGet(ctx, paymentID)
+		require.NoError(t, err)
+		require.NotNil(t, p.CounterpartyID)
+		require.Equal(t, models.CounterpartyFromExternalAccount(counterpartySecret(store), account).ID, *p.CounterpartyID)
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		accounts := []models.Account{}
+		for _, reference := range []string{"ext4", "ext5"} {
+			accounts = append(accounts, models.Account{
+				ID: models.AccountID{
+					Reference:   reference,
+					ConnectorID: defaultConnector.ID,
+				},
+				ConnectorID: defaultConnector.ID,
+				Reference:   reference,
+				CreatedAt:   now.Add(-60 * time.Minute).UTC().Time,
+				Type:        models.ACCOUNT_TYPE_EXTERNAL,
+				Raw:         []byte(`{}`),
+			})
+		}
+		upsertAccounts(t, ctx, store, accounts)
+
+		n, err := store.CounterpartiesLinkPendingAccounts(ctx, 1)
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+
+		n, err = store.CounterpartiesLinkPendingAccounts(ctx, 1)
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+
+		n, err = store.CounterpartiesLinkPendingAccounts(ctx, 1)
+		require.NoError(t, err)
+		require.Equal(t, 0, n)
+	})
+
+	t.Run("get unknown counterparty", func(t *testing.T) {
+		_, err := store.CounterpartiesGet(ctx, uuid.New())
+		require.Error(t, err)
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestCounterpartiesList(t *testing.T) {
+	t.Parallel()
+
+	ctx := logging.TestingContext()
+	store := newStore(t)
+	defer store.Close()
+
+	upsertConnector(t, ctx, store, defaultConnector)
+	upsertConnector(t, ctx, store, defaultConnector2)
+	upsertAccounts(t, ctx, store, counterpartyAccounts())
+	// test3 is an external account without bank details, its counterparty is
+	// deduplicated by its reference.
+	upsertAccounts(t, ctx, store, defaultAccounts())
+	linkCounterparties(t, ctx, store)
+
+	id := models.CounterpartyIDFromIBAN(counterpartySecret(store), counterpartyIBAN)
+
+	t.Run("list all counterparties", func(t *testing.T) {
+		cursor, err := store.CounterpartiesList(ctx, NewListCounterpartiesQuery(
+			paginate.NewPaginatedQueryOptions(CounterpartyQuery{}).WithPageSize(15),
+		))
+		require.NoError(t, err)
+		require.Len(t, cursor.Data, 2)
+		require.False(t, cursor.HasMore)
+	})
+
+	t.Run("list counterparties by name prefix", func(t *testing.T) {
+		cursor, err := store.CounterpartiesList(ctx, NewListCounterpartiesQuery(
+			paginate.NewPaginatedQueryOptions(CounterpartyQuery{}).
+				WithPageSize(15).
+				WithQueryBuilder(query.Like("name", "acm%")),
+		))
+		require.NoError(t, err)
+		require.Len(t, cursor.Data, 1)
+		require.Equal(t, id, cursor.Data[0].ID)
+	})
+
+	t.Run("list counterparties by iban", func(t *testing.T) {
+		cursor, err := store.CounterpartiesList(ctx, NewListCounterpartiesQuery(
+			paginate.NewPaginatedQueryOptions(CounterpartyQuery{}).
+				WithPageSize(15).
+				WithQueryBuilder(query.Match("iban", "fr76 3000 6000 0112 3456 7890 189")),
+		))
+		require.NoError(t, err)
+		require.Len(t, cursor.Data, 1)
+		require.Equal(t, id, cursor.Data[0].ID)
+	})
+
+	t.Run("list counterparties by connector_id", func(t *testing.T) {
+		cursor, err := store.CounterpartiesList(ctx, NewListCounterpartiesQuery(
+			paginate.NewPaginatedQueryOptions(CounterpartyQuery{}).
+				WithPageSize(15).
+				WithQueryBuilder(query.Match("connector_id", defaultConnector2.ID.String())),
+		))
+		require.NoError(t, err)
+		require.Len(t, cursor.Data, 1)
+		require.Equal(t, id, cursor.Data[0].ID)
+	})
+
+	t.Run("wrong query builder operator when listing by iban", func(t *testing.T) {
+		cursor, err := store.CounterpartiesList(ctx, NewListCounterpartiesQuery(
+			paginate.NewPaginatedQueryOptions(CounterpartyQuery{}).
+				WithPageSize(15).
+				WithQueryBuilder(query.Like("iban", "FR76%")),
+		))
+		require.Error(t, err)
+		require.Nil(t, cursor)
+		assert.True(t, errors.Is(err, ErrValidation))
+	})
+
+	t.Run("unknown query builder key when listing", func(t *testing.T) {
+		cursor, err := store.CounterpartiesList(ctx, NewListCounterpartiesQuery(
+			paginate.NewPaginatedQueryOptions(CounterpartyQuery{}).
+				WithPageSize(15).
+				WithQueryBuilder(query.Match("unknown", "unknown")),
+		))
+		require.Error(t, err)
+		require.Nil(t, cursor)
+	})
+
+	t.Run("list payments by counterparty", func(t *testing.T) {
+		paymentID := models.PaymentID{
+			PaymentReference: models.PaymentReference{Reference: "cp1", Type: models.PAYMENT_TYPE_PAYOUT},
+			ConnectorID:      defaultConnector2.ID,
+		}
+		upsertPayments(t, ctx, store, []models.Payment{
+			{
+				ID:                   paymentID,
+				ConnectorID:          defaultConnector2.ID,
+				Reference:            "cp1",
+				CreatedAt:            now.Add(-10 * time.Minute).UTC().Time,
+				Type:                 models.PAYMENT_TYPE_PAYOUT,
+				InitialAmount:        big.NewInt(100),
+				Amount:               big.NewInt(100),
+				Asset:                "EUR/2",
+				Scheme:               models.PAYMENT_SCHEME_SEPA,
+				Status:               models.PAYMENT_STATUS_SUCCEEDED,
+				DestinationAccountID: &counterpartyAccounts()[1].ID,
+			},
+		})
+
+		cursor, err := store.PaymentsList(ctx, NewListPaymentsQuery(
+			paginate.NewPaginatedQueryOptions(PaymentQuery{}).
+				WithPageSize(15).
+				WithQueryBuilder(query.Match("counterparty_id", id.String())),
+		))
+		require.NoError(t, err)
+		require.Len(t, cursor.Data, 1)
+		require.Equal(t, paymentID, cursor.Data[0].ID)
+		require.Equal(t, id, *cursor.Data[0].CounterpartyID)
+	})
+}
@@ -0,0 +1,288 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/query"
+	"github.com/formancehq/go-libs/v5/pkg/storage/bun/paginate"
+	internalTime "github.com/formancehq/go-libs/v5/pkg/types/time"
+	internalEvents "github.com/formancehq/payments/internal/events"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/formancehq/payments/pkg/events"
+	"github.com/uptrace/bun"
+)
+
+type dispute struct {
+	bun.BaseModel `bun:"table:disputes"`
+
+	SortID int64 `bun:"sort_id,autoincrement"`
+
+	// Mandatory fields
+	ID          models.DisputeID     `bun:"id,pk,type:character varying,notnull"`
+	ConnectorID models.ConnectorID   `bun:"connector_id,type:character varying,notnull"`
+	Reference   string               `bun:"reference,type:text,notnull"`
+	CreatedAt   internalTime.Time    `bun:"created_at,type:timestamp without time zone,notnull"`
+	UpdatedAt   internalTime.Time    `bun:"updated_at,type:timestamp without time zone,notnull"`
+	Amount      *big.Int             `bun:"amount,type:numeric,notnull"`
+	Asset       string               `bun:"asset,type:text,notnull"`
+	Status      models.DisputeStatus `bun:"status,type:text,notnull"`
+
+	// Optional fields
+	PaymentID       *models.PaymentID  `bun:"payment_id,type:character varying,nullzero"`
+	Reason          string             `bun:"reason,type:text,nullzero"`
+	EvidenceDueDate *internalTime.Time `bun:"evidence_due_date,type:timestamp without time zone,nullzero"`
+
+	// Optional fields with default
+	Metadata map[string]string `bun:"metadata,type:jsonb,nullzero,notnull,default:'{}'"`
+
+	// Raw PSP response
+	Raw json.RawMessage `bun:"raw,type:json,notnull"`
+}
+
+func (s *store) DisputesUpsert(ctx context.Context, disputes []models.Dispute) error {
+	disputesToInsert := make([]dispute, 0, len(disputes))
+
+	for _, d := range disputes {
+		disputesToInsert = append(disputesToInsert, fromDisputeModels(d))
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return e("failed to create transaction", err)
+	}
+	defer func() {
+		rollbackOnTxError(ctx, &tx, err)
+	}()
+
+	if len(disputesToInsert) > 0 {
+		_, err = tx.NewInsert().
+			Model(&disputesToInsert).
+			On("CONFLICT (id) DO UPDATE").
+			Set("updated_at = EXCLUDED.updated_at").
+			Set("amount = EXCLUDED.amount").
+			Set("status = EXCLUDED.status").
+			Set("reason = EXCLUDED.reason").
+			Set("evidence_due_date = EXCLUDED.evidence_due_date").
+			Set("payment_id = COALESCE(EXCLUDED.payment_id, dispute.payment_id)").
+			Set("metadata = dispute.metadata || EXCLUDED.metadata").
+			Set("raw = EXCLUDED.raw").
+			Exec(ctx)
+		if err != nil {
+			return e("failed to insert disputes", err)
+		}
+	}
+
+	// Create outbox events in the same transaction. The idempotency key is
+	// derived from the dispute status, so a new event is only published when
+	// the dispute moves to another status.
+	outboxEvents := make([]models.OutboxEvent, 0, len(disputes))
+	for _, d := range disputes {
+		evtMsg := internalEvents.Events{}.NewEventSavedDispute(d)
+		var payloadBytes []byte
+		payloadBytes, err = json.Marshal(evtMsg.Payload)
+		if err != nil {
+			return e("failed to marshal dispute event payload", err)
+		}
+
+		connectorID := d.ConnectorID
+		outboxEvents = append(outboxEvents, models.OutboxEvent{
+			ID: models.EventID{
+				EventIdempotencyKey: d.IdempotencyKey(),
+				ConnectorID:         &connectorID,
+			},
+			EventType:   events.EventTypeSavedDispute,
+			EntityID:    d.ID.String(),
+			Payload:     payloadBytes,
+			CreatedAt:   time.Now().UTC(),
+			Status:      models.OUTBOX_STATUS_PENDING,
+			ConnectorID: &connectorID,
+		})
+	}
+
+	if len(outboxEvents) > 0 {
+		if err = s.OutboxEventsInsert(ctx, tx, outboxEvents); err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return e("failed to commit transaction", err)
+	}
+
+	return nil
+}
+
+func (s *store) DisputesGet(ctx context.Context, id models.DisputeID) (*models.Dispute, error) {
+	var d dispute
+	err := s.db.NewSelect().
+		Model(&d).
+		Where("id = ?", id).
+		Scan(ctx)
+	if err != nil {
+		return nil, e("failed to get dispute", err)
+	}
+
+	res := toDisputeModels(d)
+	return &res, nil
+}
+
+func (s *store) DisputesDeleteFromConnectorID(ctx context.Context, connectorID models.ConnectorID) error {
+	_, err := s.db.NewDelete().
+		Model((*dispute)(nil)).
+		Where("connector_id = ?", connectorID).
+		Exec(ctx)
+
+	return e("failed to delete disputes", err)
+}
+
+type DisputeQuery struct {
+	Sort []SortKey `json:"sort,omitempty"`
+}
+
+type ListDisputesQuery paginate.OffsetPaginatedQuery[paginate.PaginatedQueryOptions[DisputeQuery]]
+
+func NewListDisputesQuery(opts paginate.PaginatedQueryOptions[DisputeQuery]) ListDisputesQuery {
+	return ListDisputesQuery{
+		PageSize: opts.PageSize,
+		Order:    paginate.OrderAsc,
+		Options:  opts,
+	}
+}
+
+var disputesSortColumns = map[string]string{
+	"created_at": "dispute.created_at",
+	"updated_at": "dispute.updated_at",
+	"reference":  "dispute.reference",
+	"amount":     "dispute.amount",
+}
+
+func (s *store) disputesQueryContext(qb query.Builder) (string, []any, error) {
+	where, args, err := qb.Build(query.ContextFn(func(key, operator string, value any) (string, []any, error) {
+		switch {
+		case key == "reference":
+			return matchText("dispute.reference", key, operator, value)
+		case key == "id",
+			key == "connector_id",
+			key == "payment_id",
+			key == "asset",
+			key == "status",
+			key == "reason":
+			return matchEqual("dispute."+key, key, operator, value)
+		case key == "created_at",
+			key == "updated_at":
+			return matchDate("dispute."+key, key, operator, value)
+
+		case key == "amount",
+			key == "evidence_due_date":
+			return fmt.Sprintf("dispute.%s %s ?", key, query.DefaultComparisonOperatorsMapping[operator]), []any{value}, nil
+		case metadataRegex.Match([]byte(key)):
+			return matchMetadata("dispute.metadata", key, operator, value)
+		default:
+			return "", nil, fmt.Errorf("unknown key '%s' when building query: %w", key, ErrValidation)
+		}
+	}))
+
+	return where, args, err
+}
+
+func (s *store) DisputesList(ctx context.Context, q ListDisputesQuery) (*paginate.Cursor[models.Dispute], error) {
+	var (
+		where string
+		args  []any
+		err   error
+	)
+	if q.Options.QueryBuilder != nil {
+		where, args, err = s.disputesQueryContext(q.Options.QueryBuilder)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	orderBy, err := sortOrder(q.Options.Options.Sort, disputesSortColumns, "dispute.created_at", "dispute.sort_id")
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := paginateWithOffset[paginate.PaginatedQueryOptions[DisputeQuery], dispute](s, ctx,
+		(*paginate.OffsetPaginatedQuery[paginate.PaginatedQueryOptions[DisputeQuery]])(&q),
+		func(query *bun.SelectQuery) *bun.SelectQuery {
+			if where != "" {
+				query = query.Where(where, args...)
+			}
+
+			query = query.Order(orderBy...)
+
+			return query
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	disputes := make([]models.Dispute, 0, len(cursor.Data))
+	for _, d := range cursor.Data {
+		disputes = append(disputes, toDisputeModels(d))
+	}
+
+	return &paginate.Cursor[models.Dispute]{
+		PageSize: cursor.PageSize,
+		HasMore:  cursor.HasMore,
+		Previous: cursor.Previous,
+		Next:     cursor.Next,
+		Data:     disputes,
+	}, nil
+}
+
+func fromDisputeModels(from models.Dispute) dispute {
+	d := dispute{
+		ID:          from.ID,
+		ConnectorID: from.ConnectorID,
+		Reference:   from.Reference,
+		CreatedAt:   internalTime.New(from.CreatedAt),
+		UpdatedAt:   internalTime.New(from.UpdatedAt),
+		Amount:      from.Amount,
+		Asset:       from.Asset,
+		Status:      from.Status,
+		PaymentID:   from.PaymentID,
+		Reason:      from.Reason,
+		Metadata:    from.Metadata,
+		Raw:         from.Raw,
+	}
+
+	if from.EvidenceDueDate != nil {
+		evidenceDueDate := internalTime.New(*from.EvidenceDueDate)
+		d.EvidenceDueDate = &evidenceDueDate
+	}
+
+	return d
+}
+
+func toDisputeModels(from dispute) models.Dispute {
+	d := models.Dispute{
+		ID:          from.ID,
+		ConnectorID: from.ConnectorID,
+		Reference:   from.Reference,
+		CreatedAt:   from.CreatedAt.Time,
+		UpdatedAt:   from.UpdatedAt.Time,
+		PaymentID:   from.PaymentID,
+		Amount:      from.Amount,
+		Asset:       from.Asset,
+		Status:      from.Status,
+		Reason:      from.Reason,
+		Metadata:    from.Metadata,
+		Raw:         from.Raw,
+	}
+
+	if from.EvidenceDueDate != nil {
+		evidenceDueDate := from.EvidenceDueDate.Time
+		d.EvidenceDueDate = &evidenceDueDate
+	}
+
+	return d
+}
@@ -0,0 +1,339 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/observe/log"
+	"github.com/formancehq/go-libs/v5/pkg/query"
+	"github.com/formancehq/go-libs/v5/pkg/storage/bun/paginate"
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/formancehq/payments/pkg/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDisputesUpsert(t *testing.T) {
+	t.Parallel()
+
+	ctx := logging.TestingContext()
+	store := newStore(t)
+
+	upsertConnector(t, ctx, store, defaultConnector)
+
+	t.Run("outbox event created atomically with dispute", func(t *testing.T) {
+		defer cleanupOutboxHelper(ctx, store)()
+
+		disputeID := models.DisputeID{
+			Reference:   "dp-test-1",
+			ConnectorID: defaultConnector.ID,
+		}
+
+		d := models.Dispute{
+			ID:              disputeID,
+			ConnectorID:     defaultConnector.ID,
+			Reference:       "dp-test-1",
+			CreatedAt:       now.Add(-60 * time.Minute).UTC().Time,
+			UpdatedAt:       now.Add(-5 * time.Minute).UTC().Time,
+			Amount:          big.NewInt(10000),
+			Asset:           "USD/2",
+			Status:          models.DISPUTE_STATUS_NEEDS_RESPONSE,
+			Reason:          "fraudulent",
+			EvidenceDueDate: pointer.For(now.Add(7 * 24 * time.Hour).UTC().Time),
+			Metadata:        map[string]string{},
+			Raw:             []byte(`{"test": "data"}`),
+		}
+
+		require.NoError(t, store.DisputesUpsert(ctx, []models.Dispute{d}))
+
+		stored, err := store.DisputesGet(ctx, disputeID)
+		require.NoError(t, err)
+		assert.Equal(t, disputeID, stored.ID)
+		assert.Equal(t, models.DISPUTE_STATUS_NEEDS_RESPONSE, stored.Status)
+		require.NotNil(t, stored.EvidenceDueDate)
+
+		pendingEvents, err := store.OutboxEventsPollPending(ctx, 100)
+		require.NoError(t, err)
+
+		expectedKey := d.IdempotencyKey()
+		var found bool
+		for _, event := range pendingEvents {
+			if event.ID.EventIdempotencyKey == expectedKey {
+				found = true
+				assert.Equal(t, events.EventTypeSavedDispute, event.EventType)
+				assert.Equal(t, disputeID.String(), event.EntityID)
+				assert.Equal(t, models.OUTBOX_STATUS_PENDING, event.Status)
+				assert.Equal(t, defaultConnector.ID, *event.ConnectorID)
+
+				var payload map[string]interface{}
+				err = json.Unmarshal(event.Payload, &payload)
+				require.NoError(t, err)
+				assert.Equal(t, d.ID.String(), payload["id"])
+				assert.Equal(t, d.Status.String(), payload["status"])
+				break
+			}
+		}
+		assert.True(t, found, "outbox event must exist for new dispute")
+	})
+
+	t.Run("one event per status change", func(t *testing.T) {
+		defer cleanupOutboxHelper(ctx, store)()
+
+		disputeID := models.DisputeID{
+			Reference:   "dp-test-2",
+			ConnectorID: defaultConnector.ID,
+		}
+
+		d := models.Dispute{
+			ID:          disputeID,
+			ConnectorID: defaultConnector.ID,
+			Reference:   "dp-test-2",
+			CreatedAt:   now.Add(-60 * time.Minute).UTC().Time,
+			UpdatedAt:   now.Add(-5 * time.Minute).UTC().Time,
+			Amount:      big.NewInt(5000),
+			Asset:       "EUR/2",
+			Status:      models.DISPUTE_STATUS_NEEDS_RESPONSE,
+			Metadata:    map[string]string{},
+			Raw:         []byte(`{}`),
+		}
+
+		require.NoError(t, store.DisputesUpsert(ctx, []models.Dispute{d}))
+
+		// Same status: deduplicated
+		d.UpdatedAt = now.Add(-4 * time.Minute).UTC().Time
+		require.NoError(t, store.DisputesUpsert(ctx, []models.Dispute{d}))
+
+		// New status: new event
+		d.UpdatedAt = now.Add(-3 * time.Minute).UTC().Time
+		d.Status = models.DISPUTE_STATUS_WON
+		require.NoError(t, store.DisputesUpsert(ctx, []models.Dispute{d}))
+
+		stored, err := store.DisputesGet(ctx, disputeID)
+		require.NoError(t, err)
+		assert.Equal(t, models.DISPUTE_STATUS_WON, stored.Status)
+
+		pendingEvents, err := store.OutboxEventsPollPending(ctx, 100)
+		require.NoError(t, err)
+
+		disputeEvents := make([]models.OutboxEvent, 0)
+		for _, event := range pendingEvents {
+			if event.EventType == events.EventTypeSavedDispute && event.EntityID == disputeID.String() {
+				disputeEvents = append(disputeEvents, event)
+			}
+		}
+		require.Len(t, disputeEvents, 2, "expected one outbox event per status")
+	})
+}
+
+func defaultDisputes() []models.Dispute {
+	paymentID := models.PaymentID{
+		PaymentReference: models.PaymentReference{Reference: "txn-1", Type: models.PAYMENT_TYPE_PAYIN},
+		ConnectorID:      defaultConnector.ID,
+	}
+
+	return []models.Dispute{
+		{
+			ID:              models.DisputeID{Reference: "dp-1", ConnectorID: defaultConnector.ID},
+			ConnectorID:     defaultConnector.ID,
+			Reference:       "dp-1",
+			CreatedAt:       now.Add(-60 * time.Minute).UTC().Time,
+			UpdatedAt:       now.Add(-50 * time.Minute).UTC().Time,
+			PaymentID:       &paymentID,
+			Amount:          big.NewInt(10000),
+			Asset:           "USD/2",
+			Status:          models.DISPUTE_STATUS_NEEDS_RESPONSE,
+			Reason:          "fraudulent",
+			EvidenceDueDate: pointer.For(now.Add(24 * time.Hour).UTC().Time),
+			Metadata:        map[string]string{"key1": "value1"},
+			Raw:             []byte(`{}`),
+		},
+		{
+			ID:          models.DisputeID{Reference: "dp-2", ConnectorID: defaultConnector.ID},
+			ConnectorID: defaultConnector.ID,
+			Reference:   "dp-2",
+			CreatedAt:   now.Add(-30 * time.Minute).UTC().Time,
+			UpdatedAt:   now.Add(-20 * time.Minute).UTC().Time,
+			Amount:      big.NewInt(5000),
+			Asset:       "EUR/2",
+			Status:      models.DISPUTE_STATUS_LOST,
+			Reason:      "product_not_received",
+			Metadata:    map[string]string{"key2": "value2"},
+			Raw:         []byte(`{}`),
+		},
+	}
+}
+
+func upsertDisputes(t *testing.T, ctx context.Context, store Storage, disputes []models.Dispute) {
+	t.Helper()
+	require.NoError(t, store.DisputesUpsert(ctx, disputes))
+}
+
+func TestDisputesGet(t *testing.T) {
+	t.Parallel()
+
+	ctx := logging.TestingContext()
+	store := newStore(t)
+
+	upsertConnector(t, ctx, store, defaultConnector)
+	disputes := defaultDisputes()
+	upsertDisputes(t, ctx, store, disputes)
+
+	t.Run("get unknown dispute", func(t *testing.T) {
+		unknownID := models.DisputeID{Reference: "unknown", ConnectorID: defaultConnector.ID}
+		_, err := store.DisputesGet(ctx, unknownID)
+		require.Error(t, err)
+	})
+
+	t.Run("get existing dispute", func(t *testing.T) {
+		for _, expected := range disputes {
+			stored, err := store.DisputesGet(ctx, expected.ID)
+			require.NoError(t, err)
+
+			assert.Equal(t, expected.ID, stored.ID)
+			assert.Equal(t, expected.ConnectorID, stored.ConnectorID)
+			assert.Equal(t, expected.Reference, stored.Reference)
+			assert.Equal(t, expected.PaymentID, stored.PaymentID)
+			assert.Equal(t, expected.Asset, stored.Asset)
+			assert.Equal(t, expected.Status, stored.Status)
+			assert.Equal(t, expected.Reason, stored.Reason)
+			assert.Equal(t, 0, expected.Amount.Cmp(stored.Amount))
+		}
+	})
+}
+
+func TestDisputesList(t *testing.T) {
+	t.Parallel()
+
+	ctx := logging.TestingContext()
+	store := newStore(t)
+
+	upsertConnector(t, ctx, store, defaultConnector)
+	disputes := defaultDisputes()
+	upsertDisputes(t, ctx, store, disputes)
+
+	t.Run("list all disputes", func(t *testing.T) {
+		q := NewListDisputesQuery(paginate.NewPaginatedQueryOptions(DisputeQuery{}).WithPageSize(10))
+		cursor, err := store.DisputesList(ctx, q)
+		require.NoError(t, err)
+		assert.Len(t, cursor.Data, 2)
+	})
+
+	t.Run("filter by status", func(t *testing.T) {
+		q := NewListDisputesQuery(
+			paginate.NewPaginatedQueryOptions(DisputeQuery{}).
+				WithPageSize(10).
+				WithQueryBuilder(query.Match("status", "NEEDS_RESPONSE")),
+		)
+		cursor, err := store.DisputesList(ctx, q)
+		require.NoError(t, err)
+		require.Len(t, cursor.Data, 1)
+		assert.Equal(t, "dp-1", cursor.Data[0].Reference)
+	})
+
+	t.Run("filter by status with invalid operator", func(t *testing.T) {
+		q := NewListDisputesQuery(
+			paginate.NewPaginatedQueryOptions(DisputeQuery{}).
+				WithPageSize(10).
+				WithQueryBuilder(query.Lt("status", "WON")),
+		)
+		_, err := store.DisputesList(ctx, q)
+		require.Error(t, err)
+	})
+
+	t.Run("filter by payment_id", func(t *testing.T) {
+		q := NewListDisputesQuery(
+			paginate.NewPaginatedQueryOptions(DisputeQuery{}).
+				WithPageSize(10).
+				WithQueryBuilder(query.Match("payment_id", disputes[0].PaymentID.String())),
+		)
+		cursor, err := store.DisputesList(ctx, q)
+		require.NoError(t, err)
+		require.Len(t, cursor.Data, 1)
+		assert.Equal(t, "dp-1", cursor.Data[0].Reference)
+	})
+
+	t.Run("filter by evidence_due_date", func(t *testing.T) {
+		q := NewListDisputesQuery(
+			paginate.NewPaginatedQueryOptions(DisputeQuery{}).
+				WithPageSize(10).
+				WithQueryBuilder(query.Lt("evidence_due_date", now.Add(48*time.Hour).UTC().Time)),
+		)
+		cursor, err := store.DisputesList(ctx, q)
+		require.NoError(t, err)
+		require.Len(t, cursor.Data, 1)
+		assert.Equal(t, "dp-1", cursor.Data[0].Reference)
+	})
+
+	t.Run("filter by metadata", func(t *testing.T) {
+		q := NewListDisputesQuery(
+			paginate.NewPaginatedQueryOptions(DisputeQuery{}).
+				WithPageSize(10).
+				WithQueryBuilder(query.Match("metadata[key2]", "value2")),
+		)
+		cursor, err := store.DisputesList(ctx, q)
+		require.NoError(t, err)
+		require.Len(t, cursor.Data, 1)
+		assert.Equal(t, "dp-2", cursor.Data[0].Reference)
+	})
+
+	t.Run("filter by unknown key", func(t *testing.T) {
+		q := NewListDisputesQuery(
+			paginate.NewPaginatedQueryOptions(DisputeQuery{}).
+				WithPageSize(10).
+				WithQueryBuilder(query.Match("unknown_field", "value")),
+		)
+		_, err := store.DisputesList(ctx, q)
+		require.Error(t, err)
+	})
+
+	t.Run("pagination", func(t *testing.T) {
+		q := NewListDisputesQuery(paginate.NewPaginatedQueryOptions(DisputeQuery{}).WithPageSize(1))
+		cursor, err := store.DisputesList(ctx, q)
+		require.NoError(t, err)
+		require.Len(t, cursor.Data, 1)
+		assert.True(t, cursor.HasMore)
+
+		var next ListDisputesQuery
+		err = paginate.UnmarshalCursor(cursor.Next, &next)
+		require.NoError(t, err)
+		cursor, err = store.DisputesList(ctx, next)
+		require.NoError(t, err)
+		require.Len(t, cursor.Data, 1)
+		assert.False(t, cursor.HasMore)
+	})
+}
+
+func TestDisputesDeleteFromConnectorID(t *testing.T) {
+	t.Parallel()
+
+	ctx := logging.TestingContext()
+	store := newStore(t)
+
+	upsertConnector(t, ctx, store, defaultConnector)
+	disputes := defaultDisputes()
+	upsertDisputes(t, ctx, store, disputes)
+
+	t.Run("delete from unknown connector", func(t *testing.T) {
+		unknownConnID := models.ConnectorID{Reference: defaultConnector.ID.Reference, Provider: "unknown"}
+		require.NoError(t, store.DisputesDeleteFromConnectorID(ctx, unknownConnID))
+
+		for _, d := range disputes {
+			_, err := store.DisputesGet(ctx, d.ID)
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("delete from existing connector", func(t *testing.T) {
+		require.NoError(t, store.DisputesDeleteFromConnectorID(ctx, defaultConnector.ID))
+
+		for _, d := range disputes {
+			_, err := store.DisputesGet(ctx, d.ID)
+			require.Error(t, err, fmt.Sprintf("dispute %s should have been deleted", d.ID.String()))
+		}
+	})
+}
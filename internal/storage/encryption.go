@@ -11,11 +11,22 @@ import (
 // EncryptRaw encrypts a JSON payload using Postgres pgcrypto and the storage encryption key.
 // It mirrors the encryption performed in other storage methods (e.g., connectors install).
 func (s *store) EncryptRaw(ctx context.Context, message json.RawMessage) (json.RawMessage, error) {
+	return s.encryptRaw(ctx, message, s.configEncryptionKey)
+}
+
+// EncryptRawWithKey encrypts a JSON payload like EncryptRaw, but with the
+// given key. It is used to export data which is only decrypted by another
+// environment, having its own encryption key.
+func (s *store) EncryptRawWithKey(ctx context.Context, message json.RawMessage, key string) (json.RawMessage, error) {
+	return s.encryptRaw(ctx, message, key)
+}
+
+func (s *store) encryptRaw(ctx context.Context, message json.RawMessage, key string) (json.RawMessage, error) {
 	// Use a simple SELECT to leverage pgp_sym_encrypt with consistent options
 	// We encrypt the JSON as TEXT to match existing patterns
 	var cipher []byte
 	// bun.NewRaw with positional args; we cast to text in the SQL expression
-	if err := s.db.NewRaw("SELECT pgp_sym_encrypt(?::TEXT, ?::TEXT, ?::TEXT)", string(message), s.configEncryptionKey, encryptionOptions).Scan(ctx, &cipher); err != nil {
+	if err := s.db.NewRaw("SELECT pgp_sym_encrypt(?::TEXT, ?::TEXT, ?::TEXT)", string(message), key, encryptionOptions).Scan(ctx, &cipher); err != nil {
 		return nil, err
 	}
 	// Base64-encode the binary ciphertext so it can be safely marshaled as JSON
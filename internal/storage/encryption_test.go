@@ -32,6 +32,22 @@ func TestEncryptDecryptRaw(t *testing.T) {
 	require.Equal(t, string(plain), string(back))
 }
 
+func TestEncryptRawWithKey(t *testing.T) {
+	t.Parallel()
+
+	st := newStore(t)
+
+	plain := json.RawMessage(`"secret"`)
+
+	cipher, err := st.EncryptRawWithKey(context.Background(), plain, "another-key")
+	require.NoError(t, err)
+	require.NotEqual(t, string(plain), string(cipher))
+
+	// Only the owner of the other key is able to decrypt it
+	_, err = st.DecryptRaw(context.Background(), cipher)
+	require.Error(t, err)
+}
+
 func TestDecryptRaw_NotJSONString(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,32 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+)
+
+// WithLock runs fn while holding the Postgres advisory lock of the given
+// name, waiting for the other holders to release it first. The lock is
+// shared by every instance using the same database, and released when fn
+// returns or the session ends.
+func (s *store) WithLock(ctx context.Context, name string, fn func(ctx context.Context) error) error {
+	// Session level advisory locks belong to a connection, keep the same one
+	// from the lock to the unlock.
+	conn, err := s.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot get connection: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock(hashtext(?))", name); err != nil {
+		return e("failed to acquire lock", err)
+	}
+	defer func() {
+		// Use a fresh context, the lock must be released even if ctx is done
+		if _, err := conn.ExecContext(context.WithoutCancel(ctx), "SELECT pg_advisory_unlock(hashtext(?))", name); err != nil {
+			s.logger.Errorf("failed to release lock %s: %v", name, err)
+		}
+	}()
+
+	return fn(ctx)
+}
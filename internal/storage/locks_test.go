@@ -0,0 +1,61 @@
+package storage
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithLock(t *testing.T) {
+	t.Parallel()
+
+	st := newStore(t)
+	ctx := context.Background()
+
+	t.Run("holders run one at a time", func(t *testing.T) {
+		var (
+			mu      sync.Mutex
+			running int
+			maxSeen int
+			wg      sync.WaitGroup
+		)
+
+		for i := 0; i < 3; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				err := st.WithLock(ctx, "test", func(ctx context.Context) error {
+					mu.Lock()
+					running++
+					maxSeen = max(maxSeen, running)
+					mu.Unlock()
+
+					time.Sleep(50 * time.Millisecond)
+
+					mu.Lock()
+					running--
+					mu.Unlock()
+					return nil
+				})
+				require.NoError(t, err)
+			}()
+		}
+		wg.Wait()
+
+		require.Equal(t, 1, maxSeen)
+	})
+
+	t.Run("released on error", func(t *testing.T) {
+		err := st.WithLock(ctx, "error", func(ctx context.Context) error {
+			return context.Canceled
+		})
+		require.ErrorIs(t, err, context.Canceled)
+
+		ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		require.NoError(t, st.WithLock(ctx, "error", func(ctx context.Context) error { return nil }))
+	})
+}
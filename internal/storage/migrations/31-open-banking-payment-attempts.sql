@@ -0,0 +1,38 @@
+create table if not exists open_banking_payment_attempts (
+    sort_id bigserial not null,
+
+    -- Mandatory fields
+    id uuid not null,
+    psu_id uuid not null,
+    connector_id varchar not null,
+    payment_initiation_id varchar not null,
+    created_at timestamp without time zone not null,
+    status text not null,
+
+    -- Optional fields
+    client_redirect_url text,
+    payment_request_reference text,
+    state jsonb,
+    error text,
+
+    -- Primary key
+    primary key (id)
+);
+
+create index open_banking_payment_attempts_created_at_sort_id on open_banking_payment_attempts (created_at, sort_id);
+create index open_banking_payment_attempts_psu_id on open_banking_payment_attempts (psu_id);
+create unique index open_banking_payment_attempts_payment_request_reference on open_banking_payment_attempts (connector_id, payment_request_reference);
+alter table open_banking_payment_attempts
+    add constraint open_banking_payment_attempts_connector_id_fk foreign key (connector_id)
+    references connectors (id)
+    on delete cascade;
+
+alter table open_banking_payment_attempts
+    add constraint open_banking_payment_attempts_psu_id_fk foreign key (psu_id)
+    references payment_service_users (id)
+    on delete cascade;
+
+alter table open_banking_payment_attempts
+    add constraint open_banking_payment_attempts_payment_initiation_id_fk foreign key (payment_initiation_id)
+    references payment_initiations (id)
+    on delete cascade;
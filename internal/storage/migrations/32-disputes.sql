@@ -0,0 +1,37 @@
+create table disputes (
+    -- Autoincrement fields
+    sort_id bigserial not null,
+
+    -- Mandatory fields
+    id             varchar not null,
+    connector_id   varchar not null,
+    reference      text not null,
+    created_at     timestamp without time zone not null,
+    updated_at     timestamp without time zone not null,
+    amount         numeric not null,
+    asset          text not null,
+    status         text not null,
+
+    -- Optional fields
+    payment_id     varchar,
+    reason         text,
+    evidence_due_date timestamp without time zone,
+
+    -- Optional fields with default
+    metadata jsonb not null default '{}'::jsonb,
+
+    -- Raw PSP response
+    raw json not null,
+
+    -- Primary key
+    primary key (id)
+);
+create index disputes_created_at_sort_id on disputes (created_at, sort_id);
+create index disputes_connector_id on disputes (connector_id);
+create index disputes_status on disputes (status);
+create index disputes_payment_id on disputes (payment_id);
+create index disputes_evidence_due_date on disputes (evidence_due_date);
+alter table disputes
+    add constraint disputes_connector_id_fk foreign key (connector_id)
+    references connectors (id)
+    on delete cascade;
@@ -0,0 +1,15 @@
+create table if not exists connector_rate_budgets (
+    -- Mandatory fields
+    connector_id varchar not null,
+    tokens       double precision not null,
+    refilled_at  timestamp without time zone not null,
+    day          timestamp without time zone not null,
+    daily_count  bigint not null,
+
+    -- Primary key
+    primary key (connector_id)
+);
+alter table connector_rate_budgets
+    add constraint connector_rate_budgets_connector_id_fk foreign key (connector_id)
+    references connectors (id)
+    on delete cascade;
@@ -0,0 +1,13 @@
+create table if not exists connector_health (
+    -- Mandatory fields
+    connector_id varchar not null,
+    status       text not null,
+    updated_at   timestamp without time zone not null,
+
+    -- Primary key
+    primary key (connector_id)
+);
+alter table connector_health
+    add constraint connector_health_connector_id_fk foreign key (connector_id)
+    references connectors (id)
+    on delete cascade;
@@ -0,0 +1,4 @@
+alter table connectors
+    add column if not exists environment text;
+
+create index if not exists connectors_environment on connectors (environment);
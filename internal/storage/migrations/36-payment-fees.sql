@@ -0,0 +1,19 @@
+create table if not exists payment_fees (
+    -- Autoincrement fields
+    sort_id bigserial not null,
+
+    -- Mandatory fields
+    payment_id  varchar not null,
+    reference   text not null,
+    type        text not null,
+    amount      numeric not null,
+    asset       text not null,
+
+    -- Primary key
+    primary key (payment_id, reference, type, asset)
+);
+create index payment_fees_payment_id_sort_id on payment_fees (payment_id, sort_id);
+alter table payment_fees
+    add constraint payment_fees_payment_id_fk foreign key (payment_id)
+    references payments (id)
+    on delete cascade;
@@ -0,0 +1,36 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+// AddListQueryIndexes adds the indexes backing the prefix matching on
+// references and names and the updated_at filters and sorts of the list
+// endpoints. Each statement is executed in its own autocommitted transaction
+// (no surrounding tx), similar to migration 23.
+func AddListQueryIndexes(ctx context.Context, db bun.IDB) error {
+	stmts := []string{
+		`CREATE INDEX CONCURRENTLY IF NOT EXISTS payments_lower_reference ON payments (lower(reference) text_pattern_ops);`,
+		`CREATE INDEX CONCURRENTLY IF NOT EXISTS payments_connector_id_created_at ON payments (connector_id, created_at);`,
+		`CREATE INDEX CONCURRENTLY IF NOT EXISTS accounts_lower_reference ON accounts (lower(reference) text_pattern_ops);`,
+		`CREATE INDEX CONCURRENTLY IF NOT EXISTS accounts_lower_name ON accounts (lower(name) text_pattern_ops);`,
+		`CREATE INDEX CONCURRENTLY IF NOT EXISTS bank_accounts_lower_name ON bank_accounts (lower(name) text_pattern_ops);`,
+		`CREATE INDEX CONCURRENTLY IF NOT EXISTS payment_initiations_lower_reference ON payment_initiations (lower(reference) text_pattern_ops);`,
+		`CREATE INDEX CONCURRENTLY IF NOT EXISTS orders_lower_reference ON orders (lower(reference) text_pattern_ops);`,
+		`CREATE INDEX CONCURRENTLY IF NOT EXISTS orders_updated_at_sort_id ON orders (updated_at, sort_id);`,
+		`CREATE INDEX CONCURRENTLY IF NOT EXISTS conversions_lower_reference ON conversions (lower(reference) text_pattern_ops);`,
+		`CREATE INDEX CONCURRENTLY IF NOT EXISTS conversions_updated_at_sort_id ON conversions (updated_at, sort_id);`,
+		`CREATE INDEX CONCURRENTLY IF NOT EXISTS disputes_lower_reference ON disputes (lower(reference) text_pattern_ops);`,
+		`CREATE INDEX CONCURRENTLY IF NOT EXISTS disputes_updated_at_sort_id ON disputes (updated_at, sort_id);`,
+	}
+
+	for i, stmt := range stmts {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("migration 37 statement %d failed: %w", i+1, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+create table if not exists counterparties (
+    -- Autoincrement fields
+    sort_id bigserial not null,
+
+    -- Mandatory fields
+    id         uuid not null,
+    created_at timestamp without time zone not null,
+    name       text not null,
+
+    -- Optional fields
+    account_number bytea,
+    iban           bytea,
+    swift_bic_code bytea,
+    country        text,
+
+    -- Optional fields with default
+    metadata jsonb not null default '{}'::jsonb,
+
+    -- Primary key
+    primary key (id)
+);
+create index counterparties_created_at_sort_id on counterparties (created_at, sort_id);
+create index counterparties_lower_name on counterparties (lower(name) text_pattern_ops);
+
+create table if not exists counterparties_related_accounts (
+    -- Autoincrement fields
+    sort_id bigserial not null,
+
+    -- Mandatory fields
+    counterparty_id uuid not null,
+    account_id      varchar not null,
+    connector_id    varchar not null,
+    created_at      timestamp without time zone not null,
+
+    -- Primary key
+    primary key (counterparty_id, account_id)
+);
+create index counterparties_related_accounts_account_id on counterparties_related_accounts (account_id);
+alter table counterparties_related_accounts
+    add constraint counterparties_related_accounts_counterparty_id_fk foreign key (counterparty_id)
+    references counterparties (id)
+    on delete cascade;
+alter table counterparties_related_accounts
+    add constraint counterparties_related_accounts_account_id_fk foreign key (account_id)
+    references accounts (id)
+    on delete cascade;
+alter table counterparties_related_accounts
+    add constraint counterparties_related_accounts_connector_id_fk foreign key (connector_id)
+    references connectors (id)
+    on delete cascade;
@@ -0,0 +1,44 @@
+create table if not exists payment_correlation_rules (
+    -- Autoincrement fields
+    sort_id bigserial not null,
+
+    -- Mandatory fields
+    id              uuid not null,
+    created_at      timestamp without time zone not null,
+    name            text not null,
+    match_reference boolean not null default false,
+    match_amount    boolean not null default false,
+    date_window     bigint not null default 0,
+
+    -- Optional fields
+    metadata_keys text[],
+
+    -- Primary key
+    primary key (id)
+);
+create index payment_correlation_rules_created_at_sort_id on payment_correlation_rules (created_at, sort_id);
+
+create table if not exists payment_correlations (
+    -- Autoincrement fields
+    sort_id bigserial not null,
+
+    -- Mandatory fields
+    payment_id varchar not null,
+    group_id   uuid not null,
+    created_at timestamp without time zone not null,
+
+    -- Optional fields
+    rule_id uuid,
+
+    -- Primary key
+    primary key (payment_id)
+);
+create index payment_correlations_group_id on payment_correlations (group_id);
+alter table payment_correlations
+    add constraint payment_correlations_payment_id_fk foreign key (payment_id)
+    references payments (id)
+    on delete cascade;
+alter table payment_correlations
+    add constraint payment_correlations_rule_id_fk foreign key (rule_id)
+    references payment_correlation_rules (id)
+    on delete set null;
@@ -0,0 +1,42 @@
+create table if not exists payment_initiation_limits (
+    -- Autoincrement fields
+    sort_id bigserial not null,
+
+    -- Mandatory fields
+    id         uuid not null,
+    created_at timestamp without time zone not null,
+    name       text not null,
+    type       text not null,
+    scope      text not null,
+    action     text not null,
+    max_count  integer not null default 0,
+
+    -- Optional fields
+    connector_id varchar,
+    asset        text,
+    max_amount   numeric,
+
+    -- Primary key
+    primary key (id)
+);
+create index payment_initiation_limits_created_at_sort_id on payment_initiation_limits (created_at, sort_id);
+alter table payment_initiation_limits
+    add constraint payment_initiation_limits_connector_id_fk foreign key (connector_id)
+    references connectors (id)
+    on delete cascade;
+
+create table if not exists payment_initiation_limit_usages (
+    -- Mandatory fields
+    limit_id     uuid not null,
+    scope_key    varchar not null,
+    window_start timestamp without time zone not null,
+    amount       numeric not null default 0,
+    count        integer not null default 0,
+
+    -- Primary key
+    primary key (limit_id, scope_key, window_start)
+);
+alter table payment_initiation_limit_usages
+    add constraint payment_initiation_limit_usages_limit_id_fk foreign key (limit_id)
+    references payment_initiation_limits (id)
+    on delete cascade;
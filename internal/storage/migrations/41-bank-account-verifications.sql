@@ -0,0 +1,27 @@
+create table if not exists bank_account_verifications (
+    -- Autoincrement fields
+    sort_id bigserial not null,
+
+    -- Mandatory fields
+    id              uuid not null,
+    bank_account_id uuid not null,
+    connector_id    varchar not null,
+    created_at      timestamp without time zone not null,
+    name            text not null,
+    result          text not null,
+
+    -- Optional fields
+    matched_name text,
+
+    -- Primary key
+    primary key (id)
+);
+create index bank_account_verifications_bank_account_id on bank_account_verifications (bank_account_id, created_at desc, sort_id desc);
+alter table bank_account_verifications
+    add constraint bank_account_verifications_bank_account_id_fk foreign key (bank_account_id)
+    references bank_accounts (id)
+    on delete cascade;
+alter table bank_account_verifications
+    add constraint bank_account_verifications_connector_id_fk foreign key (connector_id)
+    references connectors (id)
+    on delete cascade;
@@ -0,0 +1,10 @@
+alter table counterparties
+    add column if not exists routing_code bytea;
+
+alter table payments
+    add column if not exists counterparty_id uuid;
+alter table payments
+    add constraint payments_counterparty_id_fk foreign key (counterparty_id)
+    references counterparties (id)
+    on delete set null
+    not valid;
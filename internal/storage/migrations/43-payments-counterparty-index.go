@@ -0,0 +1,19 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+// AddPaymentsCounterpartyIndex adds the index backing the counterparty_id
+// filter of the payments list. It is executed without a surrounding
+// transaction, similar to migration 37.
+func AddPaymentsCounterpartyIndex(ctx context.Context, db bun.IDB) error {
+	_, err := db.ExecContext(ctx, `CREATE INDEX CONCURRENTLY IF NOT EXISTS payments_counterparty_id ON payments (counterparty_id);`)
+	if err != nil {
+		return fmt.Errorf("migration 43 failed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+// AddPaymentCorrelationIndexes adds the indexes backing the lookup of the
+// correlation candidates of a payment, the closest in time first, for the
+// rules matching on the reference or on the amount. Each statement is executed
+// in its own autocommitted transaction (no surrounding tx), similar to
+// migration 37.
+func AddPaymentCorrelationIndexes(ctx context.Context, db bun.IDB) error {
+	stmts := []string{
+		`CREATE INDEX CONCURRENTLY IF NOT EXISTS payments_reference_created_at ON payments (reference, created_at);`,
+		`CREATE INDEX CONCURRENTLY IF NOT EXISTS payments_asset_initial_amount_created_at ON payments (asset, initial_amount, created_at);`,
+	}
+
+	for i, stmt := range stmts {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("migration 44 statement %d failed: %w", i+1, err)
+		}
+	}
+	return nil
+}
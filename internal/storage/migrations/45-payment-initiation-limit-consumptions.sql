@@ -0,0 +1,20 @@
+create table if not exists payment_initiation_limit_consumptions (
+    -- Mandatory fields
+    payment_initiation_id varchar not null,
+    limit_id              uuid not null,
+    scope_key             varchar not null,
+    window_start          timestamp without time zone not null,
+    amount                numeric not null default 0,
+    count                 integer not null default 0,
+
+    -- Primary key
+    primary key (payment_initiation_id, limit_id)
+);
+alter table payment_initiation_limit_consumptions
+    add constraint payment_initiation_limit_consumptions_payment_initiation_id_fk foreign key (payment_initiation_id)
+    references payment_initiations (id)
+    on delete cascade;
+alter table payment_initiation_limit_consumptions
+    add constraint payment_initiation_limit_consumptions_limit_id_fk foreign key (limit_id)
+    references payment_initiation_limits (id)
+    on delete cascade;
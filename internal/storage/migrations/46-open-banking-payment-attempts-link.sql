@@ -0,0 +1,2 @@
+alter table open_banking_payment_attempts
+    add column if not exists link text;
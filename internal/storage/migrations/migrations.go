@@ -67,6 +67,45 @@ var workflowInstancesConnectorScheduleIndex string
 //go:embed 29-orders-and-conversions.sql
 var ordersAndConversions string
 
+//go:embed 31-open-banking-payment-attempts.sql
+var openBankingPaymentAttempts string
+
+//go:embed 32-disputes.sql
+var disputes string
+
+//go:embed 33-connector-rate-budgets.sql
+var connectorRateBudgets string
+
+//go:embed 34-connector-health.sql
+var connectorHealth string
+
+//go:embed 35-connector-environment.sql
+var connectorEnvironment string
+
+//go:embed 36-payment-fees.sql
+var paymentFees string
+
+//go:embed 38-counterparties.sql
+var counterparties string
+
+//go:embed 39-payment-correlations.sql
+var paymentCorrelations string
+
+//go:embed 40-payment-initiation-limits.sql
+var paymentInitiationLimits string
+
+//go:embed 41-bank-account-verifications.sql
+var bankAccountVerifications string
+
+//go:embed 42-counterparties-routing-code.sql
+var counterpartiesRoutingCode string
+
+//go:embed 45-payment-initiation-limit-consumptions.sql
+var paymentInitiationLimitConsumptions string
+
+//go:embed 46-open-banking-payment-attempts-link.sql
+var openBankingPaymentAttemptsLink string
+
 func registerMigrations(logger logging.Logger, migrator *migrations.Migrator, encryptionKey string) {
 	migrator.RegisterMigrations(
 		migrations.Migration{
@@ -448,6 +487,185 @@ func registerMigrations(logger logging.Logger, migrator *migrations.Migrator, en
 				})
 			},
 		},
+		migrations.Migration{
+			Name: "open banking payment attempts",
+			Up: func(ctx context.Context, db bun.IDB) error {
+				return db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+					logger.Info("running open banking payment attempts migration...")
+					_, err := tx.ExecContext(ctx, openBankingPaymentAttempts)
+					logger.WithField("error", err).Info("finished running open banking payment attempts migration")
+					return err
+				})
+			},
+		},
+		migrations.Migration{
+			Name: "disputes",
+			Up: func(ctx context.Context, db bun.IDB) error {
+				return db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+					logger.Info("running disputes migration...")
+					_, err := tx.ExecContext(ctx, disputes)
+					logger.WithField("error", err).Info("finished running disputes migration")
+					return err
+				})
+			},
+		},
+		migrations.Migration{
+			Name: "connector rate budgets",
+			Up: func(ctx context.Context, db bun.IDB) error {
+				return db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+					logger.Info("running connector rate budgets migration...")
+					_, err := tx.ExecContext(ctx, connectorRateBudgets)
+					logger.WithField("error", err).Info("finished running connector rate budgets migration")
+					return err
+				})
+			},
+		},
+		migrations.Migration{
+			Name: "connector health",
+			Up: func(ctx context.Context, db bun.IDB) error {
+				return db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+					logger.Info("running connector health migration...")
+					_, err := tx.ExecContext(ctx, connectorHealth)
+					logger.WithField("error", err).Info("finished running connector health migration")
+					return err
+				})
+			},
+		},
+		migrations.Migration{
+			Name: "connector environment",
+			Up: func(ctx context.Context, db bun.IDB) error {
+				return db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+					logger.Info("running connector environment migration...")
+					_, err := tx.ExecContext(ctx, connectorEnvironment)
+					logger.WithField("error", err).Info("finished running connector environment migration")
+					return err
+				})
+			},
+		},
+		migrations.Migration{
+			Name: "payment fees",
+			Up: func(ctx context.Context, db bun.IDB) error {
+				return db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+					logger.Info("running payment fees migration...")
+					_, err := tx.ExecContext(ctx, paymentFees)
+					logger.WithField("error", err).Info("finished running payment fees migration")
+					return err
+				})
+			},
+		},
+		migrations.Migration{
+			Name: "list query indexes",
+			Up: func(ctx context.Context, db bun.IDB) error {
+				logger.Info("running list query indexes migration...")
+				if _, ok := db.(*bun.Tx); ok {
+					return fmt.Errorf("migration 37 must not run inside a transaction; pass a *bun.DB")
+				}
+				err := AddListQueryIndexes(ctx, db)
+				logger.WithField("error", err).Info("finished running list query indexes migration")
+				return err
+			},
+		},
+		migrations.Migration{
+			Name: "counterparties",
+			Up: func(ctx context.Context, db bun.IDB) error {
+				return db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+					logger.Info("running counterparties migration...")
+					_, err := tx.ExecContext(ctx, counterparties)
+					logger.WithField("error", err).Info("finished running counterparties migration")
+					return err
+				})
+			},
+		},
+		migrations.Migration{
+			Name: "payment correlations",
+			Up: func(ctx context.Context, db bun.IDB) error {
+				return db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+					logger.Info("running payment correlations migration...")
+					_, err := tx.ExecContext(ctx, paymentCorrelations)
+					logger.WithField("error", err).Info("finished running payment correlations migration")
+					return err
+				})
+			},
+		},
+		migrations.Migration{
+			Name: "payment initiation limits",
+			Up: func(ctx context.Context, db bun.IDB) error {
+				return db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+					logger.Info("running payment initiation limits migration...")
+					_, err := tx.ExecContext(ctx, paymentInitiationLimits)
+					logger.WithField("error", err).Info("finished running payment initiation limits migration")
+					return err
+				})
+			},
+		},
+		migrations.Migration{
+			Name: "bank account verifications",
+			Up: func(ctx context.Context, db bun.IDB) error {
+				return db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+					logger.Info("running bank account verifications migration...")
+					_, err := tx.ExecContext(ctx, bankAccountVerifications)
+					logger.WithField("error", err).Info("finished running bank account verifications migration")
+					return err
+				})
+			},
+		},
+		migrations.Migration{
+			Name: "counterparties routing code",
+			Up: func(ctx context.Context, db bun.IDB) error {
+				return db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+					logger.Info("running counterparties routing code migration...")
+					_, err := tx.ExecContext(ctx, counterpartiesRoutingCode)
+					logger.WithField("error", err).Info("finished running counterparties routing code migration")
+					return err
+				})
+			},
+		},
+		migrations.Migration{
+			Name: "payments counterparty index",
+			Up: func(ctx context.Context, db bun.IDB) error {
+				logger.Info("running payments counterparty index migration...")
+				if _, ok := db.(*bun.Tx); ok {
+					return fmt.Errorf("migration 43 must not run inside a transaction; pass a *bun.DB")
+				}
+				err := AddPaymentsCounterpartyIndex(ctx, db)
+				logger.WithField("error", err).Info("finished running payments counterparty index migration")
+				return err
+			},
+		},
+		migrations.Migration{
+			Name: "payment correlation indexes",
+			Up: func(ctx context.Context, db bun.IDB) error {
+				logger.Info("running payment correlation indexes migration...")
+				if _, ok := db.(*bun.Tx); ok {
+					return fmt.Errorf("migration 44 must not run inside a transaction; pass a *bun.DB")
+				}
+				err := AddPaymentCorrelationIndexes(ctx, db)
+				logger.WithField("error", err).Info("finished running payment correlation indexes migration")
+				return err
+			},
+		},
+		migrations.Migration{
+			Name: "payment initiation limit consumptions",
+			Up: func(ctx context.Context, db bun.IDB) error {
+				return db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+					logger.Info("running payment initiation limit consumptions migration...")
+					_, err := tx.ExecContext(ctx, paymentInitiationLimitConsumptions)
+					logger.WithField("error", err).Info("finished running payment initiation limit consumptions migration")
+					return err
+				})
+			},
+		},
+		migrations.Migration{
+			Name: "open banking payment attempts link",
+			Up: func(ctx context.Context, db bun.IDB) error {
+				return db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+					logger.Info("running open banking payment attempts link migration...")
+					_, err := tx.ExecContext(ctx, openBankingPaymentAttemptsLink)
+					logger.WithField("error", err).Info("finished running open banking payment attempts link migration")
+					return err
+				})
+			},
+		},
 	)
 }
 
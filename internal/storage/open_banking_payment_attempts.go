@@ -0,0 +1,135 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/formancehq/go-libs/v5/pkg/types/time"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type openBankingPaymentAttempt struct {
+	bun.BaseModel `bun:"table:open_banking_payment_attempts"`
+
+	// Mandatory fields
+	ID                  uuid.UUID                                 `bun:"id,pk,type:uuid,notnull"`
+	PsuID               uuid.UUID                                 `bun:"psu_id,type:uuid,notnull"`
+	ConnectorID         models.ConnectorID                        `bun:"connector_id,type:character varying,notnull"`
+	PaymentInitiationID models.PaymentInitiationID                `bun:"payment_initiation_id,type:character varying,notnull"`
+	CreatedAt           time.Time                                 `bun:"created_at,type:timestamp without time zone,notnull"`
+	Status              models.OpenBankingConnectionAttemptStatus `bun:"status,type:text,notnull"`
+	State               json.RawMessage                           `bun:"state,type:jsonb,nullzero"`
+
+	// Optional fields
+	ClientRedirectURL       *string `bun:"client_redirect_url,type:text,nullzero"`
+	PaymentRequestReference *string `bun:"payment_request_reference,type:text,nullzero"`
+	Link                    *string `bun:"link,type:text,nullzero"`
+	Error                   *string `bun:"error,type:text,nullzero"`
+}
+
+func (s *store) OpenBankingPaymentAttemptsUpsert(ctx context.Context, from models.OpenBankingPaymentAttempt) error {
+	attempt, err := fromOpenBankingPaymentAttemptsModels(from)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.NewInsert().
+		Model(&attempt).
+		On("CONFLICT (id) DO UPDATE").
+		Set("error = EXCLUDED.error").
+		Set("status = EXCLUDED.status").
+		Set("payment_request_reference = EXCLUDED.payment_request_reference").
+		Set("link = EXCLUDED.link").
+		Set("state = EXCLUDED.state").
+		Exec(ctx)
+	if err != nil {
+		return e("upserting open banking payment attempt", err)
+	}
+
+	return nil
+}
+
+func (s *store) OpenBankingPaymentAttemptsUpdateStatus(ctx context.Context, id uuid.UUID, status models.OpenBankingConnectionAttemptStatus, errMsg *string) error {
+	_, err := s.db.NewUpdate().
+		Model((*openBankingPaymentAttempt)(nil)).
+		Set("status = ?", status).
+		Set("error = ?", errMsg).
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return e("updating open banking payment attempt status", err)
+	}
+
+	return nil
+}
+
+func (s *store) OpenBankingPaymentAttemptsGet(ctx context.Context, id uuid.UUID) (*models.OpenBankingPaymentAttempt, error) {
+	attempt := openBankingPaymentAttempt{}
+	err := s.db.NewSelect().
+		Model(&attempt).
+		Where("id = ?", id).
+		Scan(ctx)
+	if err != nil {
+		return nil, e("getting open banking payment attempt", err)
+	}
+
+	return toOpenBankingPaymentAttemptsModels(attempt)
+}
+
+func (s *store) OpenBankingPaymentAttemptsGetFromPaymentRequestReference(ctx context.Context, connectorID models.ConnectorID, reference string) (*models.OpenBankingPaymentAttempt, error) {
+	attempt := openBankingPaymentAttempt{}
+	err := s.db.NewSelect().
+		Model(&attempt).
+		Where("connector_id = ?", connectorID).
+		Where("payment_request_reference = ?", reference).
+		Scan(ctx)
+	if err != nil {
+		return nil, e("getting open banking payment attempt", err)
+	}
+
+	return toOpenBankingPaymentAttemptsModels(attempt)
+}
+
+func fromOpenBankingPaymentAttemptsModels(from models.OpenBankingPaymentAttempt) (openBankingPaymentAttempt, error) {
+	state, err := json.Marshal(from.State)
+	if err != nil {
+		return openBankingPaymentAttempt{}, err
+	}
+
+	return openBankingPaymentAttempt{
+		ID:                      from.ID,
+		PsuID:                   from.PsuID,
+		ConnectorID:             from.ConnectorID,
+		PaymentInitiationID:     from.PaymentInitiationID,
+		CreatedAt:               time.New(from.CreatedAt),
+		Status:                  from.Status,
+		State:                   state,
+		ClientRedirectURL:       from.ClientRedirectURL,
+		PaymentRequestReference: from.PaymentRequestReference,
+		Link:                    from.Link,
+		Error:                   from.Error,
+	}, nil
+}
+
+func toOpenBankingPaymentAttemptsModels(from openBankingPaymentAttempt) (*models.OpenBankingPaymentAttempt, error) {
+	state := models.CallbackState{}
+	if err := json.Unmarshal(from.State, &state); err != nil {
+		return nil, err
+	}
+
+	return &models.OpenBankingPaymentAttempt{
+		ID:                      from.ID,
+		PsuID:                   from.PsuID,
+		ConnectorID:             from.ConnectorID,
+		PaymentInitiationID:     from.PaymentInitiationID,
+		CreatedAt:               from.CreatedAt.Time,
+		Status:                  from.Status,
+		State:                   state,
+		ClientRedirectURL:       from.ClientRedirectURL,
+		PaymentRequestReference: from.PaymentRequestReference,
+		Link:                    from.Link,
+		Error:                   from.Error,
+	}, nil
+}
@@ -0,0 +1,167 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/formancehq/go-libs/v5/pkg/observe/log"
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/go-libs/v5/pkg/types/time"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	defaultOpenBankingPaymentAttemptID = uuid.New()
+	defaultOpenBankingPaymentAttempt   = models.OpenBankingPaymentAttempt{
+		ID:                  defaultOpenBankingPaymentAttemptID,
+		PsuID:               defaultPSU2.ID,
+		ConnectorID:         defaultConnector.ID,
+		PaymentInitiationID: piID1,
+		CreatedAt:           now.Add(-60 * time.Minute).UTC().Time,
+		Status:              models.OpenBankingConnectionAttemptStatusPending,
+		State: models.CallbackState{
+			Randomized:        "random123",
+			AttemptID:         defaultOpenBankingPaymentAttemptID,
+			PaymentInitiation: true,
+		},
+		ClientRedirectURL:       pointer.For("https://example.com/redirect"),
+		PaymentRequestReference: pointer.For("payment_request_123"),
+	}
+)
+
+func createOpenBankingPaymentAttempt(t *testing.T, ctx context.Context, storage Storage, attempt models.OpenBankingPaymentAttempt) {
+	require.NoError(t, storage.OpenBankingPaymentAttemptsUpsert(ctx, attempt))
+}
+
+func setupOpenBankingPaymentAttempts(t *testing.T, ctx context.Context, store Storage) {
+	upsertConnector(t, ctx, store, defaultConnector)
+	upsertAccounts(t, ctx, store, defaultAccounts())
+	upsertPaymentInitiations(t, ctx, store, defaultPaymentInitiations())
+	createPSU(t, ctx, store, defaultPSU2)
+	createOpenBankingPaymentAttempt(t, ctx, store, defaultOpenBankingPaymentAttempt)
+}
+
+func TestOpenBankingPaymentAttemptsUpsert(t *testing.T) {
+	t.Parallel()
+
+	ctx := logging.TestingContext()
+	store := newStore(t)
+	defer store.Close()
+
+	setupOpenBankingPaymentAttempts(t, ctx, store)
+
+	t.Run("upsert with same id", func(t *testing.T) {
+		attempt := defaultOpenBankingPaymentAttempt
+		attempt.Status = models.OpenBankingConnectionAttemptStatusExited
+		attempt.PaymentRequestReference = pointer.For("payment_request_changed")
+		attempt.Error = pointer.For("payment failed")
+
+		require.NoError(t, store.OpenBankingPaymentAttemptsUpsert(ctx, attempt))
+
+		actual, err := store.OpenBankingPaymentAttemptsGet(ctx, attempt.ID)
+		require.NoError(t, err)
+		require.Equal(t, attempt.Status, actual.Status)
+		require.Equal(t, attempt.PaymentRequestReference, actual.PaymentRequestReference)
+		require.Equal(t, attempt.Error, actual.Error)
+		// Should not update the client redirect url
+		require.Equal(t, defaultOpenBankingPaymentAttempt.ClientRedirectURL, actual.ClientRedirectURL)
+	})
+
+	t.Run("unknown payment initiation", func(t *testing.T) {
+		attempt := defaultOpenBankingPaymentAttempt
+		attempt.ID = uuid.New()
+		attempt.PaymentRequestReference = nil
+		attempt.PaymentInitiationID = models.PaymentInitiationID{
+			Reference:   "unknown",
+			ConnectorID: defaultConnector.ID,
+		}
+
+		require.Error(t, store.OpenBankingPaymentAttemptsUpsert(ctx, attempt))
+	})
+}
+
+func TestOpenBankingPaymentAttemptsUpdateStatus(t *testing.T) {
+	t.Parallel()
+
+	ctx := logging.TestingContext()
+	store := newStore(t)
+	defer store.Close()
+
+	setupOpenBankingPaymentAttempts(t, ctx, store)
+
+	t.Run("update status to exited", func(t *testing.T) {
+		errMsg := pointer.For("payment rejected")
+		require.NoError(t, store.OpenBankingPaymentAttemptsUpdateStatus(ctx, defaultOpenBankingPaymentAttempt.ID, models.OpenBankingConnectionAttemptStatusExited, errMsg))
+
+		actual, err := store.OpenBankingPaymentAttemptsGet(ctx, defaultOpenBankingPaymentAttempt.ID)
+		require.NoError(t, err)
+		require.Equal(t, models.OpenBankingConnectionAttemptStatusExited, actual.Status)
+		require.Equal(t, errMsg, actual.Error)
+	})
+
+	t.Run("update status to completed", func(t *testing.T) {
+		require.NoError(t, store.OpenBankingPaymentAttemptsUpdateStatus(ctx, defaultOpenBankingPaymentAttempt.ID, models.OpenBankingConnectionAttemptStatusCompleted, nil))
+
+		actual, err := store.OpenBankingPaymentAttemptsGet(ctx, defaultOpenBankingPaymentAttempt.ID)
+		require.NoError(t, err)
+		require.Equal(t, models.OpenBankingConnectionAttemptStatusCompleted, actual.Status)
+		require.Nil(t, actual.Error)
+	})
+}
+
+func TestOpenBankingPaymentAttemptsGet(t *testing.T) {
+	t.Parallel()
+
+	ctx := logging.TestingContext()
+	store := newStore(t)
+	defer store.Close()
+
+	setupOpenBankingPaymentAttempts(t, ctx, store)
+
+	t.Run("get attempt", func(t *testing.T) {
+		actual, err := store.OpenBankingPaymentAttemptsGet(ctx, defaultOpenBankingPaymentAttempt.ID)
+		require.NoError(t, err)
+		compareOpenBankingPaymentAttempts(t, defaultOpenBankingPaymentAttempt, *actual)
+	})
+
+	t.Run("get non-existent attempt", func(t *testing.T) {
+		_, err := store.OpenBankingPaymentAttemptsGet(ctx, uuid.New())
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestOpenBankingPaymentAttemptsGetFromPaymentRequestReference(t *testing.T) {
+	t.Parallel()
+
+	ctx := logging.TestingContext()
+	store := newStore(t)
+	defer store.Close()
+
+	setupOpenBankingPaymentAttempts(t, ctx, store)
+
+	t.Run("get attempt from reference", func(t *testing.T) {
+		actual, err := store.OpenBankingPaymentAttemptsGetFromPaymentRequestReference(ctx, defaultConnector.ID, *defaultOpenBankingPaymentAttempt.PaymentRequestReference)
+		require.NoError(t, err)
+		compareOpenBankingPaymentAttempts(t, defaultOpenBankingPaymentAttempt, *actual)
+	})
+
+	t.Run("get attempt from unknown reference", func(t *testing.T) {
+		_, err := store.OpenBankingPaymentAttemptsGetFromPaymentRequestReference(ctx, defaultConnector.ID, "unknown")
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func compareOpenBankingPaymentAttempts(t *testing.T, expected, actual models.OpenBankingPaymentAttempt) {
+	require.Equal(t, expected.ID, actual.ID)
+	require.Equal(t, expected.PsuID, actual.PsuID)
+	require.Equal(t, expected.ConnectorID, actual.ConnectorID)
+	require.Equal(t, expected.PaymentInitiationID, actual.PaymentInitiationID)
+	require.Equal(t, expected.CreatedAt, actual.CreatedAt)
+	require.Equal(t, expected.Status, actual.Status)
+	require.Equal(t, expected.State, actual.State)
+	require.Equal(t, expected.ClientRedirectURL, actual.ClientRedirectURL)
+	require.Equal(t, expected.PaymentRequestReference, actual.PaymentRequestReference)
+	require.Equal(t, expected.Error, actual.Error)
+}
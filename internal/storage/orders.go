@@ -245,7 +245,9 @@ func (s *store) OrdersDeleteFromConnectorID(ctx context.Context, connectorID mod
 	return e("failed to delete orders", err)
 }
 
-type OrderQuery struct{}
+type OrderQuery struct {
+	Sort []SortKey `json:"sort,omitempty"`
+}
 
 type ListOrdersQuery paginate.OffsetPaginatedQuery[paginate.PaginatedQueryOptions[OrderQuery]]
 
@@ -257,11 +259,18 @@ func NewListOrdersQuery(opts paginate.PaginatedQueryOptions[OrderQuery]) ListOrd
 	}
 }
 
+var ordersSortColumns = map[string]string{
+	"created_at": "o.created_at",
+	"updated_at": "o.updated_at",
+	"reference":  "o.reference",
+}
+
 func (s *store) ordersQueryContext(qb query.Builder) (string, []any, error) {
 	where, args, err := qb.Build(query.ContextFn(func(key, operator string, value any) (string, []any, error) {
 		switch {
-		case key == "reference",
-			key == "id",
+		case key == "reference":
+			return matchText("o.reference", key, operator, value)
+		case key == "id",
 			key == "connector_id",
 			key == "direction",
 			key == "source_asset",
@@ -269,10 +278,10 @@ func (s *store) ordersQueryContext(qb query.Builder) (string, []any, error) {
 			key == "type",
 			key == "status",
 			key == "time_in_force":
-			if operator != "$match" {
-				return "", nil, e(fmt.Sprintf("'%s' column can only be used with $match", key), ErrValidation)
-			}
-			return fmt.Sprintf("o.%s = ?", key), []any{value}, nil
+			return matchEqual("o."+key, key, operator, value)
+		case key == "created_at",
+			key == "updated_at":
+			return matchDate("o."+key, key, operator, value)
 
 		case key == "base_quantity_ordered",
 			key == "base_quantity_filled",
@@ -280,14 +289,7 @@ func (s *store) ordersQueryContext(qb query.Builder) (string, []any, error) {
 			key == "fee":
 			return fmt.Sprintf("o.%s %s ?", key, query.DefaultComparisonOperatorsMapping[operator]), []any{value}, nil
 		case metadataRegex.Match([]byte(key)):
-			if operator != "$match" {
-				return "", nil, e("'metadata' column can only be used with $match", ErrValidation)
-			}
-			match := metadataRegex.FindAllStringSubmatch(key, 3)
-
-			return "o.metadata @> ?", []any{map[string]any{
-				match[0][1]: value,
-			}}, nil
+			return matchMetadata("o.metadata", key, operator, value)
 		default:
 			return "", nil, fmt.Errorf("unknown key '%s' when building query: %w", key, ErrValidation)
 		}
@@ -309,6 +311,11 @@ func (s *store) OrdersList(ctx context.Context, q ListOrdersQuery) (*paginate.Cu
 		}
 	}
 
+	orderBy, err := sortOrder(q.Options.Options.Sort, ordersSortColumns, "o.created_at", "o.sort_id")
+	if err != nil {
+		return nil, err
+	}
+
 	cursor, err := paginateWithOffset[paginate.PaginatedQueryOptions[OrderQuery], order](s, ctx,
 		(*paginate.OffsetPaginatedQuery[paginate.PaginatedQueryOptions[OrderQuery]])(&q),
 		func(query *bun.SelectQuery) *bun.SelectQuery {
@@ -327,7 +334,7 @@ func (s *store) OrdersList(ctx context.Context, q ListOrdersQuery) (*paginate.Cu
 				LIMIT 1
 			) oad ON true`)
 
-			query = query.Order("created_at DESC", "sort_id DESC")
+			query = query.Order(orderBy...)
 
 			return query
 		},
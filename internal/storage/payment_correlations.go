@@ -0,0 +1,570 @@
+package storage
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/query"
+	"github.com/formancehq/go-libs/v5/pkg/storage/bun/paginate"
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	internalTime "github.com/formancehq/go-libs/v5/pkg/types/time"
+	internalEvents "github.com/formancehq/payments/internal/events"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/formancehq/payments/pkg/events"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/uptrace/bun"
+)
+
+// maxPaymentCorrelationCandidates bounds the number of payments checked
+// against a rule for every payment to correlate, the closest in time first.
+const maxPaymentCorrelationCandidates = 10
+
+type paymentCorrelationRule struct {
+	bun.BaseModel `bun:"table:payment_correlation_rules"`
+
+	// Mandatory fields
+	ID             uuid.UUID         `bun:"id,pk,type:uuid,notnull"`
+	CreatedAt      internalTime.Time `bun:"created_at,type:timestamp without time zone,notnull"`
+	Name           string            `bun:"name,type:text,notnull"`
+	MatchReference bool              `bun:"match_reference,type:boolean,notnull"`
+	MatchAmount    bool              `bun:"match_amount,type:boolean,notnull"`
+	DateWindow     time.Duration     `bun:"date_window,type:bigint,notnull"`
+
+	// Optional fields
+	// c.f.: https://bun.uptrace.dev/guide/models.html#nulls
+	MetadataKeys []string `bun:"metadata_keys,type:text[],array"`
+}
+
+type paymentCorrelation struct {
+	bun.BaseModel `bun:"table:payment_correlations"`
+
+	// Mandatory fields
+	PaymentID models.PaymentID  `bun:"payment_id,pk,type:character varying,notnull"`
+	GroupID   uuid.UUID         `bun:"group_id,type:uuid,notnull"`
+	CreatedAt internalTime.Time `bun:"created_at,type:timestamp without time zone,notnull"`
+
+	// Optional fields
+	// c.f.: https://bun.uptrace.dev/guide/models.html#nulls
+	RuleID *uuid.UUID `bun:"rule_id,type:uuid,nullzero"`
+}
+
+func (s *store) PaymentCorrelationRulesCreate(ctx context.Context, rule models.PaymentCorrelationRule) error {
+	toInsert := fromPaymentCorrelationRuleModels(rule)
+
+	_, err := s.db.NewInsert().
+		Model(&toInsert).
+		Exec(ctx)
+	if err != nil {
+		return e("failed to insert payment correlation rule", err)
+	}
+
+	return nil
+}
+
+func (s *store) PaymentCorrelationRulesDelete(ctx context.Context, id uuid.UUID) error {
+	res, err := s.db.NewDelete().
+		Model((*paymentCorrelationRule)(nil)).
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return e("failed to delete payment correlation rule", err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return e("failed to delete payment correlation rule", err)
+	}
+
+	if rowsAffected == 0 {
+		return e("failed to delete payment correlation rule", ErrNotFound)
+	}
+
+	return nil
+}
+
+type PaymentCorrelationRuleQuery struct{}
+
+type ListPaymentCorrelationRulesQuery paginate.OffsetPaginatedQuery[paginate.PaginatedQueryOptions[PaymentCorrelationRuleQuery]]
+
+func NewListPaymentCorrelationRulesQuery(opts paginate.PaginatedQueryOptions[PaymentCorrelationRuleQuery]) ListPaymentCorrelationRulesQuery {
+	return ListPaymentCorrelationRulesQuery{
+		Order:    paginate.OrderAsc,
+		PageSize: opts.PageSize,
+		Options:  opts,
+	}
+}
+
+func (s *store) paymentCorrelationRulesQueryContext(qb query.Builder) (string, []any, error) {
+	return qb.Build(query.ContextFn(func(key, operator string, value any) (string, []any, error) {
+		switch {
+		case key == "name":
+			return matchText("payment_correlation_rule.name", key, operator, value)
+		case key == "id":
+			return matchEqual("payment_correlation_rule.id", key, operator, value)
+		default:
+			return "", nil, fmt.Errorf("unknown key '%s' when building query: %w", key, ErrValidation)
+		}
+	}))
+}
+
+func (s *store) PaymentCorrelationRulesList(ctx context.Context, q ListPaymentCorrelationRulesQuery) (*paginate.Cursor[models.PaymentCorrelationRule], error) {
+	var (
+		where string
+		args  []any
+		err   error
+	)
+	if q.Options.QueryBuilder != nil {
+		where, args, err = s.paymentCorrelationRulesQueryContext(q.Options.QueryBuilder)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	cursor, err := paginateWithOffset[paginate.PaginatedQueryOptions[PaymentCorrelationRuleQuery], paymentCorrelationRule](s, ctx,
+		(*paginate.OffsetPaginatedQuery[paginate.PaginatedQueryOptions[PaymentCorrelationRuleQuery]])(&q),
+		func(query *bun.SelectQuery) *bun.SelectQuery {
+			if where != "" {
+				query = query.Where(where, args...)
+			}
+
+			query = query.Order("created_at DESC", "sort_id DESC")
+
+			return query
+		},
+	)
+	if err != nil {
+		return nil, e("failed to fetch payment correlation rules", err)
+	}
+
+	rules := make([]models.PaymentCorrelationRule, 0, len(cursor.Data))
+	for _, r := range cursor.Data {
+		rules = append(rules, toPaymentCorrelationRuleModels(r))
+	}
+
+	return &paginate.Cursor[models.PaymentCorrelationRule]{
+		PageSize: cursor.PageSize,
+		HasMore:  cursor.HasMore,
+		Previous: cursor.Previous,
+		Next:     cursor.Next,
+		Data:     rules,
+	}, nil
+}
+
+// paymentsCorrelate links every payment which is not correlated yet to the
+// closest payment of another connector matching one of the correlation rules,
+// the oldest rule first. The returned outbox events must be inserted in the
+// same transaction.
+//
+// A payment stays in the group it was linked to. The payments left alone are
+// checked again every time they are upserted, so that they are linked once
+// their counterpart arrives or once they are updated to match it. Adding a
+// rule does not link the payments already stored.
+func (s *store) paymentsCorrelate(ctx context.Context, tx bun.Tx, payments []models.Payment) ([]models.OutboxEvent, error) {
+	if len(payments) == 0 {
+		return nil, nil
+	}
+
+	var rules []paymentCorrelationRule
+	err := tx.NewSelect().
+		Model(&rules).
+		Order("created_at ASC", "sort_id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, e("failed to fetch payment correlation rules", err)
+	}
+
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]models.PaymentID, 0, len(payments))
+	for _, p := range payments {
+		ids = append(ids, p.ID)
+	}
+
+	var correlated []paymentCorrelation
+	err = tx.NewSelect().
+		Model(&correlated).
+		Column("payment_id").
+		Where("payment_id IN (?)", bun.In(ids)).
+		Scan(ctx)
+	if err != nil {
+		return nil, e("failed to fetch payment correlations", err)
+	}
+
+	alreadyCorrelated := make(map[models.PaymentID]struct{}, len(correlated))
+	for _, c := range correlated {
+		alreadyCorrelated[c.PaymentID] = struct{}{}
+	}
+
+	remaining := make([]models.Payment, 0, len(payments))
+	for _, p := range payments {
+		if _, ok := alreadyCorrelated[p.ID]; !ok {
+			remaining = append(remaining, p)
+		}
+	}
+
+	now := time.Now().UTC()
+	outboxEvents := make([]models.OutboxEvent, 0)
+	for _, r := range rules {
+		if len(remaining) == 0 {
+			break
+		}
+
+		rule := toPaymentCorrelationRuleModels(r)
+
+		candidates, err := s.paymentsCorrelationCandidates(ctx, tx, rule, remaining)
+		if err != nil {
+			return nil, err
+		}
+
+		unmatched := make([]models.Payment, 0, len(remaining))
+		for _, p := range remaining {
+			candidate := paymentsCorrelationClosestMatch(rule, p, candidates[p.ID])
+			if candidate == nil {
+				unmatched = append(unmatched, p)
+				continue
+			}
+
+			groupID, err := s.paymentsCorrelationLink(ctx, tx, rule.ID, now, p.ID, *candidate)
+			if err != nil {
+				return nil, err
+			}
+
+			correlation, err := s.paymentsCorrelation(ctx, tx, p.ID)
+			if err != nil {
+				return nil, err
+			}
+			if correlation == nil {
+				return nil, e("failed to correlate payment", fmt.Errorf("missing correlation group %s", groupID))
+			}
+
+			evtMsg := internalEvents.Events{}.NewEventSavedPaymentCorrelation(p.ID, rule.ID, now, *correlation)
+			payloadBytes, err := json.Marshal(evtMsg.Payload)
+			if err != nil {
+				return nil, e("failed to marshal payment correlation event payload", err)
+			}
+
+			connectorID := p.ConnectorID
+			outboxEvents = append(outboxEvents, models.OutboxEvent{
+				ID: models.EventID{
+					EventIdempotencyKey: evtMsg.IdempotencyKey,
+					ConnectorID:         &connectorID,
+				},
+				EventType:   events.EventTypeSavedPaymentCorrelation,
+				EntityID:    p.ID.String(),
+				Payload:     payloadBytes,
+				CreatedAt:   now,
+				Status:      models.OUTBOX_STATUS_PENDING,
+				ConnectorID: &connectorID,
+			})
+		}
+		remaining = unmatched
+	}
+
+	return outboxEvents, nil
+}
+
+// paymentCorrelationCandidate is a payment of another connector which may
+// match a rule for the payment ForID.
+type paymentCorrelationCandidate struct {
+	ForID         models.PaymentID   `bun:"for_id"`
+	SortID        int64              `bun:"sort_id"`
+	ID            models.PaymentID   `bun:"id"`
+	ConnectorID   models.ConnectorID `bun:"connector_id"`
+	Reference     string             `bun:"reference"`
+	CreatedAt     internalTime.Time  `bun:"created_at"`
+	InitialAmount *big.Int           `bun:"initial_amount,type:numeric"`
+	Asset         string             `bun:"asset"`
+	Metadata      map[string]string  `bun:"metadata,type:jsonb"`
+}
+
+// paymentsCorrelationCandidates fetches, in one query, the payments of other
+// connectors matching the rule for each of the given payments. For each
+// payment, at most maxPaymentCorrelationCandidates payments are fetched on
+// each side of its creation date, so that both lookups walk the
+// (reference, created_at), (asset, initial_amount, created_at) or
+// (created_at) indexes instead of sorting every match.
+func (s *store) paymentsCorrelationCandidates(ctx context.Context, tx bun.Tx, rule models.PaymentCorrelationRule, payments []models.Payment) (map[models.PaymentID][]paymentCorrelationCandidate, error) {
+	values := make([]string, 0, len(payments))
+	args := make([]any, 0, len(payments)*9)
+	for _, p := range payments {
+		if rule.MatchAmount && p.InitialAmount == nil {
+			continue
+		}
+
+		hasMetadata := true
+		for _, key := range rule.MetadataKeys {
+			if _, ok := p.Metadata[key]; !ok {
+				hasMetadata = false
+				break
+			}
+		}
+		if !hasMetadata {
+			continue
+		}
+
+		metadata, err := json.Marshal(p.Metadata)
+		if err != nil {
+			return nil, e("failed to marshal payment metadata", err)
+		}
+
+		var initialAmount *string
+		if p.InitialAmount != nil {
+			initialAmount = pointer.For(p.InitialAmount.String())
+		}
+
+		createdAt := p.CreatedAt.UTC()
+		values = append(values, "(?, ?, ?, ?, ?::numeric, ?::jsonb, ?::timestamp without time zone, ?::timestamp without time zone, ?::timestamp without time zone)")
+		args = append(args,
+			p.ID, p.ConnectorID, p.Reference, p.Asset, initialAmount, string(metadata),
+			createdAt, createdAt.Add(-rule.DateWindow), createdAt.Add(rule.DateWindow),
+		)
+	}
+
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	matches := []string{"p.connector_id != src.connector_id", "p.id != src.id"}
+	var matchArgs []any
+	if rule.MatchReference {
+		matches = append(matches, "p.reference = src.reference")
+	}
+	if rule.MatchAmount {
+		matches = append(matches, "p.asset = src.asset", "p.initial_amount = src.initial_amount")
+	}
+	for _, key := range rule.MetadataKeys {
+		matches = append(matches, "p.metadata->>? = src.metadata->>?")
+		matchArgs = append(matchArgs, key, key)
+	}
+
+	before := append(slices.Clone(matches), "p.created_at <= src.created_at")
+	after := append(slices.Clone(matches), "p.created_at > src.created_at")
+	if rule.DateWindow > 0 {
+		before = append(before, "p.created_at >= src.window_start")
+		after = append(after, "p.created_at <= src.window_end")
+	}
+
+	args = append(args, matchArgs...)
+	args = append(args, matchArgs...)
+
+	var candidates []paymentCorrelationCandidate
+	err := tx.NewRaw(fmt.Sprintf(`
+		SELECT src.id AS for_id, c.*
+		FROM (VALUES %s) AS src (id, connector_id, reference, asset, initial_amount, metadata, created_at, window_start, window_end)
+		CROSS JOIN LATERAL (
+			(
+				SELECT p.sort_id, p.id, p.connector_id, p.reference, p.created_at, p.initial_amount, p.asset, p.metadata
+				FROM payments p
+				WHERE %s
+				ORDER BY p.created_at DESC, p.sort_id ASC
+				LIMIT %d
+			)
+			UNION ALL
+			(
+				SELECT p.sort_id, p.id, p.connector_id, p.reference, p.created_at, p.initial_amount, p.asset, p.metadata
+				FROM payments p
+				WHERE %s
+				ORDER BY p.created_at ASC, p.sort_id ASC
+				LIMIT %d
+			)
+		) AS c`,
+		strings.Join(values, ", "),
+		strings.Join(before, " AND "), maxPaymentCorrelationCandidates,
+		strings.Join(after, " AND "), maxPaymentCorrelationCandidates,
+	), args...).Scan(ctx, &candidates)
+	if err != nil {
+		return nil, e("failed to fetch payment correlation candidates", err)
+	}
+
+	res := make(map[models.PaymentID][]paymentCorrelationCandidate, len(payments))
+	for _, c := range candidates {
+		res[c.ForID] = append(res[c.ForID], c)
+	}
+
+	return res, nil
+}
+
+// paymentsCorrelationClosestMatch returns the candidate matching the rule,
+// the closest in time to p, or nil if there is none.
+func paymentsCorrelationClosestMatch(rule models.PaymentCorrelationRule, p models.Payment, candidates []paymentCorrelationCandidate) *models.PaymentID {
+	distance := func(c paymentCorrelationCandidate) time.Duration {
+		d := c.CreatedAt.Time.Sub(p.CreatedAt)
+		if d < 0 {
+			return -d
+		}
+		return d
+	}
+
+	slices.SortFunc(candidates, func(a, b paymentCorrelationCandidate) int {
+		if c := cmp.Compare(distance(a), distance(b)); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.SortID, b.SortID)
+	})
+
+	if len(candidates) > maxPaymentCorrelationCandidates {
+		candidates = candidates[:maxPaymentCorrelationCandidates]
+	}
+
+	for _, c := range candidates {
+		candidate := models.Payment{
+			ID:            c.ID,
+			ConnectorID:   c.ConnectorID,
+			Reference:     c.Reference,
+			CreatedAt:     c.CreatedAt.Time,
+			InitialAmount: c.InitialAmount,
+			Asset:         c.Asset,
+			Metadata:      c.Metadata,
+		}
+		if rule.Matches(p, candidate) {
+			return &c.ID
+		}
+	}
+
+	return nil
+}
+
+// paymentsCorrelationLink adds the payment to the group of the candidate,
+// creating it if needed. When both payments already belong to different
+// groups, the groups are merged.
+func (s *store) paymentsCorrelationLink(ctx context.Context, tx bun.Tx, ruleID uuid.UUID, at time.Time, paymentID models.PaymentID, candidateID models.PaymentID) (uuid.UUID, error) {
+	var existing []paymentCorrelation
+	err := tx.NewSelect().
+		Model(&existing).
+		Where("payment_id IN (?)", bun.In([]models.PaymentID{paymentID, candidateID})).
+		Scan(ctx)
+	if err != nil {
+		return uuid.Nil, e("failed to fetch payment correlations", err)
+	}
+
+	var candidateGroupID, paymentGroupID uuid.UUID
+	for _, c := range existing {
+		switch c.PaymentID {
+		case candidateID:
+			candidateGroupID = c.GroupID
+		case paymentID:
+			paymentGroupID = c.GroupID
+		}
+	}
+
+	var groupID uuid.UUID
+	switch {
+	case candidateGroupID != uuid.Nil:
+		groupID = candidateGroupID
+	case paymentGroupID != uuid.Nil:
+		groupID = paymentGroupID
+	default:
+		groupID = uuid.New()
+	}
+
+	if paymentGroupID != uuid.Nil && paymentGroupID != groupID {
+		_, err = tx.NewUpdate().
+			Model((*paymentCorrelation)(nil)).
+			Set("group_id = ?", groupID).
+			Where("group_id = ?", paymentGroupID).
+			Exec(ctx)
+		if err != nil {
+			return uuid.Nil, e("failed to merge payment correlation groups", err)
+		}
+	}
+
+	toInsert := []paymentCorrelation{
+		{
+			PaymentID: candidateID,
+			GroupID:   groupID,
+			CreatedAt: internalTime.New(at),
+			RuleID:    &ruleID,
+		},
+		{
+			PaymentID: paymentID,
+			GroupID:   groupID,
+			CreatedAt: internalTime.New(at),
+			RuleID:    &ruleID,
+		},
+	}
+
+	_, err = tx.NewInsert().
+		Model(&toInsert).
+		On("CONFLICT (payment_id) DO NOTHING").
+		Exec(ctx)
+	if err != nil {
+		return uuid.Nil, e("failed to insert payment correlations", err)
+	}
+
+	return groupID, nil
+}
+
+// paymentsCorrelation returns the correlation group of the payment, or nil if
+// it was not linked to any other payment.
+func (s *store) paymentsCorrelation(ctx context.Context, db bun.IDB, id models.PaymentID) (*models.PaymentCorrelation, error) {
+	var own paymentCorrelation
+	err := db.NewSelect().
+		Model(&own).
+		Where("payment_id = ?", id).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, e("failed to get payment correlation", err)
+	}
+
+	var others []paymentCorrelation
+	err = db.NewSelect().
+		Model(&others).
+		Where("group_id = ?", own.GroupID).
+		Where("payment_id != ?", id).
+		Order("created_at ASC", "sort_id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, e("failed to get payment correlation", err)
+	}
+
+	res := &models.PaymentCorrelation{
+		GroupID:  own.GroupID,
+		Payments: make([]models.PaymentCorrelationLink, 0, len(others)),
+	}
+	for _, o := range others {
+		res.Payments = append(res.Payments, models.PaymentCorrelationLink{
+			PaymentID: o.PaymentID,
+			RuleID:    o.RuleID,
+			CreatedAt: o.CreatedAt.Time,
+		})
+	}
+
+	return res, nil
+}
+
+func fromPaymentCorrelationRuleModels(from models.PaymentCorrelationRule) paymentCorrelationRule {
+	return paymentCorrelationRule{
+		ID:             from.ID,
+		CreatedAt:      internalTime.New(from.CreatedAt),
+		Name:           from.Name,
+		MatchReference: from.MatchReference,
+		MatchAmount:    from.MatchAmount,
+		DateWindow:     from.DateWindow,
+		MetadataKeys:   from.MetadataKeys,
+	}
+}
+
+func toPaymentCorrelationRuleModels(from paymentCorrelationRule) models.PaymentCorrelationRule {
+	return models.PaymentCorrelationRule{
+		ID:             from.ID,
+		CreatedAt:      from.CreatedAt.Time,
+		Name:           from.Name,
+		MatchReference: from.MatchReference,
+		MatchAmount:    from.MatchAmount,
+		DateWindow:     from.DateWindow,
+		MetadataKeys:   from.MetadataKeys,
+	}
+}
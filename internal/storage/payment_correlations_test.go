@@ -0,0 +1,193 @@
+package storage
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/observe/log"
+	"github.com/formancehq/go-libs/v5/pkg/query"
+	"github.com/formancehq/go-libs/v5/pkg/storage/bun/paginate"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/formancehq/payments/pkg/events"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func correlationPayment(connectorID models.ConnectorID, reference string, paymentType models.PaymentType, amount int64, createdAt time.Time) models.Payment {
+	return models.Payment{
+		ID: models.PaymentID{
+			PaymentReference: models.PaymentReference{Reference: reference, Type: paymentType},
+			ConnectorID:      connectorID,
+		},
+		ConnectorID:   connectorID,
+		Reference:     reference,
+		CreatedAt:     createdAt,
+		Type:          paymentType,
+		InitialAmount: big.NewInt(amount),
+		Amount:        big.NewInt(amount),
+		Asset:         "EUR/2",
+		Scheme:        models.PAYMENT_SCHEME_SEPA,
+		Status:        models.PAYMENT_STATUS_SUCCEEDED,
+	}
+}
+
+func TestPaymentCorrelationRules(t *testing.T) {
+	t.Parallel()
+
+	ctx := logging.TestingContext()
+	store := newStore(t)
+	defer store.Close()
+
+	rule := models.PaymentCorrelationRule{
+		ID:           uuid.New(),
+		Name:         "wise to banking circle",
+		CreatedAt:    now.Add(-time.Minute).UTC().Time,
+		MatchAmount:  true,
+		MetadataKeys: []string{"end_to_end_id"},
+		DateWindow:   72 * time.Hour,
+	}
+	require.NoError(t, store.PaymentCorrelationRulesCreate(ctx, rule))
+
+	t.Run("list", func(t *testing.T) {
+		cursor, err := store.PaymentCorrelationRulesList(ctx, NewListPaymentCorrelationRulesQuery(
+			paginate.NewPaginatedQueryOptions(PaymentCorrelationRuleQuery{}).
+				WithPageSize(15).
+				WithQueryBuilder(query.Match("name", rule.Name)),
+		))
+		require.NoError(t, err)
+		require.Len(t, cursor.Data, 1)
+		require.Equal(t, rule, cursor.Data[0])
+	})
+
+	t.Run("create duplicate", func(t *testing.T) {
+		err := store.PaymentCorrelationRulesCreate(ctx, rule)
+		require.ErrorIs(t, err, ErrDuplicateKeyValue)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, store.PaymentCorrelationRulesDelete(ctx, rule.ID))
+
+		cursor, err := store.PaymentCorrelationRulesList(ctx, NewListPaymentCorrelationRulesQuery(
+			paginate.NewPaginatedQueryOptions(PaymentCorrelationRuleQuery{}).WithPageSize(15),
+		))
+		require.NoError(t, err)
+		require.Len(t, cursor.Data, 0)
+	})
+
+	t.Run("delete unknown", func(t *testing.T) {
+		err := store.PaymentCorrelationRulesDelete(ctx, uuid.New())
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestPaymentsCorrelate(t *testing.T) {
+	t.Parallel()
+
+	ctx := logging.TestingContext()
+	store := newStore(t)
+	defer store.Close()
+
+	upsertConnector(t, ctx, store, defaultConnector)
+	upsertConnector(t, ctx, store, defaultConnector2)
+
+	rule := models.PaymentCorrelationRule{
+		ID:          uuid.New(),
+		Name:        "amount",
+		CreatedAt:   now.Add(-time.Hour).UTC().Time,
+		MatchAmount: true,
+		DateWindow:  48 * time.Hour,
+	}
+	require.NoError(t, store.PaymentCorrelationRulesCreate(ctx, rule))
+
+	payout := correlationPayment(defaultConnector.ID, "payout1", models.PAYMENT_TYPE_PAYOUT, 1000, now.Add(-30*time.Hour).UTC().Time)
+	payin := correlationPayment(defaultConnector2.ID, "payin1", models.PAYMENT_TYPE_PAYIN, 1000, now.Add(-10*time.Hour).UTC().Time)
+	otherAmount := correlationPayment(defaultConnector2.ID, "payin2", models.PAYMENT_TYPE_PAYIN, 2000, now.Add(-5*time.Hour).UTC().Time)
+
+	upsertPayments(t, ctx, store, []models.Payment{payout})
+	upsertPayments(t, ctx, store, []models.Payment{payin, otherAmount})
+
+	t.Run("payments linked across connectors", func(t *testing.T) {
+		p, err := store.PaymentsGet(ctx, payin.ID)
+		require.NoError(t, err)
+		require.NotNil(t, p.Correlation)
+		require.Len(t, p.Correlation.Payments, 1)
+		require.Equal(t, payout.ID, p.Correlation.Payments[0].PaymentID)
+		require.Equal(t, rule.ID, *p.Correlation.Payments[0].RuleID)
+
+		other, err := store.PaymentsGet(ctx, payout.ID)
+		require.NoError(t, err)
+		require.NotNil(t, other.Correlation)
+		require.Equal(t, p.Correlation.GroupID, other.Correlation.GroupID)
+		require.Equal(t, payin.ID, other.Correlation.Payments[0].PaymentID)
+	})
+
+	t.Run("payment without match", func(t *testing.T) {
+		p, err := store.PaymentsGet(ctx, otherAmount.ID)
+		require.NoError(t, err)
+		require.Nil(t, p.Correlation)
+	})
+
+	t.Run("upsert is idempotent", func(t *testing.T) {
+		upsertPayments(t, ctx, store, []models.Payment{payin})
+
+		p, err := store.PaymentsGet(ctx, payout.ID)
+		require.NoError(t, err)
+		require.Len(t, p.Correlation.Payments, 1)
+	})
+
+	t.Run("outbox event", func(t *testing.T) {
+		pendingEvents, err := store.OutboxEventsPollPending(ctx, 100)
+		require.NoError(t, err)
+
+		var correlationEvents []models.OutboxEvent
+		for _, evt := range pendingEvents {
+			if evt.EventType == events.EventTypeSavedPaymentCorrelation {
+				correlationEvents = append(correlationEvents, evt)
+			}
+		}
+		require.Len(t, correlationEvents, 1)
+		require.Equal(t, payin.ID.String(), correlationEvents[0].EntityID)
+	})
+
+	t.Run("payments left alone are linked once they match", func(t *testing.T) {
+		metadataRule := models.PaymentCorrelationRule{
+			ID:           uuid.New(),
+			Name:         "end to end id",
+			CreatedAt:    now.UTC().Time,
+			MetadataKeys: []string{"end_to_end_id"},
+		}
+		require.NoError(t, store.PaymentCorrelationRulesCreate(ctx, metadataRule))
+
+		transfer := correlationPayment(defaultConnector.ID, "transfer1", models.PAYMENT_TYPE_PAYOUT, 3000, now.Add(-2*time.Hour).UTC().Time)
+		transfer.Metadata = map[string]string{"end_to_end_id": "e2e1"}
+		upsertPayments(t, ctx, store, []models.Payment{transfer})
+
+		counterpart := correlationPayment(defaultConnector2.ID, "transfer2", models.PAYMENT_TYPE_PAYIN, 4000, now.Add(-time.Hour).UTC().Time)
+		upsertPayments(t, ctx, store, []models.Payment{counterpart})
+
+		p, err := store.PaymentsGet(ctx, counterpart.ID)
+		require.NoError(t, err)
+		require.Nil(t, p.Correlation)
+
+		// The connector reports the end to end id on a later fetch.
+		counterpart.Metadata = map[string]string{"end_to_end_id": "e2e1"}
+		upsertPayments(t, ctx, store, []models.Payment{counterpart})
+
+		p, err = store.PaymentsGet(ctx, counterpart.ID)
+		require.NoError(t, err)
+		require.NotNil(t, p.Correlation)
+		require.Len(t, p.Correlation.Payments, 1)
+		require.Equal(t, transfer.ID, p.Correlation.Payments[0].PaymentID)
+		require.Equal(t, metadataRule.ID, *p.Correlation.Payments[0].RuleID)
+	})
+
+	t.Run("links outlive their rule", func(t *testing.T) {
+		require.NoError(t, store.PaymentCorrelationRulesDelete(ctx, rule.ID))
+
+		p, err := store.PaymentsGet(ctx, payin.ID)
+		require.NoError(t, err)
+		require.NotNil(t, p.Correlation)
+		require.Nil(t, p.Correlation.Payments[0].RuleID)
+	})
+}
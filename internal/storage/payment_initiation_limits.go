@@ -0,0 +1,562 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/query"
+	"github.com/formancehq/go-libs/v5/pkg/storage/bun/paginate"
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	internalTime "github.com/formancehq/go-libs/v5/pkg/types/time"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/uptrace/bun"
+)
+
+type paymentInitiationLimit struct {
+	bun.BaseModel `bun:"table:payment_initiation_limits"`
+
+	// Mandatory fields
+	ID        uuid.UUID         `bun:"id,pk,type:uuid,notnull"`
+	CreatedAt internalTime.Time `bun:"created_at,type:timestamp without time zone,notnull"`
+	Name      string            `bun:"name,type:text,notnull"`
+	Type      string            `bun:"type,type:text,notnull"`
+	Scope     string            `bun:"scope,type:text,notnull"`
+	Action    string            `bun:"action,type:text,notnull"`
+	MaxCount  int               `bun:"max_count,type:integer,notnull"`
+
+	// Optional fields
+	// c.f.: https://bun.uptrace.dev/guide/models.html#nulls
+	ConnectorID *models.ConnectorID `bun:"connector_id,type:character varying,nullzero"`
+	Asset       *string             `bun:"asset,type:text,nullzero"`
+	MaxAmount   *big.Int            `bun:"max_amount,type:numeric,nullzero"`
+}
+
+type paymentInitiationLimitUsage struct {
+	bun.BaseModel `bun:"table:payment_initiation_limit_usages"`
+
+	// Mandatory fields
+	LimitID     uuid.UUID         `bun:"limit_id,pk,type:uuid,notnull"`
+	ScopeKey    string            `bun:"scope_key,pk,type:character varying,notnull"`
+	WindowStart internalTime.Time `bun:"window_start,pk,type:timestamp without time zone,notnull"`
+	Amount      *big.Int          `bun:"amount,type:numeric,notnull"`
+	Count       int               `bun:"count,type:integer,notnull"`
+}
+
+type paymentInitiationLimitConsumption struct {
+	bun.BaseModel `bun:"table:payment_initiation_limit_consumptions"`
+
+	// Mandatory fields
+	PaymentInitiationID models.PaymentInitiationID `bun:"payment_initiation_id,pk,type:character varying,notnull"`
+	LimitID             uuid.UUID                  `bun:"limit_id,pk,type:uuid,notnull"`
+	ScopeKey            string                     `bun:"scope_key,type:character varying,notnull"`
+	WindowStart         internalTime.Time          `bun:"window_start,type:timestamp without time zone,notnull"`
+	Amount              *big.Int                   `bun:"amount,type:numeric,notnull"`
+	Count               int                        `bun:"count,type:integer,notnull"`
+}
+
+func (s *store) PaymentInitiationLimitsCreate(ctx context.Context, limit models.PaymentInitiationLimit) error {
+	toInsert := fromPaymentInitiationLimitModels(limit)
+
+	_, err := s.db.NewInsert().
+		Model(&toInsert).
+		Exec(ctx)
+	if err != nil {
+		return e("failed to insert payment initiation limit", err)
+	}
+
+	return nil
+}
+
+// PaymentInitiationLimitsUpdate updates the name, the action and the
+// maximums of the limit. The other fields define the usage counters and
+// cannot be changed.
+func (s *store) PaymentInitiationLimitsUpdate(ctx context.Context, limit models.PaymentInitiationLimit) error {
+	toUpdate := fromPaymentInitiationLimitModels(limit)
+
+	res, err := s.db.NewUpdate().
+		Model(&toUpdate).
+		Column("name", "action", "max_amount", "max_count").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return e("failed to update payment initiation limit", err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return e("failed to update payment initiation limit", err)
+	}
+
+	if rowsAffected == 0 {
+		return e("failed to update payment initiation limit", ErrNotFound)
+	}
+
+	return nil
+}
+
+func (s *store) PaymentInitiationLimitsGet(ctx context.Context, id uuid.UUID) (*models.PaymentInitiationLimit, error) {
+	var limit paymentInitiationLimit
+	err := s.db.NewSelect().
+		Model(&limit).
+		Where("id = ?", id).
+		Scan(ctx)
+	if err != nil {
+		return nil, e("failed to get payment initiation limit", err)
+	}
+
+	res, err := toPaymentInitiationLimitModels(limit)
+	if err != nil {
+		return nil, e("failed to get payment initiation limit", err)
+	}
+
+	return pointer.For(res), nil
+}
+
+func (s *store) PaymentInitiationLimitsDelete(ctx context.Context, id uuid.UUID) error {
+	res, err := s.db.NewDelete().
+		Model((*paymentInitiationLimit)(nil)).
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return e("failed to delete payment initiation limit", err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return e("failed to delete payment initiation limit", err)
+	}
+
+	if rowsAffected == 0 {
+		return e("failed to delete payment initiation limit", ErrNotFound)
+	}
+
+	return nil
+}
+
+type PaymentInitiationLimitQuery struct{}
+
+type ListPaymentInitiationLimitsQuery paginate.OffsetPaginatedQuery[paginate.PaginatedQueryOptions[PaymentInitiationLimitQuery]]
+
+func NewListPaymentInitiationLimitsQuery(opts paginate.PaginatedQueryOptions[PaymentInitiationLimitQuery]) ListPaymentInitiationLimitsQuery {
+	return ListPaymentInitiationLimitsQuery{
+		Order:    paginate.OrderAsc,
+		PageSize: opts.PageSize,
+		Options:  opts,
+	}
+}
+
+func (s *store) paymentInitiationLimitsQueryContext(qb query.Builder) (string, []any, error) {
+	return qb.Build(query.ContextFn(func(key, operator string, value any) (string, []any, error) {
+		switch {
+		case key == "name":
+			return matchText("payment_initiation_limit.name", key, operator, value)
+		case key == "id",
+			key == "type",
+			key == "scope",
+			key == "action",
+			key == "asset":
+			return matchEqual(fmt.Sprintf("payment_initiation_limit.%s", key), key, operator, value)
+		case key == "connectorID", key == "connector_id":
+			return matchEqual("payment_initiation_limit.connector_id", key, operator, value)
+		default:
+			return "", nil, fmt.Errorf("unknown key '%s' when building query: %w", key, ErrValidation)
+		}
+	}))
+}
+
+func (s *store) PaymentInitiationLimitsList(ctx context.Context, q ListPaymentInitiationLimitsQuery) (*paginate.Cursor[models.PaymentInitiationLimit], error) {
+	var (
+		where string
+		args  []any
+		err   error
+	)
+	if q.Options.QueryBuilder != nil {
+		where, args, err = s.paymentInitiationLimitsQueryContext(q.Options.QueryBuilder)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	cursor, err := paginateWithOffset[paginate.PaginatedQueryOptions[PaymentInitiationLimitQuery], paymentInitiationLimit](s, ctx,
+		(*paginate.OffsetPaginatedQuery[paginate.PaginatedQueryOptions[PaymentInitiationLimitQuery]])(&q),
+		func(query *bun.SelectQuery) *bun.SelectQuery {
+			if where != "" {
+				query = query.Where(where, args...)
+			}
+
+			query = query.Order("created_at DESC", "sort_id DESC")
+
+			return query
+		},
+	)
+	if err != nil {
+		return nil, e("failed to fetch payment initiation limits", err)
+	}
+
+	limits := make([]models.PaymentInitiationLimit, 0, len(cursor.Data))
+	for _, l := range cursor.Data {
+		limit, err := toPaymentInitiationLimitModels(l)
+		if err != nil {
+			return nil, e("failed to fetch payment initiation limits", err)
+		}
+		limits = append(limits, limit)
+	}
+
+	return &paginate.Cursor[models.PaymentInitiationLimit]{
+		PageSize: cursor.PageSize,
+		HasMore:  cursor.HasMore,
+		Previous: cursor.Previous,
+		Next:     cursor.Next,
+		Data:     limits,
+	}, nil
+}
+
+// PaymentInitiationLimitUsagesList returns the usage counters of the limit
+// for the window starting at windowStart, one per scope key.
+func (s *store) PaymentInitiationLimitUsagesList(ctx context.Context, limitID uuid.UUID, windowStart time.Time) ([]models.PaymentInitiationLimitUsage, error) {
+	var usages []paymentInitiationLimitUsage
+	err := s.db.NewSelect().
+		Model(&usages).
+		Where("limit_id = ?", limitID).
+		Where("window_start = ?", windowStart.UTC()).
+		Order("scope_key ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, e("failed to fetch payment initiation limit usages", err)
+	}
+
+	res := make([]models.PaymentInitiationLimitUsage, 0, len(usages))
+	for _, u := range usages {
+		res = append(res, toPaymentInitiationLimitUsageModels(u))
+	}
+
+	return res, nil
+}
+
+// PaymentInitiationLimitsCheck returns the limits the payment initiation
+// would exceed at the given time, without consuming them.
+func (s *store) PaymentInitiationLimitsCheck(ctx context.Context, pi models.PaymentInitiation, at time.Time) ([]models.PaymentInitiationLimit, error) {
+	exceeded, _, err := s.paymentInitiationLimitsEvaluate(ctx, s.db, pi, at, false)
+	return exceeded, err
+}
+
+// PaymentInitiationLimitsConsume adds the payment initiation to the usage
+// counters of the limits it is subject to, unless it exceeds one of them with
+// the REJECT action, or with the REQUIRE_APPROVAL action when the payment
+// initiation was not approved. Those limits are returned and nothing is
+// consumed. A payment initiation is only consumed once until it is released.
+func (s *store) PaymentInitiationLimitsConsume(ctx context.Context, pi models.PaymentInitiation, at time.Time, approved bool) ([]models.PaymentInitiationLimit, error) {
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return nil, e("failed to create transaction", err)
+	}
+	defer func() {
+		rollbackOnTxError(ctx, &tx, err)
+	}()
+
+	blocking, err := s.paymentInitiationLimitsConsume(ctx, tx, pi, at, approved)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(blocking) > 0 {
+		if err = tx.Rollback(); err != nil {
+			return nil, e("failed to rollback transaction", err)
+		}
+		return blocking, nil
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, e("failed to commit transaction", err)
+	}
+
+	return nil, nil
+}
+
+// paymentInitiationLimitsConsume returns the limits blocking the payment
+// initiation, or consumes it and records the consumption so that it can be
+// released later.
+func (s *store) paymentInitiationLimitsConsume(ctx context.Context, tx bun.Tx, pi models.PaymentInitiation, at time.Time, approved bool) ([]models.PaymentInitiationLimit, error) {
+	exceeded, usages, err := s.paymentInitiationLimitsEvaluate(ctx, tx, pi, at, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(usages) == 0 {
+		return paymentInitiationLimitsBlocking(exceeded, approved), nil
+	}
+
+	// The limits are locked at this point, a concurrent consumption of the
+	// same payment initiation is either committed or not started.
+	consumed, err := tx.NewSelect().
+		Model((*paymentInitiationLimitConsumption)(nil)).
+		Where("payment_initiation_id = ?", pi.ID).
+		Exists(ctx)
+	if err != nil {
+		return nil, e("failed to fetch payment initiation limit consumptions", err)
+	}
+	if consumed {
+		return nil, nil
+	}
+
+	if blocking := paymentInitiationLimitsBlocking(exceeded, approved); len(blocking) > 0 {
+		return blocking, nil
+	}
+
+	consumptions := make([]paymentInitiationLimitConsumption, 0, len(usages))
+	for _, u := range usages {
+		consumptions = append(consumptions, paymentInitiationLimitConsumption{
+			PaymentInitiationID: pi.ID,
+			LimitID:             u.LimitID,
+			ScopeKey:            u.ScopeKey,
+			WindowStart:         u.WindowStart,
+			Amount:              u.Amount,
+			Count:               u.Count,
+		})
+	}
+
+	_, err = tx.NewInsert().
+		Model(&usages).
+		On("CONFLICT (limit_id, scope_key, window_start) DO UPDATE").
+		Set("amount = payment_initiation_limit_usage.amount + EXCLUDED.amount").
+		Set("count = payment_initiation_limit_usage.count + EXCLUDED.count").
+		Exec(ctx)
+	if err != nil {
+		return nil, e("failed to update payment initiation limit usages", err)
+	}
+
+	_, err = tx.NewInsert().
+		Model(&consumptions).
+		Exec(ctx)
+	if err != nil {
+		return nil, e("failed to insert payment initiation limit consumptions", err)
+	}
+
+	return nil, nil
+}
+
+func paymentInitiationLimitsBlocking(exceeded []models.PaymentInitiationLimit, approved bool) []models.PaymentInitiationLimit {
+	blocking := make([]models.PaymentInitiationLimit, 0, len(exceeded))
+	for _, l := range exceeded {
+		if l.Action == models.PAYMENT_INITIATION_LIMIT_ACTION_REJECT || !approved {
+			blocking = append(blocking, l)
+		}
+	}
+	return blocking
+}
+
+// paymentInitiationLimitsRelease deletes the consumptions of the payment
+// initiation and subtracts them from the usage counters. The limits are
+// locked first, in the same order as when they are consumed.
+func (s *store) paymentInitiationLimitsRelease(ctx context.Context, tx bun.Tx, piID models.PaymentInitiationID) error {
+	var limitIDs []uuid.UUID
+	err := tx.NewSelect().
+		Model((*paymentInitiationLimit)(nil)).
+		Column("id").
+		Where("id IN (?)", tx.NewSelect().
+			Model((*paymentInitiationLimitConsumption)(nil)).
+			Column("limit_id").
+			Where("payment_initiation_id = ?", piID)).
+		Order("id ASC").
+		For("UPDATE").
+		Scan(ctx, &limitIDs)
+	if err != nil {
+		return e("failed to lock payment initiation limits", err)
+	}
+
+	if len(limitIDs) == 0 {
+		return nil
+	}
+
+	_, err = tx.NewRaw(`
+		WITH released AS (
+			DELETE FROM payment_initiation_limit_consumptions
+			WHERE payment_initiation_id = ?
+			RETURNING limit_id, scope_key, window_start, amount, count
+		)
+		UPDATE payment_initiation_limit_usages AS u
+		SET amount = u.amount - released.amount, count = u.count - released.count
+		FROM released
+		WHERE u.limit_id = released.limit_id
+			AND u.scope_key = released.scope_key
+			AND u.window_start = released.window_start`,
+		piID,
+	).Exec(ctx)
+	if err != nil {
+		return e("failed to release payment initiation limit usages", err)
+	}
+
+	return nil
+}
+
+// paymentInitiationLimitsEvaluate returns the limits the payment initiation
+// exceeds, and the increments of the usage counters of the limits it is
+// subject to. With forUpdate, only the limits the payment initiation is
+// subject to are locked, by ascending id.
+func (s *store) paymentInitiationLimitsEvaluate(ctx context.Context, db bun.IDB, pi models.PaymentInitiation, at time.Time, forUpdate bool) ([]models.PaymentInitiationLimit, []paymentInitiationLimitUsage, error) {
+	var candidates []paymentInitiationLimit
+	err := db.NewSelect().
+		Model(&candidates).
+		Where("connector_id IS NULL OR connector_id = ?", pi.ConnectorID).
+		Order("id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, nil, e("failed to fetch payment initiation limits", err)
+	}
+
+	limits := make([]models.PaymentInitiationLimit, 0, len(candidates))
+	for _, l := range candidates {
+		limit, err := toPaymentInitiationLimitModels(l)
+		if err != nil {
+			return nil, nil, e("failed to fetch payment initiation limits", err)
+		}
+
+		if limit.Applies(pi) {
+			limits = append(limits, limit)
+		}
+	}
+
+	if forUpdate && len(limits) > 0 {
+		ids := make([]uuid.UUID, 0, len(limits))
+		for _, l := range limits {
+			ids = append(ids, l.ID)
+		}
+
+		// Reload the locked limits, their maximums may have been updated
+		// since the first select. The ones deleted meanwhile are skipped.
+		var locked []paymentInitiationLimit
+		err := db.NewSelect().
+			Model(&locked).
+			Where("id IN (?)", bun.In(ids)).
+			Order("id ASC").
+			For("UPDATE").
+			Scan(ctx)
+		if err != nil {
+			return nil, nil, e("failed to lock payment initiation limits", err)
+		}
+
+		limits = limits[:0]
+		for _, l := range locked {
+			limit, err := toPaymentInitiationLimitModels(l)
+			if err != nil {
+				return nil, nil, e("failed to fetch payment initiation limits", err)
+			}
+			limits = append(limits, limit)
+		}
+	}
+
+	exceeded := make([]models.PaymentInitiationLimit, 0)
+	usages := make([]paymentInitiationLimitUsage, 0)
+	for _, limit := range limits {
+		usage := models.PaymentInitiationLimitUsage{
+			LimitID:     limit.ID,
+			ScopeKey:    limit.ScopeKey(pi),
+			WindowStart: limit.Type.WindowStart(at),
+			Amount:      big.NewInt(0),
+		}
+
+		if !usage.WindowStart.IsZero() {
+			var current paymentInitiationLimitUsage
+			err := db.NewSelect().
+				Model(&current).
+				Where("limit_id = ?", usage.LimitID).
+				Where("scope_key = ?", usage.ScopeKey).
+				Where("window_start = ?", usage.WindowStart).
+				Scan(ctx)
+			switch {
+			case err == nil:
+				usage = toPaymentInitiationLimitUsageModels(current)
+			case !errors.Is(err, sql.ErrNoRows):
+				return nil, nil, e("failed to fetch payment initiation limit usage", err)
+			}
+
+			increment := paymentInitiationLimitUsage{
+				LimitID:     usage.LimitID,
+				ScopeKey:    usage.ScopeKey,
+				WindowStart: internalTime.New(usage.WindowStart),
+				Amount:      big.NewInt(0),
+				Count:       1,
+			}
+			if !limit.Type.IsCount() && pi.Amount != nil {
+				increment.Amount = new(big.Int).Set(pi.Amount)
+			}
+			usages = append(usages, increment)
+		}
+
+		if limit.Exceeds(pi, usage) {
+			exceeded = append(exceeded, limit)
+		}
+	}
+
+	return exceeded, usages, nil
+}
+
+func fromPaymentInitiationLimitModels(from models.PaymentInitiationLimit) paymentInitiationLimit {
+	var asset *string
+	if from.Asset != "" {
+		asset = pointer.For(from.Asset)
+	}
+
+	return paymentInitiationLimit{
+		ID:          from.ID,
+		CreatedAt:   internalTime.New(from.CreatedAt),
+		Name:        from.Name,
+		Type:        from.Type.String(),
+		Scope:       from.Scope.String(),
+		Action:      from.Action.String(),
+		MaxCount:    from.MaxCount,
+		ConnectorID: from.ConnectorID,
+		Asset:       asset,
+		MaxAmount:   from.MaxAmount,
+	}
+}
+
+func toPaymentInitiationLimitModels(from paymentInitiationLimit) (models.PaymentInitiationLimit, error) {
+	typ, err := models.PaymentInitiationLimitTypeFromString(from.Type)
+	if err != nil {
+		return models.PaymentInitiationLimit{}, err
+	}
+
+	scope, err := models.PaymentInitiationLimitScopeFromString(from.Scope)
+	if err != nil {
+		return models.PaymentInitiationLimit{}, err
+	}
+
+	action, err := models.PaymentInitiationLimitActionFromString(from.Action)
+	if err != nil {
+		return models.PaymentInitiationLimit{}, err
+	}
+
+	var asset string
+	if from.Asset != nil {
+		asset = *from.Asset
+	}
+
+	return models.PaymentInitiationLimit{
+		ID:          from.ID,
+		Name:        from.Name,
+		CreatedAt:   from.CreatedAt.Time,
+		Type:        typ,
+		Scope:       scope,
+		Action:      action,
+		ConnectorID: from.ConnectorID,
+		Asset:       asset,
+		MaxAmount:   from.MaxAmount,
+		MaxCount:    from.MaxCount,
+	}, nil
+}
+
+func toPaymentInitiationLimitUsageModels(from paymentInitiationLimitUsage) models.PaymentInitiationLimitUsage {
+	return models.PaymentInitiationLimitUsage{
+		LimitID:     from.LimitID,
+		ScopeKey:    from.ScopeKey,
+		WindowStart: from.WindowStart.Time,
+		Amount:      from.Amount,
+		Count:       from.Count,
+	}
+}
@@ -0,0 +1,305 @@
+package storage
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/observe/log"
+	"github.com/formancehq/go-libs/v5/pkg/query"
+	"github.com/formancehq/go-libs/v5/pkg/storage/bun/paginate"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentInitiationLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := logging.TestingContext()
+	store := newStore(t)
+	defer store.Close()
+
+	upsertConnector(t, ctx, store, defaultConnector)
+
+	limit := models.PaymentInitiationLimit{
+		ID:          uuid.New(),
+		Name:        "daily eur",
+		CreatedAt:   now.Add(-time.Minute).UTC().Time,
+		Type:        models.PAYMENT_INITIATION_LIMIT_TYPE_DAILY_AMOUNT,
+		Scope:       models.PAYMENT_INITIATION_LIMIT_SCOPE_CONNECTOR,
+		Action:      models.PAYMENT_INITIATION_LIMIT_ACTION_REJECT,
+		ConnectorID: &defaultConnector.ID,
+		Asset:       "EUR/2",
+		MaxAmount:   big.NewInt(1000),
+	}
+	require.NoError(t, store.PaymentInitiationLimitsCreate(ctx, limit))
+
+	t.Run("get", func(t *testing.T) {
+		res, err := store.PaymentInitiationLimitsGet(ctx, limit.ID)
+		require.NoError(t, err)
+		require.Equal(t, limit, *res)
+	})
+
+	t.Run("get unknown", func(t *testing.T) {
+		_, err := store.PaymentInitiationLimitsGet(ctx, uuid.New())
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		cursor, err := store.PaymentInitiationLimitsList(ctx, NewListPaymentInitiationLimitsQuery(
+			paginate.NewPaginatedQueryOptions(PaymentInitiationLimitQuery{}).
+				WithPageSize(15).
+				WithQueryBuilder(query.Match("type", "DAILY_AMOUNT")),
+		))
+		require.NoError(t, err)
+		require.Len(t, cursor.Data, 1)
+		require.Equal(t, limit, cursor.Data[0])
+	})
+
+	t.Run("update", func(t *testing.T) {
+		updated := limit
+		updated.Name = "daily eur updated"
+		updated.MaxAmount = big.NewInt(2000)
+		require.NoError(t, store.PaymentInitiationLimitsUpdate(ctx, updated))
+
+		res, err := store.PaymentInitiationLimitsGet(ctx, limit.ID)
+		require.NoError(t, err)
+		require.Equal(t, updated, *res)
+
+		require.NoError(t, store.PaymentInitiationLimitsUpdate(ctx, limit))
+	})
+
+	t.Run("update unknown", func(t *testing.T) {
+		unknown := limit
+		unknown.ID = uuid.New()
+		err := store.PaymentInitiationLimitsUpdate(ctx, unknown)
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("create duplicate", func(t *testing.T) {
+		err := store.PaymentInitiationLimitsCreate(ctx, limit)
+		require.ErrorIs(t, err, ErrDuplicateKeyValue)
+	})
+
+	t.Run("delete unknown", func(t *testing.T) {
+		err := store.PaymentInitiationLimitsDelete(ctx, uuid.New())
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestPaymentInitiationLimitsConsume(t *testing.T) {
+	t.Parallel()
+
+	ctx := logging.TestingContext()
+	store := newStore(t)
+	defer store.Close()
+
+	upsertConnector(t, ctx, store, defaultConnector)
+
+	daily := models.PaymentInitiationLimit{
+		ID:        uuid.New(),
+		Name:      "daily eur",
+		CreatedAt: now.Add(-time.Minute).UTC().Time,
+		Type:      models.PAYMENT_INITIATION_LIMIT_TYPE_DAILY_AMOUNT,
+		Scope:     models.PAYMENT_INITIATION_LIMIT_SCOPE_CONNECTOR,
+		Action:    models.PAYMENT_INITIATION_LIMIT_ACTION_REQUIRE_APPROVAL,
+		Asset:     "EUR/2",
+		MaxAmount: big.NewInt(250),
+	}
+	hourly := models.PaymentInitiationLimit{
+		ID:        uuid.New(),
+		Name:      "hourly",
+		CreatedAt: now.Add(-time.Minute).UTC().Time,
+		Type:      models.PAYMENT_INITIATION_LIMIT_TYPE_HOURLY_COUNT,
+		Scope:     models.PAYMENT_INITIATION_LIMIT_SCOPE_CONNECTOR,
+		Action:    models.PAYMENT_INITIATION_LIMIT_ACTION_REJECT,
+		MaxCount:  3,
+	}
+	require.NoError(t, store.PaymentInitiationLimitsCreate(ctx, daily))
+	require.NoError(t, store.PaymentInitiationLimitsCreate(ctx, hourly))
+
+	at := time.Date(2024, 3, 15, 13, 45, 0, 0, time.UTC)
+	pi := models.PaymentInitiation{
+		ConnectorID: defaultConnector.ID,
+		Type:        models.PAYMENT_INITIATION_TYPE_PAYOUT,
+		Amount:      big.NewInt(100),
+		Asset:       "EUR/2",
+	}
+	newPI := func(t *testing.T, amount int64) models.PaymentInitiation {
+		p := pi
+		p.Reference = uuid.New().String()
+		p.ID = models.PaymentInitiationID{
+			Reference:   p.Reference,
+			ConnectorID: defaultConnector.ID,
+		}
+		p.CreatedAt = now.UTC().Time
+		p.Amount = big.NewInt(amount)
+		require.NoError(t, store.PaymentInitiationsInsert(ctx, p))
+		return p
+	}
+	requireUsage := func(t *testing.T, amount int64, count int) {
+		usages, err := store.PaymentInitiationLimitUsagesList(ctx, daily.ID, daily.Type.WindowStart(at))
+		require.NoError(t, err)
+		require.Len(t, usages, 1)
+		require.Equal(t, defaultConnector.ID.String(), usages[0].ScopeKey)
+		require.Equal(t, big.NewInt(amount), usages[0].Amount)
+
+		usages, err = store.PaymentInitiationLimitUsagesList(ctx, hourly.ID, hourly.Type.WindowStart(at))
+		require.NoError(t, err)
+		require.Len(t, usages, 1)
+		require.Equal(t, count, usages[0].Count)
+	}
+
+	var approved models.PaymentInitiation
+	t.Run("consume under the limits", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			exceeded, err := store.PaymentInitiationLimitsConsume(ctx, newPI(t, 100), at, false)
+			require.NoError(t, err)
+			require.Empty(t, exceeded)
+		}
+
+		requireUsage(t, 200, 2)
+	})
+
+	t.Run("consume once", func(t *testing.T) {
+		p := newPI(t, 10)
+		for i := 0; i < 2; i++ {
+			exceeded, err := store.PaymentInitiationLimitsConsume(ctx, p, at, false)
+			require.NoError(t, err)
+			require.Empty(t, exceeded)
+		}
+		requireUsage(t, 210, 3)
+
+		require.NoError(t, store.PaymentInitiationAdjustmentsUpsert(ctx, models.PaymentInitiationAdjustment{
+			ID: models.PaymentInitiationAdjustmentID{
+				PaymentInitiationID: p.ID,
+				CreatedAt:           now.UTC().Time,
+				Status:              models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_REJECTED,
+			},
+			CreatedAt: now.UTC().Time,
+			Status:    models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_REJECTED,
+		}))
+		requireUsage(t, 200, 2)
+	})
+
+	t.Run("check does not consume", func(t *testing.T) {
+		exceeded, err := store.PaymentInitiationLimitsCheck(ctx, pi, at)
+		require.NoError(t, err)
+		require.Len(t, exceeded, 1)
+		require.Equal(t, daily.ID, exceeded[0].ID)
+
+		requireUsage(t, 200, 2)
+	})
+
+	t.Run("require approval", func(t *testing.T) {
+		approved = newPI(t, 100)
+		exceeded, err := store.PaymentInitiationLimitsConsume(ctx, approved, at, false)
+		require.NoError(t, err)
+		require.Len(t, exceeded, 1)
+		require.Equal(t, daily.ID, exceeded[0].ID)
+
+		// Approved payment initiations go over REQUIRE_APPROVAL limits
+		exceeded, err = store.PaymentInitiationLimitsConsume(ctx, approved, at, true)
+		require.NoError(t, err)
+		require.Empty(t, exceeded)
+		requireUsage(t, 300, 3)
+	})
+
+	t.Run("reject", func(t *testing.T) {
+		exceeded, err := store.PaymentInitiationLimitsConsume(ctx, newPI(t, 100), at, true)
+		require.NoError(t, err)
+		require.Len(t, exceeded, 1)
+		require.Equal(t, hourly.ID, exceeded[0].ID)
+
+		requireUsage(t, 300, 3)
+	})
+
+	t.Run("release on failure", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			createdAt := now.Add(time.Duration(i) * time.Minute).UTC().Time
+			require.NoError(t, store.PaymentInitiationAdjustmentsUpsert(ctx, models.PaymentInitiationAdjustment{
+				ID: models.PaymentInitiationAdjustmentID{
+					PaymentInitiationID: approved.ID,
+					CreatedAt:           createdAt,
+					Status:              models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_FAILED,
+				},
+				CreatedAt: createdAt,
+				Status:    models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_FAILED,
+			}))
+
+			// Released once only
+			requireUsage(t, 200, 2)
+		}
+	})
+
+	t.Run("insert and consume", func(t *testing.T) {
+		waiting := pi
+		waiting.Reference = uuid.New().String()
+		waiting.ID = models.PaymentInitiationID{Reference: waiting.Reference, ConnectorID: defaultConnector.ID}
+		waiting.CreatedAt = now.UTC().Time
+		exceeded, err := store.PaymentInitiationsInsertAndConsumeLimits(ctx, waiting, at)
+		require.NoError(t, err)
+		require.Len(t, exceeded, 1)
+		require.Equal(t, daily.ID, exceeded[0].ID)
+
+		// Inserted without consuming, it waits for an approval
+		_, err = store.PaymentInitiationsGet(ctx, waiting.ID)
+		require.NoError(t, err)
+		requireUsage(t, 200, 2)
+
+		sent := waiting
+		sent.Reference = uuid.New().String()
+		sent.ID = models.PaymentInitiationID{Reference: sent.Reference, ConnectorID: defaultConnector.ID}
+		sent.Amount = big.NewInt(10)
+		exceeded, err = store.PaymentInitiationsInsertAndConsumeLimits(ctx, sent, at)
+		require.NoError(t, err)
+		require.Empty(t, exceeded)
+		requireUsage(t, 210, 3)
+
+		rejected := sent
+		rejected.Reference = uuid.New().String()
+		rejected.ID = models.PaymentInitiationID{Reference: rejected.Reference, ConnectorID: defaultConnector.ID}
+		exceeded, err = store.PaymentInitiationsInsertAndConsumeLimits(ctx, rejected, at)
+		require.NoError(t, err)
+		require.Len(t, exceeded, 1)
+		require.Equal(t, hourly.ID, exceeded[0].ID)
+
+		// Nothing is inserted when a limit rejects it
+		_, err = store.PaymentInitiationsGet(ctx, rejected.ID)
+		require.ErrorIs(t, err, ErrNotFound)
+		requireUsage(t, 210, 3)
+
+		// Deleting it gives back what it consumed
+		require.NoError(t, store.PaymentInitiationsDelete(ctx, sent.ID))
+		requireUsage(t, 200, 2)
+	})
+
+	t.Run("next window", func(t *testing.T) {
+		exceeded, err := store.PaymentInitiationLimitsConsume(ctx, newPI(t, 300), at.Add(time.Hour), false)
+		require.NoError(t, err)
+		require.Len(t, exceeded, 1)
+		require.Equal(t, daily.ID, exceeded[0].ID)
+
+		exceeded, err = store.PaymentInitiationLimitsConsume(ctx, newPI(t, 100), at.Add(24*time.Hour), false)
+		require.NoError(t, err)
+		require.Empty(t, exceeded)
+	})
+
+	t.Run("other asset", func(t *testing.T) {
+		usd := pi
+		usd.Asset = "USD/2"
+		usd.Amount = big.NewInt(1000)
+		exceeded, err := store.PaymentInitiationLimitsCheck(ctx, usd, at.Add(24*time.Hour))
+		require.NoError(t, err)
+		require.Empty(t, exceeded)
+	})
+
+	t.Run("delete removes usages", func(t *testing.T) {
+		require.NoError(t, store.PaymentInitiationLimitsDelete(ctx, daily.ID))
+
+		usages, err := store.PaymentInitiationLimitUsagesList(ctx, daily.ID, daily.Type.WindowStart(at))
+		require.NoError(t, err)
+		require.Empty(t, usages)
+	})
+}
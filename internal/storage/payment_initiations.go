@@ -80,6 +80,59 @@ func (s *store) PaymentInitiationsInsert(ctx context.Context, pi models.PaymentI
 		rollbackOnTxError(ctx, &tx, err)
 	}()
 
+	if err = s.paymentInitiationsInsert(ctx, tx, pi, adjustments...); err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return e("failed to commit transaction", err)
+	}
+	return nil
+}
+
+// PaymentInitiationsInsertAndConsumeLimits inserts the payment initiation
+// and consumes the limits it is subject to in the same transaction, see
+// PaymentInitiationLimitsConsume. Nothing is inserted when one of the
+// exceeded limits has the REJECT action. When the exceeded limits all require
+// an approval, the payment initiation is inserted without consuming them.
+// The exceeded limits are returned in both cases.
+func (s *store) PaymentInitiationsInsertAndConsumeLimits(ctx context.Context, pi models.PaymentInitiation, at stdtime.Time, adjustments ...models.PaymentInitiationAdjustment) (exceeded []models.PaymentInitiationLimit, err error) {
+	var tx bun.Tx
+	tx, err = s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, e("upsert payment initiations", err)
+	}
+	defer func() {
+		rollbackOnTxError(ctx, &tx, err)
+	}()
+
+	if err = s.paymentInitiationsInsert(ctx, tx, pi, adjustments...); err != nil {
+		return nil, err
+	}
+
+	exceeded, err = s.paymentInitiationLimitsConsume(ctx, tx, pi, at, false)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, l := range exceeded {
+		if l.Action == models.PAYMENT_INITIATION_LIMIT_ACTION_REJECT {
+			if err = tx.Rollback(); err != nil {
+				return nil, e("failed to rollback transaction", err)
+			}
+			return exceeded, nil
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, e("failed to commit transaction", err)
+	}
+	return exceeded, nil
+}
+
+func (s *store) paymentInitiationsInsert(ctx context.Context, tx bun.Tx, pi models.PaymentInitiation, adjustments ...models.PaymentInitiationAdjustment) (err error) {
 	toInsert := fromPaymentInitiationModels(pi)
 	adjustmentsToInsert := make([]paymentInitiationAdjustment, 0, len(adjustments))
 	for _, adj := range adjustments {
@@ -189,10 +242,6 @@ func (s *store) PaymentInitiationsInsert(ctx context.Context, pi models.PaymentI
 		}
 	}
 
-	err = tx.Commit()
-	if err != nil {
-		return e("failed to commit transaction", err)
-	}
 	return nil
 }
 
@@ -261,15 +310,39 @@ func (s *store) PaymentInitiationsDeleteFromConnectorID(ctx context.Context, con
 	return e("failed to delete payment initiations", err)
 }
 
-func (s *store) PaymentInitiationsDelete(ctx context.Context, piID models.PaymentInitiationID) error {
-	_, err := s.db.NewDelete().
+func (s *store) PaymentInitiationsDelete(ctx context.Context, piID models.PaymentInitiationID) (err error) {
+	var tx bun.Tx
+	tx, err = s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return e("failed to begin transaction", err)
+	}
+	defer func() {
+		rollbackOnTxError(ctx, &tx, err)
+	}()
+
+	// A payment initiation waiting for a validation may have consumed its
+	// limits already if it could not be sent to the connector.
+	if err = s.paymentInitiationLimitsRelease(ctx, tx, piID); err != nil {
+		return err
+	}
+
+	_, err = tx.NewDelete().
 		Model((*paymentInitiation)(nil)).
 		Where("id = ?", piID).
 		Exec(ctx)
-	return e("failed to delete payment initiation", err)
+	if err != nil {
+		return e("failed to delete payment initiation", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return e("failed to commit transaction", err)
+	}
+	return nil
 }
 
-type PaymentInitiationQuery struct{}
+type PaymentInitiationQuery struct {
+	Sort []SortKey `json:"sort,omitempty"`
+}
 
 type ListPaymentInitiationsQuery paginate.OffsetPaginatedQuery[paginate.PaginatedQueryOptions[PaymentInitiationQuery]]
 
@@ -281,46 +354,46 @@ func NewListPaymentInitiationsQuery(opts paginate.PaginatedQueryOptions[PaymentI
 	}
 }
 
+var paymentInitiationsSortColumns = map[string]string{
+	"created_at":   "payment_initiation.created_at",
+	"scheduled_at": "payment_initiation.scheduled_at",
+	"reference":    "payment_initiation.reference",
+	"amount":       "payment_initiation.amount",
+}
+
 func (s *store) paymentsInitiationQueryContext(qb query.Builder) (string, string, []any, error) {
 	join := ""
 	where, args, err := qb.Build(query.ContextFn(func(key, operator string, value any) (string, []any, error) {
 		switch {
-		case key == "reference",
-			key == "id",
+		case key == "reference":
+			return matchText("payment_initiation.reference", key, operator, value)
+		case key == "id",
 			key == "connector_id",
 			key == "type",
 			key == "asset",
 			key == "source_account_id",
 			key == "destination_account_id":
-			if operator != "$match" {
-				return "", nil, e(fmt.Sprintf("'%s' column can only be used with $match", key), ErrValidation)
-			}
-			return fmt.Sprintf("%s = ?", key), []any{value}, nil
+			return matchEqual("payment_initiation."+key, key, operator, value)
 
 		case key == "status":
-			if operator != "$match" {
-				return "", nil, e(fmt.Sprintf("'%s' column can only be used with $match", key), ErrValidation)
-			}
-
 			// we only care about the latest adjustment, so we need to sort the adjustments
 			join = `JOIN payment_initiation_adjustments AS current_adj
 ON (current_adj.payment_initiation_id = payment_initiation.id)
 LEFT OUTER JOIN payment_initiation_adjustments newer_adj
 ON (newer_adj.payment_initiation_id = payment_initiation.id AND current_adj.sort_id < newer_adj.sort_id)`
 
-			return fmt.Sprintf("current_adj.%s = ? AND newer_adj.id IS NULL", key), []any{value}, nil
+			clause, args, err := matchEqual("current_adj.status", key, operator, value)
+			if err != nil {
+				return "", nil, err
+			}
+			return clause + " AND newer_adj.id IS NULL", args, nil
+		case key == "created_at",
+			key == "scheduled_at":
+			return matchDate("payment_initiation."+key, key, operator, value)
 		case key == "amount":
-			return fmt.Sprintf("%s %s ?", key, query.DefaultComparisonOperatorsMapping[operator]), []any{value}, nil
+			return fmt.Sprintf("payment_initiation.%s %s ?", key, query.DefaultComparisonOperatorsMapping[operator]), []any{value}, nil
 		case metadataRegex.Match([]byte(key)):
-			if operator != "$match" {
-				return "", nil, e(fmt.Sprintf("'%s' column can only be used with $match", key), ErrValidation)
-			}
-			match := metadataRegex.FindAllStringSubmatch(key, 3)
-
-			key := "metadata"
-			return key + " @> ?", []any{map[string]any{
-				match[0][1]: value,
-			}}, nil
+			return matchMetadata("payment_initiation.metadata", key, operator, value)
 		}
 		return "", nil, e(fmt.Sprintf("unknown key '%s' when building query", key), ErrValidation)
 	}))
@@ -342,6 +415,11 @@ func (s *store) PaymentInitiationsList(ctx context.Context, q ListPaymentInitiat
 		}
 	}
 
+	orderBy, err := sortOrder(q.Options.Options.Sort, paymentInitiationsSortColumns, "payment_initiation.created_at", "payment_initiation.sort_id")
+	if err != nil {
+		return nil, err
+	}
+
 	cursor, err := paginateWithOffset[paginate.PaginatedQueryOptions[PaymentInitiationQuery], paymentInitiation](s, ctx,
 		(*paginate.OffsetPaginatedQuery[paginate.PaginatedQueryOptions[PaymentInitiationQuery]])(&q),
 		func(query *bun.SelectQuery) *bun.SelectQuery {
@@ -353,8 +431,7 @@ func (s *store) PaymentInitiationsList(ctx context.Context, q ListPaymentInitiat
 				query = query.Where(where, args...)
 			}
 
-			// TODO(polo): sorter ?
-			query = query.Order("payment_initiation.created_at DESC", "payment_initiation.sort_id DESC")
+			query = query.Order(orderBy...)
 
 			return query
 		},
@@ -576,6 +653,12 @@ func (s *store) PaymentInitiationAdjustmentsUpsert(ctx context.Context, adj mode
 		if err = s.OutboxEventsInsert(ctx, tx, []models.OutboxEvent{outboxEvent}); err != nil {
 			return err
 		}
+
+		if releasesPaymentInitiationLimits(adj.Status) {
+			if err = s.paymentInitiationLimitsRelease(ctx, tx, adj.ID.PaymentInitiationID); err != nil {
+				return err
+			}
+		}
 	}
 
 	if err = tx.Commit(); err != nil {
@@ -670,6 +753,12 @@ func (s *store) PaymentInitiationAdjustmentsUpsertIfPredicate(
 		if err = s.OutboxEventsInsert(ctx, tx, []models.OutboxEvent{outboxEvent}); err != nil {
 			return false, err
 		}
+
+		if releasesPaymentInitiationLimits(adj.Status) {
+			if err = s.paymentInitiationLimitsRelease(ctx, tx, adj.ID.PaymentInitiationID); err != nil {
+				return false, err
+			}
+		}
 	}
 
 	err = tx.Commit()
@@ -679,6 +768,18 @@ func (s *store) PaymentInitiationAdjustmentsUpsertIfPredicate(
 	return rowsAffected > 0, nil
 }
 
+// releasesPaymentInitiationLimits returns whether a payment initiation
+// reaching the status gives back what it consumed of its limits.
+func releasesPaymentInitiationLimits(status models.PaymentInitiationAdjustmentStatus) bool {
+	switch status {
+	case models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_FAILED,
+		models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_REJECTED:
+		return true
+	default:
+		return false
+	}
+}
+
 func (s *store) PaymentInitiationAdjustmentsGet(ctx context.Context, id models.PaymentInitiationAdjustmentID) (*models.PaymentInitiationAdjustment, error) {
 	var adj paymentInitiationAdjustment
 	err := s.db.NewSelect().
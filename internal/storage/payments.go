@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"slices"
 	"time"
 
 	"github.com/formancehq/go-libs/v5/pkg/query"
@@ -42,6 +43,7 @@ type payment struct {
 	DestinationAccountID    *models.AccountID `bun:"destination_account_id,type:character varying,nullzero"`
 	PsuID                   *uuid.UUID        `bun:"psu_id,type:uuid,nullzero"`
 	OpenBankingConnectionID *string           `bun:"open_banking_connection_id,type:character varying,nullzero"`
+	CounterpartyID          *uuid.UUID        `bun:"counterparty_id,type:uuid,nullzero"`
 
 	// Optional fields with default
 	// c.f. https://bun.uptrace.dev/guide/models.html#default
@@ -69,16 +71,72 @@ type paymentAdjustment struct {
 	Metadata map[string]string `bun:"metadata,type:jsonb,nullzero,notnull,default:'{}'"`
 }
 
-func (s *store) PaymentsUpsert(ctx context.Context, payments []models.Payment) error {
-	paymentsToInsert := make([]payment, 0, len(payments))
-	adjustmentsToInsert := make([]paymentAdjustment, 0)
+// attributePayinsToAccountsPSU attributes the incoming payments received on
+// an account issued for a payment service user (e.g. a virtual account) to
+// that user, when the PSP did not already do it. The payments of the caller
+// are left untouched.
+func (s *store) attributePayinsToAccountsPSU(ctx context.Context, tx bun.Tx, payments []models.Payment) ([]models.Payment, error) {
+	isCandidate := func(p models.Payment) bool {
+		return p.Type == models.PAYMENT_TYPE_PAYIN && p.PsuID == nil && p.DestinationAccountID != nil
+	}
+
+	accountIDs := make([]models.AccountID, 0)
 	for _, p := range payments {
-		paymentsToInsert = append(paymentsToInsert, fromPaymentModels(p))
-		for _, a := range p.Adjustments {
-			adjustmentsToInsert = append(adjustmentsToInsert, fromPaymentAdjustmentModels(a))
+		if isCandidate(p) {
+			accountIDs = append(accountIDs, *p.DestinationAccountID)
 		}
 	}
 
+	if len(accountIDs) == 0 {
+		return payments, nil
+	}
+
+	var accounts []account
+	err := tx.NewSelect().
+		Model(&accounts).
+		Column("id", "psu_id").
+		Where("id IN (?)", bun.In(accountIDs)).
+		Where("psu_id IS NOT NULL").
+		Scan(ctx)
+	if err != nil {
+		return nil, e("failed to get accounts payment service users", err)
+	}
+
+	if len(accounts) == 0 {
+		return payments, nil
+	}
+
+	psuIDs := make(map[models.AccountID]uuid.UUID, len(accounts))
+	for _, a := range accounts {
+		psuIDs[a.ID] = *a.PsuID
+	}
+
+	res := slices.Clone(payments)
+	for i := range res {
+		if !isCandidate(res[i]) {
+			continue
+		}
+
+		if psuID, ok := psuIDs[*res[i].DestinationAccountID]; ok {
+			res[i].PsuID = &psuID
+		}
+	}
+
+	return res, nil
+}
+
+type paymentFee struct {
+	bun.BaseModel `bun:"table:payment_fees"`
+
+	// Mandatory fields
+	PaymentID models.PaymentID      `bun:"payment_id,pk,type:character varying,notnull"`
+	Reference string                `bun:"reference,pk,type:text,notnull"`
+	Type      models.PaymentFeeType `bun:"type,pk,type:text,notnull"`
+	Asset     string                `bun:"asset,pk,type:text,notnull"`
+	Amount    *big.Int              `bun:"amount,type:numeric,notnull"`
+}
+
+func (s *store) PaymentsUpsert(ctx context.Context, payments []models.Payment) error {
 	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
 	if err != nil {
 		return e("failed to create transaction", err)
@@ -87,6 +145,40 @@ func (s *store) PaymentsUpsert(ctx context.Context, payments []models.Payment) e
 		rollbackOnTxError(ctx, &tx, err)
 	}()
 
+	payments, err = s.attributePayinsToAccountsPSU(ctx, tx, payments)
+	if err != nil {
+		return err
+	}
+
+	paymentsToInsert := make([]payment, 0, len(payments))
+	adjustmentsToInsert := make([]paymentAdjustment, 0)
+	feesToInsert := make([]paymentFee, 0)
+	// A fee reported twice in the same batch must be inserted once, postgres
+	// refuses to update the same row twice in one statement. The last one wins.
+	type paymentFeeKey struct {
+		paymentID models.PaymentID
+		reference string
+		feeType   models.PaymentFeeType
+		asset     string
+	}
+	feesSeen := make(map[paymentFeeKey]int)
+	for _, p := range payments {
+		paymentsToInsert = append(paymentsToInsert, fromPaymentModels(p))
+		for _, a := range p.Adjustments {
+			adjustmentsToInsert = append(adjustmentsToInsert, fromPaymentAdjustmentModels(a))
+		}
+		for _, f := range p.Fees {
+			fee := fromPaymentFeeModels(p.ID, f)
+			key := paymentFeeKey{paymentID: fee.PaymentID, reference: fee.Reference, feeType: fee.Type, asset: fee.Asset}
+			if i, ok := feesSeen[key]; ok {
+				feesToInsert[i] = fee
+				continue
+			}
+			feesSeen[key] = len(feesToInsert)
+			feesToInsert = append(feesToInsert, fee)
+		}
+	}
+
 	if len(paymentsToInsert) > 0 {
 		_, err = tx.NewInsert().
 			Model(&paymentsToInsert).
@@ -114,6 +206,19 @@ func (s *store) PaymentsUpsert(ctx context.Context, payments []models.Payment) e
 		}
 	}
 
+	// Fees can be revised by the PSP until the payment settles, the last
+	// reported amount is kept.
+	if len(feesToInsert) > 0 {
+		_, err = tx.NewInsert().
+			Model(&feesToInsert).
+			On("CONFLICT (payment_id, reference, type, asset) DO UPDATE").
+			Set("amount = EXCLUDED.amount").
+			Exec(ctx)
+		if err != nil {
+			return e("failed to insert payment fees", err)
+		}
+	}
+
 	// paymentMap resolves the full payment model for an inserted adjustment, both for the
 	// amount deltas below and for the outbox events further down.
 	paymentMap := make(map[models.PaymentID]models.Payment, len(payments))
@@ -242,6 +347,7 @@ func (s *store) PaymentsUpsert(ctx context.Context, payments []models.Payment) e
 				Provider:      models.ToV3Provider(payment.ConnectorID.Provider),
 				RawData:       adj.Raw,
 				Metadata:      payment.Metadata,
+				Fees:          internalEvents.NewPaymentFeesMessagePayload(payment.Fees),
 			}
 
 			if payment.SourceAccountID != nil {
@@ -285,6 +391,44 @@ func (s *store) PaymentsUpsert(ctx context.Context, payments []models.Payment) e
 		}
 	}
 
+	// Payments reported before their external account was linked to a
+	// counterparty are picked up by counterpartiesUpsert instead.
+	if len(paymentMap) > 0 {
+		paymentIDs := make([]models.PaymentID, 0, len(paymentMap))
+		for id := range paymentMap {
+			paymentIDs = append(paymentIDs, id)
+		}
+		if err = linkPaymentsToCounterparties(ctx, tx, "payments.id IN (?)", bun.In(paymentIDs)); err != nil {
+			return err
+		}
+	}
+
+	if len(paymentMap) > 0 {
+		// The last version of a payment reported twice in the batch wins, as
+		// for paymentMap.
+		toCorrelate := make([]models.Payment, 0, len(paymentMap))
+		seen := make(map[models.PaymentID]struct{}, len(paymentMap))
+		for _, p := range payments {
+			if _, ok := seen[p.ID]; ok {
+				continue
+			}
+			seen[p.ID] = struct{}{}
+			toCorrelate = append(toCorrelate, paymentMap[p.ID])
+		}
+
+		var correlationEvents []models.OutboxEvent
+		correlationEvents, err = s.paymentsCorrelate(ctx, tx, toCorrelate)
+		if err != nil {
+			return err
+		}
+
+		if len(correlationEvents) > 0 {
+			if err = s.OutboxEventsInsert(ctx, tx, correlationEvents); err != nil {
+				return err
+			}
+		}
+	}
+
 	if err = tx.Commit(); err != nil {
 		return e("failed to commit transaction", err)
 	}
@@ -359,14 +503,26 @@ func (s *store) PaymentsGet(ctx context.Context, id models.PaymentID) (*models.P
 		adjustments = append(adjustments, toPaymentAdjustmentModels(a))
 	}
 
+	fees, err := s.paymentsFees(ctx, payment.ID)
+	if err != nil {
+		return nil, err
+	}
+
 	status := models.PAYMENT_STATUS_PENDING
 	if len(adjustments) > 0 {
 		// This list is ordered by created_at DESC, so the first element is the
 		// last adjustment, and we want the last status.
 		status = adjustments[0].Status
 	}
+	correlation, err := s.paymentsCorrelation(ctx, s.db, payment.ID)
+	if err != nil {
+		return nil, err
+	}
+
 	res := toPaymentModels(payment, status)
 	res.Adjustments = adjustments
+	res.Fees = fees[payment.ID]
+	res.Correlation = correlation
 	return &res, nil
 }
 
@@ -397,6 +553,11 @@ func (s *store) PaymentsGetByReference(ctx context.Context, reference string, co
 		adjustments = append(adjustments, toPaymentAdjustmentModels(a))
 	}
 
+	fees, err := s.paymentsFees(ctx, payment.ID)
+	if err != nil {
+		return nil, err
+	}
+
 	status := models.PAYMENT_STATUS_PENDING
 	if len(adjustments) > 0 {
 		// This list is ordered by created_at DESC, so the first element is the
@@ -405,6 +566,7 @@ func (s *store) PaymentsGetByReference(ctx context.Context, reference string, co
 	}
 	res := toPaymentModels(payment, status)
 	res.Adjustments = adjustments
+	res.Fees = fees[payment.ID]
 	return &res, nil
 }
 
@@ -565,7 +727,9 @@ func (s *store) PaymentsDeleteFromOpenBankingConnectionID(ctx context.Context, p
 	return e("failed to delete payments", err)
 }
 
-type PaymentQuery struct{}
+type PaymentQuery struct {
+	Sort []SortKey `json:"sort,omitempty"`
+}
 
 type ListPaymentsQuery paginate.OffsetPaginatedQuery[paginate.PaginatedQueryOptions[PaymentQuery]]
 
@@ -577,38 +741,43 @@ func NewListPaymentsQuery(opts paginate.PaginatedQueryOptions[PaymentQuery]) Lis
 	}
 }
 
+var paymentsSortColumns = map[string]string{
+	"created_at":     "payment.created_at",
+	"updated_at":     "apd.updated_at",
+	"reference":      "payment.reference",
+	"amount":         "payment.amount",
+	"initial_amount": "payment.initial_amount",
+}
+
 func (s *store) paymentsQueryContext(qb query.Builder) (string, []any, error) {
 	where, args, err := qb.Build(query.ContextFn(func(key, operator string, value any) (string, []any, error) {
 		switch {
-		case key == "reference",
-			key == "id",
+		case key == "reference":
+			return matchText("payment.reference", key, operator, value)
+		case key == "id",
 			key == "connector_id",
 			key == "type",
 			key == "asset",
 			key == "scheme",
-			key == "status",
 			key == "source_account_id",
 			key == "destination_account_id",
 			key == "psu_id",
-			key == "open_banking_connection_id":
-			if operator != "$match" {
-				return "", nil, e(fmt.Sprintf("'%s' column can only be used with $match", key), ErrValidation)
-			}
-			return fmt.Sprintf("%s = ?", key), []any{value}, nil
+			key == "open_banking_connection_id",
+			key == "counterparty_id":
+			return matchEqual("payment."+key, key, operator, value)
+		case key == "status":
+			return matchEqual("apd.status", key, operator, value)
+		case key == "created_at":
+			return matchDate("payment.created_at", key, operator, value)
+		case key == "updated_at":
+			// The last adjustment of a payment is its last update.
+			return matchDate("apd.updated_at", key, operator, value)
 
 		case key == "initial_amount",
 			key == "amount":
-			return fmt.Sprintf("%s %s ?", key, query.DefaultComparisonOperatorsMapping[operator]), []any{value}, nil
+			return fmt.Sprintf("payment.%s %s ?", key, query.DefaultComparisonOperatorsMapping[operator]), []any{value}, nil
 		case metadataRegex.Match([]byte(key)):
-			if operator != "$match" {
-				return "", nil, e("'metadata' column can only be used with $match", ErrValidation)
-			}
-			match := metadataRegex.FindAllStringSubmatch(key, 3)
-
-			key := "metadata"
-			return key + " @> ?", []any{map[string]any{
-				match[0][1]: value,
-			}}, nil
+			return matchMetadata("payment.metadata", key, operator, value)
 		default:
 			return "", nil, fmt.Errorf("unknown key '%s' when building query: %w", key, ErrValidation)
 		}
@@ -630,6 +799,11 @@ func (s *store) PaymentsList(ctx context.Context, q ListPaymentsQuery) (*paginat
 		}
 	}
 
+	orderBy, err := sortOrder(q.Options.Options.Sort, paymentsSortColumns, "payment.created_at", "payment.sort_id")
+	if err != nil {
+		return nil, err
+	}
+
 	// TODO(polo): should fetch the adjustments and get the last status and amount?
 	cursor, err := paginateWithOffset[paginate.PaginatedQueryOptions[PaymentQuery], payment](s, ctx,
 		(*paginate.OffsetPaginatedQuery[paginate.PaginatedQueryOptions[PaymentQuery]])(&q),
@@ -640,15 +814,14 @@ func (s *store) PaymentsList(ctx context.Context, q ListPaymentsQuery) (*paginat
 
 			query.Column("payment.*", "apd.status").
 				Join(`join lateral (
-				select status
+				select status, created_at as updated_at
 				from payment_adjustments apd
 				where payment_id = payment.id
 				order by created_at desc, sort_id desc
 				limit 1
 			) apd on true`)
 
-			// TODO(polo): sorter ?
-			query = query.Order("created_at DESC", "sort_id DESC")
+			query = query.Order(orderBy...)
 
 			return query
 		},
@@ -657,9 +830,21 @@ func (s *store) PaymentsList(ctx context.Context, q ListPaymentsQuery) (*paginat
 		return nil, e("failed to fetch payments", err)
 	}
 
+	ids := make([]models.PaymentID, 0, len(cursor.Data))
+	for _, p := range cursor.Data {
+		ids = append(ids, p.ID)
+	}
+
+	fees, err := s.paymentsFees(ctx, ids...)
+	if err != nil {
+		return nil, err
+	}
+
 	payments := make([]models.Payment, 0, len(cursor.Data))
 	for _, p := range cursor.Data {
-		payments = append(payments, toPaymentModels(p, p.Status))
+		res := toPaymentModels(p, p.Status)
+		res.Fees = fees[p.ID]
+		payments = append(payments, res)
 	}
 
 	return &paginate.Cursor[models.Payment]{
@@ -671,6 +856,162 @@ func (s *store) PaymentsList(ctx context.Context, q ListPaymentsQuery) (*paginat
 	}, nil
 }
 
+// PaymentsAggregateQuery groups the payments matching the query builder, the
+// same one as PaymentsList, by the given keys and time interval.
+type PaymentsAggregateQuery struct {
+	QueryBuilder query.Builder
+	// One of asset, status, type, scheme, connector_id, source_account_id and
+	// destination_account_id. Payments are always grouped by asset.
+	GroupBy []string
+	// One of hour, day and month, optional.
+	Interval string
+}
+
+// maxPaymentsAggregates bounds the number of groups returned by
+// PaymentsAggregate.
+const maxPaymentsAggregates = 10000
+
+var paymentsAggregateColumns = map[string]string{
+	"asset":                  "payment.asset",
+	"status":                 "apd.status",
+	"type":                   "payment.type",
+	"scheme":                 "payment.scheme",
+	"connector_id":           "payment.connector_id",
+	"source_account_id":      "payment.source_account_id",
+	"destination_account_id": "payment.destination_account_id",
+}
+
+var paymentsAggregateIntervals = map[string]struct{}{
+	"hour":  {},
+	"day":   {},
+	"month": {},
+}
+
+func (s *store) PaymentsAggregate(ctx context.Context, q PaymentsAggregateQuery) ([]models.PaymentAggregate, error) {
+	var (
+		where string
+		args  []any
+		err   error
+	)
+	if q.QueryBuilder != nil {
+		where, args, err = s.paymentsQueryContext(q.QueryBuilder)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	groups := []string{"asset"}
+	for _, key := range q.GroupBy {
+		if _, ok := paymentsAggregateColumns[key]; !ok {
+			return nil, e(fmt.Sprintf("unknown group by key '%s'", key), ErrValidation)
+		}
+		if !slices.Contains(groups, key) {
+			groups = append(groups, key)
+		}
+	}
+
+	if q.Interval != "" {
+		if _, ok := paymentsAggregateIntervals[q.Interval]; !ok {
+			return nil, e(fmt.Sprintf("unknown interval '%s'", q.Interval), ErrValidation)
+		}
+	}
+
+	type paymentAggregate struct {
+		Asset                string                `bun:"asset"`
+		Status               *models.PaymentStatus `bun:"status"`
+		Type                 *models.PaymentType   `bun:"type"`
+		Scheme               *models.PaymentScheme `bun:"scheme"`
+		ConnectorID          *models.ConnectorID   `bun:"connector_id"`
+		SourceAccountID      *models.AccountID     `bun:"source_account_id"`
+		DestinationAccountID *models.AccountID     `bun:"destination_account_id"`
+		Bucket               *time.Time            `bun:"bucket"`
+		Count                int64                 `bun:"count"`
+		Amount               *big.Int              `bun:"amount"`
+		InitialAmount        *big.Int              `bun:"initial_amount"`
+	}
+
+	query := s.db.NewSelect().
+		TableExpr("payments AS payment").
+		Join(`join lateral (
+			select status, created_at as updated_at
+			from payment_adjustments apd
+			where payment_id = payment.id
+			order by created_at desc, sort_id desc
+			limit 1
+		) apd on true`)
+
+	for _, key := range groups {
+		query = query.ColumnExpr(paymentsAggregateColumns[key]+" AS ?", bun.Ident(key)).
+			GroupExpr(paymentsAggregateColumns[key]).
+			OrderExpr(paymentsAggregateColumns[key])
+	}
+	if q.Interval != "" {
+		query = query.ColumnExpr("date_trunc(?, payment.created_at) AS bucket", q.Interval).
+			GroupExpr("bucket").
+			OrderExpr("bucket")
+	}
+	query = query.ColumnExpr("count(*) AS count").
+		ColumnExpr("sum(payment.amount) AS amount").
+		ColumnExpr("sum(payment.initial_amount) AS initial_amount").
+		Limit(maxPaymentsAggregates + 1)
+
+	if where != "" {
+		query = query.Where(where, args...)
+	}
+
+	var aggregates []paymentAggregate
+	if err := query.Scan(ctx, &aggregates); err != nil {
+		return nil, e("failed to aggregate payments", err)
+	}
+
+	if len(aggregates) > maxPaymentsAggregates {
+		return nil, e(fmt.Sprintf("more than %d groups, narrow the query or use a larger interval", maxPaymentsAggregates), ErrValidation)
+	}
+
+	res := make([]models.PaymentAggregate, 0, len(aggregates))
+	for _, a := range aggregates {
+		res = append(res, models.PaymentAggregate{
+			Asset:                a.Asset,
+			Status:               a.Status,
+			Type:                 a.Type,
+			Scheme:               a.Scheme,
+			ConnectorID:          a.ConnectorID,
+			SourceAccountID:      a.SourceAccountID,
+			DestinationAccountID: a.DestinationAccountID,
+			Bucket:               a.Bucket,
+			Count:                a.Count,
+			Amount:               a.Amount,
+			InitialAmount:        a.InitialAmount,
+		})
+	}
+
+	return res, nil
+}
+
+// paymentsFees returns the fees of the given payments, in the order they
+// were first reported.
+func (s *store) paymentsFees(ctx context.Context, ids ...models.PaymentID) (map[models.PaymentID][]models.PaymentFee, error) {
+	res := make(map[models.PaymentID][]models.PaymentFee)
+	if len(ids) == 0 {
+		return res, nil
+	}
+
+	var fees []paymentFee
+	err := s.db.NewSelect().
+		Model(&fees).
+		Where("payment_id IN (?)", bun.In(ids)).
+		Order("payment_id", "sort_id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, e("failed to get payment fees", err)
+	}
+
+	for _, f := range fees {
+		res[f.PaymentID] = append(res[f.PaymentID], toPaymentFeeModels(f))
+	}
+	return res, nil
+}
+
 func fromPaymentModels(from models.Payment) payment {
 	return payment{
 		ID:                      from.ID,
@@ -686,6 +1027,7 @@ func fromPaymentModels(from models.Payment) payment {
 		DestinationAccountID:    from.DestinationAccountID,
 		PsuID:                   from.PsuID,
 		OpenBankingConnectionID: from.OpenBankingConnectionID,
+		CounterpartyID:          from.CounterpartyID,
 		Metadata:                from.Metadata,
 	}
 }
@@ -706,6 +1048,7 @@ func toPaymentModels(payment payment, status models.PaymentStatus) models.Paymen
 		DestinationAccountID:    payment.DestinationAccountID,
 		PsuID:                   payment.PsuID,
 		OpenBankingConnectionID: payment.OpenBankingConnectionID,
+		CounterpartyID:          payment.CounterpartyID,
 		Metadata:                payment.Metadata,
 	}
 }
@@ -742,3 +1085,22 @@ func toPaymentAdjustmentModels(from paymentAdjustment) models.PaymentAdjustment
 		Raw:       from.Raw,
 	}
 }
+
+func fromPaymentFeeModels(paymentID models.PaymentID, from models.PaymentFee) paymentFee {
+	return paymentFee{
+		PaymentID: paymentID,
+		Reference: from.Reference,
+		Type:      from.Type,
+		Asset:     from.Asset,
+		Amount:    new(big.Int).Set(from.Amount),
+	}
+}
+
+func toPaymentFeeModels(from paymentFee) models.PaymentFee {
+	return models.PaymentFee{
+		Reference: from.Reference,
+		Type:      from.Type,
+		Amount:    from.Amount,
+		Asset:     from.Asset,
+	}
+}
@@ -1198,6 +1198,168 @@ func TestPaymentsUpsertNilAmountAdjustment(t *testing.T) {
 	require.Equal(t, models.PAYMENT_STATUS_REFUNDED, actual.Status, "the status change must still be recorded")
 }
 
+func TestPaymentsUpsertFees(t *testing.T) {
+	t.Parallel()
+
+	ctx := logging.TestingContext()
+	store := newStore(t)
+	defer store.Close()
+	defer cleanupOutboxHelper(ctx, store)()
+
+	upsertConnector(t, ctx, store, defaultConnector)
+	upsertAccounts(t, ctx, store, defaultAccounts())
+
+	paymentID := models.PaymentID{
+		PaymentReference: models.PaymentReference{
+			Reference: "with-fees",
+			Type:      models.PAYMENT_TYPE_PAYIN,
+		},
+		ConnectorID: defaultConnector.ID,
+	}
+	p := models.Payment{
+		ID:            paymentID,
+		ConnectorID:   defaultConnector.ID,
+		Reference:     "with-fees",
+		CreatedAt:     now.Add(-60 * time.Minute).UTC().Time,
+		Type:          models.PAYMENT_TYPE_PAYIN,
+		InitialAmount: big.NewInt(1000),
+		Amount:        big.NewInt(1000),
+		Asset:         "EUR/2",
+		Scheme:        models.PAYMENT_SCHEME_CARD_VISA,
+		Status:        models.PAYMENT_STATUS_SUCCEEDED,
+		Fees: []models.PaymentFee{
+			{Reference: "with-fees", Type: models.PAYMENT_FEE_TYPE_PROCESSING, Amount: big.NewInt(20), Asset: "EUR/2"},
+			{Reference: "with-fees", Type: models.PAYMENT_FEE_TYPE_TAX, Amount: big.NewInt(4), Asset: "EUR/2"},
+			// Same key in the same batch: the last one wins
+			{Reference: "with-fees", Type: models.PAYMENT_FEE_TYPE_PROCESSING, Amount: big.NewInt(25), Asset: "EUR/2"},
+		},
+	}
+
+	require.NoError(t, store.PaymentsUpsert(ctx, []models.Payment{p}))
+
+	expectedFees := []models.PaymentFee{
+		{Reference: "with-fees", Type: models.PAYMENT_FEE_TYPE_PROCESSING, Amount: big.NewInt(25), Asset: "EUR/2"},
+		{Reference: "with-fees", Type: models.PAYMENT_FEE_TYPE_TAX, Amount: big.NewInt(4), Asset: "EUR/2"},
+	}
+
+	t.Run("get payment", func(t *testing.T) {
+		actual, err := store.PaymentsGet(ctx, paymentID)
+		require.NoError(t, err)
+		require.Equal(t, expectedFees, actual.Fees)
+		require.Equal(t, big.NewInt(1000), actual.Amount, "fees must not change the amount")
+	})
+
+	t.Run("get payment by reference", func(t *testing.T) {
+		actual, err := store.PaymentsGetByReference(ctx, "with-fees", defaultConnector.ID)
+		require.NoError(t, err)
+		require.Equal(t, expectedFees, actual.Fees)
+	})
+
+	t.Run("list payments", func(t *testing.T) {
+		q := NewListPaymentsQuery(
+			paginate.NewPaginatedQueryOptions(PaymentQuery{}).
+				WithPageSize(15).
+				WithQueryBuilder(query.Match("reference", "with-fees")),
+		)
+
+		cursor, err := store.PaymentsList(ctx, q)
+		require.NoError(t, err)
+		require.Len(t, cursor.Data, 1)
+		require.Equal(t, expectedFees, cursor.Data[0].Fees)
+	})
+
+	t.Run("revised fee amount", func(t *testing.T) {
+		revised := p
+		revised.Fees = []models.PaymentFee{
+			{Reference: "with-fees", Type: models.PAYMENT_FEE_TYPE_PROCESSING, Amount: big.NewInt(30), Asset: "EUR/2"},
+			{Reference: "fx_1", Type: models.PAYMENT_FEE_TYPE_EXCHANGE, Amount: big.NewInt(3), Asset: "USD/2"},
+		}
+		require.NoError(t, store.PaymentsUpsert(ctx, []models.Payment{revised}))
+
+		actual, err := store.PaymentsGet(ctx, paymentID)
+		require.NoError(t, err)
+		require.Equal(t, []models.PaymentFee{
+			{Reference: "with-fees", Type: models.PAYMENT_FEE_TYPE_PROCESSING, Amount: big.NewInt(30), Asset: "EUR/2"},
+			{Reference: "with-fees", Type: models.PAYMENT_FEE_TYPE_TAX, Amount: big.NewInt(4), Asset: "EUR/2"},
+			{Reference: "fx_1", Type: models.PAYMENT_FEE_TYPE_EXCHANGE, Amount: big.NewInt(3), Asset: "USD/2"},
+		}, actual.Fees)
+	})
+}
+
+func TestPaymentsUpsertAttributesPayinsToAccountPSU(t *testing.T) {
+	t.Parallel()
+
+	ctx := logging.TestingContext()
+	store := newStore(t)
+	defer store.Close()
+	defer cleanupOutboxHelper(ctx, store)()
+
+	upsertConnector(t, ctx, store, defaultConnector)
+	createPSU(t, ctx, store, defaultPSU2)
+
+	virtualAccount := models.Account{
+		ID: models.AccountID{
+			Reference:   "virtual",
+			ConnectorID: defaultConnector.ID,
+		},
+		ConnectorID: defaultConnector.ID,
+		Reference:   "virtual",
+		CreatedAt:   now.Add(-60 * time.Minute).UTC().Time,
+		Type:        models.ACCOUNT_TYPE_INTERNAL,
+		PsuID:       &defaultPSU2.ID,
+		Raw:         []byte(`{}`),
+	}
+	upsertAccounts(t, ctx, store, append(defaultAccounts(), virtualAccount))
+
+	newPayment := func(reference string, paymentType models.PaymentType, destination models.AccountID) models.Payment {
+		id := models.PaymentID{
+			PaymentReference: models.PaymentReference{
+				Reference: reference,
+				Type:      paymentType,
+			},
+			ConnectorID: defaultConnector.ID,
+		}
+		return models.Payment{
+			ID:                   id,
+			ConnectorID:          defaultConnector.ID,
+			Reference:            reference,
+			CreatedAt:            now.Add(-30 * time.Minute).UTC().Time,
+			Type:                 paymentType,
+			InitialAmount:        big.NewInt(100),
+			Amount:               big.NewInt(100),
+			Asset:                "GBP/2",
+			Scheme:               models.PAYMENT_SCHEME_OTHER,
+			Status:               models.PAYMENT_STATUS_SUCCEEDED,
+			DestinationAccountID: &destination,
+		}
+	}
+
+	payin := newPayment("payin-virtual", models.PAYMENT_TYPE_PAYIN, virtualAccount.ID)
+	transfer := newPayment("transfer-virtual", models.PAYMENT_TYPE_TRANSFER, virtualAccount.ID)
+	other := newPayment("payin-other", models.PAYMENT_TYPE_PAYIN, defaultAccounts()[0].ID)
+
+	require.NoError(t, store.PaymentsUpsert(ctx, []models.Payment{payin, transfer, other}))
+
+	t.Run("payin on the account is attributed to its payment service user", func(t *testing.T) {
+		actual, err := store.PaymentsGet(ctx, payin.ID)
+		require.NoError(t, err)
+		require.NotNil(t, actual.PsuID)
+		require.Equal(t, defaultPSU2.ID, *actual.PsuID)
+	})
+
+	t.Run("other payment types are not attributed", func(t *testing.T) {
+		actual, err := store.PaymentsGet(ctx, transfer.ID)
+		require.NoError(t, err)
+		require.Nil(t, actual.PsuID)
+	})
+
+	t.Run("payin on an account without payment service user", func(t *testing.T) {
+		actual, err := store.PaymentsGet(ctx, other.ID)
+		require.NoError(t, err)
+		require.Nil(t, actual.PsuID)
+	})
+}
+
 func TestPaymentsUpdateMetadata(t *testing.T) {
 	t.Parallel()
 
@@ -2150,6 +2312,155 @@ func TestPaymentsList(t *testing.T) {
 		require.False(t, cursor.HasMore)
 	})
 
+	t.Run("list payments by reference prefix", func(t *testing.T) {
+		q := NewListPaymentsQuery(
+			paginate.NewPaginatedQueryOptions(PaymentQuery{}).
+				WithPageSize(15).
+				WithQueryBuilder(query.Like("reference", "TEST%")),
+		)
+
+		cursor, err := store.PaymentsList(ctx, q)
+		require.NoError(t, err)
+		require.Len(t, cursor.Data, 3)
+		require.False(t, cursor.HasMore)
+	})
+
+	t.Run("list payments by assets", func(t *testing.T) {
+		q := NewListPaymentsQuery(
+			paginate.NewPaginatedQueryOptions(PaymentQuery{}).
+				WithPageSize(15).
+				WithQueryBuilder(query.In("asset", []any{"USD/2", "DKK/2"})),
+		)
+
+		cursor, err := store.PaymentsList(ctx, q)
+		require.NoError(t, err)
+		require.Len(t, cursor.Data, 2)
+		require.False(t, cursor.HasMore)
+		comparePayments(t, dps[2], cursor.Data[0])
+		comparePayments(t, dps[0], cursor.Data[1])
+	})
+
+	t.Run("wrong $in value when listing with asset", func(t *testing.T) {
+		q := NewListPaymentsQuery(
+			paginate.NewPaginatedQueryOptions(PaymentQuery{}).
+				WithPageSize(15).
+				WithQueryBuilder(query.In("asset", []any{})),
+		)
+
+		cursor, err := store.PaymentsList(ctx, q)
+		require.Error(t, err)
+		require.Nil(t, cursor)
+		assert.True(t, errors.Is(err, ErrValidation))
+	})
+
+	t.Run("list payments by created_at range", func(t *testing.T) {
+		q := NewListPaymentsQuery(
+			paginate.NewPaginatedQueryOptions(PaymentQuery{}).
+				WithPageSize(15).
+				WithQueryBuilder(query.And(
+					query.Gte("created_at", now.Add(-58*time.Minute).UTC().Format(time.RFC3339Nano)),
+					query.Lt("created_at", now.Add(-40*time.Minute).UTC().Format(time.RFC3339Nano)),
+				)),
+		)
+
+		cursor, err := store.PaymentsList(ctx, q)
+		require.NoError(t, err)
+		require.Len(t, cursor.Data, 1)
+		require.False(t, cursor.HasMore)
+		comparePayments(t, dps[2], cursor.Data[0])
+	})
+
+	t.Run("wrong date when listing with created_at", func(t *testing.T) {
+		q := NewListPaymentsQuery(
+			paginate.NewPaginatedQueryOptions(PaymentQuery{}).
+				WithPageSize(15).
+				WithQueryBuilder(query.Gte("created_at", "yesterday")),
+		)
+
+		cursor, err := store.PaymentsList(ctx, q)
+		require.Error(t, err)
+		require.Nil(t, cursor)
+		assert.True(t, errors.Is(err, ErrValidation))
+		assert.Regexp(t, "created_at", err.Error())
+	})
+
+	t.Run("list payments by metadata values", func(t *testing.T) {
+		q := NewListPaymentsQuery(
+			paginate.NewPaginatedQueryOptions(PaymentQuery{}).
+				WithPageSize(15).
+				WithQueryBuilder(query.In("metadata[key1]", []any{"value1", "value2"})),
+		)
+
+		cursor, err := store.PaymentsList(ctx, q)
+		require.NoError(t, err)
+		require.Len(t, cursor.Data, 1)
+		require.False(t, cursor.HasMore)
+		comparePayments(t, dps[0], cursor.Data[0])
+	})
+
+	t.Run("list payments by metadata prefix", func(t *testing.T) {
+		q := NewListPaymentsQuery(
+			paginate.NewPaginatedQueryOptions(PaymentQuery{}).
+				WithPageSize(15).
+				WithQueryBuilder(query.Like("metadata[key1]", "VAL%")),
+		)
+
+		cursor, err := store.PaymentsList(ctx, q)
+		require.NoError(t, err)
+		require.Len(t, cursor.Data, 1)
+		require.False(t, cursor.HasMore)
+		comparePayments(t, dps[0], cursor.Data[0])
+	})
+
+	t.Run("list payments sorted by amount", func(t *testing.T) {
+		q := NewListPaymentsQuery(
+			paginate.NewPaginatedQueryOptions(PaymentQuery{
+				Sort: []SortKey{{Key: "amount", Order: paginate.OrderAsc}},
+			}).WithPageSize(2),
+		)
+
+		cursor, err := store.PaymentsList(ctx, q)
+		require.NoError(t, err)
+		require.Len(t, cursor.Data, 2)
+		require.True(t, cursor.HasMore)
+		comparePayments(t, dps[0], cursor.Data[0])
+		comparePayments(t, dps[1], cursor.Data[1])
+
+		err = paginate.UnmarshalCursor(cursor.Next, &q)
+		require.NoError(t, err)
+		cursor, err = store.PaymentsList(ctx, q)
+		require.NoError(t, err)
+		require.Len(t, cursor.Data, 1)
+		require.False(t, cursor.HasMore)
+		comparePayments(t, dps[2], cursor.Data[0])
+	})
+
+	t.Run("unknown sort key when listing", func(t *testing.T) {
+		q := NewListPaymentsQuery(
+			paginate.NewPaginatedQueryOptions(PaymentQuery{
+				Sort: []SortKey{{Key: "unknown", Order: paginate.OrderAsc}},
+			}).WithPageSize(15),
+		)
+
+		cursor, err := store.PaymentsList(ctx, q)
+		require.Error(t, err)
+		require.Nil(t, cursor)
+		assert.True(t, errors.Is(err, ErrValidation))
+	})
+
+	t.Run("invalid sort order when listing", func(t *testing.T) {
+		q := NewListPaymentsQuery(
+			paginate.NewPaginatedQueryOptions(PaymentQuery{
+				Sort: []SortKey{{Key: "amount", Order: paginate.Order(42)}},
+			}).WithPageSize(15),
+		)
+
+		cursor, err := store.PaymentsList(ctx, q)
+		require.Error(t, err)
+		require.Nil(t, cursor)
+		assert.True(t, errors.Is(err, ErrValidation))
+	})
+
 	t.Run("unknown query builder key when listing", func(t *testing.T) {
 		q := NewListPaymentsQuery(
 			paginate.NewPaginatedQueryOptions(PaymentQuery{}).
@@ -2266,6 +2577,77 @@ func comparePaymentAdjustments(t *testing.T, expected, actual models.PaymentAdju
 	require.Equal(t, expected.Asset, actual.Asset)
 }
 
+func TestPaymentsAggregate(t *testing.T) {
+	t.Parallel()
+
+	ctx := logging.TestingContext()
+	store := newStore(t)
+	defer store.Close()
+
+	upsertConnector(t, ctx, store, defaultConnector)
+	upsertAccounts(t, ctx, store, defaultAccounts())
+	upsertPayments(t, ctx, store, defaultPayments())
+
+	t.Run("aggregate payments by asset", func(t *testing.T) {
+		aggregates, err := store.PaymentsAggregate(ctx, PaymentsAggregateQuery{})
+		require.NoError(t, err)
+		require.Equal(t, []models.PaymentAggregate{
+			{Asset: "DKK/2", Count: 1, Amount: big.NewInt(300), InitialAmount: big.NewInt(300)},
+			{Asset: "EUR/2", Count: 1, Amount: big.NewInt(200), InitialAmount: big.NewInt(200)},
+			{Asset: "USD/2", Count: 1, Amount: big.NewInt(100), InitialAmount: big.NewInt(100)},
+		}, aggregates)
+	})
+
+	t.Run("aggregate payments by connector and status", func(t *testing.T) {
+		aggregates, err := store.PaymentsAggregate(ctx, PaymentsAggregateQuery{
+			QueryBuilder: query.Match("asset", "USD/2"),
+			GroupBy:      []string{"connector_id", "status"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []models.PaymentAggregate{
+			{
+				Asset:         "USD/2",
+				ConnectorID:   &defaultConnector.ID,
+				Status:        pointer.For(models.PAYMENT_STATUS_SUCCEEDED),
+				Count:         1,
+				Amount:        big.NewInt(100),
+				InitialAmount: big.NewInt(100),
+			},
+		}, aggregates)
+	})
+
+	t.Run("aggregate payments by day", func(t *testing.T) {
+		aggregates, err := store.PaymentsAggregate(ctx, PaymentsAggregateQuery{
+			QueryBuilder: query.In("asset", []any{"USD/2", "EUR/2"}),
+			Interval:     "day",
+		})
+		require.NoError(t, err)
+		require.Len(t, aggregates, 2)
+		for _, a := range aggregates {
+			require.NotNil(t, a.Bucket)
+			require.True(t, a.Bucket.Equal(a.Bucket.Truncate(24*time.Hour)))
+		}
+	})
+
+	t.Run("unknown group by key", func(t *testing.T) {
+		aggregates, err := store.PaymentsAggregate(ctx, PaymentsAggregateQuery{
+			GroupBy: []string{"unknown"},
+		})
+		require.Error(t, err)
+		require.Nil(t, aggregates)
+		assert.True(t, errors.Is(err, ErrValidation))
+	})
+
+	t.Run("unknown interval", func(t *testing.T) {
+		aggregates, err := store.PaymentsAggregate(ctx, PaymentsAggregateQuery{
+			Interval: "week",
+		})
+		require.Error(t, err)
+		require.Nil(t, aggregates)
+		assert.True(t, errors.Is(err, ErrValidation))
+	})
+}
+
 func TestPaymentsDelete(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,159 @@
+package storage
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/query"
+	"github.com/formancehq/go-libs/v5/pkg/storage/bun/paginate"
+	"github.com/uptrace/bun"
+)
+
+// SortKey is a client selected sort key of a list query. It is part of the
+// query options so that it is kept in the pagination cursors.
+type SortKey struct {
+	Key   string         `json:"key"`
+	Order paginate.Order `json:"order"`
+}
+
+// sortOrder returns the ORDER BY expressions of a list query. columns maps the
+// sort keys exposed to the clients to their column. Without sort keys, the
+// list is sorted by creation date, newest first. The sort id is always added
+// last so that the offset pagination stays stable.
+func sortOrder(keys []SortKey, columns map[string]string, createdAtColumn, sortIDColumn string) ([]string, error) {
+	if len(keys) == 0 {
+		return []string{createdAtColumn + " DESC", sortIDColumn + " DESC"}, nil
+	}
+
+	orders := make([]string, 0, len(keys)+1)
+	for _, k := range keys {
+		column, ok := columns[k.Key]
+		if !ok {
+			return nil, e(fmt.Sprintf("unknown sort key '%s'", k.Key), ErrValidation)
+		}
+		// The sort keys can come from a client cursor, Order.String panics on
+		// unknown values
+		if k.Order != paginate.OrderAsc && k.Order != paginate.OrderDesc {
+			return nil, e(fmt.Sprintf("invalid order of sort key '%s'", k.Key), ErrValidation)
+		}
+		orders = append(orders, fmt.Sprintf("%s %s", column, k.Order))
+	}
+	orders = append(orders, fmt.Sprintf("%s %s", sortIDColumn, keys[0].Order))
+
+	return orders, nil
+}
+
+// matchEqual builds the clause of a column that can be matched against one or
+// a list of values.
+func matchEqual(column, key, operator string, value any) (string, []any, error) {
+	switch operator {
+	case "$match":
+		return fmt.Sprintf("%s = ?", column), []any{value}, nil
+	case "$in":
+		values, err := inValues(key, value)
+		if err != nil {
+			return "", nil, err
+		}
+		return fmt.Sprintf("%s IN (?)", column), []any{bun.In(values)}, nil
+	default:
+		return "", nil, e(fmt.Sprintf("'%s' column can only be used with $match or $in", key), ErrValidation)
+	}
+}
+
+// matchText builds the clause of a text column that can also be matched with
+// a case insensitive LIKE pattern, e.g. a prefix with "ref_%".
+func matchText(column, key, operator string, value any) (string, []any, error) {
+	if operator != "$like" {
+		return matchEqual(column, key, operator, value)
+	}
+
+	pattern, err := likePattern(key, value)
+	if err != nil {
+		return "", nil, err
+	}
+	// lower() rather than ILIKE so that prefix patterns can use the
+	// text_pattern_ops indexes.
+	return fmt.Sprintf("lower(%s) LIKE lower(?)", column), []any{pattern}, nil
+}
+
+// matchWithComparisons also accepts the $lt, $lte, $gt and $gte operators on
+// top of the ones accepted by match. Some columns always accepted them, the
+// list queries must keep accepting them.
+func matchWithComparisons(
+	match func(column, key, operator string, value any) (string, []any, error),
+) func(column, key, operator string, value any) (string, []any, error) {
+	return func(column, key, operator string, value any) (string, []any, error) {
+		switch operator {
+		case "$lt", "$lte", "$gt", "$gte":
+			return fmt.Sprintf("%s %s ?", column, query.DefaultComparisonOperatorsMapping[operator]), []any{value}, nil
+		default:
+			return match(column, key, operator, value)
+		}
+	}
+}
+
+// matchDate builds the clause of a date column, accepting RFC3339 values.
+func matchDate(column, key, operator string, value any) (string, []any, error) {
+	switch operator {
+	case "$match", "$lt", "$lte", "$gt", "$gte":
+	default:
+		return "", nil, e(fmt.Sprintf("'%s' column can only be used with $match, $lt, $lte, $gt or $gte", key), ErrValidation)
+	}
+
+	s, ok := value.(string)
+	if !ok {
+		return "", nil, e(fmt.Sprintf("'%s' value must be a RFC3339 date", key), ErrValidation)
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return "", nil, e(fmt.Sprintf("'%s' value must be a RFC3339 date", key), ErrValidation)
+	}
+
+	// Dates are stored without time zone, in UTC.
+	return fmt.Sprintf("%s %s ?", column, query.DefaultComparisonOperatorsMapping[operator]), []any{t.UTC()}, nil
+}
+
+// matchMetadata builds the clause of a metadata[key] filter. $match uses the
+// jsonb containment operator so that it can use the metadata indexes.
+func matchMetadata(column, key, operator string, value any) (string, []any, error) {
+	match := metadataRegex.FindAllStringSubmatch(key, 3)
+	metadataKey := match[0][1]
+
+	switch operator {
+	case "$match":
+		return column + " @> ?", []any{map[string]any{
+			metadataKey: value,
+		}}, nil
+	case "$in":
+		values, err := inValues(key, value)
+		if err != nil {
+			return "", nil, err
+		}
+		return fmt.Sprintf("%s->>? IN (?)", column), []any{metadataKey, bun.In(values)}, nil
+	case "$like":
+		pattern, err := likePattern(key, value)
+		if err != nil {
+			return "", nil, err
+		}
+		return fmt.Sprintf("%s->>? ILIKE ?", column), []any{metadataKey, pattern}, nil
+	default:
+		return "", nil, e(fmt.Sprintf("'%s' column can only be used with $match, $in or $like", key), ErrValidation)
+	}
+}
+
+func inValues(key string, value any) ([]any, error) {
+	values, ok := value.([]any)
+	if !ok || len(values) == 0 {
+		return nil, e(fmt.Sprintf("'%s' $in value must be a non empty list", key), ErrValidation)
+	}
+	return values, nil
+}
+
+func likePattern(key string, value any) (string, error) {
+	pattern, ok := value.(string)
+	if !ok || strings.TrimSpace(pattern) == "" {
+		return "", e(fmt.Sprintf("'%s' $like value must be a non empty string", key), ErrValidation)
+	}
+	return pattern, nil
+}
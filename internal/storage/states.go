@@ -42,6 +42,15 @@ func (s *store) StatesGet(ctx context.Context, id models.StateID) (models.State,
 	return res, nil
 }
 
+func (s *store) StatesDelete(ctx context.Context, id models.StateID) error {
+	_, err := s.db.NewDelete().
+		Model((*state)(nil)).
+		Where("id = ?", id).
+		Exec(ctx)
+
+	return e("failed to delete state", err)
+}
+
 func (s *store) StatesDeleteFromConnectorID(ctx context.Context, connectorID models.ConnectorID) error {
 	_, err := s.db.NewDelete().
 		Model((*state)(nil)).
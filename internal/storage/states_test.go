@@ -152,3 +152,36 @@ func TestDeleteStatesFromConnectorID(t *testing.T) {
 		}
 	})
 }
+
+func TestStatesDelete(t *testing.T) {
+	t.Parallel()
+
+	ctx := logging.TestingContext()
+	store := newStore(t)
+	defer store.Close()
+
+	upsertConnector(t, ctx, store, defaultConnector)
+	for _, state := range defaultStates {
+		upsertState(t, ctx, store, state)
+	}
+
+	t.Run("delete unknown state", func(t *testing.T) {
+		require.NoError(t, store.StatesDelete(ctx, models.StateID{
+			Reference:   "unknown",
+			ConnectorID: defaultConnector.ID,
+		}))
+	})
+
+	t.Run("delete state", func(t *testing.T) {
+		require.NoError(t, store.StatesDelete(ctx, defaultStates[0].ID))
+
+		_, err := store.StatesGet(ctx, defaultStates[0].ID)
+		require.Error(t, err)
+
+		for _, state := range defaultStates[1:] {
+			s, err := store.StatesGet(ctx, state.ID)
+			require.NoError(t, err)
+			require.Equal(t, state, s)
+		}
+	})
+}
@@ -41,16 +41,32 @@ type Storage interface {
 	BankAccountsList(ctx context.Context, q ListBankAccountsQuery) (*paginate.Cursor[models.BankAccount], error)
 	BankAccountsAddRelatedAccount(ctx context.Context, bID uuid.UUID, relatedAccount models.BankAccountRelatedAccount) error
 	BankAccountsDeleteRelatedAccountFromConnectorID(ctx context.Context, connectorID models.ConnectorID) error
+	BankAccountsGetFromRelatedAccountID(ctx context.Context, accountID models.AccountID) (*models.BankAccount, error)
+	BankAccountsAddVerification(ctx context.Context, verification models.BankAccountVerification) error
 
 	// Connectors
 	ListenConnectorsChanges(ctx context.Context, handler HandlerConnectorsChanges) error
 	ConnectorsInstall(ctx context.Context, c models.Connector, oldConnectorID *models.ConnectorID) error
 	ConnectorsUninstall(ctx context.Context, id models.ConnectorID) error
 	ConnectorsConfigUpdate(ctx context.Context, c models.Connector) error
+	ConnectorsRotateCredentials(ctx context.Context, c models.Connector, rotation models.ConnectorCredentialsRotation) error
 	ConnectorsGet(ctx context.Context, id models.ConnectorID) (*models.Connector, error)
 	ConnectorsList(ctx context.Context, q ListConnectorsQuery) (*paginate.Cursor[models.Connector], error)
 	ConnectorsScheduleForDeletion(ctx context.Context, id models.ConnectorID) error
 
+	// Counterparties
+	CounterpartiesUpsert(ctx context.Context, counterparties []models.Counterparty) error
+	CounterpartiesLinkPendingAccounts(ctx context.Context, limit int) (int, error)
+	CounterpartiesGet(ctx context.Context, id uuid.UUID) (*models.Counterparty, error)
+	CounterpartiesList(ctx context.Context, q ListCounterpartiesQuery) (*paginate.Cursor[models.Counterparty], error)
+
+	// Connector Health
+	ConnectorHealthGet(ctx context.Context, connectorID models.ConnectorID, recentErrorsLimit int) (*models.ConnectorHealth, error)
+	ConnectorHealthRefresh(ctx context.Context, connectorID models.ConnectorID, at time.Time) error
+
+	// Connector Rate Budgets
+	ConnectorRateBudgetsTake(ctx context.Context, connectorID models.ConnectorID, budget models.RateBudget, now time.Time) (models.RateBudgetUsage, time.Duration, error)
+
 	// Connector Tasks Tree
 	ConnectorTasksTreeUpsert(ctx context.Context, connectorID models.ConnectorID, tasks models.ConnectorTasksTree) error
 	ConnectorTasksTreeGet(ctx context.Context, connectorID models.ConnectorID) (*models.ConnectorTasksTree, error)
@@ -69,6 +85,7 @@ type Storage interface {
 	PaymentsGet(ctx context.Context, id models.PaymentID) (*models.Payment, error)
 	PaymentsGetByReference(ctx context.Context, reference string, connectorID models.ConnectorID) (*models.Payment, error)
 	PaymentsList(ctx context.Context, q ListPaymentsQuery) (*paginate.Cursor[models.Payment], error)
+	PaymentsAggregate(ctx context.Context, q PaymentsAggregateQuery) ([]models.PaymentAggregate, error)
 	PaymentsDeleteFromConnectorID(ctx context.Context, connectorID models.ConnectorID) error
 	PaymentsDeleteFromConnectorIDBatch(ctx context.Context, connectorID models.ConnectorID, batchSize int) (int, error)
 	PaymentsDeleteFromReference(ctx context.Context, reference string, connectorID models.ConnectorID) error
@@ -78,8 +95,24 @@ type Storage interface {
 	PaymentsDeleteFromOpenBankingConnectionID(ctx context.Context, psuID uuid.UUID, connectorID models.ConnectorID, openBankingConnectionID string) error
 	PaymentsDelete(ctx context.Context, id models.PaymentID) error
 
+	// Payment Correlation Rules
+	PaymentCorrelationRulesCreate(ctx context.Context, rule models.PaymentCorrelationRule) error
+	PaymentCorrelationRulesDelete(ctx context.Context, id uuid.UUID) error
+	PaymentCorrelationRulesList(ctx context.Context, q ListPaymentCorrelationRulesQuery) (*paginate.Cursor[models.PaymentCorrelationRule], error)
+
+	// Payment Initiation Limits
+	PaymentInitiationLimitsCreate(ctx context.Context, limit models.PaymentInitiationLimit) error
+	PaymentInitiationLimitsUpdate(ctx context.Context, limit models.PaymentInitiationLimit) error
+	PaymentInitiationLimitsGet(ctx context.Context, id uuid.UUID) (*models.PaymentInitiationLimit, error)
+	PaymentInitiationLimitsDelete(ctx context.Context, id uuid.UUID) error
+	PaymentInitiationLimitsList(ctx context.Context, q ListPaymentInitiationLimitsQuery) (*paginate.Cursor[models.PaymentInitiationLimit], error)
+	PaymentInitiationLimitsCheck(ctx context.Context, pi models.PaymentInitiation, at time.Time) ([]models.PaymentInitiationLimit, error)
+	PaymentInitiationLimitsConsume(ctx context.Context, pi models.PaymentInitiation, at time.Time, approved bool) ([]models.PaymentInitiationLimit, error)
+	PaymentInitiationLimitUsagesList(ctx context.Context, limitID uuid.UUID, windowStart time.Time) ([]models.PaymentInitiationLimitUsage, error)
+
 	// Payment Initiations
 	PaymentInitiationsInsert(ctx context.Context, pi models.PaymentInitiation, adjustments ...models.PaymentInitiationAdjustment) error
+	PaymentInitiationsInsertAndConsumeLimits(ctx context.Context, pi models.PaymentInitiation, at time.Time, adjustments ...models.PaymentInitiationAdjustment) ([]models.PaymentInitiationLimit, error)
 	PaymentInitiationsUpdateMetadata(ctx context.Context, piID models.PaymentInitiationID, metadata map[string]string) error
 	PaymentInitiationsGet(ctx context.Context, piID models.PaymentInitiationID) (*models.PaymentInitiation, error)
 	PaymentInitiationsDelete(ctx context.Context, piID models.PaymentInitiationID) error
@@ -130,6 +163,10 @@ type Storage interface {
 	OpenBankingConnectionAttemptsUpdateStatus(ctx context.Context, id uuid.UUID, status models.OpenBankingConnectionAttemptStatus, errMsg *string) error
 	OpenBankingConnectionAttemptsList(ctx context.Context, psuID uuid.UUID, connectorID models.ConnectorID, query ListOpenBankingConnectionAttemptsQuery) (*paginate.Cursor[models.OpenBankingConnectionAttempt], error)
 	OpenBankingConnectionAttemptsGet(ctx context.Context, id uuid.UUID) (*models.OpenBankingConnectionAttempt, error)
+	OpenBankingPaymentAttemptsUpsert(ctx context.Context, from models.OpenBankingPaymentAttempt) error
+	OpenBankingPaymentAttemptsUpdateStatus(ctx context.Context, id uuid.UUID, status models.OpenBankingConnectionAttemptStatus, errMsg *string) error
+	OpenBankingPaymentAttemptsGet(ctx context.Context, id uuid.UUID) (*models.OpenBankingPaymentAttempt, error)
+	OpenBankingPaymentAttemptsGetFromPaymentRequestReference(ctx context.Context, connectorID models.ConnectorID, reference string) (*models.OpenBankingPaymentAttempt, error)
 	OpenBankingForwardedUserUpsert(ctx context.Context, psuID uuid.UUID, from models.OpenBankingForwardedUser) error
 	OpenBankingForwardedUserGet(ctx context.Context, psuID uuid.UUID, connectorID models.ConnectorID) (*models.OpenBankingForwardedUser, error)
 	OpenBankingForwardedUserGetByPSPUserID(ctx context.Context, pspUserID string, connectorID models.ConnectorID) (*models.OpenBankingForwardedUser, error)
@@ -155,6 +192,7 @@ type Storage interface {
 	// State
 	StatesUpsert(ctx context.Context, state models.State) error
 	StatesGet(ctx context.Context, id models.StateID) (models.State, error)
+	StatesDelete(ctx context.Context, id models.StateID) error
 	StatesDeleteFromConnectorID(ctx context.Context, connectorID models.ConnectorID) error
 
 	// Tasks
@@ -202,9 +240,20 @@ type Storage interface {
 	ConversionsList(ctx context.Context, q ListConversionsQuery) (*paginate.Cursor[models.Conversion], error)
 	ConversionsDeleteFromConnectorID(ctx context.Context, connectorID models.ConnectorID) error
 
+	// Disputes
+	DisputesUpsert(ctx context.Context, disputes []models.Dispute) error
+	DisputesGet(ctx context.Context, id models.DisputeID) (*models.Dispute, error)
+	DisputesList(ctx context.Context, q ListDisputesQuery) (*paginate.Cursor[models.Dispute], error)
+	DisputesDeleteFromConnectorID(ctx context.Context, connectorID models.ConnectorID) error
+
+	// Locks
+	WithLock(ctx context.Context, name string, fn func(ctx context.Context) error) error
+
 	// Raw encryption helpers
 	// EncryptRaw encrypts a JSON payload using the storage encryption key via Postgres pgcrypto
 	EncryptRaw(ctx context.Context, message json.RawMessage) (json.RawMessage, error)
+	// EncryptRawWithKey encrypts a JSON payload like EncryptRaw, but using the given key
+	EncryptRawWithKey(ctx context.Context, message json.RawMessage, key string) (json.RawMessage, error)
 	// DecryptRaw decrypts a previously encrypted JSON payload using the storage encryption key via Postgres pgcrypto
 	DecryptRaw(ctx context.Context, message json.RawMessage) (json.RawMessage, error)
 }
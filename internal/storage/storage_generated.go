@@ -233,6 +233,20 @@ func (mr *MockStorageMockRecorder) BankAccountsAddRelatedAccount(ctx, bID, relat
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BankAccountsAddRelatedAccount", reflect.TypeOf((*MockStorage)(nil).BankAccountsAddRelatedAccount), ctx, bID, relatedAccount)
 }
 
+// BankAccountsAddVerification mocks base method.
+func (m *MockStorage) BankAccountsAddVerification(ctx context.Context, verification models.BankAccountVerification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BankAccountsAddVerification", ctx, verification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BankAccountsAddVerification indicates an expected call of BankAccountsAddVerification.
+func (mr *MockStorageMockRecorder) BankAccountsAddVerification(ctx, verification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BankAccountsAddVerification", reflect.TypeOf((*MockStorage)(nil).BankAccountsAddVerification), ctx, verification)
+}
+
 // BankAccountsDeleteRelatedAccountFromConnectorID mocks base method.
 func (m *MockStorage) BankAccountsDeleteRelatedAccountFromConnectorID(ctx context.Context, connectorID models.ConnectorID) error {
 	m.ctrl.T.Helper()
@@ -262,6 +276,21 @@ func (mr *MockStorageMockRecorder) BankAccountsGet(ctx, id, expand any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BankAccountsGet", reflect.TypeOf((*MockStorage)(nil).BankAccountsGet), ctx, id, expand)
 }
 
+// BankAccountsGetFromRelatedAccountID mocks base method.
+func (m *MockStorage) BankAccountsGetFromRelatedAccountID(ctx context.Context, accountID models.AccountID) (*models.BankAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BankAccountsGetFromRelatedAccountID", ctx, accountID)
+	ret0, _ := ret[0].(*models.BankAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BankAccountsGetFromRelatedAccountID indicates an expected call of BankAccountsGetFromRelatedAccountID.
+func (mr *MockStorageMockRecorder) BankAccountsGetFromRelatedAccountID(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BankAccountsGetFromRelatedAccountID", reflect.TypeOf((*MockStorage)(nil).BankAccountsGetFromRelatedAccountID), ctx, accountID)
+}
+
 // BankAccountsList mocks base method.
 func (m *MockStorage) BankAccountsList(ctx context.Context, q ListBankAccountsQuery) (*paginate.Cursor[models.BankAccount], error) {
 	m.ctrl.T.Helper()
@@ -319,6 +348,51 @@ func (mr *MockStorageMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockStorage)(nil).Close))
 }
 
+// ConnectorHealthGet mocks base method.
+func (m *MockStorage) ConnectorHealthGet(ctx context.Context, connectorID models.ConnectorID, recentErrorsLimit int) (*models.ConnectorHealth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConnectorHealthGet", ctx, connectorID, recentErrorsLimit)
+	ret0, _ := ret[0].(*models.ConnectorHealth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConnectorHealthGet indicates an expected call of ConnectorHealthGet.
+func (mr *MockStorageMockRecorder) ConnectorHealthGet(ctx, connectorID, recentErrorsLimit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectorHealthGet", reflect.TypeOf((*MockStorage)(nil).ConnectorHealthGet), ctx, connectorID, recentErrorsLimit)
+}
+
+// ConnectorHealthRefresh mocks base method.
+func (m *MockStorage) ConnectorHealthRefresh(ctx context.Context, connectorID models.ConnectorID, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConnectorHealthRefresh", ctx, connectorID, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConnectorHealthRefresh indicates an expected call of ConnectorHealthRefresh.
+func (mr *MockStorageMockRecorder) ConnectorHealthRefresh(ctx, connectorID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectorHealthRefresh", reflect.TypeOf((*MockStorage)(nil).ConnectorHealthRefresh), ctx, connectorID, at)
+}
+
+// ConnectorRateBudgetsTake mocks base method.
+func (m *MockStorage) ConnectorRateBudgetsTake(ctx context.Context, connectorID models.ConnectorID, budget models.RateBudget, now time.Time) (models.RateBudgetUsage, time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConnectorRateBudgetsTake", ctx, connectorID, budget, now)
+	ret0, _ := ret[0].(models.RateBudgetUsage)
+	ret1, _ := ret[1].(time.Duration)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ConnectorRateBudgetsTake indicates an expected call of ConnectorRateBudgetsTake.
+func (mr *MockStorageMockRecorder) ConnectorRateBudgetsTake(ctx, connectorID, budget, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectorRateBudgetsTake", reflect.TypeOf((*MockStorage)(nil).ConnectorRateBudgetsTake), ctx, connectorID, budget, now)
+}
+
 // ConnectorTasksTreeDeleteFromConnectorID mocks base method.
 func (m *MockStorage) ConnectorTasksTreeDeleteFromConnectorID(ctx context.Context, connectorID models.ConnectorID) error {
 	m.ctrl.T.Helper()
@@ -420,6 +494,20 @@ func (mr *MockStorageMockRecorder) ConnectorsList(ctx, q any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectorsList", reflect.TypeOf((*MockStorage)(nil).ConnectorsList), ctx, q)
 }
 
+// ConnectorsRotateCredentials mocks base method.
+func (m *MockStorage) ConnectorsRotateCredentials(ctx context.Context, c models.Connector, rotation models.ConnectorCredentialsRotation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConnectorsRotateCredentials", ctx, c, rotation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConnectorsRotateCredentials indicates an expected call of ConnectorsRotateCredentials.
+func (mr *MockStorageMockRecorder) ConnectorsRotateCredentials(ctx, c, rotation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectorsRotateCredentials", reflect.TypeOf((*MockStorage)(nil).ConnectorsRotateCredentials), ctx, c, rotation)
+}
+
 // ConnectorsScheduleForDeletion mocks base method.
 func (m *MockStorage) ConnectorsScheduleForDeletion(ctx context.Context, id models.ConnectorID) error {
 	m.ctrl.T.Helper()
@@ -506,6 +594,65 @@ func (mr *MockStorageMockRecorder) ConversionsUpsert(ctx, conversions any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConversionsUpsert", reflect.TypeOf((*MockStorage)(nil).ConversionsUpsert), ctx, conversions)
 }
 
+// CounterpartiesGet mocks base method.
+func (m *MockStorage) CounterpartiesGet(ctx context.Context, id uuid.UUID) (*models.Counterparty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CounterpartiesGet", ctx, id)
+	ret0, _ := ret[0].(*models.Counterparty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CounterpartiesGet indicates an expected call of CounterpartiesGet.
+func (mr *MockStorageMockRecorder) CounterpartiesGet(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CounterpartiesGet", reflect.TypeOf((*MockStorage)(nil).CounterpartiesGet), ctx, id)
+}
+
+// CounterpartiesLinkPendingAccounts mocks base method.
+func (m *MockStorage) CounterpartiesLinkPendingAccounts(ctx context.Context, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CounterpartiesLinkPendingAccounts", ctx, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CounterpartiesLinkPendingAccounts indicates an expected call of CounterpartiesLinkPendingAccounts.
+func (mr *MockStorageMockRecorder) CounterpartiesLinkPendingAccounts(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CounterpartiesLinkPendingAccounts", reflect.TypeOf((*MockStorage)(nil).CounterpartiesLinkPendingAccounts), ctx, limit)
+}
+
+// CounterpartiesList mocks base method.
+func (m *MockStorage) CounterpartiesList(ctx context.Context, q ListCounterpartiesQuery) (*paginate.Cursor[models.Counterparty], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CounterpartiesList", ctx, q)
+	ret0, _ := ret[0].(*paginate.Cursor[models.Counterparty])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CounterpartiesList indicates an expected call of CounterpartiesList.
+func (mr *MockStorageMockRecorder) CounterpartiesList(ctx, q any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CounterpartiesList", reflect.TypeOf((*MockStorage)(nil).CounterpartiesList), ctx, q)
+}
+
+// CounterpartiesUpsert mocks base method.
+func (m *MockStorage) CounterpartiesUpsert(ctx context.Context, counterparties []models.Counterparty) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CounterpartiesUpsert", ctx, counterparties)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CounterpartiesUpsert indicates an expected call of CounterpartiesUpsert.
+func (mr *MockStorageMockRecorder) CounterpartiesUpsert(ctx, counterparties any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CounterpartiesUpsert", reflect.TypeOf((*MockStorage)(nil).CounterpartiesUpsert), ctx, counterparties)
+}
+
 // DecryptRaw mocks base method.
 func (m *MockStorage) DecryptRaw(ctx context.Context, message json.RawMessage) (json.RawMessage, error) {
 	m.ctrl.T.Helper()
@@ -521,6 +668,64 @@ func (mr *MockStorageMockRecorder) DecryptRaw(ctx, message any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecryptRaw", reflect.TypeOf((*MockStorage)(nil).DecryptRaw), ctx, message)
 }
 
+// DisputesDeleteFromConnectorID mocks base method.
+func (m *MockStorage) DisputesDeleteFromConnectorID(ctx context.Context, connectorID models.ConnectorID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisputesDeleteFromConnectorID", ctx, connectorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisputesDeleteFromConnectorID indicates an expected call of DisputesDeleteFromConnectorID.
+func (mr *MockStorageMockRecorder) DisputesDeleteFromConnectorID(ctx, connectorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisputesDeleteFromConnectorID", reflect.TypeOf((*MockStorage)(nil).DisputesDeleteFromConnectorID), ctx, connectorID)
+}
+
+// DisputesGet mocks base method.
+func (m *MockStorage) DisputesGet(ctx context.Context, id models.DisputeID) (*models.Dispute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisputesGet", ctx, id)
+	ret0, _ := ret[0].(*models.Dispute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisputesGet indicates an expected call of DisputesGet.
+func (mr *MockStorageMockRecorder) DisputesGet(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisputesGet", reflect.TypeOf((*MockStorage)(nil).DisputesGet), ctx, id)
+}
+
+// DisputesList mocks base method.
+func (m *MockStorage) DisputesList(ctx context.Context, q ListDisputesQuery) (*paginate.Cursor[models.Dispute], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisputesList", ctx, q)
+	ret0, _ := ret[0].(*paginate.Cursor[models.Dispute])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisputesList indicates an expected call of DisputesList.
+func (mr *MockStorageMockRecorder) DisputesList(ctx, q any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisputesList", reflect.TypeOf((*MockStorage)(nil).DisputesList), ctx, q)
+}
+
+// DisputesUpsert mocks base method.
+func (m *MockStorage) DisputesUpsert(ctx context.Context, disputes []models.Dispute) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisputesUpsert", ctx, disputes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisputesUpsert indicates an expected call of DisputesUpsert.
+func (mr *MockStorageMockRecorder) DisputesUpsert(ctx, disputes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisputesUpsert", reflect.TypeOf((*MockStorage)(nil).DisputesUpsert), ctx, disputes)
+}
+
 // EncryptRaw mocks base method.
 func (m *MockStorage) EncryptRaw(ctx context.Context, message json.RawMessage) (json.RawMessage, error) {
 	m.ctrl.T.Helper()
@@ -536,6 +741,21 @@ func (mr *MockStorageMockRecorder) EncryptRaw(ctx, message any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EncryptRaw", reflect.TypeOf((*MockStorage)(nil).EncryptRaw), ctx, message)
 }
 
+// EncryptRawWithKey mocks base method.
+func (m *MockStorage) EncryptRawWithKey(ctx context.Context, message json.RawMessage, key string) (json.RawMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EncryptRawWithKey", ctx, message, key)
+	ret0, _ := ret[0].(json.RawMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EncryptRawWithKey indicates an expected call of EncryptRawWithKey.
+func (mr *MockStorageMockRecorder) EncryptRawWithKey(ctx, message, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EncryptRawWithKey", reflect.TypeOf((*MockStorage)(nil).EncryptRawWithKey), ctx, message, key)
+}
+
 // EventsSentDeleteFromConnectorID mocks base method.
 func (m *MockStorage) EventsSentDeleteFromConnectorID(ctx context.Context, connectorID models.ConnectorID) error {
 	m.ctrl.T.Helper()
@@ -944,6 +1164,64 @@ func (mr *MockStorageMockRecorder) OpenBankingForwardedUserUpsert(ctx, psuID, fr
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenBankingForwardedUserUpsert", reflect.TypeOf((*MockStorage)(nil).OpenBankingForwardedUserUpsert), ctx, psuID, from)
 }
 
+// OpenBankingPaymentAttemptsGet mocks base method.
+func (m *MockStorage) OpenBankingPaymentAttemptsGet(ctx context.Context, id uuid.UUID) (*models.OpenBankingPaymentAttempt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenBankingPaymentAttemptsGet", ctx, id)
+	ret0, _ := ret[0].(*models.OpenBankingPaymentAttempt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenBankingPaymentAttemptsGet indicates an expected call of OpenBankingPaymentAttemptsGet.
+func (mr *MockStorageMockRecorder) OpenBankingPaymentAttemptsGet(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenBankingPaymentAttemptsGet", reflect.TypeOf((*MockStorage)(nil).OpenBankingPaymentAttemptsGet), ctx, id)
+}
+
+// OpenBankingPaymentAttemptsGetFromPaymentRequestReference mocks base method.
+func (m *MockStorage) OpenBankingPaymentAttemptsGetFromPaymentRequestReference(ctx context.Context, connectorID models.ConnectorID, reference string) (*models.OpenBankingPaymentAttempt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenBankingPaymentAttemptsGetFromPaymentRequestReference", ctx, connectorID, reference)
+	ret0, _ := ret[0].(*models.OpenBankingPaymentAttempt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenBankingPaymentAttemptsGetFromPaymentRequestReference indicates an expected call of OpenBankingPaymentAttemptsGetFromPaymentRequestReference.
+func (mr *MockStorageMockRecorder) OpenBankingPaymentAttemptsGetFromPaymentRequestReference(ctx, connectorID, reference any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenBankingPaymentAttemptsGetFromPaymentRequestReference", reflect.TypeOf((*MockStorage)(nil).OpenBankingPaymentAttemptsGetFromPaymentRequestReference), ctx, connectorID, reference)
+}
+
+// OpenBankingPaymentAttemptsUpdateStatus mocks base method.
+func (m *MockStorage) OpenBankingPaymentAttemptsUpdateStatus(ctx context.Context, id uuid.UUID, status models.OpenBankingConnectionAttemptStatus, errMsg *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenBankingPaymentAttemptsUpdateStatus", ctx, id, status, errMsg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OpenBankingPaymentAttemptsUpdateStatus indicates an expected call of OpenBankingPaymentAttemptsUpdateStatus.
+func (mr *MockStorageMockRecorder) OpenBankingPaymentAttemptsUpdateStatus(ctx, id, status, errMsg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenBankingPaymentAttemptsUpdateStatus", reflect.TypeOf((*MockStorage)(nil).OpenBankingPaymentAttemptsUpdateStatus), ctx, id, status, errMsg)
+}
+
+// OpenBankingPaymentAttemptsUpsert mocks base method.
+func (m *MockStorage) OpenBankingPaymentAttemptsUpsert(ctx context.Context, from models.OpenBankingPaymentAttempt) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenBankingPaymentAttemptsUpsert", ctx, from)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OpenBankingPaymentAttemptsUpsert indicates an expected call of OpenBankingPaymentAttemptsUpsert.
+func (mr *MockStorageMockRecorder) OpenBankingPaymentAttemptsUpsert(ctx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenBankingPaymentAttemptsUpsert", reflect.TypeOf((*MockStorage)(nil).OpenBankingPaymentAttemptsUpsert), ctx, from)
+}
+
 // OrdersDeleteFromConnectorID mocks base method.
 func (m *MockStorage) OrdersDeleteFromConnectorID(ctx context.Context, connectorID models.ConnectorID) error {
 	m.ctrl.T.Helper()
@@ -1087,6 +1365,49 @@ func (mr *MockStorageMockRecorder) OutboxEventsPollPending(ctx, limit any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutboxEventsPollPending", reflect.TypeOf((*MockStorage)(nil).OutboxEventsPollPending), ctx, limit)
 }
 
+// PaymentCorrelationRulesCreate mocks base method.
+func (m *MockStorage) PaymentCorrelationRulesCreate(ctx context.Context, rule models.PaymentCorrelationRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PaymentCorrelationRulesCreate", ctx, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PaymentCorrelationRulesCreate indicates an expected call of PaymentCorrelationRulesCreate.
+func (mr *MockStorageMockRecorder) PaymentCorrelationRulesCreate(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentCorrelationRulesCreate", reflect.TypeOf((*MockStorage)(nil).PaymentCorrelationRulesCreate), ctx, rule)
+}
+
+// PaymentCorrelationRulesDelete mocks base method.
+func (m *MockStorage) PaymentCorrelationRulesDelete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PaymentCorrelationRulesDelete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PaymentCorrelationRulesDelete indicates an expected call of PaymentCorrelationRulesDelete.
+func (mr *MockStorageMockRecorder) PaymentCorrelationRulesDelete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentCorrelationRulesDelete", reflect.TypeOf((*MockStorage)(nil).PaymentCorrelationRulesDelete), ctx, id)
+}
+
+// PaymentCorrelationRulesList mocks base method.
+func (m *MockStorage) PaymentCorrelationRulesList(ctx context.Context, q ListPaymentCorrelationRulesQuery) (*paginate.Cursor[models.PaymentCorrelationRule], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PaymentCorrelationRulesList", ctx, q)
+	ret0, _ := ret[0].(*paginate.Cursor[models.PaymentCorrelationRule])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PaymentCorrelationRulesList indicates an expected call of PaymentCorrelationRulesList.
+func (mr *MockStorageMockRecorder) PaymentCorrelationRulesList(ctx, q any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentCorrelationRulesList", reflect.TypeOf((*MockStorage)(nil).PaymentCorrelationRulesList), ctx, q)
+}
+
 // PaymentInitiationAdjustmentsGet mocks base method.
 func (m *MockStorage) PaymentInitiationAdjustmentsGet(ctx context.Context, id models.PaymentInitiationAdjustmentID) (*models.PaymentInitiationAdjustment, error) {
 	m.ctrl.T.Helper()
@@ -1161,6 +1482,123 @@ func (mr *MockStorageMockRecorder) PaymentInitiationIDsListFromPaymentID(ctx, id
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentInitiationIDsListFromPaymentID", reflect.TypeOf((*MockStorage)(nil).PaymentInitiationIDsListFromPaymentID), ctx, id)
 }
 
+// PaymentInitiationLimitUsagesList mocks base method.
+func (m *MockStorage) PaymentInitiationLimitUsagesList(ctx context.Context, limitID uuid.UUID, windowStart time.Time) ([]models.PaymentInitiationLimitUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PaymentInitiationLimitUsagesList", ctx, limitID, windowStart)
+	ret0, _ := ret[0].([]models.PaymentInitiationLimitUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PaymentInitiationLimitUsagesList indicates an expected call of PaymentInitiationLimitUsagesList.
+func (mr *MockStorageMockRecorder) PaymentInitiationLimitUsagesList(ctx, limitID, windowStart any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentInitiationLimitUsagesList", reflect.TypeOf((*MockStorage)(nil).PaymentInitiationLimitUsagesList), ctx, limitID, windowStart)
+}
+
+// PaymentInitiationLimitsCheck mocks base method.
+func (m *MockStorage) PaymentInitiationLimitsCheck(ctx context.Context, pi models.PaymentInitiation, at time.Time) ([]models.PaymentInitiationLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PaymentInitiationLimitsCheck", ctx, pi, at)
+	ret0, _ := ret[0].([]models.PaymentInitiationLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PaymentInitiationLimitsCheck indicates an expected call of PaymentInitiationLimitsCheck.
+func (mr *MockStorageMockRecorder) PaymentInitiationLimitsCheck(ctx, pi, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentInitiationLimitsCheck", reflect.TypeOf((*MockStorage)(nil).PaymentInitiationLimitsCheck), ctx, pi, at)
+}
+
+// PaymentInitiationLimitsConsume mocks base method.
+func (m *MockStorage) PaymentInitiationLimitsConsume(ctx context.Context, pi models.PaymentInitiation, at time.Time, approved bool) ([]models.PaymentInitiationLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PaymentInitiationLimitsConsume", ctx, pi, at, approved)
+	ret0, _ := ret[0].([]models.PaymentInitiationLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PaymentInitiationLimitsConsume indicates an expected call of PaymentInitiationLimitsConsume.
+func (mr *MockStorageMockRecorder) PaymentInitiationLimitsConsume(ctx, pi, at, approved any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentInitiationLimitsConsume", reflect.TypeOf((*MockStorage)(nil).PaymentInitiationLimitsConsume), ctx, pi, at, approved)
+}
+
+// PaymentInitiationLimitsCreate mocks base method.
+func (m *MockStorage) PaymentInitiationLimitsCreate(ctx context.Context, limit models.PaymentInitiationLimit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PaymentInitiationLimitsCreate", ctx, limit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PaymentInitiationLimitsCreate indicates an expected call of PaymentInitiationLimitsCreate.
+func (mr *MockStorageMockRecorder) PaymentInitiationLimitsCreate(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentInitiationLimitsCreate", reflect.TypeOf((*MockStorage)(nil).PaymentInitiationLimitsCreate), ctx, limit)
+}
+
+// PaymentInitiationLimitsDelete mocks base method.
+func (m *MockStorage) PaymentInitiationLimitsDelete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PaymentInitiationLimitsDelete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PaymentInitiationLimitsDelete indicates an expected call of PaymentInitiationLimitsDelete.
+func (mr *MockStorageMockRecorder) PaymentInitiationLimitsDelete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentInitiationLimitsDelete", reflect.TypeOf((*MockStorage)(nil).PaymentInitiationLimitsDelete), ctx, id)
+}
+
+// PaymentInitiationLimitsGet mocks base method.
+func (m *MockStorage) PaymentInitiationLimitsGet(ctx context.Context, id uuid.UUID) (*models.PaymentInitiationLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PaymentInitiationLimitsGet", ctx, id)
+	ret0, _ := ret[0].(*models.PaymentInitiationLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PaymentInitiationLimitsGet indicates an expected call of PaymentInitiationLimitsGet.
+func (mr *MockStorageMockRecorder) PaymentInitiationLimitsGet(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentInitiationLimitsGet", reflect.TypeOf((*MockStorage)(nil).PaymentInitiationLimitsGet), ctx, id)
+}
+
+// PaymentInitiationLimitsList mocks base method.
+func (m *MockStorage) PaymentInitiationLimitsList(ctx context.Context, q ListPaymentInitiationLimitsQuery) (*paginate.Cursor[models.PaymentInitiationLimit], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PaymentInitiationLimitsList", ctx, q)
+	ret0, _ := ret[0].(*paginate.Cursor[models.PaymentInitiationLimit])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PaymentInitiationLimitsList indicates an expected call of PaymentInitiationLimitsList.
+func (mr *MockStorageMockRecorder) PaymentInitiationLimitsList(ctx, q any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentInitiationLimitsList", reflect.TypeOf((*MockStorage)(nil).PaymentInitiationLimitsList), ctx, q)
+}
+
+// PaymentInitiationLimitsUpdate mocks base method.
+func (m *MockStorage) PaymentInitiationLimitsUpdate(ctx context.Context, limit models.PaymentInitiationLimit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PaymentInitiationLimitsUpdate", ctx, limit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PaymentInitiationLimitsUpdate indicates an expected call of PaymentInitiationLimitsUpdate.
+func (mr *MockStorageMockRecorder) PaymentInitiationLimitsUpdate(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentInitiationLimitsUpdate", reflect.TypeOf((*MockStorage)(nil).PaymentInitiationLimitsUpdate), ctx, limit)
+}
+
 // PaymentInitiationRelatedPaymentsList mocks base method.
 func (m *MockStorage) PaymentInitiationRelatedPaymentsList(ctx context.Context, piID models.PaymentInitiationID, q ListPaymentInitiationRelatedPaymentsQuery) (*paginate.Cursor[models.Payment], error) {
 	m.ctrl.T.Helper()
@@ -1354,6 +1792,26 @@ func (mr *MockStorageMockRecorder) PaymentInitiationsInsert(ctx, pi any, adjustm
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentInitiationsInsert", reflect.TypeOf((*MockStorage)(nil).PaymentInitiationsInsert), varargs...)
 }
 
+// PaymentInitiationsInsertAndConsumeLimits mocks base method.
+func (m *MockStorage) PaymentInitiationsInsertAndConsumeLimits(ctx context.Context, pi models.PaymentInitiation, at time.Time, adjustments ...models.PaymentInitiationAdjustment) ([]models.PaymentInitiationLimit, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, pi, at}
+	for _, a := range adjustments {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PaymentInitiationsInsertAndConsumeLimits", varargs...)
+	ret0, _ := ret[0].([]models.PaymentInitiationLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PaymentInitiationsInsertAndConsumeLimits indicates an expected call of PaymentInitiationsInsertAndConsumeLimits.
+func (mr *MockStorageMockRecorder) PaymentInitiationsInsertAndConsumeLimits(ctx, pi, at any, adjustments ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, pi, at}, adjustments...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentInitiationsInsertAndConsumeLimits", reflect.TypeOf((*MockStorage)(nil).PaymentInitiationsInsertAndConsumeLimits), varargs...)
+}
+
 // PaymentInitiationsList mocks base method.
 func (m *MockStorage) PaymentInitiationsList(ctx context.Context, q ListPaymentInitiationsQuery) (*paginate.Cursor[models.PaymentInitiation], error) {
 	m.ctrl.T.Helper()
@@ -1455,6 +1913,21 @@ func (mr *MockStorageMockRecorder) PaymentServiceUsersList(ctx, query any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentServiceUsersList", reflect.TypeOf((*MockStorage)(nil).PaymentServiceUsersList), ctx, query)
 }
 
+// PaymentsAggregate mocks base method.
+func (m *MockStorage) PaymentsAggregate(ctx context.Context, q PaymentsAggregateQuery) ([]models.PaymentAggregate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PaymentsAggregate", ctx, q)
+	ret0, _ := ret[0].([]models.PaymentAggregate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PaymentsAggregate indicates an expected call of PaymentsAggregate.
+func (mr *MockStorageMockRecorder) PaymentsAggregate(ctx, q any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentsAggregate", reflect.TypeOf((*MockStorage)(nil).PaymentsAggregate), ctx, q)
+}
+
 // PaymentsDelete mocks base method.
 func (m *MockStorage) PaymentsDelete(ctx context.Context, id models.PaymentID) error {
 	m.ctrl.T.Helper()
@@ -1871,6 +2344,20 @@ func (mr *MockStorageMockRecorder) SchedulesUpsert(ctx, schedule any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchedulesUpsert", reflect.TypeOf((*MockStorage)(nil).SchedulesUpsert), ctx, schedule)
 }
 
+// StatesDelete mocks base method.
+func (m *MockStorage) StatesDelete(ctx context.Context, id models.StateID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StatesDelete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StatesDelete indicates an expected call of StatesDelete.
+func (mr *MockStorageMockRecorder) StatesDelete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatesDelete", reflect.TypeOf((*MockStorage)(nil).StatesDelete), ctx, id)
+}
+
 // StatesDeleteFromConnectorID mocks base method.
 func (m *MockStorage) StatesDeleteFromConnectorID(ctx context.Context, connectorID models.ConnectorID) error {
 	m.ctrl.T.Helper()
@@ -2057,3 +2544,17 @@ func (mr *MockStorageMockRecorder) WebhooksInsert(ctx, webhook any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebhooksInsert", reflect.TypeOf((*MockStorage)(nil).WebhooksInsert), ctx, webhook)
 }
+
+// WithLock mocks base method.
+func (m *MockStorage) WithLock(ctx context.Context, name string, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithLock", ctx, name, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithLock indicates an expected call of WithLock.
+func (mr *MockStorageMockRecorder) WithLock(ctx, name, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithLock", reflect.TypeOf((*MockStorage)(nil).WithLock), ctx, name, fn)
+}
@@ -11,9 +11,11 @@ import (
 	"github.com/formancehq/payments/internal/connectors"
 	"github.com/formancehq/payments/internal/connectors/engine"
 	"github.com/formancehq/payments/internal/connectors/engine/activities"
+	"github.com/formancehq/payments/internal/connectors/engine/screening"
 	"github.com/formancehq/payments/internal/connectors/engine/workflow"
 	"github.com/formancehq/payments/internal/events"
 	"github.com/formancehq/payments/internal/storage"
+	"github.com/formancehq/payments/pkg/domain/models"
 	"github.com/go-chi/chi/v5"
 	"go.temporal.io/sdk/client"
 	"go.temporal.io/sdk/worker"
@@ -47,6 +49,9 @@ func NewModule(
 	outboxCleanupInterval time.Duration,
 	healthCheckInterval time.Duration,
 	healthCheckErrorThreshold int,
+	screeningURL string,
+	screeningTimeout time.Duration,
+	payoutBankAccountVerification models.BankAccountVerificationResult,
 ) fx.Option {
 	ret := []fx.Option{
 		fx.Supply(worker.Options{
@@ -65,7 +70,9 @@ func NewModule(
 			return connectors.NewManager(logger, debug, pollingPeriodDefault, pollingPeriodMinimum)
 		}),
 		fx.Provide(func(temporalClient client.Client, manager connectors.Manager, logger logging.Logger) workflow.Workflow {
-			return workflow.New(temporalClient, temporalNamespace, manager, stack, stackURL, logger, healthCheckInterval)
+			return workflow.New(temporalClient, temporalNamespace, manager, stack, stackURL, logger, healthCheckInterval).
+				WithScreening(screeningURL != "").
+				WithBankAccountVerification(payoutBankAccountVerification)
 		}),
 		fx.Provide(func(
 			logger logging.Logger,
@@ -74,7 +81,11 @@ func NewModule(
 			events *events.Events,
 			connectors connectors.Manager,
 		) activities.Activities {
-			return activities.New(logger, temporalClient, storage, events, connectors, temporalRateLimitingRetryDelay, healthCheckErrorThreshold)
+			a := activities.New(logger, temporalClient, storage, events, connectors, temporalRateLimitingRetryDelay, healthCheckErrorThreshold)
+			if screeningURL != "" {
+				a = a.WithScreeningClient(screening.NewClient(screeningURL, screeningTimeout))
+			}
+			return a
 		}),
 		fx.Provide(
 			fx.Annotate(func(
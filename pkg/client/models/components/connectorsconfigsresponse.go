@@ -6,6 +6,8 @@ type ConnectorsConfigsResponseData struct {
 	DataType     string  `json:"dataType"`
 	Required     bool    `json:"required"`
 	DefaultValue *string `json:"defaultValue,omitempty"`
+	// Whether the parameter holds a secret
+	Sensitive *bool `json:"sensitive,omitempty"`
 }
 
 func (o *ConnectorsConfigsResponseData) GetDataType() string {
@@ -29,6 +31,13 @@ func (o *ConnectorsConfigsResponseData) GetDefaultValue() *string {
 	return o.DefaultValue
 }
 
+func (o *ConnectorsConfigsResponseData) GetSensitive() *bool {
+	if o == nil {
+		return nil
+	}
+	return o.Sensitive
+}
+
 // ConnectorsConfigsResponse - OK
 type ConnectorsConfigsResponse struct {
 	Data map[string]map[string]ConnectorsConfigsResponseData `json:"data"`
@@ -7,16 +7,21 @@ import (
 )
 
 type V3AdyenConfig struct {
-	APIKey             string  `json:"apiKey"`
-	CompanyID          string  `json:"companyID"`
-	LiveEndpointPrefix *string `json:"liveEndpointPrefix,omitempty"`
-	Name               string  `json:"name"`
+	APIKey string `json:"apiKey"`
+	// Daily UTC time windows during which the connector is not polled
+	BlackoutWindows    []V3BlackoutWindow `json:"blackoutWindows,omitempty"`
+	CompanyID          string             `json:"companyID"`
+	LiveEndpointPrefix *string            `json:"liveEndpointPrefix,omitempty"`
+	Name               string             `json:"name"`
 	// Deprecated: From v3.1, this parameter will be ignored.
-	PageSize        *int64  `default:"25" json:"pageSize"`
-	PollingPeriod   *string `default:"30m" json:"pollingPeriod"`
-	Provider        *string `default:"Adyen" json:"provider"`
-	WebhookPassword *string `json:"webhookPassword,omitempty"`
-	WebhookUsername *string `json:"webhookUsername,omitempty"`
+	PageSize      *int64  `default:"25" json:"pageSize"`
+	PollingPeriod *string `default:"30m" json:"pollingPeriod"`
+	// Polling period overrides by fetch capability (e.g. FETCH_BALANCES), falling back to pollingPeriod
+	PollingPeriods         map[string]string `json:"pollingPeriods,omitempty"`
+	Provider               *string           `default:"Adyen" json:"provider"`
+	TransferWebhookHMACKey *string           `json:"transferWebhookHMACKey,omitempty"`
+	WebhookPassword        *string           `json:"webhookPassword,omitempty"`
+	WebhookUsername        *string           `json:"webhookUsername,omitempty"`
 }
 
 func (v V3AdyenConfig) MarshalJSON() ([]byte, error) {
@@ -37,6 +42,13 @@ func (o *V3AdyenConfig) GetAPIKey() string {
 	return o.APIKey
 }
 
+func (o *V3AdyenConfig) GetBlackoutWindows() []V3BlackoutWindow {
+	if o == nil {
+		return nil
+	}
+	return o.BlackoutWindows
+}
+
 func (o *V3AdyenConfig) GetCompanyID() string {
 	if o == nil {
 		return ""
@@ -72,6 +84,13 @@ func (o *V3AdyenConfig) GetPollingPeriod() *string {
 	return o.PollingPeriod
 }
 
+func (o *V3AdyenConfig) GetPollingPeriods() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.PollingPeriods
+}
+
 func (o *V3AdyenConfig) GetProvider() *string {
 	if o == nil {
 		return nil
@@ -79,6 +98,13 @@ func (o *V3AdyenConfig) GetProvider() *string {
 	return o.Provider
 }
 
+func (o *V3AdyenConfig) GetTransferWebhookHMACKey() *string {
+	if o == nil {
+		return nil
+	}
+	return o.TransferWebhookHMACKey
+}
+
 func (o *V3AdyenConfig) GetWebhookPassword() *string {
 	if o == nil {
 		return nil
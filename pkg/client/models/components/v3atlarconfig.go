@@ -9,12 +9,16 @@ import (
 type V3AtlarConfig struct {
 	AccessKey string `json:"accessKey"`
 	BaseURL   string `json:"baseUrl"`
-	Name      string `json:"name"`
+	// Daily UTC time windows during which the connector is not polled
+	BlackoutWindows []V3BlackoutWindow `json:"blackoutWindows,omitempty"`
+	Name            string             `json:"name"`
 	// Deprecated: From v3.1, this parameter will be ignored.
 	PageSize      *int64  `default:"25" json:"pageSize"`
 	PollingPeriod *string `default:"30m" json:"pollingPeriod"`
-	Provider      *string `default:"Atlar" json:"provider"`
-	Secret        string  `json:"secret"`
+	// Polling period overrides by fetch capability (e.g. FETCH_BALANCES), falling back to pollingPeriod
+	PollingPeriods map[string]string `json:"pollingPeriods,omitempty"`
+	Provider       *string           `default:"Atlar" json:"provider"`
+	Secret         string            `json:"secret"`
 }
 
 func (v V3AtlarConfig) MarshalJSON() ([]byte, error) {
@@ -42,6 +46,13 @@ func (o *V3AtlarConfig) GetBaseURL() string {
 	return o.BaseURL
 }
 
+func (o *V3AtlarConfig) GetBlackoutWindows() []V3BlackoutWindow {
+	if o == nil {
+		return nil
+	}
+	return o.BlackoutWindows
+}
+
 func (o *V3AtlarConfig) GetName() string {
 	if o == nil {
 		return ""
@@ -63,6 +74,13 @@ func (o *V3AtlarConfig) GetPollingPeriod() *string {
 	return o.PollingPeriod
 }
 
+func (o *V3AtlarConfig) GetPollingPeriods() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.PollingPeriods
+}
+
 func (o *V3AtlarConfig) GetProvider() *string {
 	if o == nil {
 		return nil
@@ -8,14 +8,18 @@ import (
 
 type V3BankingbridgeConfig struct {
 	AuthEndpoint string `json:"authEndpoint"`
-	ClientID     string `json:"clientID"`
-	ClientSecret string `json:"clientSecret"`
-	Endpoint     string `json:"endpoint"`
-	Name         string `json:"name"`
+	// Daily UTC time windows during which the connector is not polled
+	BlackoutWindows []V3BlackoutWindow `json:"blackoutWindows,omitempty"`
+	ClientID        string             `json:"clientID"`
+	ClientSecret    string             `json:"clientSecret"`
+	Endpoint        string             `json:"endpoint"`
+	Name            string             `json:"name"`
 	// Deprecated: From v3.1, this parameter will be ignored.
 	PageSize      *int64  `default:"25" json:"pageSize"`
 	PollingPeriod *string `default:"30m" json:"pollingPeriod"`
-	Provider      *string `default:"Bankingbridge" json:"provider"`
+	// Polling period overrides by fetch capability (e.g. FETCH_BALANCES), falling back to pollingPeriod
+	PollingPeriods map[string]string `json:"pollingPeriods,omitempty"`
+	Provider       *string           `default:"Bankingbridge" json:"provider"`
 }
 
 func (v V3BankingbridgeConfig) MarshalJSON() ([]byte, error) {
@@ -36,6 +40,13 @@ func (o *V3BankingbridgeConfig) GetAuthEndpoint() string {
 	return o.AuthEndpoint
 }
 
+func (o *V3BankingbridgeConfig) GetBlackoutWindows() []V3BlackoutWindow {
+	if o == nil {
+		return nil
+	}
+	return o.BlackoutWindows
+}
+
 func (o *V3BankingbridgeConfig) GetClientID() string {
 	if o == nil {
 		return ""
@@ -78,6 +89,13 @@ func (o *V3BankingbridgeConfig) GetPollingPeriod() *string {
 	return o.PollingPeriod
 }
 
+func (o *V3BankingbridgeConfig) GetPollingPeriods() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.PollingPeriods
+}
+
 func (o *V3BankingbridgeConfig) GetProvider() *string {
 	if o == nil {
 		return nil
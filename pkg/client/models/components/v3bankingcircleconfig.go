@@ -8,16 +8,20 @@ import (
 
 type V3BankingcircleConfig struct {
 	AuthorizationEndpoint string `json:"authorizationEndpoint"`
-	Endpoint              string `json:"endpoint"`
-	Name                  string `json:"name"`
+	// Daily UTC time windows during which the connector is not polled
+	BlackoutWindows []V3BlackoutWindow `json:"blackoutWindows,omitempty"`
+	Endpoint        string             `json:"endpoint"`
+	Name            string             `json:"name"`
 	// Deprecated: From v3.1, this parameter will be ignored.
-	PageSize           *int64  `default:"25" json:"pageSize"`
-	Password           string  `json:"password"`
-	PollingPeriod      *string `default:"30m" json:"pollingPeriod"`
-	Provider           *string `default:"Bankingcircle" json:"provider"`
-	UserCertificate    string  `json:"userCertificate"`
-	UserCertificateKey string  `json:"userCertificateKey"`
-	Username           string  `json:"username"`
+	PageSize      *int64  `default:"25" json:"pageSize"`
+	Password      string  `json:"password"`
+	PollingPeriod *string `default:"30m" json:"pollingPeriod"`
+	// Polling period overrides by fetch capability (e.g. FETCH_BALANCES), falling back to pollingPeriod
+	PollingPeriods     map[string]string `json:"pollingPeriods,omitempty"`
+	Provider           *string           `default:"Bankingcircle" json:"provider"`
+	UserCertificate    string            `json:"userCertificate"`
+	UserCertificateKey string            `json:"userCertificateKey"`
+	Username           string            `json:"username"`
 }
 
 func (v V3BankingcircleConfig) MarshalJSON() ([]byte, error) {
@@ -38,6 +42,13 @@ func (o *V3BankingcircleConfig) GetAuthorizationEndpoint() string {
 	return o.AuthorizationEndpoint
 }
 
+func (o *V3BankingcircleConfig) GetBlackoutWindows() []V3BlackoutWindow {
+	if o == nil {
+		return nil
+	}
+	return o.BlackoutWindows
+}
+
 func (o *V3BankingcircleConfig) GetEndpoint() string {
 	if o == nil {
 		return ""
@@ -73,6 +84,13 @@ func (o *V3BankingcircleConfig) GetPollingPeriod() *string {
 	return o.PollingPeriod
 }
 
+func (o *V3BankingcircleConfig) GetPollingPeriods() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.PollingPeriods
+}
+
 func (o *V3BankingcircleConfig) GetProvider() *string {
 	if o == nil {
 		return nil
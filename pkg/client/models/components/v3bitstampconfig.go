@@ -7,14 +7,18 @@ import (
 )
 
 type V3BitstampConfig struct {
-	APIKey    string  `json:"apiKey"`
-	APISecret string  `json:"apiSecret"`
-	Endpoint  *string `json:"endpoint,omitempty"`
-	Name      string  `json:"name"`
+	APIKey    string `json:"apiKey"`
+	APISecret string `json:"apiSecret"`
+	// Daily UTC time windows during which the connector is not polled
+	BlackoutWindows []V3BlackoutWindow `json:"blackoutWindows,omitempty"`
+	Endpoint        *string            `json:"endpoint,omitempty"`
+	Name            string             `json:"name"`
 	// Deprecated: From v3.1, this parameter will be ignored.
 	PageSize      *int64  `default:"25" json:"pageSize"`
 	PollingPeriod *string `default:"30m" json:"pollingPeriod"`
-	Provider      *string `default:"Bitstamp" json:"provider"`
+	// Polling period overrides by fetch capability (e.g. FETCH_BALANCES), falling back to pollingPeriod
+	PollingPeriods map[string]string `json:"pollingPeriods,omitempty"`
+	Provider       *string           `default:"Bitstamp" json:"provider"`
 }
 
 func (v V3BitstampConfig) MarshalJSON() ([]byte, error) {
@@ -42,6 +46,13 @@ func (o *V3BitstampConfig) GetAPISecret() string {
 	return o.APISecret
 }
 
+func (o *V3BitstampConfig) GetBlackoutWindows() []V3BlackoutWindow {
+	if o == nil {
+		return nil
+	}
+	return o.BlackoutWindows
+}
+
 func (o *V3BitstampConfig) GetEndpoint() *string {
 	if o == nil {
 		return nil
@@ -70,6 +81,13 @@ func (o *V3BitstampConfig) GetPollingPeriod() *string {
 	return o.PollingPeriod
 }
 
+func (o *V3BitstampConfig) GetPollingPeriods() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.PollingPeriods
+}
+
 func (o *V3BitstampConfig) GetProvider() *string {
 	if o == nil {
 		return nil
@@ -25,6 +25,8 @@ const (
 	V3CapabilityCreatePayout                 V3Capability = "CREATE_PAYOUT"
 	V3CapabilityAllowFormanceAccountCreation V3Capability = "ALLOW_FORMANCE_ACCOUNT_CREATION"
 	V3CapabilityAllowFormancePaymentCreation V3Capability = "ALLOW_FORMANCE_PAYMENT_CREATION"
+	V3CapabilityVerifyBankAccount            V3Capability = "VERIFY_BANK_ACCOUNT"
+	V3CapabilityCreateAccount                V3Capability = "CREATE_ACCOUNT"
 )
 
 func (e V3Capability) ToPointer() *V3Capability {
@@ -63,6 +65,10 @@ func (e *V3Capability) UnmarshalJSON(data []byte) error {
 	case "ALLOW_FORMANCE_ACCOUNT_CREATION":
 		fallthrough
 	case "ALLOW_FORMANCE_PAYMENT_CREATION":
+		fallthrough
+	case "VERIFY_BANK_ACCOUNT":
+		fallthrough
+	case "CREATE_ACCOUNT":
 		*e = V3Capability(v)
 		return nil
 	default:
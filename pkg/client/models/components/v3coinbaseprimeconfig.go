@@ -9,13 +9,17 @@ import (
 type V3CoinbaseprimeConfig struct {
 	APIKey    string `json:"apiKey"`
 	APISecret string `json:"apiSecret"`
-	Name      string `json:"name"`
+	// Daily UTC time windows during which the connector is not polled
+	BlackoutWindows []V3BlackoutWindow `json:"blackoutWindows,omitempty"`
+	Name            string             `json:"name"`
 	// Deprecated: From v3.1, this parameter will be ignored.
 	PageSize      *int64  `default:"25" json:"pageSize"`
 	Passphrase    string  `json:"passphrase"`
 	PollingPeriod *string `default:"30m" json:"pollingPeriod"`
-	PortfolioID   string  `json:"portfolioId"`
-	Provider      *string `default:"Coinbaseprime" json:"provider"`
+	// Polling period overrides by fetch capability (e.g. FETCH_BALANCES), falling back to pollingPeriod
+	PollingPeriods map[string]string `json:"pollingPeriods,omitempty"`
+	PortfolioID    string            `json:"portfolioId"`
+	Provider       *string           `default:"Coinbaseprime" json:"provider"`
 }
 
 func (v V3CoinbaseprimeConfig) MarshalJSON() ([]byte, error) {
@@ -43,6 +47,13 @@ func (o *V3CoinbaseprimeConfig) GetAPISecret() string {
 	return o.APISecret
 }
 
+func (o *V3CoinbaseprimeConfig) GetBlackoutWindows() []V3BlackoutWindow {
+	if o == nil {
+		return nil
+	}
+	return o.BlackoutWindows
+}
+
 func (o *V3CoinbaseprimeConfig) GetName() string {
 	if o == nil {
 		return ""
@@ -71,6 +82,13 @@ func (o *V3CoinbaseprimeConfig) GetPollingPeriod() *string {
 	return o.PollingPeriod
 }
 
+func (o *V3CoinbaseprimeConfig) GetPollingPeriods() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.PollingPeriods
+}
+
 func (o *V3CoinbaseprimeConfig) GetPortfolioID() string {
 	if o == nil {
 		return ""
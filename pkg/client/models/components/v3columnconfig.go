@@ -7,13 +7,17 @@ import (
 )
 
 type V3ColumnConfig struct {
-	APIKey   string `json:"apiKey"`
-	Endpoint string `json:"endpoint"`
-	Name     string `json:"name"`
+	APIKey string `json:"apiKey"`
+	// Daily UTC time windows during which the connector is not polled
+	BlackoutWindows []V3BlackoutWindow `json:"blackoutWindows,omitempty"`
+	Endpoint        string             `json:"endpoint"`
+	Name            string             `json:"name"`
 	// Deprecated: From v3.1, this parameter will be ignored.
 	PageSize      *int64  `default:"25" json:"pageSize"`
 	PollingPeriod *string `default:"30m" json:"pollingPeriod"`
-	Provider      *string `default:"Column" json:"provider"`
+	// Polling period overrides by fetch capability (e.g. FETCH_BALANCES), falling back to pollingPeriod
+	PollingPeriods map[string]string `json:"pollingPeriods,omitempty"`
+	Provider       *string           `default:"Column" json:"provider"`
 }
 
 func (v V3ColumnConfig) MarshalJSON() ([]byte, error) {
@@ -34,6 +38,13 @@ func (o *V3ColumnConfig) GetAPIKey() string {
 	return o.APIKey
 }
 
+func (o *V3ColumnConfig) GetBlackoutWindows() []V3BlackoutWindow {
+	if o == nil {
+		return nil
+	}
+	return o.BlackoutWindows
+}
+
 func (o *V3ColumnConfig) GetEndpoint() string {
 	if o == nil {
 		return ""
@@ -62,6 +73,13 @@ func (o *V3ColumnConfig) GetPollingPeriod() *string {
 	return o.PollingPeriod
 }
 
+func (o *V3ColumnConfig) GetPollingPeriods() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.PollingPeriods
+}
+
 func (o *V3ColumnConfig) GetProvider() *string {
 	if o == nil {
 		return nil
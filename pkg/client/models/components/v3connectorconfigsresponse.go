@@ -6,6 +6,8 @@ type V3ConnectorConfigsResponseData struct {
 	DataType     string  `json:"dataType"`
 	Required     bool    `json:"required"`
 	DefaultValue *string `json:"defaultValue,omitempty"`
+	// Whether the parameter holds a secret
+	Sensitive *bool `json:"sensitive,omitempty"`
 }
 
 func (o *V3ConnectorConfigsResponseData) GetDataType() string {
@@ -29,6 +31,13 @@ func (o *V3ConnectorConfigsResponseData) GetDefaultValue() *string {
 	return o.DefaultValue
 }
 
+func (o *V3ConnectorConfigsResponseData) GetSensitive() *bool {
+	if o == nil {
+		return nil
+	}
+	return o.Sensitive
+}
+
 type V3ConnectorConfigsResponse struct {
 	Data map[string]map[string]V3ConnectorConfigsResponseData `json:"data"`
 }
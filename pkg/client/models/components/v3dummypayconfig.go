@@ -7,14 +7,18 @@ import (
 )
 
 type V3DummypayConfig struct {
-	Directory     string `json:"directory"`
-	LinkFlowError *bool  `json:"linkFlowError,omitempty"`
-	Name          string `json:"name"`
+	// Daily UTC time windows during which the connector is not polled
+	BlackoutWindows []V3BlackoutWindow `json:"blackoutWindows,omitempty"`
+	Directory       string             `json:"directory"`
+	LinkFlowError   *bool              `json:"linkFlowError,omitempty"`
+	Name            string             `json:"name"`
 	// Deprecated: From v3.1, this parameter will be ignored.
-	PageSize            *int64  `default:"25" json:"pageSize"`
-	PollingPeriod       *string `default:"30m" json:"pollingPeriod"`
-	Provider            *string `default:"Dummypay" json:"provider"`
-	UpdateLinkFlowError *bool   `json:"updateLinkFlowError,omitempty"`
+	PageSize      *int64  `default:"25" json:"pageSize"`
+	PollingPeriod *string `default:"30m" json:"pollingPeriod"`
+	// Polling period overrides by fetch capability (e.g. FETCH_BALANCES), falling back to pollingPeriod
+	PollingPeriods      map[string]string `json:"pollingPeriods,omitempty"`
+	Provider            *string           `default:"Dummypay" json:"provider"`
+	UpdateLinkFlowError *bool             `json:"updateLinkFlowError,omitempty"`
 }
 
 func (v V3DummypayConfig) MarshalJSON() ([]byte, error) {
@@ -28,6 +32,13 @@ func (v *V3DummypayConfig) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (o *V3DummypayConfig) GetBlackoutWindows() []V3BlackoutWindow {
+	if o == nil {
+		return nil
+	}
+	return o.BlackoutWindows
+}
+
 func (o *V3DummypayConfig) GetDirectory() string {
 	if o == nil {
 		return ""
@@ -63,6 +74,13 @@ func (o *V3DummypayConfig) GetPollingPeriod() *string {
 	return o.PollingPeriod
 }
 
+func (o *V3DummypayConfig) GetPollingPeriods() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.PollingPeriods
+}
+
 func (o *V3DummypayConfig) GetProvider() *string {
 	if o == nil {
 		return nil
@@ -16,6 +16,7 @@ const (
 	V3ErrorsEnumMissingOrInvalidBody V3ErrorsEnum = "MISSING_OR_INVALID_BODY"
 	V3ErrorsEnumConflict             V3ErrorsEnum = "CONFLICT"
 	V3ErrorsEnumNotFound             V3ErrorsEnum = "NOT_FOUND"
+	V3ErrorsEnumLimitExceeded        V3ErrorsEnum = "LIMIT_EXCEEDED"
 )
 
 func (e V3ErrorsEnum) ToPointer() *V3ErrorsEnum {
@@ -38,6 +39,8 @@ func (e *V3ErrorsEnum) UnmarshalJSON(data []byte) error {
 	case "CONFLICT":
 		fallthrough
 	case "NOT_FOUND":
+		fallthrough
+	case "LIMIT_EXCEEDED":
 		*e = V3ErrorsEnum(v)
 		return nil
 	default:
@@ -7,14 +7,18 @@ import (
 )
 
 type V3FireblocksConfig struct {
-	APIKey   string  `json:"apiKey"`
-	Endpoint *string `json:"endpoint,omitempty"`
-	Name     string  `json:"name"`
+	APIKey string `json:"apiKey"`
+	// Daily UTC time windows during which the connector is not polled
+	BlackoutWindows []V3BlackoutWindow `json:"blackoutWindows,omitempty"`
+	Endpoint        *string            `json:"endpoint,omitempty"`
+	Name            string             `json:"name"`
 	// Deprecated: From v3.1, this parameter will be ignored.
 	PageSize      *int64  `default:"25" json:"pageSize"`
 	PollingPeriod *string `default:"30m" json:"pollingPeriod"`
-	PrivateKey    string  `json:"privateKey"`
-	Provider      *string `default:"Fireblocks" json:"provider"`
+	// Polling period overrides by fetch capability (e.g. FETCH_BALANCES), falling back to pollingPeriod
+	PollingPeriods map[string]string `json:"pollingPeriods,omitempty"`
+	PrivateKey     string            `json:"privateKey"`
+	Provider       *string           `default:"Fireblocks" json:"provider"`
 }
 
 func (v V3FireblocksConfig) MarshalJSON() ([]byte, error) {
@@ -35,6 +39,13 @@ func (o *V3FireblocksConfig) GetAPIKey() string {
 	return o.APIKey
 }
 
+func (o *V3FireblocksConfig) GetBlackoutWindows() []V3BlackoutWindow {
+	if o == nil {
+		return nil
+	}
+	return o.BlackoutWindows
+}
+
 func (o *V3FireblocksConfig) GetEndpoint() *string {
 	if o == nil {
 		return nil
@@ -63,6 +74,13 @@ func (o *V3FireblocksConfig) GetPollingPeriod() *string {
 	return o.PollingPeriod
 }
 
+func (o *V3FireblocksConfig) GetPollingPeriods() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.PollingPeriods
+}
+
 func (o *V3FireblocksConfig) GetPrivateKey() string {
 	if o == nil {
 		return ""
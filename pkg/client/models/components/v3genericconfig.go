@@ -7,13 +7,17 @@ import (
 )
 
 type V3GenericConfig struct {
-	APIKey   string `json:"apiKey"`
-	Endpoint string `json:"endpoint"`
-	Name     string `json:"name"`
+	APIKey string `json:"apiKey"`
+	// Daily UTC time windows during which the connector is not polled
+	BlackoutWindows []V3BlackoutWindow `json:"blackoutWindows,omitempty"`
+	Endpoint        string             `json:"endpoint"`
+	Name            string             `json:"name"`
 	// Deprecated: From v3.1, this parameter will be ignored.
 	PageSize      *int64  `default:"25" json:"pageSize"`
 	PollingPeriod *string `default:"30m" json:"pollingPeriod"`
-	Provider      *string `default:"Generic" json:"provider"`
+	// Polling period overrides by fetch capability (e.g. FETCH_BALANCES), falling back to pollingPeriod
+	PollingPeriods map[string]string `json:"pollingPeriods,omitempty"`
+	Provider       *string           `default:"Generic" json:"provider"`
 }
 
 func (v V3GenericConfig) MarshalJSON() ([]byte, error) {
@@ -34,6 +38,13 @@ func (o *V3GenericConfig) GetAPIKey() string {
 	return o.APIKey
 }
 
+func (o *V3GenericConfig) GetBlackoutWindows() []V3BlackoutWindow {
+	if o == nil {
+		return nil
+	}
+	return o.BlackoutWindows
+}
+
 func (o *V3GenericConfig) GetEndpoint() string {
 	if o == nil {
 		return ""
@@ -62,6 +73,13 @@ func (o *V3GenericConfig) GetPollingPeriod() *string {
 	return o.PollingPeriod
 }
 
+func (o *V3GenericConfig) GetPollingPeriods() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.PollingPeriods
+}
+
 func (o *V3GenericConfig) GetProvider() *string {
 	if o == nil {
 		return nil
@@ -7,14 +7,18 @@ import (
 )
 
 type V3IncreaseConfig struct {
-	APIKey   string `json:"apiKey"`
-	Endpoint string `json:"endpoint"`
-	Name     string `json:"name"`
+	APIKey string `json:"apiKey"`
+	// Daily UTC time windows during which the connector is not polled
+	BlackoutWindows []V3BlackoutWindow `json:"blackoutWindows,omitempty"`
+	Endpoint        string             `json:"endpoint"`
+	Name            string             `json:"name"`
 	// Deprecated: From v3.1, this parameter will be ignored.
-	PageSize            *int64  `default:"25" json:"pageSize"`
-	PollingPeriod       *string `default:"30m" json:"pollingPeriod"`
-	Provider            *string `default:"Increase" json:"provider"`
-	WebhookSharedSecret string  `json:"webhookSharedSecret"`
+	PageSize      *int64  `default:"25" json:"pageSize"`
+	PollingPeriod *string `default:"30m" json:"pollingPeriod"`
+	// Polling period overrides by fetch capability (e.g. FETCH_BALANCES), falling back to pollingPeriod
+	PollingPeriods      map[string]string `json:"pollingPeriods,omitempty"`
+	Provider            *string           `default:"Increase" json:"provider"`
+	WebhookSharedSecret string            `json:"webhookSharedSecret"`
 }
 
 func (v V3IncreaseConfig) MarshalJSON() ([]byte, error) {
@@ -35,6 +39,13 @@ func (o *V3IncreaseConfig) GetAPIKey() string {
 	return o.APIKey
 }
 
+func (o *V3IncreaseConfig) GetBlackoutWindows() []V3BlackoutWindow {
+	if o == nil {
+		return nil
+	}
+	return o.BlackoutWindows
+}
+
 func (o *V3IncreaseConfig) GetEndpoint() string {
 	if o == nil {
 		return ""
@@ -63,6 +74,13 @@ func (o *V3IncreaseConfig) GetPollingPeriod() *string {
 	return o.PollingPeriod
 }
 
+func (o *V3IncreaseConfig) GetPollingPeriods() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.PollingPeriods
+}
+
 func (o *V3IncreaseConfig) GetProvider() *string {
 	if o == nil {
 		return nil
@@ -7,14 +7,18 @@ import (
 )
 
 type V3MangopayConfig struct {
-	APIKey   string `json:"apiKey"`
-	ClientID string `json:"clientID"`
-	Endpoint string `json:"endpoint"`
-	Name     string `json:"name"`
+	APIKey string `json:"apiKey"`
+	// Daily UTC time windows during which the connector is not polled
+	BlackoutWindows []V3BlackoutWindow `json:"blackoutWindows,omitempty"`
+	ClientID        string             `json:"clientID"`
+	Endpoint        string             `json:"endpoint"`
+	Name            string             `json:"name"`
 	// Deprecated: From v3.1, this parameter will be ignored.
 	PageSize      *int64  `default:"25" json:"pageSize"`
 	PollingPeriod *string `default:"30m" json:"pollingPeriod"`
-	Provider      *string `default:"Mangopay" json:"provider"`
+	// Polling period overrides by fetch capability (e.g. FETCH_BALANCES), falling back to pollingPeriod
+	PollingPeriods map[string]string `json:"pollingPeriods,omitempty"`
+	Provider       *string           `default:"Mangopay" json:"provider"`
 }
 
 func (v V3MangopayConfig) MarshalJSON() ([]byte, error) {
@@ -35,6 +39,13 @@ func (o *V3MangopayConfig) GetAPIKey() string {
 	return o.APIKey
 }
 
+func (o *V3MangopayConfig) GetBlackoutWindows() []V3BlackoutWindow {
+	if o == nil {
+		return nil
+	}
+	return o.BlackoutWindows
+}
+
 func (o *V3MangopayConfig) GetClientID() string {
 	if o == nil {
 		return ""
@@ -70,6 +81,13 @@ func (o *V3MangopayConfig) GetPollingPeriod() *string {
 	return o.PollingPeriod
 }
 
+func (o *V3MangopayConfig) GetPollingPeriods() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.PollingPeriods
+}
+
 func (o *V3MangopayConfig) GetProvider() *string {
 	if o == nil {
 		return nil
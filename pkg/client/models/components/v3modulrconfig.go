@@ -9,12 +9,16 @@ import (
 type V3ModulrConfig struct {
 	APIKey    string `json:"apiKey"`
 	APISecret string `json:"apiSecret"`
-	Endpoint  string `json:"endpoint"`
-	Name      string `json:"name"`
+	// Daily UTC time windows during which the connector is not polled
+	BlackoutWindows []V3BlackoutWindow `json:"blackoutWindows,omitempty"`
+	Endpoint        string             `json:"endpoint"`
+	Name            string             `json:"name"`
 	// Deprecated: From v3.1, this parameter will be ignored.
 	PageSize      *int64  `default:"25" json:"pageSize"`
 	PollingPeriod *string `default:"30m" json:"pollingPeriod"`
-	Provider      *string `default:"Modulr" json:"provider"`
+	// Polling period overrides by fetch capability (e.g. FETCH_BALANCES), falling back to pollingPeriod
+	PollingPeriods map[string]string `json:"pollingPeriods,omitempty"`
+	Provider       *string           `default:"Modulr" json:"provider"`
 }
 
 func (v V3ModulrConfig) MarshalJSON() ([]byte, error) {
@@ -42,6 +46,13 @@ func (o *V3ModulrConfig) GetAPISecret() string {
 	return o.APISecret
 }
 
+func (o *V3ModulrConfig) GetBlackoutWindows() []V3BlackoutWindow {
+	if o == nil {
+		return nil
+	}
+	return o.BlackoutWindows
+}
+
 func (o *V3ModulrConfig) GetEndpoint() string {
 	if o == nil {
 		return ""
@@ -70,6 +81,13 @@ func (o *V3ModulrConfig) GetPollingPeriod() *string {
 	return o.PollingPeriod
 }
 
+func (o *V3ModulrConfig) GetPollingPeriods() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.PollingPeriods
+}
+
 func (o *V3ModulrConfig) GetProvider() *string {
 	if o == nil {
 		return nil
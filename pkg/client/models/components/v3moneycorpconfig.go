@@ -7,14 +7,18 @@ import (
 )
 
 type V3MoneycorpConfig struct {
-	APIKey   string `json:"apiKey"`
-	ClientID string `json:"clientID"`
-	Endpoint string `json:"endpoint"`
-	Name     string `json:"name"`
+	APIKey string `json:"apiKey"`
+	// Daily UTC time windows during which the connector is not polled
+	BlackoutWindows []V3BlackoutWindow `json:"blackoutWindows,omitempty"`
+	ClientID        string             `json:"clientID"`
+	Endpoint        string             `json:"endpoint"`
+	Name            string             `json:"name"`
 	// Deprecated: From v3.1, this parameter will be ignored.
 	PageSize      *int64  `default:"25" json:"pageSize"`
 	PollingPeriod *string `default:"30m" json:"pollingPeriod"`
-	Provider      *string `default:"Moneycorp" json:"provider"`
+	// Polling period overrides by fetch capability (e.g. FETCH_BALANCES), falling back to pollingPeriod
+	PollingPeriods map[string]string `json:"pollingPeriods,omitempty"`
+	Provider       *string           `default:"Moneycorp" json:"provider"`
 }
 
 func (v V3MoneycorpConfig) MarshalJSON() ([]byte, error) {
@@ -35,6 +39,13 @@ func (o *V3MoneycorpConfig) GetAPIKey() string {
 	return o.APIKey
 }
 
+func (o *V3MoneycorpConfig) GetBlackoutWindows() []V3BlackoutWindow {
+	if o == nil {
+		return nil
+	}
+	return o.BlackoutWindows
+}
+
 func (o *V3MoneycorpConfig) GetClientID() string {
 	if o == nil {
 		return ""
@@ -70,6 +81,13 @@ func (o *V3MoneycorpConfig) GetPollingPeriod() *string {
 	return o.PollingPeriod
 }
 
+func (o *V3MoneycorpConfig) GetPollingPeriods() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.PollingPeriods
+}
+
 func (o *V3MoneycorpConfig) GetProvider() *string {
 	if o == nil {
 		return nil
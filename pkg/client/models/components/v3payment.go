@@ -22,7 +22,9 @@ type V3Payment struct {
 	Status               V3PaymentStatusEnum   `json:"status"`
 	SourceAccountID      *string               `json:"sourceAccountID,omitempty"`
 	DestinationAccountID *string               `json:"destinationAccountID,omitempty"`
+	CounterpartyID       *string               `json:"counterpartyID,omitempty"`
 	Metadata             map[string]string     `json:"metadata,omitempty"`
+	Fees                 []V3PaymentFee        `json:"fees,omitempty"`
 	Adjustments          []V3PaymentAdjustment `json:"adjustments,omitempty"`
 }
 
@@ -128,6 +130,13 @@ func (o *V3Payment) GetDestinationAccountID() *string {
 	return o.DestinationAccountID
 }
 
+func (o *V3Payment) GetCounterpartyID() *string {
+	if o == nil {
+		return nil
+	}
+	return o.CounterpartyID
+}
+
 func (o *V3Payment) GetMetadata() map[string]string {
 	if o == nil {
 		return nil
@@ -135,6 +144,13 @@ func (o *V3Payment) GetMetadata() map[string]string {
 	return o.Metadata
 }
 
+func (o *V3Payment) GetFees() []V3PaymentFee {
+	if o == nil {
+		return nil
+	}
+	return o.Fees
+}
+
 func (o *V3Payment) GetAdjustments() []V3PaymentAdjustment {
 	if o == nil {
 		return nil
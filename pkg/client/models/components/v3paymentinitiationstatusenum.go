@@ -13,6 +13,7 @@ const (
 	V3PaymentInitiationStatusEnumUnknown                V3PaymentInitiationStatusEnum = "UNKNOWN"
 	V3PaymentInitiationStatusEnumWaitingForValidation   V3PaymentInitiationStatusEnum = "WAITING_FOR_VALIDATION"
 	V3PaymentInitiationStatusEnumScheduledForProcessing V3PaymentInitiationStatusEnum = "SCHEDULED_FOR_PROCESSING"
+	V3PaymentInitiationStatusEnumPendingScreening       V3PaymentInitiationStatusEnum = "PENDING_SCREENING"
 	V3PaymentInitiationStatusEnumProcessing             V3PaymentInitiationStatusEnum = "PROCESSING"
 	V3PaymentInitiationStatusEnumProcessed              V3PaymentInitiationStatusEnum = "PROCESSED"
 	V3PaymentInitiationStatusEnumFailed                 V3PaymentInitiationStatusEnum = "FAILED"
@@ -37,6 +38,8 @@ func (e *V3PaymentInitiationStatusEnum) UnmarshalJSON(data []byte) error {
 		fallthrough
 	case "SCHEDULED_FOR_PROCESSING":
 		fallthrough
+	case "PENDING_SCREENING":
+		fallthrough
 	case "PROCESSING":
 		fallthrough
 	case "PROCESSED":
@@ -7,14 +7,19 @@ import (
 )
 
 type V3PlaidConfig struct {
-	ClientID     string `json:"clientID"`
-	ClientSecret string `json:"clientSecret"`
-	IsSandbox    *bool  `json:"isSandbox,omitempty"`
-	Name         string `json:"name"`
+	// Daily UTC time windows during which the connector is not polled
+	BlackoutWindows   []V3BlackoutWindow `json:"blackoutWindows,omitempty"`
+	ClientID          string             `json:"clientID"`
+	ClientSecret      string             `json:"clientSecret"`
+	IsSandbox         *bool              `json:"isSandbox,omitempty"`
+	IsTransferEnabled *bool              `json:"isTransferEnabled,omitempty"`
+	Name              string             `json:"name"`
 	// Deprecated: From v3.1, this parameter will be ignored.
 	PageSize      *int64  `default:"25" json:"pageSize"`
 	PollingPeriod *string `default:"30m" json:"pollingPeriod"`
-	Provider      *string `default:"Plaid" json:"provider"`
+	// Polling period overrides by fetch capability (e.g. FETCH_BALANCES), falling back to pollingPeriod
+	PollingPeriods map[string]string `json:"pollingPeriods,omitempty"`
+	Provider       *string           `default:"Plaid" json:"provider"`
 }
 
 func (v V3PlaidConfig) MarshalJSON() ([]byte, error) {
@@ -28,6 +33,13 @@ func (v *V3PlaidConfig) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (o *V3PlaidConfig) GetBlackoutWindows() []V3BlackoutWindow {
+	if o == nil {
+		return nil
+	}
+	return o.BlackoutWindows
+}
+
 func (o *V3PlaidConfig) GetClientID() string {
 	if o == nil {
 		return ""
@@ -49,6 +61,13 @@ func (o *V3PlaidConfig) GetIsSandbox() *bool {
 	return o.IsSandbox
 }
 
+func (o *V3PlaidConfig) GetIsTransferEnabled() *bool {
+	if o == nil {
+		return nil
+	}
+	return o.IsTransferEnabled
+}
+
 func (o *V3PlaidConfig) GetName() string {
 	if o == nil {
 		return ""
@@ -70,6 +89,13 @@ func (o *V3PlaidConfig) GetPollingPeriod() *string {
 	return o.PollingPeriod
 }
 
+func (o *V3PlaidConfig) GetPollingPeriods() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.PollingPeriods
+}
+
 func (o *V3PlaidConfig) GetProvider() *string {
 	if o == nil {
 		return nil
@@ -7,17 +7,21 @@ import (
 )
 
 type V3PowensConfig struct {
-	ClientID              string `json:"clientID"`
-	ClientSecret          string `json:"clientSecret"`
-	ConfigurationToken    string `json:"configurationToken"`
-	Domain                string `json:"domain"`
-	Endpoint              string `json:"endpoint"`
-	MaxConnectionsPerLink int64  `json:"maxConnectionsPerLink"`
-	Name                  string `json:"name"`
+	// Daily UTC time windows during which the connector is not polled
+	BlackoutWindows       []V3BlackoutWindow `json:"blackoutWindows,omitempty"`
+	ClientID              string             `json:"clientID"`
+	ClientSecret          string             `json:"clientSecret"`
+	ConfigurationToken    string             `json:"configurationToken"`
+	Domain                string             `json:"domain"`
+	Endpoint              string             `json:"endpoint"`
+	MaxConnectionsPerLink int64              `json:"maxConnectionsPerLink"`
+	Name                  string             `json:"name"`
 	// Deprecated: From v3.1, this parameter will be ignored.
 	PageSize      *int64  `default:"25" json:"pageSize"`
 	PollingPeriod *string `default:"30m" json:"pollingPeriod"`
-	Provider      *string `default:"Powens" json:"provider"`
+	// Polling period overrides by fetch capability (e.g. FETCH_BALANCES), falling back to pollingPeriod
+	PollingPeriods map[string]string `json:"pollingPeriods,omitempty"`
+	Provider       *string           `default:"Powens" json:"provider"`
 }
 
 func (v V3PowensConfig) MarshalJSON() ([]byte, error) {
@@ -31,6 +35,13 @@ func (v *V3PowensConfig) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (o *V3PowensConfig) GetBlackoutWindows() []V3BlackoutWindow {
+	if o == nil {
+		return nil
+	}
+	return o.BlackoutWindows
+}
+
 func (o *V3PowensConfig) GetClientID() string {
 	if o == nil {
 		return ""
@@ -94,6 +105,13 @@ func (o *V3PowensConfig) GetPollingPeriod() *string {
 	return o.PollingPeriod
 }
 
+func (o *V3PowensConfig) GetPollingPeriods() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.PollingPeriods
+}
+
 func (o *V3PowensConfig) GetProvider() *string {
 	if o == nil {
 		return nil
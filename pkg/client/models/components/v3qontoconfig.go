@@ -7,15 +7,19 @@ import (
 )
 
 type V3QontoConfig struct {
-	APIKey   string `json:"apiKey"`
-	ClientID string `json:"clientID"`
-	Endpoint string `json:"endpoint"`
-	Name     string `json:"name"`
+	APIKey string `json:"apiKey"`
+	// Daily UTC time windows during which the connector is not polled
+	BlackoutWindows []V3BlackoutWindow `json:"blackoutWindows,omitempty"`
+	ClientID        string             `json:"clientID"`
+	Endpoint        string             `json:"endpoint"`
+	Name            string             `json:"name"`
 	// Deprecated: From v3.1, this parameter will be ignored.
 	PageSize      *int64  `default:"25" json:"pageSize"`
 	PollingPeriod *string `default:"30m" json:"pollingPeriod"`
-	Provider      *string `default:"Qonto" json:"provider"`
-	StagingToken  *string `json:"stagingToken,omitempty"`
+	// Polling period overrides by fetch capability (e.g. FETCH_BALANCES), falling back to pollingPeriod
+	PollingPeriods map[string]string `json:"pollingPeriods,omitempty"`
+	Provider       *string           `default:"Qonto" json:"provider"`
+	StagingToken   *string           `json:"stagingToken,omitempty"`
 }
 
 func (v V3QontoConfig) MarshalJSON() ([]byte, error) {
@@ -36,6 +40,13 @@ func (o *V3QontoConfig) GetAPIKey() string {
 	return o.APIKey
 }
 
+func (o *V3QontoConfig) GetBlackoutWindows() []V3BlackoutWindow {
+	if o == nil {
+		return nil
+	}
+	return o.BlackoutWindows
+}
+
 func (o *V3QontoConfig) GetClientID() string {
 	if o == nil {
 		return ""
@@ -71,6 +82,13 @@ func (o *V3QontoConfig) GetPollingPeriod() *string {
 	return o.PollingPeriod
 }
 
+func (o *V3QontoConfig) GetPollingPeriods() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.PollingPeriods
+}
+
 func (o *V3QontoConfig) GetProvider() *string {
 	if o == nil {
 		return nil
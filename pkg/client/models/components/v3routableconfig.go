@@ -9,12 +9,16 @@ import (
 type V3RoutableConfig struct {
 	ActingTeamMember *string `json:"actingTeamMember,omitempty"`
 	APIKey           string  `json:"apiKey"`
-	Endpoint         *string `json:"endpoint,omitempty"`
-	Name             string  `json:"name"`
+	// Daily UTC time windows during which the connector is not polled
+	BlackoutWindows []V3BlackoutWindow `json:"blackoutWindows,omitempty"`
+	Endpoint        *string            `json:"endpoint,omitempty"`
+	Name            string             `json:"name"`
 	// Deprecated: From v3.1, this parameter will be ignored.
 	PageSize      *int64  `default:"25" json:"pageSize"`
 	PollingPeriod *string `default:"30m" json:"pollingPeriod"`
-	Provider      *string `default:"Routable" json:"provider"`
+	// Polling period overrides by fetch capability (e.g. FETCH_BALANCES), falling back to pollingPeriod
+	PollingPeriods map[string]string `json:"pollingPeriods,omitempty"`
+	Provider       *string           `default:"Routable" json:"provider"`
 }
 
 func (v V3RoutableConfig) MarshalJSON() ([]byte, error) {
@@ -42,6 +46,13 @@ func (o *V3RoutableConfig) GetAPIKey() string {
 	return o.APIKey
 }
 
+func (o *V3RoutableConfig) GetBlackoutWindows() []V3BlackoutWindow {
+	if o == nil {
+		return nil
+	}
+	return o.BlackoutWindows
+}
+
 func (o *V3RoutableConfig) GetEndpoint() *string {
 	if o == nil {
 		return nil
@@ -70,6 +81,13 @@ func (o *V3RoutableConfig) GetPollingPeriod() *string {
 	return o.PollingPeriod
 }
 
+func (o *V3RoutableConfig) GetPollingPeriods() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.PollingPeriods
+}
+
 func (o *V3RoutableConfig) GetProvider() *string {
 	if o == nil {
 		return nil
@@ -8,11 +8,15 @@ import (
 
 type V3StripeConfig struct {
 	APIKey string `json:"apiKey"`
-	Name   string `json:"name"`
+	// Daily UTC time windows during which the connector is not polled
+	BlackoutWindows []V3BlackoutWindow `json:"blackoutWindows,omitempty"`
+	Name            string             `json:"name"`
 	// Deprecated: From v3.1, this parameter will be ignored.
 	PageSize      *int64  `default:"25" json:"pageSize"`
 	PollingPeriod *string `default:"30m" json:"pollingPeriod"`
-	Provider      *string `default:"Stripe" json:"provider"`
+	// Polling period overrides by fetch capability (e.g. FETCH_BALANCES), falling back to pollingPeriod
+	PollingPeriods map[string]string `json:"pollingPeriods,omitempty"`
+	Provider       *string           `default:"Stripe" json:"provider"`
 }
 
 func (v V3StripeConfig) MarshalJSON() ([]byte, error) {
@@ -33,6 +37,13 @@ func (o *V3StripeConfig) GetAPIKey() string {
 	return o.APIKey
 }
 
+func (o *V3StripeConfig) GetBlackoutWindows() []V3BlackoutWindow {
+	if o == nil {
+		return nil
+	}
+	return o.BlackoutWindows
+}
+
 func (o *V3StripeConfig) GetName() string {
 	if o == nil {
 		return ""
@@ -54,6 +65,13 @@ func (o *V3StripeConfig) GetPollingPeriod() *string {
 	return o.PollingPeriod
 }
 
+func (o *V3StripeConfig) GetPollingPeriods() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.PollingPeriods
+}
+
 func (o *V3StripeConfig) GetProvider() *string {
 	if o == nil {
 		return nil
@@ -7,14 +7,18 @@ import (
 )
 
 type V3TinkConfig struct {
-	ClientID     string `json:"clientID"`
-	ClientSecret string `json:"clientSecret"`
-	Endpoint     string `json:"endpoint"`
-	Name         string `json:"name"`
+	// Daily UTC time windows during which the connector is not polled
+	BlackoutWindows []V3BlackoutWindow `json:"blackoutWindows,omitempty"`
+	ClientID        string             `json:"clientID"`
+	ClientSecret    string             `json:"clientSecret"`
+	Endpoint        string             `json:"endpoint"`
+	Name            string             `json:"name"`
 	// Deprecated: From v3.1, this parameter will be ignored.
 	PageSize      *int64  `default:"25" json:"pageSize"`
 	PollingPeriod *string `default:"30m" json:"pollingPeriod"`
-	Provider      *string `default:"Tink" json:"provider"`
+	// Polling period overrides by fetch capability (e.g. FETCH_BALANCES), falling back to pollingPeriod
+	PollingPeriods map[string]string `json:"pollingPeriods,omitempty"`
+	Provider       *string           `default:"Tink" json:"provider"`
 }
 
 func (v V3TinkConfig) MarshalJSON() ([]byte, error) {
@@ -28,6 +32,13 @@ func (v *V3TinkConfig) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (o *V3TinkConfig) GetBlackoutWindows() []V3BlackoutWindow {
+	if o == nil {
+		return nil
+	}
+	return o.BlackoutWindows
+}
+
 func (o *V3TinkConfig) GetClientID() string {
 	if o == nil {
 		return ""
@@ -70,6 +81,13 @@ func (o *V3TinkConfig) GetPollingPeriod() *string {
 	return o.PollingPeriod
 }
 
+func (o *V3TinkConfig) GetPollingPeriods() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.PollingPeriods
+}
+
 func (o *V3TinkConfig) GetProvider() *string {
 	if o == nil {
 		return nil
@@ -8,12 +8,16 @@ import (
 
 type V3WiseConfig struct {
 	APIKey string `json:"apiKey"`
-	Name   string `json:"name"`
+	// Daily UTC time windows during which the connector is not polled
+	BlackoutWindows []V3BlackoutWindow `json:"blackoutWindows,omitempty"`
+	Name            string             `json:"name"`
 	// Deprecated: From v3.1, this parameter will be ignored.
-	PageSize         *int64  `default:"25" json:"pageSize"`
-	PollingPeriod    *string `default:"30m" json:"pollingPeriod"`
-	Provider         *string `default:"Wise" json:"provider"`
-	WebhookPublicKey string  `json:"webhookPublicKey"`
+	PageSize      *int64  `default:"25" json:"pageSize"`
+	PollingPeriod *string `default:"30m" json:"pollingPeriod"`
+	// Polling period overrides by fetch capability (e.g. FETCH_BALANCES), falling back to pollingPeriod
+	PollingPeriods   map[string]string `json:"pollingPeriods,omitempty"`
+	Provider         *string           `default:"Wise" json:"provider"`
+	WebhookPublicKey string            `json:"webhookPublicKey"`
 }
 
 func (v V3WiseConfig) MarshalJSON() ([]byte, error) {
@@ -34,6 +38,13 @@ func (o *V3WiseConfig) GetAPIKey() string {
 	return o.APIKey
 }
 
+func (o *V3WiseConfig) GetBlackoutWindows() []V3BlackoutWindow {
+	if o == nil {
+		return nil
+	}
+	return o.BlackoutWindows
+}
+
 func (o *V3WiseConfig) GetName() string {
 	if o == nil {
 		return ""
@@ -55,6 +66,13 @@ func (o *V3WiseConfig) GetPollingPeriod() *string {
 	return o.PollingPeriod
 }
 
+func (o *V3WiseConfig) GetPollingPeriods() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.PollingPeriods
+}
+
 func (o *V3WiseConfig) GetProvider() *string {
 	if o == nil {
 		return nil
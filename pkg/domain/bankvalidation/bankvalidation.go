@@ -0,0 +1,140 @@
+// Package bankvalidation checks the format and the check digits of bank
+// details before they are stored or forwarded to a connector, so that typos
+// are reported on the field instead of as a PSP rejection.
+package bankvalidation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+)
+
+// FieldError is an invalid bank detail, Field is the JSON name of the field.
+type FieldError struct {
+	Field string
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Err)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// FieldErrors are all the invalid bank details of a bank account.
+type FieldErrors []*FieldError
+
+func (e FieldErrors) Error() string {
+	errs := make([]string, 0, len(e))
+	for _, err := range e {
+		errs = append(errs, err.Error())
+	}
+	return strings.Join(errs, ", ")
+}
+
+func (e FieldErrors) Unwrap() []error {
+	errs := make([]error, 0, len(e))
+	for _, err := range e {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// RoutingCodeMetadataKey is a metadata key a connector reads the routing
+// code of a bank account from. Country is the country whose format the
+// routing code follows, the country of the bank account when empty.
+type RoutingCodeMetadataKey struct {
+	Key     string
+	Country string
+}
+
+// ValidateBankAccount checks the IBAN and the BIC of the bank account, and
+// its local account number and routing codes for the countries where their
+// format is known. The routing codes are read from the
+// models.BankAccountRoutingCodeMetadataKey metadata and from the connector
+// metadata keys given. It returns FieldErrors if any of them is invalid.
+func ValidateBankAccount(ba models.BankAccount, routingCodeKeys ...RoutingCodeMetadataKey) error {
+	var errs FieldErrors
+
+	if ba.IBAN != nil {
+		if err := ValidateIBAN(*ba.IBAN); err != nil {
+			errs = append(errs, &FieldError{Field: "iban", Err: err})
+		}
+	}
+
+	if ba.SwiftBicCode != nil {
+		if err := ValidateBIC(*ba.SwiftBicCode); err != nil {
+			errs = append(errs, &FieldError{Field: "swiftBicCode", Err: err})
+		}
+	}
+
+	country := ""
+	if ba.Country != nil {
+		country = strings.ToUpper(*ba.Country)
+	}
+
+	if ba.AccountNumber != nil {
+		if err := ValidateAccountNumber(country, *ba.AccountNumber); err != nil {
+			errs = append(errs, &FieldError{Field: "accountNumber", Err: err})
+		}
+	}
+
+	keys := append([]RoutingCodeMetadataKey{{Key: models.BankAccountRoutingCodeMetadataKey}}, routingCodeKeys...)
+	for _, key := range keys {
+		routingCode, ok := ba.Metadata[key.Key]
+		if !ok {
+			continue
+		}
+
+		routingCountry := country
+		if key.Country != "" {
+			routingCountry = key.Country
+		}
+
+		if err := ValidateRoutingCode(routingCountry, routingCode); err != nil {
+			errs = append(errs, &FieldError{
+				Field: fmt.Sprintf("metadata[%s]", key.Key),
+				Err:   err,
+			})
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// normalize removes the spaces and hyphens used to group the characters of
+// bank details when they are printed.
+func normalize(v string) string {
+	return strings.ToUpper(strings.NewReplacer(" ", "", "-", "").Replace(v))
+}
+
+func isDigits(v string) bool {
+	if v == "" {
+		return false
+	}
+	for _, c := range v {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func isAlphanumeric(v string) bool {
+	if v == "" {
+		return false
+	}
+	for _, c := range v {
+		if (c < '0' || c > '9') && (c < 'A' || c > 'Z') && (c < 'a' || c > 'z') {
+			return false
+		}
+	}
+	return true
+}
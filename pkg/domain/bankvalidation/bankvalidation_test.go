@@ -0,0 +1,113 @@
+package bankvalidation_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/payments/pkg/domain/bankvalidation"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateBankAccount(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid IBAN and BIC", func(t *testing.T) {
+		t.Parallel()
+
+		err := bankvalidation.ValidateBankAccount(models.BankAccount{
+			IBAN:         pointer.For("DE89370400440532013000"),
+			SwiftBicCode: pointer.For("DEUTDEFF"),
+			Country:      pointer.For("DE"),
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("valid local US bank account", func(t *testing.T) {
+		t.Parallel()
+
+		err := bankvalidation.ValidateBankAccount(models.BankAccount{
+			AccountNumber: pointer.For("123456789"),
+			Country:       pointer.For("us"),
+			Metadata: map[string]string{
+				models.BankAccountRoutingCodeMetadataKey: "021000021",
+			},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("connector routing codes", func(t *testing.T) {
+		t.Parallel()
+
+		keys := []bankvalidation.RoutingCodeMetadataKey{
+			{Key: "com.psp.spec/routingNumber"},
+			{Key: "com.psp.spec/sortCode", Country: "GB"},
+		}
+
+		err := bankvalidation.ValidateBankAccount(models.BankAccount{
+			AccountNumber: pointer.For("1234-5678 9"),
+			Country:       pointer.For("US"),
+			Metadata: map[string]string{
+				"com.psp.spec/routingNumber": "021000021",
+				"com.psp.spec/sortCode":      "60-16-13",
+			},
+		}, keys...)
+		require.NoError(t, err)
+
+		err = bankvalidation.ValidateBankAccount(models.BankAccount{
+			Country: pointer.For("US"),
+			Metadata: map[string]string{
+				"com.psp.spec/routingNumber": "021000022",
+				"com.psp.spec/sortCode":      "021000021",
+			},
+		}, keys...)
+		var fieldErrors bankvalidation.FieldErrors
+		require.True(t, errors.As(err, &fieldErrors))
+		require.Len(t, fieldErrors, 2)
+		require.Equal(t, "metadata[com.psp.spec/routingNumber]", fieldErrors[0].Field)
+		require.ErrorIs(t, fieldErrors[0], bankvalidation.ErrInvalidRoutingCode)
+		require.Equal(t, "metadata[com.psp.spec/sortCode]", fieldErrors[1].Field)
+		require.ErrorIs(t, fieldErrors[1], bankvalidation.ErrInvalidRoutingCode)
+	})
+
+	t.Run("no bank details", func(t *testing.T) {
+		t.Parallel()
+
+		require.NoError(t, bankvalidation.ValidateBankAccount(models.BankAccount{}))
+	})
+
+	t.Run("every invalid field is reported", func(t *testing.T) {
+		t.Parallel()
+
+		err := bankvalidation.ValidateBankAccount(models.BankAccount{
+			IBAN:          pointer.For("GB29NWBK60161331926818"),
+			SwiftBicCode:  pointer.For("NWBK"),
+			AccountNumber: pointer.For("3192681"),
+			Country:       pointer.For("GB"),
+			Metadata: map[string]string{
+				models.BankAccountRoutingCodeMetadataKey: "60-16",
+			},
+		})
+		require.Error(t, err)
+
+		var fieldErrors bankvalidation.FieldErrors
+		require.True(t, errors.As(err, &fieldErrors))
+		require.Len(t, fieldErrors, 4)
+		require.ErrorIs(t, err, bankvalidation.ErrInvalidIBANChecksum)
+
+		require.Equal(t, "iban", fieldErrors[0].Field)
+		require.ErrorIs(t, fieldErrors[0], bankvalidation.ErrInvalidIBANChecksum)
+		require.Equal(t, "swiftBicCode", fieldErrors[1].Field)
+		require.ErrorIs(t, fieldErrors[1], bankvalidation.ErrInvalidBIC)
+		require.Equal(t, "accountNumber", fieldErrors[2].Field)
+		require.ErrorIs(t, fieldErrors[2], bankvalidation.ErrInvalidAccountNumber)
+		require.Equal(t, "metadata[com.formance.spec/routingCode]", fieldErrors[3].Field)
+		require.ErrorIs(t, fieldErrors[3], bankvalidation.ErrInvalidRoutingCode)
+
+		require.Equal(t, "iban: invalid IBAN check digits, "+
+			"swiftBicCode: "+bankvalidation.ErrInvalidBIC.Error()+", "+
+			"accountNumber: invalid account number: must have 8 digits, "+
+			"metadata[com.formance.spec/routingCode]: invalid routing code: sort code must have 6 digits", err.Error())
+	})
+}
@@ -0,0 +1,20 @@
+package bankvalidation
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+var ErrInvalidBIC = errors.New("invalid BIC, expected 4 letters for the bank, 2 letters for the country, 2 characters for the location and an optional 3 characters branch code")
+
+var bicRegexp = regexp.MustCompile(`^[A-Z]{4}[A-Z]{2}[A-Z0-9]{2}([A-Z0-9]{3})?$`)
+
+// ValidateBIC checks the format of a BIC (ISO 9362), letters are case
+// insensitive.
+func ValidateBIC(bic string) error {
+	if !bicRegexp.MatchString(strings.ToUpper(bic)) {
+		return ErrInvalidBIC
+	}
+	return nil
+}
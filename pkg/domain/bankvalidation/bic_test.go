@@ -0,0 +1,41 @@
+package bankvalidation_test
+
+import (
+	"testing"
+
+	"github.com/formancehq/payments/pkg/domain/bankvalidation"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateBIC(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		bic         string
+		expectedErr error
+	}{
+		{name: "8 characters", bic: "DEUTDEFF"},
+		{name: "11 characters", bic: "DEUTDEFF500"},
+		{name: "digits in the location", bic: "NWBKGB2L"},
+		{name: "lowercase", bic: "deutdeff"},
+		{name: "digit in the bank code", bic: "DEU1DEFF", expectedErr: bankvalidation.ErrInvalidBIC},
+		{name: "digit in the country code", bic: "DEUTD1FF", expectedErr: bankvalidation.ErrInvalidBIC},
+		{name: "too short", bic: "DEUTDEF", expectedErr: bankvalidation.ErrInvalidBIC},
+		{name: "incomplete branch code", bic: "DEUTDEFF50", expectedErr: bankvalidation.ErrInvalidBIC},
+		{name: "too long", bic: "DEUTDEFF5000", expectedErr: bankvalidation.ErrInvalidBIC},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := bankvalidation.ValidateBIC(test.bic)
+			if test.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, test.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package bankvalidation
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrInvalidIBANCountry  = errors.New("unknown IBAN country code")
+	ErrInvalidIBANLength   = errors.New("invalid IBAN length")
+	ErrInvalidIBANFormat   = errors.New("invalid IBAN format")
+	ErrInvalidIBANChecksum = errors.New("invalid IBAN check digits")
+)
+
+// ibanLengths is the length of the IBANs of each country of the SWIFT IBAN
+// registry.
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16,
+	"BG": 22, "BH": 22, "BI": 27, "BR": 29, "BY": 28, "CH": 21, "CR": 22,
+	"CY": 28, "CZ": 24, "DE": 22, "DJ": 27, "DK": 18, "DO": 28, "EE": 20,
+	"EG": 29, "ES": 24, "FI": 18, "FK": 18, "FO": 18, "FR": 27, "GB": 22,
+	"GE": 22, "GI": 23, "GL": 18, "GR": 27, "GT": 28, "HR": 21, "HU": 28,
+	"IE": 22, "IL": 23, "IQ": 23, "IS": 26, "IT": 27, "JO": 30, "KW": 30,
+	"KZ": 20, "LB": 28, "LC": 32, "LI": 21, "LT": 20, "LU": 20, "LV": 21,
+	"LY": 25, "MC": 27, "MD": 24, "ME": 22, "MK": 19, "MN": 20, "MR": 27,
+	"MT": 31, "MU": 30, "NI": 28, "NL": 18, "NO": 15, "OM": 23, "PK": 24,
+	"PL": 28, "PS": 29, "PT": 25, "QA": 29, "RO": 24, "RS": 22, "RU": 33,
+	"SA": 24, "SC": 31, "SD": 18, "SE": 24, "SI": 19, "SK": 24, "SM": 27,
+	"SO": 23, "ST": 25, "SV": 28, "TL": 23, "TN": 24, "TR": 26, "UA": 29,
+	"VA": 22, "VG": 24, "XK": 20, "YE": 30,
+}
+
+// ValidateIBAN checks the country code, the length and the mod-97 check
+// digits of the IBAN. Spaces are ignored and letters are case insensitive.
+func ValidateIBAN(iban string) error {
+	iban = normalize(iban)
+
+	if len(iban) < 4 {
+		return ErrInvalidIBANLength
+	}
+
+	country := iban[:2]
+	length, ok := ibanLengths[country]
+	if !ok {
+		return fmt.Errorf("%w %q", ErrInvalidIBANCountry, country)
+	}
+
+	if len(iban) != length {
+		return fmt.Errorf("%w: %d characters instead of %d for %s", ErrInvalidIBANLength, len(iban), length, country)
+	}
+
+	if !isDigits(iban[2:4]) {
+		return ErrInvalidIBANFormat
+	}
+
+	// The country code and the check digits are moved at the end, and each
+	// letter is replaced by two digits (A = 10, ..., Z = 35).
+	remainder := 0
+	for _, c := range iban[4:] + iban[:4] {
+		switch {
+		case c >= '0' && c <= '9':
+			remainder = (remainder*10 + int(c-'0')) % 97
+		case c >= 'A' && c <= 'Z':
+			remainder = (remainder*100 + int(c-'A') + 10) % 97
+		default:
+			return ErrInvalidIBANFormat
+		}
+	}
+
+	if remainder != 1 {
+		return ErrInvalidIBANChecksum
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package bankvalidation_test
+
+import (
+	"testing"
+
+	"github.com/formancehq/payments/pkg/domain/bankvalidation"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateIBAN(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		iban        string
+		expectedErr error
+	}{
+		{name: "valid DE", iban: "DE89370400440532013000"},
+		{name: "valid GB", iban: "GB29NWBK60161331926819"},
+		{name: "valid FR with letters in the BBAN", iban: "FR1420041010050500013M02606"},
+		{name: "valid NO, shortest length", iban: "NO9386011117947"},
+		{name: "valid with spaces", iban: "GB29 NWBK 6016 1331 9268 19"},
+		{name: "valid lowercase", iban: "gb29nwbk60161331926819"},
+		{name: "too short", iban: "DE8", expectedErr: bankvalidation.ErrInvalidIBANLength},
+		{name: "unknown country", iban: "XX89370400440532013000", expectedErr: bankvalidation.ErrInvalidIBANCountry},
+		{name: "wrong length for the country", iban: "DE8937040044053201300", expectedErr: bankvalidation.ErrInvalidIBANLength},
+		{name: "letters as check digits", iban: "DEAB370400440532013000", expectedErr: bankvalidation.ErrInvalidIBANFormat},
+		{name: "invalid character", iban: "DE8937040044053201300!", expectedErr: bankvalidation.ErrInvalidIBANFormat},
+		{name: "typo in the BBAN", iban: "DE89370400440532013001", expectedErr: bankvalidation.ErrInvalidIBANChecksum},
+		{name: "swapped digits", iban: "GB29NWBK60161331928619", expectedErr: bankvalidation.ErrInvalidIBANChecksum},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := bankvalidation.ValidateIBAN(test.iban)
+			if test.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, test.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package bankvalidation
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrInvalidAccountNumber = errors.New("invalid account number")
+	ErrInvalidRoutingCode   = errors.New("invalid routing code")
+)
+
+// ValidateAccountNumber checks the length of the local account number in the
+// US, the UK and Canada. It only checks that the account number is
+// alphanumeric for the other countries. Spaces and hyphens are ignored.
+func ValidateAccountNumber(country string, accountNumber string) error {
+	accountNumber = normalize(accountNumber)
+	switch country {
+	case "US":
+		return validateDigits(accountNumber, 4, 17, ErrInvalidAccountNumber)
+	case "GB":
+		return validateDigits(accountNumber, 8, 8, ErrInvalidAccountNumber)
+	case "CA":
+		return validateDigits(accountNumber, 7, 12, ErrInvalidAccountNumber)
+	default:
+		if !isAlphanumeric(accountNumber) {
+			return fmt.Errorf("%w: must be alphanumeric", ErrInvalidAccountNumber)
+		}
+		return nil
+	}
+}
+
+// ValidateRoutingCode checks the routing code of the country: the ABA routing
+// number in the US, the sort code in the UK and the transit number in Canada.
+// Routing codes of the other countries are not checked.
+func ValidateRoutingCode(country string, routingCode string) error {
+	switch country {
+	case "US":
+		return ValidateABARoutingNumber(routingCode)
+	case "GB":
+		return ValidateSortCode(routingCode)
+	case "CA":
+		return ValidateCanadianTransitNumber(routingCode)
+	default:
+		return nil
+	}
+}
+
+// ValidateABARoutingNumber checks the Federal Reserve prefix and the 3-7-1
+// checksum of a US ABA routing number. Spaces and hyphens are ignored.
+func ValidateABARoutingNumber(routingNumber string) error {
+	routingNumber = normalize(routingNumber)
+	if len(routingNumber) != 9 || !isDigits(routingNumber) {
+		return fmt.Errorf("%w: ABA routing number must have 9 digits", ErrInvalidRoutingCode)
+	}
+
+	prefix := int(routingNumber[0]-'0')*10 + int(routingNumber[1]-'0')
+	switch {
+	case prefix <= 12, prefix >= 21 && prefix <= 32, prefix >= 61 && prefix <= 72, prefix == 80:
+	default:
+		return fmt.Errorf("%w: unknown ABA routing number prefix %02d", ErrInvalidRoutingCode, prefix)
+	}
+
+	weights := [3]int{3, 7, 1}
+	sum := 0
+	for i, c := range routingNumber {
+		sum += int(c-'0') * weights[i%3]
+	}
+
+	if sum%10 != 0 {
+		return fmt.Errorf("%w: invalid ABA routing number checksum", ErrInvalidRoutingCode)
+	}
+
+	return nil
+}
+
+// ValidateSortCode checks a UK sort code: 6 digits, optionally grouped by
+// pairs with hyphens or spaces.
+func ValidateSortCode(sortCode string) error {
+	sortCode = normalize(sortCode)
+	if len(sortCode) != 6 || !isDigits(sortCode) {
+		return fmt.Errorf("%w: sort code must have 6 digits", ErrInvalidRoutingCode)
+	}
+	return nil
+}
+
+// ValidateCanadianTransitNumber checks a Canadian routing number, either in
+// the paper format (5 digits branch transit number, optional hyphen, 3 digits
+// institution number) or in the electronic format (0, 3 digits institution
+// number, 5 digits branch transit number).
+func ValidateCanadianTransitNumber(transitNumber string) error {
+	normalized := normalize(transitNumber)
+	switch {
+	case len(normalized) == 8 && isDigits(normalized):
+	case len(normalized) == 9 && isDigits(normalized) && normalized[0] == '0':
+	default:
+		return fmt.Errorf("%w: transit number must be 5 digits branch and 3 digits institution, or 0 followed by 3 digits institution and 5 digits branch", ErrInvalidRoutingCode)
+	}
+	return nil
+}
+
+func validateDigits(v string, minLength int, maxLength int, err error) error {
+	if !isDigits(v) {
+		return fmt.Errorf("%w: must only contain digits", err)
+	}
+
+	if len(v) < minLength || len(v) > maxLength {
+		if minLength == maxLength {
+			return fmt.Errorf("%w: must have %d digits", err, minLength)
+		}
+		return fmt.Errorf("%w: must have between %d and %d digits", err, minLength, maxLength)
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package bankvalidation_test
+
+import (
+	"testing"
+
+	"github.com/formancehq/payments/pkg/domain/bankvalidation"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAccountNumber(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		country       string
+		accountNumber string
+		expectedErr   error
+	}{
+		{name: "US", country: "US", accountNumber: "123456789"},
+		{name: "US too short", country: "US", accountNumber: "123", expectedErr: bankvalidation.ErrInvalidAccountNumber},
+		{name: "US too long", country: "US", accountNumber: "123456789012345678", expectedErr: bankvalidation.ErrInvalidAccountNumber},
+		{name: "US letters", country: "US", accountNumber: "12345A789", expectedErr: bankvalidation.ErrInvalidAccountNumber},
+		{name: "GB", country: "GB", accountNumber: "31926819"},
+		{name: "GB 7 digits", country: "GB", accountNumber: "3192681", expectedErr: bankvalidation.ErrInvalidAccountNumber},
+		{name: "CA", country: "CA", accountNumber: "1234567"},
+		{name: "CA too long", country: "CA", accountNumber: "1234567890123", expectedErr: bankvalidation.ErrInvalidAccountNumber},
+		{name: "other country", country: "FR", accountNumber: "ABC123"},
+		{name: "no country", accountNumber: "ABC123"},
+		{name: "US grouped", country: "US", accountNumber: "1234-5678 9"},
+		{name: "GB grouped", country: "GB", accountNumber: "3192 6819"},
+		{name: "other country grouped", country: "FR", accountNumber: "ABC-123"},
+		{name: "other country not alphanumeric", country: "FR", accountNumber: "ABC/123", expectedErr: bankvalidation.ErrInvalidAccountNumber},
+		{name: "empty", country: "FR", accountNumber: "", expectedErr: bankvalidation.ErrInvalidAccountNumber},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := bankvalidation.ValidateAccountNumber(test.country, test.accountNumber)
+			if test.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, test.expectedErr)
+			}
+		})
+	}
+}
+
+func TestValidateRoutingCode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		country     string
+		routingCode string
+		expectedErr error
+	}{
+		{name: "ABA routing number", country: "US", routingCode: "021000021"},
+		{name: "ABA routing number of a thrift institution", country: "US", routingCode: "211370545"},
+		{name: "ABA routing number of an electronic transaction", country: "US", routingCode: "121000358"},
+		{name: "ABA routing number checksum", country: "US", routingCode: "021000022", expectedErr: bankvalidation.ErrInvalidRoutingCode},
+		{name: "ABA routing number prefix", country: "US", routingCode: "131000015", expectedErr: bankvalidation.ErrInvalidRoutingCode},
+		{name: "ABA routing number length", country: "US", routingCode: "02100002", expectedErr: bankvalidation.ErrInvalidRoutingCode},
+		{name: "ABA routing number letters", country: "US", routingCode: "02100002A", expectedErr: bankvalidation.ErrInvalidRoutingCode},
+		{name: "sort code", country: "GB", routingCode: "601613"},
+		{name: "sort code with hyphens", country: "GB", routingCode: "60-16-13"},
+		{name: "sort code with spaces", country: "GB", routingCode: "60 16 13"},
+		{name: "sort code too short", country: "GB", routingCode: "60-16-1", expectedErr: bankvalidation.ErrInvalidRoutingCode},
+		{name: "sort code letters", country: "GB", routingCode: "60-16-1A", expectedErr: bankvalidation.ErrInvalidRoutingCode},
+		{name: "transit number paper format", country: "CA", routingCode: "12345-003"},
+		{name: "transit number without hyphen", country: "CA", routingCode: "12345003"},
+		{name: "transit number electronic format", country: "CA", routingCode: "000312345"},
+		{name: "transit number electronic format without leading zero", country: "CA", routingCode: "100312345", expectedErr: bankvalidation.ErrInvalidRoutingCode},
+		{name: "transit number too short", country: "CA", routingCode: "1234-003", expectedErr: bankvalidation.ErrInvalidRoutingCode},
+		{name: "other country is not checked", country: "FR", routingCode: "anything"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := bankvalidation.ValidateRoutingCode(test.country, test.routingCode)
+			if test.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, test.expectedErr)
+			}
+		})
+	}
+}
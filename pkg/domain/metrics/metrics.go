@@ -32,11 +32,17 @@ func GetMetricsRegistry() MetricsRegistry {
 type MetricsRegistry interface {
 	ConnectorPSPCalls() metric.Int64Counter
 	ConnectorPSPCallLatencies() metric.Int64Histogram
+	ConnectorRateBudgetCalls() metric.Int64Counter
+	ConnectorRateBudgetTokens() metric.Float64Gauge
+	ConnectorRateBudgetDailyUsage() metric.Int64Gauge
 }
 
 type metricsRegistry struct {
-	connectorPSPCalls         metric.Int64Counter
-	connectorPSPCallLatencies metric.Int64Histogram
+	connectorPSPCalls             metric.Int64Counter
+	connectorPSPCallLatencies     metric.Int64Histogram
+	connectorRateBudgetCalls      metric.Int64Counter
+	connectorRateBudgetTokens     metric.Float64Gauge
+	connectorRateBudgetDailyUsage metric.Int64Gauge
 }
 
 func RegisterMetricsRegistry(meterProvider metric.MeterProvider) (MetricsRegistry, error) {
@@ -60,9 +66,39 @@ func RegisterMetricsRegistry(meterProvider metric.MeterProvider) (MetricsRegistr
 		return nil, err
 	}
 
+	connectorRateBudgetCalls, err := meter.Int64Counter(
+		"payments_connectors_rate_budget_calls",
+		metric.WithUnit("1"),
+		metric.WithDescription("payments connectors plugin calls granted or throttled by their rate budget"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	connectorRateBudgetTokens, err := meter.Float64Gauge(
+		"payments_connectors_rate_budget_tokens",
+		metric.WithUnit("1"),
+		metric.WithDescription("payments connectors plugin calls left in their rate budget"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	connectorRateBudgetDailyUsage, err := meter.Int64Gauge(
+		"payments_connectors_rate_budget_daily_usage",
+		metric.WithUnit("1"),
+		metric.WithDescription("payments connectors plugin calls taken from their daily quota"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	currentRegistry := &metricsRegistry{
-		connectorPSPCalls:         connectorPSPCalls,
-		connectorPSPCallLatencies: connectorPSPCallLatencies,
+		connectorPSPCalls:             connectorPSPCalls,
+		connectorPSPCallLatencies:     connectorPSPCallLatencies,
+		connectorRateBudgetCalls:      connectorRateBudgetCalls,
+		connectorRateBudgetTokens:     connectorRateBudgetTokens,
+		connectorRateBudgetDailyUsage: connectorRateBudgetDailyUsage,
 	}
 
 	registryMu.Lock()
@@ -80,6 +116,18 @@ func (m *metricsRegistry) ConnectorPSPCallLatencies() metric.Int64Histogram {
 	return m.connectorPSPCallLatencies
 }
 
+func (m *metricsRegistry) ConnectorRateBudgetCalls() metric.Int64Counter {
+	return m.connectorRateBudgetCalls
+}
+
+func (m *metricsRegistry) ConnectorRateBudgetTokens() metric.Float64Gauge {
+	return m.connectorRateBudgetTokens
+}
+
+func (m *metricsRegistry) ConnectorRateBudgetDailyUsage() metric.Int64Gauge {
+	return m.connectorRateBudgetDailyUsage
+}
+
 type NoopMetricsRegistry struct{}
 
 func NewNoOpMetricsRegistry() *NoopMetricsRegistry {
@@ -96,6 +144,21 @@ func (m *NoopMetricsRegistry) ConnectorPSPCallLatencies() metric.Int64Histogram
 	return histogram
 }
 
+func (m *NoopMetricsRegistry) ConnectorRateBudgetCalls() metric.Int64Counter {
+	counter, _ := noop.NewMeterProvider().Meter("payments").Int64Counter("payments_connectors_rate_budget_calls")
+	return counter
+}
+
+func (m *NoopMetricsRegistry) ConnectorRateBudgetTokens() metric.Float64Gauge {
+	gauge, _ := noop.NewMeterProvider().Meter("payments").Float64Gauge("payments_connectors_rate_budget_tokens")
+	return gauge
+}
+
+func (m *NoopMetricsRegistry) ConnectorRateBudgetDailyUsage() metric.Int64Gauge {
+	gauge, _ := noop.NewMeterProvider().Meter("payments").Int64Gauge("payments_connectors_rate_budget_daily_usage")
+	return gauge
+}
+
 var (
 	_ MetricsRegistry = (*metricsRegistry)(nil)
 	_ MetricsRegistry = (*NoopMetricsRegistry)(nil)
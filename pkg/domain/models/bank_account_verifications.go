@@ -0,0 +1,149 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BankAccountVerificationResult is the outcome of a bank account ownership
+// verification (name check / confirmation of payee) performed by a PSP.
+type BankAccountVerificationResult int
+
+const (
+	BANK_ACCOUNT_VERIFICATION_RESULT_UNKNOWN BankAccountVerificationResult = iota
+	// The name given matches the name of the account holder.
+	BANK_ACCOUNT_VERIFICATION_RESULT_MATCH
+	// The name given is close to the name of the account holder, the PSP
+	// usually returns the actual name of the holder in that case.
+	BANK_ACCOUNT_VERIFICATION_RESULT_CLOSE_MATCH
+	// The name given does not match the name of the account holder.
+	BANK_ACCOUNT_VERIFICATION_RESULT_NO_MATCH
+)
+
+func (r BankAccountVerificationResult) String() string {
+	switch r {
+	case BANK_ACCOUNT_VERIFICATION_RESULT_MATCH:
+		return "MATCH"
+	case BANK_ACCOUNT_VERIFICATION_RESULT_CLOSE_MATCH:
+		return "CLOSE_MATCH"
+	case BANK_ACCOUNT_VERIFICATION_RESULT_NO_MATCH:
+		return "NO_MATCH"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+func BankAccountVerificationResultFromString(s string) (BankAccountVerificationResult, error) {
+	switch s {
+	case "MATCH":
+		return BANK_ACCOUNT_VERIFICATION_RESULT_MATCH, nil
+	case "CLOSE_MATCH":
+		return BANK_ACCOUNT_VERIFICATION_RESULT_CLOSE_MATCH, nil
+	case "NO_MATCH":
+		return BANK_ACCOUNT_VERIFICATION_RESULT_NO_MATCH, nil
+	case "UNKNOWN":
+		return BANK_ACCOUNT_VERIFICATION_RESULT_UNKNOWN, nil
+	default:
+		return BANK_ACCOUNT_VERIFICATION_RESULT_UNKNOWN, fmt.Errorf("unknown bank account verification result: %s", s)
+	}
+}
+
+func (r BankAccountVerificationResult) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.String())
+}
+
+func (r *BankAccountVerificationResult) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	var err error
+	*r, err = BankAccountVerificationResultFromString(s)
+	return err
+}
+
+// Accepts returns true if the verification result is good enough according
+// to the minimum result required: a MATCH is always accepted, a CLOSE_MATCH
+// only when it is the minimum required.
+func (r BankAccountVerificationResult) Accepts(minimum BankAccountVerificationResult) bool {
+	switch r {
+	case BANK_ACCOUNT_VERIFICATION_RESULT_MATCH:
+		return true
+	case BANK_ACCOUNT_VERIFICATION_RESULT_CLOSE_MATCH:
+		return minimum == BANK_ACCOUNT_VERIFICATION_RESULT_CLOSE_MATCH
+	default:
+		return false
+	}
+}
+
+// BankAccountVerification is one ownership verification of a bank account
+// against a connector. Verifications are kept as a history on the bank
+// account, the latest one being the one used by the payout policy.
+type BankAccountVerification struct {
+	ID            uuid.UUID   `json:"id"`
+	BankAccountID uuid.UUID   `json:"bankAccountID"`
+	ConnectorID   ConnectorID `json:"connectorID"`
+	CreatedAt     time.Time   `json:"createdAt"`
+
+	// Name of the bank account holder sent to the PSP
+	Name   string                        `json:"name"`
+	Result BankAccountVerificationResult `json:"result"`
+	// Name of the holder known by the PSP, when it is returned (usually on a
+	// close match)
+	MatchedName *string `json:"matchedName"`
+}
+
+func (v BankAccountVerification) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		ID            uuid.UUID                     `json:"id"`
+		BankAccountID uuid.UUID                     `json:"bankAccountID"`
+		ConnectorID   string                        `json:"connectorID"`
+		CreatedAt     time.Time                     `json:"createdAt"`
+		Name          string                        `json:"name"`
+		Result        BankAccountVerificationResult `json:"result"`
+		MatchedName   *string                       `json:"matchedName"`
+	}{
+		ID:            v.ID,
+		BankAccountID: v.BankAccountID,
+		ConnectorID:   v.ConnectorID.String(),
+		CreatedAt:     v.CreatedAt,
+		Name:          v.Name,
+		Result:        v.Result,
+		MatchedName:   v.MatchedName,
+	})
+}
+
+func (v *BankAccountVerification) UnmarshalJSON(data []byte) error {
+	var aux struct {
+		ID            uuid.UUID                     `json:"id"`
+		BankAccountID uuid.UUID                     `json:"bankAccountID"`
+		ConnectorID   string                        `json:"connectorID"`
+		CreatedAt     time.Time                     `json:"createdAt"`
+		Name          string                        `json:"name"`
+		Result        BankAccountVerificationResult `json:"result"`
+		MatchedName   *string                       `json:"matchedName"`
+	}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	connectorID, err := ConnectorIDFromString(aux.ConnectorID)
+	if err != nil {
+		return err
+	}
+
+	v.ID = aux.ID
+	v.BankAccountID = aux.BankAccountID
+	v.ConnectorID = connectorID
+	v.CreatedAt = aux.CreatedAt
+	v.Name = aux.Name
+	v.Result = aux.Result
+	v.MatchedName = aux.MatchedName
+
+	return nil
+}
@@ -29,6 +29,11 @@ const (
 	AccountBankAccountNameMetadataKey    = bankAccountOwnerNamespace + "name"
 	AccountBankAccountCountryMetadataKey = bankAccountOwnerNamespace + "country"
 	AccountSwiftBicCodeMetadataKey       = bankAccountOwnerNamespace + "swiftBicCode"
+
+	// Local bank code of the bank accounts, checked according to their
+	// country: ABA routing number in the US, sort code in the UK and transit
+	// number in Canada.
+	BankAccountRoutingCodeMetadataKey = formanceMetadataSpecNamespace + "routingCode"
 )
 
 type BankAccount struct {
@@ -44,6 +49,9 @@ type BankAccount struct {
 	Metadata map[string]string `json:"metadata"`
 
 	RelatedAccounts []BankAccountRelatedAccount `json:"relatedAccounts"`
+
+	// History of the ownership verifications, most recent first
+	Verifications []BankAccountVerification `json:"verifications,omitempty"`
 }
 
 type bankAccountIK struct {
@@ -127,9 +135,47 @@ func FillBankAccountDetailsToAccountMetadata(account *Account, bankAccount *Bank
 		account.Metadata[AccountBankAccountCountryMetadataKey] = *bankAccount.Country
 	}
 
+	if routingCode := bankAccount.Metadata[BankAccountRoutingCodeMetadataKey]; routingCode != "" {
+		account.Metadata[BankAccountRoutingCodeMetadataKey] = routingCode
+	}
+
 	account.Metadata[AccountBankAccountNameMetadataKey] = bankAccount.Name
 }
 
+// BankAccountDetails are the bank details of an external account fetched from
+// a PSP.
+type BankAccountDetails struct {
+	Name          string
+	IBAN          string
+	AccountNumber string
+	SwiftBicCode  string
+	RoutingCode   string
+	Country       string
+}
+
+// FillBankAccountDetailsToPSPAccountMetadata stores the bank details of an
+// external account fetched from a PSP under the same metadata keys as the
+// bank accounts forwarded to the connectors, so that both are read the same
+// way, e.g. to deduplicate the counterparties. Empty details are left out.
+func FillBankAccountDetailsToPSPAccountMetadata(account *PSPAccount, details BankAccountDetails) {
+	if account.Metadata == nil {
+		account.Metadata = make(map[string]string)
+	}
+
+	for key, value := range map[string]string{
+		AccountBankAccountNameMetadataKey:    details.Name,
+		AccountIBANMetadataKey:               details.IBAN,
+		AccountAccountNumberMetadataKey:      details.AccountNumber,
+		AccountSwiftBicCodeMetadataKey:       details.SwiftBicCode,
+		BankAccountRoutingCodeMetadataKey:    details.RoutingCode,
+		AccountBankAccountCountryMetadataKey: details.Country,
+	} {
+		if value = strings.TrimSpace(value); value != "" {
+			account.Metadata[key] = value
+		}
+	}
+}
+
 func FillBankAccountMetadataWithPaymentServiceUserInfo(ba *BankAccount, psu *PaymentServiceUser) {
 	if psu.Address != nil {
 		var addressLine1 *string
@@ -127,6 +127,7 @@ func TestFillBankAccountDetailsToAccountMetadata(t *testing.T) {
 				models.BankAccountOwnerCityMetadataKey:         "Berlin",
 				models.BankAccountOwnerRegionMetadataKey:       "Berlin",
 				models.BankAccountOwnerPostalCodeMetadataKey:   "10115",
+				models.BankAccountRoutingCodeMetadataKey:       "37040044",
 			},
 		}
 
@@ -149,6 +150,7 @@ func TestFillBankAccountDetailsToAccountMetadata(t *testing.T) {
 		assert.Equal(t, iban, account.Metadata[models.AccountIBANMetadataKey])
 		assert.Equal(t, swiftBicCode, account.Metadata[models.AccountSwiftBicCodeMetadataKey])
 		assert.Equal(t, country, account.Metadata[models.AccountBankAccountCountryMetadataKey])
+		assert.Equal(t, "37040044", account.Metadata[models.BankAccountRoutingCodeMetadataKey])
 		assert.Equal(t, "Test Bank Account", account.Metadata[models.AccountBankAccountNameMetadataKey])
 	})
 
@@ -211,3 +213,25 @@ func TestFillBankAccountDetailsToAccountMetadata(t *testing.T) {
 		assert.False(t, hasCountry)
 	})
 }
+
+func TestFillBankAccountDetailsToPSPAccountMetadata(t *testing.T) {
+	t.Parallel()
+
+	account := &models.PSPAccount{
+		Reference: "ext",
+		Metadata:  map[string]string{"psp_key": "value"},
+	}
+
+	models.FillBankAccountDetailsToPSPAccountMetadata(account, models.BankAccountDetails{
+		Name:          "Jane Doe",
+		AccountNumber: "123456789",
+		RoutingCode:   " 021000021 ",
+	})
+
+	assert.Equal(t, map[string]string{
+		"psp_key":                                "value",
+		models.AccountBankAccountNameMetadataKey: "Jane Doe",
+		models.AccountAccountNumberMetadataKey:   "123456789",
+		models.BankAccountRoutingCodeMetadataKey: "021000021",
+	}, account.Metadata)
+}
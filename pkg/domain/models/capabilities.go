@@ -20,6 +20,7 @@ const (
 	CAPABILITY_FETCH_OTHERS
 	CAPABILITY_FETCH_ORDERS
 	CAPABILITY_FETCH_CONVERSIONS
+	CAPABILITY_FETCH_DISPUTES
 
 	// Webhooks capabilities indicates that the connector can create, manage and
 	// receive webhooks from the connector
@@ -39,6 +40,14 @@ const (
 	// still want us to record the accounts and payments.
 	CAPABILITY_ALLOW_FORMANCE_ACCOUNT_CREATION
 	CAPABILITY_ALLOW_FORMANCE_PAYMENT_CREATION
+
+	// Verification capabilities indicates that the connector can check the
+	// ownership of a bank account against the PSP (e.g. confirmation of payee)
+	CAPABILITY_VERIFY_BANK_ACCOUNT
+
+	// Account creation capability indicates that the connector can issue new
+	// accounts on the PSP (e.g. virtual accounts / named IBANs)
+	CAPABILITY_CREATE_ACCOUNT
 )
 
 func (t Capability) String() string {
@@ -57,6 +66,8 @@ func (t Capability) String() string {
 		return "FETCH_ORDERS"
 	case CAPABILITY_FETCH_CONVERSIONS:
 		return "FETCH_CONVERSIONS"
+	case CAPABILITY_FETCH_DISPUTES:
+		return "FETCH_DISPUTES"
 	case CAPABILITY_CREATE_WEBHOOKS:
 		return "CREATE_WEBHOOKS"
 	case CAPABILITY_TRANSLATE_WEBHOOKS:
@@ -74,11 +85,23 @@ func (t Capability) String() string {
 	case CAPABILITY_ALLOW_FORMANCE_PAYMENT_CREATION:
 		return "ALLOW_FORMANCE_PAYMENT_CREATION"
 
+	case CAPABILITY_VERIFY_BANK_ACCOUNT:
+		return "VERIFY_BANK_ACCOUNT"
+
+	case CAPABILITY_CREATE_ACCOUNT:
+		return "CREATE_ACCOUNT"
+
 	default:
 		return "UNKNOWN"
 	}
 }
 
+// IsFetch reports whether the capability is backed by a polling schedule
+// fetching data from the connector.
+func (t Capability) IsFetch() bool {
+	return t >= CAPABILITY_FETCH_ACCOUNTS && t <= CAPABILITY_FETCH_DISPUTES
+}
+
 func (t Capability) Value() (driver.Value, error) {
 	res := t.String()
 	if res == "UNKNOWN" {
@@ -136,6 +159,8 @@ func (t *Capability) Scan(value interface{}) error {
 		*t = CAPABILITY_FETCH_ORDERS
 	case "FETCH_CONVERSIONS":
 		*t = CAPABILITY_FETCH_CONVERSIONS
+	case "FETCH_DISPUTES":
+		*t = CAPABILITY_FETCH_DISPUTES
 	case "CREATE_WEBHOOKS":
 		*t = CAPABILITY_CREATE_WEBHOOKS
 	case "TRANSLATE_WEBHOOKS":
@@ -153,6 +178,12 @@ func (t *Capability) Scan(value interface{}) error {
 	case "ALLOW_FORMANCE_PAYMENT_CREATION":
 		*t = CAPABILITY_ALLOW_FORMANCE_PAYMENT_CREATION
 
+	case "VERIFY_BANK_ACCOUNT":
+		*t = CAPABILITY_VERIFY_BANK_ACCOUNT
+
+	case "CREATE_ACCOUNT":
+		*t = CAPABILITY_CREATE_ACCOUNT
+
 	default:
 		return fmt.Errorf("unknown capability")
 	}
@@ -28,6 +28,8 @@ func TestCapabilityString(t *testing.T) {
 		{models.CAPABILITY_CREATE_PAYOUT, "CREATE_PAYOUT"},
 		{models.CAPABILITY_ALLOW_FORMANCE_ACCOUNT_CREATION, "ALLOW_FORMANCE_ACCOUNT_CREATION"},
 		{models.CAPABILITY_ALLOW_FORMANCE_PAYMENT_CREATION, "ALLOW_FORMANCE_PAYMENT_CREATION"},
+		{models.CAPABILITY_VERIFY_BANK_ACCOUNT, "VERIFY_BANK_ACCOUNT"},
+		{models.CAPABILITY_CREATE_ACCOUNT, "CREATE_ACCOUNT"},
 		{models.CAPABILITY_FETCH_UNKNOWN, "UNKNOWN"},
 		{models.Capability(999), "UNKNOWN"}, // Unknown capability
 	}
@@ -37,6 +39,16 @@ func TestCapabilityString(t *testing.T) {
 	}
 }
 
+func TestCapabilityIsFetch(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, models.CAPABILITY_FETCH_UNKNOWN.IsFetch())
+	assert.True(t, models.CAPABILITY_FETCH_ACCOUNTS.IsFetch())
+	assert.True(t, models.CAPABILITY_FETCH_DISPUTES.IsFetch())
+	assert.False(t, models.CAPABILITY_CREATE_WEBHOOKS.IsFetch())
+	assert.False(t, models.CAPABILITY_CREATE_PAYOUT.IsFetch())
+}
+
 func TestCapabilityValue(t *testing.T) {
 	t.Parallel()
 
@@ -60,6 +72,8 @@ func TestCapabilityValue(t *testing.T) {
 			{models.CAPABILITY_CREATE_PAYOUT, "CREATE_PAYOUT"},
 			{models.CAPABILITY_ALLOW_FORMANCE_ACCOUNT_CREATION, "ALLOW_FORMANCE_ACCOUNT_CREATION"},
 			{models.CAPABILITY_ALLOW_FORMANCE_PAYMENT_CREATION, "ALLOW_FORMANCE_PAYMENT_CREATION"},
+			{models.CAPABILITY_VERIFY_BANK_ACCOUNT, "VERIFY_BANK_ACCOUNT"},
+			{models.CAPABILITY_CREATE_ACCOUNT, "CREATE_ACCOUNT"},
 		}
 
 		for _, tc := range testCases {
@@ -114,6 +128,8 @@ func TestCapabilityScan(t *testing.T) {
 			{"CREATE_PAYOUT", models.CAPABILITY_CREATE_PAYOUT},
 			{"ALLOW_FORMANCE_ACCOUNT_CREATION", models.CAPABILITY_ALLOW_FORMANCE_ACCOUNT_CREATION},
 			{"ALLOW_FORMANCE_PAYMENT_CREATION", models.CAPABILITY_ALLOW_FORMANCE_PAYMENT_CREATION},
+			{"VERIFY_BANK_ACCOUNT", models.CAPABILITY_VERIFY_BANK_ACCOUNT},
+			{"CREATE_ACCOUNT", models.CAPABILITY_CREATE_ACCOUNT},
 		}
 
 		for _, tc := range testCases {
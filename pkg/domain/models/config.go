@@ -2,6 +2,7 @@ package models
 
 import (
 	"encoding/json"
+	"fmt"
 	"time"
 )
 
@@ -14,22 +15,64 @@ type PluginInternalConfig interface{}
 type Config struct {
 	Name          string        `json:"name" validate:"required,gte=3,lte=500"`
 	PollingPeriod time.Duration `json:"pollingPeriod" validate:"required"`
+	// PollingPeriods overrides PollingPeriod for specific fetch capabilities
+	PollingPeriods map[Capability]time.Duration `json:"pollingPeriods,omitempty"`
+	// BlackoutWindows are the times of day during which the connector must not be polled
+	BlackoutWindows []BlackoutWindow `json:"blackoutWindows,omitempty"`
+	// Environment is a free-form label, e.g. sandbox or production, which
+	// connectors can be filtered on
+	Environment string `json:"environment,omitempty" validate:"omitempty,lte=100"`
+}
+
+// PollingPeriodFor returns the polling period of the given capability,
+// falling back to PollingPeriod when it is not overridden.
+func (c Config) PollingPeriodFor(capability Capability) time.Duration {
+	if pollingPeriod, ok := c.PollingPeriods[capability]; ok && pollingPeriod > 0 {
+		return pollingPeriod
+	}
+	return c.PollingPeriod
+}
+
+// SchedulePolicy returns what is needed to schedule the fetching of the given
+// capability.
+func (c Config) SchedulePolicy(capability Capability) SchedulePolicy {
+	return SchedulePolicy{
+		PollingPeriod:   c.PollingPeriodFor(capability),
+		BlackoutWindows: c.BlackoutWindows,
+	}
 }
 
 func (c Config) MarshalJSON() ([]byte, error) {
+	var pollingPeriods map[string]string
+	if len(c.PollingPeriods) > 0 {
+		pollingPeriods = make(map[string]string, len(c.PollingPeriods))
+		for capability, pollingPeriod := range c.PollingPeriods {
+			pollingPeriods[capability.String()] = pollingPeriod.String()
+		}
+	}
+
 	return json.Marshal(struct {
-		Name          string `json:"name"`
-		PollingPeriod string `json:"pollingPeriod"`
+		Name            string            `json:"name"`
+		PollingPeriod   string            `json:"pollingPeriod"`
+		PollingPeriods  map[string]string `json:"pollingPeriods,omitempty"`
+		BlackoutWindows []BlackoutWindow  `json:"blackoutWindows,omitempty"`
+		Environment     string            `json:"environment,omitempty"`
 	}{
-		Name:          c.Name,
-		PollingPeriod: c.PollingPeriod.String(),
+		Name:            c.Name,
+		PollingPeriod:   c.PollingPeriod.String(),
+		PollingPeriods:  pollingPeriods,
+		BlackoutWindows: c.BlackoutWindows,
+		Environment:     c.Environment,
 	})
 }
 
 func (c *Config) UnmarshalJSON(data []byte) error {
 	var raw struct {
-		Name          string `json:"name"`
-		PollingPeriod string `json:"pollingPeriod"`
+		Name            string            `json:"name"`
+		PollingPeriod   string            `json:"pollingPeriod"`
+		PollingPeriods  map[string]string `json:"pollingPeriods"`
+		BlackoutWindows []BlackoutWindow  `json:"blackoutWindows"`
+		Environment     string            `json:"environment"`
 	}
 
 	if err := json.Unmarshal(data, &raw); err != nil {
@@ -45,11 +88,84 @@ func (c *Config) UnmarshalJSON(data []byte) error {
 		pollingPeriod = p
 	}
 
+	var pollingPeriods map[Capability]time.Duration
+	if len(raw.PollingPeriods) > 0 {
+		pollingPeriods = make(map[Capability]time.Duration, len(raw.PollingPeriods))
+		for rawCapability, rawPollingPeriod := range raw.PollingPeriods {
+			var capability Capability
+			if err := capability.Scan(rawCapability); err != nil {
+				return fmt.Errorf("invalid polling period capability %q: %w", rawCapability, err)
+			}
+
+			p, err := time.ParseDuration(rawPollingPeriod)
+			if err != nil {
+				return err
+			}
+			pollingPeriods[capability] = p
+		}
+	}
+
 	c.Name = raw.Name
 
 	if pollingPeriod > 0 {
 		c.PollingPeriod = pollingPeriod
 	}
 
+	c.PollingPeriods = pollingPeriods
+	c.BlackoutWindows = raw.BlackoutWindows
+	c.Environment = raw.Environment
+
 	return nil
 }
+
+// SchedulePolicy drives the schedule of a single fetch capability. It only
+// holds non-secret values so it can safely be recorded in workflow history.
+type SchedulePolicy struct {
+	PollingPeriod   time.Duration    `json:"pollingPeriod"`
+	BlackoutWindows []BlackoutWindow `json:"blackoutWindows,omitempty"`
+}
+
+// BlackoutWindow is a daily range of time, in UTC and formatted as HH:MM,
+// during which a connector must not be polled. The end is excluded, and a
+// window ending before it starts spans midnight.
+type BlackoutWindow struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+func (w BlackoutWindow) Validate() error {
+	start, end, err := w.Minutes()
+	if err != nil {
+		return err
+	}
+
+	if start == end {
+		return fmt.Errorf("blackout window start and end must differ: %w", ErrValidation)
+	}
+
+	return nil
+}
+
+// Minutes returns the start and the end of the window as minutes since
+// midnight.
+func (w BlackoutWindow) Minutes() (int, int, error) {
+	start, err := parseTimeOfDay(w.Start)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid blackout window start %q: %w", w.Start, ErrValidation)
+	}
+
+	end, err := parseTimeOfDay(w.End)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid blackout window end %q: %w", w.End, ErrValidation)
+	}
+
+	return start, end, nil
+}
+
+func parseTimeOfDay(value string) (int, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
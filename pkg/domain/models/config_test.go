@@ -103,3 +103,93 @@ func TestConfigUnmarshalJSON(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestConfigSchedulePolicyJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("round trip", func(t *testing.T) {
+		t.Parallel()
+		// Given
+
+		jsonData := `{
+			"name": "test-config",
+			"pollingPeriod": "5m",
+			"pollingPeriods": {"FETCH_EXTERNAL_ACCOUNTS": "24h"},
+			"blackoutWindows": [{"start": "23:30", "end": "01:00"}],
+			"environment": "sandbox"
+		}`
+
+		var config models.Config
+		err := json.Unmarshal([]byte(jsonData), &config)
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, "sandbox", config.Environment)
+
+		assert.Equal(t, 24*time.Hour, config.PollingPeriodFor(models.CAPABILITY_FETCH_EXTERNAL_ACCOUNTS))
+		assert.Equal(t, 5*time.Minute, config.PollingPeriodFor(models.CAPABILITY_FETCH_BALANCES))
+		assert.Equal(t, []models.BlackoutWindow{{Start: "23:30", End: "01:00"}}, config.BlackoutWindows)
+
+		data, err := json.Marshal(config)
+		require.NoError(t, err)
+
+		var got models.Config
+		require.NoError(t, json.Unmarshal(data, &got))
+		assert.Equal(t, config, got)
+	})
+
+	t.Run("omitted when empty", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := json.Marshal(models.Config{Name: "test-config", PollingPeriod: time.Minute})
+
+		// Then
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name":"test-config","pollingPeriod":"1m0s"}`, string(data))
+	})
+
+	t.Run("unknown capability", func(t *testing.T) {
+		t.Parallel()
+		// Given
+
+		jsonData := `{
+			"name": "test-config",
+			"pollingPeriods": {"FETCH_UNICORNS": "1h"}
+		}`
+
+		var config models.Config
+		err := json.Unmarshal([]byte(jsonData), &config)
+
+		// Then
+		require.Error(t, err)
+	})
+}
+
+func TestBlackoutWindowValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		window  models.BlackoutWindow
+		wantErr bool
+	}{
+		{name: "same day", window: models.BlackoutWindow{Start: "02:00", End: "04:30"}},
+		{name: "spanning midnight", window: models.BlackoutWindow{Start: "23:00", End: "01:00"}},
+		{name: "invalid start", window: models.BlackoutWindow{Start: "25:00", End: "01:00"}, wantErr: true},
+		{name: "missing end", window: models.BlackoutWindow{Start: "01:00"}, wantErr: true},
+		{name: "empty window", window: models.BlackoutWindow{Start: "01:00", End: "01:00"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.window.Validate()
+			if tt.wantErr {
+				require.ErrorIs(t, err, models.ErrValidation)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
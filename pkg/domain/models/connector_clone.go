@@ -0,0 +1,59 @@
+package models
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+// ConnectorClone is the outcome of cloning a connector into a new one with
+// the same provider.
+type ConnectorClone struct {
+	ConnectorID ConnectorID
+	// Pools created for the new connector from the dynamic pools whose query
+	// targets the cloned connector.
+	PoolIDs []uuid.UUID
+}
+
+func (c ConnectorClone) MarshalJSON() ([]byte, error) {
+	poolIDs := make([]string, len(c.PoolIDs))
+	for i, id := range c.PoolIDs {
+		poolIDs[i] = id.String()
+	}
+
+	return json.Marshal(&struct {
+		ConnectorID string   `json:"connectorID"`
+		PoolIDs     []string `json:"poolIDs"`
+	}{
+		ConnectorID: c.ConnectorID.String(),
+		PoolIDs:     poolIDs,
+	})
+}
+
+func (c *ConnectorClone) UnmarshalJSON(data []byte) error {
+	var aux struct {
+		ConnectorID string   `json:"connectorID"`
+		PoolIDs     []string `json:"poolIDs"`
+	}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	connectorID, err := ConnectorIDFromString(aux.ConnectorID)
+	if err != nil {
+		return err
+	}
+
+	poolIDs := make([]uuid.UUID, len(aux.PoolIDs))
+	for i, id := range aux.PoolIDs {
+		poolIDs[i], err = uuid.Parse(id)
+		if err != nil {
+			return err
+		}
+	}
+
+	c.ConnectorID = connectorID
+	c.PoolIDs = poolIDs
+	return nil
+}
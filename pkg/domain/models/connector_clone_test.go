@@ -0,0 +1,32 @@
+package models_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectorCloneMarshalUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	clone := models.ConnectorClone{
+		ConnectorID: models.ConnectorID{Reference: uuid.New(), Provider: "stripe"},
+		PoolIDs:     []uuid.UUID{uuid.New()},
+	}
+
+	data, err := json.Marshal(clone)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"connectorID":"`+clone.ConnectorID.String()+`","poolIDs":["`+clone.PoolIDs[0].String()+`"]}`, string(data))
+
+	var unmarshalled models.ConnectorClone
+	require.NoError(t, json.Unmarshal(data, &unmarshalled))
+	assert.Equal(t, clone, unmarshalled)
+
+	data, err = json.Marshal(models.ConnectorClone{ConnectorID: clone.ConnectorID})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"connectorID":"`+clone.ConnectorID.String()+`","poolIDs":[]}`, string(data))
+}
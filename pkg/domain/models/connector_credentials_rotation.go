@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+)
+
+// ConnectorCredentialsRotation records the rotation of a connector's
+// credentials. It only lists the names of the rotated config fields, never
+// their values.
+type ConnectorCredentialsRotation struct {
+	ConnectorID ConnectorID `json:"connectorID"`
+	Fields      []string    `json:"fields"`
+	RotatedAt   time.Time   `json:"rotatedAt"`
+}
+
+func (r *ConnectorCredentialsRotation) IdempotencyKey() string {
+	return IdempotencyKey(struct {
+		ConnectorID ConnectorID `json:"ConnectorID"`
+		RotatedAt   time.Time   `json:"RotatedAt"`
+	}{r.ConnectorID, r.RotatedAt})
+}
@@ -0,0 +1,200 @@
+package models
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+)
+
+type ConnectorHealthStatus string
+
+const (
+	// Every fetch capability ran successfully on its last run.
+	CONNECTOR_HEALTH_STATUS_HEALTHY ConnectorHealthStatus = "HEALTHY"
+	// At least one fetch capability is failing but is still scheduled.
+	CONNECTOR_HEALTH_STATUS_DEGRADED ConnectorHealthStatus = "DEGRADED"
+	// At least one fetch capability is failing and its schedules were paused,
+	// its data is not refreshed anymore.
+	CONNECTOR_HEALTH_STATUS_UNHEALTHY ConnectorHealthStatus = "UNHEALTHY"
+)
+
+// ScheduleHealth summarizes the workflow instances of a schedule.
+type ScheduleHealth struct {
+	ScheduleID          string
+	PausedAt            *time.Time
+	PausedReason        *string
+	LastSuccessAt       *time.Time
+	LastErrorAt         *time.Time
+	LastError           *string
+	ConsecutiveFailures int
+}
+
+// CapabilityHealth aggregates the health of all the schedules of a fetch
+// capability: a capability may have one schedule per parent object (e.g. one
+// balances schedule per account).
+type CapabilityHealth struct {
+	Capability    Capability
+	LastSuccessAt *time.Time
+	LastErrorAt   *time.Time
+	LastError     *string
+	// Highest number of consecutive failures among the capability schedules.
+	ConsecutiveFailures int
+	// Number of the capability schedules currently paused.
+	PausedSchedules int
+	PausedReason    *string
+}
+
+type ConnectorHealth struct {
+	ConnectorID  ConnectorID
+	Status       ConnectorHealthStatus
+	Capabilities []CapabilityHealth
+	RecentErrors []Instance
+}
+
+var healthCapabilities = []Capability{
+	CAPABILITY_FETCH_ACCOUNTS,
+	CAPABILITY_FETCH_BALANCES,
+	CAPABILITY_FETCH_EXTERNAL_ACCOUNTS,
+	CAPABILITY_FETCH_PAYMENTS,
+	CAPABILITY_FETCH_OTHERS,
+	CAPABILITY_FETCH_ORDERS,
+	CAPABILITY_FETCH_CONVERSIONS,
+	CAPABILITY_FETCH_DISPUTES,
+}
+
+// NewConnectorHealth aggregates the health of the connector fetch schedules by
+// capability. Schedules which are not fetching data are ignored.
+func NewConnectorHealth(connectorID ConnectorID, schedules []ScheduleHealth, recentErrors []Instance) ConnectorHealth {
+	byCapability := make(map[Capability]*CapabilityHealth)
+	for _, schedule := range schedules {
+		capability, ok := capabilityFromScheduleID(connectorID, schedule.ScheduleID)
+		if !ok {
+			continue
+		}
+
+		health, ok := byCapability[capability]
+		if !ok {
+			health = &CapabilityHealth{Capability: capability}
+			byCapability[capability] = health
+		}
+
+		if schedule.LastSuccessAt != nil && (health.LastSuccessAt == nil || schedule.LastSuccessAt.After(*health.LastSuccessAt)) {
+			health.LastSuccessAt = schedule.LastSuccessAt
+		}
+		if schedule.LastErrorAt != nil && (health.LastErrorAt == nil || schedule.LastErrorAt.After(*health.LastErrorAt)) {
+			health.LastErrorAt = schedule.LastErrorAt
+			health.LastError = schedule.LastError
+		}
+		if schedule.ConsecutiveFailures > health.ConsecutiveFailures {
+			health.ConsecutiveFailures = schedule.ConsecutiveFailures
+		}
+		if schedule.PausedAt != nil {
+			health.PausedSchedules++
+			if health.PausedReason == nil {
+				health.PausedReason = schedule.PausedReason
+			}
+		}
+	}
+
+	res := ConnectorHealth{
+		ConnectorID:  connectorID,
+		Status:       CONNECTOR_HEALTH_STATUS_HEALTHY,
+		Capabilities: make([]CapabilityHealth, 0, len(byCapability)),
+		RecentErrors: recentErrors,
+	}
+	for _, health := range byCapability {
+		res.Capabilities = append(res.Capabilities, *health)
+
+		switch {
+		case health.ConsecutiveFailures == 0:
+		case health.PausedSchedules > 0:
+			res.Status = CONNECTOR_HEALTH_STATUS_UNHEALTHY
+		case res.Status == CONNECTOR_HEALTH_STATUS_HEALTHY:
+			res.Status = CONNECTOR_HEALTH_STATUS_DEGRADED
+		}
+	}
+	sort.Slice(res.Capabilities, func(i, j int) bool {
+		return res.Capabilities[i].Capability < res.Capabilities[j].Capability
+	})
+
+	return res
+}
+
+// capabilityFromScheduleID extracts the capability of a fetch schedule, whose
+// ID is formatted as <stack>-<connectorID>-<capability>[-<parentID>].
+func capabilityFromScheduleID(connectorID ConnectorID, scheduleID string) (Capability, bool) {
+	prefix := connectorID.String() + "-"
+	idx := strings.Index(scheduleID, prefix)
+	if idx < 0 {
+		return 0, false
+	}
+
+	name, _, _ := strings.Cut(scheduleID[idx+len(prefix):], "-")
+	for _, capability := range healthCapabilities {
+		if capability.String() == name {
+			return capability, true
+		}
+	}
+	return 0, false
+}
+
+func (h ConnectorHealth) MarshalJSON() ([]byte, error) {
+	type capabilityHealth struct {
+		Capability          Capability `json:"capability"`
+		LastSuccessAt       *time.Time `json:"lastSuccessAt,omitempty"`
+		LastErrorAt         *time.Time `json:"lastErrorAt,omitempty"`
+		LastError           *string    `json:"lastError,omitempty"`
+		ConsecutiveFailures int        `json:"consecutiveFailures"`
+		Paused              bool       `json:"paused"`
+		PausedSchedules     int        `json:"pausedSchedules"`
+		PausedReason        *string    `json:"pausedReason,omitempty"`
+	}
+
+	capabilities := make([]capabilityHealth, 0, len(h.Capabilities))
+	for _, c := range h.Capabilities {
+		capabilities = append(capabilities, capabilityHealth{
+			Capability:          c.Capability,
+			LastSuccessAt:       c.LastSuccessAt,
+			LastErrorAt:         c.LastErrorAt,
+			LastError:           c.LastError,
+			ConsecutiveFailures: c.ConsecutiveFailures,
+			Paused:              c.PausedSchedules > 0,
+			PausedSchedules:     c.PausedSchedules,
+			PausedReason:        c.PausedReason,
+		})
+	}
+
+	recentErrors := h.RecentErrors
+	if recentErrors == nil {
+		recentErrors = []Instance{}
+	}
+
+	return json.Marshal(&struct {
+		ConnectorID  string                `json:"connectorID"`
+		Status       ConnectorHealthStatus `json:"status"`
+		Capabilities []capabilityHealth    `json:"capabilities"`
+		RecentErrors []Instance            `json:"recentErrors"`
+	}{
+		ConnectorID:  h.ConnectorID.String(),
+		Status:       h.Status,
+		Capabilities: capabilities,
+		RecentErrors: recentErrors,
+	})
+}
+
+// ConnectorHealthChange records a change of the connector health status.
+type ConnectorHealthChange struct {
+	ConnectorID    ConnectorID
+	PreviousStatus ConnectorHealthStatus
+	Status         ConnectorHealthStatus
+	ChangedAt      time.Time
+}
+
+func (c *ConnectorHealthChange) IdempotencyKey() string {
+	return IdempotencyKey(struct {
+		ConnectorID ConnectorID           `json:"ConnectorID"`
+		Status      ConnectorHealthStatus `json:"Status"`
+		ChangedAt   time.Time             `json:"ChangedAt"`
+	}{c.ConnectorID, c.Status, c.ChangedAt})
+}
@@ -0,0 +1,105 @@
+package models_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewConnectorHealth(t *testing.T) {
+	t.Parallel()
+
+	connectorID := models.ConnectorID{Reference: uuid.New(), Provider: "stripe"}
+	scheduleID := func(suffix string) string {
+		return fmt.Sprintf("stack-%s-%s", connectorID.String(), suffix)
+	}
+	now := time.Now().UTC()
+	earlier := now.Add(-time.Hour)
+	errMsg := "error"
+	reason := "paused"
+
+	t.Run("no schedules", func(t *testing.T) {
+		t.Parallel()
+
+		health := models.NewConnectorHealth(connectorID, nil, nil)
+		assert.Equal(t, models.CONNECTOR_HEALTH_STATUS_HEALTHY, health.Status)
+		assert.Empty(t, health.Capabilities)
+	})
+
+	t.Run("aggregates schedules by capability", func(t *testing.T) {
+		t.Parallel()
+
+		health := models.NewConnectorHealth(connectorID, []models.ScheduleHealth{
+			{ScheduleID: scheduleID("FETCH_ACCOUNTS"), LastSuccessAt: &now},
+			{ScheduleID: scheduleID("FETCH_BALANCES-account1"), LastSuccessAt: &earlier, LastErrorAt: &now, LastError: &errMsg, ConsecutiveFailures: 2},
+			{ScheduleID: scheduleID("FETCH_BALANCES-account2"), LastSuccessAt: &now, LastErrorAt: &earlier, ConsecutiveFailures: 0},
+			{ScheduleID: scheduleID("unknown")},
+			{ScheduleID: "another-schedule"},
+		}, nil)
+
+		assert.Equal(t, models.CONNECTOR_HEALTH_STATUS_DEGRADED, health.Status)
+		require.Len(t, health.Capabilities, 2)
+
+		accounts := health.Capabilities[0]
+		assert.Equal(t, models.CAPABILITY_FETCH_ACCOUNTS, accounts.Capability)
+		assert.Zero(t, accounts.ConsecutiveFailures)
+
+		balances := health.Capabilities[1]
+		assert.Equal(t, models.CAPABILITY_FETCH_BALANCES, balances.Capability)
+		assert.Equal(t, now, *balances.LastSuccessAt)
+		assert.Equal(t, now, *balances.LastErrorAt)
+		assert.Equal(t, errMsg, *balances.LastError)
+		assert.Equal(t, 2, balances.ConsecutiveFailures)
+	})
+
+	t.Run("failing paused capability", func(t *testing.T) {
+		t.Parallel()
+
+		health := models.NewConnectorHealth(connectorID, []models.ScheduleHealth{
+			{ScheduleID: scheduleID("FETCH_ACCOUNTS"), ConsecutiveFailures: 1},
+			{ScheduleID: scheduleID("FETCH_PAYMENTS"), ConsecutiveFailures: 10, PausedAt: &now, PausedReason: &reason},
+		}, nil)
+
+		assert.Equal(t, models.CONNECTOR_HEALTH_STATUS_UNHEALTHY, health.Status)
+		assert.Equal(t, 1, health.Capabilities[1].PausedSchedules)
+		assert.Equal(t, reason, *health.Capabilities[1].PausedReason)
+	})
+
+	t.Run("manually paused capability", func(t *testing.T) {
+		t.Parallel()
+
+		health := models.NewConnectorHealth(connectorID, []models.ScheduleHealth{
+			{ScheduleID: scheduleID("FETCH_PAYMENTS"), PausedAt: &now, PausedReason: &reason},
+		}, nil)
+
+		assert.Equal(t, models.CONNECTOR_HEALTH_STATUS_HEALTHY, health.Status)
+	})
+}
+
+func TestConnectorHealthMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	connectorID := models.ConnectorID{Reference: uuid.New(), Provider: "stripe"}
+	health := models.ConnectorHealth{
+		ConnectorID: connectorID,
+		Status:      models.CONNECTOR_HEALTH_STATUS_DEGRADED,
+		Capabilities: []models.CapabilityHealth{
+			{Capability: models.CAPABILITY_FETCH_PAYMENTS, ConsecutiveFailures: 3, PausedSchedules: 1},
+		},
+	}
+
+	data, err := json.Marshal(health)
+	require.NoError(t, err)
+	assert.JSONEq(t, fmt.Sprintf(`{
+		"connectorID": %q,
+		"status": "DEGRADED",
+		"capabilities": [{"capability": "FETCH_PAYMENTS", "consecutiveFailures": 3, "paused": true, "pausedSchedules": 1}],
+		"recentErrors": []
+	}`, connectorID.String()), string(data))
+}
@@ -0,0 +1,99 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type ConnectorTestCheckStatus string
+
+const (
+	CONNECTOR_TEST_CHECK_STATUS_PASSED  ConnectorTestCheckStatus = "PASSED"
+	CONNECTOR_TEST_CHECK_STATUS_FAILED  ConnectorTestCheckStatus = "FAILED"
+	CONNECTOR_TEST_CHECK_STATUS_SKIPPED ConnectorTestCheckStatus = "SKIPPED"
+)
+
+const (
+	// ConnectorTestCheckConfig validates the config against the plugin's
+	// schema, without reaching the PSP.
+	ConnectorTestCheckConfig = "config"
+	// ConnectorTestCheckConnection runs a minimal authenticated call against
+	// the PSP.
+	ConnectorTestCheckConnection = "connection"
+)
+
+type ConnectorTestErrorType string
+
+const (
+	CONNECTOR_TEST_ERROR_TYPE_INVALID_CONFIG ConnectorTestErrorType = "INVALID_CONFIG"
+	// The PSP rejected the call, most of the time because of wrong
+	// credentials.
+	CONNECTOR_TEST_ERROR_TYPE_CLIENT_ERROR   ConnectorTestErrorType = "CLIENT_ERROR"
+	CONNECTOR_TEST_ERROR_TYPE_RATE_LIMITED   ConnectorTestErrorType = "RATE_LIMITED"
+	CONNECTOR_TEST_ERROR_TYPE_TIMEOUT        ConnectorTestErrorType = "TIMEOUT"
+	CONNECTOR_TEST_ERROR_TYPE_UPSTREAM_ERROR ConnectorTestErrorType = "UPSTREAM_ERROR"
+)
+
+// ConnectorTestResult holds the diagnostics of a connector dry run. Checks
+// run in order and the ones following a failure are skipped.
+type ConnectorTestResult struct {
+	Provider string               `json:"provider"`
+	Success  bool                 `json:"success"`
+	Checks   []ConnectorTestCheck `json:"checks"`
+}
+
+type ConnectorTestCheck struct {
+	Name     string                   `json:"name"`
+	Status   ConnectorTestCheckStatus `json:"status"`
+	Duration time.Duration            `json:"duration"`
+	// Only set when the check failed
+	ErrorType ConnectorTestErrorType `json:"errorType,omitempty"`
+	Error     string                 `json:"error,omitempty"`
+}
+
+func (c ConnectorTestCheck) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Name      string                   `json:"name"`
+		Status    ConnectorTestCheckStatus `json:"status"`
+		Duration  string                   `json:"duration"`
+		ErrorType ConnectorTestErrorType   `json:"errorType,omitempty"`
+		Error     string                   `json:"error,omitempty"`
+	}{
+		Name:      c.Name,
+		Status:    c.Status,
+		Duration:  c.Duration.String(),
+		ErrorType: c.ErrorType,
+		Error:     c.Error,
+	})
+}
+
+func (c *ConnectorTestCheck) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Name      string                   `json:"name"`
+		Status    ConnectorTestCheckStatus `json:"status"`
+		Duration  string                   `json:"duration"`
+		ErrorType ConnectorTestErrorType   `json:"errorType"`
+		Error     string                   `json:"error"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	var duration time.Duration
+	if raw.Duration != "" {
+		d, err := time.ParseDuration(raw.Duration)
+		if err != nil {
+			return err
+		}
+		duration = d
+	}
+
+	c.Name = raw.Name
+	c.Status = raw.Status
+	c.Duration = duration
+	c.ErrorType = raw.ErrorType
+	c.Error = raw.Error
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package models_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectorTestCheckMarshalUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	check := models.ConnectorTestCheck{
+		Name:      models.ConnectorTestCheckConnection,
+		Status:    models.CONNECTOR_TEST_CHECK_STATUS_FAILED,
+		Duration:  150 * time.Millisecond,
+		ErrorType: models.CONNECTOR_TEST_ERROR_TYPE_CLIENT_ERROR,
+		Error:     "unauthorized",
+	}
+
+	data, err := json.Marshal(check)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"connection","status":"FAILED","duration":"150ms","errorType":"CLIENT_ERROR","error":"unauthorized"}`, string(data))
+
+	var unmarshalled models.ConnectorTestCheck
+	require.NoError(t, json.Unmarshal(data, &unmarshalled))
+	assert.Equal(t, check, unmarshalled)
+
+	t.Run("omits error fields when the check passed", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := json.Marshal(models.ConnectorTestCheck{
+			Name:   models.ConnectorTestCheckConfig,
+			Status: models.CONNECTOR_TEST_CHECK_STATUS_PASSED,
+		})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name":"config","status":"PASSED","duration":"0s"}`, string(data))
+	})
+
+	t.Run("invalid duration", func(t *testing.T) {
+		t.Parallel()
+
+		var check models.ConnectorTestCheck
+		err := json.Unmarshal([]byte(`{"name":"config","duration":"invalid"}`), &check)
+		require.Error(t, err)
+	})
+}
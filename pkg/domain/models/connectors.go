@@ -66,6 +66,10 @@ type Connector struct {
 	// Config given by the user. It will be encrypted when stored
 	Config json.RawMessage `json:"config"`
 
+	// Environment set in the config, stored apart from it so that connectors
+	// can be filtered on it. Empty when not set.
+	Environment string `json:"environment,omitempty"`
+
 	// UpdatedAt is set by a DB trigger whenever the connector row is updated.
 	// Nil means the connector has never been updated since initial install.
 	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
@@ -94,6 +98,7 @@ func (c Connector) MarshalJSON() ([]byte, error) {
 		Config               json.RawMessage `json:"config"`
 		ScheduledForDeletion bool            `json:"scheduledForDeletion"`
 		UpdatedAt            *time.Time      `json:"updatedAt,omitempty"`
+		Environment          string          `json:"environment,omitempty"`
 	}{
 		ID:                   c.ID.String(),
 		Reference:            c.ID.Reference.String(),
@@ -103,6 +108,7 @@ func (c Connector) MarshalJSON() ([]byte, error) {
 		Config:               c.Config,
 		ScheduledForDeletion: c.ScheduledForDeletion,
 		UpdatedAt:            c.UpdatedAt,
+		Environment:          c.Environment,
 	})
 }
 
@@ -111,6 +117,7 @@ func (c *Connector) UnmarshalJSON(data []byte) error {
 		Config               json.RawMessage `json:"config"`
 		ScheduledForDeletion bool            `json:"scheduledForDeletion"`
 		UpdatedAt            *time.Time      `json:"updatedAt,omitempty"`
+		Environment          string          `json:"environment,omitempty"`
 	}
 	if err := json.Unmarshal(data, &aux); err != nil {
 		return err
@@ -128,6 +135,7 @@ func (c *Connector) UnmarshalJSON(data []byte) error {
 	c.Config = aux.Config
 	c.ScheduledForDeletion = aux.ScheduledForDeletion
 	c.UpdatedAt = aux.UpdatedAt
+	c.Environment = aux.Environment
 
 	return nil
 }
@@ -0,0 +1,274 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Version of the connectors export document. It must be bumped on every
+// breaking change of the document format.
+const ConnectorsExportVersion = 1
+
+// Value replacing the secrets of a redacted export.
+const ConnectorsExportRedactedValue = "<redacted>"
+
+type ConnectorsExportSecrets string
+
+const (
+	// Secrets are exported in clear text.
+	CONNECTORS_EXPORT_SECRETS_PLAIN ConnectorsExportSecrets = "PLAIN"
+	// Secrets are replaced by ConnectorsExportRedactedValue and must be
+	// provided again on the target environment.
+	CONNECTORS_EXPORT_SECRETS_REDACTED ConnectorsExportSecrets = "REDACTED"
+	// Secrets are encrypted with the config encryption key of the target
+	// environment, only the target is able to import them.
+	CONNECTORS_EXPORT_SECRETS_ENCRYPTED ConnectorsExportSecrets = "ENCRYPTED"
+)
+
+func ConnectorsExportSecretsFromString(value string) (ConnectorsExportSecrets, error) {
+	switch s := ConnectorsExportSecrets(strings.ToUpper(value)); s {
+	case CONNECTORS_EXPORT_SECRETS_PLAIN,
+		CONNECTORS_EXPORT_SECRETS_REDACTED,
+		CONNECTORS_EXPORT_SECRETS_ENCRYPTED:
+		return s, nil
+	default:
+		return "", fmt.Errorf("unknown secrets mode %q: %w", value, ErrValidation)
+	}
+}
+
+type ConnectorsExportOptions struct {
+	Secrets ConnectorsExportSecrets
+	// Config encryption key of the target environment, only used with
+	// CONNECTORS_EXPORT_SECRETS_ENCRYPTED.
+	EncryptionKey string
+}
+
+func (o ConnectorsExportOptions) Validate() error {
+	if _, err := ConnectorsExportSecretsFromString(string(o.Secrets)); err != nil {
+		return err
+	}
+
+	if o.Secrets == CONNECTORS_EXPORT_SECRETS_ENCRYPTED && o.EncryptionKey == "" {
+		return fmt.Errorf("missing encryption key to encrypt secrets: %w", ErrValidation)
+	}
+
+	return nil
+}
+
+// ConnectorsExport is the document used to promote connectors and pools from
+// one environment to another. Connectors and pools are identified by their
+// names, which are unique within an environment.
+type ConnectorsExport struct {
+	Version    int                     `json:"version"`
+	ExportedAt time.Time               `json:"exportedAt"`
+	Secrets    ConnectorsExportSecrets `json:"secrets"`
+	Connectors []ConnectorExport       `json:"connectors"`
+	Pools      []PoolExport            `json:"pools"`
+}
+
+type ConnectorExport struct {
+	Name     string          `json:"name"`
+	Provider string          `json:"provider"`
+	Config   json.RawMessage `json:"config"`
+	// Metadata of the webhooks configs hold the secrets shared with the PSP,
+	// they are redacted or encrypted like the secrets of the config.
+	WebhooksConfigs []WebhookConfigExport `json:"webhooksConfigs,omitempty"`
+}
+
+type WebhookConfigExport struct {
+	Name     string            `json:"name"`
+	URLPath  string            `json:"urlPath"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+type PoolExport struct {
+	Name string   `json:"name"`
+	Type PoolType `json:"type"`
+	// Only set for dynamic pools
+	Query map[string]any `json:"query,omitempty"`
+	// Only set for static pools
+	Accounts []PoolAccountExport `json:"accounts,omitempty"`
+}
+
+// PoolAccountExport references an account by the name of its connector, since
+// connector IDs differ between environments.
+type PoolAccountExport struct {
+	Connector string `json:"connector"`
+	Reference string `json:"reference"`
+}
+
+func (e ConnectorsExport) Validate() error {
+	if e.Version != ConnectorsExportVersion {
+		return fmt.Errorf("unsupported export version %d: %w", e.Version, ErrValidation)
+	}
+
+	if _, err := ConnectorsExportSecretsFromString(string(e.Secrets)); err != nil {
+		return err
+	}
+
+	connectors := make(map[string]struct{}, len(e.Connectors))
+	for _, c := range e.Connectors {
+		if c.Name == "" {
+			return fmt.Errorf("missing connector name: %w", ErrValidation)
+		}
+		if c.Provider == "" {
+			return fmt.Errorf("missing provider of connector %q: %w", c.Name, ErrValidation)
+		}
+		if _, ok := connectors[c.Name]; ok {
+			return fmt.Errorf("duplicate connector %q: %w", c.Name, ErrValidation)
+		}
+		connectors[c.Name] = struct{}{}
+
+		var config struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(c.Config, &config); err != nil {
+			return fmt.Errorf("invalid config of connector %q: %w", c.Name, ErrValidation)
+		}
+		if config.Name != c.Name {
+			return fmt.Errorf("config name of connector %q differs from its name: %w", c.Name, ErrValidation)
+		}
+
+		webhooksConfigs := make(map[string]struct{}, len(c.WebhooksConfigs))
+		for _, w := range c.WebhooksConfigs {
+			if w.Name == "" {
+				return fmt.Errorf("missing webhooks config name of connector %q: %w", c.Name, ErrValidation)
+			}
+			if _, ok := webhooksConfigs[w.Name]; ok {
+				return fmt.Errorf("duplicate webhooks config %q of connector %q: %w", w.Name, c.Name, ErrValidation)
+			}
+			webhooksConfigs[w.Name] = struct{}{}
+		}
+	}
+
+	pools := make(map[string]struct{}, len(e.Pools))
+	for _, p := range e.Pools {
+		if p.Name == "" {
+			return fmt.Errorf("missing pool name: %w", ErrValidation)
+		}
+		if _, ok := pools[p.Name]; ok {
+			return fmt.Errorf("duplicate pool %q: %w", p.Name, ErrValidation)
+		}
+		pools[p.Name] = struct{}{}
+
+		switch p.Type {
+		case POOL_TYPE_STATIC:
+			if len(p.Query) > 0 {
+				return fmt.Errorf("static pool %q cannot have a query: %w", p.Name, ErrValidation)
+			}
+		case POOL_TYPE_DYNAMIC:
+			if len(p.Accounts) > 0 {
+				return fmt.Errorf("dynamic pool %q cannot have accounts: %w", p.Name, ErrValidation)
+			}
+		default:
+			return fmt.Errorf("unknown type %q of pool %q: %w", p.Type, p.Name, ErrValidation)
+		}
+	}
+
+	return nil
+}
+
+type ConnectorsImportAction string
+
+const (
+	CONNECTORS_IMPORT_ACTION_CREATE    ConnectorsImportAction = "CREATE"
+	CONNECTORS_IMPORT_ACTION_UPDATE    ConnectorsImportAction = "UPDATE"
+	CONNECTORS_IMPORT_ACTION_UNCHANGED ConnectorsImportAction = "UNCHANGED"
+	// The object cannot be imported, the reason is given along with it.
+	CONNECTORS_IMPORT_ACTION_SKIP ConnectorsImportAction = "SKIP"
+	// Only reported when reconciling with pruning, for installed connectors
+	// missing from the document.
+	CONNECTORS_IMPORT_ACTION_UNINSTALL ConnectorsImportAction = "UNINSTALL"
+)
+
+// ConnectorsImportPlan describes what importing a ConnectorsExport changes
+// on the target environment. Objects of the environment missing from the
+// document are left untouched.
+type ConnectorsImportPlan struct {
+	// False when the plan was only computed (dry run)
+	Applied    bool                    `json:"applied"`
+	Connectors []ConnectorImportChange `json:"connectors"`
+	Pools      []PoolImportChange      `json:"pools"`
+}
+
+type ConnectorImportChange struct {
+	Name     string                 `json:"name"`
+	Provider string                 `json:"provider"`
+	Action   ConnectorsImportAction `json:"action"`
+	// Set when the connector already exists, or once it was created
+	ConnectorID *ConnectorID `json:"connectorID,omitempty"`
+	// Names of the config fields which change, values are never reported
+	// since they may be secrets.
+	Changes []string `json:"changes,omitempty"`
+	Reason  string   `json:"reason,omitempty"`
+}
+
+type PoolImportChange struct {
+	Name   string                 `json:"name"`
+	Action ConnectorsImportAction `json:"action"`
+	PoolID *uuid.UUID             `json:"poolID,omitempty"`
+	// Either query or accounts
+	Changes []string `json:"changes,omitempty"`
+	Reason  string   `json:"reason,omitempty"`
+}
+
+func (c ConnectorImportChange) MarshalJSON() ([]byte, error) {
+	var connectorID *string
+	if c.ConnectorID != nil {
+		id := c.ConnectorID.String()
+		connectorID = &id
+	}
+
+	return json.Marshal(&struct {
+		Name        string                 `json:"name"`
+		Provider    string                 `json:"provider"`
+		Action      ConnectorsImportAction `json:"action"`
+		ConnectorID *string                `json:"connectorID,omitempty"`
+		Changes     []string               `json:"changes,omitempty"`
+		Reason      string                 `json:"reason,omitempty"`
+	}{
+		Name:        c.Name,
+		Provider:    c.Provider,
+		Action:      c.Action,
+		ConnectorID: connectorID,
+		Changes:     c.Changes,
+		Reason:      c.Reason,
+	})
+}
+
+func (c *ConnectorImportChange) UnmarshalJSON(data []byte) error {
+	var aux struct {
+		Name        string                 `json:"name"`
+		Provider    string                 `json:"provider"`
+		Action      ConnectorsImportAction `json:"action"`
+		ConnectorID *string                `json:"connectorID"`
+		Changes     []string               `json:"changes"`
+		Reason      string                 `json:"reason"`
+	}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var connectorID *ConnectorID
+	if aux.ConnectorID != nil {
+		id, err := ConnectorIDFromString(*aux.ConnectorID)
+		if err != nil {
+			return err
+		}
+		connectorID = &id
+	}
+
+	c.Name = aux.Name
+	c.Provider = aux.Provider
+	c.Action = aux.Action
+	c.ConnectorID = connectorID
+	c.Changes = aux.Changes
+	c.Reason = aux.Reason
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package models_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectorsExportValidate(t *testing.T) {
+	t.Parallel()
+
+	valid := func() models.ConnectorsExport {
+		return models.ConnectorsExport{
+			Version: models.ConnectorsExportVersion,
+			Secrets: models.CONNECTORS_EXPORT_SECRETS_REDACTED,
+			Connectors: []models.ConnectorExport{
+				{
+					Name:            "stripe-eu",
+					Provider:        "stripe",
+					Config:          json.RawMessage(`{"name":"stripe-eu"}`),
+					WebhooksConfigs: []models.WebhookConfigExport{{Name: "payments", URLPath: "/payments"}},
+				},
+			},
+			Pools: []models.PoolExport{
+				{Name: "static", Type: models.POOL_TYPE_STATIC, Accounts: []models.PoolAccountExport{{Connector: "stripe-eu", Reference: "acc"}}},
+				{Name: "dynamic", Type: models.POOL_TYPE_DYNAMIC, Query: map[string]any{"$match": map[string]any{"default_asset": "EUR/2"}}},
+			},
+		}
+	}
+
+	require.NoError(t, valid().Validate())
+
+	tests := []struct {
+		name   string
+		update func(e *models.ConnectorsExport)
+	}{
+		{"unsupported version", func(e *models.ConnectorsExport) { e.Version = 2 }},
+		{"unknown secrets mode", func(e *models.ConnectorsExport) { e.Secrets = "HIDDEN" }},
+		{"missing connector name", func(e *models.ConnectorsExport) { e.Connectors[0].Name = "" }},
+		{"missing provider", func(e *models.ConnectorsExport) { e.Connectors[0].Provider = "" }},
+		{"duplicate connector", func(e *models.ConnectorsExport) { e.Connectors = append(e.Connectors, e.Connectors[0]) }},
+		{"invalid config", func(e *models.ConnectorsExport) { e.Connectors[0].Config = json.RawMessage(`[]`) }},
+		{"config name mismatch", func(e *models.ConnectorsExport) { e.Connectors[0].Config = json.RawMessage(`{"name":"other"}`) }},
+		{"missing webhooks config name", func(e *models.ConnectorsExport) { e.Connectors[0].WebhooksConfigs[0].Name = "" }},
+		{"duplicate webhooks config", func(e *models.ConnectorsExport) {
+			e.Connectors[0].WebhooksConfigs = append(e.Connectors[0].WebhooksConfigs, e.Connectors[0].WebhooksConfigs[0])
+		}},
+		{"missing pool name", func(e *models.ConnectorsExport) { e.Pools[0].Name = "" }},
+		{"duplicate pool", func(e *models.ConnectorsExport) { e.Pools[1].Name = e.Pools[0].Name }},
+		{"static pool with query", func(e *models.ConnectorsExport) { e.Pools[0].Query = map[string]any{"a": "b"} }},
+		{"dynamic pool with accounts", func(e *models.ConnectorsExport) { e.Pools[1].Accounts = e.Pools[0].Accounts }},
+		{"unknown pool type", func(e *models.ConnectorsExport) { e.Pools[0].Type = "OTHER" }},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			e := valid()
+			test.update(&e)
+			require.ErrorIs(t, e.Validate(), models.ErrValidation)
+		})
+	}
+}
+
+func TestConnectorsExportOptionsValidate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, models.ConnectorsExportOptions{Secrets: models.CONNECTORS_EXPORT_SECRETS_REDACTED}.Validate())
+	require.NoError(t, models.ConnectorsExportOptions{Secrets: models.CONNECTORS_EXPORT_SECRETS_ENCRYPTED, EncryptionKey: "key"}.Validate())
+	require.ErrorIs(t, models.ConnectorsExportOptions{Secrets: models.CONNECTORS_EXPORT_SECRETS_ENCRYPTED}.Validate(), models.ErrValidation)
+	require.ErrorIs(t, models.ConnectorsExportOptions{}.Validate(), models.ErrValidation)
+}
+
+func TestConnectorsExportSecretsFromString(t *testing.T) {
+	t.Parallel()
+
+	s, err := models.ConnectorsExportSecretsFromString("encrypted")
+	require.NoError(t, err)
+	assert.Equal(t, models.CONNECTORS_EXPORT_SECRETS_ENCRYPTED, s)
+
+	_, err = models.ConnectorsExportSecretsFromString("unknown")
+	require.ErrorIs(t, err, models.ErrValidation)
+}
+
+func TestConnectorImportChangeMarshalUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	connectorID := models.ConnectorID{Reference: uuid.New(), Provider: "stripe"}
+	change := models.ConnectorImportChange{
+		Name:        "stripe-eu",
+		Provider:    "stripe",
+		Action:      models.CONNECTORS_IMPORT_ACTION_UPDATE,
+		ConnectorID: &connectorID,
+		Changes:     []string{"apiKey"},
+	}
+
+	data, err := json.Marshal(change)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"stripe-eu","provider":"stripe","action":"UPDATE","connectorID":"`+connectorID.String()+`","changes":["apiKey"]}`, string(data))
+
+	var unmarshalled models.ConnectorImportChange
+	require.NoError(t, json.Unmarshal(data, &unmarshalled))
+	assert.Equal(t, change, unmarshalled)
+
+	data, err = json.Marshal(models.ConnectorImportChange{Name: "new", Provider: "wise", Action: models.CONNECTORS_IMPORT_ACTION_CREATE})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"new","provider":"wise","action":"CREATE"}`, string(data))
+}
@@ -152,6 +152,7 @@ func TestConnectorMarshalJSON(t *testing.T) {
 		},
 		Config:               json.RawMessage(`{"apiKey": "test_key"}`),
 		ScheduledForDeletion: false,
+		Environment:          "sandbox",
 	}
 
 	data, err := json.Marshal(connector)
@@ -168,6 +169,7 @@ func TestConnectorMarshalJSON(t *testing.T) {
 	assert.Equal(t, "Test Connector", jsonMap["name"])
 	assert.Equal(t, "stripe", jsonMap["provider"])
 	assert.Equal(t, false, jsonMap["scheduledForDeletion"])
+	assert.Equal(t, "sandbox", jsonMap["environment"])
 
 	configJson, ok := jsonMap["config"].(map[string]interface{})
 	require.True(t, ok)
@@ -196,7 +198,8 @@ func TestConnectorUnmarshalJSON(t *testing.T) {
 			"createdAt": "` + now.Format(time.RFC3339Nano) + `",
 			"provider": "stripe",
 			"config": {"apiKey": "test_key"},
-			"scheduledForDeletion": false
+			"scheduledForDeletion": false,
+			"environment": "production"
 		}`
 
 		var connector models.Connector
@@ -205,6 +208,7 @@ func TestConnectorUnmarshalJSON(t *testing.T) {
 
 		// Then
 		require.NoError(t, err)
+		assert.Equal(t, "production", connector.Environment)
 
 		assert.Equal(t, id.String(), connector.ID.String())
 		assert.Equal(t, "Test Connector", connector.Name)
@@ -0,0 +1,161 @@
+package models
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// counterpartyNamespace is used to derive the counterparty IDs from their
+// deduplication key, so that the same counterparty seen on several connectors
+// always gets the same ID. The derivation is keyed, see counterpartyID.
+var counterpartyNamespace = uuid.MustParse("3f1b7c2e-5a0d-4c6e-9b8f-2d4e6a8c0b1d")
+
+// Counterparty is the other side of the payments, deduplicated across
+// connectors by IBAN, or by account number and routing code (or BIC when the
+// connector does not give the routing code), or by PSP reference when the
+// connector does not give any bank details.
+type Counterparty struct {
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+	Name      string    `json:"name"`
+
+	IBAN          *string `json:"iban"`
+	AccountNumber *string `json:"accountNumber"`
+	SwiftBicCode  *string `json:"swiftBicCode"`
+	RoutingCode   *string `json:"routingCode"`
+	Country       *string `json:"country"`
+
+	Metadata map[string]string `json:"metadata"`
+
+	// External accounts of the counterparty, across connectors.
+	RelatedAccounts []CounterpartyRelatedAccount `json:"relatedAccounts"`
+}
+
+type CounterpartyRelatedAccount struct {
+	AccountID AccountID `json:"accountID"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (c CounterpartyRelatedAccount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		AccountID   string    `json:"accountID"`
+		ConnectorID string    `json:"connectorID"`
+		Provider    string    `json:"provider"`
+		CreatedAt   time.Time `json:"createdAt"`
+	}{
+		AccountID:   c.AccountID.String(),
+		ConnectorID: c.AccountID.ConnectorID.String(),
+		Provider:    ToV3Provider(c.AccountID.ConnectorID.Provider),
+		CreatedAt:   c.CreatedAt,
+	})
+}
+
+// Obfuscate masks the IBAN and account number in place, the same way as the
+// bank accounts.
+func (c *Counterparty) Obfuscate() error {
+	if c.IBAN != nil {
+		*c.IBAN = obfuscate(*c.IBAN, 4, 4)
+	}
+
+	if c.AccountNumber != nil {
+		*c.AccountNumber = obfuscate(*c.AccountNumber, 2, 3)
+	}
+
+	return nil
+}
+
+// CounterpartyIDFromIBAN returns the ID of the counterparty deduplicated by
+// the given IBAN, secret being the key the IDs are derived with.
+func CounterpartyIDFromIBAN(secret, iban string) uuid.UUID {
+	return counterpartyID(secret, "iban:"+normalizeBankDetail(iban))
+}
+
+// counterpartyID derives the ID of a counterparty from its deduplication key
+// with an HMAC: the IDs are exposed, and the bank details are few enough to
+// be guessed and confirmed from an unkeyed hash.
+func counterpartyID(secret, key string) uuid.UUID {
+	return uuid.NewHash(hmac.New(sha256.New, []byte(secret)), counterpartyNamespace, []byte(key), 8)
+}
+
+func normalizeBankDetail(v string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(v), ""))
+}
+
+// CounterpartyFromExternalAccount extracts the counterparty of an external
+// account from the bank details stored in the account metadata, either by the
+// connector when fetching it or from the bank account forwarded to it. The
+// external accounts without bank details are deduplicated by their PSP
+// reference, which only matches on the connectors of the same provider. It
+// returns nil for the other account types. secret is the key the IDs are
+// derived with.
+func CounterpartyFromExternalAccount(secret string, account Account) *Counterparty {
+	if account.Type != ACCOUNT_TYPE_EXTERNAL {
+		return nil
+	}
+
+	c := &Counterparty{
+		CreatedAt: account.CreatedAt,
+		Name:      account.Reference,
+		Metadata:  make(map[string]string),
+		RelatedAccounts: []CounterpartyRelatedAccount{
+			{
+				AccountID: account.ID,
+				CreatedAt: account.CreatedAt,
+			},
+		},
+	}
+
+	if name := account.Metadata[AccountBankAccountNameMetadataKey]; name != "" {
+		c.Name = name
+	} else if account.Name != nil && *account.Name != "" {
+		c.Name = *account.Name
+	}
+
+	if v := normalizeBankDetail(account.Metadata[AccountIBANMetadataKey]); v != "" {
+		c.IBAN = &v
+	}
+	if v := normalizeBankDetail(account.Metadata[AccountAccountNumberMetadataKey]); v != "" {
+		c.AccountNumber = &v
+	}
+	if v := normalizeBankDetail(account.Metadata[AccountSwiftBicCodeMetadataKey]); v != "" {
+		c.SwiftBicCode = &v
+	}
+	if v := normalizeBankDetail(account.Metadata[BankAccountRoutingCodeMetadataKey]); v != "" {
+		c.RoutingCode = &v
+	}
+	if v := strings.TrimSpace(account.Metadata[AccountBankAccountCountryMetadataKey]); v != "" {
+		c.Country = &v
+	}
+
+	for _, key := range []string{
+		BankAccountOwnerAddressLine1MetadataKey,
+		BankAccountOwnerAddressLine2MetadataKey,
+		BankAccountOwnerCityMetadataKey,
+		BankAccountOwnerRegionMetadataKey,
+		BankAccountOwnerPostalCodeMetadataKey,
+		BankAccountOwnerEmailMetadataKey,
+		BankAccountOwnerPhoneNumberMetadataKey,
+	} {
+		if v := account.Metadata[key]; v != "" {
+			c.Metadata[key] = v
+		}
+	}
+
+	switch {
+	case c.IBAN != nil:
+		c.ID = CounterpartyIDFromIBAN(secret, *c.IBAN)
+	case c.AccountNumber != nil && c.RoutingCode != nil:
+		c.ID = counterpartyID(secret, "account:"+*c.AccountNumber+":"+*c.RoutingCode)
+	case c.AccountNumber != nil && c.SwiftBicCode != nil:
+		c.ID = counterpartyID(secret, "account:"+*c.AccountNumber+":"+*c.SwiftBicCode)
+	default:
+		c.ID = counterpartyID(secret, "reference:"+account.ID.ConnectorID.Provider+":"+account.Reference)
+	}
+
+	return c
+}
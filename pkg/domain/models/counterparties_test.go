@@ -0,0 +1,135 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCounterpartyFromExternalAccount(t *testing.T) {
+	t.Parallel()
+
+	const secret = "key"
+	now := time.Now().UTC()
+	account := func(provider, reference string, metadata map[string]string) models.Account {
+		connectorID := models.ConnectorID{Provider: provider, Reference: uuid.New()}
+		return models.Account{
+			ID:          models.AccountID{Reference: reference, ConnectorID: connectorID},
+			ConnectorID: connectorID,
+			Reference:   reference,
+			CreatedAt:   now,
+			Type:        models.ACCOUNT_TYPE_EXTERNAL,
+			Name:        pointer.For("acc"),
+			Metadata:    metadata,
+		}
+	}
+
+	t.Run("internal account", func(t *testing.T) {
+		t.Parallel()
+
+		a := account("stripe", "acc1", nil)
+		a.Type = models.ACCOUNT_TYPE_INTERNAL
+		require.Nil(t, models.CounterpartyFromExternalAccount(secret, a))
+	})
+
+	t.Run("deduplicated by IBAN across connectors", func(t *testing.T) {
+		t.Parallel()
+
+		c1 := models.CounterpartyFromExternalAccount(secret, account("stripe", "acc1", map[string]string{
+			models.AccountIBANMetadataKey:            "fr76 3000 6000 0112 3456 7890 189",
+			models.AccountBankAccountNameMetadataKey: "ACME",
+			models.BankAccountOwnerCityMetadataKey:   "Paris",
+		}))
+		c2 := models.CounterpartyFromExternalAccount(secret, account("wise", "acc2", map[string]string{
+			models.AccountIBANMetadataKey: "FR7630006000011234567890189",
+		}))
+
+		require.NotNil(t, c1)
+		require.NotNil(t, c2)
+		require.Equal(t, c1.ID, c2.ID)
+		require.Equal(t, models.CounterpartyIDFromIBAN(secret, "FR7630006000011234567890189"), c1.ID)
+		require.Equal(t, "FR7630006000011234567890189", *c1.IBAN)
+		require.Equal(t, "ACME", c1.Name)
+		require.Equal(t, "acc", c2.Name)
+		require.Equal(t, map[string]string{models.BankAccountOwnerCityMetadataKey: "Paris"}, c1.Metadata)
+		require.Len(t, c1.RelatedAccounts, 1)
+	})
+
+	t.Run("deduplicated by account number and routing code", func(t *testing.T) {
+		t.Parallel()
+
+		c1 := models.CounterpartyFromExternalAccount(secret, account("column", "acc1", map[string]string{
+			models.AccountAccountNumberMetadataKey:   "123456789",
+			models.BankAccountRoutingCodeMetadataKey: "021000021",
+		}))
+		// The BIC is not part of the key when the routing code is known
+		c2 := models.CounterpartyFromExternalAccount(secret, account("increase", "acc2", map[string]string{
+			models.AccountAccountNumberMetadataKey:   "123456789",
+			models.BankAccountRoutingCodeMetadataKey: "021000021",
+			models.AccountSwiftBicCodeMetadataKey:    "chasus33",
+		}))
+		c3 := models.CounterpartyFromExternalAccount(secret, account("increase", "acc3", map[string]string{
+			models.AccountAccountNumberMetadataKey:   "123456789",
+			models.BankAccountRoutingCodeMetadataKey: "026009593",
+		}))
+
+		require.Equal(t, c1.ID, c2.ID)
+		require.NotEqual(t, c1.ID, c3.ID)
+		require.Nil(t, c1.IBAN)
+		require.Equal(t, "021000021", *c1.RoutingCode)
+	})
+
+	t.Run("deduplicated by account number and BIC without routing code", func(t *testing.T) {
+		t.Parallel()
+
+		metadata := map[string]string{
+			models.AccountAccountNumberMetadataKey: "123456789",
+			models.AccountSwiftBicCodeMetadataKey:  "bnpafrpp",
+		}
+		c1 := models.CounterpartyFromExternalAccount(secret, account("stripe", "acc1", metadata))
+		c2 := models.CounterpartyFromExternalAccount(secret, account("wise", "acc2", metadata))
+
+		require.Equal(t, c1.ID, c2.ID)
+		require.Equal(t, "BNPAFRPP", *c1.SwiftBicCode)
+	})
+
+	t.Run("deduplicated by PSP reference without bank details", func(t *testing.T) {
+		t.Parallel()
+
+		c1 := models.CounterpartyFromExternalAccount(secret, account("stripe", "acc1", nil))
+		c2 := models.CounterpartyFromExternalAccount(secret, account("stripe", "acc1", map[string]string{
+			models.AccountAccountNumberMetadataKey: "123456789",
+		}))
+		c3 := models.CounterpartyFromExternalAccount(secret, account("wise", "acc1", nil))
+
+		require.NotNil(t, c1)
+		require.Equal(t, c1.ID, c2.ID)
+		require.NotEqual(t, c1.ID, c3.ID)
+		require.Nil(t, c1.IBAN)
+		require.Equal(t, "acc", c1.Name)
+	})
+
+	t.Run("IDs depend on the secret", func(t *testing.T) {
+		t.Parallel()
+
+		iban := "FR7630006000011234567890189"
+		require.NotEqual(t, models.CounterpartyIDFromIBAN(secret, iban), models.CounterpartyIDFromIBAN("other", iban))
+		require.Equal(t, models.CounterpartyIDFromIBAN(secret, iban), models.CounterpartyIDFromIBAN(secret, "fr76 3000 6000 0112 3456 7890 189"))
+	})
+}
+
+func TestCounterpartyObfuscate(t *testing.T) {
+	t.Parallel()
+
+	c := models.Counterparty{
+		IBAN:          pointer.For("FR7630006000011234567890189"),
+		AccountNumber: pointer.For("123456789"),
+	}
+	require.NoError(t, c.Obfuscate())
+	require.Equal(t, "FR76*******************0189", *c.IBAN)
+	require.Equal(t, "12****789", *c.AccountNumber)
+}
@@ -0,0 +1,78 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/gibson042/canonicaljson-go"
+)
+
+type DisputeID struct {
+	Reference   string
+	ConnectorID ConnectorID
+}
+
+func (cid DisputeID) String() string {
+	data, err := canonicaljson.Marshal(cid)
+	if err != nil {
+		panic(err)
+	}
+
+	return base64.URLEncoding.WithPadding(base64.NoPadding).EncodeToString(data)
+}
+
+func DisputeIDFromString(value string) (DisputeID, error) {
+	ret := DisputeID{}
+	data, err := base64.URLEncoding.WithPadding(base64.NoPadding).DecodeString(value)
+	if err != nil {
+		return ret, err
+	}
+	err = canonicaljson.Unmarshal(data, &ret)
+	if err != nil {
+		return ret, err
+	}
+
+	return ret, nil
+}
+
+func MustDisputeIDFromString(value string) *DisputeID {
+	data, err := base64.URLEncoding.WithPadding(base64.NoPadding).DecodeString(value)
+	if err != nil {
+		panic(err)
+	}
+	ret := DisputeID{}
+	err = canonicaljson.Unmarshal(data, &ret)
+	if err != nil {
+		panic(err)
+	}
+
+	return &ret
+}
+
+func (cid DisputeID) Value() (driver.Value, error) {
+	return cid.String(), nil
+}
+
+func (cid *DisputeID) Scan(value interface{}) error {
+	if value == nil {
+		return errors.New("dispute id is nil")
+	}
+
+	if s, err := driver.String.ConvertValue(value); err == nil {
+
+		if v, ok := s.(string); ok {
+
+			id, err := DisputeIDFromString(v)
+			if err != nil {
+				return fmt.Errorf("failed to parse dispute id %s: %v", v, err)
+			}
+
+			*cid = id
+			return nil
+		}
+	}
+
+	return fmt.Errorf("failed to scan dispute id: %v", value)
+}
@@ -0,0 +1,130 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newDisputeID(t *testing.T) models.DisputeID {
+	t.Helper()
+	return models.DisputeID{
+		Reference: "dp123",
+		ConnectorID: models.ConnectorID{
+			Provider:  "stripe",
+			Reference: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		},
+	}
+}
+
+func TestDisputeID(t *testing.T) {
+	t.Parallel()
+
+	t.Run("String", func(t *testing.T) {
+		t.Parallel()
+		id := newDisputeID(t)
+		result := id.String()
+		assert.NotEmpty(t, result)
+
+		decoded, err := models.DisputeIDFromString(result)
+		require.NoError(t, err)
+		assert.Equal(t, id.Reference, decoded.Reference)
+		assert.Equal(t, id.ConnectorID.Provider, decoded.ConnectorID.Provider)
+	})
+
+	t.Run("DisputeIDFromString", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("valid", func(t *testing.T) {
+			t.Parallel()
+			original := newDisputeID(t)
+			id, err := models.DisputeIDFromString(original.String())
+			require.NoError(t, err)
+			assert.Equal(t, original.Reference, id.Reference)
+		})
+
+		t.Run("illegal base64", func(t *testing.T) {
+			t.Parallel()
+			_, err := models.DisputeIDFromString("invalid-format")
+			assert.Error(t, err)
+		})
+
+		t.Run("empty string", func(t *testing.T) {
+			t.Parallel()
+			_, err := models.DisputeIDFromString("")
+			assert.Error(t, err)
+		})
+	})
+
+	t.Run("MustDisputeIDFromString", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("valid", func(t *testing.T) {
+			t.Parallel()
+			original := newDisputeID(t)
+			id := models.MustDisputeIDFromString(original.String())
+			require.NotNil(t, id)
+			assert.Equal(t, original.Reference, id.Reference)
+		})
+
+		t.Run("illegal base64 panics", func(t *testing.T) {
+			t.Parallel()
+			assert.Panics(t, func() {
+				models.MustDisputeIDFromString("invalid-format")
+			})
+		})
+
+		t.Run("illegal json panics", func(t *testing.T) {
+			t.Parallel()
+			assert.Panics(t, func() {
+				models.MustDisputeIDFromString("aW52YWxpZC1qc29u")
+			})
+		})
+	})
+
+	t.Run("Value", func(t *testing.T) {
+		t.Parallel()
+		id := newDisputeID(t)
+		val, err := id.Value()
+		require.NoError(t, err)
+		assert.Equal(t, id.String(), val)
+	})
+
+	t.Run("Scan", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("valid", func(t *testing.T) {
+			t.Parallel()
+			original := newDisputeID(t)
+			var id models.DisputeID
+			err := id.Scan(original.String())
+			require.NoError(t, err)
+			assert.Equal(t, original.Reference, id.Reference)
+		})
+
+		t.Run("nil", func(t *testing.T) {
+			t.Parallel()
+			var id models.DisputeID
+			err := id.Scan(nil)
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), "dispute id is nil")
+		})
+
+		t.Run("invalid type", func(t *testing.T) {
+			t.Parallel()
+			var id models.DisputeID
+			err := id.Scan(123)
+			assert.Error(t, err)
+		})
+
+		t.Run("illegal base64", func(t *testing.T) {
+			t.Parallel()
+			var id models.DisputeID
+			err := id.Scan("invalid-format")
+			assert.Error(t, err)
+		})
+	})
+}
@@ -0,0 +1,117 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+type DisputeStatus int
+
+const (
+	DISPUTE_STATUS_UNKNOWN DisputeStatus = iota
+	// The cardholder's bank asked questions (inquiry, request for
+	// information, fraud warning) without moving funds yet.
+	DISPUTE_STATUS_INQUIRY
+	// Funds were withdrawn and evidence must be submitted before the
+	// evidence due date.
+	DISPUTE_STATUS_NEEDS_RESPONSE
+	// Evidence was submitted and the issuer is reviewing it.
+	DISPUTE_STATUS_UNDER_REVIEW
+	DISPUTE_STATUS_WON
+	DISPUTE_STATUS_LOST
+	// An inquiry was closed without escalating to a chargeback.
+	DISPUTE_STATUS_CLOSED
+)
+
+func (s DisputeStatus) String() string {
+	switch s {
+	case DISPUTE_STATUS_INQUIRY:
+		return "INQUIRY"
+	case DISPUTE_STATUS_NEEDS_RESPONSE:
+		return "NEEDS_RESPONSE"
+	case DISPUTE_STATUS_UNDER_REVIEW:
+		return "UNDER_REVIEW"
+	case DISPUTE_STATUS_WON:
+		return "WON"
+	case DISPUTE_STATUS_LOST:
+		return "LOST"
+	case DISPUTE_STATUS_CLOSED:
+		return "CLOSED"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+func DisputeStatusFromString(str string) (DisputeStatus, error) {
+	switch str {
+	case "INQUIRY":
+		return DISPUTE_STATUS_INQUIRY, nil
+	case "NEEDS_RESPONSE":
+		return DISPUTE_STATUS_NEEDS_RESPONSE, nil
+	case "UNDER_REVIEW":
+		return DISPUTE_STATUS_UNDER_REVIEW, nil
+	case "WON":
+		return DISPUTE_STATUS_WON, nil
+	case "LOST":
+		return DISPUTE_STATUS_LOST, nil
+	case "CLOSED":
+		return DISPUTE_STATUS_CLOSED, nil
+	case "UNKNOWN":
+		return DISPUTE_STATUS_UNKNOWN, nil
+	default:
+		return DISPUTE_STATUS_UNKNOWN, fmt.Errorf("unknown dispute status: %s", str)
+	}
+}
+
+func (s DisputeStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+func (s *DisputeStatus) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+
+	var err error
+	*s, err = DisputeStatusFromString(str)
+	return err
+}
+
+func (s DisputeStatus) Value() (driver.Value, error) {
+	if s == DISPUTE_STATUS_UNKNOWN {
+		return nil, fmt.Errorf("unknown dispute status")
+	}
+	return s.String(), nil
+}
+
+func (s *DisputeStatus) Scan(value interface{}) error {
+	if value == nil {
+		return errors.New("dispute status is nil")
+	}
+
+	str, err := driver.String.ConvertValue(value)
+	if err != nil {
+		return fmt.Errorf("failed to convert dispute status")
+	}
+
+	v, ok := str.(string)
+	if !ok {
+		return fmt.Errorf("failed to cast dispute status")
+	}
+
+	*s, err = DisputeStatusFromString(v)
+	return err
+}
+
+// IsFinal returns true if the dispute status is a final state
+func (s DisputeStatus) IsFinal() bool {
+	switch s {
+	case DISPUTE_STATUS_WON, DISPUTE_STATUS_LOST, DISPUTE_STATUS_CLOSED:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,89 @@
+package models_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDisputeStatus(t *testing.T) {
+	t.Parallel()
+
+	all := []models.DisputeStatus{
+		models.DISPUTE_STATUS_INQUIRY,
+		models.DISPUTE_STATUS_NEEDS_RESPONSE,
+		models.DISPUTE_STATUS_UNDER_REVIEW,
+		models.DISPUTE_STATUS_WON,
+		models.DISPUTE_STATUS_LOST,
+		models.DISPUTE_STATUS_CLOSED,
+	}
+
+	t.Run("String", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, "UNKNOWN", models.DISPUTE_STATUS_UNKNOWN.String())
+		assert.Equal(t, "INQUIRY", models.DISPUTE_STATUS_INQUIRY.String())
+		assert.Equal(t, "NEEDS_RESPONSE", models.DISPUTE_STATUS_NEEDS_RESPONSE.String())
+		assert.Equal(t, "UNDER_REVIEW", models.DISPUTE_STATUS_UNDER_REVIEW.String())
+		assert.Equal(t, "WON", models.DISPUTE_STATUS_WON.String())
+		assert.Equal(t, "LOST", models.DISPUTE_STATUS_LOST.String())
+		assert.Equal(t, "CLOSED", models.DISPUTE_STATUS_CLOSED.String())
+		assert.Equal(t, "UNKNOWN", models.DisputeStatus(999).String())
+	})
+
+	t.Run("FromString", func(t *testing.T) {
+		t.Parallel()
+		for _, s := range all {
+			result, err := models.DisputeStatusFromString(s.String())
+			require.NoError(t, err)
+			assert.Equal(t, s, result)
+		}
+
+		result, err := models.DisputeStatusFromString("INVALID")
+		require.Error(t, err)
+		assert.Equal(t, models.DISPUTE_STATUS_UNKNOWN, result)
+	})
+
+	t.Run("MarshalJSON_UnmarshalJSON", func(t *testing.T) {
+		t.Parallel()
+		for _, s := range all {
+			data, err := json.Marshal(s)
+			require.NoError(t, err)
+
+			var result models.DisputeStatus
+			err = json.Unmarshal(data, &result)
+			require.NoError(t, err)
+			assert.Equal(t, s, result)
+		}
+	})
+
+	t.Run("Value_Scan", func(t *testing.T) {
+		t.Parallel()
+		for _, s := range all {
+			v, err := s.Value()
+			require.NoError(t, err)
+
+			var scanned models.DisputeStatus
+			err = scanned.Scan(v)
+			require.NoError(t, err)
+			assert.Equal(t, s, scanned)
+		}
+		_, err := models.DISPUTE_STATUS_UNKNOWN.Value()
+		require.Error(t, err)
+
+		var scanned models.DisputeStatus
+		require.Error(t, scanned.Scan(nil))
+	})
+
+	t.Run("IsFinal", func(t *testing.T) {
+		t.Parallel()
+		assert.False(t, models.DISPUTE_STATUS_INQUIRY.IsFinal())
+		assert.False(t, models.DISPUTE_STATUS_NEEDS_RESPONSE.IsFinal())
+		assert.False(t, models.DISPUTE_STATUS_UNDER_REVIEW.IsFinal())
+		assert.True(t, models.DISPUTE_STATUS_WON.IsFinal())
+		assert.True(t, models.DISPUTE_STATUS_LOST.IsFinal())
+		assert.True(t, models.DISPUTE_STATUS_CLOSED.IsFinal())
+	})
+}
@@ -0,0 +1,228 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/formancehq/payments/pkg/domain/assets"
+	errorsutils "github.com/formancehq/payments/pkg/domain/errors"
+)
+
+// PSPDispute represents a card dispute (inquiry or chargeback) raised by a
+// cardholder against a payment.
+type PSPDispute struct {
+	Reference string
+	CreatedAt time.Time
+
+	// Reference of the disputed payment, as returned by FetchNextPayments.
+	// Disputes are always raised against a pay-in.
+	PaymentReference *string
+
+	Amount *big.Int
+	Asset  string
+
+	Status DisputeStatus
+	// PSP reason code of the dispute (fraudulent, product_not_received,
+	// 4837...)
+	Reason string
+	// Deadline to submit evidence, if any
+	EvidenceDueDate *time.Time
+
+	Metadata map[string]string
+	Raw      json.RawMessage
+}
+
+func (d *PSPDispute) Validate() error {
+	if d.Reference == "" {
+		return errorsutils.NewWrappedError(errors.New("missing dispute reference"), ErrValidation)
+	}
+	if d.CreatedAt.IsZero() {
+		return errorsutils.NewWrappedError(errors.New("missing dispute createdAt"), ErrValidation)
+	}
+	if d.Amount == nil {
+		return errorsutils.NewWrappedError(errors.New("missing dispute amount"), ErrValidation)
+	}
+	if !assets.IsValid(d.Asset) {
+		return errorsutils.NewWrappedError(errors.New("invalid dispute asset"), ErrValidation)
+	}
+	if d.Status == DISPUTE_STATUS_UNKNOWN {
+		return errorsutils.NewWrappedError(errors.New("missing dispute status"), ErrValidation)
+	}
+	if d.Raw == nil {
+		return errorsutils.NewWrappedError(errors.New("missing dispute raw"), ErrValidation)
+	}
+	return nil
+}
+
+// Dispute represents a card dispute in Formance.
+type Dispute struct {
+	ID              DisputeID     `json:"id"`
+	ConnectorID     ConnectorID   `json:"connectorID"`
+	Reference       string        `json:"reference"`
+	CreatedAt       time.Time     `json:"createdAt"`
+	UpdatedAt       time.Time     `json:"updatedAt"`
+	PaymentID       *PaymentID    `json:"paymentID"`
+	Amount          *big.Int      `json:"amount"`
+	Asset           string        `json:"asset"`
+	Status          DisputeStatus `json:"status"`
+	Reason          string        `json:"reason"`
+	EvidenceDueDate *time.Time    `json:"evidenceDueDate,omitempty"`
+
+	Metadata map[string]string `json:"metadata"`
+	Raw      json.RawMessage   `json:"raw"`
+}
+
+func (d *Dispute) IdempotencyKey() string {
+	return IdempotencyKey(struct {
+		ID     DisputeID     `json:"ID"`
+		Status DisputeStatus `json:"Status"`
+	}{d.ID, d.Status})
+}
+
+func (d Dispute) MarshalJSON() ([]byte, error) {
+	var paymentID *string
+	if d.PaymentID != nil {
+		id := d.PaymentID.String()
+		paymentID = &id
+	}
+
+	return json.Marshal(&struct {
+		ID              string            `json:"id"`
+		ConnectorID     string            `json:"connectorID"`
+		Provider        string            `json:"provider"`
+		Reference       string            `json:"reference"`
+		CreatedAt       time.Time         `json:"createdAt"`
+		UpdatedAt       time.Time         `json:"updatedAt"`
+		PaymentID       *string           `json:"paymentID"`
+		Amount          *big.Int          `json:"amount"`
+		Asset           string            `json:"asset"`
+		Status          DisputeStatus     `json:"status"`
+		Reason          string            `json:"reason"`
+		EvidenceDueDate *time.Time        `json:"evidenceDueDate,omitempty"`
+		Metadata        map[string]string `json:"metadata"`
+		Raw             json.RawMessage   `json:"raw"`
+	}{
+		ID:              d.ID.String(),
+		ConnectorID:     d.ConnectorID.String(),
+		Provider:        ToV3Provider(d.ConnectorID.Provider),
+		Reference:       d.Reference,
+		CreatedAt:       d.CreatedAt,
+		UpdatedAt:       d.UpdatedAt,
+		PaymentID:       paymentID,
+		Amount:          d.Amount,
+		Asset:           d.Asset,
+		Status:          d.Status,
+		Reason:          d.Reason,
+		EvidenceDueDate: d.EvidenceDueDate,
+		Metadata:        d.Metadata,
+		Raw:             d.Raw,
+	})
+}
+
+func (d *Dispute) UnmarshalJSON(data []byte) error {
+	var aux struct {
+		ID              string            `json:"id"`
+		ConnectorID     string            `json:"connectorID"`
+		Reference       string            `json:"reference"`
+		CreatedAt       time.Time         `json:"createdAt"`
+		UpdatedAt       time.Time         `json:"updatedAt"`
+		PaymentID       *string           `json:"paymentID"`
+		Amount          *big.Int          `json:"amount"`
+		Asset           string            `json:"asset"`
+		Status          DisputeStatus     `json:"status"`
+		Reason          string            `json:"reason"`
+		EvidenceDueDate *time.Time        `json:"evidenceDueDate,omitempty"`
+		Metadata        map[string]string `json:"metadata"`
+		Raw             json.RawMessage   `json:"raw"`
+	}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	id, err := DisputeIDFromString(aux.ID)
+	if err != nil {
+		return err
+	}
+
+	connectorID, err := ConnectorIDFromString(aux.ConnectorID)
+	if err != nil {
+		return err
+	}
+
+	d.PaymentID = nil
+	if aux.PaymentID != nil {
+		paymentID, err := PaymentIDFromString(*aux.PaymentID)
+		if err != nil {
+			return err
+		}
+		d.PaymentID = &paymentID
+	}
+
+	d.ID = id
+	d.ConnectorID = connectorID
+	d.Reference = aux.Reference
+	d.CreatedAt = aux.CreatedAt
+	d.UpdatedAt = aux.UpdatedAt
+	d.Amount = aux.Amount
+	d.Asset = aux.Asset
+	d.Status = aux.Status
+	d.Reason = aux.Reason
+	d.EvidenceDueDate = aux.EvidenceDueDate
+	d.Metadata = aux.Metadata
+	d.Raw = aux.Raw
+
+	return nil
+}
+
+// FromPSPDisputeToDispute converts a PSPDispute to a Dispute
+func FromPSPDisputeToDispute(from PSPDispute, connectorID ConnectorID) (Dispute, error) {
+	if err := from.Validate(); err != nil {
+		return Dispute{}, err
+	}
+
+	var paymentID *PaymentID
+	if from.PaymentReference != nil && *from.PaymentReference != "" {
+		paymentID = &PaymentID{
+			PaymentReference: PaymentReference{
+				Reference: *from.PaymentReference,
+				Type:      PAYMENT_TYPE_PAYIN,
+			},
+			ConnectorID: connectorID,
+		}
+	}
+
+	return Dispute{
+		ID: DisputeID{
+			Reference:   from.Reference,
+			ConnectorID: connectorID,
+		},
+		ConnectorID:     connectorID,
+		Reference:       from.Reference,
+		CreatedAt:       from.CreatedAt,
+		UpdatedAt:       time.Now().UTC(),
+		PaymentID:       paymentID,
+		Amount:          from.Amount,
+		Asset:           from.Asset,
+		Status:          from.Status,
+		Reason:          from.Reason,
+		EvidenceDueDate: from.EvidenceDueDate,
+		Metadata:        from.Metadata,
+		Raw:             from.Raw,
+	}, nil
+}
+
+// FromPSPDisputes converts a slice of PSPDisputes to Disputes
+func FromPSPDisputes(from []PSPDispute, connectorID ConnectorID) ([]Dispute, error) {
+	disputes := make([]Dispute, 0, len(from))
+	for _, d := range from {
+		dispute, err := FromPSPDisputeToDispute(d, connectorID)
+		if err != nil {
+			return nil, err
+		}
+		disputes = append(disputes, dispute)
+	}
+	return disputes, nil
+}
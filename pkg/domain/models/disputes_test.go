@@ -0,0 +1,180 @@
+package models_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validPSPDispute() models.PSPDispute {
+	return models.PSPDispute{
+		Reference:        "dp-ref-1",
+		CreatedAt:        time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		PaymentReference: pointer.For("txn_1"),
+		Amount:           big.NewInt(1000),
+		Asset:            "USD/2",
+		Status:           models.DISPUTE_STATUS_NEEDS_RESPONSE,
+		Reason:           "fraudulent",
+		EvidenceDueDate:  pointer.For(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)),
+		Metadata:         map[string]string{"k": "v"},
+		Raw:              json.RawMessage(`{"raw":"ok"}`),
+	}
+}
+
+func TestPSPDisputeValidate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+		d := validPSPDispute()
+		assert.NoError(t, d.Validate())
+	})
+
+	cases := []struct {
+		name   string
+		mutate func(*models.PSPDispute)
+		errMsg string
+	}{
+		{"missing reference", func(d *models.PSPDispute) { d.Reference = "" }, "missing dispute reference"},
+		{"missing createdAt", func(d *models.PSPDispute) { d.CreatedAt = time.Time{} }, "missing dispute createdAt"},
+		{"missing amount", func(d *models.PSPDispute) { d.Amount = nil }, "missing dispute amount"},
+		{"invalid asset", func(d *models.PSPDispute) { d.Asset = "nope" }, "invalid dispute asset"},
+		{"missing status", func(d *models.PSPDispute) { d.Status = models.DISPUTE_STATUS_UNKNOWN }, "missing dispute status"},
+		{"missing raw", func(d *models.PSPDispute) { d.Raw = nil }, "missing dispute raw"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			d := validPSPDispute()
+			tc.mutate(&d)
+			err := d.Validate()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.errMsg)
+		})
+	}
+}
+
+func TestFromPSPDisputeToDispute(t *testing.T) {
+	t.Parallel()
+	connectorID := newConnectorID(t)
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+		psp := validPSPDispute()
+		dispute, err := models.FromPSPDisputeToDispute(psp, connectorID)
+		require.NoError(t, err)
+
+		assert.Equal(t, psp.Reference, dispute.Reference)
+		assert.Equal(t, psp.CreatedAt, dispute.CreatedAt)
+		assert.Equal(t, psp.Amount, dispute.Amount)
+		assert.Equal(t, psp.Asset, dispute.Asset)
+		assert.Equal(t, psp.Status, dispute.Status)
+		assert.Equal(t, psp.Reason, dispute.Reason)
+		assert.Equal(t, psp.EvidenceDueDate, dispute.EvidenceDueDate)
+		assert.Equal(t, connectorID, dispute.ConnectorID)
+		assert.False(t, dispute.UpdatedAt.IsZero())
+
+		require.NotNil(t, dispute.PaymentID)
+		assert.Equal(t, "txn_1", dispute.PaymentID.Reference)
+		assert.Equal(t, models.PAYMENT_TYPE_PAYIN, dispute.PaymentID.Type)
+		assert.Equal(t, connectorID, dispute.PaymentID.ConnectorID)
+	})
+
+	t.Run("nil payment reference -> nil payment id", func(t *testing.T) {
+		t.Parallel()
+		psp := validPSPDispute()
+		psp.PaymentReference = nil
+
+		dispute, err := models.FromPSPDisputeToDispute(psp, connectorID)
+		require.NoError(t, err)
+		assert.Nil(t, dispute.PaymentID)
+	})
+
+	t.Run("invalid dispute returns error", func(t *testing.T) {
+		t.Parallel()
+		psp := validPSPDispute()
+		psp.Reference = ""
+		_, err := models.FromPSPDisputeToDispute(psp, connectorID)
+		require.Error(t, err)
+	})
+
+	t.Run("idempotency key changes with status", func(t *testing.T) {
+		t.Parallel()
+		psp := validPSPDispute()
+		first, err := models.FromPSPDisputeToDispute(psp, connectorID)
+		require.NoError(t, err)
+
+		psp.Status = models.DISPUTE_STATUS_UNDER_REVIEW
+		second, err := models.FromPSPDisputeToDispute(psp, connectorID)
+		require.NoError(t, err)
+
+		assert.NotEqual(t, first.IdempotencyKey(), second.IdempotencyKey())
+	})
+}
+
+func TestFromPSPDisputes(t *testing.T) {
+	t.Parallel()
+	connectorID := newConnectorID(t)
+
+	a := validPSPDispute()
+	a.Reference = "a"
+	b := validPSPDispute()
+	b.Reference = "b"
+
+	disputes, err := models.FromPSPDisputes([]models.PSPDispute{a, b}, connectorID)
+	require.NoError(t, err)
+	require.Len(t, disputes, 2)
+	assert.Equal(t, "a", disputes[0].Reference)
+	assert.Equal(t, "b", disputes[1].Reference)
+
+	bad := validPSPDispute()
+	bad.Reference = ""
+	_, err = models.FromPSPDisputes([]models.PSPDispute{bad}, connectorID)
+	require.Error(t, err)
+}
+
+func TestDisputeMarshalUnmarshal(t *testing.T) {
+	t.Parallel()
+	connectorID := newConnectorID(t)
+
+	dispute, err := models.FromPSPDisputeToDispute(validPSPDispute(), connectorID)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(dispute)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"provider":`)
+	assert.Contains(t, string(data), `"status":"NEEDS_RESPONSE"`)
+
+	var decoded models.Dispute
+	require.NoError(t, json.Unmarshal(data, &decoded))
+
+	assert.Equal(t, dispute.ID, decoded.ID)
+	assert.Equal(t, dispute.ConnectorID, decoded.ConnectorID)
+	assert.Equal(t, dispute.Amount, decoded.Amount)
+	assert.Equal(t, dispute.Status, decoded.Status)
+	assert.Equal(t, dispute.Reason, decoded.Reason)
+	assert.Equal(t, dispute.PaymentID, decoded.PaymentID)
+	require.NotNil(t, decoded.EvidenceDueDate)
+	assert.True(t, dispute.EvidenceDueDate.Equal(*decoded.EvidenceDueDate))
+
+	t.Run("invalid payment id", func(t *testing.T) {
+		t.Parallel()
+		payload := map[string]any{
+			"id":          dispute.ID.String(),
+			"connectorID": connectorID.String(),
+			"paymentID":   "invalid-payment-id",
+		}
+		raw, err := json.Marshal(payload)
+		require.NoError(t, err)
+
+		var d models.Dispute
+		require.Error(t, json.Unmarshal(raw, &d))
+	})
+}
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// FetchWindow bounds a fetch to objects created in [From, To). It is only set
+// when backfilling a connector. Plugins able to filter on creation date use it
+// to list less objects, the engine drops the ones created out of it anyway.
+type FetchWindow struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+}
+
+func (w FetchWindow) Validate() error {
+	if w.From.IsZero() {
+		return fmt.Errorf("missing from: %w", ErrValidation)
+	}
+
+	if w.To.IsZero() {
+		return fmt.Errorf("missing to: %w", ErrValidation)
+	}
+
+	if !w.From.Before(w.To) {
+		return fmt.Errorf("from must be before to: %w", ErrValidation)
+	}
+
+	return nil
+}
+
+func (w FetchWindow) Contains(t time.Time) bool {
+	return !t.Before(w.From) && t.Before(w.To)
+}
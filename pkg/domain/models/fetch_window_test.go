@@ -0,0 +1,37 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchWindow(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Validate", func(t *testing.T) {
+		t.Parallel()
+
+		require.NoError(t, models.FetchWindow{From: from, To: to}.Validate())
+		require.ErrorIs(t, models.FetchWindow{To: to}.Validate(), models.ErrValidation)
+		require.ErrorIs(t, models.FetchWindow{From: from}.Validate(), models.ErrValidation)
+		require.ErrorIs(t, models.FetchWindow{From: to, To: from}.Validate(), models.ErrValidation)
+		require.ErrorIs(t, models.FetchWindow{From: from, To: from}.Validate(), models.ErrValidation)
+	})
+
+	t.Run("Contains", func(t *testing.T) {
+		t.Parallel()
+
+		w := models.FetchWindow{From: from, To: to}
+		assert.True(t, w.Contains(from))
+		assert.True(t, w.Contains(from.Add(time.Hour)))
+		assert.False(t, w.Contains(to))
+		assert.False(t, w.Contains(from.Add(-time.Second)))
+	})
+}
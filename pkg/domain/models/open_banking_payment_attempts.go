@@ -0,0 +1,111 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// When a user initiates a payment via a link, we will create an attempt in
+// order to save some crucial information and to be able to match the
+// provider's webhooks with the related payment initiation.
+type OpenBankingPaymentAttempt struct {
+	// ID of the attempt
+	ID uuid.UUID `json:"id"`
+	// ID of the psu
+	PsuID uuid.UUID `json:"psuID"`
+	// Related connector ID
+	ConnectorID ConnectorID `json:"connectorID"`
+	// Related payment initiation ID
+	PaymentInitiationID PaymentInitiationID `json:"paymentInitiationID"`
+	// Creation date of the attempt
+	CreatedAt time.Time `json:"createdAt"`
+	// Status of the attempt
+	Status OpenBankingConnectionAttemptStatus `json:"status"`
+	// State given to the url in order to be able to verify that the callback
+	// is valid.
+	State CallbackState `json:"state"`
+	// Client redirect URL, given by the user
+	ClientRedirectURL *string `json:"clientRedirectURL"`
+
+	// Optional
+	// Reference of the payment request on the provider
+	PaymentRequestReference *string `json:"paymentRequestReference"`
+	// Link given to the payment service user to authorize the payment
+	Link *string `json:"link"`
+	// Error message in case of failure
+	Error *string `json:"error"`
+}
+
+func (a OpenBankingPaymentAttempt) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		ID                      uuid.UUID     `json:"id"`
+		PsuID                   uuid.UUID     `json:"psuID"`
+		ConnectorID             string        `json:"connectorID"`
+		PaymentInitiationID     string        `json:"paymentInitiationID"`
+		CreatedAt               time.Time     `json:"createdAt"`
+		Status                  string        `json:"status"`
+		State                   CallbackState `json:"state"`
+		ClientRedirectURL       *string       `json:"clientRedirectURL,omitempty"`
+		PaymentRequestReference *string       `json:"paymentRequestReference,omitempty"`
+		Link                    *string       `json:"link,omitempty"`
+		Error                   *string       `json:"error,omitempty"`
+	}{
+		ID:                      a.ID,
+		PsuID:                   a.PsuID,
+		ConnectorID:             a.ConnectorID.String(),
+		PaymentInitiationID:     a.PaymentInitiationID.String(),
+		CreatedAt:               a.CreatedAt,
+		Status:                  string(a.Status),
+		State:                   a.State,
+		ClientRedirectURL:       a.ClientRedirectURL,
+		PaymentRequestReference: a.PaymentRequestReference,
+		Link:                    a.Link,
+		Error:                   a.Error,
+	})
+}
+
+func (a *OpenBankingPaymentAttempt) UnmarshalJSON(data []byte) error {
+	var aux struct {
+		ID                      uuid.UUID     `json:"id"`
+		PsuID                   uuid.UUID     `json:"psuID"`
+		ConnectorID             string        `json:"connectorID"`
+		PaymentInitiationID     string        `json:"paymentInitiationID"`
+		CreatedAt               time.Time     `json:"createdAt"`
+		Status                  string        `json:"status"`
+		State                   CallbackState `json:"state"`
+		ClientRedirectURL       *string       `json:"clientRedirectURL,omitempty"`
+		PaymentRequestReference *string       `json:"paymentRequestReference,omitempty"`
+		Link                    *string       `json:"link,omitempty"`
+		Error                   *string       `json:"error,omitempty"`
+	}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	connectorID, err := ConnectorIDFromString(aux.ConnectorID)
+	if err != nil {
+		return err
+	}
+
+	piID, err := PaymentInitiationIDFromString(aux.PaymentInitiationID)
+	if err != nil {
+		return err
+	}
+
+	a.ID = aux.ID
+	a.PsuID = aux.PsuID
+	a.ConnectorID = connectorID
+	a.PaymentInitiationID = piID
+	a.CreatedAt = aux.CreatedAt
+	a.Status = OpenBankingConnectionAttemptStatus(aux.Status)
+	a.State = aux.State
+	a.ClientRedirectURL = aux.ClientRedirectURL
+	a.PaymentRequestReference = aux.PaymentRequestReference
+	a.Link = aux.Link
+	a.Error = aux.Error
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package models
+
+import (
+	"encoding/json"
+	"math/big"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+)
+
+// PaymentAggregate sums the payments of a group. Amounts of different assets
+// are never summed together: the asset is always part of the group. The other
+// group fields are only set when the payments were grouped by them.
+type PaymentAggregate struct {
+	Asset                string
+	Status               *PaymentStatus
+	Type                 *PaymentType
+	Scheme               *PaymentScheme
+	ConnectorID          *ConnectorID
+	SourceAccountID      *AccountID
+	DestinationAccountID *AccountID
+	// Start of the time bucket, when grouped by an interval.
+	Bucket *time.Time
+
+	Count         int64
+	Amount        *big.Int
+	InitialAmount *big.Int
+}
+
+func (a PaymentAggregate) MarshalJSON() ([]byte, error) {
+	var connectorID, sourceAccountID, destinationAccountID *string
+	if a.ConnectorID != nil {
+		connectorID = pointer.For(a.ConnectorID.String())
+	}
+	if a.SourceAccountID != nil {
+		sourceAccountID = pointer.For(a.SourceAccountID.String())
+	}
+	if a.DestinationAccountID != nil {
+		destinationAccountID = pointer.For(a.DestinationAccountID.String())
+	}
+
+	return json.Marshal(&struct {
+		Asset                string         `json:"asset"`
+		Status               *PaymentStatus `json:"status,omitempty"`
+		Type                 *PaymentType   `json:"type,omitempty"`
+		Scheme               *PaymentScheme `json:"scheme,omitempty"`
+		ConnectorID          *string        `json:"connectorID,omitempty"`
+		SourceAccountID      *string        `json:"sourceAccountID,omitempty"`
+		DestinationAccountID *string        `json:"destinationAccountID,omitempty"`
+		Bucket               *time.Time     `json:"bucket,omitempty"`
+		Count                int64          `json:"count"`
+		Amount               *big.Int       `json:"amount"`
+		InitialAmount        *big.Int       `json:"initialAmount"`
+	}{
+		Asset:                a.Asset,
+		Status:               a.Status,
+		Type:                 a.Type,
+		Scheme:               a.Scheme,
+		ConnectorID:          connectorID,
+		SourceAccountID:      sourceAccountID,
+		DestinationAccountID: destinationAccountID,
+		Bucket:               a.Bucket,
+		Count:                a.Count,
+		Amount:               a.Amount,
+		InitialAmount:        a.InitialAmount,
+	})
+}
@@ -0,0 +1,57 @@
+package models_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentAggregateMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	connectorID := models.ConnectorID{Provider: "stripe", Reference: uuid.New()}
+
+	t.Run("grouped by asset only", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := json.Marshal(models.PaymentAggregate{
+			Asset:         "USD/2",
+			Count:         2,
+			Amount:        big.NewInt(300),
+			InitialAmount: big.NewInt(350),
+		})
+		require.NoError(t, err)
+		require.JSONEq(t, `{"asset":"USD/2","count":2,"amount":300,"initialAmount":350}`, string(data))
+	})
+
+	t.Run("grouped by status, connector and day", func(t *testing.T) {
+		t.Parallel()
+
+		bucket := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+		data, err := json.Marshal(models.PaymentAggregate{
+			Asset:         "EUR/2",
+			Status:        pointer.For(models.PAYMENT_STATUS_SUCCEEDED),
+			ConnectorID:   &connectorID,
+			Bucket:        &bucket,
+			Count:         1,
+			Amount:        big.NewInt(100),
+			InitialAmount: big.NewInt(100),
+		})
+		require.NoError(t, err)
+		require.JSONEq(t, `{
+			"asset":"EUR/2",
+			"status":"SUCCEEDED",
+			"connectorID":"`+connectorID.String()+`",
+			"bucket":"2024-01-02T00:00:00Z",
+			"count":1,
+			"amount":100,
+			"initialAmount":100
+		}`, string(data))
+	})
+}
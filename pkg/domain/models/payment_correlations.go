@@ -0,0 +1,205 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	errorsutils "github.com/formancehq/payments/pkg/domain/errors"
+	"github.com/google/uuid"
+)
+
+// PaymentCorrelationRule links the payments of different connectors which
+// are the same economic payment, e.g. a payout made on one PSP and received
+// as a payin on a bank account of another one. Two payments match a rule
+// when all its criteria match.
+type PaymentCorrelationRule struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Payments must have the same reference.
+	MatchReference bool `json:"matchReference"`
+	// Payments must have the same initial amount and asset.
+	MatchAmount bool `json:"matchAmount"`
+	// Payments must have the same value for each of these metadata keys.
+	MetadataKeys []string `json:"metadataKeys"`
+	// Maximum duration between the creation dates of the payments, no limit
+	// when zero.
+	DateWindow time.Duration `json:"dateWindow"`
+}
+
+func (r PaymentCorrelationRule) Validate() error {
+	if r.Name == "" {
+		return errorsutils.NewWrappedError(errors.New("missing correlation rule name"), ErrValidation)
+	}
+
+	if !r.MatchReference && !r.MatchAmount && len(r.MetadataKeys) == 0 {
+		return errorsutils.NewWrappedError(errors.New("correlation rule must match at least the reference, the amount or a metadata key"), ErrValidation)
+	}
+
+	for _, key := range r.MetadataKeys {
+		if key == "" {
+			return errorsutils.NewWrappedError(errors.New("empty correlation rule metadata key"), ErrValidation)
+		}
+	}
+
+	if r.DateWindow < 0 {
+		return errorsutils.NewWrappedError(errors.New("negative correlation rule date window"), ErrValidation)
+	}
+
+	return nil
+}
+
+func (r PaymentCorrelationRule) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		ID             string    `json:"id"`
+		Name           string    `json:"name"`
+		CreatedAt      time.Time `json:"createdAt"`
+		MatchReference bool      `json:"matchReference"`
+		MatchAmount    bool      `json:"matchAmount"`
+		MetadataKeys   []string  `json:"metadataKeys"`
+		DateWindow     string    `json:"dateWindow,omitempty"`
+	}{
+		ID:             r.ID.String(),
+		Name:           r.Name,
+		CreatedAt:      r.CreatedAt,
+		MatchReference: r.MatchReference,
+		MatchAmount:    r.MatchAmount,
+		MetadataKeys:   r.MetadataKeys,
+		DateWindow: func() string {
+			if r.DateWindow == 0 {
+				return ""
+			}
+			return r.DateWindow.String()
+		}(),
+	})
+}
+
+func (r *PaymentCorrelationRule) UnmarshalJSON(data []byte) error {
+	var aux struct {
+		ID             uuid.UUID `json:"id"`
+		Name           string    `json:"name"`
+		CreatedAt      time.Time `json:"createdAt"`
+		MatchReference bool      `json:"matchReference"`
+		MatchAmount    bool      `json:"matchAmount"`
+		MetadataKeys   []string  `json:"metadataKeys"`
+		DateWindow     string    `json:"dateWindow"`
+	}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var dateWindow time.Duration
+	if aux.DateWindow != "" {
+		var err error
+		dateWindow, err = time.ParseDuration(aux.DateWindow)
+		if err != nil {
+			return err
+		}
+	}
+
+	r.ID = aux.ID
+	r.Name = aux.Name
+	r.CreatedAt = aux.CreatedAt
+	r.MatchReference = aux.MatchReference
+	r.MatchAmount = aux.MatchAmount
+	r.MetadataKeys = aux.MetadataKeys
+	r.DateWindow = dateWindow
+
+	return nil
+}
+
+// Matches tells whether the two payments are the same economic payment
+// according to the rule. Payments of the same connector never match, they are
+// already linked by their parent reference.
+func (r PaymentCorrelationRule) Matches(p1, p2 Payment) bool {
+	if p1.ConnectorID == p2.ConnectorID {
+		return false
+	}
+
+	if r.MatchReference && p1.Reference != p2.Reference {
+		return false
+	}
+
+	if r.MatchAmount {
+		if p1.Asset != p2.Asset || p1.InitialAmount == nil || p2.InitialAmount == nil ||
+			p1.InitialAmount.Cmp(p2.InitialAmount) != 0 {
+			return false
+		}
+	}
+
+	for _, key := range r.MetadataKeys {
+		v, ok := p1.Metadata[key]
+		if !ok || v != p2.Metadata[key] {
+			return false
+		}
+	}
+
+	if r.DateWindow > 0 {
+		diff := p1.CreatedAt.Sub(p2.CreatedAt)
+		if diff < 0 {
+			diff = -diff
+		}
+		if diff > r.DateWindow {
+			return false
+		}
+	}
+
+	return true
+}
+
+// PaymentCorrelation is the group of payments, across connectors, a payment
+// was linked to by the correlation rules.
+type PaymentCorrelation struct {
+	GroupID uuid.UUID `json:"groupID"`
+	// Other payments of the group.
+	Payments []PaymentCorrelationLink `json:"payments"`
+}
+
+type PaymentCorrelationLink struct {
+	PaymentID PaymentID `json:"paymentID"`
+	// Rule which added the payment to the group, nil if it was deleted since.
+	RuleID    *uuid.UUID `json:"ruleID"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
+
+func (l PaymentCorrelationLink) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		PaymentID   string     `json:"paymentID"`
+		ConnectorID string     `json:"connectorID"`
+		Provider    string     `json:"provider"`
+		RuleID      *uuid.UUID `json:"ruleID"`
+		CreatedAt   time.Time  `json:"createdAt"`
+	}{
+		PaymentID:   l.PaymentID.String(),
+		ConnectorID: l.PaymentID.ConnectorID.String(),
+		Provider:    ToV3Provider(l.PaymentID.ConnectorID.Provider),
+		RuleID:      l.RuleID,
+		CreatedAt:   l.CreatedAt,
+	})
+}
+
+func (l *PaymentCorrelationLink) UnmarshalJSON(data []byte) error {
+	var aux struct {
+		PaymentID string     `json:"paymentID"`
+		RuleID    *uuid.UUID `json:"ruleID"`
+		CreatedAt time.Time  `json:"createdAt"`
+	}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	id, err := PaymentIDFromString(aux.PaymentID)
+	if err != nil {
+		return err
+	}
+
+	l.PaymentID = id
+	l.RuleID = aux.RuleID
+	l.CreatedAt = aux.CreatedAt
+
+	return nil
+}
@@ -0,0 +1,190 @@
+package models_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentCorrelationRuleValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		rule    models.PaymentCorrelationRule
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			rule: models.PaymentCorrelationRule{Name: "wise to bc", MatchAmount: true, DateWindow: 72 * time.Hour},
+		},
+		{
+			name:    "missing name",
+			rule:    models.PaymentCorrelationRule{MatchReference: true},
+			wantErr: true,
+		},
+		{
+			name:    "no criteria",
+			rule:    models.PaymentCorrelationRule{Name: "test", DateWindow: time.Hour},
+			wantErr: true,
+		},
+		{
+			name:    "empty metadata key",
+			rule:    models.PaymentCorrelationRule{Name: "test", MetadataKeys: []string{""}},
+			wantErr: true,
+		},
+		{
+			name:    "negative date window",
+			rule:    models.PaymentCorrelationRule{Name: "test", MatchReference: true, DateWindow: -time.Hour},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := test.rule.Validate()
+			if test.wantErr {
+				require.ErrorIs(t, err, models.ErrValidation)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPaymentCorrelationRuleMatches(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	payment := func(provider, reference string, amount int64, createdAt time.Time) models.Payment {
+		connectorID := models.ConnectorID{Provider: provider, Reference: uuid.MustParse("a2a84a6b-6d2c-4fa5-b4c9-3b0f2c4e0b11")}
+		return models.Payment{
+			ID: models.PaymentID{
+				PaymentReference: models.PaymentReference{Reference: reference, Type: models.PAYMENT_TYPE_PAYOUT},
+				ConnectorID:      connectorID,
+			},
+			ConnectorID:   connectorID,
+			Reference:     reference,
+			CreatedAt:     createdAt,
+			InitialAmount: big.NewInt(amount),
+			Asset:         "EUR/2",
+			Metadata:      map[string]string{"end_to_end_id": "e2e-" + reference},
+		}
+	}
+
+	payout := payment("wise", "ref1", 100, now)
+
+	tests := []struct {
+		name  string
+		rule  models.PaymentCorrelationRule
+		other models.Payment
+		match bool
+	}{
+		{
+			name:  "same connector",
+			rule:  models.PaymentCorrelationRule{MatchAmount: true},
+			other: payment("wise", "ref2", 100, now),
+		},
+		{
+			name:  "amount within date window",
+			rule:  models.PaymentCorrelationRule{MatchAmount: true, DateWindow: 48 * time.Hour},
+			other: payment("bankingcircle", "ref2", 100, now.Add(24*time.Hour)),
+			match: true,
+		},
+		{
+			name:  "amount outside date window",
+			rule:  models.PaymentCorrelationRule{MatchAmount: true, DateWindow: 48 * time.Hour},
+			other: payment("bankingcircle", "ref2", 100, now.Add(-72*time.Hour)),
+		},
+		{
+			name:  "different amount",
+			rule:  models.PaymentCorrelationRule{MatchAmount: true},
+			other: payment("bankingcircle", "ref2", 101, now),
+		},
+		{
+			name:  "different reference",
+			rule:  models.PaymentCorrelationRule{MatchReference: true, MatchAmount: true},
+			other: payment("bankingcircle", "ref2", 100, now),
+		},
+		{
+			name:  "same metadata",
+			rule:  models.PaymentCorrelationRule{MetadataKeys: []string{"end_to_end_id"}},
+			other: payment("bankingcircle", "ref1", 5, now),
+			match: true,
+		},
+		{
+			name:  "different metadata",
+			rule:  models.PaymentCorrelationRule{MetadataKeys: []string{"end_to_end_id"}},
+			other: payment("bankingcircle", "ref2", 100, now),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, test.match, test.rule.Matches(payout, test.other))
+			require.Equal(t, test.match, test.rule.Matches(test.other, payout))
+		})
+	}
+}
+
+func TestPaymentCorrelationJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("rule", func(t *testing.T) {
+		t.Parallel()
+
+		rule := models.PaymentCorrelationRule{
+			ID:           uuid.New(),
+			Name:         "test",
+			CreatedAt:    time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			MatchAmount:  true,
+			MetadataKeys: []string{"end_to_end_id"},
+			DateWindow:   72 * time.Hour,
+		}
+
+		data, err := json.Marshal(rule)
+		require.NoError(t, err)
+		require.Contains(t, string(data), `"dateWindow":"72h0m0s"`)
+
+		var res models.PaymentCorrelationRule
+		require.NoError(t, json.Unmarshal(data, &res))
+		require.Equal(t, rule, res)
+	})
+
+	t.Run("payment correlation", func(t *testing.T) {
+		t.Parallel()
+
+		connectorID := models.ConnectorID{Provider: "wise", Reference: uuid.New()}
+		correlation := models.PaymentCorrelation{
+			GroupID: uuid.New(),
+			Payments: []models.PaymentCorrelationLink{
+				{
+					PaymentID: models.PaymentID{
+						PaymentReference: models.PaymentReference{Reference: "ref1", Type: models.PAYMENT_TYPE_PAYOUT},
+						ConnectorID:      connectorID,
+					},
+					RuleID:    pointer.For(uuid.New()),
+					CreatedAt: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		}
+
+		data, err := json.Marshal(correlation)
+		require.NoError(t, err)
+		require.Contains(t, string(data), `"provider":"wise"`)
+
+		var res models.PaymentCorrelation
+		require.NoError(t, json.Unmarshal(data, &res))
+		require.Equal(t, correlation, res)
+	})
+}
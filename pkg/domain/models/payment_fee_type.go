@@ -0,0 +1,105 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+type PaymentFeeType int
+
+const (
+	PAYMENT_FEE_TYPE_UNKNOWN PaymentFeeType = iota
+	// Fee charged by the PSP for processing the payment
+	PAYMENT_FEE_TYPE_PROCESSING
+	// Fee charged by the network: blockchain fees, card scheme fees...
+	PAYMENT_FEE_TYPE_NETWORK
+	// Fee charged on a currency conversion or a trade
+	PAYMENT_FEE_TYPE_EXCHANGE
+	// Fee taken by a platform on top of the PSP fees, e.g. an application fee
+	PAYMENT_FEE_TYPE_PLATFORM
+	PAYMENT_FEE_TYPE_TAX
+	PAYMENT_FEE_TYPE_OTHER
+)
+
+func (t PaymentFeeType) String() string {
+	switch t {
+	case PAYMENT_FEE_TYPE_PROCESSING:
+		return "PROCESSING"
+	case PAYMENT_FEE_TYPE_NETWORK:
+		return "NETWORK"
+	case PAYMENT_FEE_TYPE_EXCHANGE:
+		return "EXCHANGE"
+	case PAYMENT_FEE_TYPE_PLATFORM:
+		return "PLATFORM"
+	case PAYMENT_FEE_TYPE_TAX:
+		return "TAX"
+	case PAYMENT_FEE_TYPE_OTHER:
+		return "OTHER"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+func PaymentFeeTypeFromString(s string) (PaymentFeeType, error) {
+	switch s {
+	case "PROCESSING":
+		return PAYMENT_FEE_TYPE_PROCESSING, nil
+	case "NETWORK":
+		return PAYMENT_FEE_TYPE_NETWORK, nil
+	case "EXCHANGE":
+		return PAYMENT_FEE_TYPE_EXCHANGE, nil
+	case "PLATFORM":
+		return PAYMENT_FEE_TYPE_PLATFORM, nil
+	case "TAX":
+		return PAYMENT_FEE_TYPE_TAX, nil
+	case "OTHER":
+		return PAYMENT_FEE_TYPE_OTHER, nil
+	case "UNKNOWN":
+		return PAYMENT_FEE_TYPE_UNKNOWN, nil
+	default:
+		return PAYMENT_FEE_TYPE_UNKNOWN, fmt.Errorf("unknown payment fee type: %s", s)
+	}
+}
+
+func (t PaymentFeeType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+func (t *PaymentFeeType) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	var err error
+	*t, err = PaymentFeeTypeFromString(s)
+	return err
+}
+
+func (t PaymentFeeType) Value() (driver.Value, error) {
+	if t == PAYMENT_FEE_TYPE_UNKNOWN {
+		return nil, fmt.Errorf("unknown payment fee type")
+	}
+	return t.String(), nil
+}
+
+func (t *PaymentFeeType) Scan(value interface{}) error {
+	if value == nil {
+		return errors.New("payment fee type is nil")
+	}
+
+	s, err := driver.String.ConvertValue(value)
+	if err != nil {
+		return fmt.Errorf("failed to convert payment fee type")
+	}
+
+	v, ok := s.(string)
+	if !ok {
+		return fmt.Errorf("failed to cast payment fee type")
+	}
+
+	*t, err = PaymentFeeTypeFromString(v)
+	return err
+}
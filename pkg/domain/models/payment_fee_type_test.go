@@ -0,0 +1,77 @@
+package models_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentFeeType(t *testing.T) {
+	t.Parallel()
+
+	allTypes := []struct {
+		typ models.PaymentFeeType
+		str string
+	}{
+		{models.PAYMENT_FEE_TYPE_UNKNOWN, "UNKNOWN"},
+		{models.PAYMENT_FEE_TYPE_PROCESSING, "PROCESSING"},
+		{models.PAYMENT_FEE_TYPE_NETWORK, "NETWORK"},
+		{models.PAYMENT_FEE_TYPE_EXCHANGE, "EXCHANGE"},
+		{models.PAYMENT_FEE_TYPE_PLATFORM, "PLATFORM"},
+		{models.PAYMENT_FEE_TYPE_TAX, "TAX"},
+		{models.PAYMENT_FEE_TYPE_OTHER, "OTHER"},
+	}
+
+	t.Run("String", func(t *testing.T) {
+		t.Parallel()
+		for _, tc := range allTypes {
+			assert.Equal(t, tc.str, tc.typ.String())
+		}
+		assert.Equal(t, "UNKNOWN", models.PaymentFeeType(999).String())
+	})
+
+	t.Run("FromString", func(t *testing.T) {
+		t.Parallel()
+		for _, tc := range allTypes {
+			result, err := models.PaymentFeeTypeFromString(tc.str)
+			require.NoError(t, err)
+			assert.Equal(t, tc.typ, result)
+		}
+		_, err := models.PaymentFeeTypeFromString("INVALID_TYPE")
+		require.Error(t, err)
+	})
+
+	t.Run("MarshalJSON_UnmarshalJSON", func(t *testing.T) {
+		t.Parallel()
+		for _, tc := range allTypes[1:] { // skip UNKNOWN
+			data, err := json.Marshal(tc.typ)
+			require.NoError(t, err)
+
+			var result models.PaymentFeeType
+			err = json.Unmarshal(data, &result)
+			require.NoError(t, err)
+			assert.Equal(t, tc.typ, result)
+		}
+	})
+
+	t.Run("Value_Scan", func(t *testing.T) {
+		t.Parallel()
+		for _, tc := range allTypes[1:] { // skip UNKNOWN
+			v, err := tc.typ.Value()
+			require.NoError(t, err)
+
+			var scanned models.PaymentFeeType
+			err = scanned.Scan(v)
+			require.NoError(t, err)
+			assert.Equal(t, tc.typ, scanned)
+		}
+		_, err := models.PAYMENT_FEE_TYPE_UNKNOWN.Value()
+		require.Error(t, err)
+
+		var scanned models.PaymentFeeType
+		require.Error(t, scanned.Scan(nil))
+	})
+}
@@ -0,0 +1,60 @@
+package models
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/formancehq/payments/pkg/domain/assets"
+	errorsutils "github.com/formancehq/payments/pkg/domain/errors"
+)
+
+// PaymentFee is a fee charged by the PSP on a payment. Fees are never
+// subtracted from the payment amount, they are reported next to it.
+type PaymentFee struct {
+	// Reference of the PSP object the fee was charged on, e.g. the
+	// adjustment or the fee transaction. Defaults to the reference of the
+	// PSP payment it was reported with.
+	Reference string `json:"reference"`
+
+	// Type of fee: processing, network, exchange etc...
+	Type PaymentFeeType `json:"type"`
+
+	// Fee amount, always positive.
+	Amount *big.Int `json:"amount"`
+
+	// Currency. Should be in minor currencies unit. It can differ from the
+	// payment asset, e.g. when the fee is charged in the settlement currency.
+	Asset string `json:"asset"`
+}
+
+func (f *PaymentFee) Validate() error {
+	if f.Type == PAYMENT_FEE_TYPE_UNKNOWN {
+		return errorsutils.NewWrappedError(errors.New("missing payment fee type"), ErrValidation)
+	}
+
+	if f.Amount == nil || f.Amount.Sign() < 0 {
+		return errorsutils.NewWrappedError(errors.New("invalid payment fee amount"), ErrValidation)
+	}
+
+	if !assets.IsValid(f.Asset) {
+		return errorsutils.NewWrappedError(errors.New("invalid payment fee asset"), ErrValidation)
+	}
+
+	return nil
+}
+
+func fromPSPPaymentToPaymentFees(from PSPPayment) []PaymentFee {
+	if len(from.Fees) == 0 {
+		return nil
+	}
+
+	fees := make([]PaymentFee, 0, len(from.Fees))
+	for _, f := range from.Fees {
+		fee := f
+		if fee.Reference == "" {
+			fee.Reference = from.Reference
+		}
+		fees = append(fees, fee)
+	}
+	return fees
+}
@@ -0,0 +1,106 @@
+package models_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentFeeValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		fee     models.PaymentFee
+		wantErr string
+	}{
+		{
+			name: "valid fee",
+			fee: models.PaymentFee{
+				Type:   models.PAYMENT_FEE_TYPE_NETWORK,
+				Amount: big.NewInt(10),
+				Asset:  "BTC/8",
+			},
+		},
+		{
+			name: "zero fee",
+			fee: models.PaymentFee{
+				Type:   models.PAYMENT_FEE_TYPE_PROCESSING,
+				Amount: big.NewInt(0),
+				Asset:  "USD/2",
+			},
+		},
+		{
+			name: "missing type",
+			fee: models.PaymentFee{
+				Amount: big.NewInt(10),
+				Asset:  "USD/2",
+			},
+			wantErr: "missing payment fee type",
+		},
+		{
+			name: "missing amount",
+			fee: models.PaymentFee{
+				Type:  models.PAYMENT_FEE_TYPE_PROCESSING,
+				Asset: "USD/2",
+			},
+			wantErr: "invalid payment fee amount",
+		},
+		{
+			name: "negative amount",
+			fee: models.PaymentFee{
+				Type:   models.PAYMENT_FEE_TYPE_PROCESSING,
+				Amount: big.NewInt(-10),
+				Asset:  "USD/2",
+			},
+			wantErr: "invalid payment fee amount",
+		},
+		{
+			name: "invalid asset",
+			fee: models.PaymentFee{
+				Type:   models.PAYMENT_FEE_TYPE_PROCESSING,
+				Amount: big.NewInt(10),
+				Asset:  "invalid",
+			},
+			wantErr: "invalid payment fee asset",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.fee.Validate()
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.ErrorIs(t, err, models.ErrValidation)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestPaymentFeeMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	fee := models.PaymentFee{
+		Reference: "txn_123",
+		Type:      models.PAYMENT_FEE_TYPE_EXCHANGE,
+		Amount:    big.NewInt(125),
+		Asset:     "EUR/2",
+	}
+
+	data, err := json.Marshal(fee)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"reference":"txn_123","type":"EXCHANGE","amount":125,"asset":"EUR/2"}`, string(data))
+
+	var actual models.PaymentFee
+	require.NoError(t, json.Unmarshal(data, &actual))
+	require.Equal(t, fee, actual)
+}
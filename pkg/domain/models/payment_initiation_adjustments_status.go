@@ -21,6 +21,7 @@ const (
 	PAYMENT_INITIATION_ADJUSTMENT_STATUS_REVERSE_FAILED
 	PAYMENT_INITIATION_ADJUSTMENT_STATUS_REVERSED
 	PAYMENT_INITIATION_ADJUSTMENT_STATUS_SCHEDULED_FOR_PROCESSING
+	PAYMENT_INITIATION_ADJUSTMENT_STATUS_PENDING_SCREENING
 )
 
 func (s PaymentInitiationAdjustmentStatus) String() string {
@@ -43,6 +44,8 @@ func (s PaymentInitiationAdjustmentStatus) String() string {
 		return "REVERSED"
 	case PAYMENT_INITIATION_ADJUSTMENT_STATUS_SCHEDULED_FOR_PROCESSING:
 		return "SCHEDULED_FOR_PROCESSING"
+	case PAYMENT_INITIATION_ADJUSTMENT_STATUS_PENDING_SCREENING:
+		return "PENDING_SCREENING"
 	case PAYMENT_INITIATION_ADJUSTMENT_STATUS_UNKNOWN:
 		return "UNKNOWN"
 	}
@@ -69,6 +72,8 @@ func PaymentInitiationAdjustmentStatusFromString(s string) (PaymentInitiationAdj
 		return PAYMENT_INITIATION_ADJUSTMENT_STATUS_REVERSED, nil
 	case "SCHEDULED_FOR_PROCESSING":
 		return PAYMENT_INITIATION_ADJUSTMENT_STATUS_SCHEDULED_FOR_PROCESSING, nil
+	case "PENDING_SCREENING":
+		return PAYMENT_INITIATION_ADJUSTMENT_STATUS_PENDING_SCREENING, nil
 	case "UNKNOWN":
 		return PAYMENT_INITIATION_ADJUSTMENT_STATUS_UNKNOWN, nil
 	}
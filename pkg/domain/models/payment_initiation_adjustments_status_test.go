@@ -29,6 +29,7 @@ func TestPaymentInitiationAdjustmentStatus(t *testing.T) {
 			{models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_REVERSE_FAILED, "REVERSE_FAILED"},
 			{models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_REVERSED, "REVERSED"},
 			{models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_SCHEDULED_FOR_PROCESSING, "SCHEDULED_FOR_PROCESSING"},
+			{models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PENDING_SCREENING, "PENDING_SCREENING"},
 			{models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_UNKNOWN, "UNKNOWN"},
 		}
 
@@ -59,6 +60,7 @@ func TestPaymentInitiationAdjustmentStatus(t *testing.T) {
 			{"REVERSE_FAILED", models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_REVERSE_FAILED, false},
 			{"REVERSED", models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_REVERSED, false},
 			{"SCHEDULED_FOR_PROCESSING", models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_SCHEDULED_FOR_PROCESSING, false},
+			{"PENDING_SCREENING", models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_PENDING_SCREENING, false},
 			{"UNKNOWN", models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_UNKNOWN, false},
 			{"invalid", models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_UNKNOWN, true},
 			{"", models.PAYMENT_INITIATION_ADJUSTMENT_STATUS_UNKNOWN, true},
@@ -0,0 +1,58 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// PaymentInitiationLimitAction is what happens to a payment initiation
+// exceeding a limit.
+type PaymentInitiationLimitAction int
+
+const (
+	PAYMENT_INITIATION_LIMIT_ACTION_UNKNOWN PaymentInitiationLimitAction = iota
+	// The payment initiation is refused.
+	PAYMENT_INITIATION_LIMIT_ACTION_REJECT
+	// The payment initiation is not sent to the connector and waits for a
+	// manual approval.
+	PAYMENT_INITIATION_LIMIT_ACTION_REQUIRE_APPROVAL
+)
+
+func (a PaymentInitiationLimitAction) String() string {
+	switch a {
+	case PAYMENT_INITIATION_LIMIT_ACTION_REJECT:
+		return "REJECT"
+	case PAYMENT_INITIATION_LIMIT_ACTION_REQUIRE_APPROVAL:
+		return "REQUIRE_APPROVAL"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+func PaymentInitiationLimitActionFromString(s string) (PaymentInitiationLimitAction, error) {
+	switch s {
+	case "REJECT":
+		return PAYMENT_INITIATION_LIMIT_ACTION_REJECT, nil
+	case "REQUIRE_APPROVAL":
+		return PAYMENT_INITIATION_LIMIT_ACTION_REQUIRE_APPROVAL, nil
+	case "UNKNOWN":
+		return PAYMENT_INITIATION_LIMIT_ACTION_UNKNOWN, nil
+	default:
+		return PAYMENT_INITIATION_LIMIT_ACTION_UNKNOWN, fmt.Errorf("unknown payment initiation limit action: %s", s)
+	}
+}
+
+func (a PaymentInitiationLimitAction) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.String())
+}
+
+func (a *PaymentInitiationLimitAction) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	var err error
+	*a, err = PaymentInitiationLimitActionFromString(s)
+	return err
+}
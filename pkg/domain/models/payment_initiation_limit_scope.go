@@ -0,0 +1,58 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// PaymentInitiationLimitScope tells what the usage counters of a limit are
+// kept for, e.g. a daily amount limit with the SOURCE_ACCOUNT scope caps the
+// total of every source account separately.
+type PaymentInitiationLimitScope int
+
+const (
+	PAYMENT_INITIATION_LIMIT_SCOPE_UNKNOWN PaymentInitiationLimitScope = iota
+	PAYMENT_INITIATION_LIMIT_SCOPE_CONNECTOR
+	// Payment initiations without source account are not subject to limits
+	// with this scope.
+	PAYMENT_INITIATION_LIMIT_SCOPE_SOURCE_ACCOUNT
+)
+
+func (s PaymentInitiationLimitScope) String() string {
+	switch s {
+	case PAYMENT_INITIATION_LIMIT_SCOPE_CONNECTOR:
+		return "CONNECTOR"
+	case PAYMENT_INITIATION_LIMIT_SCOPE_SOURCE_ACCOUNT:
+		return "SOURCE_ACCOUNT"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+func PaymentInitiationLimitScopeFromString(s string) (PaymentInitiationLimitScope, error) {
+	switch s {
+	case "CONNECTOR":
+		return PAYMENT_INITIATION_LIMIT_SCOPE_CONNECTOR, nil
+	case "SOURCE_ACCOUNT":
+		return PAYMENT_INITIATION_LIMIT_SCOPE_SOURCE_ACCOUNT, nil
+	case "UNKNOWN":
+		return PAYMENT_INITIATION_LIMIT_SCOPE_UNKNOWN, nil
+	default:
+		return PAYMENT_INITIATION_LIMIT_SCOPE_UNKNOWN, fmt.Errorf("unknown payment initiation limit scope: %s", s)
+	}
+}
+
+func (s PaymentInitiationLimitScope) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+func (s *PaymentInitiationLimitScope) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+
+	var err error
+	*s, err = PaymentInitiationLimitScopeFromString(str)
+	return err
+}
@@ -0,0 +1,90 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type PaymentInitiationLimitType int
+
+const (
+	PAYMENT_INITIATION_LIMIT_TYPE_UNKNOWN PaymentInitiationLimitType = iota
+	// Maximum amount of a single payment initiation.
+	PAYMENT_INITIATION_LIMIT_TYPE_MAX_AMOUNT
+	// Maximum total amount of the payment initiations of a day (UTC).
+	PAYMENT_INITIATION_LIMIT_TYPE_DAILY_AMOUNT
+	// Maximum total amount of the payment initiations of a month (UTC).
+	PAYMENT_INITIATION_LIMIT_TYPE_MONTHLY_AMOUNT
+	// Maximum number of payment initiations of an hour.
+	PAYMENT_INITIATION_LIMIT_TYPE_HOURLY_COUNT
+)
+
+func (t PaymentInitiationLimitType) String() string {
+	switch t {
+	case PAYMENT_INITIATION_LIMIT_TYPE_MAX_AMOUNT:
+		return "MAX_AMOUNT"
+	case PAYMENT_INITIATION_LIMIT_TYPE_DAILY_AMOUNT:
+		return "DAILY_AMOUNT"
+	case PAYMENT_INITIATION_LIMIT_TYPE_MONTHLY_AMOUNT:
+		return "MONTHLY_AMOUNT"
+	case PAYMENT_INITIATION_LIMIT_TYPE_HOURLY_COUNT:
+		return "HOURLY_COUNT"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+func PaymentInitiationLimitTypeFromString(s string) (PaymentInitiationLimitType, error) {
+	switch s {
+	case "MAX_AMOUNT":
+		return PAYMENT_INITIATION_LIMIT_TYPE_MAX_AMOUNT, nil
+	case "DAILY_AMOUNT":
+		return PAYMENT_INITIATION_LIMIT_TYPE_DAILY_AMOUNT, nil
+	case "MONTHLY_AMOUNT":
+		return PAYMENT_INITIATION_LIMIT_TYPE_MONTHLY_AMOUNT, nil
+	case "HOURLY_COUNT":
+		return PAYMENT_INITIATION_LIMIT_TYPE_HOURLY_COUNT, nil
+	case "UNKNOWN":
+		return PAYMENT_INITIATION_LIMIT_TYPE_UNKNOWN, nil
+	default:
+		return PAYMENT_INITIATION_LIMIT_TYPE_UNKNOWN, fmt.Errorf("unknown payment initiation limit type: %s", s)
+	}
+}
+
+func (t PaymentInitiationLimitType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+func (t *PaymentInitiationLimitType) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	var err error
+	*t, err = PaymentInitiationLimitTypeFromString(s)
+	return err
+}
+
+// IsCount returns true if the limit counts payment initiations instead of
+// summing their amounts.
+func (t PaymentInitiationLimitType) IsCount() bool {
+	return t == PAYMENT_INITIATION_LIMIT_TYPE_HOURLY_COUNT
+}
+
+// WindowStart returns the start of the window the usage counters of the limit
+// are kept for at the given time, zero for limits without counters.
+func (t PaymentInitiationLimitType) WindowStart(at time.Time) time.Time {
+	at = at.UTC()
+	switch t {
+	case PAYMENT_INITIATION_LIMIT_TYPE_DAILY_AMOUNT:
+		return time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, time.UTC)
+	case PAYMENT_INITIATION_LIMIT_TYPE_MONTHLY_AMOUNT:
+		return time.Date(at.Year(), at.Month(), 1, 0, 0, 0, 0, time.UTC)
+	case PAYMENT_INITIATION_LIMIT_TYPE_HOURLY_COUNT:
+		return at.Truncate(time.Hour)
+	default:
+		return time.Time{}
+	}
+}
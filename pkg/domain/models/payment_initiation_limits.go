@@ -0,0 +1,234 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	errorsutils "github.com/formancehq/payments/pkg/domain/errors"
+	"github.com/google/uuid"
+)
+
+// PaymentInitiationLimit is a guardrail checked when payment initiations are
+// created or approved, before they are sent to the connector.
+type PaymentInitiationLimit struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	Type PaymentInitiationLimitType `json:"type"`
+	// What the usage counters are kept for, ignored by MAX_AMOUNT limits.
+	Scope  PaymentInitiationLimitScope  `json:"scope"`
+	Action PaymentInitiationLimitAction `json:"action"`
+
+	// Only the payment initiations of this connector are subject to the
+	// limit, all connectors when nil.
+	ConnectorID *ConnectorID `json:"connectorID"`
+	// Asset of the amount limits. Count limits only count the payment
+	// initiations of this asset when set.
+	Asset string `json:"asset"`
+
+	// Required by amount limits.
+	MaxAmount *big.Int `json:"maxAmount"`
+	// Required by count limits.
+	MaxCount int `json:"maxCount"`
+}
+
+func (l PaymentInitiationLimit) Validate() error {
+	if l.Name == "" {
+		return errorsutils.NewWrappedError(errors.New("missing limit name"), ErrValidation)
+	}
+
+	if l.Action == PAYMENT_INITIATION_LIMIT_ACTION_UNKNOWN {
+		return errorsutils.NewWrappedError(errors.New("missing limit action"), ErrValidation)
+	}
+
+	switch l.Type {
+	case PAYMENT_INITIATION_LIMIT_TYPE_UNKNOWN:
+		return errorsutils.NewWrappedError(errors.New("missing limit type"), ErrValidation)
+	case PAYMENT_INITIATION_LIMIT_TYPE_MAX_AMOUNT:
+	default:
+		if l.Scope == PAYMENT_INITIATION_LIMIT_SCOPE_UNKNOWN {
+			return errorsutils.NewWrappedError(errors.New("missing limit scope"), ErrValidation)
+		}
+	}
+
+	if l.Type.IsCount() {
+		if l.MaxCount <= 0 {
+			return errorsutils.NewWrappedError(errors.New("limit max count must be positive"), ErrValidation)
+		}
+		return nil
+	}
+
+	if l.Asset == "" {
+		return errorsutils.NewWrappedError(errors.New("missing limit asset"), ErrValidation)
+	}
+
+	if l.MaxAmount == nil || l.MaxAmount.Sign() < 0 {
+		return errorsutils.NewWrappedError(errors.New("limit max amount must not be negative"), ErrValidation)
+	}
+
+	return nil
+}
+
+// Applies tells whether the payment initiation is subject to the limit.
+func (l PaymentInitiationLimit) Applies(pi PaymentInitiation) bool {
+	if l.ConnectorID != nil && *l.ConnectorID != pi.ConnectorID {
+		return false
+	}
+
+	if l.Asset != "" && l.Asset != pi.Asset {
+		return false
+	}
+
+	if l.Type != PAYMENT_INITIATION_LIMIT_TYPE_MAX_AMOUNT &&
+		l.Scope == PAYMENT_INITIATION_LIMIT_SCOPE_SOURCE_ACCOUNT &&
+		pi.SourceAccountID == nil {
+		return false
+	}
+
+	return true
+}
+
+// ScopeKey returns the key of the usage counter of the payment initiation.
+func (l PaymentInitiationLimit) ScopeKey(pi PaymentInitiation) string {
+	switch l.Scope {
+	case PAYMENT_INITIATION_LIMIT_SCOPE_SOURCE_ACCOUNT:
+		if pi.SourceAccountID == nil {
+			return ""
+		}
+		return pi.SourceAccountID.String()
+	default:
+		return pi.ConnectorID.String()
+	}
+}
+
+// Exceeds tells whether adding the payment initiation to the usage of its
+// window goes over the limit.
+func (l PaymentInitiationLimit) Exceeds(pi PaymentInitiation, usage PaymentInitiationLimitUsage) bool {
+	if l.Type.IsCount() {
+		return usage.Count+1 > l.MaxCount
+	}
+
+	total := new(big.Int)
+	if pi.Amount != nil {
+		total.Set(pi.Amount)
+	}
+	if l.Type != PAYMENT_INITIATION_LIMIT_TYPE_MAX_AMOUNT && usage.Amount != nil {
+		total.Add(total, usage.Amount)
+	}
+
+	return total.Cmp(l.MaxAmount) > 0
+}
+
+func (l PaymentInitiationLimit) MarshalJSON() ([]byte, error) {
+	var connectorID *string
+	if l.ConnectorID != nil {
+		connectorID = pointer.For(l.ConnectorID.String())
+	}
+
+	return json.Marshal(&struct {
+		ID          string                       `json:"id"`
+		Name        string                       `json:"name"`
+		CreatedAt   time.Time                    `json:"createdAt"`
+		Type        PaymentInitiationLimitType   `json:"type"`
+		Scope       PaymentInitiationLimitScope  `json:"scope"`
+		Action      PaymentInitiationLimitAction `json:"action"`
+		ConnectorID *string                      `json:"connectorID,omitempty"`
+		Asset       string                       `json:"asset,omitempty"`
+		MaxAmount   *big.Int                     `json:"maxAmount,omitempty"`
+		MaxCount    int                          `json:"maxCount,omitempty"`
+	}{
+		ID:          l.ID.String(),
+		Name:        l.Name,
+		CreatedAt:   l.CreatedAt,
+		Type:        l.Type,
+		Scope:       l.Scope,
+		Action:      l.Action,
+		ConnectorID: connectorID,
+		Asset:       l.Asset,
+		MaxAmount:   l.MaxAmount,
+		MaxCount:    l.MaxCount,
+	})
+}
+
+func (l *PaymentInitiationLimit) UnmarshalJSON(data []byte) error {
+	var aux struct {
+		ID          uuid.UUID                    `json:"id"`
+		Name        string                       `json:"name"`
+		CreatedAt   time.Time                    `json:"createdAt"`
+		Type        PaymentInitiationLimitType   `json:"type"`
+		Scope       PaymentInitiationLimitScope  `json:"scope"`
+		Action      PaymentInitiationLimitAction `json:"action"`
+		ConnectorID *string                      `json:"connectorID"`
+		Asset       string                       `json:"asset"`
+		MaxAmount   *big.Int                     `json:"maxAmount"`
+		MaxCount    int                          `json:"maxCount"`
+	}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var connectorID *ConnectorID
+	if aux.ConnectorID != nil {
+		id, err := ConnectorIDFromString(*aux.ConnectorID)
+		if err != nil {
+			return err
+		}
+		connectorID = &id
+	}
+
+	l.ID = aux.ID
+	l.Name = aux.Name
+	l.CreatedAt = aux.CreatedAt
+	l.Type = aux.Type
+	l.Scope = aux.Scope
+	l.Action = aux.Action
+	l.ConnectorID = connectorID
+	l.Asset = aux.Asset
+	l.MaxAmount = aux.MaxAmount
+	l.MaxCount = aux.MaxCount
+
+	return nil
+}
+
+// PaymentInitiationLimitUsage is the usage counter of a limit for a scope
+// key and a window.
+type PaymentInitiationLimitUsage struct {
+	LimitID uuid.UUID `json:"limitID"`
+	// ID of the connector or of the source account, depending on the scope
+	// of the limit.
+	ScopeKey    string    `json:"scopeKey"`
+	WindowStart time.Time `json:"windowStart"`
+	Amount      *big.Int  `json:"amount"`
+	Count       int       `json:"count"`
+}
+
+// PaymentInitiationLimitUpdate holds the fields of a limit which can be
+// updated, nil fields are left unchanged.
+type PaymentInitiationLimitUpdate struct {
+	Name      *string
+	Action    *PaymentInitiationLimitAction
+	MaxAmount *big.Int
+	MaxCount  *int
+}
+
+// Apply returns the limit with the update applied.
+func (u PaymentInitiationLimitUpdate) Apply(l PaymentInitiationLimit) PaymentInitiationLimit {
+	if u.Name != nil {
+		l.Name = *u.Name
+	}
+	if u.Action != nil {
+		l.Action = *u.Action
+	}
+	if u.MaxAmount != nil {
+		l.MaxAmount = u.MaxAmount
+	}
+	if u.MaxCount != nil {
+		l.MaxCount = *u.MaxCount
+	}
+	return l
+}
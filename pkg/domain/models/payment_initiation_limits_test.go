@@ -0,0 +1,230 @@
+package models_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentInitiationLimitEnums(t *testing.T) {
+	t.Parallel()
+
+	for _, typ := range []models.PaymentInitiationLimitType{
+		models.PAYMENT_INITIATION_LIMIT_TYPE_MAX_AMOUNT,
+		models.PAYMENT_INITIATION_LIMIT_TYPE_DAILY_AMOUNT,
+		models.PAYMENT_INITIATION_LIMIT_TYPE_MONTHLY_AMOUNT,
+		models.PAYMENT_INITIATION_LIMIT_TYPE_HOURLY_COUNT,
+	} {
+		res, err := models.PaymentInitiationLimitTypeFromString(typ.String())
+		require.NoError(t, err)
+		require.Equal(t, typ, res)
+	}
+	_, err := models.PaymentInitiationLimitTypeFromString("WEEKLY_AMOUNT")
+	require.Error(t, err)
+
+	for _, scope := range []models.PaymentInitiationLimitScope{
+		models.PAYMENT_INITIATION_LIMIT_SCOPE_CONNECTOR,
+		models.PAYMENT_INITIATION_LIMIT_SCOPE_SOURCE_ACCOUNT,
+	} {
+		res, err := models.PaymentInitiationLimitScopeFromString(scope.String())
+		require.NoError(t, err)
+		require.Equal(t, scope, res)
+	}
+	_, err = models.PaymentInitiationLimitScopeFromString("ASSET")
+	require.Error(t, err)
+
+	for _, action := range []models.PaymentInitiationLimitAction{
+		models.PAYMENT_INITIATION_LIMIT_ACTION_REJECT,
+		models.PAYMENT_INITIATION_LIMIT_ACTION_REQUIRE_APPROVAL,
+	} {
+		res, err := models.PaymentInitiationLimitActionFromString(action.String())
+		require.NoError(t, err)
+		require.Equal(t, action, res)
+	}
+	_, err = models.PaymentInitiationLimitActionFromString("WARN")
+	require.Error(t, err)
+}
+
+func TestPaymentInitiationLimitTypeWindowStart(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2024, 3, 15, 13, 45, 12, 0, time.UTC)
+	require.True(t, models.PAYMENT_INITIATION_LIMIT_TYPE_MAX_AMOUNT.WindowStart(at).IsZero())
+	require.Equal(t, time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC), models.PAYMENT_INITIATION_LIMIT_TYPE_DAILY_AMOUNT.WindowStart(at))
+	require.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), models.PAYMENT_INITIATION_LIMIT_TYPE_MONTHLY_AMOUNT.WindowStart(at))
+	require.Equal(t, time.Date(2024, 3, 15, 13, 0, 0, 0, time.UTC), models.PAYMENT_INITIATION_LIMIT_TYPE_HOURLY_COUNT.WindowStart(at))
+}
+
+func TestPaymentInitiationLimitValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		limit   models.PaymentInitiationLimit
+		wantErr bool
+	}{
+		{
+			name: "valid max amount",
+			limit: models.PaymentInitiationLimit{
+				Name: "max", Type: models.PAYMENT_INITIATION_LIMIT_TYPE_MAX_AMOUNT,
+				Action: models.PAYMENT_INITIATION_LIMIT_ACTION_REJECT, Asset: "EUR/2", MaxAmount: big.NewInt(100),
+			},
+		},
+		{
+			name: "valid hourly count",
+			limit: models.PaymentInitiationLimit{
+				Name: "velocity", Type: models.PAYMENT_INITIATION_LIMIT_TYPE_HOURLY_COUNT,
+				Scope: models.PAYMENT_INITIATION_LIMIT_SCOPE_CONNECTOR, Action: models.PAYMENT_INITIATION_LIMIT_ACTION_REQUIRE_APPROVAL, MaxCount: 10,
+			},
+		},
+		{
+			name: "missing name",
+			limit: models.PaymentInitiationLimit{
+				Type:   models.PAYMENT_INITIATION_LIMIT_TYPE_MAX_AMOUNT,
+				Action: models.PAYMENT_INITIATION_LIMIT_ACTION_REJECT, Asset: "EUR/2", MaxAmount: big.NewInt(100),
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing scope",
+			limit: models.PaymentInitiationLimit{
+				Name: "daily", Type: models.PAYMENT_INITIATION_LIMIT_TYPE_DAILY_AMOUNT,
+				Action: models.PAYMENT_INITIATION_LIMIT_ACTION_REJECT, Asset: "EUR/2", MaxAmount: big.NewInt(100),
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing asset",
+			limit: models.PaymentInitiationLimit{
+				Name: "daily", Type: models.PAYMENT_INITIATION_LIMIT_TYPE_DAILY_AMOUNT, Scope: models.PAYMENT_INITIATION_LIMIT_SCOPE_CONNECTOR,
+				Action: models.PAYMENT_INITIATION_LIMIT_ACTION_REJECT, MaxAmount: big.NewInt(100),
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing max amount",
+			limit: models.PaymentInitiationLimit{
+				Name: "max", Type: models.PAYMENT_INITIATION_LIMIT_TYPE_MAX_AMOUNT,
+				Action: models.PAYMENT_INITIATION_LIMIT_ACTION_REJECT, Asset: "EUR/2",
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing max count",
+			limit: models.PaymentInitiationLimit{
+				Name: "velocity", Type: models.PAYMENT_INITIATION_LIMIT_TYPE_HOURLY_COUNT,
+				Scope: models.PAYMENT_INITIATION_LIMIT_SCOPE_CONNECTOR, Action: models.PAYMENT_INITIATION_LIMIT_ACTION_REJECT,
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing action",
+			limit: models.PaymentInitiationLimit{
+				Name: "max", Type: models.PAYMENT_INITIATION_LIMIT_TYPE_MAX_AMOUNT, Asset: "EUR/2", MaxAmount: big.NewInt(100),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			err := test.limit.Validate()
+			if test.wantErr {
+				require.ErrorIs(t, err, models.ErrValidation)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPaymentInitiationLimitApplies(t *testing.T) {
+	t.Parallel()
+
+	connectorID := models.ConnectorID{Reference: uuid.New(), Provider: "wise"}
+	otherConnectorID := models.ConnectorID{Reference: uuid.New(), Provider: "wise"}
+	sourceAccountID := models.AccountID{Reference: "acc1", ConnectorID: connectorID}
+	pi := models.PaymentInitiation{
+		ConnectorID:     connectorID,
+		SourceAccountID: &sourceAccountID,
+		Amount:          big.NewInt(100),
+		Asset:           "EUR/2",
+	}
+	limit := models.PaymentInitiationLimit{
+		Type:        models.PAYMENT_INITIATION_LIMIT_TYPE_DAILY_AMOUNT,
+		Scope:       models.PAYMENT_INITIATION_LIMIT_SCOPE_SOURCE_ACCOUNT,
+		ConnectorID: &connectorID,
+		Asset:       "EUR/2",
+	}
+
+	require.True(t, limit.Applies(pi))
+	require.Equal(t, sourceAccountID.String(), limit.ScopeKey(pi))
+
+	otherConnector := limit
+	otherConnector.ConnectorID = &otherConnectorID
+	require.False(t, otherConnector.Applies(pi))
+
+	otherAsset := pi
+	otherAsset.Asset = "USD/2"
+	require.False(t, limit.Applies(otherAsset))
+
+	noSourceAccount := pi
+	noSourceAccount.SourceAccountID = nil
+	require.False(t, limit.Applies(noSourceAccount))
+
+	connectorScope := limit
+	connectorScope.Scope = models.PAYMENT_INITIATION_LIMIT_SCOPE_CONNECTOR
+	require.True(t, connectorScope.Applies(noSourceAccount))
+	require.Equal(t, connectorID.String(), connectorScope.ScopeKey(noSourceAccount))
+}
+
+func TestPaymentInitiationLimitExceeds(t *testing.T) {
+	t.Parallel()
+
+	pi := models.PaymentInitiation{Amount: big.NewInt(100), Asset: "EUR/2"}
+
+	max := models.PaymentInitiationLimit{Type: models.PAYMENT_INITIATION_LIMIT_TYPE_MAX_AMOUNT, MaxAmount: big.NewInt(100)}
+	require.False(t, max.Exceeds(pi, models.PaymentInitiationLimitUsage{}))
+	max.MaxAmount = big.NewInt(99)
+	require.True(t, max.Exceeds(pi, models.PaymentInitiationLimitUsage{}))
+
+	daily := models.PaymentInitiationLimit{Type: models.PAYMENT_INITIATION_LIMIT_TYPE_DAILY_AMOUNT, MaxAmount: big.NewInt(250)}
+	require.False(t, daily.Exceeds(pi, models.PaymentInitiationLimitUsage{Amount: big.NewInt(150)}))
+	require.True(t, daily.Exceeds(pi, models.PaymentInitiationLimitUsage{Amount: big.NewInt(151)}))
+
+	hourly := models.PaymentInitiationLimit{Type: models.PAYMENT_INITIATION_LIMIT_TYPE_HOURLY_COUNT, MaxCount: 2}
+	require.False(t, hourly.Exceeds(pi, models.PaymentInitiationLimitUsage{Count: 1}))
+	require.True(t, hourly.Exceeds(pi, models.PaymentInitiationLimitUsage{Count: 2}))
+}
+
+func TestPaymentInitiationLimitJSON(t *testing.T) {
+	t.Parallel()
+
+	connectorID := models.ConnectorID{Reference: uuid.New(), Provider: "wise"}
+	limit := models.PaymentInitiationLimit{
+		ID:          uuid.New(),
+		Name:        "daily",
+		CreatedAt:   time.Now().UTC().Truncate(time.Second),
+		Type:        models.PAYMENT_INITIATION_LIMIT_TYPE_DAILY_AMOUNT,
+		Scope:       models.PAYMENT_INITIATION_LIMIT_SCOPE_CONNECTOR,
+		Action:      models.PAYMENT_INITIATION_LIMIT_ACTION_REQUIRE_APPROVAL,
+		ConnectorID: &connectorID,
+		Asset:       "EUR/2",
+		MaxAmount:   big.NewInt(1000),
+	}
+
+	data, err := json.Marshal(limit)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"connectorID":"`+connectorID.String()+`"`)
+	require.Contains(t, string(data), `"type":"DAILY_AMOUNT"`)
+
+	var res models.PaymentInitiationLimit
+	require.NoError(t, json.Unmarshal(data, &res))
+	require.Equal(t, limit, res)
+}
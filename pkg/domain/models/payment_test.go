@@ -110,6 +110,19 @@ func TestFromPSPPaymentToPayment(t *testing.T) {
 			Scheme:                      models.PAYMENT_SCHEME_OTHER,
 			Status:                      models.PAYMENT_STATUS_SUCCEEDED,
 			DestinationAccountReference: pointer.For("acc"),
+			Fees: []models.PaymentFee{
+				{
+					Type:   models.PAYMENT_FEE_TYPE_PROCESSING,
+					Amount: big.NewInt(3),
+					Asset:  "EUR/2",
+				},
+				{
+					Reference: "fee_reference",
+					Type:      models.PAYMENT_FEE_TYPE_TAX,
+					Amount:    big.NewInt(1),
+					Asset:     "EUR/2",
+				},
+			},
 			Metadata: map[string]string{
 				"foo": "bar",
 			},
@@ -143,6 +156,20 @@ func TestFromPSPPaymentToPayment(t *testing.T) {
 				Reference:   "acc",
 				ConnectorID: connectorID,
 			},
+			Fees: []models.PaymentFee{
+				{
+					Reference: "test1",
+					Type:      models.PAYMENT_FEE_TYPE_PROCESSING,
+					Amount:    big.NewInt(3),
+					Asset:     "EUR/2",
+				},
+				{
+					Reference: "fee_reference",
+					Type:      models.PAYMENT_FEE_TYPE_TAX,
+					Amount:    big.NewInt(1),
+					Asset:     "EUR/2",
+				},
+			},
 			Metadata: map[string]string{
 				"foo": "bar",
 			},
@@ -206,6 +233,8 @@ func comparePayment(t *testing.T, expected, actual models.Payment) {
 		require.Equal(t, v, actual.Metadata[k])
 	}
 
+	require.Equal(t, expected.Fees, actual.Fees)
+
 	compareAdjustments(t, expected.Adjustments, actual.Adjustments)
 }
 
@@ -335,6 +364,14 @@ func TestPaymentMarshalUnmarshal(t *testing.T) {
 		Metadata: map[string]string{
 			"key": "value",
 		},
+		Fees: []models.PaymentFee{
+			{
+				Reference: "adj123",
+				Type:      models.PAYMENT_FEE_TYPE_PROCESSING,
+				Amount:    big.NewInt(2),
+				Asset:     "USD/2",
+			},
+		},
 		Adjustments: []models.PaymentAdjustment{
 			{
 				ID: models.PaymentAdjustmentID{
@@ -528,6 +565,35 @@ func TestPSPPaymentValidate(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "missing payment raw: validation error")
 	})
+
+	t.Run("invalid fee", func(t *testing.T) {
+		t.Parallel()
+
+		// Given
+		payment := models.PSPPayment{
+			Reference: "payment123",
+			CreatedAt: now,
+			Type:      models.PAYMENT_TYPE_PAYIN,
+			Amount:    big.NewInt(100),
+			Asset:     "USD/2",
+			Scheme:    models.PAYMENT_SCHEME_OTHER,
+			Status:    models.PAYMENT_STATUS_SUCCEEDED,
+			Fees: []models.PaymentFee{
+				{
+					Type:   models.PAYMENT_FEE_TYPE_PROCESSING,
+					Amount: big.NewInt(-1),
+					Asset:  "USD/2",
+				},
+			},
+			Raw: []byte(`{}`),
+		}
+
+		err := payment.Validate()
+
+		// Then
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid payment fee amount: validation error")
+	})
 }
 
 func TestPSPPaymentHasParent(t *testing.T) {
@@ -33,6 +33,9 @@ type PSPPayment struct {
 	// Payment amount (gross). Do not subtract PSP fees from this value.
 	Amount *big.Int
 
+	// Optional, fees charged by the PSP on this payment.
+	Fees []PaymentFee
+
 	// Currency. Should be in minor currencies unit.
 	// For example: USD/2
 	Asset string
@@ -93,6 +96,12 @@ func (p *PSPPayment) Validate() error {
 		return errorsutils.NewWrappedError(errors.New("missing payment raw"), ErrValidation)
 	}
 
+	for i := range p.Fees {
+		if err := p.Fees[i].Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -138,12 +147,22 @@ type Payment struct {
 	PsuID *uuid.UUID `json:"psuID"`
 	// Optional, can be filled if the payment is related to an open banking connector
 	OpenBankingConnectionID *string `json:"openBankingConnectionID"`
+	// Optional, counterparty of the external account of the payment, filled
+	// once the external account is linked to a counterparty
+	CounterpartyID *uuid.UUID `json:"counterpartyID"`
 
 	// Additional metadata
 	Metadata map[string]string `json:"metadata"`
 
+	// Fees charged by the PSP on this payment
+	Fees []PaymentFee `json:"fees"`
+
 	// Related adjustment
 	Adjustments []PaymentAdjustment `json:"adjustments"`
+
+	// Optional, payments of other connectors linked to this one by the
+	// correlation rules
+	Correlation *PaymentCorrelation `json:"correlation,omitempty"`
 }
 
 func (p Payment) MarshalJSON() ([]byte, error) {
@@ -163,8 +182,11 @@ func (p Payment) MarshalJSON() ([]byte, error) {
 		DestinationAccountID    *string             `json:"destinationAccountID"`
 		PsuID                   *string             `json:"psuID,omitempty"`
 		OpenBankingConnectionID *string             `json:"openBankingConnectionID,omitempty"`
+		CounterpartyID          *string             `json:"counterpartyID,omitempty"`
 		Metadata                map[string]string   `json:"metadata"`
+		Fees                    []PaymentFee        `json:"fees"`
 		Adjustments             []PaymentAdjustment `json:"adjustments"`
+		Correlation             *PaymentCorrelation `json:"correlation,omitempty"`
 	}{
 		ID:            p.ID.String(),
 		ConnectorID:   p.ConnectorID.String(),
@@ -196,8 +218,16 @@ func (p Payment) MarshalJSON() ([]byte, error) {
 			return pointer.For(p.PsuID.String())
 		}(),
 		OpenBankingConnectionID: p.OpenBankingConnectionID,
-		Metadata:                p.Metadata,
-		Adjustments:             p.Adjustments,
+		CounterpartyID: func() *string {
+			if p.CounterpartyID == nil {
+				return nil
+			}
+			return pointer.For(p.CounterpartyID.String())
+		}(),
+		Metadata:    p.Metadata,
+		Fees:        p.Fees,
+		Adjustments: p.Adjustments,
+		Correlation: p.Correlation,
 	})
 }
 
@@ -218,8 +248,11 @@ func (c *Payment) UnmarshalJSON(data []byte) error {
 		DestinationAccountID    *string             `json:"destinationAccountID"`
 		PsuID                   *string             `json:"psuID,omitempty"`
 		OpenBankingConnectionID *string             `json:"openBankingConnectionID,omitempty"`
+		CounterpartyID          *string             `json:"counterpartyID,omitempty"`
 		Metadata                map[string]string   `json:"metadata"`
+		Fees                    []PaymentFee        `json:"fees"`
 		Adjustments             []PaymentAdjustment `json:"adjustments"`
+		Correlation             *PaymentCorrelation `json:"correlation,omitempty"`
 	}
 
 	if err := json.Unmarshal(data, &aux); err != nil {
@@ -270,6 +303,15 @@ func (c *Payment) UnmarshalJSON(data []byte) error {
 		c.OpenBankingConnectionID = nil
 	}
 
+	c.CounterpartyID = nil
+	if aux.CounterpartyID != nil {
+		counterpartyID, err := uuid.Parse(*aux.CounterpartyID)
+		if err != nil {
+			return err
+		}
+		c.CounterpartyID = &counterpartyID
+	}
+
 	c.ID = id
 	c.ConnectorID = connectorID
 	c.Reference = aux.Reference
@@ -283,7 +325,9 @@ func (c *Payment) UnmarshalJSON(data []byte) error {
 	c.SourceAccountID = sourceAccountID
 	c.DestinationAccountID = destinationAccountID
 	c.Metadata = aux.Metadata
+	c.Fees = aux.Fees
 	c.Adjustments = aux.Adjustments
+	c.Correlation = aux.Correlation
 
 	return nil
 }
@@ -325,6 +369,7 @@ func FromPSPPaymentToPayment(from PSPPayment, connectorID ConnectorID) (Payment,
 		}(),
 		OpenBankingConnectionID: from.OpenBankingConnectionID,
 		Metadata:                from.Metadata,
+		Fees:                    fromPSPPaymentToPaymentFees(from),
 	}
 
 	if p.Status == PAYMENT_STATUS_AUTHORISATION {
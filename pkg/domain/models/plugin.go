@@ -31,6 +31,9 @@ type Plugin interface {
 	ScheduleForDeletion(bool)
 	Install(context.Context, InstallRequest) (InstallResponse, error)
 	Uninstall(context.Context, UninstallRequest) (UninstallResponse, error)
+	// TestConnection performs a lightweight authenticated call against the
+	// PSP to check that the plugin's credentials are accepted.
+	TestConnection(context.Context, TestConnectionRequest) (TestConnectionResponse, error)
 
 	CreateWebhooks(context.Context, CreateWebhooksRequest) (CreateWebhooksResponse, error)
 	TrimWebhook(context.Context, TrimWebhookRequest) (TrimWebhookResponse, error)
@@ -53,6 +56,10 @@ type UninstallRequest struct {
 
 type UninstallResponse struct{}
 
+type TestConnectionRequest struct{}
+
+type TestConnectionResponse struct{}
+
 type CreateWebhooksRequest struct {
 	FromPayload    json.RawMessage
 	ConnectorID    string
@@ -99,6 +106,7 @@ type WebhookResponse struct {
 	PaymentToDelete *PSPPaymentsToDelete
 	PaymentToCancel *PSPPaymentsToCancel
 	Balance         *PSPBalance
+	Dispute         *PSPDispute
 
 	OpenBankingAccount *PSPOpenBankingAccount
 	OpenBankingPayment *PSPOpenBankingPayment
@@ -110,6 +118,7 @@ type WebhookResponse struct {
 	UserConnectionPendingDisconnect *PSPUserConnectionPendingDisconnect
 	UserConnectionDisconnected      *PSPUserConnectionDisconnected
 	UserConnectionReconnected       *PSPUserConnectionReconnected
+	UserPaymentUpdated              *PSPUserPaymentUpdated
 }
 
 type OpenBankingDataToFetch string
@@ -223,6 +232,20 @@ type PSPUserLinkSessionFinished struct {
 	Error     *string
 }
 
+type PSPUserPaymentUpdated struct {
+	// Reference of the payment request on the provider, as returned by
+	// CreateUserPaymentLink
+	PaymentRequestReference string
+	// New status of the related payment initiation
+	Status PaymentInitiationAdjustmentStatus
+	At     time.Time
+
+	// Optional
+	// Payment created on the provider once the payment request is executed
+	Payment *PSPPayment
+	Error   *string
+}
+
 type UserLinkSessionFinished struct {
 	PsuID       uuid.UUID
 	ConnectorID ConnectorID
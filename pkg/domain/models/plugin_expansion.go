@@ -1,5 +1,7 @@
 package models
 
+import "context"
+
 //go:generate mockgen -source plugin_expansion.go -destination plugin_expansion_generated.go -package models
 
 // PluginWithBootstrapOnInstall is an optional upgrade on Plugin. A plugin
@@ -25,3 +27,46 @@ type PluginWithBootstrapOnInstall interface {
 type PluginWithPayoutThrottle interface {
 	PayoutsPerSecond() float64
 }
+
+// PluginWithBankAccountVerification is an optional upgrade on Plugin. A
+// plugin that implements it can check with the PSP that the name given on a
+// bank account matches the name of its holder (name check / confirmation of
+// payee). Such plugins must also declare CAPABILITY_VERIFY_BANK_ACCOUNT.
+type PluginWithBankAccountVerification interface {
+	VerifyBankAccount(context.Context, VerifyBankAccountRequest) (VerifyBankAccountResponse, error)
+}
+
+type VerifyBankAccountRequest struct {
+	BankAccount BankAccount
+}
+
+type VerifyBankAccountResponse struct {
+	Result BankAccountVerificationResult
+	// Name of the holder known by the PSP, if returned
+	MatchedName *string
+}
+
+// PluginWithAccountCreation is an optional upgrade on Plugin. A plugin that
+// implements it can issue new accounts on the PSP, usually virtual accounts
+// (named IBANs) used to collect the funds of a customer. Such plugins must
+// also declare CAPABILITY_CREATE_ACCOUNT.
+type PluginWithAccountCreation interface {
+	CreateAccount(context.Context, CreateAccountRequest) (CreateAccountResponse, error)
+}
+
+type CreateAccountRequest struct {
+	// Reference given by the caller, unique per connector. PSPs supporting it
+	// should use it as the external reference of the account. It is also the
+	// idempotency key of the creation: the call is retried until it succeeds
+	// and must not issue a second account.
+	Reference    string
+	Name         string
+	DefaultAsset *string
+	// Optional, the payment service user the account is issued for
+	PaymentServiceUser *PSPPaymentServiceUser
+	Metadata           map[string]string
+}
+
+type CreateAccountResponse struct {
+	Account PSPAccount
+}
@@ -10,6 +10,7 @@
 package models
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -90,3 +91,81 @@ func (mr *MockPluginWithPayoutThrottleMockRecorder) PayoutsPerSecond() *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayoutsPerSecond", reflect.TypeOf((*MockPluginWithPayoutThrottle)(nil).PayoutsPerSecond))
 }
+
+// MockPluginWithBankAccountVerification is a mock of PluginWithBankAccountVerification interface.
+type MockPluginWithBankAccountVerification struct {
+	ctrl     *gomock.Controller
+	recorder *MockPluginWithBankAccountVerificationMockRecorder
+	isgomock struct{}
+}
+
+// MockPluginWithBankAccountVerificationMockRecorder is the mock recorder for MockPluginWithBankAccountVerification.
+type MockPluginWithBankAccountVerificationMockRecorder struct {
+	mock *MockPluginWithBankAccountVerification
+}
+
+// NewMockPluginWithBankAccountVerification creates a new mock instance.
+func NewMockPluginWithBankAccountVerification(ctrl *gomock.Controller) *MockPluginWithBankAccountVerification {
+	mock := &MockPluginWithBankAccountVerification{ctrl: ctrl}
+	mock.recorder = &MockPluginWithBankAccountVerificationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPluginWithBankAccountVerification) EXPECT() *MockPluginWithBankAccountVerificationMockRecorder {
+	return m.recorder
+}
+
+// VerifyBankAccount mocks base method.
+func (m *MockPluginWithBankAccountVerification) VerifyBankAccount(arg0 context.Context, arg1 VerifyBankAccountRequest) (VerifyBankAccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyBankAccount", arg0, arg1)
+	ret0, _ := ret[0].(VerifyBankAccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyBankAccount indicates an expected call of VerifyBankAccount.
+func (mr *MockPluginWithBankAccountVerificationMockRecorder) VerifyBankAccount(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyBankAccount", reflect.TypeOf((*MockPluginWithBankAccountVerification)(nil).VerifyBankAccount), arg0, arg1)
+}
+
+// MockPluginWithAccountCreation is a mock of PluginWithAccountCreation interface.
+type MockPluginWithAccountCreation struct {
+	ctrl     *gomock.Controller
+	recorder *MockPluginWithAccountCreationMockRecorder
+	isgomock struct{}
+}
+
+// MockPluginWithAccountCreationMockRecorder is the mock recorder for MockPluginWithAccountCreation.
+type MockPluginWithAccountCreationMockRecorder struct {
+	mock *MockPluginWithAccountCreation
+}
+
+// NewMockPluginWithAccountCreation creates a new mock instance.
+func NewMockPluginWithAccountCreation(ctrl *gomock.Controller) *MockPluginWithAccountCreation {
+	mock := &MockPluginWithAccountCreation{ctrl: ctrl}
+	mock.recorder = &MockPluginWithAccountCreationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPluginWithAccountCreation) EXPECT() *MockPluginWithAccountCreationMockRecorder {
+	return m.recorder
+}
+
+// CreateAccount mocks base method.
+func (m *MockPluginWithAccountCreation) CreateAccount(arg0 context.Context, arg1 CreateAccountRequest) (CreateAccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccount", arg0, arg1)
+	ret0, _ := ret[0].(CreateAccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccount indicates an expected call of CreateAccount.
+func (mr *MockPluginWithAccountCreationMockRecorder) CreateAccount(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockPluginWithAccountCreation)(nil).CreateAccount), arg0, arg1)
+}
@@ -159,6 +159,21 @@ func (mr *MockPluginMockRecorder) CreateUserLink(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserLink", reflect.TypeOf((*MockPlugin)(nil).CreateUserLink), arg0, arg1)
 }
 
+// CreateUserPaymentLink mocks base method.
+func (m *MockPlugin) CreateUserPaymentLink(arg0 context.Context, arg1 CreateUserPaymentLinkRequest) (CreateUserPaymentLinkResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserPaymentLink", arg0, arg1)
+	ret0, _ := ret[0].(CreateUserPaymentLinkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserPaymentLink indicates an expected call of CreateUserPaymentLink.
+func (mr *MockPluginMockRecorder) CreateUserPaymentLink(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserPaymentLink", reflect.TypeOf((*MockPlugin)(nil).CreateUserPaymentLink), arg0, arg1)
+}
+
 // CreateWebhooks mocks base method.
 func (m *MockPlugin) CreateWebhooks(arg0 context.Context, arg1 CreateWebhooksRequest) (CreateWebhooksResponse, error) {
 	m.ctrl.T.Helper()
@@ -249,6 +264,21 @@ func (mr *MockPluginMockRecorder) FetchNextConversions(arg0, arg1 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchNextConversions", reflect.TypeOf((*MockPlugin)(nil).FetchNextConversions), arg0, arg1)
 }
 
+// FetchNextDisputes mocks base method.
+func (m *MockPlugin) FetchNextDisputes(arg0 context.Context, arg1 FetchNextDisputesRequest) (FetchNextDisputesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchNextDisputes", arg0, arg1)
+	ret0, _ := ret[0].(FetchNextDisputesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchNextDisputes indicates an expected call of FetchNextDisputes.
+func (mr *MockPluginMockRecorder) FetchNextDisputes(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchNextDisputes", reflect.TypeOf((*MockPlugin)(nil).FetchNextDisputes), arg0, arg1)
+}
+
 // FetchNextExternalAccounts mocks base method.
 func (m *MockPlugin) FetchNextExternalAccounts(arg0 context.Context, arg1 FetchNextExternalAccountsRequest) (FetchNextExternalAccountsResponse, error) {
 	m.ctrl.T.Helper()
@@ -424,6 +454,21 @@ func (mr *MockPluginMockRecorder) ScheduleForDeletion(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleForDeletion", reflect.TypeOf((*MockPlugin)(nil).ScheduleForDeletion), arg0)
 }
 
+// TestConnection mocks base method.
+func (m *MockPlugin) TestConnection(arg0 context.Context, arg1 TestConnectionRequest) (TestConnectionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestConnection", arg0, arg1)
+	ret0, _ := ret[0].(TestConnectionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TestConnection indicates an expected call of TestConnection.
+func (mr *MockPluginMockRecorder) TestConnection(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestConnection", reflect.TypeOf((*MockPlugin)(nil).TestConnection), arg0, arg1)
+}
+
 // TranslateWebhook mocks base method.
 func (m *MockPlugin) TranslateWebhook(arg0 context.Context, arg1 TranslateWebhookRequest) (TranslateWebhookResponse, error) {
 	m.ctrl.T.Helper()
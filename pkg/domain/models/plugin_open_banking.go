@@ -30,6 +30,11 @@ type OpenBankingPlugin interface {
 	DeleteUserConnection(context.Context, DeleteUserConnectionRequest) (DeleteUserConnectionResponse, error)
 	// Delete a specific user on the provider
 	DeleteUser(context.Context, DeleteUserRequest) (DeleteUserResponse, error)
+
+	// Payment Initiation
+	// Create a payment request on the provider and the link to forward to
+	// the user so that they can authorize the payment from their bank
+	CreateUserPaymentLink(context.Context, CreateUserPaymentLinkRequest) (CreateUserPaymentLinkResponse, error)
 }
 
 type CreateUserRequest struct {
@@ -70,6 +75,27 @@ type CreateUserLinkResponse struct {
 	TemporaryLinkToken *Token
 }
 
+type CreateUserPaymentLinkRequest struct {
+	AttemptID                string
+	PaymentServiceUser       *PSPPaymentServiceUser
+	OpenBankingForwardedUser *OpenBankingForwardedUser
+	PaymentInitiation        PSPPaymentInitiation
+	ApplicationName          string
+	ClientRedirectURL        *string
+	FormanceRedirectURL      *string
+	CallBackState            string
+	WebhookBaseURL           string
+}
+
+type CreateUserPaymentLinkResponse struct {
+	// Link created to forward to the user to authorize the payment
+	Link string
+
+	// Reference of the payment request on the provider. It will be used to
+	// match the webhooks received afterwards with the payment initiation.
+	PaymentRequestReference string
+}
+
 type UpdateUserLinkRequest struct {
 	AttemptID                string
 	PaymentServiceUser       *PSPPaymentServiceUser
@@ -145,6 +171,9 @@ type CallbackState struct {
 	Randomized string `json:"randomized"`
 	// ID of the attempt, used to get the client redirect URL
 	AttemptID uuid.UUID `json:"attemptID"`
+	// True if the attempt is related to a payment initiation and not to a
+	// connection.
+	PaymentInitiation bool `json:"paymentInitiation,omitempty"`
 }
 
 func (pid CallbackState) String() string {
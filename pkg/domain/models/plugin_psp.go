@@ -13,6 +13,7 @@ type PSPPlugin interface {
 	FetchNextOthers(context.Context, FetchNextOthersRequest) (FetchNextOthersResponse, error)
 	FetchNextOrders(context.Context, FetchNextOrdersRequest) (FetchNextOrdersResponse, error)
 	FetchNextConversions(context.Context, FetchNextConversionsRequest) (FetchNextConversionsResponse, error)
+	FetchNextDisputes(context.Context, FetchNextDisputesRequest) (FetchNextDisputesResponse, error)
 
 	CreateBankAccount(context.Context, CreateBankAccountRequest) (CreateBankAccountResponse, error)
 	CreateTransfer(context.Context, CreateTransferRequest) (CreateTransferResponse, error)
@@ -27,6 +28,8 @@ type FetchNextAccountsRequest struct {
 	FromPayload json.RawMessage
 	State       json.RawMessage
 	PageSize    int
+	// Window is only set when backfilling, see FetchWindow.
+	Window *FetchWindow
 }
 
 type FetchNextAccountsResponse struct {
@@ -39,6 +42,8 @@ type FetchNextExternalAccountsRequest struct {
 	FromPayload json.RawMessage
 	State       json.RawMessage
 	PageSize    int
+	// Window is only set when backfilling, see FetchWindow.
+	Window *FetchWindow
 }
 
 type FetchNextExternalAccountsResponse struct {
@@ -51,6 +56,8 @@ type FetchNextPaymentsRequest struct {
 	FromPayload json.RawMessage
 	State       json.RawMessage
 	PageSize    int
+	// Window is only set when backfilling, see FetchWindow.
+	Window *FetchWindow
 }
 
 type FetchNextPaymentsResponse struct {
@@ -95,6 +102,11 @@ type CreateBankAccountResponse struct {
 
 type CreateTransferRequest struct {
 	PaymentInitiation PSPPaymentInitiation
+
+	// Optional, filled when one of the payment initiation accounts was
+	// fetched through an open banking connection of a payment service user.
+	PaymentServiceUser    *PSPPaymentServiceUser
+	OpenBankingConnection *OpenBankingConnection
 }
 
 type CreateTransferResponse struct {
@@ -130,6 +142,11 @@ type PollTransferStatusResponse struct {
 
 type CreatePayoutRequest struct {
 	PaymentInitiation PSPPaymentInitiation
+
+	// Optional, filled when one of the payment initiation accounts was
+	// fetched through an open banking connection of a payment service user.
+	PaymentServiceUser    *PSPPaymentServiceUser
+	OpenBankingConnection *OpenBankingConnection
 }
 
 type CreatePayoutResponse struct {
@@ -187,3 +204,15 @@ type FetchNextConversionsResponse struct {
 	HasMore     bool
 }
 
+type FetchNextDisputesRequest struct {
+	FromPayload json.RawMessage
+	State       json.RawMessage
+	PageSize    int
+}
+
+type FetchNextDisputesResponse struct {
+	Disputes []PSPDispute
+	NewState json.RawMessage
+	HasMore  bool
+}
+
@@ -0,0 +1,106 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// RateBudget is the rate at which the plugin of a provider may be called. It
+// is declared when registering a plugin and shared by every workflow, on every
+// worker, of the same connector.
+//
+// The budget counts plugin calls, i.e. activities, not the HTTP requests sent
+// to the PSP: a single call (e.g. fetching a page of payments) may issue
+// several requests. Plugins should size it from the PSP rate limit and the
+// number of requests their heaviest calls make.
+type RateBudget struct {
+	// CallsPerSecond is the rate at which the budget refills.
+	CallsPerSecond float64
+	// Burst is the number of calls that can be made at once, defaults to 1.
+	Burst int
+	// DailyQuota caps the number of calls per UTC day, 0 means no quota.
+	DailyQuota int
+}
+
+func (b RateBudget) Validate() error {
+	if b.CallsPerSecond <= 0 {
+		return fmt.Errorf("rate budget calls per second must be positive: %w", ErrValidation)
+	}
+
+	if b.Burst < 0 {
+		return fmt.Errorf("rate budget burst cannot be negative: %w", ErrValidation)
+	}
+
+	if b.DailyQuota < 0 {
+		return fmt.Errorf("rate budget daily quota cannot be negative: %w", ErrValidation)
+	}
+
+	return nil
+}
+
+func (b RateBudget) capacity() float64 {
+	if b.Burst < 1 {
+		return 1
+	}
+	return float64(b.Burst)
+}
+
+// RateBudgetUsage is how much of its rate budget a connector consumed.
+type RateBudgetUsage struct {
+	ConnectorID ConnectorID
+	// Tokens left in the bucket when it was last refilled
+	Tokens     float64
+	RefilledAt time.Time
+	// Day is the UTC day DailyCount relates to
+	Day        time.Time
+	DailyCount int
+}
+
+// NewUsage returns the usage of a budget nothing was taken from yet.
+func (b RateBudget) NewUsage(connectorID ConnectorID, now time.Time) RateBudgetUsage {
+	now = now.UTC()
+	return RateBudgetUsage{
+		ConnectorID: connectorID,
+		Tokens:      b.capacity(),
+		RefilledAt:  now,
+		Day:         now.Truncate(24 * time.Hour),
+	}
+}
+
+// Take refills the budget up to now and takes one call out of it. When the
+// budget is exhausted, it returns false along with how long to wait before
+// trying again.
+func (b RateBudget) Take(usage RateBudgetUsage, now time.Time) (RateBudgetUsage, time.Duration, bool) {
+	now = now.UTC()
+	capacity := b.capacity()
+
+	if usage.RefilledAt.IsZero() {
+		usage = b.NewUsage(usage.ConnectorID, now)
+	}
+
+	if elapsed := now.Sub(usage.RefilledAt); elapsed > 0 {
+		usage.Tokens = math.Min(capacity, usage.Tokens+elapsed.Seconds()*b.CallsPerSecond)
+		usage.RefilledAt = now
+	}
+
+	day := now.Truncate(24 * time.Hour)
+	if !usage.Day.Equal(day) {
+		usage.Day = day
+		usage.DailyCount = 0
+	}
+
+	if b.DailyQuota > 0 && usage.DailyCount >= b.DailyQuota {
+		return usage, day.Add(24 * time.Hour).Sub(now), false
+	}
+
+	if usage.Tokens < 1 {
+		wait := time.Duration((1 - usage.Tokens) / b.CallsPerSecond * float64(time.Second))
+		return usage, wait, false
+	}
+
+	usage.Tokens--
+	usage.DailyCount++
+
+	return usage, 0, true
+}
@@ -0,0 +1,72 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateBudgetValidate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, models.RateBudget{CallsPerSecond: 10, Burst: 20, DailyQuota: 1000}.Validate())
+	require.ErrorIs(t, models.RateBudget{}.Validate(), models.ErrValidation)
+	require.ErrorIs(t, models.RateBudget{CallsPerSecond: 1, Burst: -1}.Validate(), models.ErrValidation)
+	require.ErrorIs(t, models.RateBudget{CallsPerSecond: 1, DailyQuota: -1}.Validate(), models.ErrValidation)
+}
+
+func TestRateBudgetTake(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("burst then refill", func(t *testing.T) {
+		t.Parallel()
+
+		budget := models.RateBudget{CallsPerSecond: 2, Burst: 2}
+
+		var usage models.RateBudgetUsage
+		var ok bool
+		for i := 0; i < 2; i++ {
+			usage, _, ok = budget.Take(usage, now)
+			require.True(t, ok)
+		}
+
+		usage, wait, ok := budget.Take(usage, now)
+		require.False(t, ok)
+		require.Equal(t, 500*time.Millisecond, wait)
+
+		_, _, ok = budget.Take(usage, now.Add(wait))
+		require.True(t, ok)
+	})
+
+	t.Run("refill is capped by the burst", func(t *testing.T) {
+		t.Parallel()
+
+		budget := models.RateBudget{CallsPerSecond: 1, Burst: 3}
+		usage := models.RateBudgetUsage{Tokens: 0, RefilledAt: now, Day: now.Truncate(24 * time.Hour)}
+
+		usage, _, ok := budget.Take(usage, now.Add(time.Hour))
+		require.True(t, ok)
+		require.Equal(t, float64(2), usage.Tokens)
+	})
+
+	t.Run("daily quota", func(t *testing.T) {
+		t.Parallel()
+
+		budget := models.RateBudget{CallsPerSecond: 100, Burst: 100, DailyQuota: 1}
+
+		usage, _, ok := budget.Take(models.RateBudgetUsage{}, now)
+		require.True(t, ok)
+
+		usage, wait, ok := budget.Take(usage, now)
+		require.False(t, ok)
+		require.Equal(t, 12*time.Hour, wait)
+
+		usage, _, ok = budget.Take(usage, now.Add(wait))
+		require.True(t, ok)
+		require.Equal(t, 1, usage.DailyCount)
+	})
+}
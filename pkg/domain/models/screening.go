@@ -0,0 +1,202 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type ScreeningDecision int
+
+const (
+	SCREENING_DECISION_UNKNOWN ScreeningDecision = iota
+	SCREENING_DECISION_APPROVED
+	SCREENING_DECISION_REJECTED
+	// The screening service needs more time, the decision will be sent
+	// asynchronously through the screening callback.
+	SCREENING_DECISION_PENDING
+)
+
+func (d ScreeningDecision) String() string {
+	switch d {
+	case SCREENING_DECISION_APPROVED:
+		return "APPROVED"
+	case SCREENING_DECISION_REJECTED:
+		return "REJECTED"
+	case SCREENING_DECISION_PENDING:
+		return "PENDING"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+func ScreeningDecisionFromString(value string) (ScreeningDecision, error) {
+	switch value {
+	case "APPROVED":
+		return SCREENING_DECISION_APPROVED, nil
+	case "REJECTED":
+		return SCREENING_DECISION_REJECTED, nil
+	case "PENDING":
+		return SCREENING_DECISION_PENDING, nil
+	case "UNKNOWN":
+		return SCREENING_DECISION_UNKNOWN, nil
+	default:
+		return SCREENING_DECISION_UNKNOWN, fmt.Errorf("unknown screening decision: %s", value)
+	}
+}
+
+func (d ScreeningDecision) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf(`"%s"`, d.String())), nil
+}
+
+func (d *ScreeningDecision) UnmarshalJSON(data []byte) error {
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	value, err := ScreeningDecisionFromString(v)
+	if err != nil {
+		return err
+	}
+
+	*d = value
+
+	return nil
+}
+
+// ScreeningRequest is sent to the sanctions/AML screening service before a
+// payment initiation is forwarded to the PSP.
+type ScreeningRequest struct {
+	PaymentInitiationID PaymentInitiationID   `json:"paymentInitiationID"`
+	Type                PaymentInitiationType `json:"type"`
+	Reference           string                `json:"reference"`
+	Description         string                `json:"description"`
+	Amount              *big.Int              `json:"amount"`
+	Asset               string                `json:"asset"`
+	Beneficiary         BankAccount           `json:"beneficiary"`
+
+	// URL on which the screening service must post its decision when it
+	// answered PENDING.
+	CallbackURL string `json:"callbackURL"`
+}
+
+func (r ScreeningRequest) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		PaymentInitiationID string                `json:"paymentInitiationID"`
+		Type                PaymentInitiationType `json:"type"`
+		Reference           string                `json:"reference"`
+		Description         string                `json:"description"`
+		Amount              *big.Int              `json:"amount"`
+		Asset               string                `json:"asset"`
+		Beneficiary         BankAccount           `json:"beneficiary"`
+		CallbackURL         string                `json:"callbackURL"`
+	}{
+		PaymentInitiationID: r.PaymentInitiationID.String(),
+		Type:                r.Type,
+		Reference:           r.Reference,
+		Description:         r.Description,
+		Amount:              r.Amount,
+		Asset:               r.Asset,
+		Beneficiary:         r.Beneficiary,
+		CallbackURL:         r.CallbackURL,
+	})
+}
+
+func (r *ScreeningRequest) UnmarshalJSON(data []byte) error {
+	var aux struct {
+		PaymentInitiationID string                `json:"paymentInitiationID"`
+		Type                PaymentInitiationType `json:"type"`
+		Reference           string                `json:"reference"`
+		Description         string                `json:"description"`
+		Amount              *big.Int              `json:"amount"`
+		Asset               string                `json:"asset"`
+		Beneficiary         BankAccount           `json:"beneficiary"`
+		CallbackURL         string                `json:"callbackURL"`
+	}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	id, err := PaymentInitiationIDFromString(aux.PaymentInitiationID)
+	if err != nil {
+		return err
+	}
+
+	r.PaymentInitiationID = id
+	r.Type = aux.Type
+	r.Reference = aux.Reference
+	r.Description = aux.Description
+	r.Amount = aux.Amount
+	r.Asset = aux.Asset
+	r.Beneficiary = aux.Beneficiary
+	r.CallbackURL = aux.CallbackURL
+
+	return nil
+}
+
+type ScreeningResult struct {
+	Decision ScreeningDecision `json:"decision"`
+	// Optional, why the payment initiation was rejected.
+	Reason string `json:"reason,omitempty"`
+}
+
+// ScreeningBeneficiaryFromPSPAccount builds the beneficiary sent to the
+// screening service from the bank details stored in the metadata of the
+// destination account.
+func ScreeningBeneficiaryFromPSPAccount(account *PSPAccount) BankAccount {
+	if account == nil {
+		return BankAccount{}
+	}
+
+	ba := BankAccount{
+		CreatedAt: account.CreatedAt,
+		Name:      account.Reference,
+		Metadata:  make(map[string]string),
+	}
+
+	// External accounts created from a Formance bank account are referenced
+	// by the bank account ID.
+	if id, err := uuid.Parse(account.Reference); err == nil {
+		ba.ID = id
+	}
+
+	if name := account.Metadata[AccountBankAccountNameMetadataKey]; name != "" {
+		ba.Name = name
+	} else if account.Name != nil && *account.Name != "" {
+		ba.Name = *account.Name
+	}
+
+	if v := strings.TrimSpace(account.Metadata[AccountIBANMetadataKey]); v != "" {
+		ba.IBAN = &v
+	}
+	if v := strings.TrimSpace(account.Metadata[AccountAccountNumberMetadataKey]); v != "" {
+		ba.AccountNumber = &v
+	}
+	if v := strings.TrimSpace(account.Metadata[AccountSwiftBicCodeMetadataKey]); v != "" {
+		ba.SwiftBicCode = &v
+	}
+	if v := strings.TrimSpace(account.Metadata[AccountBankAccountCountryMetadataKey]); v != "" {
+		ba.Country = &v
+	}
+
+	for _, key := range []string{
+		BankAccountOwnerAddressLine1MetadataKey,
+		BankAccountOwnerAddressLine2MetadataKey,
+		BankAccountOwnerCityMetadataKey,
+		BankAccountOwnerRegionMetadataKey,
+		BankAccountOwnerPostalCodeMetadataKey,
+		BankAccountOwnerEmailMetadataKey,
+		BankAccountOwnerPhoneNumberMetadataKey,
+	} {
+		if v := account.Metadata[key]; v != "" {
+			ba.Metadata[key] = v
+		}
+	}
+
+	return ba
+}
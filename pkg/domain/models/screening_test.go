@@ -0,0 +1,118 @@
+package models_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/formancehq/go-libs/v5/pkg/types/pointer"
+	"github.com/formancehq/payments/pkg/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScreeningDecision(t *testing.T) {
+	t.Parallel()
+
+	for _, decision := range []models.ScreeningDecision{
+		models.SCREENING_DECISION_UNKNOWN,
+		models.SCREENING_DECISION_APPROVED,
+		models.SCREENING_DECISION_REJECTED,
+		models.SCREENING_DECISION_PENDING,
+	} {
+		res, err := models.ScreeningDecisionFromString(decision.String())
+		require.NoError(t, err)
+		require.Equal(t, decision, res)
+	}
+
+	_, err := models.ScreeningDecisionFromString("invalid")
+	require.Error(t, err)
+
+	var result models.ScreeningResult
+	require.NoError(t, json.Unmarshal([]byte(`{"decision":"REJECTED","reason":"sanctioned"}`), &result))
+	require.Equal(t, models.ScreeningResult{Decision: models.SCREENING_DECISION_REJECTED, Reason: "sanctioned"}, result)
+
+	require.Error(t, json.Unmarshal([]byte(`{"decision":"MAYBE"}`), &result))
+}
+
+func TestScreeningRequestJSON(t *testing.T) {
+	t.Parallel()
+
+	connectorID := models.ConnectorID{Provider: "wise", Reference: uuid.New()}
+	req := models.ScreeningRequest{
+		PaymentInitiationID: models.PaymentInitiationID{Reference: "pi1", ConnectorID: connectorID},
+		Type:                models.PAYMENT_INITIATION_TYPE_PAYOUT,
+		Reference:           "pi1",
+		Amount:              big.NewInt(100),
+		Asset:               "EUR/2",
+		Beneficiary: models.BankAccount{
+			ID:       uuid.New(),
+			Name:     "John Doe",
+			IBAN:     pointer.For("FR7630006000011234567890189"),
+			Metadata: map[string]string{},
+		},
+		CallbackURL: "http://localhost/screening",
+	}
+
+	data, err := json.Marshal(req)
+	require.NoError(t, err)
+
+	var res models.ScreeningRequest
+	require.NoError(t, json.Unmarshal(data, &res))
+	require.Equal(t, req, res)
+}
+
+func TestScreeningBeneficiaryFromPSPAccount(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil account", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, models.BankAccount{}, models.ScreeningBeneficiaryFromPSPAccount(nil))
+	})
+
+	t.Run("formance bank account", func(t *testing.T) {
+		t.Parallel()
+
+		bankAccountID := uuid.New()
+		createdAt := time.Now().UTC()
+		ba := models.ScreeningBeneficiaryFromPSPAccount(&models.PSPAccount{
+			Reference: bankAccountID.String(),
+			CreatedAt: createdAt,
+			Name:      pointer.For("account name"),
+			Metadata: map[string]string{
+				models.AccountBankAccountNameMetadataKey:    "John Doe",
+				models.AccountIBANMetadataKey:               "FR7630006000011234567890189",
+				models.AccountSwiftBicCodeMetadataKey:       "",
+				models.AccountBankAccountCountryMetadataKey: "FR",
+				models.BankAccountOwnerCityMetadataKey:      "Paris",
+				"other":                                     "value",
+			},
+		})
+
+		require.Equal(t, models.BankAccount{
+			ID:        bankAccountID,
+			CreatedAt: createdAt,
+			Name:      "John Doe",
+			IBAN:      pointer.For("FR7630006000011234567890189"),
+			Country:   pointer.For("FR"),
+			Metadata: map[string]string{
+				models.BankAccountOwnerCityMetadataKey: "Paris",
+			},
+		}, ba)
+	})
+
+	t.Run("psp account", func(t *testing.T) {
+		t.Parallel()
+
+		ba := models.ScreeningBeneficiaryFromPSPAccount(&models.PSPAccount{
+			Reference: "acc1",
+			Name:      pointer.For("account name"),
+		})
+
+		require.Equal(t, uuid.Nil, ba.ID)
+		require.Equal(t, "account name", ba.Name)
+		require.Nil(t, ba.IBAN)
+	})
+}
@@ -39,6 +39,10 @@ func (dp *basePlugin) Uninstall(ctx context.Context, req models.UninstallRequest
 	return models.UninstallResponse{}, ErrNotImplemented
 }
 
+func (dp *basePlugin) TestConnection(ctx context.Context, req models.TestConnectionRequest) (models.TestConnectionResponse, error) {
+	return models.TestConnectionResponse{}, ErrNotImplemented
+}
+
 func (dp *basePlugin) FetchNextAccounts(ctx context.Context, req models.FetchNextAccountsRequest) (models.FetchNextAccountsResponse, error) {
 	return models.FetchNextAccountsResponse{}, ErrNotImplemented
 }
@@ -95,6 +99,10 @@ func (dp *basePlugin) FetchNextConversions(ctx context.Context, req models.Fetch
 	return models.FetchNextConversionsResponse{}, ErrNotImplemented
 }
 
+func (dp *basePlugin) FetchNextDisputes(ctx context.Context, req models.FetchNextDisputesRequest) (models.FetchNextDisputesResponse, error) {
+	return models.FetchNextDisputesResponse{}, ErrNotImplemented
+}
+
 func (dp *basePlugin) CreateWebhooks(ctx context.Context, req models.CreateWebhooksRequest) (models.CreateWebhooksResponse, error) {
 	return models.CreateWebhooksResponse{}, ErrNotImplemented
 }
@@ -141,4 +149,8 @@ func (dp *basePlugin) DeleteUser(ctx context.Context, req models.DeleteUserReque
 	return models.DeleteUserResponse{}, ErrNotImplemented
 }
 
+func (dp *basePlugin) CreateUserPaymentLink(ctx context.Context, req models.CreateUserPaymentLinkRequest) (models.CreateUserPaymentLinkResponse, error) {
+	return models.CreateUserPaymentLinkResponse{}, ErrNotImplemented
+}
+
 var _ models.Plugin = &basePlugin{}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	logging "github.com/formancehq/go-libs/v5/pkg/observe/log"
+	"github.com/formancehq/payments/pkg/domain/bankvalidation"
 	"github.com/formancehq/payments/pkg/domain/models"
 )
 
@@ -20,4 +21,10 @@ type Registration struct {
 	CreateFunc   CreateFunc
 	PageSize     uint64
 	RawConf      any
+	// RateBudget is optional, plugins without one are not throttled. It
+	// counts plugin calls, not PSP requests, see models.RateBudget.
+	RateBudget *models.RateBudget
+	// RoutingCodeMetadataKeys are the bank account metadata keys the plugin
+	// reads routing codes from, validated when bank accounts are created.
+	RoutingCodeMetadataKeys []bankvalidation.RoutingCodeMetadataKey
 }
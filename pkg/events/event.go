@@ -14,11 +14,15 @@ const (
 	EventTypeSavedBalances                              = "SAVED_BALANCE"
 	EventTypeSavedBankAccount                           = "SAVED_BANK_ACCOUNT"
 	EventTypeConnectorReset                             = "CONNECTOR_RESET"
+	EventTypeConnectorCredentialsRotated                = "CONNECTOR_CREDENTIALS_ROTATED"
+	EventTypeConnectorHealthChanged                     = "CONNECTOR_HEALTH_CHANGED"
 	EventTypeSavedPaymentInitiation                     = "SAVED_PAYMENT_INITIATION"
 	EventTypeSavedPaymentInitiationAdjustment           = "SAVED_PAYMENT_INITIATION_ADJUSTMENT"
 	EventTypeSavedPaymentInitiationRelatedPayment       = "SAVED_PAYMENT_INITIATION_RELATED_PAYMENT"
 	EventTypeSavedOrder                                 = "SAVED_ORDER"
 	EventTypeSavedConversion                            = "SAVED_CONVERSION"
+	EventTypeSavedDispute                               = "SAVED_DISPUTE"
+	EventTypeSavedPaymentCorrelation                    = "SAVED_PAYMENT_CORRELATION"
 	EventTypeUpdatedTask                                = "UPDATED_TASK"
 	EventTypeOpenBankingUserLinkStatus                  = "OPEN_BANKING_USER_LINK_STATUS"
 	EventTypeOpenBankingUserConnectionDataSynced        = "OPEN_BANKING_USER_CONNECTION_DATA_SYNCED"
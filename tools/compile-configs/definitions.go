@@ -34,10 +34,14 @@ type V3Config struct {
 }
 
 type Property struct {
-	Type                         string `yaml:"type"`
-	Default                      any    `yaml:"default,omitempty"`
-	Deprecated                   bool   `yaml:"deprecated,omitempty"`
-	XSpeakeasyDeprecationMessage string `yaml:"x-speakeasy-deprecation-message,omitempty"`
+	Ref                          string    `yaml:"$ref,omitempty"`
+	Type                         string    `yaml:"type,omitempty"`
+	Description                  string    `yaml:"description,omitempty"`
+	Default                      any       `yaml:"default,omitempty"`
+	Deprecated                   bool      `yaml:"deprecated,omitempty"`
+	XSpeakeasyDeprecationMessage string    `yaml:"x-speakeasy-deprecation-message,omitempty"`
+	AdditionalProperties         *Property `yaml:"additionalProperties,omitempty"`
+	Items                        *Property `yaml:"items,omitempty"`
 }
 
 type ConfigJson map[string]ConfigProperties
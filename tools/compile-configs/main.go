@@ -148,6 +148,20 @@ func readConfig(basePath string, name string, caserName string) (V3Config, error
 			Deprecated:                   true,
 			XSpeakeasyDeprecationMessage: "From v3.1, this parameter will be ignored",
 		},
+		"pollingPeriods": {
+			Type:        "object",
+			Description: "Polling period overrides by fetch capability (e.g. FETCH_BALANCES), falling back to pollingPeriod",
+			AdditionalProperties: &Property{
+				Type: "string",
+			},
+		},
+		"blackoutWindows": {
+			Type:        "array",
+			Description: "Daily UTC time windows during which the connector is not polled",
+			Items: &Property{
+				Ref: "#/components/schemas/V3BlackoutWindow",
+			},
+		},
 	}
 	for _, decl := range f.Decls {
 		gen, ok := decl.(*ast.GenDecl)
@@ -19,6 +19,7 @@ var taskTypeNames = map[models.TaskType]string{
 	models.TASK_FETCH_PAYMENTS:          "FETCH_PAYMENTS",
 	models.TASK_FETCH_ORDERS:            "FETCH_ORDERS",
 	models.TASK_FETCH_CONVERSIONS:       "FETCH_CONVERSIONS",
+	models.TASK_FETCH_DISPUTES:          "FETCH_DISPUTES",
 	models.TASK_CREATE_WEBHOOKS:         "CREATE_WEBHOOKS",
 }
 